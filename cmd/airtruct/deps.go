@@ -5,12 +5,14 @@ import (
 	"os"
 	"strings"
 
+	"github.com/dustin/go-humanize"
 	"github.com/rs/zerolog/log"
 	"github.com/urfave/cli/v2"
 	_ "github.com/warpstreamlabs/bento/public/components/all"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/credentials/insecure"
 
+	"github.com/sananguliyev/airtruct/internal/alerting"
 	"github.com/sananguliyev/airtruct/internal/analytics"
 	"github.com/sananguliyev/airtruct/internal/api"
 	"github.com/sananguliyev/airtruct/internal/api/coordinator"
@@ -71,6 +73,42 @@ func buildAuthConfig(ctx *cli.Context) *config.AuthConfig {
 	}
 }
 
+func buildIngressConfig(ctx *cli.Context) *config.IngressConfig {
+	maxBodySize, err := humanize.ParseBytes(expandStr(ctx, "ingress.max-body-size"))
+	if err != nil {
+		log.Fatal().Err(err).Msg("Invalid ingress max body size")
+	}
+
+	return &config.IngressConfig{
+		HeaderAllowlist:       splitComma(expandStr(ctx, "ingress.header-allowlist")),
+		HeaderDenylist:        splitComma(expandStr(ctx, "ingress.header-denylist")),
+		DeadLetterRetention:   ctx.Duration("ingress.dead-letter-retention"),
+		MaxBodySize:           int64(maxBodySize),
+		RequestTimeout:        ctx.Duration("ingress.request-timeout"),
+		MaxConcurrentRequests: ctx.Int("ingress.max-concurrent-requests"),
+	}
+}
+
+func buildAlertingConfig(ctx *cli.Context) *config.AlertingConfig {
+	return &config.AlertingConfig{
+		EvaluationInterval: ctx.Duration("alerting.evaluation-interval"),
+		SMTPHost:           expandStr(ctx, "alerting.smtp-host"),
+		SMTPPort:           ctx.Int("alerting.smtp-port"),
+		SMTPUsername:       expandStr(ctx, "alerting.smtp-username"),
+		SMTPPassword:       expandStr(ctx, "alerting.smtp-password"),
+		SMTPFrom:           expandStr(ctx, "alerting.smtp-from"),
+	}
+}
+
+func buildTracingConfig(ctx *cli.Context) *config.TracingConfig {
+	return &config.TracingConfig{
+		Endpoint:    expandStr(ctx, "tracing.otlp-endpoint"),
+		Protocol:    expandStr(ctx, "tracing.otlp-protocol"),
+		Secure:      ctx.Bool("tracing.otlp-secure"),
+		SampleRatio: ctx.Float64("tracing.sample-ratio"),
+	}
+}
+
 func splitComma(s string) []string {
 	if s == "" {
 		return nil
@@ -90,6 +128,8 @@ func InitializeCoordinatorCommand(ctx *cli.Context) *intcli.CoordinatorCLI {
 	db := persistence.NewGormDB(databaseConfig)
 	secretConfig := buildSecretConfig(ctx)
 	authConfig := buildAuthConfig(ctx)
+	ingressConfig := buildIngressConfig(ctx)
+	alertingConfig := buildAlertingConfig(ctx)
 	authManager, err := auth.NewManager(authConfig, secretConfig.Key)
 	if err != nil {
 		log.Fatal().Err(err).Msg("Failed to create auth manager")
@@ -104,24 +144,37 @@ func InitializeCoordinatorCommand(ctx *cli.Context) *intcli.CoordinatorCLI {
 	flowRepository := persistence.NewFlowRepository(db)
 	workerRepository := persistence.NewWorkerRepository(db)
 	workerFlowRepository := persistence.NewWorkerFlowRepository(db)
+	flowMetricRepository := persistence.NewFlowMetricRepository(db)
+	flowComponentMetricRepository := persistence.NewFlowComponentMetricRepository(db)
+	flowLogRepository := persistence.NewFlowLogRepository(db, ctx.Duration("logs.retention"))
 	secretRepository := persistence.NewSecretRepository(db)
 	cacheRepository := persistence.NewCacheRepository(db)
+	mcpServerRepository := persistence.NewMCPServerRepository(db)
+	mcpToolCallRepository := persistence.NewMCPToolCallRepository(db)
 	flowCacheRepository := persistence.NewFlowCacheRepository(db)
 	bufferRepository := persistence.NewBufferRepository(db)
 	flowBufferRepository := persistence.NewFlowBufferRepository(db)
 	rateLimitRepository := persistence.NewRateLimitRepository(db)
 	rateLimitStateRepository := persistence.NewRateLimitStateRepository(db)
+	rateLimitCounterRepository := persistence.NewRateLimitCounterRepository(db)
 	flowRateLimitRepository := persistence.NewFlowRateLimitRepository(db)
 	fileRepository := persistence.NewFileRepository(db)
-	rateLimiterEngine := ratelimiter.NewEngine(rateLimitRepository, rateLimitStateRepository)
+	queuedRequestRepository := persistence.NewQueuedRequestRepository(db)
+	alertRuleRepository := persistence.NewAlertRuleRepository(db)
+	alertChannelRepository := persistence.NewAlertChannelRepository(db)
+	alertSilenceRepository := persistence.NewAlertSilenceRepository(db)
+	alertRepository := persistence.NewAlertRepository(db)
+	alertManager := alerting.NewManager(alertRuleRepository, alertChannelRepository, alertSilenceRepository, alertRepository, flowRepository, workerRepository, workerFlowRepository, flowMetricRepository, alertingConfig)
+	rateLimiterEngine := ratelimiter.NewEngine(rateLimitRepository, rateLimitStateRepository, rateLimitCounterRepository)
 	analyticsProvider := analytics.NewLocalProvider(db)
 	flowWorkerMap := executorcoordinator.NewFlowWorkerMap()
-	coordinatorAPI := coordinator.NewCoordinatorAPI(eventRepository, flowRepository, flowCacheRepository, flowRateLimitRepository, flowBufferRepository, workerRepository, workerFlowRepository, secretRepository, cacheRepository, bufferRepository, rateLimitRepository, fileRepository, rateLimiterEngine, aesgcm, analyticsProvider, flowWorkerMap)
-	coordinatorExecutor := executor.NewCoordinatorExecutor(workerRepository, flowRepository, flowCacheRepository, flowRateLimitRepository, workerFlowRepository, fileRepository, flowWorkerMap)
-	mcpHandler := mcppkg.NewMCPHandler(flowRepository, coordinatorExecutor, Version)
+	coordinatorExecutor := executor.NewCoordinatorExecutor(workerRepository, flowRepository, flowCacheRepository, flowRateLimitRepository, workerFlowRepository, fileRepository, secretRepository, queuedRequestRepository, aesgcm, rateLimiterEngine, flowWorkerMap, ingressConfig, alertManager)
+	mcpHandler := mcppkg.NewMCPHandler(flowRepository, mcpServerRepository, mcpToolCallRepository, secretRepository, aesgcm, rateLimiterEngine, coordinatorExecutor, Version)
+	coordinatorAPI := coordinator.NewCoordinatorAPI(eventRepository, flowRepository, flowCacheRepository, flowRateLimitRepository, flowBufferRepository, workerRepository, workerFlowRepository, flowMetricRepository, flowComponentMetricRepository, flowLogRepository, secretRepository, cacheRepository, mcpServerRepository, mcpToolCallRepository, bufferRepository, rateLimitRepository, fileRepository, queuedRequestRepository, alertRuleRepository, alertChannelRepository, alertSilenceRepository, alertRepository, rateLimiterEngine, aesgcm, analyticsProvider, flowWorkerMap, mcpHandler, alertManager)
 	httpPort := uint32(ctx.Uint("http-port"))
 	grpcPort := uint32(ctx.Uint("grpc-port"))
-	coordinatorCLI := intcli.NewCoordinatorCLI(coordinatorAPI, coordinatorExecutor, rateLimiterEngine, authManager, mcpHandler, httpPort, grpcPort)
+	metricsPort := uint32(ctx.Uint("metrics-port"))
+	coordinatorCLI := intcli.NewCoordinatorCLI(coordinatorAPI, coordinatorExecutor, rateLimiterEngine, []intcli.RetentionStore{flowMetricRepository, flowComponentMetricRepository, eventRepository, flowLogRepository}, alertManager, alertingConfig.EvaluationInterval, authManager, mcpHandler, httpPort, grpcPort, metricsPort)
 	return coordinatorCLI
 }
 
@@ -138,6 +191,7 @@ func InitializeWorkerCommand(appCtx context.Context, ctx *cli.Context) *intcli.W
 	vaultProvider := vault.NewLocalProvider(secretConfig, grpcConn)
 	workerExecutor := executor.NewWorkerExecutor(appCtx, grpcConn, grpcPort, vaultProvider)
 	workerAPI := api.NewWorkerAPI(workerExecutor)
-	workerCLI := intcli.NewWorkerCLI(workerAPI, workerExecutor, grpcPort)
+	metricsPort := uint32(ctx.Uint("metrics-port"))
+	workerCLI := intcli.NewWorkerCLI(workerAPI, workerExecutor, grpcPort, metricsPort)
 	return workerCLI
 }
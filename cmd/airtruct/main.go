@@ -1,11 +1,14 @@
 package main
 
 import (
+	"context"
 	"fmt"
 	"os"
 	"os/signal"
+	"time"
 
 	_ "github.com/sananguliyev/airtruct/internal/components/all"
+	"github.com/sananguliyev/airtruct/internal/tracing"
 
 	"github.com/rs/zerolog"
 	"github.com/rs/zerolog/log"
@@ -64,6 +67,34 @@ func main() {
 				EnvVars: []string{"GRPC_PORT"},
 				Value:   50000,
 			}),
+			altsrc.NewUintFlag(&cli.UintFlag{
+				Name:    "metrics-port",
+				Aliases: []string{"mp"},
+				Usage:   "port of the /metrics endpoint; when 0 workers do not serve metrics and the coordinator serves them on its http port behind authentication",
+				EnvVars: []string{"METRICS_PORT"},
+			}),
+			altsrc.NewStringFlag(&cli.StringFlag{
+				Name:    "tracing.otlp-endpoint",
+				Usage:   "host:port of the OTLP collector traces are exported to, tracing is disabled when empty",
+				EnvVars: []string{"TRACING_OTLP_ENDPOINT"},
+			}),
+			altsrc.NewStringFlag(&cli.StringFlag{
+				Name:    "tracing.otlp-protocol",
+				Usage:   "OTLP transport (grpc or http)",
+				EnvVars: []string{"TRACING_OTLP_PROTOCOL"},
+				Value:   "grpc",
+			}),
+			altsrc.NewBoolFlag(&cli.BoolFlag{
+				Name:    "tracing.otlp-secure",
+				Usage:   "connect to the OTLP collector with TLS",
+				EnvVars: []string{"TRACING_OTLP_SECURE"},
+			}),
+			altsrc.NewFloat64Flag(&cli.Float64Flag{
+				Name:    "tracing.sample-ratio",
+				Usage:   "share of new traces that are recorded, between 0 and 1",
+				EnvVars: []string{"TRACING_SAMPLE_RATIO"},
+				Value:   1,
+			}),
 			altsrc.NewBoolFlag(&cli.BoolFlag{
 				Name:    "debug",
 				Aliases: []string{"d"},
@@ -157,6 +188,77 @@ func main() {
 				EnvVars: []string{"AUTH_OAUTH2_SESSION_COOKIE_NAME"},
 				Value:   "airtruct_session",
 			}),
+			altsrc.NewStringFlag(&cli.StringFlag{
+				Name:    "ingress.header-allowlist",
+				Usage:   "Headers forwarded between ingest clients and flows (comma-separated, * suffix matches a prefix), all when empty",
+				EnvVars: []string{"INGRESS_HEADER_ALLOWLIST"},
+			}),
+			altsrc.NewStringFlag(&cli.StringFlag{
+				Name:    "ingress.header-denylist",
+				Usage:   "Headers never forwarded between ingest clients and flows (comma-separated, * suffix matches a prefix)",
+				EnvVars: []string{"INGRESS_HEADER_DENYLIST"},
+			}),
+			altsrc.NewDurationFlag(&cli.DurationFlag{
+				Name:    "ingress.dead-letter-retention",
+				Value:   7 * 24 * time.Hour,
+				Usage:   "How long queued ingest requests that could not be delivered are kept",
+				EnvVars: []string{"INGRESS_DEAD_LETTER_RETENTION"},
+			}),
+			altsrc.NewStringFlag(&cli.StringFlag{
+				Name:    "ingress.max-body-size",
+				Value:   "10MB",
+				Usage:   "Default size limit of ingest request bodies, e.g. 512KB or 10MiB, 0 disables it",
+				EnvVars: []string{"INGRESS_MAX_BODY_SIZE"},
+			}),
+			altsrc.NewDurationFlag(&cli.DurationFlag{
+				Name:    "ingress.request-timeout",
+				Usage:   "Default time a flow has to respond to an ingest request, 0 disables it",
+				EnvVars: []string{"INGRESS_REQUEST_TIMEOUT"},
+			}),
+			altsrc.NewIntFlag(&cli.IntFlag{
+				Name:    "ingress.max-concurrent-requests",
+				Value:   1000,
+				Usage:   "Ingest requests the coordinator handles at once, further requests receive 503, 0 disables it",
+				EnvVars: []string{"INGRESS_MAX_CONCURRENT_REQUESTS"},
+			}),
+			altsrc.NewDurationFlag(&cli.DurationFlag{
+				Name:    "logs.retention",
+				Value:   7 * 24 * time.Hour,
+				Usage:   "How long log lines collected from flows are kept, 0 keeps them forever",
+				EnvVars: []string{"LOGS_RETENTION"},
+			}),
+			altsrc.NewDurationFlag(&cli.DurationFlag{
+				Name:    "alerting.evaluation-interval",
+				Value:   30 * time.Second,
+				Usage:   "How often alert rules are evaluated",
+				EnvVars: []string{"ALERTING_EVALUATION_INTERVAL"},
+			}),
+			altsrc.NewStringFlag(&cli.StringFlag{
+				Name:    "alerting.smtp-host",
+				Usage:   "Host of the SMTP server alert emails are sent through",
+				EnvVars: []string{"ALERTING_SMTP_HOST"},
+			}),
+			altsrc.NewIntFlag(&cli.IntFlag{
+				Name:    "alerting.smtp-port",
+				Value:   587,
+				Usage:   "Port of the SMTP server alert emails are sent through",
+				EnvVars: []string{"ALERTING_SMTP_PORT"},
+			}),
+			altsrc.NewStringFlag(&cli.StringFlag{
+				Name:    "alerting.smtp-username",
+				Usage:   "Username to authenticate with the SMTP server, no authentication when empty",
+				EnvVars: []string{"ALERTING_SMTP_USERNAME"},
+			}),
+			altsrc.NewStringFlag(&cli.StringFlag{
+				Name:    "alerting.smtp-password",
+				Usage:   "Password to authenticate with the SMTP server",
+				EnvVars: []string{"ALERTING_SMTP_PASSWORD"},
+			}),
+			altsrc.NewStringFlag(&cli.StringFlag{
+				Name:    "alerting.smtp-from",
+				Usage:   "Sender address of alert emails",
+				EnvVars: []string{"ALERTING_SMTP_FROM"},
+			}),
 		},
 		Before: func(ctx *cli.Context) error {
 			configFile := ctx.String("config")
@@ -178,6 +280,14 @@ func main() {
 				return fmt.Errorf("invalid role: %s. Must be '%s' or '%s'", role, RoleCoordinator, RoleWorker)
 			}
 
+			if ratio := ctx.Float64("tracing.sample-ratio"); ratio < 0 || ratio > 1 {
+				return fmt.Errorf("invalid tracing.sample-ratio: %v. Must be between 0 and 1", ratio)
+			}
+
+			if interval := ctx.Duration("alerting.evaluation-interval"); interval <= 0 {
+				return fmt.Errorf("invalid alerting.evaluation-interval: %v. Must be positive", interval)
+			}
+
 			if ctx.String("secret.key") == "" {
 				return fmt.Errorf("secret.key is required (set via YAML, SECRET_KEY env, or --secret.key flag)")
 			}
@@ -192,6 +302,17 @@ func main() {
 			cCtx, stop := signal.NotifyContext(ctx.Context, os.Interrupt, os.Kill)
 			defer stop()
 			setLogLevel(ctx.Bool("debug"))
+			shutdownTracing, err := tracing.Init(cCtx, buildTracingConfig(ctx), "airtruct-"+ctx.String("role"), Version)
+			if err != nil {
+				return fmt.Errorf("failed to initialize tracing: %w", err)
+			}
+			defer func() {
+				shutdownCtx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+				defer cancel()
+				if err := shutdownTracing(shutdownCtx); err != nil {
+					log.Error().Err(err).Msg("failed to flush traces")
+				}
+			}()
 			if ctx.String("role") == RoleCoordinator {
 				log.Info().Msg("starting coordinator")
 				coordinatorCLI := InitializeCoordinatorCommand(ctx)
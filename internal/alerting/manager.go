@@ -0,0 +1,465 @@
+package alerting
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"sync"
+	"time"
+
+	"github.com/rs/zerolog/log"
+
+	"github.com/sananguliyev/airtruct/internal/config"
+	"github.com/sananguliyev/airtruct/internal/metrics"
+	"github.com/sananguliyev/airtruct/internal/persistence"
+)
+
+// notifyTimeout bounds the time the notifications of an alert take to be sent to all of its channels.
+const notifyTimeout = 10 * time.Second
+
+// Event is something that happened on the coordinator, the enabled rules of its type fire an alert about it.
+type Event struct {
+	Type persistence.AlertRuleType
+	// FlowID is the version of the flow the event is about, if any.
+	FlowID   int64
+	WorkerID string
+}
+
+type alertKey struct {
+	ruleID  int64
+	subject string
+}
+
+// Manager fires alerts on events and evaluates the windowed rules, resolves the alerts once their condition
+// cleared and notifies the channels of the rules unless an alert is silenced.
+type Manager struct {
+	ruleRepo       persistence.AlertRuleRepository
+	channelRepo    persistence.AlertChannelRepository
+	silenceRepo    persistence.AlertSilenceRepository
+	alertRepo      persistence.AlertRepository
+	flowRepo       persistence.FlowRepository
+	workerRepo     persistence.WorkerRepository
+	workerFlowRepo persistence.WorkerFlowRepository
+	flowMetricRepo persistence.FlowMetricRepository
+	notifiers      map[persistence.AlertChannelType]Notifier
+
+	// mu serializes firing and resolving, so a rule never has two firing alerts about the same subject.
+	mu sync.Mutex
+}
+
+func NewManager(
+	ruleRepo persistence.AlertRuleRepository,
+	channelRepo persistence.AlertChannelRepository,
+	silenceRepo persistence.AlertSilenceRepository,
+	alertRepo persistence.AlertRepository,
+	flowRepo persistence.FlowRepository,
+	workerRepo persistence.WorkerRepository,
+	workerFlowRepo persistence.WorkerFlowRepository,
+	flowMetricRepo persistence.FlowMetricRepository,
+	alertingConfig *config.AlertingConfig,
+) *Manager {
+	client := &http.Client{Timeout: notifyTimeout}
+
+	return &Manager{
+		ruleRepo:       ruleRepo,
+		channelRepo:    channelRepo,
+		silenceRepo:    silenceRepo,
+		alertRepo:      alertRepo,
+		flowRepo:       flowRepo,
+		workerRepo:     workerRepo,
+		workerFlowRepo: workerFlowRepo,
+		flowMetricRepo: flowMetricRepo,
+		notifiers: map[persistence.AlertChannelType]Notifier{
+			persistence.AlertChannelTypeWebhook: &webhookNotifier{client: client},
+			persistence.AlertChannelTypeSlack:   &slackNotifier{client: client},
+			persistence.AlertChannelTypeEmail:   &emailNotifier{config: alertingConfig},
+		},
+	}
+}
+
+// Trigger fires an alert for every enabled rule of the event type that watches its flow, unless the rule already
+// has a firing alert about the same subject.
+func (m *Manager) Trigger(event Event) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	rules, err := m.ruleRepo.ListEnabledByType(event.Type)
+	if err != nil {
+		log.Error().Err(err).Str("type", string(event.Type)).Msg("Failed to list alert rules")
+		return
+	} else if len(rules) == 0 {
+		return
+	}
+
+	var flow *persistence.Flow
+	if event.FlowID != 0 {
+		flow, err = m.flowRepo.FindByID(event.FlowID)
+		if err != nil {
+			log.Error().Err(err).Int64("flow_id", event.FlowID).Msg("Failed to find flow of alert")
+			return
+		} else if flow == nil {
+			return
+		}
+	}
+
+	alert := persistence.Alert{
+		Type:     event.Type,
+		Subject:  "worker:" + event.WorkerID,
+		WorkerID: event.WorkerID,
+	}
+	switch event.Type {
+	case persistence.AlertRuleTypeFlowFailed:
+		alert.Message = fmt.Sprintf("Flow %q failed on worker %s", flow.Name, event.WorkerID)
+	case persistence.AlertRuleTypeLeaseExpired:
+		alert.Message = fmt.Sprintf("Lease of flow %q on worker %s expired", flow.Name, event.WorkerID)
+	case persistence.AlertRuleTypeWorkerLost:
+		alert.Message = fmt.Sprintf("Worker %s stopped sending heartbeats", event.WorkerID)
+	}
+	if flow != nil {
+		alert.FlowID = rootFlowID(flow)
+		alert.Subject = flowSubject(alert.FlowID)
+	}
+
+	for i := range rules {
+		if rules[i].FlowID != 0 && rules[i].FlowID != alert.FlowID {
+			continue
+		}
+		m.fire(&rules[i], alert)
+	}
+}
+
+// Evaluate checks the windowed rules against the metrics of the active flows and resolves the firing alerts whose
+// condition cleared.
+func (m *Manager) Evaluate(_ context.Context) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	rules, err := m.ruleRepo.ListEnabled()
+	if err != nil {
+		return fmt.Errorf("list alert rules: %w", err)
+	}
+	firing, err := m.alertRepo.ListFiring()
+	if err != nil {
+		return fmt.Errorf("list firing alerts: %w", err)
+	}
+
+	rulesByID := make(map[int64]*persistence.AlertRule, len(rules))
+	for i := range rules {
+		rulesByID[rules[i].ID] = &rules[i]
+	}
+	firingByKey := make(map[alertKey]*persistence.Alert, len(firing))
+	for i := range firing {
+		firingByKey[alertKey{firing[i].RuleID, firing[i].Subject}] = &firing[i]
+	}
+
+	evaluated, err := m.evaluateWindows(rules, firingByKey)
+	if err != nil {
+		return err
+	}
+
+	var runningFlowIDs map[int64]bool
+	for i := range firing {
+		alert := &firing[i]
+		key := alertKey{alert.RuleID, alert.Subject}
+
+		rule, ok := rulesByID[alert.RuleID]
+		if !ok {
+			// The rule was disabled or deleted, there is nobody left to tell.
+			m.resolve(alert, nil)
+			continue
+		}
+
+		switch rule.Type {
+		case persistence.AlertRuleTypeErrorRate, persistence.AlertRuleTypeNoInput:
+			if _, ok := evaluated[key]; !ok {
+				// The flow is no longer active or no longer watched by the rule.
+				m.resolve(alert, rule)
+			}
+		case persistence.AlertRuleTypeFlowFailed, persistence.AlertRuleTypeLeaseExpired:
+			if runningFlowIDs == nil {
+				if runningFlowIDs, err = m.runningFlowIDs(); err != nil {
+					return err
+				}
+			}
+			recovered, err := m.flowRecovered(alert.FlowID, runningFlowIDs)
+			if err != nil {
+				return err
+			} else if recovered {
+				m.resolve(alert, rule)
+			}
+		case persistence.AlertRuleTypeWorkerLost:
+			worker, err := m.workerRepo.FindByID(alert.WorkerID)
+			if err != nil {
+				return fmt.Errorf("find worker: %w", err)
+			}
+			if worker.Status == persistence.WorkerStatusActive {
+				m.resolve(alert, rule)
+			}
+		}
+	}
+
+	return nil
+}
+
+// evaluateWindows fires and resolves the alerts of the windowed rules and returns the keys it evaluated.
+func (m *Manager) evaluateWindows(rules []persistence.AlertRule, firingByKey map[alertKey]*persistence.Alert) (map[alertKey]struct{}, error) {
+	evaluated := make(map[alertKey]struct{})
+
+	var flows []persistence.Flow
+	var versionIDs map[int64][]int64
+	for i := range rules {
+		rule := &rules[i]
+		if !rule.Type.Windowed() {
+			continue
+		}
+
+		if flows == nil {
+			var err error
+			if flows, err = m.flowRepo.ListAllByStatuses(persistence.FlowStatusActive); err != nil {
+				return nil, fmt.Errorf("list active flows: %w", err)
+			}
+			versionIDs = make(map[int64][]int64, len(flows))
+		}
+
+		for j := range flows {
+			flow := &flows[j]
+			rootID := rootFlowID(flow)
+			if rule.FlowID != 0 && rule.FlowID != rootID {
+				continue
+			}
+
+			if _, ok := versionIDs[rootID]; !ok {
+				versions, err := m.flowRepo.ListAllVersionsByParentID(rootID)
+				if err != nil {
+					return nil, fmt.Errorf("list flow versions: %w", err)
+				}
+				ids := make([]int64, 0, len(versions))
+				for _, version := range versions {
+					ids = append(ids, version.ID)
+				}
+				versionIDs[rootID] = ids
+			}
+
+			message, err := m.checkWindow(rule, flow, versionIDs[rootID])
+			if err != nil {
+				return nil, err
+			}
+
+			key := alertKey{rule.ID, flowSubject(rootID)}
+			evaluated[key] = struct{}{}
+			if message != "" {
+				if _, ok := firingByKey[key]; !ok {
+					m.fire(rule, persistence.Alert{
+						Type:    rule.Type,
+						Subject: key.subject,
+						FlowID:  rootID,
+						Message: message,
+					})
+				}
+			} else if alert, ok := firingByKey[key]; ok {
+				m.resolve(alert, rule)
+			}
+		}
+	}
+
+	return evaluated, nil
+}
+
+// checkWindow returns the message of the alert the windowed rule fires about the flow, or an empty string when
+// the flow is fine.
+func (m *Manager) checkWindow(rule *persistence.AlertRule, flow *persistence.Flow, versionIDs []int64) (string, error) {
+	now := time.Now()
+	if rule.Type == persistence.AlertRuleTypeNoInput && now.Sub(flow.CreatedAt) < rule.Window() {
+		// The flow has not been around for the whole window yet.
+		return "", nil
+	}
+
+	buckets, err := m.flowMetricRepo.List(versionIDs, persistence.FlowMetricResolutionMinute, now.Add(-rule.Window()))
+	if err != nil {
+		return "", fmt.Errorf("list flow metrics: %w", err)
+	}
+
+	var input, processorErrors int64
+	for _, bucket := range buckets {
+		input += bucket.InputEvents
+		processorErrors += bucket.ProcessorErrors
+	}
+
+	switch rule.Type {
+	case persistence.AlertRuleTypeErrorRate:
+		if input == 0 {
+			return "", nil
+		}
+		if rate := float64(processorErrors) / float64(input); rate > rule.Threshold {
+			return fmt.Sprintf(
+				"Error rate of flow %q is %.1f%% over the last %d minutes, above %.1f%%",
+				flow.Name, rate*100, rule.WindowMinutes, rule.Threshold*100,
+			), nil
+		}
+	case persistence.AlertRuleTypeNoInput:
+		if input == 0 {
+			return fmt.Sprintf("Flow %q read no input in the last %d minutes", flow.Name, rule.WindowMinutes), nil
+		}
+	}
+	return "", nil
+}
+
+func (m *Manager) runningFlowIDs() (map[int64]bool, error) {
+	workerFlows, err := m.workerFlowRepo.ListAllByStatuses(persistence.WorkerFlowStatusRunning)
+	if err != nil {
+		return nil, fmt.Errorf("list running worker flows: %w", err)
+	}
+
+	ids := make(map[int64]bool, len(workerFlows))
+	for _, workerFlow := range workerFlows {
+		ids[workerFlow.FlowID] = true
+	}
+	return ids, nil
+}
+
+// flowRecovered tells whether a version of the flow runs again, or the flow is no longer meant to run.
+func (m *Manager) flowRecovered(rootID int64, runningFlowIDs map[int64]bool) (bool, error) {
+	versions, err := m.flowRepo.ListAllVersionsByParentID(rootID)
+	if err != nil {
+		return false, fmt.Errorf("list flow versions: %w", err)
+	}
+
+	active := false
+	for _, version := range versions {
+		if runningFlowIDs[version.ID] {
+			return true, nil
+		}
+		if version.IsCurrent && version.Status == persistence.FlowStatusActive {
+			active = true
+		}
+	}
+	return !active, nil
+}
+
+func (m *Manager) fire(rule *persistence.AlertRule, alert persistence.Alert) {
+	existing, err := m.alertRepo.FindFiring(rule.ID, alert.Subject)
+	if err != nil {
+		log.Error().Err(err).Int64("rule_id", rule.ID).Msg("Failed to find firing alert")
+		return
+	} else if existing != nil {
+		return
+	}
+
+	alert.RuleID = rule.ID
+	alert.RuleName = rule.Name
+	alert.Silenced = m.silenced(&alert, time.Now())
+	if err = m.alertRepo.Create(&alert); err != nil {
+		log.Error().Err(err).Int64("rule_id", rule.ID).Msg("Failed to store alert")
+		return
+	}
+	metrics.AlertsFired.WithLabelValues(string(alert.Type)).Inc()
+
+	log.Warn().
+		Int64("alert_id", alert.ID).
+		Int64("rule_id", rule.ID).
+		Str("subject", alert.Subject).
+		Bool("silenced", alert.Silenced).
+		Msg(alert.Message)
+
+	if !alert.Silenced {
+		m.notify(rule, alert)
+	}
+}
+
+// resolve resolves the alert and notifies the channels of its rule, no one is notified when rule is nil.
+func (m *Manager) resolve(alert *persistence.Alert, rule *persistence.AlertRule) {
+	now := time.Now()
+	if err := m.alertRepo.Resolve(alert.ID, now); err != nil {
+		log.Error().Err(err).Int64("alert_id", alert.ID).Msg("Failed to resolve alert")
+		return
+	}
+	alert.Status = persistence.AlertStatusResolved
+	alert.ResolvedAt = &now
+
+	log.Info().
+		Int64("alert_id", alert.ID).
+		Int64("rule_id", alert.RuleID).
+		Str("subject", alert.Subject).
+		Msg("Alert resolved")
+
+	if rule != nil && !alert.Silenced && !m.silenced(alert, now) {
+		m.notify(rule, *alert)
+	}
+}
+
+func (m *Manager) silenced(alert *persistence.Alert, at time.Time) bool {
+	silences, err := m.silenceRepo.ListActive(at)
+	if err != nil {
+		log.Error().Err(err).Msg("Failed to list alert silences")
+		return false
+	}
+
+	for i := range silences {
+		if silences[i].Matches(alert, at) {
+			return true
+		}
+	}
+	return false
+}
+
+// notify sends the alert to the channels of the rule in the background.
+func (m *Manager) notify(rule *persistence.AlertRule, alert persistence.Alert) {
+	channels, err := m.channelRepo.FindByIDs(rule.GetChannelIDs())
+	if err != nil {
+		log.Error().Err(err).Int64("rule_id", rule.ID).Msg("Failed to find alert channels")
+		return
+	} else if len(channels) == 0 {
+		return
+	}
+
+	go func() {
+		ctx, cancel := context.WithTimeout(context.Background(), notifyTimeout)
+		defer cancel()
+
+		for i := range channels {
+			if err := m.send(ctx, &channels[i], &alert); err != nil {
+				log.Error().
+					Err(err).
+					Int64("alert_id", alert.ID).
+					Int64("channel_id", channels[i].ID).
+					Msg("Failed to send alert notification")
+			}
+		}
+	}()
+}
+
+// TestChannel sends a test notification to the channel and returns the error of the channel, if any.
+func (m *Manager) TestChannel(ctx context.Context, channel *persistence.AlertChannel) error {
+	ctx, cancel := context.WithTimeout(ctx, notifyTimeout)
+	defer cancel()
+
+	return m.send(ctx, channel, &persistence.Alert{
+		RuleName:  "Test",
+		Status:    persistence.AlertStatusFiring,
+		Message:   fmt.Sprintf("Test notification of alert channel %q", channel.Name),
+		StartedAt: time.Now(),
+	})
+}
+
+func (m *Manager) send(ctx context.Context, channel *persistence.AlertChannel, alert *persistence.Alert) error {
+	notifier, ok := m.notifiers[channel.Type]
+	if !ok {
+		return fmt.Errorf("unknown alert channel type %q", channel.Type)
+	}
+
+	err := notifier.Notify(ctx, channel, alert)
+	metrics.AlertNotifications.WithLabelValues(string(channel.Type), metrics.Result(err)).Inc()
+	return err
+}
+
+// rootFlowID returns the first version of the flow, which alerts and rules refer to.
+func rootFlowID(flow *persistence.Flow) int64 {
+	if flow.ParentID != nil {
+		return *flow.ParentID
+	}
+	return flow.ID
+}
+
+func flowSubject(flowID int64) string {
+	return fmt.Sprintf("flow:%d", flowID)
+}
@@ -0,0 +1,283 @@
+package alerting
+
+import (
+	"database/sql"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"gorm.io/driver/sqlite"
+	"gorm.io/gorm"
+	_ "modernc.org/sqlite"
+
+	"github.com/sananguliyev/airtruct/internal/config"
+	"github.com/sananguliyev/airtruct/internal/persistence"
+)
+
+type testEnv struct {
+	db       *gorm.DB
+	manager  *Manager
+	channel  *persistence.AlertChannel
+	received chan persistence.Alert
+}
+
+func setupTestEnv(t *testing.T) *testEnv {
+	sqlDB, err := sql.Open("sqlite", ":memory:")
+	if err != nil {
+		t.Fatalf("failed to open sqlite connection: %v", err)
+	}
+	// Every connection opens its own in-memory database.
+	sqlDB.SetMaxOpenConns(1)
+	db, err := gorm.Open(sqlite.New(sqlite.Config{Conn: sqlDB}), &gorm.Config{})
+	if err != nil {
+		t.Fatalf("failed to open test database: %v", err)
+	}
+
+	err = db.AutoMigrate(
+		&persistence.Buffer{}, &persistence.Flow{}, &persistence.FlowProcessor{}, &persistence.FlowCache{},
+		&persistence.Worker{}, &persistence.WorkerFlow{}, &persistence.FlowMetric{},
+		&persistence.AlertRule{}, &persistence.AlertChannel{}, &persistence.AlertSilence{}, &persistence.Alert{},
+	)
+	if err != nil {
+		t.Fatalf("failed to migrate test database: %v", err)
+	}
+
+	env := &testEnv{db: db, received: make(chan persistence.Alert, 16)}
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var alert persistence.Alert
+		if err := json.NewDecoder(r.Body).Decode(&alert); err != nil {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+		env.received <- alert
+	}))
+	t.Cleanup(server.Close)
+
+	channelRepo := persistence.NewAlertChannelRepository(db)
+	env.channel = &persistence.AlertChannel{Name: "hook", Type: persistence.AlertChannelTypeWebhook, URL: server.URL}
+	if err := channelRepo.Create(env.channel); err != nil {
+		t.Fatalf("failed to create channel: %v", err)
+	}
+
+	env.manager = NewManager(
+		persistence.NewAlertRuleRepository(db),
+		channelRepo,
+		persistence.NewAlertSilenceRepository(db),
+		persistence.NewAlertRepository(db),
+		persistence.NewFlowRepository(db),
+		persistence.NewWorkerRepository(db),
+		persistence.NewWorkerFlowRepository(db),
+		persistence.NewFlowMetricRepository(db),
+		&config.AlertingConfig{},
+	)
+	return env
+}
+
+func (e *testEnv) createRule(t *testing.T, rule *persistence.AlertRule) {
+	rule.Name = string(rule.Type)
+	rule.Enabled = true
+	rule.SetChannelIDs([]int64{e.channel.ID})
+	if err := persistence.NewAlertRuleRepository(e.db).Create(rule); err != nil {
+		t.Fatalf("failed to create rule: %v", err)
+	}
+}
+
+func (e *testEnv) createFlow(t *testing.T, createdAt time.Time) *persistence.Flow {
+	flow := &persistence.Flow{
+		Name:            "orders",
+		InputComponent:  "generate",
+		InputConfig:     []byte("{}"),
+		OutputComponent: "drop",
+		OutputConfig:    []byte("{}"),
+		IsCurrent:       true,
+		Status:          persistence.FlowStatusActive,
+	}
+	if err := persistence.NewFlowRepository(e.db).Create(flow); err != nil {
+		t.Fatalf("failed to create flow: %v", err)
+	}
+	e.db.Model(flow).Update("created_at", createdAt)
+	flow.CreatedAt = createdAt
+	return flow
+}
+
+func (e *testEnv) alerts(t *testing.T) []persistence.Alert {
+	alerts, _, err := persistence.NewAlertRepository(e.db).List(persistence.AlertFilter{Limit: 100})
+	if err != nil {
+		t.Fatalf("failed to list alerts: %v", err)
+	}
+	return alerts
+}
+
+func (e *testEnv) expectNotification(t *testing.T, status persistence.AlertStatus) persistence.Alert {
+	select {
+	case alert := <-e.received:
+		if alert.Status != status {
+			t.Fatalf("expected %s notification, got %s", status, alert.Status)
+		}
+		return alert
+	case <-time.After(5 * time.Second):
+		t.Fatalf("expected %s notification", status)
+		return persistence.Alert{}
+	}
+}
+
+func (e *testEnv) expectNoNotification(t *testing.T) {
+	select {
+	case alert := <-e.received:
+		t.Fatalf("unexpected %s notification: %s", alert.Status, alert.Message)
+	case <-time.After(200 * time.Millisecond):
+	}
+}
+
+func TestTriggerFiresOnceAndResolvesWhenFlowRuns(t *testing.T) {
+	env := setupTestEnv(t)
+	flow := env.createFlow(t, time.Now())
+	env.createRule(t, &persistence.AlertRule{Type: persistence.AlertRuleTypeFlowFailed})
+
+	event := Event{Type: persistence.AlertRuleTypeFlowFailed, FlowID: flow.ID, WorkerID: "worker-1"}
+	env.manager.Trigger(event)
+	env.manager.Trigger(event)
+
+	notified := env.expectNotification(t, persistence.AlertStatusFiring)
+	if notified.FlowID != flow.ID || notified.WorkerID != "worker-1" {
+		t.Errorf("unexpected alert subject: flow %d, worker %s", notified.FlowID, notified.WorkerID)
+	}
+	env.expectNoNotification(t)
+	if alerts := env.alerts(t); len(alerts) != 1 {
+		t.Fatalf("expected 1 alert, got %d", len(alerts))
+	}
+
+	workerFlowRepo := persistence.NewWorkerFlowRepository(env.db)
+	workerFlow, err := workerFlowRepo.Queue("worker-1", flow.ID)
+	if err != nil {
+		t.Fatalf("failed to queue worker flow: %v", err)
+	}
+	if err = workerFlowRepo.UpdateStatus(workerFlow.ID, persistence.WorkerFlowStatusRunning); err != nil {
+		t.Fatalf("failed to update worker flow: %v", err)
+	}
+
+	if err = env.manager.Evaluate(t.Context()); err != nil {
+		t.Fatalf("failed to evaluate: %v", err)
+	}
+	env.expectNotification(t, persistence.AlertStatusResolved)
+	if alerts := env.alerts(t); alerts[0].Status != persistence.AlertStatusResolved || alerts[0].ResolvedAt == nil {
+		t.Errorf("expected alert to be resolved, got %s", alerts[0].Status)
+	}
+}
+
+func TestTriggerSkipsRulesOfOtherFlows(t *testing.T) {
+	env := setupTestEnv(t)
+	flow := env.createFlow(t, time.Now())
+	other := env.createFlow(t, time.Now())
+	env.createRule(t, &persistence.AlertRule{Type: persistence.AlertRuleTypeLeaseExpired, FlowID: other.ID})
+
+	env.manager.Trigger(Event{Type: persistence.AlertRuleTypeLeaseExpired, FlowID: flow.ID, WorkerID: "worker-1"})
+
+	env.expectNoNotification(t)
+	if alerts := env.alerts(t); len(alerts) != 0 {
+		t.Fatalf("expected no alerts, got %d", len(alerts))
+	}
+}
+
+func TestSilencedAlertIsNotNotified(t *testing.T) {
+	env := setupTestEnv(t)
+	env.createRule(t, &persistence.AlertRule{Type: persistence.AlertRuleTypeWorkerLost})
+
+	err := persistence.NewAlertSilenceRepository(env.db).Create(&persistence.AlertSilence{
+		WorkerID: "worker-1",
+		StartsAt: time.Now().Add(-time.Minute),
+		EndsAt:   time.Now().Add(time.Hour),
+	})
+	if err != nil {
+		t.Fatalf("failed to create silence: %v", err)
+	}
+
+	env.manager.Trigger(Event{Type: persistence.AlertRuleTypeWorkerLost, WorkerID: "worker-1"})
+	env.manager.Trigger(Event{Type: persistence.AlertRuleTypeWorkerLost, WorkerID: "worker-2"})
+
+	notified := env.expectNotification(t, persistence.AlertStatusFiring)
+	if notified.WorkerID != "worker-2" {
+		t.Errorf("expected notification about worker-2, got %s", notified.WorkerID)
+	}
+	env.expectNoNotification(t)
+
+	alerts := env.alerts(t)
+	if len(alerts) != 2 {
+		t.Fatalf("expected 2 alerts, got %d", len(alerts))
+	}
+	for _, alert := range alerts {
+		if alert.Silenced != (alert.WorkerID == "worker-1") {
+			t.Errorf("unexpected silenced %t of alert about %s", alert.Silenced, alert.WorkerID)
+		}
+	}
+}
+
+func TestEvaluateErrorRate(t *testing.T) {
+	env := setupTestEnv(t)
+	flow := env.createFlow(t, time.Now().Add(-time.Hour))
+	env.createRule(t, &persistence.AlertRule{Type: persistence.AlertRuleTypeErrorRate, Threshold: 0.1, WindowMinutes: 5})
+
+	metric := &persistence.FlowMetric{
+		FlowID:          flow.ID,
+		Resolution:      persistence.FlowMetricResolutionMinute,
+		Bucket:          time.Now().UTC().Truncate(time.Minute),
+		InputEvents:     100,
+		ProcessorErrors: 20,
+	}
+	if err := env.db.Create(metric).Error; err != nil {
+		t.Fatalf("failed to create metric: %v", err)
+	}
+
+	if err := env.manager.Evaluate(t.Context()); err != nil {
+		t.Fatalf("failed to evaluate: %v", err)
+	}
+	env.expectNotification(t, persistence.AlertStatusFiring)
+
+	if err := env.db.Model(metric).Update("processor_errors", 5).Error; err != nil {
+		t.Fatalf("failed to update metric: %v", err)
+	}
+	if err := env.manager.Evaluate(t.Context()); err != nil {
+		t.Fatalf("failed to evaluate: %v", err)
+	}
+	env.expectNotification(t, persistence.AlertStatusResolved)
+}
+
+func TestEvaluateNoInput(t *testing.T) {
+	env := setupTestEnv(t)
+	env.createFlow(t, time.Now().Add(-time.Hour))
+	env.createFlow(t, time.Now())
+	env.createRule(t, &persistence.AlertRule{Type: persistence.AlertRuleTypeNoInput, WindowMinutes: 10})
+
+	if err := env.manager.Evaluate(t.Context()); err != nil {
+		t.Fatalf("failed to evaluate: %v", err)
+	}
+
+	// The new flow has not been around for the whole window yet.
+	env.expectNotification(t, persistence.AlertStatusFiring)
+	env.expectNoNotification(t)
+}
+
+func TestEvaluateResolvesAlertsOfDisabledRulesQuietly(t *testing.T) {
+	env := setupTestEnv(t)
+	rule := &persistence.AlertRule{Type: persistence.AlertRuleTypeWorkerLost}
+	env.createRule(t, rule)
+
+	env.manager.Trigger(Event{Type: persistence.AlertRuleTypeWorkerLost, WorkerID: "worker-1"})
+	env.expectNotification(t, persistence.AlertStatusFiring)
+
+	rule.Enabled = false
+	if err := persistence.NewAlertRuleRepository(env.db).Update(rule); err != nil {
+		t.Fatalf("failed to update rule: %v", err)
+	}
+	if err := env.manager.Evaluate(t.Context()); err != nil {
+		t.Fatalf("failed to evaluate: %v", err)
+	}
+
+	env.expectNoNotification(t)
+	if alerts := env.alerts(t); alerts[0].Status != persistence.AlertStatusResolved {
+		t.Errorf("expected alert to be resolved, got %s", alerts[0].Status)
+	}
+}
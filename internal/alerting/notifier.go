@@ -0,0 +1,175 @@
+package alerting
+
+import (
+	"bytes"
+	"context"
+	"crypto/tls"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"mime"
+	"net"
+	"net/http"
+	"net/smtp"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/sananguliyev/airtruct/internal/config"
+	"github.com/sananguliyev/airtruct/internal/persistence"
+)
+
+// Notifier sends the notification of an alert, firing or resolved, to a channel.
+type Notifier interface {
+	Notify(ctx context.Context, channel *persistence.AlertChannel, alert *persistence.Alert) error
+}
+
+// webhookNotifier posts the alert as JSON to the URL of the channel.
+type webhookNotifier struct {
+	client *http.Client
+}
+
+func (n *webhookNotifier) Notify(ctx context.Context, channel *persistence.AlertChannel, alert *persistence.Alert) error {
+	body, err := json.Marshal(alert)
+	if err != nil {
+		return err
+	}
+	return post(ctx, n.client, channel.URL, body)
+}
+
+// slackNotifier posts a message to a Slack incoming webhook, or any webhook that takes a payload with text.
+type slackNotifier struct {
+	client *http.Client
+}
+
+func (n *slackNotifier) Notify(ctx context.Context, channel *persistence.AlertChannel, alert *persistence.Alert) error {
+	body, err := json.Marshal(map[string]string{"text": summary(alert)})
+	if err != nil {
+		return err
+	}
+	return post(ctx, n.client, channel.URL, body)
+}
+
+func post(ctx context.Context, client *http.Client, url string, body []byte) error {
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, url, bytes.NewReader(body))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/json")
+
+	resp, err := client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode < 200 || resp.StatusCode > 299 {
+		return fmt.Errorf("webhook responded with status %d", resp.StatusCode)
+	}
+	return nil
+}
+
+// emailNotifier sends the alert by email to the recipients of the channel through the configured SMTP server.
+type emailNotifier struct {
+	config *config.AlertingConfig
+}
+
+func (n *emailNotifier) Notify(ctx context.Context, channel *persistence.AlertChannel, alert *persistence.Alert) error {
+	if n.config.SMTPHost == "" {
+		return errors.New("SMTP server is not configured")
+	}
+
+	recipients := channel.GetRecipients()
+	if len(recipients) == 0 {
+		return errors.New("channel has no recipients")
+	}
+
+	var auth smtp.Auth
+	if n.config.SMTPUsername != "" {
+		auth = smtp.PlainAuth("", n.config.SMTPUsername, n.config.SMTPPassword, n.config.SMTPHost)
+	}
+
+	var msg bytes.Buffer
+	fmt.Fprintf(&msg, "From: %s\r\n", n.config.SMTPFrom)
+	fmt.Fprintf(&msg, "To: %s\r\n", strings.Join(recipients, ", "))
+	fmt.Fprintf(&msg, "Subject: %s\r\n", mime.QEncoding.Encode("utf-8", summary(alert)))
+	fmt.Fprintf(&msg, "Date: %s\r\n", time.Now().Format(time.RFC1123Z))
+	msg.WriteString("MIME-Version: 1.0\r\n")
+	msg.WriteString("Content-Type: text/plain; charset=UTF-8\r\n\r\n")
+	fmt.Fprintf(&msg, "%s\r\n\r\n", alert.Message)
+	fmt.Fprintf(&msg, "Rule: %s\r\n", alert.RuleName)
+	fmt.Fprintf(&msg, "Status: %s\r\n", alert.Status)
+	fmt.Fprintf(&msg, "Started at: %s\r\n", alert.StartedAt.Format(time.RFC3339))
+	if alert.ResolvedAt != nil {
+		fmt.Fprintf(&msg, "Resolved at: %s\r\n", alert.ResolvedAt.Format(time.RFC3339))
+	}
+
+	addr := net.JoinHostPort(n.config.SMTPHost, strconv.Itoa(n.config.SMTPPort))
+	return sendMail(ctx, addr, n.config.SMTPHost, auth, n.config.SMTPFrom, recipients, msg.Bytes())
+}
+
+// sendMail sends the message like smtp.SendMail, but gives up on the server once ctx is done.
+func sendMail(ctx context.Context, addr, host string, auth smtp.Auth, from string, recipients []string, msg []byte) error {
+	var dialer net.Dialer
+	conn, err := dialer.DialContext(ctx, "tcp", addr)
+	if err != nil {
+		return err
+	}
+	if deadline, ok := ctx.Deadline(); ok {
+		if err := conn.SetDeadline(deadline); err != nil {
+			conn.Close()
+			return err
+		}
+	}
+	// A cancelled ctx interrupts the exchange the same way a passed deadline does.
+	stop := context.AfterFunc(ctx, func() {
+		_ = conn.SetDeadline(time.Now())
+	})
+	defer stop()
+
+	client, err := smtp.NewClient(conn, host)
+	if err != nil {
+		conn.Close()
+		return err
+	}
+	defer client.Close()
+
+	if ok, _ := client.Extension("STARTTLS"); ok {
+		if err := client.StartTLS(&tls.Config{ServerName: host}); err != nil {
+			return err
+		}
+	}
+	if auth != nil {
+		if ok, _ := client.Extension("AUTH"); !ok {
+			return errors.New("SMTP server does not support authentication")
+		}
+		if err := client.Auth(auth); err != nil {
+			return err
+		}
+	}
+
+	if err := client.Mail(from); err != nil {
+		return err
+	}
+	for _, recipient := range recipients {
+		if err := client.Rcpt(recipient); err != nil {
+			return err
+		}
+	}
+	w, err := client.Data()
+	if err != nil {
+		return err
+	}
+	if _, err := w.Write(msg); err != nil {
+		return err
+	}
+	if err := w.Close(); err != nil {
+		return err
+	}
+	return client.Quit()
+}
+
+// summary returns the one line description of the alert, e.g. "[FIRING] Orders failing: Flow "orders" failed".
+func summary(alert *persistence.Alert) string {
+	return fmt.Sprintf("[%s] %s: %s", strings.ToUpper(string(alert.Status)), alert.RuleName, alert.Message)
+}
@@ -0,0 +1,129 @@
+package alerting
+
+import (
+	"bufio"
+	"context"
+	"net"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/sananguliyev/airtruct/internal/config"
+	"github.com/sananguliyev/airtruct/internal/persistence"
+)
+
+// startTestSMTPServer accepts connections on a local port and hands each to serve.
+func startTestSMTPServer(t *testing.T, serve func(conn net.Conn)) (string, int) {
+	t.Helper()
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatalf("failed to listen: %v", err)
+	}
+	t.Cleanup(func() { listener.Close() })
+
+	go func() {
+		for {
+			conn, err := listener.Accept()
+			if err != nil {
+				return
+			}
+			go func() {
+				defer conn.Close()
+				serve(conn)
+			}()
+		}
+	}()
+
+	addr := listener.Addr().(*net.TCPAddr)
+	return addr.IP.String(), addr.Port
+}
+
+func newTestEmailNotifier(host string, port int) (*emailNotifier, *persistence.AlertChannel) {
+	notifier := &emailNotifier{config: &config.AlertingConfig{SMTPHost: host, SMTPPort: port, SMTPFrom: "alerts@example.com"}}
+	channel := &persistence.AlertChannel{Name: "mail", Type: persistence.AlertChannelTypeEmail}
+	channel.SetRecipients([]string{"ops@example.com", "dev@example.com"})
+	return notifier, channel
+}
+
+func newTestAlert() *persistence.Alert {
+	return &persistence.Alert{RuleName: "Orders failing", Status: persistence.AlertStatusFiring, Message: "Flow failed", StartedAt: time.Now()}
+}
+
+func TestEmailNotifierSendsMail(t *testing.T) {
+	commands := make(chan string, 16)
+	host, port := startTestSMTPServer(t, func(conn net.Conn) {
+		reader := bufio.NewReader(conn)
+		reply := func(line string) { conn.Write([]byte(line + "\r\n")) }
+
+		reply("220 localhost ESMTP")
+		for {
+			line, err := reader.ReadString('\n')
+			if err != nil {
+				return
+			}
+			command := strings.TrimSpace(line)
+			commands <- command
+			switch {
+			case strings.HasPrefix(command, "EHLO"):
+				reply("250 localhost")
+			case command == "DATA":
+				reply("354 go ahead")
+				for {
+					line, err := reader.ReadString('\n')
+					if err != nil || line == ".\r\n" {
+						break
+					}
+				}
+				reply("250 queued")
+			case command == "QUIT":
+				reply("221 bye")
+				return
+			default:
+				reply("250 ok")
+			}
+		}
+	})
+
+	notifier, channel := newTestEmailNotifier(host, port)
+	if err := notifier.Notify(t.Context(), channel, newTestAlert()); err != nil {
+		t.Fatalf("Notify returned error: %v", err)
+	}
+
+	want := []string{
+		"MAIL FROM:<alerts@example.com>",
+		"RCPT TO:<ops@example.com>",
+		"RCPT TO:<dev@example.com>",
+		"DATA",
+		"QUIT",
+	}
+	var got []string
+	for len(commands) > 0 {
+		command := <-commands
+		if strings.HasPrefix(command, "EHLO") {
+			continue
+		}
+		got = append(got, command)
+	}
+	if strings.Join(got, "\n") != strings.Join(want, "\n") {
+		t.Errorf("expected commands %q, got %q", want, got)
+	}
+}
+
+func TestEmailNotifierGivesUpWhenContextIsDone(t *testing.T) {
+	// The server accepts the connection but never greets.
+	host, port := startTestSMTPServer(t, func(conn net.Conn) {
+		conn.Read(make([]byte, 1))
+	})
+
+	notifier, channel := newTestEmailNotifier(host, port)
+	ctx, cancel := context.WithTimeout(t.Context(), 100*time.Millisecond)
+	defer cancel()
+
+	started := time.Now()
+	if err := notifier.Notify(ctx, channel, newTestAlert()); err == nil {
+		t.Fatal("expected Notify to fail when the server does not respond")
+	}
+	if elapsed := time.Since(started); elapsed > 2*time.Second {
+		t.Errorf("expected Notify to give up at the deadline, it took %v", elapsed)
+	}
+}
@@ -16,16 +16,17 @@ func NewLocalProvider(db *gorm.DB) Provider {
 	return &LocalProvider{db: db}
 }
 
-func (p *LocalProvider) GetAnalytics() (*Result, error) {
+func (p *LocalProvider) GetAnalytics(query Query) (*Result, error) {
 	result := &Result{}
+	since := time.Now().UTC().Add(-query.Range.Duration())
 
 	g := new(errgroup.Group)
-	g.Go(func() error { return p.loadFlowStats(result) })
-	g.Go(func() error { return p.loadWorkerFlowMetrics(result) })
+	g.Go(func() error { return p.loadFlowStats(query, result) })
+	g.Go(func() error { return p.loadFlowMetrics(query, since, result) })
 	g.Go(func() error { return p.loadActiveWorkers(result) })
-	g.Go(func() error { return p.loadEventStats(result) })
-	g.Go(func() error { return p.loadEventsOverTime(result) })
-	g.Go(func() error { return p.loadTopComponents(result) })
+	g.Go(func() error { return p.loadEventStats(query, since, result) })
+	g.Go(func() error { return p.loadTopComponents(query, result) })
+	g.Go(func() error { return p.loadToolCallStats(query, since, result) })
 
 	if err := g.Wait(); err != nil {
 		return nil, err
@@ -33,15 +34,23 @@ func (p *LocalProvider) GetAnalytics() (*Result, error) {
 	return result, nil
 }
 
-func (p *LocalProvider) loadFlowStats(result *Result) error {
+// currentFlows selects the current versions of the queried flows.
+func (p *LocalProvider) currentFlows(query Query) *gorm.DB {
+	db := p.db.Model(&persistence.Flow{}).Where("is_current = true")
+	if len(query.FlowIDs) > 0 {
+		db = db.Where("id IN ?", query.FlowIDs)
+	}
+	return db
+}
+
+func (p *LocalProvider) loadFlowStats(query Query, result *Result) error {
 	type statusCount struct {
 		Status string
 		Count  int64
 	}
 	var counts []statusCount
-	if err := p.db.Model(&persistence.Flow{}).
+	if err := p.currentFlows(query).
 		Select("status, COUNT(*) as count").
-		Where("is_current = true").
 		Group("status").
 		Find(&counts).Error; err != nil {
 		return err
@@ -55,21 +64,46 @@ func (p *LocalProvider) loadFlowStats(result *Result) error {
 	return nil
 }
 
-func (p *LocalProvider) loadWorkerFlowMetrics(result *Result) error {
-	type metrics struct {
-		TotalInput  uint64
-		TotalOutput uint64
-		TotalErrors uint64
-	}
-	var m metrics
-	if err := p.db.Model(&persistence.WorkerFlow{}).
-		Select("COALESCE(SUM(input_events), 0) as total_input, COALESCE(SUM(output_events), 0) as total_output, COALESCE(SUM(processor_errors), 0) as total_errors").
-		Scan(&m).Error; err != nil {
+// loadFlowMetrics sums the flow metric buckets of the range into the totals and the time series, buckets without
+// events are reported as zero so charts stay continuous.
+func (p *LocalProvider) loadFlowMetrics(query Query, since time.Time, result *Result) error {
+	resolution := query.Range.Resolution()
+	width := resolution.Width()
+	since = since.Truncate(width)
+
+	db := p.db.Model(&persistence.FlowMetric{}).
+		Select(`bucket,
+			SUM(input_events) as input_events,
+			SUM(processor_errors) as processor_errors,
+			SUM(output_events) as output_events`).
+		Where("resolution = ? AND bucket >= ?", resolution, since)
+	if len(query.FlowIDs) > 0 {
+		db = db.Where("flow_id IN ?", query.FlowIDs)
+	}
+
+	var metrics []persistence.FlowMetric
+	if err := db.Group("bucket").Find(&metrics).Error; err != nil {
 		return err
 	}
-	result.TotalInputEvents = m.TotalInput
-	result.TotalOutputEvents = m.TotalOutput
-	result.TotalProcessorErrors = m.TotalErrors
+
+	points := make(map[int64]int)
+	for t := since; !t.After(time.Now()); t = t.Add(width) {
+		points[t.Unix()] = len(result.EventsOverTime)
+		result.EventsOverTime = append(result.EventsOverTime, TimeSeriesPoint{Timestamp: t})
+	}
+	for _, m := range metrics {
+		result.TotalInputEvents += uint64(m.InputEvents)
+		result.TotalOutputEvents += uint64(m.OutputEvents)
+		result.TotalProcessorErrors += uint64(m.ProcessorErrors)
+
+		i, ok := points[m.Bucket.Unix()]
+		if !ok {
+			continue
+		}
+		result.EventsOverTime[i].InputEvents += m.InputEvents
+		result.EventsOverTime[i].OutputEvents += m.OutputEvents
+		result.EventsOverTime[i].ErrorEvents += m.ProcessorErrors
+	}
 	return nil
 }
 
@@ -84,15 +118,23 @@ func (p *LocalProvider) loadActiveWorkers(result *Result) error {
 	return nil
 }
 
-func (p *LocalProvider) loadEventStats(result *Result) error {
+func (p *LocalProvider) loadEventStats(query Query, since time.Time, result *Result) error {
+	events := func() *gorm.DB {
+		db := p.db.Model(&persistence.Event{}).Where("created_at >= ?", since)
+		if len(query.FlowIDs) > 0 {
+			db = db.Where("flow_id IN ?", query.FlowIDs)
+		}
+		return db
+	}
+
 	var total int64
-	if err := p.db.Model(&persistence.Event{}).Count(&total).Error; err != nil {
+	if err := events().Count(&total).Error; err != nil {
 		return err
 	}
 	result.TotalEvents = total
 
 	var errorCount int64
-	if err := p.db.Model(&persistence.Event{}).
+	if err := events().
 		Where("type = ?", persistence.EventTypeError).
 		Count(&errorCount).Error; err != nil {
 		return err
@@ -101,52 +143,15 @@ func (p *LocalProvider) loadEventStats(result *Result) error {
 	return nil
 }
 
-func (p *LocalProvider) loadEventsOverTime(result *Result) error {
-	since := time.Now().AddDate(0, 0, -30)
-
-	type dailyPoint struct {
-		Day         string
-		InputCount  int64
-		OutputCount int64
-		ErrorCount  int64
-	}
-	var points []dailyPoint
-
-	if err := p.db.Model(&persistence.Event{}).
-		Select(`DATE(created_at) as day,
-			SUM(CASE WHEN section = 'input' THEN 1 ELSE 0 END) as input_count,
-			SUM(CASE WHEN section = 'output' THEN 1 ELSE 0 END) as output_count,
-			SUM(CASE WHEN type = 'ERROR' THEN 1 ELSE 0 END) as error_count`).
-		Where("created_at >= ?", since).
-		Group("DATE(created_at)").
-		Order("day ASC").
-		Find(&points).Error; err != nil {
-		return err
-	}
-
-	result.EventsOverTime = make([]TimeSeriesPoint, len(points))
-	for i, pt := range points {
-		t, _ := time.Parse("2006-01-02", pt.Day)
-		result.EventsOverTime[i] = TimeSeriesPoint{
-			Timestamp:    t,
-			InputEvents:  pt.InputCount,
-			OutputEvents: pt.OutputCount,
-			ErrorEvents:  pt.ErrorCount,
-		}
-	}
-	return nil
-}
-
-func (p *LocalProvider) loadTopComponents(result *Result) error {
+func (p *LocalProvider) loadTopComponents(query Query, result *Result) error {
 	type compCount struct {
 		Component string
 		Count     int64
 	}
 
 	var inputs []compCount
-	if err := p.db.Model(&persistence.Flow{}).
+	if err := p.currentFlows(query).
 		Select("input_component as component, COUNT(*) as count").
-		Where("is_current = true").
 		Group("input_component").
 		Order("count DESC").
 		Limit(10).
@@ -159,9 +164,8 @@ func (p *LocalProvider) loadTopComponents(result *Result) error {
 	}
 
 	var outputs []compCount
-	if err := p.db.Model(&persistence.Flow{}).
+	if err := p.currentFlows(query).
 		Select("output_component as component, COUNT(*) as count").
-		Where("is_current = true").
 		Group("output_component").
 		Order("count DESC").
 		Limit(10).
@@ -175,3 +179,27 @@ func (p *LocalProvider) loadTopComponents(result *Result) error {
 
 	return nil
 }
+
+func (p *LocalProvider) loadToolCallStats(query Query, since time.Time, result *Result) error {
+	db := p.db.Model(&persistence.MCPToolCall{})
+	if len(query.FlowIDs) > 0 {
+		db = db.Where("flow_version_id IN ?", query.FlowIDs)
+	}
+
+	var stats []ToolCallStats
+	if err := db.
+		Select(`tool,
+			COUNT(*) as calls,
+			SUM(CASE WHEN status != ? THEN 1 ELSE 0 END) as errors,
+			AVG(latency_ms) as avg_latency_ms,
+			MAX(latency_ms) as max_latency_ms`, persistence.MCPToolCallStatusSuccess).
+		Where("created_at >= ?", since).
+		Group("tool").
+		Order("calls DESC").
+		Find(&stats).Error; err != nil {
+		return err
+	}
+
+	result.ToolCalls = stats
+	return nil
+}
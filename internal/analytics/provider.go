@@ -1,6 +1,52 @@
 package analytics
 
-import "time"
+import (
+	"time"
+
+	"github.com/sananguliyev/airtruct/internal/persistence"
+)
+
+// Range is the time span ending now that analytics cover.
+type Range string
+
+const (
+	RangeHour  Range = "1h"
+	RangeDay   Range = "24h"
+	RangeWeek  Range = "7d"
+	RangeMonth Range = "30d"
+)
+
+// Duration returns the length of the range, 30 days when it is unknown.
+func (r Range) Duration() time.Duration {
+	switch r {
+	case RangeHour:
+		return time.Hour
+	case RangeDay:
+		return 24 * time.Hour
+	case RangeWeek:
+		return 7 * 24 * time.Hour
+	default:
+		return 30 * 24 * time.Hour
+	}
+}
+
+// Resolution returns the flow metric buckets the time series of the range is built from.
+func (r Range) Resolution() persistence.FlowMetricResolution {
+	switch r {
+	case RangeHour:
+		return persistence.FlowMetricResolutionMinute
+	case RangeDay, RangeWeek:
+		return persistence.FlowMetricResolutionHour
+	default:
+		return persistence.FlowMetricResolutionDay
+	}
+}
+
+// Query selects the range of the analytics and, unless FlowIDs is empty, the flow versions they are limited to.
+type Query struct {
+	Range   Range
+	FlowIDs []int64
+}
 
 type FlowStatusCount struct {
 	Status string
@@ -19,6 +65,14 @@ type TimeSeriesPoint struct {
 	ErrorEvents int64
 }
 
+type ToolCallStats struct {
+	Tool         string
+	Calls        int64
+	Errors       int64
+	AvgLatencyMs float64
+	MaxLatencyMs int64
+}
+
 type Result struct {
 	TotalFlows         int64
 	FlowsByStatus      []FlowStatusCount
@@ -31,8 +85,9 @@ type Result struct {
 	EventsOverTime       []TimeSeriesPoint
 	TopInputComponents   []ComponentCount
 	TopOutputComponents  []ComponentCount
+	ToolCalls            []ToolCallStats
 }
 
 type Provider interface {
-	GetAnalytics() (*Result, error)
+	GetAnalytics(query Query) (*Result, error)
 }
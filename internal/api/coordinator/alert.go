@@ -0,0 +1,462 @@
+package coordinator
+
+import (
+	"context"
+	"net/url"
+	"slices"
+	"time"
+
+	"github.com/rs/zerolog/log"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/emptypb"
+
+	"github.com/sananguliyev/airtruct/internal/persistence"
+	pb "github.com/sananguliyev/airtruct/internal/protogen"
+)
+
+func (c *CoordinatorAPI) ListAlerts(_ context.Context, in *pb.ListAlertsRequest) (*pb.ListAlertsResponse, error) {
+	if err := in.Validate(); err != nil {
+		log.Debug().Err(err).Msg("Invalid request")
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	limit := int(in.GetLimit())
+	if limit <= 0 || limit > 100 {
+		limit = 50
+	}
+
+	filter := persistence.AlertFilter{
+		Status: in.GetStatus(),
+		RuleID: in.GetRuleId(),
+		FlowID: in.GetFlowId(),
+		Limit:  limit,
+		Offset: int(in.GetOffset()),
+	}
+	if in.GetStartTime() != nil {
+		filter.StartTime = in.GetStartTime().AsTime()
+	}
+	if in.GetEndTime() != nil {
+		filter.EndTime = in.GetEndTime().AsTime()
+	}
+
+	alerts, total, err := c.alertRepo.List(filter)
+	if err != nil {
+		log.Error().Err(err).Msg("Failed to list alerts")
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	result := &pb.ListAlertsResponse{
+		Data:  make([]*pb.Alert, 0, len(alerts)),
+		Total: total,
+	}
+	for _, alert := range alerts {
+		result.Data = append(result.Data, alert.ToProto())
+	}
+	return result, nil
+}
+
+func (c *CoordinatorAPI) ListAlertRules(_ context.Context, _ *emptypb.Empty) (*pb.ListAlertRulesResponse, error) {
+	rules, err := c.alertRuleRepo.ListAll()
+	if err != nil {
+		log.Error().Err(err).Msg("Failed to list alert rules")
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	result := &pb.ListAlertRulesResponse{
+		Data: make([]*pb.AlertRule, len(rules)),
+	}
+	for i, rule := range rules {
+		result.Data[i] = rule.ToProto()
+	}
+	return result, nil
+}
+
+func (c *CoordinatorAPI) GetAlertRule(_ context.Context, in *pb.GetAlertRuleRequest) (*pb.AlertRuleResponse, error) {
+	if err := in.Validate(); err != nil {
+		log.Debug().Err(err).Msg("Invalid request")
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	rule, err := c.alertRuleRepo.FindByID(in.GetId())
+	if err != nil {
+		log.Error().Err(err).Msg("Failed to find alert rule")
+		return nil, status.Error(codes.Internal, err.Error())
+	} else if rule == nil {
+		return nil, status.Error(codes.NotFound, "Alert rule not found")
+	}
+
+	return &pb.AlertRuleResponse{
+		Data: rule.ToProto(),
+		Meta: &pb.CommonResponse{Message: "OK"},
+	}, nil
+}
+
+func (c *CoordinatorAPI) CreateAlertRule(_ context.Context, in *pb.AlertRule) (*pb.AlertRuleResponse, error) {
+	rule, err := c.alertRuleFromProto(in)
+	if err != nil {
+		return nil, err
+	}
+
+	if err = c.alertRuleRepo.Create(rule); err != nil {
+		log.Error().Err(err).Msg("Failed to create alert rule")
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &pb.AlertRuleResponse{
+		Data: rule.ToProto(),
+		Meta: &pb.CommonResponse{Message: "Alert rule has been created successfully"},
+	}, nil
+}
+
+func (c *CoordinatorAPI) UpdateAlertRule(_ context.Context, in *pb.AlertRule) (*pb.AlertRuleResponse, error) {
+	if in.GetId() == 0 {
+		log.Debug().Msg("Invalid request: ID is required")
+		return nil, status.Error(codes.InvalidArgument, "ID is required")
+	}
+
+	rule, err := c.alertRuleFromProto(in)
+	if err != nil {
+		return nil, err
+	}
+
+	existing, err := c.alertRuleRepo.FindByID(in.GetId())
+	if err != nil {
+		log.Error().Err(err).Msg("Failed to find alert rule")
+		return nil, status.Error(codes.Internal, err.Error())
+	} else if existing == nil {
+		return nil, status.Error(codes.NotFound, "Alert rule not found")
+	}
+	rule.CreatedAt = existing.CreatedAt
+
+	if err = c.alertRuleRepo.Update(rule); err != nil {
+		log.Error().Err(err).Msg("Failed to update alert rule")
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &pb.AlertRuleResponse{
+		Data: rule.ToProto(),
+		Meta: &pb.CommonResponse{Message: "Alert rule has been updated successfully"},
+	}, nil
+}
+
+func (c *CoordinatorAPI) DeleteAlertRule(_ context.Context, in *pb.GetAlertRuleRequest) (*pb.CommonResponse, error) {
+	if err := in.Validate(); err != nil {
+		log.Debug().Err(err).Msg("Invalid request")
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	rule, err := c.alertRuleRepo.FindByID(in.GetId())
+	if err != nil {
+		log.Error().Err(err).Msg("Failed to find alert rule")
+		return nil, status.Error(codes.Internal, err.Error())
+	} else if rule == nil {
+		return nil, status.Error(codes.NotFound, "Alert rule not found")
+	}
+
+	if err = c.alertRuleRepo.Delete(in.GetId()); err != nil {
+		log.Error().Err(err).Msg("Failed to delete alert rule")
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &pb.CommonResponse{
+		Message: "Alert rule has been deleted successfully",
+	}, nil
+}
+
+// alertRuleFromProto validates the rule, including the requirements of its type, and points it to the first
+// version of its flow.
+func (c *CoordinatorAPI) alertRuleFromProto(in *pb.AlertRule) (*persistence.AlertRule, error) {
+	if err := in.Validate(); err != nil {
+		log.Debug().Err(err).Msg("Invalid request")
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	rule := &persistence.AlertRule{}
+	rule.FromProto(in)
+
+	if rule.Type.Windowed() && rule.WindowMinutes == 0 {
+		return nil, status.Errorf(codes.InvalidArgument, "window_minutes is required for %s rules", rule.Type)
+	}
+	if rule.Type == persistence.AlertRuleTypeWorkerLost && rule.FlowID != 0 {
+		return nil, status.Error(codes.InvalidArgument, "worker_lost rules cannot be limited to a flow")
+	}
+
+	if rule.FlowID != 0 {
+		flow, err := c.flowRepo.FindByID(rule.FlowID)
+		if err != nil {
+			log.Error().Err(err).Msg("Failed to find flow")
+			return nil, status.Error(codes.Internal, err.Error())
+		} else if flow == nil {
+			return nil, status.Error(codes.InvalidArgument, "Flow not found")
+		}
+		if flow.ParentID != nil {
+			rule.FlowID = *flow.ParentID
+		}
+	}
+
+	channelIDs := rule.GetChannelIDs()
+	slices.Sort(channelIDs)
+	channelIDs = slices.Compact(channelIDs)
+	channels, err := c.alertChannelRepo.FindByIDs(channelIDs)
+	if err != nil {
+		log.Error().Err(err).Msg("Failed to find alert channels")
+		return nil, status.Error(codes.Internal, err.Error())
+	} else if len(channels) != len(channelIDs) {
+		return nil, status.Error(codes.InvalidArgument, "Alert channel not found")
+	}
+	rule.SetChannelIDs(channelIDs)
+
+	return rule, nil
+}
+
+func (c *CoordinatorAPI) ListAlertChannels(_ context.Context, _ *emptypb.Empty) (*pb.ListAlertChannelsResponse, error) {
+	channels, err := c.alertChannelRepo.ListAll()
+	if err != nil {
+		log.Error().Err(err).Msg("Failed to list alert channels")
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	result := &pb.ListAlertChannelsResponse{
+		Data: make([]*pb.AlertChannel, len(channels)),
+	}
+	for i, channel := range channels {
+		result.Data[i] = channel.ToProto()
+	}
+	return result, nil
+}
+
+func (c *CoordinatorAPI) GetAlertChannel(_ context.Context, in *pb.GetAlertChannelRequest) (*pb.AlertChannelResponse, error) {
+	channel, err := c.findAlertChannel(in)
+	if err != nil {
+		return nil, err
+	}
+
+	return &pb.AlertChannelResponse{
+		Data: channel.ToProto(),
+		Meta: &pb.CommonResponse{Message: "OK"},
+	}, nil
+}
+
+func (c *CoordinatorAPI) CreateAlertChannel(_ context.Context, in *pb.AlertChannel) (*pb.AlertChannelResponse, error) {
+	channel, err := alertChannelFromProto(in)
+	if err != nil {
+		return nil, err
+	}
+
+	if err = c.alertChannelRepo.Create(channel); err != nil {
+		log.Error().Err(err).Msg("Failed to create alert channel")
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &pb.AlertChannelResponse{
+		Data: channel.ToProto(),
+		Meta: &pb.CommonResponse{Message: "Alert channel has been created successfully"},
+	}, nil
+}
+
+func (c *CoordinatorAPI) UpdateAlertChannel(_ context.Context, in *pb.AlertChannel) (*pb.AlertChannelResponse, error) {
+	if in.GetId() == 0 {
+		log.Debug().Msg("Invalid request: ID is required")
+		return nil, status.Error(codes.InvalidArgument, "ID is required")
+	}
+
+	channel, err := alertChannelFromProto(in)
+	if err != nil {
+		return nil, err
+	}
+
+	existing, err := c.alertChannelRepo.FindByID(in.GetId())
+	if err != nil {
+		log.Error().Err(err).Msg("Failed to find alert channel")
+		return nil, status.Error(codes.Internal, err.Error())
+	} else if existing == nil {
+		return nil, status.Error(codes.NotFound, "Alert channel not found")
+	}
+	channel.CreatedAt = existing.CreatedAt
+
+	if err = c.alertChannelRepo.Update(channel); err != nil {
+		log.Error().Err(err).Msg("Failed to update alert channel")
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &pb.AlertChannelResponse{
+		Data: channel.ToProto(),
+		Meta: &pb.CommonResponse{Message: "Alert channel has been updated successfully"},
+	}, nil
+}
+
+func (c *CoordinatorAPI) DeleteAlertChannel(_ context.Context, in *pb.GetAlertChannelRequest) (*pb.CommonResponse, error) {
+	channel, err := c.findAlertChannel(in)
+	if err != nil {
+		return nil, err
+	}
+
+	rules, err := c.alertRuleRepo.ListAll()
+	if err != nil {
+		log.Error().Err(err).Msg("Failed to list alert rules")
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	for _, rule := range rules {
+		if slices.Contains(rule.GetChannelIDs(), channel.ID) {
+			return nil, status.Errorf(codes.FailedPrecondition, "Alert channel is used by rule %q", rule.Name)
+		}
+	}
+
+	if err = c.alertChannelRepo.Delete(channel.ID); err != nil {
+		log.Error().Err(err).Msg("Failed to delete alert channel")
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &pb.CommonResponse{
+		Message: "Alert channel has been deleted successfully",
+	}, nil
+}
+
+func (c *CoordinatorAPI) TestAlertChannel(ctx context.Context, in *pb.GetAlertChannelRequest) (*pb.CommonResponse, error) {
+	channel, err := c.findAlertChannel(in)
+	if err != nil {
+		return nil, err
+	}
+
+	if err = c.alertManager.TestChannel(ctx, channel); err != nil {
+		log.Debug().Err(err).Int64("channel_id", channel.ID).Msg("Failed to send test notification")
+		return nil, status.Errorf(codes.FailedPrecondition, "Failed to send test notification: %v", err)
+	}
+
+	return &pb.CommonResponse{
+		Message: "Test notification has been sent successfully",
+	}, nil
+}
+
+func (c *CoordinatorAPI) findAlertChannel(in *pb.GetAlertChannelRequest) (*persistence.AlertChannel, error) {
+	if err := in.Validate(); err != nil {
+		log.Debug().Err(err).Msg("Invalid request")
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	channel, err := c.alertChannelRepo.FindByID(in.GetId())
+	if err != nil {
+		log.Error().Err(err).Msg("Failed to find alert channel")
+		return nil, status.Error(codes.Internal, err.Error())
+	} else if channel == nil {
+		return nil, status.Error(codes.NotFound, "Alert channel not found")
+	}
+	return channel, nil
+}
+
+// alertChannelFromProto validates the channel, webhook and Slack channels need an HTTP URL and email channels
+// need recipients.
+func alertChannelFromProto(in *pb.AlertChannel) (*persistence.AlertChannel, error) {
+	if err := in.Validate(); err != nil {
+		log.Debug().Err(err).Msg("Invalid request")
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	channel := &persistence.AlertChannel{}
+	channel.FromProto(in)
+
+	switch channel.Type {
+	case persistence.AlertChannelTypeWebhook, persistence.AlertChannelTypeSlack:
+		u, err := url.Parse(channel.URL)
+		if err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+			return nil, status.Errorf(codes.InvalidArgument, "url of %s channels must be an http or https URL", channel.Type)
+		}
+		channel.Recipients = ""
+	case persistence.AlertChannelTypeEmail:
+		if channel.Recipients == "" {
+			return nil, status.Error(codes.InvalidArgument, "recipients are required for email channels")
+		}
+		channel.URL = ""
+	}
+
+	return channel, nil
+}
+
+func (c *CoordinatorAPI) ListAlertSilences(_ context.Context, in *pb.ListAlertSilencesRequest) (*pb.ListAlertSilencesResponse, error) {
+	silences, err := c.alertSilenceRepo.List(in.GetIncludeExpired())
+	if err != nil {
+		log.Error().Err(err).Msg("Failed to list alert silences")
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	result := &pb.ListAlertSilencesResponse{
+		Data: make([]*pb.AlertSilence, len(silences)),
+	}
+	for i, silence := range silences {
+		result.Data[i] = silence.ToProto()
+	}
+	return result, nil
+}
+
+func (c *CoordinatorAPI) CreateAlertSilence(_ context.Context, in *pb.AlertSilence) (*pb.AlertSilenceResponse, error) {
+	if err := in.Validate(); err != nil {
+		log.Debug().Err(err).Msg("Invalid request")
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	silence := &persistence.AlertSilence{}
+	silence.FromProto(in)
+
+	if !silence.EndsAt.After(silence.StartsAt) {
+		return nil, status.Error(codes.InvalidArgument, "ends_at must be after starts_at")
+	} else if !silence.EndsAt.After(time.Now()) {
+		return nil, status.Error(codes.InvalidArgument, "ends_at must be in the future")
+	}
+
+	if silence.RuleID != 0 {
+		rule, err := c.alertRuleRepo.FindByID(silence.RuleID)
+		if err != nil {
+			log.Error().Err(err).Msg("Failed to find alert rule")
+			return nil, status.Error(codes.Internal, err.Error())
+		} else if rule == nil {
+			return nil, status.Error(codes.InvalidArgument, "Alert rule not found")
+		}
+	}
+	if silence.FlowID != 0 {
+		flow, err := c.flowRepo.FindByID(silence.FlowID)
+		if err != nil {
+			log.Error().Err(err).Msg("Failed to find flow")
+			return nil, status.Error(codes.Internal, err.Error())
+		} else if flow == nil {
+			return nil, status.Error(codes.InvalidArgument, "Flow not found")
+		}
+		if flow.ParentID != nil {
+			silence.FlowID = *flow.ParentID
+		}
+	}
+
+	if err := c.alertSilenceRepo.Create(silence); err != nil {
+		log.Error().Err(err).Msg("Failed to create alert silence")
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &pb.AlertSilenceResponse{
+		Data: silence.ToProto(),
+		Meta: &pb.CommonResponse{Message: "Alert silence has been created successfully"},
+	}, nil
+}
+
+func (c *CoordinatorAPI) DeleteAlertSilence(_ context.Context, in *pb.GetAlertSilenceRequest) (*pb.CommonResponse, error) {
+	if err := in.Validate(); err != nil {
+		log.Debug().Err(err).Msg("Invalid request")
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	silence, err := c.alertSilenceRepo.FindByID(in.GetId())
+	if err != nil {
+		log.Error().Err(err).Msg("Failed to find alert silence")
+		return nil, status.Error(codes.Internal, err.Error())
+	} else if silence == nil {
+		return nil, status.Error(codes.NotFound, "Alert silence not found")
+	}
+
+	if err = c.alertSilenceRepo.Delete(in.GetId()); err != nil {
+		log.Error().Err(err).Msg("Failed to delete alert silence")
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &pb.CommonResponse{
+		Message: "Alert silence has been deleted successfully",
+	}, nil
+}
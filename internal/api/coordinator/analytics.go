@@ -2,18 +2,46 @@ package coordinator
 
 import (
 	"context"
+	"time"
 
+	"github.com/rs/zerolog/log"
+
+	"github.com/sananguliyev/airtruct/internal/analytics"
 	pb "github.com/sananguliyev/airtruct/internal/protogen"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 )
 
-func (s *CoordinatorAPI) GetAnalytics(_ context.Context, _ *pb.GetAnalyticsRequest) (*pb.GetAnalyticsResponse, error) {
+func (s *CoordinatorAPI) GetAnalytics(_ context.Context, in *pb.GetAnalyticsRequest) (*pb.GetAnalyticsResponse, error) {
 	if s.analyticsProvider == nil {
 		return nil, status.Error(codes.Unimplemented, "analytics provider not configured")
 	}
 
-	result, err := s.analyticsProvider.GetAnalytics()
+	if err := in.Validate(); err != nil {
+		log.Debug().Err(err).Msg("Invalid request")
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	query := analytics.Query{Range: analytics.Range(in.GetRange())}
+	if query.Range == "" {
+		query.Range = analytics.RangeMonth
+	}
+	if in.GetFlowId() > 0 {
+		// Like events, analytics of a flow cover all of its versions.
+		flows, err := s.flowRepo.ListAllVersionsByParentID(in.GetFlowId())
+		if err != nil {
+			log.Error().Err(err).Msg("failed to list flow versions")
+			return nil, status.Error(codes.Internal, "failed to list flow versions")
+		}
+		if len(flows) == 0 {
+			return nil, status.Error(codes.NotFound, "Flow not found")
+		}
+		for _, flow := range flows {
+			query.FlowIDs = append(query.FlowIDs, flow.ID)
+		}
+	}
+
+	result, err := s.analyticsProvider.GetAnalytics(query)
 	if err != nil {
 		return nil, status.Errorf(codes.Internal, "failed to get analytics: %v", err)
 	}
@@ -39,7 +67,7 @@ func (s *CoordinatorAPI) GetAnalytics(_ context.Context, _ *pb.GetAnalyticsReque
 	resp.EventsOverTime = make([]*pb.GetAnalyticsResponse_TimeSeriesPoint, len(result.EventsOverTime))
 	for i, pt := range result.EventsOverTime {
 		resp.EventsOverTime[i] = &pb.GetAnalyticsResponse_TimeSeriesPoint{
-			Timestamp:   pt.Timestamp.Format("2006-01-02"),
+			Timestamp:   pt.Timestamp.UTC().Format(time.RFC3339),
 			InputEvents: pt.InputEvents,
 			OutputEvents: pt.OutputEvents,
 			ErrorEvents: pt.ErrorEvents,
@@ -62,5 +90,16 @@ func (s *CoordinatorAPI) GetAnalytics(_ context.Context, _ *pb.GetAnalyticsReque
 		}
 	}
 
+	resp.ToolCalls = make([]*pb.GetAnalyticsResponse_ToolCallStats, len(result.ToolCalls))
+	for i, t := range result.ToolCalls {
+		resp.ToolCalls[i] = &pb.GetAnalyticsResponse_ToolCallStats{
+			Tool:         t.Tool,
+			Calls:        t.Calls,
+			Errors:       t.Errors,
+			AvgLatencyMs: t.AvgLatencyMs,
+			MaxLatencyMs: t.MaxLatencyMs,
+		}
+	}
+
 	return resp, nil
 }
@@ -2,6 +2,7 @@ package coordinator
 
 import (
 	"context"
+	"errors"
 
 	"github.com/rs/zerolog/log"
 	"google.golang.org/grpc/codes"
@@ -11,6 +12,7 @@ import (
 	coordinatorexecutor "github.com/sananguliyev/airtruct/internal/executor/coordinator"
 	"github.com/sananguliyev/airtruct/internal/persistence"
 	pb "github.com/sananguliyev/airtruct/internal/protogen"
+	"github.com/sananguliyev/airtruct/internal/ratelimiter"
 )
 
 func extractCacheResourceName(configYAML string) (string, error) {
@@ -96,6 +98,16 @@ func (c *CoordinatorAPI) CreateFlow(_ context.Context, in *pb.Flow) (*pb.FlowRes
 			Config:    []byte(processor.GetConfig()),
 		}
 	}
+	if err := c.validateFlowRoute(*flow); err != nil {
+		return nil, err
+	}
+	if err := coordinatorexecutor.ValidateEventSettings(*flow); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	if err := c.validateFlowRateLimits(*flow); err != nil {
+		return nil, err
+	}
+
 	if !flow.IsReady {
 		flow.Status = persistence.FlowStatusPaused
 	} else if flow.Status == persistence.FlowStatusActive {
@@ -158,6 +170,13 @@ func (c *CoordinatorAPI) CreateFlow(_ context.Context, in *pb.Flow) (*pb.FlowRes
 		rateLimitNames[rateLimitName] = true
 	}
 
+	// Check processor configs
+	for _, processor := range in.GetProcessors() {
+		if rateLimitName, err := extractRateLimitResourceName(processor.GetConfig()); err == nil && rateLimitName != "" {
+			rateLimitNames[rateLimitName] = true
+		}
+	}
+
 	// Store rate limit references
 	for rateLimitName := range rateLimitNames {
 		rateLimit, err := c.rateLimitRepo.FindByLabel(rateLimitName)
@@ -316,6 +335,16 @@ func (c *CoordinatorAPI) UpdateFlow(_ context.Context, in *pb.Flow) (*pb.FlowRes
 	}
 	newFlow.ParentID = flow.ParentID
 
+	if err := c.validateFlowRoute(*newFlow); err != nil {
+		return nil, err
+	}
+	if err := coordinatorexecutor.ValidateEventSettings(*newFlow); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	if err := c.validateFlowRateLimits(*newFlow); err != nil {
+		return nil, err
+	}
+
 	if !newFlow.IsReady {
 		newFlow.Status = persistence.FlowStatusPaused
 	} else if newFlow.Status == persistence.FlowStatusActive {
@@ -402,6 +431,13 @@ func (c *CoordinatorAPI) UpdateFlow(_ context.Context, in *pb.Flow) (*pb.FlowRes
 		rateLimitNames[rateLimitName] = true
 	}
 
+	// Check processor configs
+	for _, processor := range in.GetProcessors() {
+		if rateLimitName, err := extractRateLimitResourceName(processor.GetConfig()); err == nil && rateLimitName != "" {
+			rateLimitNames[rateLimitName] = true
+		}
+	}
+
 	// Store rate limit references
 	for rateLimitName := range rateLimitNames {
 		rateLimit, err := c.rateLimitRepo.FindByLabel(rateLimitName)
@@ -430,6 +466,49 @@ func (c *CoordinatorAPI) UpdateFlow(_ context.Context, in *pb.Flow) (*pb.FlowRes
 	}, nil
 }
 
+// validateFlowRoute checks that the slug and custom route of the flow are valid and not taken by another flow.
+func (c *CoordinatorAPI) validateFlowRoute(flow persistence.Flow) error {
+	others, err := c.flowRepo.ListAllCurrentWithRoutes()
+	if err != nil {
+		log.Error().Err(err).Msg("Failed to list flow routes")
+		return status.Error(codes.Internal, err.Error())
+	}
+
+	if err := coordinatorexecutor.ValidateFlowRoute(flow, others); err != nil {
+		if errors.Is(err, coordinatorexecutor.ErrRouteConflict) {
+			return status.Error(codes.AlreadyExists, err.Error())
+		}
+		return status.Error(codes.InvalidArgument, err.Error())
+	}
+	return nil
+}
+
+// validateFlowRateLimits rejects rate limits with the concurrency algorithm in flow components. Flows cannot
+// tell when a message is done, so the leases would only be returned when they expire.
+func (c *CoordinatorAPI) validateFlowRateLimits(flow persistence.Flow) error {
+	configs := []string{string(flow.InputConfig), string(flow.OutputConfig)}
+	for _, processor := range flow.Processors {
+		configs = append(configs, string(processor.Config))
+	}
+
+	for _, config := range configs {
+		rateLimitName, err := extractRateLimitResourceName(config)
+		if err != nil || rateLimitName == "" {
+			continue
+		}
+		rateLimit, err := c.rateLimitRepo.FindByLabel(rateLimitName)
+		if err != nil {
+			log.Error().Err(err).Str("rate_limit_label", rateLimitName).Msg("Failed to find rate limit")
+			return status.Error(codes.Internal, err.Error())
+		}
+		if rateLimit != nil && ratelimiter.IsConcurrency(rateLimit.Config) {
+			return status.Errorf(codes.InvalidArgument,
+				"Rate limit %q uses the concurrency algorithm, which is only supported by ingress policies and MCP tools", rateLimitName)
+		}
+	}
+	return nil
+}
+
 func validateFlowConfig(flow persistence.Flow) error {
 	return coordinatorexecutor.ValidateFlow(flow)
 }
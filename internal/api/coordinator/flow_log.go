@@ -0,0 +1,170 @@
+package coordinator
+
+import (
+	"context"
+	"encoding/json"
+	"strconv"
+	"strings"
+
+	"github.com/sananguliyev/airtruct/internal/metrics"
+	"github.com/sananguliyev/airtruct/internal/persistence"
+	pb "github.com/sananguliyev/airtruct/internal/protogen"
+
+	"github.com/rs/zerolog/log"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/emptypb"
+)
+
+// logFlow is the flow version and worker of a worker flow as needed to store its log lines.
+type logFlow struct {
+	flow     *persistence.Flow
+	workerID string
+}
+
+func (c *CoordinatorAPI) IngestLogs(_ context.Context, in *pb.IngestLogsRequest) (*emptypb.Empty, error) {
+	// Worker flows map to nil when they no longer exist, their lines are skipped.
+	workerFlows := make(map[int64]*logFlow)
+
+	lines := make([]*persistence.FlowLog, 0, len(in.GetLogs()))
+	linesByFlow := make(map[int64][]*persistence.FlowLog)
+	for _, line := range in.GetLogs() {
+		lf, err := c.resolveLogFlow(workerFlows, line.GetWorkerFlowId())
+		if err != nil {
+			return nil, err
+		}
+		if lf == nil {
+			continue
+		}
+
+		var fields string
+		if len(line.GetFields().GetFields()) > 0 {
+			data, err := json.Marshal(line.GetFields().AsMap())
+			if err != nil {
+				return nil, status.Errorf(codes.InvalidArgument, "invalid log fields: %v", err)
+			}
+			fields = string(data)
+		}
+
+		entity := &persistence.FlowLog{
+			FlowID:       lf.flow.ID,
+			WorkerFlowID: line.GetWorkerFlowId(),
+			WorkerID:     lf.workerID,
+			Level:        line.GetLevel(),
+			Message:      line.GetMessage(),
+			Fields:       fields,
+			CreatedAt:    line.GetCreatedAt().AsTime(),
+		}
+		lines = append(lines, entity)
+
+		rootID := lf.flow.ID
+		if lf.flow.ParentID != nil {
+			rootID = *lf.flow.ParentID
+		}
+		linesByFlow[rootID] = append(linesByFlow[rootID], entity)
+	}
+
+	for workerFlowID, dropped := range in.GetDropped() {
+		lf, err := c.resolveLogFlow(workerFlows, workerFlowID)
+		if err != nil {
+			return nil, err
+		}
+		if lf == nil {
+			continue
+		}
+		log.Warn().Int64("worker_flow_id", workerFlowID).Uint64("dropped", dropped).Msg("Worker dropped log lines, its log buffer was full")
+		metrics.LogsDropped.WithLabelValues(lf.flow.Name, strconv.FormatInt(lf.flow.ID, 10)).Add(float64(dropped))
+	}
+
+	if err := c.flowLogRepo.AddLogs(lines); err != nil {
+		log.Error().Err(err).Msg("failed to store flow logs")
+		return nil, status.Error(codes.Internal, "failed to store flow logs")
+	}
+	for flowID, flowLines := range linesByFlow {
+		c.logHub.Publish(flowID, flowLines)
+	}
+
+	return &emptypb.Empty{}, nil
+}
+
+// resolveLogFlow returns the flow version and worker of the worker flow from the cache, loading them on first use.
+// It returns nil when the worker flow or its flow no longer exist.
+func (c *CoordinatorAPI) resolveLogFlow(cache map[int64]*logFlow, workerFlowID int64) (*logFlow, error) {
+	if lf, ok := cache[workerFlowID]; ok {
+		return lf, nil
+	}
+
+	workerFlow, err := c.workerFlowRepo.FindByID(workerFlowID)
+	if err != nil {
+		log.Error().Err(err).Int64("worker_flow_id", workerFlowID).Msg("failed to find worker flow")
+		return nil, status.Error(codes.Internal, "failed to resolve flow ID")
+	}
+	if workerFlow == nil {
+		log.Warn().Int64("worker_flow_id", workerFlowID).Msg("Skipping log lines of unknown worker flow")
+		cache[workerFlowID] = nil
+		return nil, nil
+	}
+
+	flow, err := c.flowRepo.FindByID(workerFlow.FlowID)
+	if err != nil {
+		log.Error().Err(err).Int64("flow_id", workerFlow.FlowID).Msg("failed to find flow")
+		return nil, status.Error(codes.Internal, "failed to resolve flow")
+	}
+	if flow == nil {
+		log.Warn().Int64("flow_id", workerFlow.FlowID).Msg("Skipping log lines of unknown flow")
+		cache[workerFlowID] = nil
+		return nil, nil
+	}
+
+	lf := &logFlow{flow: flow, workerID: workerFlow.WorkerID}
+	cache[workerFlowID] = lf
+	return lf, nil
+}
+
+func (c *CoordinatorAPI) ListFlowLogs(_ context.Context, in *pb.ListFlowLogsRequest) (*pb.ListFlowLogsResponse, error) {
+	if err := in.Validate(); err != nil {
+		log.Debug().Err(err).Msg("Invalid request")
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	limit := int(in.GetLimit())
+	if limit <= 0 || limit > 100 {
+		limit = 50
+	}
+
+	flowIDs, err := c.flowVersionIDs(in.GetFlowId(), in.GetFlowVersionId())
+	if err != nil {
+		return nil, err
+	}
+
+	filter := persistence.FlowLogFilter{
+		FlowIDs:      flowIDs,
+		WorkerID:     in.GetWorkerId(),
+		WorkerFlowID: in.GetWorkerFlowId(),
+		MinLevel:     in.GetLevel(),
+		Search:       strings.TrimSpace(in.GetSearch()),
+		Limit:        limit,
+		Offset:       int(in.GetOffset()),
+	}
+	if in.GetStartTime() != nil {
+		filter.StartTime = in.GetStartTime().AsTime()
+	}
+	if in.GetEndTime() != nil {
+		filter.EndTime = in.GetEndTime().AsTime()
+	}
+
+	lines, total, err := c.flowLogRepo.List(filter)
+	if err != nil {
+		log.Error().Err(err).Msg("failed to list flow logs")
+		return nil, status.Error(codes.Internal, "failed to list flow logs")
+	}
+
+	result := &pb.ListFlowLogsResponse{
+		Data:  make([]*pb.FlowLog, 0, len(lines)),
+		Total: total,
+	}
+	for _, line := range lines {
+		result.Data = append(result.Data, line.ToProto())
+	}
+	return result, nil
+}
@@ -0,0 +1,97 @@
+package coordinator
+
+import (
+	"context"
+
+	"github.com/rs/zerolog/log"
+	pb "github.com/sananguliyev/airtruct/internal/protogen"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/sananguliyev/airtruct/internal/persistence"
+)
+
+func (c *CoordinatorAPI) ListQueuedRequests(_ context.Context, in *pb.ListQueuedRequestsRequest) (*pb.ListQueuedRequestsResponse, error) {
+	if err := in.Validate(); err != nil {
+		log.Debug().Err(err).Msg("Invalid request")
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	limit := int(in.GetLimit())
+	if limit <= 0 || limit > 100 {
+		limit = 50
+	}
+
+	requests, total, err := c.queuedRequestRepo.List(persistence.QueuedRequestFilter{
+		FlowID: in.GetFlowId(),
+		Status: in.GetStatus(),
+		Limit:  limit,
+		Offset: int(in.GetOffset()),
+	})
+	if err != nil {
+		log.Error().Err(err).Msg("Failed to list queued requests")
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	result := &pb.ListQueuedRequestsResponse{
+		Data:  make([]*pb.QueuedRequest, len(requests)),
+		Total: total,
+	}
+	for i, request := range requests {
+		result.Data[i] = request.ToProto()
+	}
+
+	return result, nil
+}
+
+func (c *CoordinatorAPI) RetryQueuedRequest(_ context.Context, in *pb.QueuedRequestIdRequest) (*pb.CommonResponse, error) {
+	if err := in.Validate(); err != nil {
+		log.Debug().Err(err).Msg("Invalid request")
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	request, err := c.queuedRequestRepo.FindByID(in.GetId())
+	if err != nil {
+		log.Error().Err(err).Msg("Failed to find queued request")
+		return nil, status.Error(codes.Internal, err.Error())
+	} else if request == nil {
+		return nil, status.Error(codes.NotFound, "Queued request not found")
+	}
+
+	if request.Status != persistence.QueuedRequestStatusDead {
+		return nil, status.Error(codes.FailedPrecondition, "Only dead letters can be retried")
+	}
+
+	if err := c.queuedRequestRepo.Requeue(in.GetId()); err != nil {
+		log.Error().Err(err).Msg("Failed to requeue request")
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &pb.CommonResponse{
+		Message: "Request has been queued for delivery",
+	}, nil
+}
+
+func (c *CoordinatorAPI) DeleteQueuedRequest(_ context.Context, in *pb.QueuedRequestIdRequest) (*pb.CommonResponse, error) {
+	if err := in.Validate(); err != nil {
+		log.Debug().Err(err).Msg("Invalid request")
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	request, err := c.queuedRequestRepo.FindByID(in.GetId())
+	if err != nil {
+		log.Error().Err(err).Msg("Failed to find queued request")
+		return nil, status.Error(codes.Internal, err.Error())
+	} else if request == nil {
+		return nil, status.Error(codes.NotFound, "Queued request not found")
+	}
+
+	if err := c.queuedRequestRepo.Delete(in.GetId()); err != nil {
+		log.Error().Err(err).Msg("Failed to delete queued request")
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &pb.CommonResponse{
+		Message: "Queued request has been deleted successfully",
+	}, nil
+}
@@ -1,7 +1,9 @@
 package coordinator
 
 import (
+	"github.com/sananguliyev/airtruct/internal/alerting"
 	"github.com/sananguliyev/airtruct/internal/analytics"
+	coordinatorexecutor "github.com/sananguliyev/airtruct/internal/executor/coordinator"
 	pb "github.com/sananguliyev/airtruct/internal/protogen"
 
 	"github.com/sananguliyev/airtruct/internal/persistence"
@@ -15,6 +17,10 @@ type FlowWorkerMap interface {
 	RemoveFlowIfMatches(flowID int64, workerFlowID int64)
 }
 
+type ToolProgressReporter interface {
+	ReportProgress(callID string, progress float64, total *float64, message string) error
+}
+
 type CoordinatorAPI struct {
 	pb.UnimplementedCoordinatorServer
 	eventRepo           persistence.EventRepository
@@ -24,15 +30,29 @@ type CoordinatorAPI struct {
 	flowRateLimitRepo persistence.FlowRateLimitRepository
 	flowBufferRepo    persistence.FlowBufferRepository
 	workerFlowRepo    persistence.WorkerFlowRepository
+	flowMetricRepo    persistence.FlowMetricRepository
+	flowComponentMetricRepo persistence.FlowComponentMetricRepository
 	secretRepo          persistence.SecretRepository
 	cacheRepo           persistence.CacheRepository
+	mcpServerRepo       persistence.MCPServerRepository
+	toolCallRepo        persistence.MCPToolCallRepository
 	bufferRepo          persistence.BufferRepository
 	rateLimitRepo       persistence.RateLimitRepository
 	fileRepo            persistence.FileRepository
+	queuedRequestRepo   persistence.QueuedRequestRepository
 	rateLimiterEngine   *ratelimiter.Engine
 	aesgcm              *vault.AESGCM
 	analyticsProvider   analytics.Provider
 	flowWorkerMap     FlowWorkerMap
+	toolProgressReporter ToolProgressReporter
+	flowLogRepo         persistence.FlowLogRepository
+	eventHub            *coordinatorexecutor.TailHub[*persistence.Event]
+	logHub              *coordinatorexecutor.TailHub[*persistence.FlowLog]
+	alertRuleRepo       persistence.AlertRuleRepository
+	alertChannelRepo    persistence.AlertChannelRepository
+	alertSilenceRepo    persistence.AlertSilenceRepository
+	alertRepo           persistence.AlertRepository
+	alertManager        *alerting.Manager
 }
 
 func NewCoordinatorAPI(
@@ -43,15 +63,27 @@ func NewCoordinatorAPI(
 	flowBufferRepo persistence.FlowBufferRepository,
 	workerRepo persistence.WorkerRepository,
 	workerFlowRepo persistence.WorkerFlowRepository,
+	flowMetricRepo persistence.FlowMetricRepository,
+	flowComponentMetricRepo persistence.FlowComponentMetricRepository,
+	flowLogRepo persistence.FlowLogRepository,
 	secretRepo persistence.SecretRepository,
 	cacheRepo persistence.CacheRepository,
+	mcpServerRepo persistence.MCPServerRepository,
+	toolCallRepo persistence.MCPToolCallRepository,
 	bufferRepo persistence.BufferRepository,
 	rateLimitRepo persistence.RateLimitRepository,
 	fileRepo persistence.FileRepository,
+	queuedRequestRepo persistence.QueuedRequestRepository,
+	alertRuleRepo persistence.AlertRuleRepository,
+	alertChannelRepo persistence.AlertChannelRepository,
+	alertSilenceRepo persistence.AlertSilenceRepository,
+	alertRepo persistence.AlertRepository,
 	rateLimiterEngine *ratelimiter.Engine,
 	aesgcm *vault.AESGCM,
 	analyticsProvider analytics.Provider,
 	flowWorkerMap FlowWorkerMap,
+	toolProgressReporter ToolProgressReporter,
+	alertManager *alerting.Manager,
 ) *CoordinatorAPI {
 	return &CoordinatorAPI{
 		eventRepo:           eventRepo,
@@ -61,14 +93,28 @@ func NewCoordinatorAPI(
 		flowBufferRepo:    flowBufferRepo,
 		workerRepo:          workerRepo,
 		workerFlowRepo:    workerFlowRepo,
+		flowMetricRepo:    flowMetricRepo,
+		flowComponentMetricRepo: flowComponentMetricRepo,
 		secretRepo:          secretRepo,
 		cacheRepo:           cacheRepo,
+		mcpServerRepo:       mcpServerRepo,
+		toolCallRepo:        toolCallRepo,
 		bufferRepo:          bufferRepo,
 		rateLimitRepo:       rateLimitRepo,
 		fileRepo:            fileRepo,
+		queuedRequestRepo:   queuedRequestRepo,
 		rateLimiterEngine:   rateLimiterEngine,
 		aesgcm:              aesgcm,
 		analyticsProvider:   analyticsProvider,
 		flowWorkerMap:     flowWorkerMap,
+		toolProgressReporter: toolProgressReporter,
+		flowLogRepo:         flowLogRepo,
+		eventHub:            coordinatorexecutor.NewTailHub[*persistence.Event](coordinatorexecutor.TailStreamEvents),
+		logHub:              coordinatorexecutor.NewTailHub[*persistence.FlowLog](coordinatorexecutor.TailStreamLogs),
+		alertRuleRepo:       alertRuleRepo,
+		alertChannelRepo:    alertChannelRepo,
+		alertSilenceRepo:    alertSilenceRepo,
+		alertRepo:           alertRepo,
+		alertManager:        alertManager,
 	}
 }
@@ -0,0 +1,74 @@
+package coordinator
+
+import (
+	"context"
+
+	"github.com/rs/zerolog/log"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/sananguliyev/airtruct/internal/persistence"
+	pb "github.com/sananguliyev/airtruct/internal/protogen"
+)
+
+func (c *CoordinatorAPI) ReportToolProgress(_ context.Context, in *pb.ToolProgressRequest) (*pb.CommonResponse, error) {
+	if err := in.Validate(); err != nil {
+		log.Debug().Err(err).Msg("Invalid request")
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	var total *float64
+	if in.Total != nil {
+		t := in.GetTotal()
+		total = &t
+	}
+
+	if err := c.toolProgressReporter.ReportProgress(in.GetCallId(), in.GetProgress(), total, in.GetMessage()); err != nil {
+		log.Debug().Err(err).Str("call_id", in.GetCallId()).Msg("Failed to report tool progress")
+		return nil, status.Error(codes.FailedPrecondition, err.Error())
+	}
+
+	return &pb.CommonResponse{Message: "Progress has been reported successfully"}, nil
+}
+
+func (c *CoordinatorAPI) ListToolCalls(_ context.Context, in *pb.ListToolCallsRequest) (*pb.ListToolCallsResponse, error) {
+	if err := in.Validate(); err != nil {
+		log.Debug().Err(err).Msg("Invalid request")
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	limit := int(in.GetLimit())
+	if limit <= 0 || limit > 100 {
+		limit = 50
+	}
+
+	filter := persistence.MCPToolCallFilter{
+		Tool:   in.GetTool(),
+		FlowID: in.GetFlowId(),
+		Status: in.GetStatus(),
+		Limit:  limit,
+		Offset: int(in.GetOffset()),
+	}
+	if in.GetStartTime() != nil {
+		filter.StartTime = in.GetStartTime().AsTime()
+	}
+	if in.GetEndTime() != nil {
+		filter.EndTime = in.GetEndTime().AsTime()
+	}
+
+	calls, total, err := c.toolCallRepo.List(filter)
+	if err != nil {
+		log.Error().Err(err).Msg("Failed to list MCP tool calls")
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	result := &pb.ListToolCallsResponse{
+		Data:  make([]*pb.McpToolCall, len(calls)),
+		Total: total,
+	}
+	for i, call := range calls {
+		result.Data[i] = call.ToProto()
+	}
+
+	return result, nil
+}
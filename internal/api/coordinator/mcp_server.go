@@ -0,0 +1,181 @@
+package coordinator
+
+import (
+	"context"
+
+	"github.com/rs/zerolog/log"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/emptypb"
+
+	"github.com/sananguliyev/airtruct/internal/mcp"
+	"github.com/sananguliyev/airtruct/internal/persistence"
+	pb "github.com/sananguliyev/airtruct/internal/protogen"
+)
+
+func (c *CoordinatorAPI) CreateMcpServer(_ context.Context, in *pb.McpServer) (*pb.McpServerResponse, error) {
+	if err := in.Validate(); err != nil {
+		log.Debug().Err(err).Msg("Invalid request")
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	if _, err := mcp.ParseUpstreamConfig(in.GetComponent(), []byte(in.GetConfig())); err != nil {
+		log.Debug().Err(err).Msg("Invalid MCP server config")
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	existing, err := c.mcpServerRepo.FindByLabel(in.GetLabel())
+	if err != nil {
+		log.Error().Err(err).Msg("Failed to check existing MCP server")
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	if existing != nil {
+		return nil, status.Error(codes.AlreadyExists, "MCP server with this label already exists")
+	}
+
+	server := &persistence.MCPServer{}
+	server.FromProto(in)
+	server.ParentID = nil
+	server.IsCurrent = true
+
+	if err := c.mcpServerRepo.Create(server); err != nil {
+		log.Error().Err(err).Msg("Failed to create MCP server")
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &pb.McpServerResponse{
+		Data: server.ToProto(),
+		Meta: &pb.CommonResponse{Message: "MCP server has been created successfully"},
+	}, nil
+}
+
+func (c *CoordinatorAPI) GetMcpServer(_ context.Context, in *pb.GetMcpServerRequest) (*pb.McpServerResponse, error) {
+	if err := in.Validate(); err != nil {
+		log.Debug().Err(err).Msg("Invalid request")
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	server, err := c.mcpServerRepo.FindByID(in.GetId())
+	if err != nil {
+		log.Error().Err(err).Msg("Failed to find MCP server")
+		return nil, status.Error(codes.Internal, err.Error())
+	} else if server == nil {
+		return nil, status.Error(codes.NotFound, "MCP server not found")
+	}
+
+	return &pb.McpServerResponse{
+		Data: server.ToProto(),
+		Meta: &pb.CommonResponse{Message: "OK"},
+	}, nil
+}
+
+func (c *CoordinatorAPI) ListMcpServers(_ context.Context, _ *emptypb.Empty) (*pb.ListMcpServersResponse, error) {
+	servers, err := c.mcpServerRepo.ListAll()
+	if err != nil {
+		log.Error().Err(err).Msg("Failed to list MCP servers")
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	result := &pb.ListMcpServersResponse{
+		Data: make([]*pb.McpServer, len(servers)),
+	}
+	for i, server := range servers {
+		result.Data[i] = server.ToProto()
+	}
+
+	return result, nil
+}
+
+func (c *CoordinatorAPI) UpdateMcpServer(_ context.Context, in *pb.McpServer) (*pb.McpServerResponse, error) {
+	if err := in.Validate(); err != nil {
+		log.Debug().Err(err).Msg("Invalid request")
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	if in.GetId() == 0 {
+		log.Debug().Msg("Invalid request: ID is required")
+		return nil, status.Error(codes.InvalidArgument, "ID is required")
+	}
+
+	if _, err := mcp.ParseUpstreamConfig(in.GetComponent(), []byte(in.GetConfig())); err != nil {
+		log.Debug().Err(err).Msg("Invalid MCP server config")
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	server, err := c.mcpServerRepo.FindByID(in.GetId())
+	if err != nil {
+		log.Error().Err(err).Msg("Failed to find MCP server")
+		return nil, status.Error(codes.Internal, err.Error())
+	} else if server == nil {
+		return nil, status.Error(codes.NotFound, "MCP server not found")
+	}
+
+	existingByLabel, err := c.mcpServerRepo.FindByLabel(in.GetLabel())
+	if err != nil {
+		log.Error().Err(err).Msg("Failed to check existing MCP server by label")
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	if existingByLabel != nil && existingByLabel.ID != in.GetId() {
+		sameLineage := false
+
+		if existingByLabel.ParentID != nil && server.ParentID != nil {
+			sameLineage = *existingByLabel.ParentID == *server.ParentID
+		}
+
+		if !sameLineage && existingByLabel.ParentID != nil {
+			sameLineage = *existingByLabel.ParentID == server.ID
+		}
+
+		if !sameLineage && server.ParentID != nil {
+			sameLineage = *server.ParentID == existingByLabel.ID
+		}
+
+		if sameLineage {
+			return nil, status.Error(codes.FailedPrecondition, "Cannot update old version. You can only update the current version of this MCP server")
+		}
+		return nil, status.Error(codes.AlreadyExists, "Another MCP server with this label already exists")
+	}
+
+	newServer := &persistence.MCPServer{}
+	newServer.FromProto(in)
+	if server.ParentID == nil {
+		newServer.ParentID = &server.ID
+	} else {
+		newServer.ParentID = server.ParentID
+	}
+	newServer.IsCurrent = true
+
+	if err = c.mcpServerRepo.Update(newServer); err != nil {
+		log.Error().Err(err).Msg("Failed to update MCP server")
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &pb.McpServerResponse{
+		Data: newServer.ToProto(),
+		Meta: &pb.CommonResponse{Message: "MCP server has been updated successfully"},
+	}, nil
+}
+
+func (c *CoordinatorAPI) DeleteMcpServer(_ context.Context, in *pb.GetMcpServerRequest) (*pb.CommonResponse, error) {
+	if err := in.Validate(); err != nil {
+		log.Debug().Err(err).Msg("Invalid request")
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	server, err := c.mcpServerRepo.FindByID(in.GetId())
+	if err != nil {
+		log.Error().Err(err).Msg("Failed to find MCP server")
+		return nil, status.Error(codes.Internal, err.Error())
+	} else if server == nil {
+		return nil, status.Error(codes.NotFound, "MCP server not found")
+	}
+
+	if err := c.mcpServerRepo.Delete(in.GetId()); err != nil {
+		log.Error().Err(err).Msg("Failed to delete MCP server")
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &pb.CommonResponse{
+		Message: "MCP server has been deleted successfully",
+	}, nil
+}
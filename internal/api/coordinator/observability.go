@@ -3,8 +3,17 @@ package coordinator
 import (
 	"context"
 	"encoding/json"
+	"fmt"
 	"io"
+	"slices"
+	"sort"
+	"strconv"
+	"strings"
+	"sync/atomic"
+	"time"
 
+	coordinatorexecutor "github.com/sananguliyev/airtruct/internal/executor/coordinator"
+	"github.com/sananguliyev/airtruct/internal/metrics"
 	"github.com/sananguliyev/airtruct/internal/persistence"
 	pb "github.com/sananguliyev/airtruct/internal/protogen"
 
@@ -17,62 +26,205 @@ import (
 	"google.golang.org/protobuf/types/known/timestamppb"
 )
 
-func (c *CoordinatorAPI) IngestEvents(stream grpc.BidiStreamingServer[pb.Event, emptypb.Empty]) error {
-	flowIDCache := make(map[int64]int64)
+const (
+	// eventAckInterval is how often the stored batches of an event stream are acknowledged, eventAckBatches
+	// how many stored batches are acknowledged right away. Workers only keep a few batches unacknowledged.
+	eventAckInterval = 250 * time.Millisecond
+	eventAckBatches  = 4
+)
+
+// eventFlow is the flow version of a worker flow as needed to store its events.
+type eventFlow struct {
+	flow   *persistence.Flow
+	policy *coordinatorexecutor.EventPolicy
+}
+
+// rootID is the initial version of the flow, live tails follow a flow across its versions by it.
+func (ef *eventFlow) rootID() int64 {
+	if ef.flow.ParentID != nil {
+		return *ef.flow.ParentID
+	}
+	return ef.flow.ID
+}
+
+func (c *CoordinatorAPI) IngestEvents(stream grpc.BidiStreamingServer[pb.EventBatch, pb.EventAck]) error {
+	// Worker flows map to nil when they no longer exist, their events are skipped.
+	workerFlows := make(map[int64]*eventFlow)
+
+	var stored atomic.Uint64
+	storedBatches := make(chan struct{}, 1)
+	ackDone := make(chan struct{})
+	ackErr := make(chan error, 1)
+	go func() {
+		defer close(ackDone)
+		ticker := time.NewTicker(eventAckInterval)
+		defer ticker.Stop()
+
+		var acked uint64
+		for {
+			select {
+			case <-stream.Context().Done():
+				return
+			case <-ticker.C:
+			case <-storedBatches:
+			}
 
+			sequence := stored.Load()
+			if sequence == acked {
+				continue
+			}
+			if err := stream.Send(&pb.EventAck{Sequence: sequence}); err != nil {
+				ackErr <- err
+				return
+			}
+			acked = sequence
+		}
+	}()
+
+	unacked := 0
 	for {
-		event, err := stream.Recv()
+		batch, err := stream.Recv()
 		if err == io.EOF {
 			return nil
 		}
 		if err != nil {
-			return status.Errorf(codes.Internal, "failed to receive event: %v", err)
+			return status.Errorf(codes.Internal, "failed to receive events: %v", err)
 		}
 
-		meta, err := event.GetMeta().MarshalJSON()
-		if err != nil {
-			return status.Errorf(codes.InvalidArgument, "invalid event format: %v", err)
+		events := make([]*persistence.Event, 0, len(batch.GetEvents()))
+		eventFlows := make(map[*persistence.Event]*eventFlow, len(batch.GetEvents()))
+		for _, event := range batch.GetEvents() {
+			meta, err := event.GetMeta().MarshalJSON()
+			if err != nil {
+				return status.Errorf(codes.InvalidArgument, "invalid event format: %v", err)
+			}
+
+			ef, err := c.resolveEventFlow(workerFlows, event.GetWorkerFlowId())
+			if err != nil {
+				return err
+			}
+			if ef == nil {
+				continue
+			}
+
+			eventEntity := &persistence.Event{
+				WorkerFlowID:   event.GetWorkerFlowId(),
+				FlowID:         ef.flow.ID,
+				TraceID:        event.GetTraceId(),
+				Section:        event.GetSection(),
+				ComponentLabel: event.GetComponentLabel(),
+				Type:           persistence.EventType(event.GetType()),
+				Meta:           meta,
+				Content:        event.GetContent(),
+			}
+			if event.GetKey() != "" {
+				key := event.GetKey()
+				eventEntity.Key = &key
+			}
+			if event.GetCreatedAt() != nil {
+				eventEntity.CreatedAt = event.GetCreatedAt().AsTime()
+			}
+			if ef.policy.Apply(eventEntity) {
+				events = append(events, eventEntity)
+				eventFlows[eventEntity] = ef
+			}
 		}
 
-		wsID := event.GetWorkerFlowId()
-		flowID, ok := flowIDCache[wsID]
-		if !ok {
-			ws, wsErr := c.workerFlowRepo.FindByID(wsID)
-			if wsErr != nil {
-				log.Error().Err(wsErr).Int64("worker_flow_id", wsID).Msg("failed to find worker flow")
-				return status.Error(codes.Internal, "failed to resolve flow ID")
+		for workerFlowID, dropped := range batch.GetDropped() {
+			ef, err := c.resolveEventFlow(workerFlows, workerFlowID)
+			if err != nil {
+				return err
 			}
-			if ws == nil {
-				return status.Errorf(codes.NotFound, "worker flow %d not found", wsID)
+			if ef == nil {
+				continue
 			}
-			flowID = ws.FlowID
-			flowIDCache[wsID] = flowID
+			log.Warn().Int64("worker_flow_id", workerFlowID).Uint64("dropped", dropped).Msg("Worker dropped events, its event buffer was full")
+			metrics.EventsDropped.WithLabelValues(ef.flow.Name, strconv.FormatInt(ef.flow.ID, 10)).Add(float64(dropped))
 		}
 
-		evetEntity := &persistence.Event{
-			WorkerFlowID: wsID,
-			FlowID:       flowID,
-			TraceID:      event.GetTraceId(),
-			Section:        event.GetSection(),
-			ComponentLabel: event.GetComponentLabel(),
-			Type:           persistence.EventType(event.GetType()),
-			Meta:           meta,
-			Content:        event.GetContent(),
+		// Events of a batch sent again after a reconnect are already stored, they are neither stored nor published
+		// twice.
+		added, err := c.eventRepo.AddEvents(events)
+		if err != nil {
+			log.Error().Err(err).Uint64("sequence", batch.GetSequence()).Msg("failed to store events")
+			return status.Error(codes.Internal, "failed to store events")
+		}
+		eventsByFlow := make(map[int64][]*persistence.Event)
+		for _, event := range added {
+			rootID := eventFlows[event].rootID()
+			eventsByFlow[rootID] = append(eventsByFlow[rootID], event)
+		}
+		for flowID, flowEvents := range eventsByFlow {
+			c.eventHub.Publish(flowID, flowEvents)
 		}
-		if err = c.eventRepo.AddEvent(evetEntity); err != nil {
-			return status.Error(codes.InvalidArgument, "invalid event format")
+
+		stored.Store(batch.GetSequence())
+		if unacked++; unacked >= eventAckBatches {
+			unacked = 0
+			select {
+			case storedBatches <- struct{}{}:
+			default:
+			}
 		}
 
-		if err = stream.Send(&emptypb.Empty{}); err != nil {
-			log.Error().Err(err).Msg("failed to ack event")
-			return status.Error(codes.Internal, "failed to ack event")
+		select {
+		case err := <-ackErr:
+			log.Error().Err(err).Msg("failed to ack events")
+			return status.Error(codes.Internal, "failed to ack events")
+		case <-ackDone:
+			return stream.Context().Err()
+		default:
 		}
 	}
 }
 
+// resolveEventFlow returns the flow version of the worker flow from the cache, loading it on first use. It returns
+// nil when the worker flow or its flow no longer exist.
+func (c *CoordinatorAPI) resolveEventFlow(cache map[int64]*eventFlow, workerFlowID int64) (*eventFlow, error) {
+	if ef, ok := cache[workerFlowID]; ok {
+		return ef, nil
+	}
+
+	workerFlow, err := c.workerFlowRepo.FindByID(workerFlowID)
+	if err != nil {
+		log.Error().Err(err).Int64("worker_flow_id", workerFlowID).Msg("failed to find worker flow")
+		return nil, status.Error(codes.Internal, "failed to resolve flow ID")
+	}
+	if workerFlow == nil {
+		log.Warn().Int64("worker_flow_id", workerFlowID).Msg("Skipping events of unknown worker flow")
+		cache[workerFlowID] = nil
+		return nil, nil
+	}
+
+	flow, err := c.flowRepo.FindByID(workerFlow.FlowID)
+	if err != nil {
+		log.Error().Err(err).Int64("flow_id", workerFlow.FlowID).Msg("failed to find flow")
+		return nil, status.Error(codes.Internal, "failed to resolve flow")
+	}
+	if flow == nil {
+		log.Warn().Int64("flow_id", workerFlow.FlowID).Msg("Skipping events of unknown flow")
+		cache[workerFlowID] = nil
+		return nil, nil
+	}
+
+	policy, err := coordinatorexecutor.NewEventPolicy(*flow)
+	if err != nil {
+		// Settings are validated when the flow is saved, drop the events rather than store content that should
+		// have been redacted.
+		log.Error().Err(err).Int64("flow_id", flow.ID).Msg("invalid event settings, dropping events")
+		none := 0.0
+		policy, _ = coordinatorexecutor.NewEventPolicy(persistence.Flow{EventSampleRate: &none})
+	}
+
+	ef := &eventFlow{flow: flow, policy: policy}
+	cache[workerFlowID] = ef
+	return ef, nil
+}
+
 func (c *CoordinatorAPI) ListEvents(ctx context.Context, in *pb.ListEventsRequest) (*pb.ListEventsResponse, error) {
 	if err := in.Validate(); err != nil {
-		return nil, status.Error(codes.InvalidArgument, "invalid request")
+		log.Debug().Err(err).Msg("Invalid request")
+		return nil, status.Error(codes.InvalidArgument, err.Error())
 	}
 
 	limit := int(in.GetLimit())
@@ -84,9 +236,44 @@ func (c *CoordinatorAPI) ListEvents(ctx context.Context, in *pb.ListEventsReques
 	startTime := in.GetStartTime().AsTime()
 	endTime := in.GetEndTime().AsTime()
 
+	flowIDs, err := c.flowVersionIDs(in.GetFlowId(), in.GetFlowVersionId())
+	if err != nil {
+		return nil, err
+	}
+
+	events, total, err := c.eventRepo.ListEvents(persistence.EventFilter{
+		FlowIDs:        flowIDs,
+		WorkerFlowID:   in.GetWorkerFlowId(),
+		Section:        in.GetSection(),
+		ComponentLabel: in.GetComponentLabel(),
+		Type:           persistence.EventType(in.GetType()),
+		TraceID:        in.GetTraceId(),
+		Search:         strings.TrimSpace(in.GetSearch()),
+		StartTime:      startTime,
+		EndTime:        endTime,
+		Limit:          limit,
+		Offset:         offset,
+	})
+	if err != nil {
+		log.Error().Err(err).Msg("failed to list events")
+		return nil, status.Error(codes.Internal, "failed to list events")
+	}
+
+	pbEvents := make([]*pb.Event, 0, len(events))
+	for _, e := range events {
+		pbEvents = append(pbEvents, eventToProto(e))
+	}
+
+	return &pb.ListEventsResponse{
+		Data:  pbEvents,
+		Total: total,
+	}, nil
+}
+
+// flowVersionIDs returns the IDs of the versions of the flow, or only versionID when it is set.
+func (c *CoordinatorAPI) flowVersionIDs(parentID, versionID int64) ([]int64, error) {
 	// The request sends the parent (initial) flow ID.
 	// Fetch all versions: the parent itself + all versions with that parent_id.
-	parentID := in.GetFlowId()
 	allFlows, err := c.flowRepo.ListAllVersionsByParentID(parentID)
 	if err != nil {
 		log.Error().Err(err).Msg("failed to list flow versions")
@@ -101,37 +288,85 @@ func (c *CoordinatorAPI) ListEvents(ctx context.Context, in *pb.ListEventsReques
 		}
 	}
 
-	events, total, err := c.eventRepo.ListEventsByFlowIDs(flowIDs, limit, offset, startTime, endTime)
+	if versionID != 0 {
+		if !slices.Contains(flowIDs, versionID) {
+			return nil, status.Error(codes.InvalidArgument, "flow version does not belong to the flow")
+		}
+		flowIDs = []int64{versionID}
+	}
+	return flowIDs, nil
+}
+
+// GetTrace returns the timeline of a traced message, the components it went through in the order it reached
+// them with the events each reported.
+func (c *CoordinatorAPI) GetTrace(_ context.Context, in *pb.GetTraceRequest) (*pb.GetTraceResponse, error) {
+	if err := in.Validate(); err != nil {
+		log.Debug().Err(err).Msg("Invalid request")
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	events, err := c.eventRepo.ListEventsByTraceID(in.GetTraceId())
 	if err != nil {
-		log.Error().Err(err).Msg("failed to list events")
-		return nil, status.Error(codes.Internal, "failed to list events")
+		log.Error().Err(err).Str("trace_id", in.GetTraceId()).Msg("failed to list trace events")
+		return nil, status.Error(codes.Internal, "failed to list trace events")
+	}
+	if len(events) == 0 {
+		return nil, status.Error(codes.NotFound, "trace not found")
 	}
 
-	pbEvents := make([]*pb.Event, 0, len(events))
+	first, last := events[0], events[len(events)-1]
+	result := &pb.GetTraceResponse{
+		TraceId:   in.GetTraceId(),
+		FlowId:    first.FlowID,
+		StartedAt: timestamppb.New(first.CreatedAt),
+		EndedAt:   timestamppb.New(last.CreatedAt),
+	}
+
+	type componentKey struct {
+		section string
+		label   string
+	}
+	components := make(map[componentKey]*pb.TraceComponent)
 	for _, e := range events {
-		var metaMap map[string]any
-		if len(e.Meta) > 0 {
-			_ = json.Unmarshal(e.Meta, &metaMap)
+		key := componentKey{e.Section, e.ComponentLabel}
+		component, ok := components[key]
+		if !ok {
+			component = &pb.TraceComponent{
+				Section:        e.Section,
+				ComponentLabel: e.ComponentLabel,
+				StartedAt:      timestamppb.New(e.CreatedAt),
+			}
+			components[key] = component
+			result.Timeline = append(result.Timeline, component)
+		}
+		component.EndedAt = timestamppb.New(e.CreatedAt)
+		if e.Type == persistence.EventTypeError {
+			component.Errors++
 		}
-		metaStruct, _ := structpb.NewStruct(metaMap)
+		component.Events = append(component.Events, eventToProto(e))
+	}
+
+	return result, nil
+}
 
-		pbEvents = append(pbEvents, &pb.Event{
-			Id:             e.ID,
-			WorkerFlowId: e.WorkerFlowID,
-			TraceId:         e.TraceID,
-			Section:        e.Section,
-			ComponentLabel: e.ComponentLabel,
-			Type:           string(e.Type),
-			Content:        e.Content,
-			Meta:           metaStruct,
-			CreatedAt:      timestamppb.New(e.CreatedAt),
-		})
+func eventToProto(e *persistence.Event) *pb.Event {
+	var metaMap map[string]any
+	if len(e.Meta) > 0 {
+		_ = json.Unmarshal(e.Meta, &metaMap)
 	}
+	metaStruct, _ := structpb.NewStruct(metaMap)
 
-	return &pb.ListEventsResponse{
-		Data:  pbEvents,
-		Total: total,
-	}, nil
+	return &pb.Event{
+		Id:             e.ID,
+		WorkerFlowId:   e.WorkerFlowID,
+		TraceId:        e.TraceID,
+		Section:        e.Section,
+		ComponentLabel: e.ComponentLabel,
+		Type:           string(e.Type),
+		Content:        e.Content,
+		Meta:           metaStruct,
+		CreatedAt:      timestamppb.New(e.CreatedAt),
+	}
 }
 
 func (c *CoordinatorAPI) IngestMetrics(ctx context.Context, in *pb.MetricsRequest) (*emptypb.Empty, error) {
@@ -142,8 +377,17 @@ func (c *CoordinatorAPI) IngestMetrics(ctx context.Context, in *pb.MetricsReques
 		return nil, status.Error(codes.InvalidArgument, "invalid metrics request")
 	}
 
-	err = c.workerFlowRepo.UpdateMetrics(
-		in.GetWorkerFlowId(),
+	workerFlow, err := c.workerFlowRepo.FindByID(in.GetWorkerFlowId())
+	if err != nil {
+		log.Error().Err(err).Int64("worker_flow_id", in.GetWorkerFlowId()).Msg("failed to find worker flow")
+		return nil, status.Error(codes.Internal, "failed to resolve flow ID")
+	}
+	if workerFlow == nil {
+		return nil, status.Errorf(codes.NotFound, "worker flow %d not found", in.GetWorkerFlowId())
+	}
+
+	err = c.flowMetricRepo.Record(
+		workerFlow.ID,
 		in.GetInputEvents(),
 		in.GetProcessorErrors(),
 		in.GetOutputEvents(),
@@ -153,5 +397,174 @@ func (c *CoordinatorAPI) IngestMetrics(ctx context.Context, in *pb.MetricsReques
 		return nil, status.Error(codes.Internal, "failed to update metrics")
 	}
 
+	components := componentMetrics(in)
+	if len(components) == 0 {
+		return &emptypb.Empty{}, nil
+	}
+
+	if err = c.flowComponentMetricRepo.Record(workerFlow.FlowID, workerFlow.ID, components); err != nil {
+		log.Error().Err(err).Int64("worker_flow_id", workerFlow.ID).Msg("failed to record component metrics")
+		return nil, status.Error(codes.Internal, "failed to update metrics")
+	}
+
 	return &emptypb.Empty{}, nil
 }
+
+// componentMetrics returns the cumulative counts of every component in the request, errors are matched to the
+// components by label as labels are unique within a flow.
+func componentMetrics(in *pb.MetricsRequest) []persistence.WorkerFlowComponentMetric {
+	sections := map[persistence.FlowSection]map[string]uint64{
+		persistence.FlowSectionInput:    in.GetInputEventsByComponent(),
+		persistence.FlowSectionPipeline: in.GetProcessorEventsByComponent(),
+		persistence.FlowSectionOutput:   in.GetOutputEventsByComponent(),
+	}
+
+	var components []persistence.WorkerFlowComponentMetric
+	for section, events := range sections {
+		for label, count := range events {
+			components = append(components, persistence.WorkerFlowComponentMetric{
+				WorkerFlowID:   in.GetWorkerFlowId(),
+				Section:        section,
+				ComponentLabel: label,
+				Events:         int64(count),
+				Errors:         int64(in.GetErrorsByComponent()[label]),
+			})
+		}
+	}
+	return components
+}
+
+func (c *CoordinatorAPI) GetFlowMetrics(_ context.Context, in *pb.GetFlowMetricsRequest) (*pb.GetFlowMetricsResponse, error) {
+	if err := in.Validate(); err != nil {
+		log.Debug().Err(err).Msg("Invalid request")
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	timeRange, step, err := flowMetricsRange(in.GetRange(), in.GetStep())
+	if err != nil {
+		log.Debug().Err(err).Msg("Invalid request")
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	// Like events, metrics are requested for the parent flow and cover all of its versions.
+	parentID := in.GetFlowId()
+	allFlows, err := c.flowRepo.ListAllVersionsByParentID(parentID)
+	if err != nil {
+		log.Error().Err(err).Msg("failed to list flow versions")
+		return nil, status.Error(codes.Internal, "failed to list flow versions")
+	}
+	if len(allFlows) == 0 {
+		return nil, status.Error(codes.NotFound, "Flow not found")
+	}
+
+	flowIDs := make([]int64, 0, len(allFlows))
+	for _, flow := range allFlows {
+		flowIDs = append(flowIDs, flow.ID)
+	}
+
+	now := time.Now().UTC()
+	since := now.Add(-timeRange).Truncate(step)
+	metrics, err := c.flowComponentMetricRepo.ListByFlowIDs(flowIDs, since)
+	if err != nil {
+		log.Error().Err(err).Msg("failed to list component metrics")
+		return nil, status.Error(codes.Internal, "failed to list component metrics")
+	}
+
+	type componentKey struct {
+		section persistence.FlowSection
+		label   string
+	}
+	components := make(map[componentKey]*pb.GetFlowMetricsResponse_Component)
+	result := &pb.GetFlowMetricsResponse{Step: step.String()}
+	for _, metric := range metrics {
+		key := componentKey{metric.Section, metric.ComponentLabel}
+		component, ok := components[key]
+		if !ok {
+			// Steps without events are reported as zero so the series of all components line up.
+			component = &pb.GetFlowMetricsResponse_Component{
+				Section:        string(metric.Section),
+				ComponentLabel: metric.ComponentLabel,
+			}
+			for t := since; !t.After(now); t = t.Add(step) {
+				component.Data = append(component.Data, &pb.GetFlowMetricsResponse_Point{
+					Timestamp: t.Format(time.RFC3339),
+				})
+			}
+			components[key] = component
+			result.Components = append(result.Components, component)
+		}
+
+		index := int(metric.Bucket.Sub(since) / step)
+		if index < 0 || index >= len(component.Data) {
+			continue
+		}
+		component.Data[index].Events += metric.Events
+		component.Data[index].Errors += metric.Errors
+		component.TotalEvents += metric.Events
+		component.TotalErrors += metric.Errors
+	}
+
+	sort.Slice(result.Components, func(i, j int) bool {
+		a, b := result.Components[i], result.Components[j]
+		if a.Section != b.Section {
+			return flowSectionOrder[a.Section] < flowSectionOrder[b.Section]
+		}
+		return a.ComponentLabel < b.ComponentLabel
+	})
+
+	return result, nil
+}
+
+var flowSectionOrder = map[string]int{
+	string(persistence.FlowSectionInput):    0,
+	string(persistence.FlowSectionPipeline): 1,
+	string(persistence.FlowSectionOutput):   2,
+}
+
+// maxFlowMetricsPoints bounds the points of every component series.
+const maxFlowMetricsPoints = 1440
+
+// flowMetricsRange parses the range and step of a metrics request. Both accept Go durations and days such as
+// 7d, the step is rounded to whole minutes, the width of the stored counters.
+func flowMetricsRange(rangeValue, stepValue string) (time.Duration, time.Duration, error) {
+	timeRange := time.Hour
+	if rangeValue != "" {
+		var err error
+		if timeRange, err = parseMetricsDuration(rangeValue); err != nil {
+			return 0, 0, fmt.Errorf("invalid range: %w", err)
+		}
+	}
+	if timeRange < time.Minute || timeRange > 30*24*time.Hour {
+		return 0, 0, fmt.Errorf("invalid range: %s must be between 1m and 30d", rangeValue)
+	}
+
+	step := timeRange / 60
+	if stepValue != "" {
+		var err error
+		if step, err = parseMetricsDuration(stepValue); err != nil {
+			return 0, 0, fmt.Errorf("invalid step: %w", err)
+		}
+	}
+	step = max(step.Round(time.Minute), time.Minute)
+	if timeRange/step > maxFlowMetricsPoints {
+		return 0, 0, fmt.Errorf("invalid step: %s gives more than %d points", step, maxFlowMetricsPoints)
+	}
+
+	return timeRange, step, nil
+}
+
+func parseMetricsDuration(value string) (time.Duration, error) {
+	if days, ok := strings.CutSuffix(value, "d"); ok {
+		n, err := strconv.Atoi(days)
+		if err != nil {
+			return 0, fmt.Errorf("unsupported duration: %s", value)
+		}
+		return time.Duration(n) * 24 * time.Hour, nil
+	}
+
+	duration, err := time.ParseDuration(value)
+	if err != nil {
+		return 0, fmt.Errorf("unsupported duration: %s", value)
+	}
+	return duration, nil
+}
@@ -2,11 +2,13 @@ package coordinator
 
 import (
 	"context"
+	"time"
 
 	"github.com/rs/zerolog/log"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 	"google.golang.org/protobuf/types/known/emptypb"
+	"google.golang.org/protobuf/types/known/timestamppb"
 
 	"github.com/sananguliyev/airtruct/internal/persistence"
 	pb "github.com/sananguliyev/airtruct/internal/protogen"
@@ -138,6 +140,8 @@ func (c *CoordinatorAPI) UpdateRateLimit(_ context.Context, in *pb.RateLimit) (*
 		log.Error().Err(err).Msg("Failed to update rate limit")
 		return nil, status.Error(codes.Internal, err.Error())
 	}
+	c.rateLimiterEngine.Invalidate(rateLimit.Label)
+	c.rateLimiterEngine.Invalidate(newRateLimit.Label)
 
 	return &pb.RateLimitResponse{
 		Data: newRateLimit.ToProto(),
@@ -163,6 +167,7 @@ func (c *CoordinatorAPI) DeleteRateLimit(_ context.Context, in *pb.GetRateLimitR
 		log.Error().Err(err).Msg("Failed to delete rate limit")
 		return nil, status.Error(codes.Internal, err.Error())
 	}
+	c.rateLimiterEngine.Invalidate(rateLimit.Label)
 
 	return &pb.CommonResponse{
 		Message: "Rate limit has been deleted successfully",
@@ -187,5 +192,157 @@ func (c *CoordinatorAPI) CheckRateLimit(_ context.Context, in *pb.RateLimitCheck
 		Remaining:    result.Remaining,
 		Limit:        result.Limit,
 		ResetAt:      result.ResetAt,
+		LeaseId:      result.LeaseID,
 	}, nil
 }
+
+func (c *CoordinatorAPI) ReleaseRateLimit(_ context.Context, in *pb.RateLimitReleaseRequest) (*pb.CommonResponse, error) {
+	if err := in.Validate(); err != nil {
+		log.Debug().Err(err).Msg("Invalid request")
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	if err := c.rateLimiterEngine.Release(in.GetLabel(), in.GetKey(), in.GetLeaseId()); err != nil {
+		log.Error().Err(err).Str("label", in.GetLabel()).Str("key", in.GetKey()).Msg("Failed to release rate limit lease")
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &pb.CommonResponse{
+		Message: "Rate limit lease has been released successfully",
+	}, nil
+}
+
+func (c *CoordinatorAPI) ListRateLimitKeys(_ context.Context, in *pb.ListRateLimitKeysRequest) (*pb.ListRateLimitKeysResponse, error) {
+	if err := in.Validate(); err != nil {
+		log.Debug().Err(err).Msg("Invalid request")
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	rateLimit, err := c.findRateLimit(in.GetId())
+	if err != nil {
+		return nil, err
+	}
+
+	limit := int(in.GetLimit())
+	if limit <= 0 || limit > 100 {
+		limit = 50
+	}
+
+	keys, total, err := c.rateLimiterEngine.Keys(rateLimit.Label, in.GetKey(), limit, int(in.GetOffset()))
+	if err != nil {
+		log.Error().Err(err).Str("label", rateLimit.Label).Msg("Failed to list rate limit keys")
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	result := &pb.ListRateLimitKeysResponse{
+		Data:  make([]*pb.RateLimitKey, len(keys)),
+		Total: total,
+	}
+	for i, key := range keys {
+		result.Data[i] = &pb.RateLimitKey{
+			Key:          key.Key,
+			Remaining:    key.Remaining,
+			Limit:        key.Limit,
+			ResetAt:      key.ResetAt,
+			LastRefillAt: timestamppb.New(key.LastRefillAt),
+			UpdatedAt:    timestamppb.New(key.UpdatedAt),
+		}
+	}
+
+	return result, nil
+}
+
+func (c *CoordinatorAPI) ResetRateLimit(_ context.Context, in *pb.ResetRateLimitRequest) (*pb.CommonResponse, error) {
+	if err := in.Validate(); err != nil {
+		log.Debug().Err(err).Msg("Invalid request")
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	rateLimit, err := c.findRateLimit(in.GetId())
+	if err != nil {
+		return nil, err
+	}
+
+	if err := c.rateLimiterEngine.Reset(rateLimit.Label, in.GetKey()); err != nil {
+		log.Error().Err(err).Str("label", rateLimit.Label).Msg("Failed to reset rate limit")
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	message := "Rate limit has been reset successfully"
+	if in.GetKey() != "" {
+		message = "Rate limit key has been reset successfully"
+	}
+	return &pb.CommonResponse{Message: message}, nil
+}
+
+func (c *CoordinatorAPI) GetRateLimitStats(_ context.Context, in *pb.GetRateLimitStatsRequest) (*pb.GetRateLimitStatsResponse, error) {
+	if err := in.Validate(); err != nil {
+		log.Debug().Err(err).Msg("Invalid request")
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	rateLimit, err := c.findRateLimit(in.GetId())
+	if err != nil {
+		return nil, err
+	}
+
+	hours := in.GetHours()
+	if hours == 0 {
+		hours = 24
+	}
+	step := rateLimitStatsStep(hours)
+	since := time.Now().Add(-time.Duration(hours) * time.Hour).Truncate(step)
+
+	counters, err := c.rateLimiterEngine.Stats(rateLimit.Label, since)
+	if err != nil {
+		log.Error().Err(err).Str("label", rateLimit.Label).Msg("Failed to get rate limit stats")
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	// Minute counters are summed into steps, steps without checks are reported as zero so charts stay
+	// continuous.
+	points := make(map[int64]*pb.GetRateLimitStatsResponse_Point)
+	result := &pb.GetRateLimitStatsResponse{}
+	for t := since; !t.After(time.Now()); t = t.Add(step) {
+		point := &pb.GetRateLimitStatsResponse_Point{Timestamp: t.UTC().Format(time.RFC3339)}
+		points[t.Unix()] = point
+		result.Data = append(result.Data, point)
+	}
+	for _, counter := range counters {
+		point, ok := points[counter.Bucket.Truncate(step).Unix()]
+		if !ok {
+			continue
+		}
+		point.Allowed += counter.Allowed
+		point.Denied += counter.Denied
+		result.TotalAllowed += counter.Allowed
+		result.TotalDenied += counter.Denied
+	}
+
+	return result, nil
+}
+
+// rateLimitStatsStep returns the width of the chart points, about a hundred per range.
+func rateLimitStatsStep(hours int64) time.Duration {
+	switch {
+	case hours <= 2:
+		return time.Minute
+	case hours <= 12:
+		return 5 * time.Minute
+	case hours <= 48:
+		return 15 * time.Minute
+	default:
+		return time.Hour
+	}
+}
+
+func (c *CoordinatorAPI) findRateLimit(id int64) (*persistence.RateLimit, error) {
+	rateLimit, err := c.rateLimitRepo.FindByID(id)
+	if err != nil {
+		log.Error().Err(err).Msg("Failed to find rate limit")
+		return nil, status.Error(codes.Internal, err.Error())
+	} else if rateLimit == nil {
+		return nil, status.Error(codes.NotFound, "Rate limit not found")
+	}
+	return rateLimit, nil
+}
@@ -0,0 +1,242 @@
+package coordinator
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strings"
+	"time"
+
+	coordinatorexecutor "github.com/sananguliyev/airtruct/internal/executor/coordinator"
+	"github.com/sananguliyev/airtruct/internal/persistence"
+	pb "github.com/sananguliyev/airtruct/internal/protogen"
+
+	"github.com/grpc-ecosystem/grpc-gateway/v2/runtime"
+	"github.com/grpc-ecosystem/grpc-gateway/v2/utilities"
+	"github.com/rs/zerolog/log"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+)
+
+// tailKeepAlive is how often an idle live tail over HTTP sends a comment so proxies keep it open.
+const tailKeepAlive = 15 * time.Second
+
+func (c *CoordinatorAPI) TailEvents(in *pb.TailEventsRequest, stream grpc.ServerStreamingServer[pb.TailEventsResponse]) error {
+	subscription, err := c.subscribeEvents(in)
+	if err != nil {
+		return err
+	}
+	defer subscription.Close()
+
+	return streamTail(stream.Context(), subscription, func(event *persistence.Event) error {
+		return stream.Send(tailEventsResponse(subscription, event))
+	})
+}
+
+// TailEventsHTTP serves TailEvents as server-sent events, the data of each is a TailEventsResponse in JSON.
+func (c *CoordinatorAPI) TailEventsHTTP(w http.ResponseWriter, r *http.Request) {
+	in := &pb.TailEventsRequest{}
+	if err := parseTailRequest(r, in); err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	subscription, err := c.subscribeEvents(in)
+	if err != nil {
+		http.Error(w, status.Convert(err).Message(), runtime.HTTPStatusFromCode(status.Code(err)))
+		return
+	}
+	defer subscription.Close()
+
+	serveTail(w, r, subscription, func(event *persistence.Event) proto.Message {
+		return tailEventsResponse(subscription, event)
+	})
+}
+
+// subscribeEvents validates the request and subscribes to the events it selects.
+func (c *CoordinatorAPI) subscribeEvents(in *pb.TailEventsRequest) (*coordinatorexecutor.TailSubscription[*persistence.Event], error) {
+	if err := in.Validate(); err != nil {
+		log.Debug().Err(err).Msg("Invalid request")
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	flowID, err := c.resolveTailFlow(in.GetFlowId(), in.GetFlowVersionId())
+	if err != nil {
+		return nil, err
+	}
+
+	filter := coordinatorexecutor.EventTailFilter{
+		FlowID:         flowID,
+		FlowVersionID:  in.GetFlowVersionId(),
+		WorkerFlowID:   in.GetWorkerFlowId(),
+		Section:        in.GetSection(),
+		ComponentLabel: in.GetComponentLabel(),
+		Type:           persistence.EventType(in.GetType()),
+		TraceID:        in.GetTraceId(),
+		Search:         strings.TrimSpace(in.GetSearch()),
+	}
+	return c.eventHub.Subscribe(filter.Matches, coordinatorexecutor.TailBufferSize), nil
+}
+
+func tailEventsResponse(subscription *coordinatorexecutor.TailSubscription[*persistence.Event], event *persistence.Event) *pb.TailEventsResponse {
+	return &pb.TailEventsResponse{
+		Event:   eventToProto(event),
+		Dropped: subscription.TakeDropped(),
+	}
+}
+
+func (c *CoordinatorAPI) TailFlowLogs(in *pb.TailFlowLogsRequest, stream grpc.ServerStreamingServer[pb.TailFlowLogsResponse]) error {
+	subscription, err := c.subscribeFlowLogs(in)
+	if err != nil {
+		return err
+	}
+	defer subscription.Close()
+
+	return streamTail(stream.Context(), subscription, func(line *persistence.FlowLog) error {
+		return stream.Send(tailFlowLogsResponse(subscription, line))
+	})
+}
+
+// TailFlowLogsHTTP serves TailFlowLogs as server-sent events, the data of each is a TailFlowLogsResponse in JSON.
+func (c *CoordinatorAPI) TailFlowLogsHTTP(w http.ResponseWriter, r *http.Request) {
+	in := &pb.TailFlowLogsRequest{}
+	if err := parseTailRequest(r, in); err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	subscription, err := c.subscribeFlowLogs(in)
+	if err != nil {
+		http.Error(w, status.Convert(err).Message(), runtime.HTTPStatusFromCode(status.Code(err)))
+		return
+	}
+	defer subscription.Close()
+
+	serveTail(w, r, subscription, func(line *persistence.FlowLog) proto.Message {
+		return tailFlowLogsResponse(subscription, line)
+	})
+}
+
+// subscribeFlowLogs validates the request and subscribes to the log lines it selects.
+func (c *CoordinatorAPI) subscribeFlowLogs(in *pb.TailFlowLogsRequest) (*coordinatorexecutor.TailSubscription[*persistence.FlowLog], error) {
+	if err := in.Validate(); err != nil {
+		log.Debug().Err(err).Msg("Invalid request")
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	flowID, err := c.resolveTailFlow(in.GetFlowId(), in.GetFlowVersionId())
+	if err != nil {
+		return nil, err
+	}
+
+	filter := coordinatorexecutor.LogTailFilter{
+		FlowID:        flowID,
+		FlowVersionID: in.GetFlowVersionId(),
+		WorkerID:      in.GetWorkerId(),
+		WorkerFlowID:  in.GetWorkerFlowId(),
+		MinLevel:      in.GetLevel(),
+		Search:        strings.TrimSpace(in.GetSearch()),
+	}
+	return c.logHub.Subscribe(filter.Matches, coordinatorexecutor.TailBufferSize), nil
+}
+
+func tailFlowLogsResponse(subscription *coordinatorexecutor.TailSubscription[*persistence.FlowLog], line *persistence.FlowLog) *pb.TailFlowLogsResponse {
+	return &pb.TailFlowLogsResponse{
+		Log:     line.ToProto(),
+		Dropped: subscription.TakeDropped(),
+	}
+}
+
+// resolveTailFlow returns the root ID of the flow a live tail follows, which the tail filters compare with. A
+// version of the flow may be passed as the flow, and the version, when set, has to belong to it.
+func (c *CoordinatorAPI) resolveTailFlow(flowID, versionID int64) (int64, error) {
+	flow, err := c.flowRepo.FindByID(flowID)
+	if err != nil {
+		log.Error().Err(err).Int64("flow_id", flowID).Msg("failed to find flow")
+		return 0, status.Error(codes.Internal, "failed to find flow")
+	}
+	if flow == nil {
+		return 0, status.Error(codes.NotFound, "flow not found")
+	}
+
+	rootID := flow.ID
+	if flow.ParentID != nil {
+		rootID = *flow.ParentID
+	}
+
+	if versionID != 0 && versionID != rootID {
+		version, err := c.flowRepo.FindByID(versionID)
+		if err != nil {
+			log.Error().Err(err).Int64("flow_id", versionID).Msg("failed to find flow version")
+			return 0, status.Error(codes.Internal, "failed to find flow version")
+		}
+		if version == nil || version.ParentID == nil || *version.ParentID != rootID {
+			return 0, status.Error(codes.InvalidArgument, "flow version does not belong to the flow")
+		}
+	}
+	return rootID, nil
+}
+
+// parseTailRequest fills the request from the flow_id of the path and the query parameters.
+func parseTailRequest(r *http.Request, in proto.Message) error {
+	query := r.URL.Query()
+	query.Set("flow_id", r.PathValue("flow_id"))
+	return runtime.PopulateQueryParameters(in, query, utilities.NewDoubleArray(nil))
+}
+
+// streamTail sends the items of the subscription until ctx is done or sending fails.
+func streamTail[T any](ctx context.Context, subscription *coordinatorexecutor.TailSubscription[T], send func(T) error) error {
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case item := <-subscription.Items():
+			if err := send(item); err != nil {
+				return err
+			}
+		}
+	}
+}
+
+// serveTail writes the items of the subscription as server-sent events until the client goes away.
+func serveTail[T any](w http.ResponseWriter, r *http.Request, subscription *coordinatorexecutor.TailSubscription[T], message func(T) proto.Message) {
+	controller := http.NewResponseController(w)
+	w.Header().Set("Content-Type", "text/event-stream")
+	w.Header().Set("Cache-Control", "no-cache")
+	w.Header().Set("X-Accel-Buffering", "no")
+	w.WriteHeader(http.StatusOK)
+	if err := controller.Flush(); err != nil {
+		log.Debug().Err(err).Msg("Live tail can not be streamed")
+		return
+	}
+
+	marshaler := protojson.MarshalOptions{EmitUnpopulated: true}
+	keepAlive := time.NewTicker(tailKeepAlive)
+	defer keepAlive.Stop()
+
+	for {
+		select {
+		case <-r.Context().Done():
+			return
+		case <-keepAlive.C:
+			if _, err := fmt.Fprint(w, ": keep-alive\n\n"); err != nil {
+				return
+			}
+		case item := <-subscription.Items():
+			data, err := marshaler.Marshal(message(item))
+			if err != nil {
+				log.Error().Err(err).Msg("failed to marshal tailed item")
+				continue
+			}
+			if _, err := fmt.Fprintf(w, "data: %s\n\n", data); err != nil {
+				return
+			}
+		}
+		if err := controller.Flush(); err != nil {
+			return
+		}
+	}
+}
@@ -7,6 +7,7 @@ import (
 
 	"time"
 
+	"github.com/sananguliyev/airtruct/internal/metrics"
 	"github.com/sananguliyev/airtruct/internal/persistence"
 	pb "github.com/sananguliyev/airtruct/internal/protogen"
 
@@ -92,6 +93,7 @@ func (c *CoordinatorAPI) DeregisterWorker(_ context.Context, in *pb.DeregisterWo
 		log.Error().Err(err).Str("worker_id", in.GetId()).Msg("Failed to deregister")
 		return nil, status.Error(codes.Internal, "failed to deregister worker")
 	}
+	metrics.WorkerHeartbeats.Forget(in.GetId())
 
 	return &pb.CommonResponse{Message: "Worker deregistered successfully"}, nil
 }
@@ -121,6 +123,7 @@ func (c *CoordinatorAPI) Heartbeat(ctx context.Context, in *pb.HeartbeatRequest)
 		log.Error().Err(err).Str("worker_id", in.GetId()).Msg("Failed to update worker heartbeat")
 		return nil, status.Error(codes.Internal, "failed to update worker heartbeat")
 	}
+	metrics.WorkerHeartbeats.Observe(workerEntity.ID)
 
 	response := &pb.HeartbeatResponse{
 		Message:                     "Heartbeat acknowledged",
@@ -174,6 +177,9 @@ func (c *CoordinatorAPI) Heartbeat(ctx context.Context, in *pb.HeartbeatRequest)
 		response.RenewedLeaseWorkerFlowIds = append(response.RenewedLeaseWorkerFlowIds, workerFlowID)
 	}
 
+	metrics.FlowLeaseRenewals.WithLabelValues(in.GetId()).Add(float64(len(response.RenewedLeaseWorkerFlowIds)))
+	metrics.FlowLeaseExpiries.WithLabelValues(in.GetId()).Add(float64(len(response.ExpiredLeaseWorkerFlowIds)))
+
 	log.Debug().
 		Str("worker_id", in.GetId()).
 		Str("address", workerEntity.Address).
@@ -7,6 +7,7 @@ import (
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 
+	"github.com/sananguliyev/airtruct/internal/alerting"
 	"github.com/sananguliyev/airtruct/internal/persistence"
 	pb "github.com/sananguliyev/airtruct/internal/protogen"
 )
@@ -41,6 +42,13 @@ func (c *CoordinatorAPI) UpdateWorkerFlowStatus(_ context.Context, in *pb.Worker
 		if workerFlow.Flow.ParentID != nil {
 			c.flowWorkerMap.RemoveFlowIfMatches(*workerFlow.Flow.ParentID, workerFlow.ID)
 		}
+		if newStatus == persistence.WorkerFlowStatusFailed {
+			c.alertManager.Trigger(alerting.Event{
+				Type:     persistence.AlertRuleTypeFlowFailed,
+				FlowID:   workerFlow.FlowID,
+				WorkerID: workerFlow.WorkerID,
+			})
+		}
 	case persistence.WorkerFlowStatusCompleted:
 		c.flowWorkerMap.RemoveFlowIfMatches(workerFlow.FlowID, workerFlow.ID)
 		if workerFlow.Flow.ParentID != nil {
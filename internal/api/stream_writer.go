@@ -0,0 +1,67 @@
+package api
+
+import (
+	"net/http"
+
+	"google.golang.org/grpc"
+
+	pb "github.com/sananguliyev/airtruct/internal/protogen"
+	"github.com/sananguliyev/airtruct/internal/utils"
+)
+
+// streamResponseWriter sends the response of a flow over an IngestStream call: the status code and headers
+// once, in a head frame, followed by a chunk frame for every write.
+type streamResponseWriter struct {
+	stream     grpc.ServerStreamingServer[pb.IngestStreamResponse]
+	header     http.Header
+	headerSent bool
+	err        error
+}
+
+func newStreamResponseWriter(stream grpc.ServerStreamingServer[pb.IngestStreamResponse]) *streamResponseWriter {
+	return &streamResponseWriter{
+		stream: stream,
+		header: make(http.Header),
+	}
+}
+
+func (w *streamResponseWriter) Header() http.Header {
+	return w.header
+}
+
+func (w *streamResponseWriter) WriteHeader(statusCode int) {
+	if w.headerSent || w.err != nil {
+		return
+	}
+	w.headerSent = true
+
+	w.err = w.stream.Send(&pb.IngestStreamResponse{
+		Frame: &pb.IngestStreamResponse_Head{
+			Head: &pb.IngestResponseHead{
+				StatusCode: int32(statusCode),
+				Headers:    utils.HeadersToProto(w.header),
+			},
+		},
+	})
+}
+
+func (w *streamResponseWriter) Write(p []byte) (int, error) {
+	w.WriteHeader(http.StatusOK)
+	if w.err != nil {
+		return 0, w.err
+	}
+	if len(p) == 0 {
+		return 0, nil
+	}
+
+	if w.err = w.stream.Send(&pb.IngestStreamResponse{
+		Frame: &pb.IngestStreamResponse_Chunk{Chunk: p},
+	}); w.err != nil {
+		return 0, w.err
+	}
+	return len(p), nil
+}
+
+// Flush is a no-op since every write is sent right away, it lets handlers that require an
+// http.Flusher stream their response.
+func (w *streamResponseWriter) Flush() {}
@@ -0,0 +1,86 @@
+package api
+
+import (
+	"errors"
+	"net/http"
+	"testing"
+
+	"google.golang.org/grpc"
+
+	pb "github.com/sananguliyev/airtruct/internal/protogen"
+)
+
+type fakeIngestStream struct {
+	grpc.ServerStream
+	frames []*pb.IngestStreamResponse
+	err    error
+}
+
+func (s *fakeIngestStream) Send(frame *pb.IngestStreamResponse) error {
+	if s.err != nil {
+		return s.err
+	}
+	s.frames = append(s.frames, frame)
+	return nil
+}
+
+func TestStreamResponseWriterSendsHeadOnceThenChunks(t *testing.T) {
+	stream := &fakeIngestStream{}
+	w := newStreamResponseWriter(stream)
+
+	w.Header().Set("Content-Type", "text/event-stream")
+	w.WriteHeader(http.StatusAccepted)
+	w.WriteHeader(http.StatusInternalServerError)
+
+	for _, chunk := range []string{"first", "", "second"} {
+		n, err := w.Write([]byte(chunk))
+		if err != nil || n != len(chunk) {
+			t.Fatalf("Write(%q) = %d, %v", chunk, n, err)
+		}
+	}
+	w.Flush()
+
+	if len(stream.frames) != 3 {
+		t.Fatalf("expected 3 frames, got %d", len(stream.frames))
+	}
+	head := stream.frames[0].GetHead()
+	if head == nil || head.GetStatusCode() != http.StatusAccepted {
+		t.Fatalf("expected head frame with status 202, got %v", stream.frames[0])
+	}
+	if values := head.GetHeaders()["Content-Type"].GetValues(); len(values) != 1 || values[0] != "text/event-stream" {
+		t.Errorf("unexpected head headers: %v", head.GetHeaders())
+	}
+	if string(stream.frames[1].GetChunk()) != "first" || string(stream.frames[2].GetChunk()) != "second" {
+		t.Errorf("unexpected chunks: %q, %q", stream.frames[1].GetChunk(), stream.frames[2].GetChunk())
+	}
+}
+
+func TestStreamResponseWriterDefaultsToStatusOK(t *testing.T) {
+	stream := &fakeIngestStream{}
+	w := newStreamResponseWriter(stream)
+
+	if _, err := w.Write([]byte("body")); err != nil {
+		t.Fatalf("failed to write: %v", err)
+	}
+	if status := stream.frames[0].GetHead().GetStatusCode(); status != http.StatusOK {
+		t.Errorf("expected status 200, got %d", status)
+	}
+}
+
+func TestStreamResponseWriterStopsAfterSendError(t *testing.T) {
+	sendErr := errors.New("stream closed")
+	stream := &fakeIngestStream{err: sendErr}
+	w := newStreamResponseWriter(stream)
+
+	if _, err := w.Write([]byte("body")); !errors.Is(err, sendErr) {
+		t.Fatalf("expected send error, got %v", err)
+	}
+
+	stream.err = nil
+	if _, err := w.Write([]byte("more")); !errors.Is(err, sendErr) {
+		t.Errorf("expected the first error to stick, got %v", err)
+	}
+	if len(stream.frames) != 0 {
+		t.Errorf("expected no frames after the error, got %d", len(stream.frames))
+	}
+}
@@ -2,14 +2,18 @@ package api
 
 import (
 	"context"
+	"net/http"
 
 	"github.com/rs/zerolog/log"
 	pb "github.com/sananguliyev/airtruct/internal/protogen"
+	"google.golang.org/grpc"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 	"google.golang.org/protobuf/types/known/emptypb"
 
 	"github.com/sananguliyev/airtruct/internal/executor"
+	"github.com/sananguliyev/airtruct/internal/mcp"
+	"github.com/sananguliyev/airtruct/internal/utils"
 )
 
 type WorkerAPI struct {
@@ -82,14 +86,8 @@ func (a *WorkerAPI) Ingest(ctx context.Context, in *pb.IngestRequest) (*pb.Inges
 		Int64("worker_flow_id", in.GetWorkerFlowId()).
 		Bytes("data", in.GetPayload()).
 		Msg("Ingesting data")
-	ingestResult, err := a.workerExecutor.IngestData(
-		ctx,
-		in.GetWorkerFlowId(),
-		in.GetMethod(),
-		in.GetPath(),
-		in.GetContentType(),
-		in.GetPayload(),
-	)
+
+	ingestResult, err := a.workerExecutor.IngestData(ctx, in.GetWorkerFlowId(), ingestRequestFromProto(in))
 	if err != nil {
 		log.Error().
 			Err(err).
@@ -101,5 +99,52 @@ func (a *WorkerAPI) Ingest(ctx context.Context, in *pb.IngestRequest) (*pb.Inges
 	return &pb.IngestResponse{
 		StatusCode: int32(ingestResult.StatusCode),
 		Response:   ingestResult.Response,
+		Headers:    utils.HeadersToProto(ingestResult.Headers),
 	}, nil
 }
+
+func (a *WorkerAPI) IngestStream(in *pb.IngestRequest, stream grpc.ServerStreamingServer[pb.IngestStreamResponse]) error {
+	log.Debug().
+		Int64("worker_flow_id", in.GetWorkerFlowId()).
+		Bytes("data", in.GetPayload()).
+		Msg("Ingesting data as stream")
+
+	w := newStreamResponseWriter(stream)
+	err := a.workerExecutor.IngestStream(stream.Context(), in.GetWorkerFlowId(), ingestRequestFromProto(in), w)
+	if err != nil {
+		log.Error().
+			Err(err).
+			Int64("worker_flow_id", in.GetWorkerFlowId()).
+			Msg("Failed to ingest data")
+		return status.Errorf(codes.Internal, "Failed to ingest data: %v", err)
+	}
+
+	// Handlers that don't write anything still answer with a status code.
+	w.WriteHeader(http.StatusOK)
+	if w.err != nil {
+		log.Error().
+			Err(w.err).
+			Int64("worker_flow_id", in.GetWorkerFlowId()).
+			Msg("Failed to stream response")
+		return status.Errorf(codes.Unavailable, "Failed to stream response: %v", w.err)
+	}
+
+	return nil
+}
+
+func ingestRequestFromProto(in *pb.IngestRequest) *executor.IngestRequest {
+	headers := utils.HeadersFromProto(in.GetHeaders())
+	if in.GetMcpCallId() != "" {
+		headers.Set(mcp.CallIDHeader, in.GetMcpCallId())
+	}
+
+	return &executor.IngestRequest{
+		Method:      in.GetMethod(),
+		Path:        in.GetPath(),
+		Query:       in.GetQuery(),
+		ContentType: in.GetContentType(),
+		RemoteAddr:  in.GetRemoteAddr(),
+		Headers:     headers,
+		Payload:     in.GetPayload(),
+	}
+}
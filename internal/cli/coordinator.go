@@ -2,6 +2,7 @@ package cli
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"net"
 	"net/http"
@@ -12,6 +13,7 @@ import (
 	"github.com/sananguliyev/airtruct/internal/api/coordinator"
 	"github.com/sananguliyev/airtruct/internal/auth"
 	"github.com/sananguliyev/airtruct/internal/executor"
+	"github.com/sananguliyev/airtruct/internal/metrics"
 	pb "github.com/sananguliyev/airtruct/internal/protogen"
 	_ "github.com/sananguliyev/airtruct/internal/statik"
 
@@ -19,6 +21,7 @@ import (
 	"github.com/rakyll/statik/fs"
 	"github.com/rs/cors"
 	"github.com/rs/zerolog/log"
+	"go.opentelemetry.io/contrib/instrumentation/net/http/otelhttp"
 	"golang.org/x/sync/errgroup"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/credentials/insecure"
@@ -29,21 +32,44 @@ type MCPSyncer interface {
 	SyncTools()
 }
 
+// RateLimiterEngine is the part of the rate limiter engine maintained in the background.
+type RateLimiterEngine interface {
+	Cleanup(time.Duration) error
+	Flush() error
+}
+
+// RetentionStore keeps records with a retention, e.g. metrics and events, expired ones are dropped in the
+// background.
+type RetentionStore interface {
+	DeleteExpired() error
+}
+
+// AlertEvaluator evaluates the alert rules that are not fired by events, e.g. error rates over a window.
+type AlertEvaluator interface {
+	Evaluate(context.Context) error
+}
+
 type CoordinatorCLI struct {
 	api                *coordinator.CoordinatorAPI
 	executor           executor.CoordinatorExecutor
-	rateLimiterEngine  interface{ Cleanup(time.Duration) error }
+	rateLimiterEngine  RateLimiterEngine
+	retentionStores    []RetentionStore
+	alertEvaluator     AlertEvaluator
+	alertInterval      time.Duration
 	authManager        *auth.Manager
 	mcpHandler         http.Handler
 	mcpSyncer          MCPSyncer
 	httpPort, grpcPort uint32
+	// metricsPort serves /metrics on its own port when it is not zero, otherwise /metrics is served on the
+	// http port behind authentication.
+	metricsPort uint32
 }
 
-func NewCoordinatorCLI(api *coordinator.CoordinatorAPI, executor executor.CoordinatorExecutor, rateLimiterEngine interface{ Cleanup(time.Duration) error }, authManager *auth.Manager, mcpHandler interface {
+func NewCoordinatorCLI(api *coordinator.CoordinatorAPI, executor executor.CoordinatorExecutor, rateLimiterEngine RateLimiterEngine, retentionStores []RetentionStore, alertEvaluator AlertEvaluator, alertInterval time.Duration, authManager *auth.Manager, mcpHandler interface {
 	http.Handler
 	MCPSyncer
-}, httpPort, grpcPort uint32) *CoordinatorCLI {
-	return &CoordinatorCLI{api, executor, rateLimiterEngine, authManager, mcpHandler, mcpHandler, httpPort, grpcPort}
+}, httpPort, grpcPort, metricsPort uint32) *CoordinatorCLI {
+	return &CoordinatorCLI{api, executor, rateLimiterEngine, retentionStores, alertEvaluator, alertInterval, authManager, mcpHandler, mcpHandler, httpPort, grpcPort, metricsPort}
 }
 
 func (c *CoordinatorCLI) Run(ctx context.Context) {
@@ -123,6 +149,62 @@ func (c *CoordinatorCLI) Run(ctx context.Context) {
 		}
 	})
 
+	retentionTicker := time.NewTicker(1 * time.Hour)
+	defer retentionTicker.Stop()
+
+	g.Go(func() error {
+		for {
+			select {
+			case <-ctx.Done():
+				log.Info().Msg("Stopping retention cleanup routine...")
+				return ctx.Err()
+			case <-retentionTicker.C:
+				for _, store := range c.retentionStores {
+					if err := store.DeleteExpired(); err != nil {
+						log.Error().Err(err).Msg("Failed to delete expired records")
+					}
+				}
+			}
+		}
+	})
+
+	alertTicker := time.NewTicker(c.alertInterval)
+	defer alertTicker.Stop()
+
+	g.Go(func() error {
+		for {
+			select {
+			case <-ctx.Done():
+				log.Info().Msg("Stopping alert evaluation routine...")
+				return ctx.Err()
+			case <-alertTicker.C:
+				if err := c.alertEvaluator.Evaluate(ctx); err != nil {
+					log.Error().Err(err).Msg("Failed to evaluate alert rules")
+				}
+			}
+		}
+	})
+
+	flushTicker := time.NewTicker(1 * time.Second)
+	defer flushTicker.Stop()
+
+	g.Go(func() error {
+		for {
+			select {
+			case <-ctx.Done():
+				log.Info().Msg("Stopping rate limit state flush routine...")
+				if err := c.rateLimiterEngine.Flush(); err != nil {
+					log.Error().Err(err).Msg("Failed to flush rate limit states")
+				}
+				return ctx.Err()
+			case <-flushTicker.C:
+				if err := c.rateLimiterEngine.Flush(); err != nil {
+					log.Error().Err(err).Msg("Failed to flush rate limit states")
+				}
+			}
+		}
+	})
+
 	mcpSyncTicker := time.NewTicker(5 * time.Second)
 	defer mcpSyncTicker.Stop()
 
@@ -138,6 +220,43 @@ func (c *CoordinatorCLI) Run(ctx context.Context) {
 		}
 	})
 
+	if err := c.executor.SyncIngressRoutes(ctx); err != nil {
+		log.Error().Err(err).Msg("Failed to sync ingress routes")
+	}
+	routeSyncTicker := time.NewTicker(5 * time.Second)
+	defer routeSyncTicker.Stop()
+
+	g.Go(func() error {
+		for {
+			select {
+			case <-ctx.Done():
+				log.Info().Msg("Stopping ingress route sync routine...")
+				return ctx.Err()
+			case <-routeSyncTicker.C:
+				if err := c.executor.SyncIngressRoutes(ctx); err != nil {
+					log.Error().Err(err).Msg("Failed to sync ingress routes")
+				}
+			}
+		}
+	})
+
+	queueReplayTicker := time.NewTicker(5 * time.Second)
+	defer queueReplayTicker.Stop()
+
+	g.Go(func() error {
+		for {
+			select {
+			case <-ctx.Done():
+				log.Info().Msg("Stopping ingress queue replay routine...")
+				return ctx.Err()
+			case <-queueReplayTicker.C:
+				if err := c.executor.ReplayIngressQueue(ctx); err != nil {
+					log.Error().Err(err).Msg("Failed to replay ingress queue")
+				}
+			}
+		}
+	})
+
 	coordinatorServerAddress := fmt.Sprintf(":%d", c.grpcPort)
 	lis, err := net.Listen("tcp", coordinatorServerAddress)
 	if err != nil {
@@ -196,20 +315,30 @@ func (c *CoordinatorCLI) Run(ctx context.Context) {
 	protectedAPI := c.authManager.Middleware(mux)
 	mainMux.Handle("/api/v0/flows/validate", c.authManager.Middleware(http.HandlerFunc(c.api.ValidateFlowHTTP)))
 	mainMux.Handle("/api/v0/flows/try", c.authManager.Middleware(http.HandlerFunc(c.api.TryFlowHTTP)))
+	mainMux.Handle("GET /api/v0/flows/{flow_id}/events/tail", c.authManager.Middleware(http.HandlerFunc(c.api.TailEventsHTTP)))
+	mainMux.Handle("GET /api/v0/flows/{flow_id}/logs/tail", c.authManager.Middleware(http.HandlerFunc(c.api.TailFlowLogsHTTP)))
 	mainMux.Handle("/api/", http.StripPrefix("/api", protectedAPI))
-	mainMux.HandleFunc("/ingest/", func(w http.ResponseWriter, r *http.Request) {
-		statusCode, response, err := c.executor.ForwardRequestToWorker(r.Context(), r)
-		if err != nil {
-			log.Error().Err(err).Msg("failed to ingest flow")
-			http.Error(w, err.Error(), http.StatusInternalServerError)
+	ingestHandler := otelhttp.NewHandler(http.HandlerFunc(c.handleIngest), "ingest")
+	mcpHandler := otelhttp.NewHandler(c.mcpHandler, "mcp")
+	mainMux.Handle("/ingest/", ingestHandler)
+	mainMux.Handle("/mcp", mcpHandler)
+	mainMux.Handle("/mcp/", mcpHandler)
+	if c.metricsPort > 0 {
+		g.Go(func() error {
+			return serveMetrics(ctx, c.metricsPort, "Coordinator")
+		})
+	} else {
+		mainMux.Handle("/metrics", c.authManager.Middleware(metrics.Handler()))
+	}
+	spa := serveSpa(statikFS, "/index.html")
+	mainMux.HandleFunc("/", func(w http.ResponseWriter, r *http.Request) {
+		// Custom flow routes live next to the UI, anything that is not a route is served by the SPA.
+		if c.executor.HasIngressRoute(r.URL.Path) {
+			ingestHandler.ServeHTTP(w, r)
 			return
 		}
-		w.WriteHeader(int(statusCode))
-		w.Write(response)
+		spa(w, r)
 	})
-	mainMux.Handle("/mcp", c.mcpHandler)
-	mainMux.Handle("/mcp/", c.mcpHandler)
-	mainMux.HandleFunc("/", serveSpa(statikFS, "/index.html"))
 
 	httpServer := &http.Server{
 		Addr:    fmt.Sprintf("0.0.0.0:%d", c.httpPort),
@@ -252,6 +381,25 @@ func (c *CoordinatorCLI) Run(ctx context.Context) {
 	}
 }
 
+func (c *CoordinatorCLI) handleIngest(w http.ResponseWriter, r *http.Request) {
+	if err := c.executor.ForwardStreamToWorker(r.Context(), r, w); err != nil {
+		var ingressErr *executor.IngressError
+		if errors.As(err, &ingressErr) {
+			log.Debug().Err(err).Str("path", r.URL.Path).Msg("ingest request rejected")
+			for key, values := range ingressErr.Header {
+				for _, value := range values {
+					w.Header().Add(key, value)
+				}
+			}
+			http.Error(w, ingressErr.Message, ingressErr.StatusCode)
+			return
+		}
+		log.Error().Err(err).Msg("failed to ingest flow")
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+}
+
 // serveSpa serves a Single Page Application (SPA).
 // If the requested file exists in the filesystem, it serves that file.
 // Otherwise, it serves the specified index file (e.g., "index.html").
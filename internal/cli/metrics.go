@@ -0,0 +1,47 @@
+package cli
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/rs/zerolog/log"
+
+	"github.com/sananguliyev/airtruct/internal/metrics"
+)
+
+// serveMetrics serves /metrics on its own port until ctx is done. The port is meant to be reachable by the
+// metrics scraper only, so the endpoint does not require authentication.
+func serveMetrics(ctx context.Context, port uint32, node string) error {
+	metricsMux := http.NewServeMux()
+	metricsMux.Handle("/metrics", metrics.Handler())
+	metricsServer := &http.Server{
+		Addr:    fmt.Sprintf("0.0.0.0:%d", port),
+		Handler: metricsMux,
+	}
+
+	log.Info().Uint32("port", port).Msgf("%s metrics server starting", node)
+	errCh := make(chan error, 1)
+	go func() {
+		errCh <- metricsServer.ListenAndServe()
+	}()
+
+	select {
+	case err := <-errCh:
+		if err != nil && err != http.ErrServerClosed {
+			log.Error().Err(err).Msgf("%s metrics server failed", node)
+			return err
+		}
+		return nil
+	case <-ctx.Done():
+		shutdownCtx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+		defer cancel()
+		if err := metricsServer.Shutdown(shutdownCtx); err != nil {
+			log.Error().Err(err).Msgf("%s metrics server graceful shutdown failed", node)
+			return err
+		}
+		log.Info().Msgf("%s metrics server stopped gracefully.", node)
+		return ctx.Err()
+	}
+}
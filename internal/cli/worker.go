@@ -11,6 +11,7 @@ import (
 	pb "github.com/sananguliyev/airtruct/internal/protogen"
 
 	"github.com/rs/zerolog/log"
+	"go.opentelemetry.io/contrib/instrumentation/google.golang.org/grpc/otelgrpc"
 	"golang.org/x/sync/errgroup"
 	"google.golang.org/grpc"
 )
@@ -19,10 +20,12 @@ type WorkerCLI struct {
 	api      *api.WorkerAPI
 	executor executor.WorkerExecutor
 	grpcPort uint32
+	// metricsPort serves /metrics when it is not zero.
+	metricsPort uint32
 }
 
-func NewWorkerCLI(api *api.WorkerAPI, executor executor.WorkerExecutor, grpcPort uint32) *WorkerCLI {
-	return &WorkerCLI{api, executor, grpcPort}
+func NewWorkerCLI(api *api.WorkerAPI, executor executor.WorkerExecutor, grpcPort, metricsPort uint32) *WorkerCLI {
+	return &WorkerCLI{api, executor, grpcPort, metricsPort}
 }
 
 func (c *WorkerCLI) Run(ctx context.Context) {
@@ -37,7 +40,7 @@ func (c *WorkerCLI) Run(ctx context.Context) {
 		log.Fatal().Err(err).Uint32("port", c.grpcPort).Msg("failed to listen GRPC port")
 	}
 
-	grpcServer := grpc.NewServer()
+	grpcServer := grpc.NewServer(grpc.StatsHandler(otelgrpc.NewServerHandler()))
 	pb.RegisterWorkerServer(grpcServer, c.api)
 
 	grpcReady := make(chan struct{})
@@ -105,6 +108,19 @@ func (c *WorkerCLI) Run(ctx context.Context) {
 		return ctx.Err()
 	})
 
+	g.Go(func() error {
+		log.Info().Msg("Starting worker flow log shipping...")
+		c.executor.ShipFlowLogs(ctx)
+		log.Info().Msg("Worker flow log shipping stopped.")
+		return ctx.Err()
+	})
+
+	if c.metricsPort > 0 {
+		g.Go(func() error {
+			return serveMetrics(ctx, c.metricsPort, "Worker")
+		})
+	}
+
 	log.Info().Msg("Worker running. Press Ctrl+C to stop.")
 	if err := g.Wait(); err != nil && err != context.Canceled && err != context.DeadlineExceeded {
 		log.Error().Err(err).Msg("Worker encountered an error during run")
@@ -4,5 +4,7 @@ import (
 	_ "github.com/sananguliyev/airtruct/internal/components/ai_gateway"
 	_ "github.com/sananguliyev/airtruct/internal/components/cdc_mysql"
 	_ "github.com/sananguliyev/airtruct/internal/components/coordinator_ratelimit"
+	_ "github.com/sananguliyev/airtruct/internal/components/mcp_client"
+	_ "github.com/sananguliyev/airtruct/internal/components/mcp_progress"
 	_ "github.com/sananguliyev/airtruct/internal/components/shopify"
 )
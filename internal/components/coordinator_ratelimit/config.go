@@ -2,8 +2,57 @@ package coordinator_ratelimit
 
 import "github.com/warpstreamlabs/bento/public/service"
 
+const (
+	crlfBlockSize = "block_size"
+	crlfBlockTTL  = "block_ttl"
+)
+
 func Config() *service.ConfigSpec {
 	return service.NewConfigSpec().
 		Summary("Coordinator-based distributed rate limiter").
-		Description("A rate limiter that uses the coordinator for distributed rate limiting across all workers. This ensures rate limits are enforced globally across the entire worker pool.")
+		Description("A rate limiter that uses the coordinator for distributed rate limiting across all workers. This ensures rate limits are enforced globally across the entire worker pool.").
+		Field(service.NewIntField(crlfBlockSize).
+			Description("Number of tokens leased from the coordinator at once and spent locally, which saves a coordinator call per message. Tokens left when the block expires are lost, so keep it well below the limit. 0 checks every message with the coordinator.").
+			Default(0)).
+		Field(service.NewDurationField(crlfBlockTTL).
+			Description("How long a leased block of tokens can be spent.").
+			Default("1s"))
+}
+
+const (
+	crpfRateLimit = "rate_limit"
+	crpfKey       = "key"
+	crpfCost      = "cost"
+	crpfPolicy    = "policy"
+)
+
+const (
+	policyWait   = "wait"
+	policyDrop   = "drop"
+	policyReject = "reject"
+)
+
+func ProcessorConfig() *service.ConfigSpec {
+	return service.NewConfigSpec().
+		Beta().
+		Categories("Utility").
+		Summary("Checks every message against a coordinator rate limit with a key taken from the message.").
+		Description(`
+This processor checks each message with the coordinator against the rate limit with the given label. Unlike the coordinator rate limit resource, which counts every message against one key, the key and the cost are derived from the message, e.g. to limit each customer separately.
+
+When the limit is exceeded the message is held until it fits (` + "`wait`" + `), removed from the batch (` + "`drop`" + `) or flagged as failed so it can be handled with a ` + "`catch`" + ` processor (` + "`reject`" + `).
+
+Allowed messages get the metadata ` + "`rate_limit_remaining`" + `, ` + "`rate_limit_limit`" + ` and ` + "`rate_limit_reset_at`" + ` (unix seconds).`).
+		Field(service.NewStringField(crpfRateLimit).
+			Description("The label of the rate limit to check.")).
+		Field(service.NewInterpolatedStringField(crpfKey).
+			Description("The key the message is counted against.").
+			Default("global")).
+		Field(service.NewBloblangField(crpfCost).
+			Description("An optional Bloblang mapping which should evaluate to the number of tokens the message costs. Each message costs 1 when not set.").
+			Optional()).
+		Field(service.NewStringEnumField(crpfPolicy, policyWait, policyDrop, policyReject).
+			Description("What to do with a message that exceeds the limit.").
+			Default(policyWait)).
+		Version("1.0.0")
 }
@@ -0,0 +1,171 @@
+package coordinator_ratelimit
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"math"
+	"os"
+	"strconv"
+	"time"
+
+	"github.com/warpstreamlabs/bento/public/bloblang"
+	"github.com/warpstreamlabs/bento/public/service"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
+
+	pb "github.com/sananguliyev/airtruct/internal/protogen"
+)
+
+func init() {
+	err := service.RegisterProcessor(
+		"coordinator_rate_limit", ProcessorConfig(),
+		func(conf *service.ParsedConfig, mgr *service.Resources) (service.Processor, error) {
+			return NewProcessorFromConfig(conf, mgr)
+		})
+	if err != nil {
+		panic(err)
+	}
+}
+
+type Processor struct {
+	client    pb.CoordinatorClient
+	conn      *grpc.ClientConn
+	logger    *service.Logger
+	rateLimit string
+	key       *service.InterpolatedString
+	cost      *bloblang.Executor
+	policy    string
+}
+
+func NewProcessorFromConfig(conf *service.ParsedConfig, mgr *service.Resources) (*Processor, error) {
+	p := &Processor{
+		logger: mgr.Logger(),
+	}
+
+	var err error
+	if p.rateLimit, err = conf.FieldString(crpfRateLimit); err != nil {
+		return nil, err
+	}
+	if p.key, err = conf.FieldInterpolatedString(crpfKey); err != nil {
+		return nil, err
+	}
+	if conf.Contains(crpfCost) {
+		if p.cost, err = conf.FieldBloblang(crpfCost); err != nil {
+			return nil, err
+		}
+	}
+	if p.policy, err = conf.FieldString(crpfPolicy); err != nil {
+		return nil, err
+	}
+
+	coordinatorAddr := os.Getenv("DISCOVERY_URI")
+	if coordinatorAddr == "" {
+		coordinatorAddr = "localhost:50000"
+	}
+
+	p.conn, err = grpc.NewClient(coordinatorAddr, grpc.WithTransportCredentials(insecure.NewCredentials()))
+	if err != nil {
+		return nil, fmt.Errorf("failed to connect to coordinator: %w", err)
+	}
+	p.client = pb.NewCoordinatorClient(p.conn)
+
+	return p, nil
+}
+
+func (p *Processor) Process(ctx context.Context, msg *service.Message) (service.MessageBatch, error) {
+	key, err := p.key.TryString(msg)
+	if err != nil {
+		return nil, fmt.Errorf("failed to interpolate key: %w", err)
+	}
+	if key == "" {
+		key = "global"
+	}
+
+	cost, err := p.messageCost(msg)
+	if err != nil {
+		return nil, err
+	}
+
+	for {
+		resp, err := p.client.CheckRateLimit(ctx, &pb.RateLimitCheckRequest{
+			Label: p.rateLimit,
+			Key:   key,
+			Cost:  cost,
+		})
+		if err != nil {
+			return nil, fmt.Errorf("failed to check rate limit: %w", err)
+		}
+		if err := releaseLease(ctx, p.client, p.rateLimit, key, resp); err != nil {
+			return nil, err
+		}
+
+		if resp.Allowed {
+			msg.MetaSetMut("rate_limit_remaining", resp.Remaining)
+			msg.MetaSetMut("rate_limit_limit", resp.Limit)
+			msg.MetaSetMut("rate_limit_reset_at", resp.ResetAt)
+			return service.MessageBatch{msg}, nil
+		}
+
+		switch p.policy {
+		case policyDrop:
+			p.logger.Debugf("Dropping message exceeding rate limit %s for key %s", p.rateLimit, key)
+			return nil, nil
+		case policyReject:
+			return nil, fmt.Errorf("rate limit %s exceeded for key %s, retry after %dms", p.rateLimit, key, resp.RetryAfterMs)
+		}
+
+		if cost > resp.Limit {
+			return nil, fmt.Errorf("message cost %d exceeds the limit %d of rate limit %s", cost, resp.Limit, p.rateLimit)
+		}
+
+		wait := max(time.Duration(resp.RetryAfterMs)*time.Millisecond, time.Millisecond)
+		select {
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		case <-time.After(wait):
+		}
+	}
+}
+
+func (p *Processor) messageCost(msg *service.Message) (int64, error) {
+	if p.cost == nil {
+		return 1, nil
+	}
+
+	value, err := msg.BloblangQueryValue(p.cost)
+	if err != nil {
+		return 0, fmt.Errorf("failed to execute cost mapping: %w", err)
+	}
+
+	var cost float64
+	switch v := value.(type) {
+	case int64:
+		cost = float64(v)
+	case uint64:
+		cost = float64(v)
+	case float64:
+		cost = v
+	case json.Number:
+		if cost, err = v.Float64(); err != nil {
+			return 0, fmt.Errorf("cost must be a number, got %q", v)
+		}
+	case string:
+		if cost, err = strconv.ParseFloat(v, 64); err != nil {
+			return 0, fmt.Errorf("cost must be a number, got %q", v)
+		}
+	default:
+		return 0, fmt.Errorf("cost must be a number, got %T", value)
+	}
+	if cost < 0 {
+		return 0, fmt.Errorf("cost must not be negative, got %v", cost)
+	}
+	return int64(math.Ceil(cost)), nil
+}
+
+func (p *Processor) Close(ctx context.Context) error {
+	if p.conn != nil {
+		return p.conn.Close()
+	}
+	return nil
+}
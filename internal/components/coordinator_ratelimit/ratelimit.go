@@ -4,6 +4,7 @@ import (
 	"context"
 	"fmt"
 	"os"
+	"sync"
 	"time"
 
 	"github.com/warpstreamlabs/bento/public/service"
@@ -29,6 +30,17 @@ type RateLimit struct {
 	conn   *grpc.ClientConn
 	logger *service.Logger
 	label  string
+
+	blockSize int64
+	blockTTL  time.Duration
+	mu        sync.Mutex
+	blocks    map[string]*tokenBlock
+}
+
+// tokenBlock is a number of tokens of a key leased from the coordinator and spent locally until it expires.
+type tokenBlock struct {
+	tokens    int64
+	expiresAt time.Time
 }
 
 const rateLimitKeyContextKey = "rate_limit_key"
@@ -36,6 +48,15 @@ const rateLimitKeyContextKey = "rate_limit_key"
 func NewFromConfig(conf *service.ParsedConfig, mgr *service.Resources) (*RateLimit, error) {
 	label := mgr.Label()
 
+	blockSize, err := conf.FieldInt(crlfBlockSize)
+	if err != nil {
+		return nil, err
+	}
+	blockTTL, err := conf.FieldDuration(crlfBlockTTL)
+	if err != nil {
+		return nil, err
+	}
+
 	coordinatorAddr := os.Getenv("DISCOVERY_URI")
 	if coordinatorAddr == "" {
 		coordinatorAddr = "localhost:50000"
@@ -49,10 +70,13 @@ func NewFromConfig(conf *service.ParsedConfig, mgr *service.Resources) (*RateLim
 	client := pb.NewCoordinatorClient(conn)
 
 	return &RateLimit{
-		client: client,
-		conn:   conn,
-		logger: mgr.Logger(),
-		label:  label,
+		client:    client,
+		conn:      conn,
+		logger:    mgr.Logger(),
+		label:     label,
+		blockSize: int64(blockSize),
+		blockTTL:  blockTTL,
+		blocks:    make(map[string]*tokenBlock),
 	}, nil
 }
 
@@ -65,26 +89,104 @@ func (r *RateLimit) AccessWithCost(ctx context.Context, cost int64) (time.Durati
 	if ctxKey, ok := ctx.Value(rateLimitKeyContextKey).(string); ok && ctxKey != "" {
 		keyStr = ctxKey
 	}
+	if cost == 0 {
+		cost = 1
+	}
 
-	req := &pb.RateLimitCheckRequest{
-		Label: r.label,
-		Key:   keyStr,
-		Cost:  cost,
+	if r.spendFromBlock(keyStr, cost) {
+		return 0, nil
+	}
+
+	requested := cost
+	if r.blockSize > cost {
+		requested = r.blockSize
 	}
 
-	resp, err := r.client.CheckRateLimit(ctx, req)
+	resp, err := r.check(ctx, keyStr, requested)
+	if err == nil && !resp.Allowed && requested > cost {
+		// Close to the limit a whole block is not available anymore, fall back to the tokens of this message.
+		requested = cost
+		resp, err = r.check(ctx, keyStr, requested)
+	}
 	if err != nil {
 		return 0, fmt.Errorf("failed to check rate limit: %w", err)
 	}
+	if err := releaseLease(ctx, r.client, r.label, keyStr, resp); err != nil {
+		return 0, err
+	}
 
 	if !resp.Allowed {
 		waitDuration := time.Duration(resp.RetryAfterMs) * time.Millisecond
 		return waitDuration, nil
 	}
 
+	if requested > cost {
+		r.storeBlock(keyStr, requested-cost)
+	}
 	return 0, nil
 }
 
+func (r *RateLimit) check(ctx context.Context, key string, cost int64) (*pb.RateLimitCheckResponse, error) {
+	return r.client.CheckRateLimit(ctx, &pb.RateLimitCheckRequest{
+		Label: r.label,
+		Key:   key,
+		Cost:  cost,
+	})
+}
+
+// releaseLease returns the lease of a concurrency rate limit right away and fails the check. Flow components
+// cannot tell when a message is done, so the lease would otherwise be held until it expires.
+func releaseLease(ctx context.Context, client pb.CoordinatorClient, label, key string, resp *pb.RateLimitCheckResponse) error {
+	if resp.GetLeaseId() == "" {
+		return nil
+	}
+	_, err := client.ReleaseRateLimit(ctx, &pb.RateLimitReleaseRequest{
+		Label:   label,
+		Key:     key,
+		LeaseId: resp.GetLeaseId(),
+	})
+	if err != nil {
+		return fmt.Errorf("rate limit %s uses the concurrency algorithm, which flows do not support, and its lease could not be released: %w", label, err)
+	}
+	return fmt.Errorf("rate limit %s uses the concurrency algorithm, which flows do not support", label)
+}
+
+func (r *RateLimit) spendFromBlock(key string, cost int64) bool {
+	if r.blockSize <= 0 {
+		return false
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	block, ok := r.blocks[key]
+	if !ok {
+		return false
+	}
+	if time.Now().After(block.expiresAt) {
+		delete(r.blocks, key)
+		return false
+	}
+	if block.tokens < cost {
+		return false
+	}
+	block.tokens -= cost
+	return true
+}
+
+func (r *RateLimit) storeBlock(key string, tokens int64) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	now := time.Now()
+	for k, block := range r.blocks {
+		if now.After(block.expiresAt) {
+			delete(r.blocks, k)
+		}
+	}
+	r.blocks[key] = &tokenBlock{tokens: tokens, expiresAt: now.Add(r.blockTTL)}
+}
+
 func (r *RateLimit) Close(ctx context.Context) error {
 	if r.conn != nil {
 		return r.conn.Close()
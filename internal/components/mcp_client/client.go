@@ -0,0 +1,281 @@
+package mcp_client
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+	"sync"
+	"time"
+
+	mcpclient "github.com/mark3labs/mcp-go/client"
+	"github.com/mark3labs/mcp-go/client/transport"
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/warpstreamlabs/bento/public/bloblang"
+	"github.com/warpstreamlabs/bento/public/service"
+	"golang.org/x/oauth2/clientcredentials"
+)
+
+type toolClient struct {
+	url         string
+	transport   string
+	tool        string
+	argsMapping *bloblang.Executor
+	headers     map[string]string
+	httpClient  *http.Client
+	timeout     time.Duration
+	logger      *service.Logger
+
+	// Long-lived SSE streams are bound to the context passed to Start, so sessions
+	// get their own context that is only cancelled on close.
+	sessionCtx    context.Context
+	sessionCancel context.CancelFunc
+
+	mu     sync.Mutex
+	client *mcpclient.Client
+}
+
+func newToolClient(conf *service.ParsedConfig, mgr *service.Resources) (*toolClient, error) {
+	c := &toolClient{
+		logger:     mgr.Logger(),
+		httpClient: &http.Client{},
+	}
+
+	var err error
+	if c.url, err = conf.FieldString(mcfURL); err != nil {
+		return nil, err
+	}
+	if c.transport, err = conf.FieldString(mcfTransport); err != nil {
+		return nil, err
+	}
+	if c.tool, err = conf.FieldString(mcfTool); err != nil {
+		return nil, err
+	}
+	if conf.Contains(mcfArgsMapping) {
+		if c.argsMapping, err = conf.FieldBloblang(mcfArgsMapping); err != nil {
+			return nil, err
+		}
+	}
+	if c.headers, err = conf.FieldStringMap(mcfHeaders); err != nil {
+		return nil, err
+	}
+	if c.timeout, err = conf.FieldDuration(mcfTimeout); err != nil {
+		return nil, err
+	}
+
+	bearerToken, err := conf.FieldString(mcfBearerToken)
+	if err != nil {
+		return nil, err
+	}
+	if bearerToken != "" {
+		if c.headers == nil {
+			c.headers = make(map[string]string)
+		}
+		c.headers["Authorization"] = "Bearer " + bearerToken
+	}
+
+	oauthConf := conf.Namespace(mcfOAuth2)
+	enabled, err := oauthConf.FieldBool(mcfOAuth2Enabled)
+	if err != nil {
+		return nil, err
+	}
+	if enabled {
+		if c.httpClient, err = oauth2Client(oauthConf); err != nil {
+			return nil, err
+		}
+	}
+
+	c.sessionCtx, c.sessionCancel = context.WithCancel(context.Background())
+	return c, nil
+}
+
+func oauth2Client(conf *service.ParsedConfig) (*http.Client, error) {
+	clientKey, err := conf.FieldString(mcfOAuth2ClientKey)
+	if err != nil {
+		return nil, err
+	}
+	clientSecret, err := conf.FieldString(mcfOAuth2ClientSecret)
+	if err != nil {
+		return nil, err
+	}
+	tokenURL, err := conf.FieldString(mcfOAuth2TokenURL)
+	if err != nil {
+		return nil, err
+	}
+	if tokenURL == "" {
+		return nil, fmt.Errorf("oauth2 token_url is required when oauth2 is enabled")
+	}
+	scopes, err := conf.FieldStringList(mcfOAuth2Scopes)
+	if err != nil {
+		return nil, err
+	}
+	params, err := conf.FieldStringMap(mcfOAuth2EndpointParams)
+	if err != nil {
+		return nil, err
+	}
+
+	endpointParams := url.Values{}
+	for k, v := range params {
+		endpointParams.Set(k, v)
+	}
+
+	cc := clientcredentials.Config{
+		ClientID:       clientKey,
+		ClientSecret:   clientSecret,
+		TokenURL:       tokenURL,
+		Scopes:         scopes,
+		EndpointParams: endpointParams,
+	}
+	return cc.Client(context.Background()), nil
+}
+
+func (c *toolClient) connect(ctx context.Context) (*mcpclient.Client, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if c.client != nil {
+		return c.client, nil
+	}
+
+	c.logger.Debugf("Connecting to MCP server at %s", c.url)
+
+	var client *mcpclient.Client
+	var err error
+	switch c.transport {
+	case transportSSE:
+		client, err = mcpclient.NewSSEMCPClient(c.url,
+			transport.WithHeaders(c.headers),
+			transport.WithHTTPClient(c.httpClient),
+		)
+	default:
+		client, err = mcpclient.NewStreamableHttpClient(c.url,
+			transport.WithHTTPHeaders(c.headers),
+			transport.WithHTTPBasicClient(c.httpClient),
+		)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to create MCP client: %w", err)
+	}
+
+	if err = client.Start(c.sessionCtx); err != nil {
+		return nil, fmt.Errorf("failed to start MCP client: %w", err)
+	}
+
+	initReq := mcp.InitializeRequest{}
+	initReq.Params.ProtocolVersion = mcp.LATEST_PROTOCOL_VERSION
+	initReq.Params.ClientInfo = mcp.Implementation{Name: "airtruct"}
+	if _, err = client.Initialize(ctx, initReq); err != nil {
+		_ = client.Close()
+		return nil, fmt.Errorf("failed to initialize MCP session: %w", err)
+	}
+
+	c.client = client
+	return client, nil
+}
+
+// reset drops the current session so that the next call reconnects.
+func (c *toolClient) reset() {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if c.client != nil {
+		_ = c.client.Close()
+		c.client = nil
+	}
+}
+
+func (c *toolClient) arguments(msg *service.Message) (map[string]any, error) {
+	var structured any
+	var err error
+	if c.argsMapping != nil {
+		resMsg, err := service.MessageBatch{msg}.BloblangQuery(0, c.argsMapping)
+		if err != nil {
+			return nil, fmt.Errorf("failed to execute args_mapping: %w", err)
+		}
+		if resMsg == nil {
+			return map[string]any{}, nil
+		}
+		if structured, err = resMsg.AsStructured(); err != nil {
+			return nil, fmt.Errorf("args_mapping result is not structured: %w", err)
+		}
+	} else {
+		if structured, err = msg.AsStructured(); err != nil {
+			return nil, fmt.Errorf("message content is not valid JSON: %w", err)
+		}
+	}
+
+	args, ok := structured.(map[string]any)
+	if !ok {
+		return nil, fmt.Errorf("tool arguments must be an object, got %T", structured)
+	}
+	return args, nil
+}
+
+func (c *toolClient) call(ctx context.Context, msg *service.Message) (*mcp.CallToolResult, error) {
+	args, err := c.arguments(msg)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, c.timeout)
+	defer cancel()
+
+	client, err := c.connect(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	c.logger.Tracef("Calling MCP tool %s with arguments: %v", c.tool, args)
+
+	result, err := client.CallTool(ctx, mcp.CallToolRequest{
+		Params: mcp.CallToolParams{
+			Name:      c.tool,
+			Arguments: args,
+		},
+	})
+	if err != nil {
+		c.reset()
+		return nil, fmt.Errorf("MCP tool call failed: %w", err)
+	}
+
+	if result.IsError {
+		return nil, fmt.Errorf("MCP tool %s returned an error: %s", c.tool, resultText(result))
+	}
+
+	return result, nil
+}
+
+func (c *toolClient) close() error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.sessionCancel()
+
+	if c.client != nil {
+		err := c.client.Close()
+		c.client = nil
+		return err
+	}
+	return nil
+}
+
+func resultText(result *mcp.CallToolResult) string {
+	var parts []string
+	for _, content := range result.Content {
+		if tc, ok := content.(mcp.TextContent); ok {
+			parts = append(parts, tc.Text)
+		}
+	}
+	return strings.Join(parts, "\n")
+}
+
+// resultContent returns the structured content of the result when present, falling back
+// to the text content.
+func resultContent(result *mcp.CallToolResult) ([]byte, error) {
+	if result.StructuredContent != nil {
+		return json.Marshal(result.StructuredContent)
+	}
+	return []byte(resultText(result)), nil
+}
@@ -0,0 +1,102 @@
+package mcp_client
+
+import "github.com/warpstreamlabs/bento/public/service"
+
+const (
+	mcfURL                  = "url"
+	mcfTransport            = "transport"
+	mcfTool                 = "tool"
+	mcfArgsMapping          = "args_mapping"
+	mcfHeaders              = "headers"
+	mcfBearerToken          = "bearer_token"
+	mcfOAuth2               = "oauth2"
+	mcfOAuth2Enabled        = "enabled"
+	mcfOAuth2ClientKey      = "client_key"
+	mcfOAuth2ClientSecret   = "client_secret"
+	mcfOAuth2TokenURL       = "token_url"
+	mcfOAuth2Scopes         = "scopes"
+	mcfOAuth2EndpointParams = "endpoint_params"
+	mcfTimeout              = "timeout"
+
+	transportStreamableHTTP = "streamable_http"
+	transportSSE            = "sse"
+)
+
+func clientFields() []*service.ConfigField {
+	return []*service.ConfigField{
+		service.NewStringField(mcfURL).
+			Description("The URL of the MCP server endpoint, e.g. `https://example.com/mcp`."),
+		service.NewStringEnumField(mcfTransport, transportStreamableHTTP, transportSSE).
+			Description("The transport used to talk to the MCP server.").
+			Default(transportStreamableHTTP),
+		service.NewStringField(mcfTool).
+			Description("The name of the tool to call."),
+		service.NewBloblangField(mcfArgsMapping).
+			Description("An optional Bloblang mapping which should evaluate to an object of tool arguments. When empty, the message content is used as arguments and must be a JSON object.").
+			Optional(),
+		service.NewStringMapField(mcfHeaders).
+			Description("A map of headers to add to every request sent to the MCP server. Values may reference secrets.").
+			Default(map[string]any{}),
+		service.NewStringField(mcfBearerToken).
+			Description("An optional bearer token sent in the Authorization header.").
+			Default("").
+			Secret(),
+		service.NewObjectField(mcfOAuth2,
+			service.NewBoolField(mcfOAuth2Enabled).
+				Description("Whether to authenticate with OAuth2 client credentials.").
+				Default(false),
+			service.NewStringField(mcfOAuth2ClientKey).
+				Description("The OAuth2 client key.").
+				Default(""),
+			service.NewStringField(mcfOAuth2ClientSecret).
+				Description("The OAuth2 client secret.").
+				Default("").
+				Secret(),
+			service.NewStringField(mcfOAuth2TokenURL).
+				Description("The URL of the OAuth2 token endpoint.").
+				Default(""),
+			service.NewStringListField(mcfOAuth2Scopes).
+				Description("The scopes to request.").
+				Default([]any{}),
+			service.NewStringMapField(mcfOAuth2EndpointParams).
+				Description("Additional parameters sent to the token endpoint.").
+				Default(map[string]any{}),
+		).
+			Description("Allows you to authenticate with the OAuth2 client credentials flow. Tokens are fetched and refreshed automatically.").
+			Advanced(),
+		service.NewDurationField(mcfTimeout).
+			Description("The maximum duration of a single tool call.").
+			Default("30s"),
+	}
+}
+
+func ProcessorConfig() *service.ConfigSpec {
+	return service.NewConfigSpec().
+		Beta().
+		Categories("AI").
+		Summary("Calls a tool on an external MCP server and replaces the message with its result.").
+		Description(`
+This processor invokes a named tool on any MCP server using the streamable HTTP or SSE transport, without an AI model in the loop.
+
+Tool arguments are taken from the args_mapping field, a Bloblang mapping that must evaluate to an object. When no mapping is set, the message content itself is used and must be a JSON object.
+
+If the tool returns structured content, the message is replaced with it. Otherwise the message is replaced with the text content of the result. Tool results flagged as errors fail the message so that it can be handled with a catch processor.`).
+		Fields(clientFields()...).
+		Version("1.0.0")
+}
+
+func OutputConfig() *service.ConfigSpec {
+	return service.NewConfigSpec().
+		Beta().
+		Categories("AI").
+		Summary("Calls a tool on an external MCP server for each message.").
+		Description(`
+This output invokes a named tool on any MCP server using the streamable HTTP or SSE transport, without an AI model in the loop.
+
+Tool arguments are taken from the args_mapping field, a Bloblang mapping that must evaluate to an object. When no mapping is set, the message content itself is used and must be a JSON object.
+
+Tool results flagged as errors are treated as delivery failures, so the message is retried.`).
+		Fields(clientFields()...).
+		Field(service.NewOutputMaxInFlightField()).
+		Version("1.0.0")
+}
@@ -0,0 +1,52 @@
+package mcp_client
+
+import (
+	"context"
+
+	"github.com/warpstreamlabs/bento/public/service"
+)
+
+func init() {
+	err := service.RegisterOutput(
+		"mcp", OutputConfig(),
+		func(conf *service.ParsedConfig, mgr *service.Resources) (service.Output, int, error) {
+			maxInFlight, err := conf.FieldMaxInFlight()
+			if err != nil {
+				return nil, 0, err
+			}
+			out, err := NewOutputFromConfig(conf, mgr)
+			if err != nil {
+				return nil, 0, err
+			}
+			return out, maxInFlight, nil
+		})
+	if err != nil {
+		panic(err)
+	}
+}
+
+type Output struct {
+	client *toolClient
+}
+
+func NewOutputFromConfig(conf *service.ParsedConfig, mgr *service.Resources) (*Output, error) {
+	client, err := newToolClient(conf, mgr)
+	if err != nil {
+		return nil, err
+	}
+	return &Output{client: client}, nil
+}
+
+func (o *Output) Connect(ctx context.Context) error {
+	_, err := o.client.connect(ctx)
+	return err
+}
+
+func (o *Output) Write(ctx context.Context, msg *service.Message) error {
+	_, err := o.client.call(ctx, msg)
+	return err
+}
+
+func (o *Output) Close(ctx context.Context) error {
+	return o.client.close()
+}
@@ -0,0 +1,52 @@
+package mcp_client
+
+import (
+	"context"
+
+	"github.com/warpstreamlabs/bento/public/service"
+)
+
+func init() {
+	err := service.RegisterProcessor(
+		"mcp_call", ProcessorConfig(),
+		func(conf *service.ParsedConfig, mgr *service.Resources) (service.Processor, error) {
+			return NewProcessorFromConfig(conf, mgr)
+		})
+	if err != nil {
+		panic(err)
+	}
+}
+
+type Processor struct {
+	client *toolClient
+}
+
+func NewProcessorFromConfig(conf *service.ParsedConfig, mgr *service.Resources) (*Processor, error) {
+	client, err := newToolClient(conf, mgr)
+	if err != nil {
+		return nil, err
+	}
+	return &Processor{client: client}, nil
+}
+
+func (p *Processor) Process(ctx context.Context, msg *service.Message) (service.MessageBatch, error) {
+	result, err := p.client.call(ctx, msg)
+	if err != nil {
+		return nil, err
+	}
+
+	content, err := resultContent(result)
+	if err != nil {
+		return nil, err
+	}
+
+	outMsg := msg.Copy()
+	outMsg.SetBytes(content)
+	outMsg.MetaSetMut("mcp_tool", p.client.tool)
+
+	return service.MessageBatch{outMsg}, nil
+}
+
+func (p *Processor) Close(ctx context.Context) error {
+	return p.client.close()
+}
@@ -0,0 +1,141 @@
+package mcp_client
+
+import (
+	"context"
+	"fmt"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/mark3labs/mcp-go/server"
+	"github.com/warpstreamlabs/bento/public/service"
+)
+
+func newTestToolClient(t *testing.T, yaml string) *toolClient {
+	t.Helper()
+	conf, err := ProcessorConfig().ParseYAML(yaml, nil)
+	if err != nil {
+		t.Fatalf("failed to parse config: %v", err)
+	}
+	c, err := newToolClient(conf, service.MockResources())
+	if err != nil {
+		t.Fatalf("failed to create client: %v", err)
+	}
+	t.Cleanup(func() { c.close() })
+	return c
+}
+
+// startTestMCPServer serves a greet tool over the streamable HTTP transport and returns the endpoint URL.
+func startTestMCPServer(t *testing.T) string {
+	t.Helper()
+	mcpServer := server.NewMCPServer("test", "1.0")
+	mcpServer.AddTool(mcp.NewTool("greet", mcp.WithString("name", mcp.Required())),
+		func(_ context.Context, req mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			name, err := req.RequireString("name")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			return mcp.NewToolResultStructured(map[string]any{"greeting": "hello " + name}, "hello "+name), nil
+		})
+
+	srv := httptest.NewServer(server.NewStreamableHTTPServer(mcpServer))
+	t.Cleanup(srv.Close)
+	return srv.URL + "/mcp"
+}
+
+func TestArguments(t *testing.T) {
+	tests := []struct {
+		name    string
+		mapping string
+		content string
+		want    string
+		wantErr bool
+	}{
+		{name: "message content", content: `{"name":"ada"}`, want: "map[name:ada]"},
+		{name: "content that is not an object", content: `["ada"]`, wantErr: true},
+		{name: "content that is not json", content: `ada`, wantErr: true},
+		{name: "mapping", mapping: `root.name = this.user.first`, content: `{"user":{"first":"ada"}}`, want: "map[name:ada]"},
+		{name: "deleted mapping result", mapping: `root = deleted()`, content: `{}`, want: "map[]"},
+		{name: "mapping that is not an object", mapping: `root = this.user.first`, content: `{"user":{"first":"ada"}}`, wantErr: true},
+		{name: "failing mapping", mapping: `root.name = this.missing.number()`, content: `{}`, wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			yaml := "url: http://localhost/mcp\ntool: greet\n"
+			if tt.mapping != "" {
+				yaml += fmt.Sprintf("args_mapping: %q\n", tt.mapping)
+			}
+			c := newTestToolClient(t, yaml)
+
+			args, err := c.arguments(service.NewMessage([]byte(tt.content)))
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("arguments() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if err == nil && fmt.Sprint(args) != tt.want {
+				t.Errorf("expected arguments %s, got %v", tt.want, args)
+			}
+		})
+	}
+}
+
+func TestResultContent(t *testing.T) {
+	textResult := mcp.NewToolResultText("first")
+	textResult.Content = append(textResult.Content, mcp.NewImageContent("data", "image/png"), mcp.NewTextContent("second"))
+
+	tests := []struct {
+		name   string
+		result *mcp.CallToolResult
+		want   string
+	}{
+		{name: "text", result: textResult, want: "first\nsecond"},
+		{name: "structured", result: mcp.NewToolResultStructured(map[string]any{"count": 2}, "2 items"), want: `{"count":2}`},
+		{name: "error", result: mcp.NewToolResultError("no such user"), want: "no such user"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			content, err := resultContent(tt.result)
+			if err != nil {
+				t.Fatalf("resultContent returned error: %v", err)
+			}
+			if string(content) != tt.want {
+				t.Errorf("expected content %q, got %q", tt.want, content)
+			}
+		})
+	}
+}
+
+func TestProcessCallsTool(t *testing.T) {
+	url := startTestMCPServer(t)
+	conf, err := ProcessorConfig().ParseYAML(fmt.Sprintf("url: %s\ntool: greet\nargs_mapping: 'root.name = this.user'\n", url), nil)
+	if err != nil {
+		t.Fatalf("failed to parse config: %v", err)
+	}
+	p, err := NewProcessorFromConfig(conf, service.MockResources())
+	if err != nil {
+		t.Fatalf("failed to create processor: %v", err)
+	}
+	t.Cleanup(func() { p.Close(context.Background()) })
+
+	batch, err := p.Process(t.Context(), service.NewMessage([]byte(`{"user":"ada"}`)))
+	if err != nil {
+		t.Fatalf("Process returned error: %v", err)
+	}
+	if len(batch) != 1 {
+		t.Fatalf("expected 1 message, got %d", len(batch))
+	}
+	content, err := batch[0].AsBytes()
+	if err != nil {
+		t.Fatalf("failed to read message: %v", err)
+	}
+	if string(content) != `{"greeting":"hello ada"}` {
+		t.Errorf("expected the structured result, got %s", content)
+	}
+	if tool, _ := batch[0].MetaGet("mcp_tool"); tool != "greet" {
+		t.Errorf("expected mcp_tool metadata %q, got %q", "greet", tool)
+	}
+
+	// Results flagged as errors fail the message.
+	if _, err := p.Process(t.Context(), service.NewMessage([]byte(`{}`))); err == nil {
+		t.Error("expected an error for a tool result flagged as error")
+	}
+}
@@ -0,0 +1,31 @@
+package mcp_progress
+
+import "github.com/warpstreamlabs/bento/public/service"
+
+const (
+	mpfProgress = "progress"
+	mpfTotal    = "total"
+	mpfMessage  = "message"
+)
+
+func Config() *service.ConfigSpec {
+	return service.NewConfigSpec().
+		Beta().
+		Categories("AI").
+		Summary("Reports the progress of the MCP tool call that triggered the message.").
+		Description(`
+This processor sends an MCP progress notification to the client that called the tool served by this flow. It is meant for long-running MCP tool flows, so that clients can show how far along a call is.
+
+Progress is only reported when the client asked for it by providing a progress token with the call. Messages that were not produced by an MCP tool call are passed through untouched.
+
+The message itself is never modified.`).
+		Field(service.NewInterpolatedStringField(mpfProgress).
+			Description("The current progress. Must resolve to a number that increases with each notification.")).
+		Field(service.NewInterpolatedStringField(mpfTotal).
+			Description("The total amount of work, if known. Must resolve to a number.").
+			Optional()).
+		Field(service.NewInterpolatedStringField(mpfMessage).
+			Description("An optional human-readable message describing the current progress.").
+			Optional()).
+		Version("1.0.0")
+}
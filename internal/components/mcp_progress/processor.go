@@ -0,0 +1,130 @@
+package mcp_progress
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"strconv"
+
+	"github.com/warpstreamlabs/bento/public/service"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
+
+	"github.com/sananguliyev/airtruct/internal/mcp"
+	pb "github.com/sananguliyev/airtruct/internal/protogen"
+)
+
+func init() {
+	err := service.RegisterProcessor(
+		"mcp_progress", Config(),
+		func(conf *service.ParsedConfig, mgr *service.Resources) (service.Processor, error) {
+			return NewFromConfig(conf, mgr)
+		})
+	if err != nil {
+		panic(err)
+	}
+}
+
+type Processor struct {
+	client   pb.CoordinatorClient
+	conn     *grpc.ClientConn
+	logger   *service.Logger
+	progress *service.InterpolatedString
+	total    *service.InterpolatedString
+	message  *service.InterpolatedString
+}
+
+func NewFromConfig(conf *service.ParsedConfig, mgr *service.Resources) (*Processor, error) {
+	p := &Processor{
+		logger: mgr.Logger(),
+	}
+
+	var err error
+	if p.progress, err = conf.FieldInterpolatedString(mpfProgress); err != nil {
+		return nil, err
+	}
+
+	if conf.Contains(mpfTotal) {
+		if p.total, err = conf.FieldInterpolatedString(mpfTotal); err != nil {
+			return nil, err
+		}
+	}
+
+	if conf.Contains(mpfMessage) {
+		if p.message, err = conf.FieldInterpolatedString(mpfMessage); err != nil {
+			return nil, err
+		}
+	}
+
+	coordinatorAddr := os.Getenv("DISCOVERY_URI")
+	if coordinatorAddr == "" {
+		coordinatorAddr = "localhost:50000"
+	}
+
+	p.conn, err = grpc.NewClient(coordinatorAddr, grpc.WithTransportCredentials(insecure.NewCredentials()))
+	if err != nil {
+		return nil, fmt.Errorf("failed to connect to coordinator: %w", err)
+	}
+	p.client = pb.NewCoordinatorClient(p.conn)
+
+	return p, nil
+}
+
+func (p *Processor) Process(ctx context.Context, msg *service.Message) (service.MessageBatch, error) {
+	callID, ok := msg.MetaGet(mcp.CallIDHeader)
+	if !ok || callID == "" {
+		return service.MessageBatch{msg}, nil
+	}
+
+	req, err := p.buildRequest(callID, msg)
+	if err != nil {
+		return nil, err
+	}
+
+	if _, err := p.client.ReportToolProgress(ctx, req); err != nil {
+		p.logger.Warnf("Failed to report progress of MCP tool call %s: %v", callID, err)
+	}
+
+	return service.MessageBatch{msg}, nil
+}
+
+func (p *Processor) buildRequest(callID string, msg *service.Message) (*pb.ToolProgressRequest, error) {
+	req := &pb.ToolProgressRequest{CallId: callID}
+
+	progressStr, err := p.progress.TryString(msg)
+	if err != nil {
+		return nil, fmt.Errorf("failed to interpolate progress: %w", err)
+	}
+	if req.Progress, err = strconv.ParseFloat(progressStr, 64); err != nil {
+		return nil, fmt.Errorf("progress must be a number, got %q", progressStr)
+	}
+
+	if p.total != nil {
+		totalStr, err := p.total.TryString(msg)
+		if err != nil {
+			return nil, fmt.Errorf("failed to interpolate total: %w", err)
+		}
+		if totalStr != "" {
+			total, err := strconv.ParseFloat(totalStr, 64)
+			if err != nil {
+				return nil, fmt.Errorf("total must be a number, got %q", totalStr)
+			}
+			req.Total = &total
+		}
+	}
+
+	if p.message != nil {
+		if req.Message, err = p.message.TryString(msg); err != nil {
+			return nil, fmt.Errorf("failed to interpolate message: %w", err)
+		}
+	}
+
+	return req, nil
+}
+
+func (p *Processor) Close(ctx context.Context) error {
+	if p.conn != nil {
+		return p.conn.Close()
+	}
+	return nil
+}
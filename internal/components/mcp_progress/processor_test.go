@@ -0,0 +1,132 @@
+package mcp_progress
+
+import (
+	"context"
+	"testing"
+
+	"github.com/warpstreamlabs/bento/public/service"
+	"google.golang.org/grpc"
+
+	"github.com/sananguliyev/airtruct/internal/mcp"
+	pb "github.com/sananguliyev/airtruct/internal/protogen"
+)
+
+// fakeCoordinatorClient records the progress reports, the other calls of the coordinator are not used.
+type fakeCoordinatorClient struct {
+	pb.CoordinatorClient
+	reports []*pb.ToolProgressRequest
+}
+
+func (c *fakeCoordinatorClient) ReportToolProgress(_ context.Context, in *pb.ToolProgressRequest, _ ...grpc.CallOption) (*pb.CommonResponse, error) {
+	c.reports = append(c.reports, in)
+	return &pb.CommonResponse{}, nil
+}
+
+func newTestProcessor(t *testing.T, yaml string) (*Processor, *fakeCoordinatorClient) {
+	t.Helper()
+	conf, err := Config().ParseYAML(yaml, nil)
+	if err != nil {
+		t.Fatalf("failed to parse config: %v", err)
+	}
+	p, err := NewFromConfig(conf, service.MockResources())
+	if err != nil {
+		t.Fatalf("failed to create processor: %v", err)
+	}
+	t.Cleanup(func() { p.Close(context.Background()) })
+
+	client := &fakeCoordinatorClient{}
+	p.client = client
+	return p, client
+}
+
+func newCallMessage(callID, body string) *service.Message {
+	msg := service.NewMessage([]byte(body))
+	if callID != "" {
+		msg.MetaSet(mcp.CallIDHeader, callID)
+	}
+	return msg
+}
+
+func TestBuildRequest(t *testing.T) {
+	p, _ := newTestProcessor(t, `
+progress: ${! json("done") }
+total: ${! json("total") }
+message: processed ${! json("done") } of ${! json("total") }
+`)
+
+	req, err := p.buildRequest("call-1", newCallMessage("call-1", `{"done":3,"total":10}`))
+	if err != nil {
+		t.Fatalf("buildRequest returned error: %v", err)
+	}
+	if req.GetCallId() != "call-1" || req.GetProgress() != 3 || req.Total == nil || req.GetTotal() != 10 {
+		t.Errorf("unexpected request: %+v", req)
+	}
+	if req.GetMessage() != "processed 3 of 10" {
+		t.Errorf("expected message %q, got %q", "processed 3 of 10", req.GetMessage())
+	}
+}
+
+func TestBuildRequestOptionalFields(t *testing.T) {
+	p, _ := newTestProcessor(t, `
+progress: "0.5"
+total: ${! json("total").or("") }
+`)
+
+	req, err := p.buildRequest("call-1", newCallMessage("call-1", `{}`))
+	if err != nil {
+		t.Fatalf("buildRequest returned error: %v", err)
+	}
+	if req.GetProgress() != 0.5 {
+		t.Errorf("expected progress 0.5, got %v", req.GetProgress())
+	}
+	// A total that resolves to an empty string is unknown.
+	if req.Total != nil {
+		t.Errorf("expected no total, got %v", req.GetTotal())
+	}
+	if req.GetMessage() != "" {
+		t.Errorf("expected no message, got %q", req.GetMessage())
+	}
+}
+
+func TestBuildRequestRejectsNonNumbers(t *testing.T) {
+	tests := []struct {
+		name string
+		yaml string
+	}{
+		{name: "progress", yaml: `progress: ${! json("step") }`},
+		{name: "total", yaml: "progress: \"1\"\ntotal: ${! json(\"step\") }"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			p, _ := newTestProcessor(t, tt.yaml)
+			if _, err := p.buildRequest("call-1", newCallMessage("call-1", `{"step":"parsing"}`)); err == nil {
+				t.Error("expected an error for a value that is not a number")
+			}
+		})
+	}
+}
+
+func TestProcessReportsProgressOfToolCalls(t *testing.T) {
+	p, client := newTestProcessor(t, `progress: "1"`)
+
+	// Messages that were not produced by an MCP tool call pass through without a report.
+	batch, err := p.Process(t.Context(), newCallMessage("", `{}`))
+	if err != nil {
+		t.Fatalf("Process returned error: %v", err)
+	}
+	if len(batch) != 1 || len(client.reports) != 0 {
+		t.Fatalf("expected the message to pass through without a report, got %d messages and %d reports", len(batch), len(client.reports))
+	}
+
+	msg := newCallMessage("call-1", `{"id":1}`)
+	batch, err = p.Process(t.Context(), msg)
+	if err != nil {
+		t.Fatalf("Process returned error: %v", err)
+	}
+	if len(batch) != 1 || batch[0] != msg {
+		t.Fatal("expected the message to be passed on unchanged")
+	}
+	if len(client.reports) != 1 || client.reports[0].GetCallId() != "call-1" {
+		t.Fatalf("expected a report for call-1, got %v", client.reports)
+	}
+}
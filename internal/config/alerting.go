@@ -0,0 +1,16 @@
+package config
+
+import "time"
+
+type AlertingConfig struct {
+	// EvaluationInterval is how often the windowed alert rules are evaluated and firing alerts are checked for
+	// resolution.
+	EvaluationInterval time.Duration
+	// SMTPHost is the host of the mail server email channels send through, email channels fail when empty.
+	SMTPHost     string
+	SMTPPort     int
+	SMTPUsername string
+	SMTPPassword string
+	// SMTPFrom is the sender address of alert emails.
+	SMTPFrom string
+}
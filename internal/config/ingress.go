@@ -0,0 +1,18 @@
+package config
+
+import "time"
+
+type IngressConfig struct {
+	// HeaderAllowlist limits the headers forwarded between clients and flows, all headers pass when empty.
+	HeaderAllowlist []string
+	// HeaderDenylist removes headers even if they are allowed.
+	HeaderDenylist []string
+	// DeadLetterRetention is how long queued requests that could not be delivered are kept.
+	DeadLetterRetention time.Duration
+	// MaxBodySize is the default limit of ingest request bodies in bytes, zero disables it.
+	MaxBodySize int64
+	// RequestTimeout is the default time a flow has to respond to an ingest request, zero disables it.
+	RequestTimeout time.Duration
+	// MaxConcurrentRequests bounds the ingest requests the coordinator handles at once, zero disables it.
+	MaxConcurrentRequests int
+}
@@ -0,0 +1,17 @@
+package config
+
+const (
+	TracingProtocolGRPC = "grpc"
+	TracingProtocolHTTP = "http"
+)
+
+type TracingConfig struct {
+	// Endpoint is the host and port of the OTLP collector spans are exported to, tracing is disabled when empty.
+	Endpoint string
+	// Protocol is the OTLP transport, grpc or http.
+	Protocol string
+	// Secure connects to the collector with TLS.
+	Secure bool
+	// SampleRatio is the share of new traces that are recorded, traces continued from a caller follow its decision.
+	SampleRatio float64
+}
@@ -4,15 +4,24 @@ import (
 	"context"
 	"net/http"
 
+	"github.com/sananguliyev/airtruct/internal/config"
 	"github.com/sananguliyev/airtruct/internal/executor/coordinator"
 	"github.com/sananguliyev/airtruct/internal/persistence"
+	pb "github.com/sananguliyev/airtruct/internal/protogen"
+	"github.com/sananguliyev/airtruct/internal/vault"
 )
 
+type IngressError = coordinator.IngressError
+
 type CoordinatorExecutor interface {
 	CheckWorkersAndAssignFlows(context.Context) error
 	CheckWorkerHeartbeats(context.Context) error
 	CheckFlowLeases(context.Context) error
-	ForwardRequestToWorker(context.Context, *http.Request) (int32, []byte, error)
+	ForwardRequestToWorker(context.Context, *http.Request) (*pb.IngestResponse, error)
+	ForwardStreamToWorker(context.Context, *http.Request, http.ResponseWriter) error
+	SyncIngressRoutes(context.Context) error
+	HasIngressRoute(path string) bool
+	ReplayIngressQueue(context.Context) error
 }
 
 type coordinatorExecutor struct {
@@ -26,10 +35,16 @@ func NewCoordinatorExecutor(
 	flowRateLimitRepo persistence.FlowRateLimitRepository,
 	workerFlowRepo persistence.WorkerFlowRepository,
 	fileRepo persistence.FileRepository,
+	secretRepo persistence.SecretRepository,
+	queuedRequestRepo persistence.QueuedRequestRepository,
+	aesgcm *vault.AESGCM,
+	rateLimiter coordinator.RateLimiter,
 	flowWorkerMap coordinator.FlowWorkerMap,
+	ingressConfig *config.IngressConfig,
+	alerter coordinator.Alerter,
 ) CoordinatorExecutor {
 	return &coordinatorExecutor{
-		coordinator: coordinator.NewCoordinatorExecutor(workerRepo, flowRepo, flowCacheRepo, flowRateLimitRepo, workerFlowRepo, fileRepo, flowWorkerMap),
+		coordinator: coordinator.NewCoordinatorExecutor(workerRepo, flowRepo, flowCacheRepo, flowRateLimitRepo, workerFlowRepo, fileRepo, secretRepo, queuedRequestRepo, aesgcm, rateLimiter, flowWorkerMap, ingressConfig, alerter),
 	}
 }
 
@@ -45,6 +60,22 @@ func (e *coordinatorExecutor) CheckFlowLeases(ctx context.Context) error {
 	return e.coordinator.CheckFlowLeases(ctx)
 }
 
-func (e *coordinatorExecutor) ForwardRequestToWorker(ctx context.Context, r *http.Request) (int32, []byte, error) {
+func (e *coordinatorExecutor) ForwardRequestToWorker(ctx context.Context, r *http.Request) (*pb.IngestResponse, error) {
 	return e.coordinator.ForwardRequestToWorker(ctx, r)
 }
+
+func (e *coordinatorExecutor) ForwardStreamToWorker(ctx context.Context, r *http.Request, w http.ResponseWriter) error {
+	return e.coordinator.ForwardStreamToWorker(ctx, r, w)
+}
+
+func (e *coordinatorExecutor) SyncIngressRoutes(ctx context.Context) error {
+	return e.coordinator.SyncIngressRoutes(ctx)
+}
+
+func (e *coordinatorExecutor) HasIngressRoute(path string) bool {
+	return e.coordinator.HasIngressRoute(path)
+}
+
+func (e *coordinatorExecutor) ReplayIngressQueue(ctx context.Context) error {
+	return e.coordinator.ReplayIngressQueue(ctx)
+}
@@ -3,16 +3,20 @@ package coordinator
 import (
 	"fmt"
 	"path/filepath"
+	"strconv"
 	"strings"
 
 	"gopkg.in/yaml.v3"
 
+	"github.com/sananguliyev/airtruct/internal/metrics"
 	"github.com/sananguliyev/airtruct/internal/persistence"
+	"github.com/sananguliyev/airtruct/internal/tracing"
 )
 
 const (
-	FileRefPrefix  = "airtruct://"
-	WorkerFilesDir = "/tmp/airtruct/files"
+	FileRefPrefix         = "airtruct://"
+	WorkerFilesDir        = "/tmp/airtruct/files"
+	DefaultMCPToolTimeout = "60s"
 )
 
 type BuildResult struct {
@@ -77,6 +81,16 @@ func (b *configBuilder) BuildFlowConfig(flow persistence.Flow) (*BuildResult, er
 	}
 	configMap["output"] = output
 
+	configMap["metrics"] = map[string]any{
+		metrics.BentoExporterName: map[string]any{
+			"flow":    flow.Name,
+			"version": strconv.FormatInt(flow.ID, 10),
+		},
+	}
+	configMap["tracer"] = map[string]any{
+		tracing.BentoTracerName: map[string]any{},
+	}
+
 	fileKeys := collectFileRefs(configMap)
 	var files []persistence.File
 	if len(fileKeys) > 0 {
@@ -156,19 +170,33 @@ func (b *configBuilder) buildInputConfig(flow persistence.Flow) (map[string]any,
 	input := make(map[string]any)
 
 	if flow.InputComponent == "mcp_tool" {
+		var toolConfig map[string]any
+		if err := yaml.Unmarshal(flow.InputConfig, &toolConfig); err != nil {
+			return nil, err
+		}
+		timeout := DefaultMCPToolTimeout
+		if t, ok := toolConfig["timeout"].(string); ok && t != "" {
+			timeout = t
+		}
+
 		input["http_server"] = map[string]any{
 			"path":          "/",
 			"allowed_verbs": []string{"POST"},
-			"timeout":       "60s",
+			"timeout":       timeout,
 			"sync_response": map[string]any{
 				"status": `${! metadata("status_code").or("200") }`,
 			},
 		}
 	} else {
-		input[flow.InputComponent] = make(map[string]any)
-		if err := yaml.Unmarshal(flow.InputConfig, input[flow.InputComponent]); err != nil {
+		inputConfig := make(map[string]any)
+		if err := yaml.Unmarshal(flow.InputConfig, &inputConfig); err != nil {
 			return nil, err
 		}
+		if flow.InputComponent == "http_server" {
+			// The ingress policy is enforced by the coordinator and is not part of the input itself.
+			delete(inputConfig, "ingress")
+		}
+		input[flow.InputComponent] = inputConfig
 	}
 
 	input["label"] = flow.InputLabel
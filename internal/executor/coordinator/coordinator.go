@@ -7,14 +7,28 @@ import (
 
 	"github.com/rs/zerolog/log"
 
+	"github.com/sananguliyev/airtruct/internal/alerting"
+	"github.com/sananguliyev/airtruct/internal/config"
+	"github.com/sananguliyev/airtruct/internal/metrics"
 	"github.com/sananguliyev/airtruct/internal/persistence"
+	pb "github.com/sananguliyev/airtruct/internal/protogen"
+	"github.com/sananguliyev/airtruct/internal/vault"
 )
 
 type CoordinatorExecutor interface {
 	CheckWorkersAndAssignFlows(context.Context) error
 	CheckWorkerHeartbeats(context.Context) error
 	CheckFlowLeases(context.Context) error
-	ForwardRequestToWorker(context.Context, *http.Request) (int32, []byte, error)
+	ForwardRequestToWorker(context.Context, *http.Request) (*pb.IngestResponse, error)
+	ForwardStreamToWorker(context.Context, *http.Request, http.ResponseWriter) error
+	SyncIngressRoutes(context.Context) error
+	HasIngressRoute(path string) bool
+	ReplayIngressQueue(context.Context) error
+}
+
+// Alerter fires the alerts of the events the coordinator notices.
+type Alerter interface {
+	Trigger(alerting.Event)
 }
 
 type coordinatorExecutor struct {
@@ -23,6 +37,7 @@ type coordinatorExecutor struct {
 	flowWorkerMap  FlowWorkerMap
 	workerFlowRepo persistence.WorkerFlowRepository
 	workerRepo       persistence.WorkerRepository
+	alerter          Alerter
 }
 
 func NewCoordinatorExecutor(
@@ -32,7 +47,13 @@ func NewCoordinatorExecutor(
 	flowRateLimitRepo persistence.FlowRateLimitRepository,
 	workerFlowRepo persistence.WorkerFlowRepository,
 	fileRepo persistence.FileRepository,
+	secretRepo persistence.SecretRepository,
+	queuedRequestRepo persistence.QueuedRequestRepository,
+	aesgcm *vault.AESGCM,
+	rateLimiter RateLimiter,
 	flowWorkerMap FlowWorkerMap,
+	ingressConfig *config.IngressConfig,
+	alerter Alerter,
 ) CoordinatorExecutor {
 	clientManager := NewGRPCClientManager()
 	workerManager := NewWorkerManager(workerRepo, workerFlowRepo, clientManager)
@@ -44,7 +65,10 @@ func NewCoordinatorExecutor(
 	}
 
 	flowAssigner := NewFlowAssigner(workerManager, flowRepo, workerFlowRepo, configBuilder, flowWorkerMap)
-	requestForwarder := NewRequestForwarder(workerManager, flowWorkerMap, flowRepo)
+	headerFilter := NewHeaderFilter(ingressConfig.HeaderAllowlist, ingressConfig.HeaderDenylist)
+	ingressGuard := NewIngressGuard(flowRepo, secretRepo, aesgcm)
+	ingressQueue := NewIngressQueue(queuedRequestRepo, flowRepo, flowWorkerMap, workerManager, ingressGuard, ingressConfig.DeadLetterRetention)
+	requestForwarder := NewRequestForwarder(workerManager, flowWorkerMap, flowRepo, headerFilter, ingressGuard, ingressQueue, ingressConfig, rateLimiter)
 
 	return &coordinatorExecutor{
 		flowAssigner:   flowAssigner,
@@ -52,6 +76,7 @@ func NewCoordinatorExecutor(
 		flowWorkerMap:  flowWorkerMap,
 		workerFlowRepo: workerFlowRepo,
 		workerRepo:       workerRepo,
+		alerter:          alerter,
 	}
 }
 
@@ -82,6 +107,8 @@ func (e *coordinatorExecutor) CheckWorkerHeartbeats(ctx context.Context) error {
 				Msg("Failed to deactivate worker")
 			continue
 		}
+		metrics.WorkerHeartbeats.Forget(worker.ID)
+		e.alerter.Trigger(alerting.Event{Type: persistence.AlertRuleTypeWorkerLost, WorkerID: worker.ID})
 
 		err = e.workerFlowRepo.StopAllRunningAndWaitingByWorkerID(worker.ID)
 		if err != nil {
@@ -116,6 +143,7 @@ func (e *coordinatorExecutor) CheckFlowLeases(ctx context.Context) error {
 			Int64("worker_flow_id", workerFlow.ID).
 			Dur("time_since_expiry", timeSinceExpiry).
 			Msg("Flow lease expired - marking as stopped")
+		metrics.FlowLeaseExpiries.WithLabelValues(workerFlow.WorkerID).Inc()
 
 		err := e.workerFlowRepo.UpdateStatus(
 			workerFlow.ID,
@@ -129,6 +157,12 @@ func (e *coordinatorExecutor) CheckFlowLeases(ctx context.Context) error {
 			continue
 		}
 
+		e.alerter.Trigger(alerting.Event{
+			Type:     persistence.AlertRuleTypeLeaseExpired,
+			FlowID:   workerFlow.FlowID,
+			WorkerID: workerFlow.WorkerID,
+		})
+
 		log.Info().
 			Str("worker_id", workerFlow.WorkerID).
 			Int64("flow_id", workerFlow.FlowID).
@@ -138,10 +172,26 @@ func (e *coordinatorExecutor) CheckFlowLeases(ctx context.Context) error {
 	return nil
 }
 
-func (e *coordinatorExecutor) ForwardRequestToWorker(ctx context.Context, r *http.Request) (int32, []byte, error) {
+func (e *coordinatorExecutor) ForwardRequestToWorker(ctx context.Context, r *http.Request) (*pb.IngestResponse, error) {
 	return e.requestForwarder.ForwardRequestToWorker(ctx, r)
 }
 
+func (e *coordinatorExecutor) ForwardStreamToWorker(ctx context.Context, r *http.Request, w http.ResponseWriter) error {
+	return e.requestForwarder.ForwardStreamToWorker(ctx, r, w)
+}
+
+func (e *coordinatorExecutor) SyncIngressRoutes(_ context.Context) error {
+	return e.requestForwarder.SyncRoutes()
+}
+
+func (e *coordinatorExecutor) HasIngressRoute(path string) bool {
+	return e.requestForwarder.HasRoute(path)
+}
+
+func (e *coordinatorExecutor) ReplayIngressQueue(ctx context.Context) error {
+	return e.requestForwarder.ReplayQueue(ctx)
+}
+
 func initializeFlowWorkerMapping(workerFlowRepo persistence.WorkerFlowRepository, flowWorkerMap FlowWorkerMap) error {
 	workerFlows, err := workerFlowRepo.ListAllByStatuses(persistence.WorkerFlowStatusRunning)
 	if err != nil {
@@ -0,0 +1,248 @@
+package coordinator
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"hash/fnv"
+	"math/rand/v2"
+	"regexp"
+	"strconv"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/sananguliyev/airtruct/internal/persistence"
+)
+
+const (
+	RedactionPresetEmail      = "email"
+	RedactionPresetCardNumber = "card_number"
+
+	DefaultRedactionReplacement = "[REDACTED]"
+)
+
+var redactionPresets = map[string]*regexp.Regexp{
+	RedactionPresetEmail:      regexp.MustCompile(`[A-Za-z0-9._%+-]+@[A-Za-z0-9.-]+\.[A-Za-z]{2,}`),
+	RedactionPresetCardNumber: regexp.MustCompile(`\b(?:\d[ -]?){12,18}\d\b`),
+}
+
+// EventPolicy applies the event settings of a flow version to the tracing events reported for it.
+type EventPolicy struct {
+	sampleRate     float64
+	maxContentSize int
+	paths          []pathRedaction
+	patterns       []patternRedaction
+}
+
+type pathRedaction struct {
+	segments    []string
+	replacement string
+}
+
+type patternRedaction struct {
+	pattern     *regexp.Regexp
+	replacement string
+}
+
+// NewEventPolicy compiles the event settings of the flow, it fails when a redaction is invalid.
+func NewEventPolicy(flow persistence.Flow) (*EventPolicy, error) {
+	policy := &EventPolicy{
+		sampleRate:     1,
+		maxContentSize: int(flow.EventMaxContentSize),
+	}
+	if flow.EventSampleRate != nil {
+		policy.sampleRate = *flow.EventSampleRate
+	}
+
+	for i, redaction := range flow.GetEventRedactions() {
+		replacement := redaction.Replacement
+		if replacement == "" {
+			replacement = DefaultRedactionReplacement
+		}
+
+		set := 0
+		for _, field := range []string{redaction.Path, redaction.Pattern, redaction.Preset} {
+			if field != "" {
+				set++
+			}
+		}
+		if set != 1 {
+			return nil, fmt.Errorf("redaction %d: exactly one of path, pattern or preset must be set", i+1)
+		}
+
+		switch {
+		case redaction.Path != "":
+			segments, err := parseRedactionPath(redaction.Path)
+			if err != nil {
+				return nil, fmt.Errorf("redaction %d: %w", i+1, err)
+			}
+			policy.paths = append(policy.paths, pathRedaction{segments, replacement})
+		case redaction.Pattern != "":
+			pattern, err := regexp.Compile(redaction.Pattern)
+			if err != nil {
+				return nil, fmt.Errorf("redaction %d: invalid pattern: %w", i+1, err)
+			}
+			policy.patterns = append(policy.patterns, patternRedaction{pattern, replacement})
+		default:
+			pattern, ok := redactionPresets[redaction.Preset]
+			if !ok {
+				return nil, fmt.Errorf("redaction %d: unknown preset %q", i+1, redaction.Preset)
+			}
+			policy.patterns = append(policy.patterns, patternRedaction{pattern, replacement})
+		}
+	}
+
+	return policy, nil
+}
+
+// ValidateEventSettings checks that the event settings of the flow can be applied.
+func ValidateEventSettings(flow persistence.Flow) error {
+	_, err := NewEventPolicy(flow)
+	return err
+}
+
+// parseRedactionPath splits a path such as $.customer.email or items.*.card into its segments, * matches every
+// key of an object or item of an array.
+func parseRedactionPath(path string) ([]string, error) {
+	path = strings.TrimPrefix(strings.TrimPrefix(path, "$"), ".")
+	segments := strings.Split(path, ".")
+	for _, segment := range segments {
+		if segment == "" {
+			return nil, fmt.Errorf("invalid path %q", path)
+		}
+	}
+	return segments, nil
+}
+
+// Apply redacts and truncates the content of the event, it returns false when the event is not sampled and must
+// not be stored. Events of a trace are either all sampled or none is.
+func (p *EventPolicy) Apply(event *persistence.Event) bool {
+	if !p.sampled(event.TraceID) {
+		return false
+	}
+
+	event.Content = p.redactContent(event.Content)
+	event.Meta = p.redactMeta(event.Meta)
+
+	if p.maxContentSize > 0 && len(event.Content) > p.maxContentSize {
+		cut := p.maxContentSize
+		for cut > 0 && !utf8.RuneStart(event.Content[cut]) {
+			cut--
+		}
+		event.Content = fmt.Sprintf("%s...[truncated %d bytes]", event.Content[:cut], len(event.Content)-cut)
+	}
+
+	return true
+}
+
+func (p *EventPolicy) sampled(traceID string) bool {
+	switch {
+	case p.sampleRate >= 1:
+		return true
+	case p.sampleRate <= 0:
+		return false
+	case traceID == "":
+		return rand.Float64() < p.sampleRate
+	}
+
+	h := fnv.New64a()
+	_, _ = h.Write([]byte(traceID))
+	return float64(h.Sum64()%10000) < p.sampleRate*10000
+}
+
+func (p *EventPolicy) redactContent(content string) string {
+	if len(p.paths) > 0 {
+		if value, ok := decodeJSON([]byte(content)); ok {
+			changed := false
+			for _, redaction := range p.paths {
+				value, changed = redactPath(value, redaction.segments, redaction.replacement, changed)
+			}
+			if changed {
+				if data, err := json.Marshal(value); err == nil {
+					content = string(data)
+				}
+			}
+		}
+	}
+
+	for _, redaction := range p.patterns {
+		content = redaction.pattern.ReplaceAllString(content, redaction.replacement)
+	}
+	return content
+}
+
+// redactMeta applies the patterns to the string values of the metadata, paths only address the content.
+func (p *EventPolicy) redactMeta(meta json.RawMessage) json.RawMessage {
+	if len(p.patterns) == 0 || len(meta) == 0 {
+		return meta
+	}
+
+	value, ok := decodeJSON(meta)
+	if !ok {
+		return meta
+	}
+	value = p.redactStrings(value)
+	data, err := json.Marshal(value)
+	if err != nil {
+		return meta
+	}
+	return data
+}
+
+func (p *EventPolicy) redactStrings(value any) any {
+	switch v := value.(type) {
+	case string:
+		for _, redaction := range p.patterns {
+			v = redaction.pattern.ReplaceAllString(v, redaction.replacement)
+		}
+		return v
+	case map[string]any:
+		for key, item := range v {
+			v[key] = p.redactStrings(item)
+		}
+	case []any:
+		for i, item := range v {
+			v[i] = p.redactStrings(item)
+		}
+	}
+	return value
+}
+
+func decodeJSON(data []byte) (any, bool) {
+	decoder := json.NewDecoder(bytes.NewReader(data))
+	decoder.UseNumber()
+
+	var value any
+	if err := decoder.Decode(&value); err != nil || decoder.More() {
+		return nil, false
+	}
+	return value, true
+}
+
+// redactPath replaces the values at the path, changed reports whether any value was replaced so far.
+func redactPath(value any, segments []string, replacement string, changed bool) (any, bool) {
+	if len(segments) == 0 {
+		return replacement, true
+	}
+
+	segment, rest := segments[0], segments[1:]
+	switch v := value.(type) {
+	case map[string]any:
+		if segment == "*" {
+			for key, item := range v {
+				v[key], changed = redactPath(item, rest, replacement, changed)
+			}
+		} else if item, ok := v[segment]; ok {
+			v[segment], changed = redactPath(item, rest, replacement, changed)
+		}
+	case []any:
+		if segment == "*" {
+			for i, item := range v {
+				v[i], changed = redactPath(item, rest, replacement, changed)
+			}
+		} else if i, err := strconv.Atoi(segment); err == nil && i >= 0 && i < len(v) {
+			v[i], changed = redactPath(v[i], rest, replacement, changed)
+		}
+	}
+	return value, changed
+}
@@ -0,0 +1,194 @@
+package coordinator
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+	"testing"
+	"unicode/utf8"
+
+	"github.com/sananguliyev/airtruct/internal/persistence"
+)
+
+func newTestEventPolicy(t *testing.T, sampleRate *float64, maxContentSize int64, redactions ...persistence.EventRedaction) *EventPolicy {
+	t.Helper()
+	flow := persistence.Flow{EventSampleRate: sampleRate, EventMaxContentSize: maxContentSize}
+	flow.SetEventRedactions(redactions)
+	policy, err := NewEventPolicy(flow)
+	if err != nil {
+		t.Fatalf("failed to compile event policy: %v", err)
+	}
+	return policy
+}
+
+func TestNewEventPolicyRejectsInvalidRedactions(t *testing.T) {
+	tests := map[string]persistence.EventRedaction{
+		"nothing set":     {},
+		"two fields set":  {Path: "$.email", Preset: RedactionPresetEmail},
+		"empty segment":   {Path: "$.customer..email"},
+		"invalid pattern": {Pattern: "("},
+		"unknown preset":  {Preset: "passport"},
+	}
+	for name, redaction := range tests {
+		t.Run(name, func(t *testing.T) {
+			flow := persistence.Flow{}
+			flow.SetEventRedactions([]persistence.EventRedaction{redaction})
+			if err := ValidateEventSettings(flow); err == nil {
+				t.Error("expected error")
+			}
+		})
+	}
+}
+
+func TestEventPolicyRedaction(t *testing.T) {
+	const secret = "jane@example.com"
+	policy := newTestEventPolicy(t, nil, 0,
+		persistence.EventRedaction{Path: "$.customer.token", Replacement: "***"},
+		persistence.EventRedaction{Path: "items.*.card"},
+		persistence.EventRedaction{Preset: RedactionPresetEmail},
+		persistence.EventRedaction{Pattern: `sk_live_[a-z0-9]+`},
+	)
+
+	tests := []struct {
+		name    string
+		content string
+		meta    string
+		hidden  []string
+		kept    []string
+	}{
+		{
+			name:    "json paths",
+			content: `{"customer":{"token":"tok-123","name":"Jane"},"items":[{"card":"4111"},{"card":"5500"}]}`,
+			hidden:  []string{"tok-123", "4111", "5500"},
+			kept:    []string{`"token":"***"`, `"Jane"`, DefaultRedactionReplacement},
+		},
+		{
+			name:    "patterns in json values",
+			content: `{"contact":"` + secret + `","key":"sk_live_abc123"}`,
+			hidden:  []string{secret, "sk_live_abc123"},
+		},
+		{
+			name:    "patterns in plain text",
+			content: "mail " + secret + " now",
+			hidden:  []string{secret},
+			kept:    []string{"mail [REDACTED] now"},
+		},
+		{
+			name:    "paths skip content that is not json",
+			content: `customer.token=tok-123`,
+			kept:    []string{"tok-123"},
+		},
+		{
+			name:    "patterns in meta",
+			content: "{}",
+			meta:    `{"headers":{"From":"` + secret + `"},"list":["sk_live_xyz"]}`,
+			hidden:  []string{secret, "sk_live_xyz"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			event := &persistence.Event{TraceID: "trace-1", Content: tt.content}
+			if tt.meta != "" {
+				event.Meta = json.RawMessage(tt.meta)
+			}
+			if !policy.Apply(event) {
+				t.Fatal("expected the event to be kept")
+			}
+			stored := event.Content + string(event.Meta)
+			for _, hidden := range tt.hidden {
+				if strings.Contains(stored, hidden) {
+					t.Errorf("expected %q to be redacted, got content %s and meta %s", hidden, event.Content, event.Meta)
+				}
+			}
+			for _, kept := range tt.kept {
+				if !strings.Contains(event.Content, kept) {
+					t.Errorf("expected content to contain %q, got %s", kept, event.Content)
+				}
+			}
+			if tt.meta != "" && !json.Valid(event.Meta) {
+				t.Errorf("expected meta to stay valid json, got %s", event.Meta)
+			}
+		})
+	}
+}
+
+func TestEventPolicyRedactsBeforeTruncating(t *testing.T) {
+	const secret = "jane@example.com"
+	policy := newTestEventPolicy(t, nil, 20, persistence.EventRedaction{Preset: RedactionPresetEmail})
+
+	// The address crosses the truncation point, a truncated part of it must not be stored either.
+	event := &persistence.Event{Content: "contact: " + secret + " " + strings.Repeat("x", 50)}
+	policy.Apply(event)
+	if strings.Contains(event.Content, "jane") {
+		t.Errorf("expected the address to be redacted before truncating, got %q", event.Content)
+	}
+}
+
+func TestEventPolicyTruncation(t *testing.T) {
+	tests := []struct {
+		name    string
+		content string
+		max     int64
+		want    string
+	}{
+		{name: "within limit", content: "hello", max: 5, want: "hello"},
+		{name: "ascii", content: "hello world", max: 5, want: "hello...[truncated 6 bytes]"},
+		// "é" is 2 bytes, the limit falls in its middle.
+		{name: "two byte rune", content: "abcé", max: 4, want: "abc...[truncated 2 bytes]"},
+		// "€" is 3 bytes, the limit falls after its first and second byte.
+		{name: "three byte rune first byte", content: "ab€cd", max: 3, want: "ab...[truncated 5 bytes]"},
+		{name: "three byte rune second byte", content: "ab€cd", max: 4, want: "ab...[truncated 5 bytes]"},
+		// "😀" is 4 bytes.
+		{name: "four byte rune", content: "😀😀", max: 6, want: "😀...[truncated 4 bytes]"},
+		{name: "no limit", content: strings.Repeat("é", 100), max: 0, want: strings.Repeat("é", 100)},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			event := &persistence.Event{Content: tt.content}
+			newTestEventPolicy(t, nil, tt.max).Apply(event)
+			if event.Content != tt.want {
+				t.Errorf("expected %q, got %q", tt.want, event.Content)
+			}
+			if !utf8.ValidString(event.Content) {
+				t.Errorf("expected valid UTF-8, got %q", event.Content)
+			}
+		})
+	}
+}
+
+func TestEventPolicySampling(t *testing.T) {
+	rate := 0.3
+	policy := newTestEventPolicy(t, &rate, 0)
+	other := newTestEventPolicy(t, &rate, 0)
+
+	sampled := 0
+	for i := range 2000 {
+		traceID := fmt.Sprintf("trace-%d", i)
+		first := policy.Apply(&persistence.Event{TraceID: traceID})
+		// Every event of a trace gets the same decision, also from another policy, e.g. after a restart.
+		for range 3 {
+			if policy.Apply(&persistence.Event{TraceID: traceID}) != first || other.Apply(&persistence.Event{TraceID: traceID}) != first {
+				t.Fatalf("expected a stable decision for trace %s", traceID)
+			}
+		}
+		if first {
+			sampled++
+		}
+	}
+	if sampled < 500 || sampled > 700 {
+		t.Errorf("expected about 30%% of 2000 traces to be sampled, got %d", sampled)
+	}
+
+	none, all := 0.0, 1.0
+	if newTestEventPolicy(t, &none, 0).Apply(&persistence.Event{TraceID: "trace"}) {
+		t.Error("expected no events with a sample rate of 0")
+	}
+	if !newTestEventPolicy(t, &all, 0).Apply(&persistence.Event{TraceID: "trace"}) {
+		t.Error("expected every event with a sample rate of 1")
+	}
+	if !newTestEventPolicy(t, nil, 0).Apply(&persistence.Event{}) {
+		t.Error("expected every event without a sample rate")
+	}
+}
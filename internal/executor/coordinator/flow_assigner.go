@@ -3,9 +3,12 @@ package coordinator
 import (
 	"container/heap"
 	"context"
+	"strconv"
+	"time"
 
 	"github.com/rs/zerolog/log"
 
+	"github.com/sananguliyev/airtruct/internal/metrics"
 	"github.com/sananguliyev/airtruct/internal/persistence"
 	pb "github.com/sananguliyev/airtruct/internal/protogen"
 	"github.com/sananguliyev/airtruct/internal/utils"
@@ -64,7 +67,11 @@ func (s *flowAssigner) AssignFlows(ctx context.Context) error {
 
 	for _, flow := range flows {
 		worker := heap.Pop(workerHeap).(persistence.Worker)
+		start := time.Now()
 		err = s.assignFlowToWorker(ctx, worker, flow)
+		metrics.FlowAssignmentDuration.
+			WithLabelValues(flow.Name, strconv.FormatInt(flow.ID, 10), metrics.Result(err)).
+			Observe(time.Since(start).Seconds())
 		if err != nil {
 			log.Error().
 				Err(err).
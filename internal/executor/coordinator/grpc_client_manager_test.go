@@ -0,0 +1,94 @@
+package coordinator
+
+import (
+	"context"
+	"net"
+	"strings"
+	"testing"
+
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/propagation"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+
+	"github.com/sananguliyev/airtruct/internal/persistence"
+	pb "github.com/sananguliyev/airtruct/internal/protogen"
+	"github.com/sananguliyev/airtruct/internal/tracing"
+)
+
+// metadataWorkerServer records the metadata of the ingest requests it receives.
+type metadataWorkerServer struct {
+	pb.UnimplementedWorkerServer
+	metadata chan metadata.MD
+}
+
+func (s *metadataWorkerServer) Ingest(ctx context.Context, _ *pb.IngestRequest) (*pb.IngestResponse, error) {
+	md, _ := metadata.FromIncomingContext(ctx)
+	s.metadata <- md
+	return &pb.IngestResponse{}, nil
+}
+
+func startTestWorkerServer(t *testing.T) (*persistence.Worker, *metadataWorkerServer) {
+	t.Helper()
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatalf("failed to listen: %v", err)
+	}
+
+	server := &metadataWorkerServer{metadata: make(chan metadata.MD, 1)}
+	grpcServer := grpc.NewServer()
+	pb.RegisterWorkerServer(grpcServer, server)
+	go grpcServer.Serve(listener)
+	t.Cleanup(grpcServer.Stop)
+
+	return &persistence.Worker{ID: "worker-1", Address: listener.Addr().String()}, server
+}
+
+func TestGRPCClientPassesTraceContextToWorker(t *testing.T) {
+	recorder := tracetest.NewSpanRecorder()
+	provider := sdktrace.NewTracerProvider(sdktrace.WithSpanProcessor(recorder))
+	previousProvider, previousPropagator := otel.GetTracerProvider(), otel.GetTextMapPropagator()
+	otel.SetTracerProvider(provider)
+	otel.SetTextMapPropagator(propagation.TraceContext{})
+	t.Cleanup(func() {
+		otel.SetTracerProvider(previousProvider)
+		otel.SetTextMapPropagator(previousPropagator)
+	})
+
+	worker, server := startTestWorkerServer(t)
+	client, err := NewGRPCClientManager().GetClient(worker)
+	if err != nil {
+		t.Fatalf("GetClient returned error: %v", err)
+	}
+
+	ctx, span := tracing.Tracer().Start(t.Context(), "ingest")
+	if _, err := client.Ingest(ctx, &pb.IngestRequest{WorkerFlowId: 1}); err != nil {
+		t.Fatalf("Ingest returned error: %v", err)
+	}
+	span.End()
+
+	traceparent := (<-server.metadata).Get("traceparent")
+	if len(traceparent) != 1 {
+		t.Fatalf("expected a traceparent in the worker metadata, got %v", traceparent)
+	}
+	traceID := span.SpanContext().TraceID().String()
+	if !strings.Contains(traceparent[0], traceID) {
+		t.Errorf("expected traceparent %q to continue trace %s", traceparent[0], traceID)
+	}
+
+	// The worker call is a child span of the coordinator's span and is the parent passed on to the worker.
+	var clientSpan sdktrace.ReadOnlySpan
+	for _, s := range recorder.Ended() {
+		if s.Parent().SpanID() == span.SpanContext().SpanID() {
+			clientSpan = s
+		}
+	}
+	if clientSpan == nil {
+		t.Fatalf("expected a span for the worker call, got %d spans", len(recorder.Ended()))
+	}
+	if !strings.Contains(traceparent[0], clientSpan.SpanContext().SpanID().String()) {
+		t.Errorf("expected traceparent %q to reference the span of the worker call %s", traceparent[0], clientSpan.SpanContext().SpanID())
+	}
+}
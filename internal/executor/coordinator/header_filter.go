@@ -0,0 +1,78 @@
+package coordinator
+
+import (
+	"net/http"
+	"strings"
+)
+
+// Headers that describe a single connection and are never forwarded between clients and flows.
+var hopByHopHeaders = map[string]bool{
+	"Connection":          true,
+	"Content-Length":      true,
+	"Keep-Alive":          true,
+	"Proxy-Authenticate":  true,
+	"Proxy-Authorization": true,
+	"Te":                  true,
+	"Trailer":             true,
+	"Transfer-Encoding":   true,
+	"Upgrade":             true,
+}
+
+// HeaderFilter decides which headers are forwarded. Patterns are case-insensitive header names,
+// a trailing * matches any header with that prefix, e.g. X-Internal-*.
+type HeaderFilter struct {
+	allow []string
+	deny  []string
+}
+
+func NewHeaderFilter(allow, deny []string) *HeaderFilter {
+	return &HeaderFilter{
+		allow: canonicalPatterns(allow),
+		deny:  canonicalPatterns(deny),
+	}
+}
+
+func canonicalPatterns(patterns []string) []string {
+	result := make([]string, 0, len(patterns))
+	for _, pattern := range patterns {
+		if pattern = strings.TrimSpace(pattern); pattern != "" {
+			result = append(result, strings.ToLower(pattern))
+		}
+	}
+	return result
+}
+
+func (f *HeaderFilter) Allowed(key string) bool {
+	if hopByHopHeaders[http.CanonicalHeaderKey(key)] {
+		return false
+	}
+
+	key = strings.ToLower(key)
+	if matchHeader(f.deny, key) {
+		return false
+	}
+	return len(f.allow) == 0 || matchHeader(f.allow, key)
+}
+
+func (f *HeaderFilter) Filter(headers http.Header) http.Header {
+	result := make(http.Header, len(headers))
+	for key, values := range headers {
+		if f.Allowed(key) {
+			result[key] = values
+		}
+	}
+	return result
+}
+
+func matchHeader(patterns []string, key string) bool {
+	for _, pattern := range patterns {
+		if prefix, ok := strings.CutSuffix(pattern, "*"); ok {
+			if strings.HasPrefix(key, prefix) {
+				return true
+			}
+		} else if pattern == key {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,63 @@
+package coordinator
+
+import (
+	"net/http"
+	"testing"
+)
+
+func TestHeaderFilterAllowed(t *testing.T) {
+	tests := []struct {
+		name   string
+		allow  []string
+		deny   []string
+		header string
+		want   bool
+	}{
+		{name: "everything by default", header: "X-Request-Id", want: true},
+		{name: "hop by hop", header: "Connection", want: false},
+		{name: "hop by hop lower case", header: "transfer-encoding", want: false},
+		{name: "hop by hop even if allowed", allow: []string{"Upgrade"}, header: "Upgrade", want: false},
+		{name: "denied", deny: []string{"Cookie"}, header: "cookie", want: false},
+		{name: "denied prefix", deny: []string{"X-Internal-*"}, header: "X-Internal-Token", want: false},
+		{name: "deny wins over allow", allow: []string{"X-*"}, deny: []string{"X-Secret"}, header: "X-Secret", want: false},
+		{name: "allowed", allow: []string{" content-type ", ""}, header: "Content-Type", want: true},
+		{name: "allowed prefix", allow: []string{"x-*"}, header: "X-Hub-Signature", want: true},
+		{name: "not in allow list", allow: []string{"Content-Type"}, header: "Authorization", want: false},
+		{name: "prefix is not a substring match", deny: []string{"Internal-*"}, header: "X-Internal-Id", want: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			filter := NewHeaderFilter(tt.allow, tt.deny)
+			if got := filter.Allowed(tt.header); got != tt.want {
+				t.Errorf("Allowed(%q) = %t, want %t", tt.header, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestHeaderFilterFilter(t *testing.T) {
+	filter := NewHeaderFilter(nil, []string{"Authorization"})
+
+	headers := http.Header{}
+	headers.Add("Content-Type", "application/json")
+	headers.Add("Accept", "text/plain")
+	headers.Add("Accept", "application/json")
+	headers.Add("Authorization", "Bearer token")
+	headers.Add("Content-Length", "42")
+
+	filtered := filter.Filter(headers)
+
+	if len(filtered) != 2 {
+		t.Fatalf("expected 2 headers, got %v", filtered)
+	}
+	if values := filtered.Values("Accept"); len(values) != 2 {
+		t.Errorf("expected all values of Accept, got %v", values)
+	}
+	if filtered.Get("Authorization") != "" || filtered.Get("Content-Length") != "" {
+		t.Errorf("unexpected headers forwarded: %v", filtered)
+	}
+	if headers.Get("Authorization") == "" {
+		t.Error("the original headers must not be modified")
+	}
+}
@@ -0,0 +1,249 @@
+package coordinator
+
+import (
+	"fmt"
+	"net/http"
+	"os"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/rs/zerolog/log"
+
+	"github.com/sananguliyev/airtruct/internal/persistence"
+	"github.com/sananguliyev/airtruct/internal/vault"
+)
+
+// ingressPolicyTTL bounds how long a compiled policy is reused, so rotated secrets are picked up.
+const ingressPolicyTTL = 30 * time.Second
+
+// IngressError rejects a request before it reaches the flow, StatusCode is the HTTP status to respond with
+// and Header holds additional response headers such as Allow.
+type IngressError struct {
+	StatusCode int
+	Message    string
+	Header     http.Header
+}
+
+func newIngressError(statusCode int, format string, args ...any) *IngressError {
+	return &IngressError{StatusCode: statusCode, Message: fmt.Sprintf(format, args...)}
+}
+
+func (e *IngressError) Error() string {
+	return e.Message
+}
+
+type cachedIngressPolicy struct {
+	policy *ingressPolicy
+	// flowName and flowVersion label the metrics of requests to the flow.
+	flowName    string
+	flowVersion string
+	loadedAt    time.Time
+}
+
+// IngressGuard enforces the ingress policies of flows on incoming requests. Requests addressed to any version of
+// a flow run on its current version, so they are checked against the policy of the current version.
+type IngressGuard struct {
+	flowRepo   persistence.FlowRepository
+	secretRepo persistence.SecretRepository
+	aesgcm     *vault.AESGCM
+	nonces     *nonceCache
+
+	mu sync.Mutex
+	// policies holds the policy of the current version by lineage, lineages the lineage of every flow ID
+	// requests were addressed to.
+	policies map[int64]cachedIngressPolicy
+	lineages map[int64]int64
+}
+
+func NewIngressGuard(flowRepo persistence.FlowRepository, secretRepo persistence.SecretRepository, aesgcm *vault.AESGCM) *IngressGuard {
+	return &IngressGuard{
+		flowRepo:   flowRepo,
+		secretRepo: secretRepo,
+		aesgcm:     aesgcm,
+		nonces:     newNonceCache(),
+		policies:   make(map[int64]cachedIngressPolicy),
+		lineages:   make(map[int64]int64),
+	}
+}
+
+// Load returns the ingress policy of the flow, nil for flows without one.
+func (g *IngressGuard) Load(flowID int64) (*ingressPolicy, error) {
+	policy, err := g.policy(flowID)
+	if err != nil {
+		log.Error().Err(err).Int64("flow_id", flowID).Msg("Failed to load ingress policy")
+		return nil, newIngressError(http.StatusInternalServerError, "ingress policy of flow %d is unavailable", flowID)
+	}
+	return policy, nil
+}
+
+// FlowLabels returns the name and version of a flow loaded before, for the metrics of its requests.
+func (g *IngressGuard) FlowLabels(flowID int64) (string, string) {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+
+	if cached, ok := g.policies[g.lineages[flowID]]; ok {
+		return cached.flowName, cached.flowVersion
+	}
+	return "", strconv.FormatInt(flowID, 10)
+}
+
+// Check returns an *IngressError when the request does not satisfy the ingress policy of the flow. The replay
+// nonce of the request is recorded and returned, it has to be passed to ForgetNonce when the request is not
+// delivered, so the sender can retry it.
+func (g *IngressGuard) Check(policy *ingressPolicy, r *http.Request, body []byte) (string, error) {
+	if policy == nil {
+		return "", nil
+	}
+
+	now := time.Now()
+	nonce, err := policy.check(r, body, now)
+	if err != nil || nonce == "" {
+		return "", err
+	}
+	// Nonces are shared by all versions of the flow.
+	nonce = strconv.FormatInt(policy.lineageID, 10) + ":" + nonce
+	if g.nonces.seen(nonce, policy.replayTTL, now) {
+		return "", newIngressError(http.StatusConflict, "request has already been received")
+	}
+	return nonce, nil
+}
+
+// ForgetNonce removes a nonce recorded by Check.
+func (g *IngressGuard) ForgetNonce(nonce string) {
+	if nonce != "" {
+		g.nonces.forget(nonce)
+	}
+}
+
+// policy returns the policy of the current version of the flow, nil for flows without one or that do not exist.
+func (g *IngressGuard) policy(flowID int64) (*ingressPolicy, error) {
+	lineageID, err := g.lineageID(flowID)
+	if err != nil || lineageID == 0 {
+		return nil, err
+	}
+
+	g.mu.Lock()
+	cached, ok := g.policies[lineageID]
+	g.mu.Unlock()
+	if ok && time.Since(cached.loadedAt) < ingressPolicyTTL {
+		return cached.policy, nil
+	}
+
+	versions, err := g.flowRepo.ListAllVersionsByParentID(lineageID)
+	if err != nil {
+		return nil, err
+	}
+	var current *persistence.Flow
+	for i := range versions {
+		if versions[i].IsCurrent {
+			current = &versions[i]
+		}
+	}
+
+	var policy *ingressPolicy
+	cached = cachedIngressPolicy{flowVersion: strconv.FormatInt(flowID, 10)}
+	if current != nil {
+		cached.flowName = current.Name
+		cached.flowVersion = strconv.FormatInt(current.ID, 10)
+		config, err := ParseIngressPolicy(*current)
+		if err != nil {
+			return nil, err
+		}
+		if config != nil {
+			if policy, err = compileIngressPolicy(config, g.expandSecrets); err != nil {
+				return nil, err
+			}
+			policy.lineageID = lineageID
+		}
+	}
+
+	cached.policy = policy
+	cached.loadedAt = time.Now()
+	g.mu.Lock()
+	g.policies[lineageID] = cached
+	g.mu.Unlock()
+	return policy, nil
+}
+
+// lineageID returns the ID shared by all versions of the flow, zero when the flow does not exist.
+func (g *IngressGuard) lineageID(flowID int64) (int64, error) {
+	g.mu.Lock()
+	lineageID, ok := g.lineages[flowID]
+	g.mu.Unlock()
+	if ok {
+		return lineageID, nil
+	}
+
+	flow, err := g.flowRepo.FindByID(flowID)
+	if err != nil || flow == nil {
+		return 0, err
+	}
+
+	// The lineage of a flow never changes, it is kept for as long as the coordinator runs.
+	lineageID = flowLineageID(*flow)
+	g.mu.Lock()
+	g.lineages[flowID] = lineageID
+	g.mu.Unlock()
+	return lineageID, nil
+}
+
+func (g *IngressGuard) expandSecrets(s string) (string, error) {
+	var missing []string
+	expanded := os.Expand(s, func(key string) string {
+		secret, err := g.secretRepo.GetByKey(key)
+		if err != nil || secret == nil {
+			missing = append(missing, key)
+			return ""
+		}
+		value, err := g.aesgcm.Decrypt(secret.EncryptedValue)
+		if err != nil {
+			missing = append(missing, key)
+			return ""
+		}
+		return value
+	})
+	if len(missing) > 0 {
+		return "", fmt.Errorf("failed to resolve secrets: %v", missing)
+	}
+	return expanded, nil
+}
+
+// nonceCache remembers request nonces until their TTL passes.
+type nonceCache struct {
+	mu        sync.Mutex
+	entries   map[string]time.Time
+	lastSweep time.Time
+}
+
+func newNonceCache() *nonceCache {
+	return &nonceCache{entries: make(map[string]time.Time)}
+}
+
+// seen records the nonce and reports whether it was already recorded and has not expired yet.
+func (c *nonceCache) seen(nonce string, ttl time.Duration, now time.Time) bool {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if now.Sub(c.lastSweep) > time.Minute {
+		for key, expiresAt := range c.entries {
+			if now.After(expiresAt) {
+				delete(c.entries, key)
+			}
+		}
+		c.lastSweep = now
+	}
+
+	if expiresAt, ok := c.entries[nonce]; ok && now.Before(expiresAt) {
+		return true
+	}
+	c.entries[nonce] = now.Add(ttl)
+	return false
+}
+
+func (c *nonceCache) forget(nonce string) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	delete(c.entries, nonce)
+}
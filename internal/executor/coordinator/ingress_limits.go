@@ -0,0 +1,90 @@
+package coordinator
+
+import (
+	"sync"
+	"time"
+)
+
+// ingressLimits bound the resources a single ingest request can take on the coordinator. Zero values do not
+// limit anything.
+type ingressLimits struct {
+	maxBodySize int64
+	timeout     time.Duration
+	maxInFlight int
+}
+
+// merge returns the limits with the non-zero values of override applied.
+func (l ingressLimits) merge(override ingressLimits) ingressLimits {
+	if override.maxBodySize > 0 {
+		l.maxBodySize = override.maxBodySize
+	}
+	if override.timeout > 0 {
+		l.timeout = override.timeout
+	}
+	if override.maxInFlight > 0 {
+		l.maxInFlight = override.maxInFlight
+	}
+	return l
+}
+
+// concurrencyLimiter admits a bounded number of requests at once without blocking, a limit of zero admits
+// any number.
+type concurrencyLimiter struct {
+	slots chan struct{}
+}
+
+func newConcurrencyLimiter(limit int) *concurrencyLimiter {
+	if limit <= 0 {
+		return &concurrencyLimiter{}
+	}
+	return &concurrencyLimiter{slots: make(chan struct{}, limit)}
+}
+
+func (l *concurrencyLimiter) tryAcquire() bool {
+	if l.slots == nil {
+		return true
+	}
+	select {
+	case l.slots <- struct{}{}:
+		return true
+	default:
+		return false
+	}
+}
+
+func (l *concurrencyLimiter) release() {
+	if l.slots != nil {
+		<-l.slots
+	}
+}
+
+// inFlightCounter counts the requests each flow is handling.
+type inFlightCounter struct {
+	mu     sync.Mutex
+	counts map[int64]int
+}
+
+func newInFlightCounter() *inFlightCounter {
+	return &inFlightCounter{counts: make(map[int64]int)}
+}
+
+// tryAcquire counts a request of the flow unless it already has limit requests in flight.
+func (c *inFlightCounter) tryAcquire(flowID int64, limit int) bool {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if limit > 0 && c.counts[flowID] >= limit {
+		return false
+	}
+	c.counts[flowID]++
+	return true
+}
+
+func (c *inFlightCounter) release(flowID int64) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if c.counts[flowID] <= 1 {
+		delete(c.counts, flowID)
+		return
+	}
+	c.counts[flowID]--
+}
@@ -0,0 +1,169 @@
+package coordinator
+
+import (
+	"bytes"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+func TestIngressLimitsMerge(t *testing.T) {
+	defaults := ingressLimits{maxBodySize: 1024, timeout: 30 * time.Second, maxInFlight: 10}
+
+	tests := []struct {
+		name     string
+		override ingressLimits
+		want     ingressLimits
+	}{
+		{name: "no override", want: defaults},
+		{
+			name:     "body size",
+			override: ingressLimits{maxBodySize: 64},
+			want:     ingressLimits{maxBodySize: 64, timeout: 30 * time.Second, maxInFlight: 10},
+		},
+		{
+			name:     "timeout and in flight",
+			override: ingressLimits{timeout: time.Second, maxInFlight: 2},
+			want:     ingressLimits{maxBodySize: 1024, timeout: time.Second, maxInFlight: 2},
+		},
+		{
+			name:     "negative values are ignored",
+			override: ingressLimits{maxBodySize: -1, timeout: -time.Second, maxInFlight: -1},
+			want:     defaults,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := defaults.merge(tt.override); got != tt.want {
+				t.Errorf("merge() = %+v, want %+v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestCompileIngressPolicyLimits(t *testing.T) {
+	policy := compileTestPolicy(t, &IngressPolicyConfig{Limits: LimitsConfig{MaxBodySize: "1KiB", Timeout: "5s", MaxInFlight: 3}})
+	want := ingressLimits{maxBodySize: 1024, timeout: 5 * time.Second, maxInFlight: 3}
+	if policy.limits != want {
+		t.Errorf("expected limits %+v, got %+v", want, policy.limits)
+	}
+
+	for _, limits := range []string{"max_body_size: lots", "timeout: 0s", "timeout: soon", "max_in_flight: -1"} {
+		if _, err := ParseIngressPolicy(testHTTPServerFlow("ingress:\n  limits:\n    " + limits + "\n")); err == nil {
+			t.Errorf("expected error for limits %q", limits)
+		}
+	}
+}
+
+func TestConcurrencyLimiter(t *testing.T) {
+	limiter := newConcurrencyLimiter(2)
+	if !limiter.tryAcquire() || !limiter.tryAcquire() {
+		t.Fatal("expected 2 slots to be acquired")
+	}
+	if limiter.tryAcquire() {
+		t.Fatal("expected the third request to be rejected")
+	}
+	limiter.release()
+	if !limiter.tryAcquire() {
+		t.Error("expected a released slot to be acquired again")
+	}
+
+	unlimited := newConcurrencyLimiter(0)
+	for range 100 {
+		if !unlimited.tryAcquire() {
+			t.Fatal("expected a limit of zero to admit every request")
+		}
+	}
+	unlimited.release()
+}
+
+func TestInFlightCounter(t *testing.T) {
+	counter := newInFlightCounter()
+	if !counter.tryAcquire(1, 1) {
+		t.Fatal("expected the first request to be admitted")
+	}
+	if counter.tryAcquire(1, 1) {
+		t.Error("expected the second request of the flow to be rejected")
+	}
+	if !counter.tryAcquire(2, 1) {
+		t.Error("expected flows to be counted separately")
+	}
+	if !counter.tryAcquire(1, 0) {
+		t.Error("expected a limit of zero to admit the request")
+	}
+
+	counter.release(1)
+	counter.release(1)
+	counter.release(2)
+	if len(counter.counts) != 0 {
+		t.Errorf("expected released flows to be removed, got %v", counter.counts)
+	}
+	// Releasing more than acquired must not go negative and block later requests.
+	counter.release(1)
+	if !counter.tryAcquire(1, 1) {
+		t.Error("expected the request to be admitted after the flow was released")
+	}
+}
+
+func TestInFlightCounterConcurrent(t *testing.T) {
+	counter := newInFlightCounter()
+	var admitted, peak, current atomic.Int64
+	var wg sync.WaitGroup
+	start := make(chan struct{})
+	for range 50 {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			<-start
+			if !counter.tryAcquire(1, 5) {
+				return
+			}
+			admitted.Add(1)
+			n := current.Add(1)
+			for {
+				p := peak.Load()
+				if n <= p || peak.CompareAndSwap(p, n) {
+					break
+				}
+			}
+			time.Sleep(time.Millisecond)
+			current.Add(-1)
+			counter.release(1)
+		}()
+	}
+	close(start)
+	wg.Wait()
+
+	if peak.Load() > 5 {
+		t.Errorf("expected at most 5 requests in flight, got %d", peak.Load())
+	}
+	if admitted.Load() == 0 {
+		t.Error("expected requests to be admitted")
+	}
+}
+
+func TestReadBodyLimit(t *testing.T) {
+	f := &requestForwarder{}
+	limits := ingressLimits{maxBodySize: 4}
+
+	r := httptest.NewRequest(http.MethodPost, "/ingest/1", bytes.NewReader([]byte("abcd")))
+	if body, err := f.readBody(r, httptest.NewRecorder(), limits); err != nil || string(body) != "abcd" {
+		t.Errorf("expected the body within the limit, got %q: %v", body, err)
+	}
+
+	r = httptest.NewRequest(http.MethodPost, "/ingest/1", bytes.NewReader([]byte("abcde")))
+	if _, err := f.readBody(r, httptest.NewRecorder(), limits); statusOf(err) != http.StatusRequestEntityTooLarge {
+		t.Errorf("expected 413 for a declared length over the limit, got %v", err)
+	}
+
+	// Without Content-Length the body is cut off while reading.
+	r = httptest.NewRequest(http.MethodPost, "/ingest/1", bytes.NewReader([]byte("abcde")))
+	r.ContentLength = -1
+	if _, err := f.readBody(r, httptest.NewRecorder(), limits); statusOf(err) != http.StatusRequestEntityTooLarge {
+		t.Errorf("expected 413 for a streamed body over the limit, got %v", err)
+	}
+}
@@ -0,0 +1,652 @@
+package coordinator
+
+import (
+	"crypto/hmac"
+	"crypto/sha1"
+	"crypto/sha256"
+	"crypto/sha512"
+	"encoding/base64"
+	"encoding/hex"
+	"fmt"
+	"hash"
+	"net"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/dustin/go-humanize"
+	"gopkg.in/yaml.v3"
+
+	"github.com/sananguliyev/airtruct/internal/persistence"
+)
+
+const (
+	SignaturePresetCustom  = "custom"
+	SignaturePresetGitHub  = "github"
+	SignaturePresetStripe  = "stripe"
+	SignaturePresetShopify = "shopify"
+	SignaturePresetSlack   = "slack"
+
+	DefaultAPIKeyHeader       = "X-API-Key"
+	DefaultSignatureTolerance = 5 * time.Minute
+	DefaultReplayTTL          = 10 * time.Minute
+
+	RateLimitKeyIP     = "ip"
+	RateLimitKeyAPIKey = "api_key"
+	RateLimitKeyHeader = "header"
+	RateLimitKeyGlobal = "global"
+
+	DefaultQueueMaxAge      = 24 * time.Hour
+	DefaultQueueMaxRequests = 10000
+	DefaultQueueMaxAttempts = 5
+)
+
+// IngressPolicyConfig is the ingress block of an http_server input. It is enforced by the coordinator before a
+// request is forwarded and never reaches the worker.
+type IngressPolicyConfig struct {
+	APIKey            APIKeyConfig    `yaml:"api_key"`
+	Signature         SignatureConfig `yaml:"signature"`
+	IPAllowlist       []string        `yaml:"ip_allowlist"`
+	TrustForwardedFor bool            `yaml:"trust_forwarded_for"`
+	TrustedProxyHops  int             `yaml:"trusted_proxy_hops"`
+	Replay            ReplayConfig    `yaml:"replay"`
+	Queue             QueueConfig     `yaml:"queue"`
+	Limits            LimitsConfig    `yaml:"limits"`
+	RateLimit         RateLimitConfig `yaml:"rate_limit"`
+}
+
+// APIKeyConfig requires a static key in a header or query parameter. Key usually references a secret, e.g.
+// ${INGEST_API_KEY}.
+type APIKeyConfig struct {
+	Key    string `yaml:"key"`
+	Header string `yaml:"header"`
+	Query  string `yaml:"query"`
+}
+
+// SignatureConfig verifies an HMAC of the request body. Presets fix the header, algorithm and encoding of the
+// provider, the remaining fields only apply to the custom preset.
+type SignatureConfig struct {
+	Preset    string `yaml:"preset"`
+	Secret    string `yaml:"secret"`
+	Header    string `yaml:"header"`
+	Algorithm string `yaml:"algorithm"`
+	Encoding  string `yaml:"encoding"`
+	Prefix    string `yaml:"prefix"`
+	Tolerance string `yaml:"tolerance"`
+}
+
+// ReplayConfig rejects requests whose nonce was already seen within TTL. The nonce is read from Header, the
+// delivery ID header of the signature preset or, failing those, the signature itself.
+type ReplayConfig struct {
+	Enabled bool   `yaml:"enabled"`
+	Header  string `yaml:"header"`
+	TTL     string `yaml:"ttl"`
+}
+
+// QueueConfig lets the coordinator accept requests while the flow is not running. They are stored and
+// replayed in order once the flow runs again, requests that cannot be delivered end up as dead letters.
+type QueueConfig struct {
+	Enabled     bool   `yaml:"enabled"`
+	MaxAge      string `yaml:"max_age"`
+	MaxRequests int    `yaml:"max_requests"`
+	MaxAttempts int    `yaml:"max_attempts"`
+}
+
+// LimitsConfig bounds the requests of a flow, unset values fall back to the coordinator defaults. MaxBodySize
+// accepts sizes like 512KB or 10MiB.
+type LimitsConfig struct {
+	MaxBodySize string `yaml:"max_body_size"`
+	Timeout     string `yaml:"timeout"`
+	MaxInFlight int    `yaml:"max_in_flight"`
+}
+
+// RateLimitConfig applies a rate limit resource to the requests of a flow, counted separately per client. Key
+// selects what identifies a client: ip, api_key, header or global.
+type RateLimitConfig struct {
+	Label  string `yaml:"label"`
+	Key    string `yaml:"key"`
+	Header string `yaml:"header"`
+	Cost   int64  `yaml:"cost"`
+}
+
+// ParseIngressPolicy returns the ingress policy of an http_server flow, or nil when the flow has none.
+func ParseIngressPolicy(flow persistence.Flow) (*IngressPolicyConfig, error) {
+	if flow.InputComponent != "http_server" {
+		return nil, nil
+	}
+
+	var inputConfig struct {
+		Ingress *IngressPolicyConfig `yaml:"ingress"`
+	}
+	if err := yaml.Unmarshal(flow.InputConfig, &inputConfig); err != nil {
+		return nil, err
+	}
+	policy := inputConfig.Ingress
+	if policy == nil {
+		return nil, nil
+	}
+
+	if policy.APIKey.Key == "" && (policy.APIKey.Header != "" || policy.APIKey.Query != "") {
+		return nil, fmt.Errorf("api_key.key is required")
+	}
+
+	if policy.Signature.Secret == "" && (policy.Signature.Header != "" || !isCustomPreset(policy.Signature.Preset)) {
+		return nil, fmt.Errorf("signature.secret is required")
+	}
+	if policy.Signature.Secret != "" {
+		switch policy.Signature.Preset {
+		case "", SignaturePresetCustom:
+			if policy.Signature.Header == "" {
+				return nil, fmt.Errorf("signature.header is required for custom signatures")
+			}
+			if _, err := hashFunc(policy.Signature.Algorithm); err != nil {
+				return nil, err
+			}
+			if _, err := decodeSignatureFunc(policy.Signature.Encoding); err != nil {
+				return nil, err
+			}
+		case SignaturePresetGitHub, SignaturePresetStripe, SignaturePresetShopify, SignaturePresetSlack:
+		default:
+			return nil, fmt.Errorf("unknown signature preset %q", policy.Signature.Preset)
+		}
+		if policy.Signature.Tolerance != "" {
+			if _, err := time.ParseDuration(policy.Signature.Tolerance); err != nil {
+				return nil, fmt.Errorf("invalid signature.tolerance: %w", err)
+			}
+		}
+	}
+
+	if _, err := parseNetworks(policy.IPAllowlist); err != nil {
+		return nil, err
+	}
+	if policy.TrustedProxyHops < 0 {
+		return nil, fmt.Errorf("trusted_proxy_hops cannot be negative")
+	}
+
+	if policy.Replay.Enabled {
+		if policy.Replay.TTL != "" {
+			if _, err := time.ParseDuration(policy.Replay.TTL); err != nil {
+				return nil, fmt.Errorf("invalid replay.ttl: %w", err)
+			}
+		}
+		if policy.Replay.Header == "" && policy.Signature.Secret == "" {
+			return nil, fmt.Errorf("replay protection requires replay.header or a signature")
+		}
+	}
+
+	if policy.Queue.Enabled {
+		if policy.Queue.MaxAge != "" {
+			if _, err := time.ParseDuration(policy.Queue.MaxAge); err != nil {
+				return nil, fmt.Errorf("invalid queue.max_age: %w", err)
+			}
+		}
+		if policy.Queue.MaxRequests < 0 || policy.Queue.MaxAttempts < 0 {
+			return nil, fmt.Errorf("queue.max_requests and queue.max_attempts cannot be negative")
+		}
+	}
+
+	if policy.Limits.MaxBodySize != "" {
+		if _, err := humanize.ParseBytes(policy.Limits.MaxBodySize); err != nil {
+			return nil, fmt.Errorf("invalid limits.max_body_size: %w", err)
+		}
+	}
+	if policy.Limits.Timeout != "" {
+		if d, err := time.ParseDuration(policy.Limits.Timeout); err != nil {
+			return nil, fmt.Errorf("invalid limits.timeout: %w", err)
+		} else if d <= 0 {
+			return nil, fmt.Errorf("limits.timeout must be positive")
+		}
+	}
+	if policy.Limits.MaxInFlight < 0 {
+		return nil, fmt.Errorf("limits.max_in_flight cannot be negative")
+	}
+
+	if policy.RateLimit.Label != "" {
+		switch policy.RateLimit.Key {
+		case "", RateLimitKeyIP, RateLimitKeyAPIKey, RateLimitKeyGlobal:
+		case RateLimitKeyHeader:
+			if policy.RateLimit.Header == "" {
+				return nil, fmt.Errorf("rate_limit.header is required when keyed by header")
+			}
+		default:
+			return nil, fmt.Errorf("unknown rate_limit.key %q", policy.RateLimit.Key)
+		}
+		if policy.RateLimit.Cost < 0 {
+			return nil, fmt.Errorf("rate_limit.cost cannot be negative")
+		}
+	}
+
+	return policy, nil
+}
+
+func isCustomPreset(preset string) bool {
+	return preset == "" || preset == SignaturePresetCustom
+}
+
+func hashFunc(algorithm string) (func() hash.Hash, error) {
+	switch strings.ToLower(algorithm) {
+	case "", "sha256":
+		return sha256.New, nil
+	case "sha1":
+		return sha1.New, nil
+	case "sha512":
+		return sha512.New, nil
+	default:
+		return nil, fmt.Errorf("unsupported signature algorithm %q", algorithm)
+	}
+}
+
+func decodeSignatureFunc(encoding string) (func(string) ([]byte, error), error) {
+	switch strings.ToLower(encoding) {
+	case "", "hex":
+		return hex.DecodeString, nil
+	case "base64":
+		return base64.StdEncoding.DecodeString, nil
+	default:
+		return nil, fmt.Errorf("unsupported signature encoding %q", encoding)
+	}
+}
+
+func parseNetworks(entries []string) ([]*net.IPNet, error) {
+	networks := make([]*net.IPNet, 0, len(entries))
+	for _, entry := range entries {
+		entry = strings.TrimSpace(entry)
+		if entry == "" {
+			continue
+		}
+		if !strings.Contains(entry, "/") {
+			ip := net.ParseIP(entry)
+			if ip == nil {
+				return nil, fmt.Errorf("invalid ip_allowlist entry %q", entry)
+			}
+			if ip.To4() != nil {
+				entry += "/32"
+			} else {
+				entry += "/128"
+			}
+		}
+		_, network, err := net.ParseCIDR(entry)
+		if err != nil {
+			return nil, fmt.Errorf("invalid ip_allowlist entry %q", entry)
+		}
+		networks = append(networks, network)
+	}
+	return networks, nil
+}
+
+// ingressPolicy is an IngressPolicyConfig with its secrets resolved, ready to check requests against.
+type ingressPolicy struct {
+	apiKey       []byte
+	apiKeyHeader string
+	apiKeyQuery  string
+	signature    *signatureVerifier
+	networks     []*net.IPNet
+	// proxyHops is the number of trusted proxies in front of the coordinator that append to X-Forwarded-For,
+	// zero when the header is not trusted.
+	proxyHops    int
+	replay       bool
+	replayHeader string
+	replayTTL    time.Duration
+	queue        *queuePolicy
+	limits       ingressLimits
+	rateLimit    *RateLimitConfig
+	// lineageID is the ID shared by all versions of the flow.
+	lineageID int64
+}
+
+// credentialHeaders returns the headers the policy reads credentials from, they are not stored with queued
+// requests.
+func (p *ingressPolicy) credentialHeaders() []string {
+	var headers []string
+	if p.apiKeyHeader != "" {
+		headers = append(headers, p.apiKeyHeader)
+	}
+	if p.signature != nil {
+		headers = append(headers, p.signature.header)
+	}
+	return headers
+}
+
+type queuePolicy struct {
+	maxAge      time.Duration
+	maxRequests int64
+	maxAttempts int
+}
+
+func newQueuePolicy(config QueueConfig) *queuePolicy {
+	queue := &queuePolicy{
+		maxAge:      DefaultQueueMaxAge,
+		maxRequests: DefaultQueueMaxRequests,
+		maxAttempts: DefaultQueueMaxAttempts,
+	}
+	if config.MaxAge != "" {
+		queue.maxAge, _ = time.ParseDuration(config.MaxAge)
+	}
+	if config.MaxRequests > 0 {
+		queue.maxRequests = int64(config.MaxRequests)
+	}
+	if config.MaxAttempts > 0 {
+		queue.maxAttempts = config.MaxAttempts
+	}
+	return queue
+}
+
+func compileIngressPolicy(config *IngressPolicyConfig, resolve func(string) (string, error)) (*ingressPolicy, error) {
+	policy := &ingressPolicy{}
+	if config.TrustForwardedFor {
+		policy.proxyHops = max(config.TrustedProxyHops, 1)
+	}
+
+	if config.APIKey.Key != "" {
+		key, err := resolve(config.APIKey.Key)
+		if err != nil {
+			return nil, err
+		}
+		policy.apiKey = []byte(key)
+		policy.apiKeyHeader = config.APIKey.Header
+		policy.apiKeyQuery = config.APIKey.Query
+		if policy.apiKeyHeader == "" && policy.apiKeyQuery == "" {
+			policy.apiKeyHeader = DefaultAPIKeyHeader
+		}
+	}
+
+	if config.Signature.Secret != "" {
+		secret, err := resolve(config.Signature.Secret)
+		if err != nil {
+			return nil, err
+		}
+		policy.signature, err = newSignatureVerifier(config.Signature, []byte(secret))
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	networks, err := parseNetworks(config.IPAllowlist)
+	if err != nil {
+		return nil, err
+	}
+	policy.networks = networks
+
+	if config.Replay.Enabled {
+		policy.replay = true
+		policy.replayHeader = config.Replay.Header
+		if policy.replayHeader == "" && policy.signature != nil {
+			policy.replayHeader = policy.signature.deliveryHeader
+		}
+		policy.replayTTL = DefaultReplayTTL
+		if config.Replay.TTL != "" {
+			policy.replayTTL, _ = time.ParseDuration(config.Replay.TTL)
+		}
+	}
+
+	if config.Queue.Enabled {
+		policy.queue = newQueuePolicy(config.Queue)
+	}
+
+	if config.Limits.MaxBodySize != "" {
+		size, _ := humanize.ParseBytes(config.Limits.MaxBodySize)
+		policy.limits.maxBodySize = int64(size)
+	}
+	if config.Limits.Timeout != "" {
+		policy.limits.timeout, _ = time.ParseDuration(config.Limits.Timeout)
+	}
+	policy.limits.maxInFlight = config.Limits.MaxInFlight
+
+	if config.RateLimit.Label != "" {
+		rateLimit := config.RateLimit
+		if rateLimit.Key == "" {
+			rateLimit.Key = RateLimitKeyIP
+		}
+		policy.rateLimit = &rateLimit
+	}
+
+	return policy, nil
+}
+
+// check verifies the request against the policy and returns the nonce to record for replay protection.
+func (p *ingressPolicy) check(r *http.Request, body []byte, now time.Time) (string, error) {
+	if len(p.networks) > 0 {
+		ip := clientIP(r, p.proxyHops)
+		if !containsIP(p.networks, ip) {
+			return "", newIngressError(http.StatusForbidden, "client address is not allowed")
+		}
+	}
+
+	if p.apiKey != nil {
+		var provided string
+		if p.apiKeyHeader != "" {
+			provided = r.Header.Get(p.apiKeyHeader)
+		}
+		if provided == "" && p.apiKeyQuery != "" {
+			provided = r.URL.Query().Get(p.apiKeyQuery)
+		}
+		if provided == "" || !hmac.Equal([]byte(provided), p.apiKey) {
+			return "", newIngressError(http.StatusUnauthorized, "invalid API key")
+		}
+	}
+
+	var signature string
+	if p.signature != nil {
+		var err error
+		if signature, err = p.signature.verify(r, body, now); err != nil {
+			return "", newIngressError(http.StatusUnauthorized, "invalid signature: %s", err)
+		}
+	}
+
+	if !p.replay {
+		return "", nil
+	}
+	nonce := signature
+	if p.replayHeader != "" {
+		nonce = r.Header.Get(p.replayHeader)
+	}
+	if nonce == "" {
+		return "", newIngressError(http.StatusUnauthorized, "missing replay nonce")
+	}
+	return nonce, nil
+}
+
+// rateLimitKey returns the key the request is counted under, requests of all versions of the flow share it.
+func (p *ingressPolicy) rateLimitKey(r *http.Request) string {
+	var client string
+	switch p.rateLimit.Key {
+	case RateLimitKeyAPIKey:
+		header, query := p.apiKeyHeader, p.apiKeyQuery
+		if header == "" && query == "" {
+			header = DefaultAPIKeyHeader
+		}
+		var provided string
+		if header != "" {
+			provided = r.Header.Get(header)
+		}
+		if provided == "" && query != "" {
+			provided = r.URL.Query().Get(query)
+		}
+		// Keys are stored with the rate limit state, so only their hash is used.
+		sum := sha256.Sum256([]byte(provided))
+		client = hex.EncodeToString(sum[:8])
+	case RateLimitKeyHeader:
+		client = r.Header.Get(p.rateLimit.Header)
+	case RateLimitKeyGlobal:
+	default:
+		if ip := clientIP(r, p.proxyHops); ip != nil {
+			client = ip.String()
+		}
+	}
+	return fmt.Sprintf("ingress/%d/%s:%s", p.lineageID, p.rateLimit.Key, client)
+}
+
+// clientIP returns the address of the client. Behind proxyHops trusted proxies it is the X-Forwarded-For entry
+// appended by the outermost of them, entries to its left are set by the client and cannot be trusted. Requests
+// that did not pass all proxies fall back to the address of the connection.
+func clientIP(r *http.Request, proxyHops int) net.IP {
+	if proxyHops > 0 {
+		var entries []string
+		for _, value := range r.Header.Values("X-Forwarded-For") {
+			entries = append(entries, strings.Split(value, ",")...)
+		}
+		if len(entries) >= proxyHops {
+			if ip := net.ParseIP(strings.TrimSpace(entries[len(entries)-proxyHops])); ip != nil {
+				return ip
+			}
+		}
+	}
+
+	host, _, err := net.SplitHostPort(r.RemoteAddr)
+	if err != nil {
+		host = r.RemoteAddr
+	}
+	return net.ParseIP(host)
+}
+
+func containsIP(networks []*net.IPNet, ip net.IP) bool {
+	if ip == nil {
+		return false
+	}
+	for _, network := range networks {
+		if network.Contains(ip) {
+			return true
+		}
+	}
+	return false
+}
+
+type signatureVerifier struct {
+	preset         string
+	secret         []byte
+	header         string
+	prefix         string
+	hash           func() hash.Hash
+	decode         func(string) ([]byte, error)
+	tolerance      time.Duration
+	deliveryHeader string
+}
+
+func newSignatureVerifier(config SignatureConfig, secret []byte) (*signatureVerifier, error) {
+	v := &signatureVerifier{
+		preset:    config.Preset,
+		secret:    secret,
+		hash:      sha256.New,
+		decode:    hex.DecodeString,
+		tolerance: DefaultSignatureTolerance,
+	}
+	if config.Tolerance != "" {
+		tolerance, err := time.ParseDuration(config.Tolerance)
+		if err != nil {
+			return nil, fmt.Errorf("invalid signature.tolerance: %w", err)
+		}
+		v.tolerance = tolerance
+	}
+
+	switch config.Preset {
+	case SignaturePresetGitHub:
+		v.header = "X-Hub-Signature-256"
+		v.prefix = "sha256="
+		v.deliveryHeader = "X-GitHub-Delivery"
+	case SignaturePresetStripe:
+		v.header = "Stripe-Signature"
+	case SignaturePresetShopify:
+		v.header = "X-Shopify-Hmac-Sha256"
+		v.decode = base64.StdEncoding.DecodeString
+		v.deliveryHeader = "X-Shopify-Webhook-Id"
+	case SignaturePresetSlack:
+		v.header = "X-Slack-Signature"
+		v.prefix = "v0="
+	default:
+		var err error
+		v.preset = SignaturePresetCustom
+		v.header = config.Header
+		v.prefix = config.Prefix
+		if v.hash, err = hashFunc(config.Algorithm); err != nil {
+			return nil, err
+		}
+		if v.decode, err = decodeSignatureFunc(config.Encoding); err != nil {
+			return nil, err
+		}
+	}
+
+	return v, nil
+}
+
+// verify checks the signature of the request and returns it, so it can serve as the replay nonce.
+func (v *signatureVerifier) verify(r *http.Request, body []byte, now time.Time) (string, error) {
+	value := r.Header.Get(v.header)
+	if value == "" {
+		return "", fmt.Errorf("missing %s header", v.header)
+	}
+
+	switch v.preset {
+	case SignaturePresetStripe:
+		return v.verifyStripe(value, body, now)
+	case SignaturePresetSlack:
+		timestamp := r.Header.Get("X-Slack-Request-Timestamp")
+		if err := v.checkTimestamp(timestamp, now); err != nil {
+			return "", err
+		}
+		payload := append([]byte("v0:"+timestamp+":"), body...)
+		if !v.matches(strings.TrimPrefix(value, v.prefix), payload) {
+			return "", fmt.Errorf("signature mismatch")
+		}
+		return value, nil
+	default:
+		if v.prefix != "" && !strings.HasPrefix(value, v.prefix) {
+			return "", fmt.Errorf("signature mismatch")
+		}
+		if !v.matches(strings.TrimPrefix(value, v.prefix), body) {
+			return "", fmt.Errorf("signature mismatch")
+		}
+		return value, nil
+	}
+}
+
+// verifyStripe checks a Stripe-Signature header of the form t=<timestamp>,v1=<signature>[,v1=<signature>...].
+func (v *signatureVerifier) verifyStripe(value string, body []byte, now time.Time) (string, error) {
+	var timestamp string
+	var signatures []string
+	for _, part := range strings.Split(value, ",") {
+		key, val, _ := strings.Cut(strings.TrimSpace(part), "=")
+		switch key {
+		case "t":
+			timestamp = val
+		case "v1":
+			signatures = append(signatures, val)
+		}
+	}
+
+	if err := v.checkTimestamp(timestamp, now); err != nil {
+		return "", err
+	}
+	payload := append([]byte(timestamp+"."), body...)
+	for _, signature := range signatures {
+		if v.matches(signature, payload) {
+			return value, nil
+		}
+	}
+	return "", fmt.Errorf("signature mismatch")
+}
+
+func (v *signatureVerifier) checkTimestamp(timestamp string, now time.Time) error {
+	seconds, err := strconv.ParseInt(timestamp, 10, 64)
+	if err != nil {
+		return fmt.Errorf("missing or invalid timestamp")
+	}
+	age := now.Sub(time.Unix(seconds, 0))
+	if age < 0 {
+		age = -age
+	}
+	if age > v.tolerance {
+		return fmt.Errorf("timestamp outside of the %s tolerance", v.tolerance)
+	}
+	return nil
+}
+
+func (v *signatureVerifier) matches(signature string, payload []byte) bool {
+	provided, err := v.decode(signature)
+	if err != nil {
+		return false
+	}
+	mac := hmac.New(v.hash, v.secret)
+	mac.Write(payload)
+	return hmac.Equal(provided, mac.Sum(nil))
+}
@@ -0,0 +1,343 @@
+package coordinator
+
+import (
+	"crypto/hmac"
+	"crypto/sha1"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"errors"
+	"hash"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/sananguliyev/airtruct/internal/persistence"
+)
+
+const testSecret = "whsec_test"
+
+var testBody = []byte(`{"event":"order.created"}`)
+
+func sign(h func() hash.Hash, payload []byte) []byte {
+	mac := hmac.New(h, []byte(testSecret))
+	mac.Write(payload)
+	return mac.Sum(nil)
+}
+
+func hexSign(payload []byte) string {
+	return hex.EncodeToString(sign(sha256.New, payload))
+}
+
+func compileTestPolicy(t *testing.T, config *IngressPolicyConfig) *ingressPolicy {
+	t.Helper()
+	policy, err := compileIngressPolicy(config, func(s string) (string, error) { return s, nil })
+	if err != nil {
+		t.Fatalf("failed to compile policy: %v", err)
+	}
+	return policy
+}
+
+func newTestRequest(headers map[string]string) *http.Request {
+	r := httptest.NewRequest(http.MethodPost, "/ingest/1", nil)
+	r.RemoteAddr = "203.0.113.7:41234"
+	for key, value := range headers {
+		r.Header.Set(key, value)
+	}
+	return r
+}
+
+func statusOf(err error) int {
+	var ingressErr *IngressError
+	if errors.As(err, &ingressErr) {
+		return ingressErr.StatusCode
+	}
+	if err != nil {
+		return -1
+	}
+	return 0
+}
+
+func TestSignaturePresets(t *testing.T) {
+	now := time.Unix(1_700_000_000, 0)
+	ts := strconv.FormatInt(now.Unix(), 10)
+	stale := strconv.FormatInt(now.Add(-10*time.Minute).Unix(), 10)
+
+	stripePayload := func(timestamp string) []byte { return append([]byte(timestamp+"."), testBody...) }
+	slackPayload := func(timestamp string) []byte { return append([]byte("v0:"+timestamp+":"), testBody...) }
+
+	tests := []struct {
+		name    string
+		config  SignatureConfig
+		headers map[string]string
+		valid   bool
+	}{
+		{
+			name:    "github",
+			config:  SignatureConfig{Preset: SignaturePresetGitHub},
+			headers: map[string]string{"X-Hub-Signature-256": "sha256=" + hexSign(testBody)},
+			valid:   true,
+		},
+		{
+			name:    "github tampered",
+			config:  SignatureConfig{Preset: SignaturePresetGitHub},
+			headers: map[string]string{"X-Hub-Signature-256": "sha256=" + hexSign([]byte(`{"event":"order.deleted"}`))},
+		},
+		{
+			name:    "github without prefix",
+			config:  SignatureConfig{Preset: SignaturePresetGitHub},
+			headers: map[string]string{"X-Hub-Signature-256": hexSign(testBody)},
+		},
+		{
+			name:   "github missing header",
+			config: SignatureConfig{Preset: SignaturePresetGitHub},
+		},
+		{
+			name:    "stripe",
+			config:  SignatureConfig{Preset: SignaturePresetStripe},
+			headers: map[string]string{"Stripe-Signature": "t=" + ts + ",v1=" + hexSign(stripePayload(ts))},
+			valid:   true,
+		},
+		{
+			name:   "stripe with several v1 signatures",
+			config: SignatureConfig{Preset: SignaturePresetStripe},
+			headers: map[string]string{
+				"Stripe-Signature": "t=" + ts + ",v1=" + hex.EncodeToString([]byte("rotated-out")) + ", v1=" + hexSign(stripePayload(ts)) + ",v0=abc",
+			},
+			valid: true,
+		},
+		{
+			name:    "stripe tampered",
+			config:  SignatureConfig{Preset: SignaturePresetStripe},
+			headers: map[string]string{"Stripe-Signature": "t=" + ts + ",v1=" + hexSign(testBody)},
+		},
+		{
+			name:    "stripe outside tolerance",
+			config:  SignatureConfig{Preset: SignaturePresetStripe},
+			headers: map[string]string{"Stripe-Signature": "t=" + stale + ",v1=" + hexSign(stripePayload(stale))},
+		},
+		{
+			name:    "stripe within custom tolerance",
+			config:  SignatureConfig{Preset: SignaturePresetStripe, Tolerance: "15m"},
+			headers: map[string]string{"Stripe-Signature": "t=" + stale + ",v1=" + hexSign(stripePayload(stale))},
+			valid:   true,
+		},
+		{
+			name:    "stripe without timestamp",
+			config:  SignatureConfig{Preset: SignaturePresetStripe},
+			headers: map[string]string{"Stripe-Signature": "v1=" + hexSign(stripePayload(""))},
+		},
+		{
+			name:    "shopify",
+			config:  SignatureConfig{Preset: SignaturePresetShopify},
+			headers: map[string]string{"X-Shopify-Hmac-Sha256": base64.StdEncoding.EncodeToString(sign(sha256.New, testBody))},
+			valid:   true,
+		},
+		{
+			name:    "shopify hex instead of base64",
+			config:  SignatureConfig{Preset: SignaturePresetShopify},
+			headers: map[string]string{"X-Shopify-Hmac-Sha256": hexSign(testBody)},
+		},
+		{
+			name:   "slack",
+			config: SignatureConfig{Preset: SignaturePresetSlack},
+			headers: map[string]string{
+				"X-Slack-Signature":         "v0=" + hexSign(slackPayload(ts)),
+				"X-Slack-Request-Timestamp": ts,
+			},
+			valid: true,
+		},
+		{
+			name:   "slack tampered timestamp",
+			config: SignatureConfig{Preset: SignaturePresetSlack},
+			headers: map[string]string{
+				"X-Slack-Signature":         "v0=" + hexSign(slackPayload(ts)),
+				"X-Slack-Request-Timestamp": strconv.FormatInt(now.Unix()+1, 10),
+			},
+		},
+		{
+			name:   "slack outside tolerance",
+			config: SignatureConfig{Preset: SignaturePresetSlack},
+			headers: map[string]string{
+				"X-Slack-Signature":         "v0=" + hexSign(slackPayload(stale)),
+				"X-Slack-Request-Timestamp": stale,
+			},
+		},
+		{
+			name:   "slack from the future",
+			config: SignatureConfig{Preset: SignaturePresetSlack},
+			headers: map[string]string{
+				"X-Slack-Signature":         "v0=" + hexSign(slackPayload(strconv.FormatInt(now.Add(10*time.Minute).Unix(), 10))),
+				"X-Slack-Request-Timestamp": strconv.FormatInt(now.Add(10*time.Minute).Unix(), 10),
+			},
+		},
+		{
+			name:    "custom",
+			config:  SignatureConfig{Header: "X-Signature", Algorithm: "sha1", Encoding: "base64", Prefix: "sig="},
+			headers: map[string]string{"X-Signature": "sig=" + base64.StdEncoding.EncodeToString(sign(sha1.New, testBody))},
+			valid:   true,
+		},
+		{
+			name:    "custom wrong algorithm",
+			config:  SignatureConfig{Header: "X-Signature", Algorithm: "sha1", Encoding: "base64"},
+			headers: map[string]string{"X-Signature": base64.StdEncoding.EncodeToString(sign(sha256.New, testBody))},
+		},
+		{
+			name:    "custom not decodable",
+			config:  SignatureConfig{Header: "X-Signature"},
+			headers: map[string]string{"X-Signature": "not-hex"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.config.Secret = testSecret
+			policy := compileTestPolicy(t, &IngressPolicyConfig{Signature: tt.config})
+
+			_, err := policy.check(newTestRequest(tt.headers), testBody, now)
+			if tt.valid && err != nil {
+				t.Fatalf("expected valid signature, got %v", err)
+			}
+			if !tt.valid && statusOf(err) != http.StatusUnauthorized {
+				t.Fatalf("expected 401, got %v", err)
+			}
+		})
+	}
+}
+
+func TestAPIKey(t *testing.T) {
+	tests := []struct {
+		name    string
+		config  APIKeyConfig
+		headers map[string]string
+		query   string
+		valid   bool
+	}{
+		{name: "default header", config: APIKeyConfig{Key: "k-123"}, headers: map[string]string{"X-API-Key": "k-123"}, valid: true},
+		{name: "wrong key", config: APIKeyConfig{Key: "k-123"}, headers: map[string]string{"X-API-Key": "k-124"}},
+		{name: "key prefix", config: APIKeyConfig{Key: "k-123"}, headers: map[string]string{"X-API-Key": "k-12"}},
+		{name: "missing key", config: APIKeyConfig{Key: "k-123"}},
+		{name: "custom header", config: APIKeyConfig{Key: "k-123", Header: "Authorization"}, headers: map[string]string{"Authorization": "k-123"}, valid: true},
+		{name: "default header ignored", config: APIKeyConfig{Key: "k-123", Header: "Authorization"}, headers: map[string]string{"X-API-Key": "k-123"}},
+		{name: "query", config: APIKeyConfig{Key: "k-123", Query: "key"}, query: "key=k-123", valid: true},
+		{name: "wrong query", config: APIKeyConfig{Key: "k-123", Query: "key"}, query: "key=nope"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			policy := compileTestPolicy(t, &IngressPolicyConfig{APIKey: tt.config})
+			r := newTestRequest(tt.headers)
+			r.URL.RawQuery = tt.query
+
+			_, err := policy.check(r, testBody, time.Now())
+			if tt.valid && err != nil {
+				t.Fatalf("expected valid API key, got %v", err)
+			}
+			if !tt.valid && statusOf(err) != http.StatusUnauthorized {
+				t.Fatalf("expected 401, got %v", err)
+			}
+		})
+	}
+}
+
+func TestIPAllowlist(t *testing.T) {
+	allowlist := []string{"10.0.0.0/8", "203.0.113.7", "2001:db8::/32"}
+
+	tests := []struct {
+		name       string
+		remoteAddr string
+		trusted    bool
+		hops       int
+		forwarded  []string
+		allowed    bool
+	}{
+		{name: "single address", remoteAddr: "203.0.113.7:1234", allowed: true},
+		{name: "cidr", remoteAddr: "10.1.2.3:1234", allowed: true},
+		{name: "ipv6 cidr", remoteAddr: "[2001:db8::1]:1234", allowed: true},
+		{name: "outside", remoteAddr: "198.51.100.1:1234"},
+		{name: "neighbour of single address", remoteAddr: "203.0.113.8:1234"},
+		{name: "forwarded for ignored when untrusted", remoteAddr: "198.51.100.1:1234", forwarded: []string{"10.1.2.3"}},
+		{name: "rightmost entry of a trusted proxy", remoteAddr: "198.51.100.1:1234", trusted: true, forwarded: []string{"10.1.2.3"}, allowed: true},
+		{name: "spoofed leftmost entry", remoteAddr: "198.51.100.1:1234", trusted: true, forwarded: []string{"10.1.2.3, 198.51.100.9"}},
+		{name: "two trusted hops", remoteAddr: "198.51.100.1:1234", trusted: true, hops: 2, forwarded: []string{"198.51.100.9, 10.1.2.3, 192.0.2.1"}, allowed: true},
+		{name: "entries over several headers", remoteAddr: "198.51.100.1:1234", trusted: true, hops: 2, forwarded: []string{"10.1.2.3", "192.0.2.1"}, allowed: true},
+		{name: "fewer entries than hops", remoteAddr: "198.51.100.1:1234", trusted: true, hops: 2, forwarded: []string{"10.1.2.3"}},
+		{name: "garbage entry", remoteAddr: "203.0.113.7:1234", trusted: true, forwarded: []string{"unknown"}, allowed: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			policy := compileTestPolicy(t, &IngressPolicyConfig{
+				IPAllowlist:       allowlist,
+				TrustForwardedFor: tt.trusted,
+				TrustedProxyHops:  tt.hops,
+			})
+			r := newTestRequest(nil)
+			r.RemoteAddr = tt.remoteAddr
+			for _, value := range tt.forwarded {
+				r.Header.Add("X-Forwarded-For", value)
+			}
+
+			_, err := policy.check(r, testBody, time.Now())
+			if tt.allowed && err != nil {
+				t.Fatalf("expected request to be allowed, got %v", err)
+			}
+			if !tt.allowed && statusOf(err) != http.StatusForbidden {
+				t.Fatalf("expected 403, got %v", err)
+			}
+		})
+	}
+}
+
+func TestParseNetworksRejectsInvalidEntries(t *testing.T) {
+	for _, entry := range []string{"10.0.0.0/33", "example.com", "10.0.0"} {
+		if _, err := parseNetworks([]string{entry}); err == nil {
+			t.Errorf("expected %q to be rejected", entry)
+		}
+	}
+}
+
+func TestIngressGuardReplay(t *testing.T) {
+	guard := &IngressGuard{nonces: newNonceCache()}
+	policy := compileTestPolicy(t, &IngressPolicyConfig{
+		Signature: SignatureConfig{Preset: SignaturePresetGitHub, Secret: testSecret},
+		Replay:    ReplayConfig{Enabled: true},
+	})
+	policy.lineageID = 7
+	headers := map[string]string{
+		"X-Hub-Signature-256": "sha256=" + hexSign(testBody),
+		"X-GitHub-Delivery":   "delivery-1",
+	}
+
+	nonce, err := guard.Check(policy, newTestRequest(headers), testBody)
+	if err != nil || nonce != "7:delivery-1" {
+		t.Fatalf("expected nonce of the flow lineage, got %q, %v", nonce, err)
+	}
+	if _, err = guard.Check(policy, newTestRequest(headers), testBody); statusOf(err) != http.StatusConflict {
+		t.Fatalf("expected 409 for a replayed request, got %v", err)
+	}
+
+	// The request was not delivered, its retry is accepted.
+	guard.ForgetNonce(nonce)
+	if _, err = guard.Check(policy, newTestRequest(headers), testBody); err != nil {
+		t.Fatalf("expected retry to be accepted, got %v", err)
+	}
+
+	delete(headers, "X-GitHub-Delivery")
+	if _, err = guard.Check(policy, newTestRequest(headers), testBody); statusOf(err) != http.StatusUnauthorized {
+		t.Fatalf("expected 401 without nonce, got %v", err)
+	}
+}
+
+func TestParseIngressPolicyRejectsNegativeProxyHops(t *testing.T) {
+	_, err := ParseIngressPolicy(testHTTPServerFlow("ingress:\n  trust_forwarded_for: true\n  trusted_proxy_hops: -1\n"))
+	if err == nil {
+		t.Fatal("expected negative trusted_proxy_hops to be rejected")
+	}
+}
+
+func testHTTPServerFlow(inputConfig string) persistence.Flow {
+	return persistence.Flow{ID: 1, InputComponent: "http_server", InputConfig: []byte(inputConfig)}
+}
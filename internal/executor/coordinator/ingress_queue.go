@@ -0,0 +1,361 @@
+package coordinator
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"sync"
+	"time"
+
+	"github.com/rs/zerolog/log"
+
+	"github.com/sananguliyev/airtruct/internal/persistence"
+	pb "github.com/sananguliyev/airtruct/internal/protogen"
+	"github.com/sananguliyev/airtruct/internal/utils"
+)
+
+// replayBatchSize is the number of queued requests loaded at once while replaying a flow's queue.
+const replayBatchSize = 100
+
+var errFlowNotAssigned = errors.New("flow is not assigned to any worker")
+
+// IngressQueue stores requests of flows with a queue policy while they are not running and replays them in
+// order once they are.
+type IngressQueue struct {
+	repo                persistence.QueuedRequestRepository
+	flowRepo            persistence.FlowRepository
+	flowWorkerMap       FlowWorkerMap
+	workerManager       WorkerManager
+	ingressGuard        *IngressGuard
+	deadLetterRetention time.Duration
+
+	mu     sync.Mutex
+	counts map[int64]*queueCount
+}
+
+// queueCount is the number of queued requests of a flow lineage. Requests being stored are counted in
+// pending, so concurrent requests cannot exceed the queue size. version changes on every update, a count
+// loaded from the database only replaces the in-memory one when nothing changed while loading it.
+type queueCount struct {
+	loaded  bool
+	queued  int64
+	pending int64
+	version uint64
+}
+
+func NewIngressQueue(
+	repo persistence.QueuedRequestRepository,
+	flowRepo persistence.FlowRepository,
+	flowWorkerMap FlowWorkerMap,
+	workerManager WorkerManager,
+	ingressGuard *IngressGuard,
+	deadLetterRetention time.Duration,
+) *IngressQueue {
+	return &IngressQueue{
+		repo:                repo,
+		flowRepo:            flowRepo,
+		flowWorkerMap:       flowWorkerMap,
+		workerManager:       workerManager,
+		ingressGuard:        ingressGuard,
+		deadLetterRetention: deadLetterRetention,
+		counts:              make(map[int64]*queueCount),
+	}
+}
+
+// count returns the queued and pending requests of the flow lineage, loading the count from the database the
+// first time the lineage is seen.
+func (q *IngressQueue) count(lineageID int64) (int64, error) {
+	q.mu.Lock()
+	count, ok := q.counts[lineageID]
+	if ok && count.loaded {
+		defer q.mu.Unlock()
+		return count.queued + count.pending, nil
+	}
+	q.mu.Unlock()
+
+	if err := q.sync(lineageID); err != nil {
+		return 0, err
+	}
+
+	q.mu.Lock()
+	defer q.mu.Unlock()
+	count = q.counts[lineageID]
+	return count.queued + count.pending, nil
+}
+
+// sync reloads the count of queued requests of the flow lineage from the database, it picks up dead letters
+// requeued through the API.
+func (q *IngressQueue) sync(lineageID int64) error {
+	q.mu.Lock()
+	count, ok := q.counts[lineageID]
+	if !ok {
+		count = &queueCount{}
+		q.counts[lineageID] = count
+	}
+	version := count.version
+	q.mu.Unlock()
+
+	queued, err := q.repo.CountQueuedByFlowID(lineageID)
+	if err != nil {
+		return fmt.Errorf("failed to count queued requests: %w", err)
+	}
+
+	q.mu.Lock()
+	defer q.mu.Unlock()
+	if count.version == version && count.pending == 0 {
+		count.queued = queued
+		count.loaded = true
+	} else if !count.loaded {
+		// Requests were queued while loading, the loaded count may or may not include them.
+		count.queued = max(queued, count.queued)
+		count.loaded = true
+	}
+	return nil
+}
+
+// reserve counts a request that is about to be stored, it fails when the queue of the flow lineage is full.
+func (q *IngressQueue) reserve(lineageID, maxRequests int64) (bool, error) {
+	if _, err := q.count(lineageID); err != nil {
+		return false, err
+	}
+
+	q.mu.Lock()
+	defer q.mu.Unlock()
+	count := q.counts[lineageID]
+	if count.queued+count.pending >= maxRequests {
+		return false, nil
+	}
+	count.pending++
+	count.version++
+	return true, nil
+}
+
+// commit ends a reservation, the request is counted as queued when it was stored.
+func (q *IngressQueue) commit(lineageID int64, stored bool) {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+	count := q.counts[lineageID]
+	count.pending--
+	if stored {
+		count.queued++
+	}
+	count.version++
+}
+
+// remove uncounts requests that left the queue of the flow lineage.
+func (q *IngressQueue) remove(lineageID, n int64) {
+	if n <= 0 {
+		return
+	}
+	q.mu.Lock()
+	defer q.mu.Unlock()
+	if count, ok := q.counts[lineageID]; ok {
+		count.queued = max(count.queued-n, 0)
+		count.version++
+	}
+}
+
+// Backlogged reports whether the flow still has queued requests, new requests have to wait behind them to
+// keep the order.
+func (q *IngressQueue) Backlogged(target *ingestTarget) (bool, error) {
+	count, err := q.count(target.policy.lineageID)
+	if err != nil {
+		return false, err
+	}
+	return count > 0, nil
+}
+
+// Accept stores the request and answers it with 202 Accepted, or rejects it with 503 when the queue is full.
+func (q *IngressQueue) Accept(target *ingestTarget, w http.ResponseWriter) error {
+	lineageID := target.policy.lineageID
+	reserved, err := q.reserve(lineageID, target.policy.queue.maxRequests)
+	if err != nil {
+		return err
+	}
+	if !reserved {
+		ingressErr := newIngressError(http.StatusServiceUnavailable, "flow %d is not running and its queue is full", target.flowID)
+		ingressErr.Header = http.Header{"Retry-After": {"60"}}
+		return ingressErr
+	}
+
+	request := &persistence.QueuedRequest{
+		FlowID:        lineageID,
+		FlowVersionID: target.flowID,
+		Method:        target.request.GetMethod(),
+		Path:          target.request.GetPath(),
+		Query:         target.request.GetQuery(),
+		ContentType:   target.request.GetContentType(),
+		RemoteAddr:    target.request.GetRemoteAddr(),
+		Payload:       target.request.GetPayload(),
+	}
+	headers := utils.HeadersFromProto(target.request.GetHeaders())
+	for _, name := range target.policy.credentialHeaders() {
+		headers.Del(name)
+	}
+	request.SetHeaders(headers)
+	if err := q.repo.Create(request); err != nil {
+		q.commit(lineageID, false)
+		return fmt.Errorf("failed to queue request: %w", err)
+	}
+	q.commit(lineageID, true)
+
+	target.delivered = true
+
+	log.Debug().Int64("flow_id", target.flowID).Int64("queued_request_id", request.ID).Msg("Queued ingest request")
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusAccepted)
+	return json.NewEncoder(w).Encode(map[string]any{
+		"status": string(persistence.QueuedRequestStatusQueued),
+		"id":     request.ID,
+	})
+}
+
+// Replay delivers queued requests to the flows that are running again and expires requests and dead letters
+// past their retention, dead letters are kept forever when the retention is zero.
+func (q *IngressQueue) Replay(ctx context.Context) error {
+	if q.deadLetterRetention > 0 {
+		if deleted, err := q.repo.DeleteDeadBefore(time.Now().Add(-q.deadLetterRetention)); err != nil {
+			log.Error().Err(err).Msg("Failed to delete expired dead letters")
+		} else if deleted > 0 {
+			log.Info().Int64("count", deleted).Msg("Deleted expired dead letters")
+		}
+	}
+
+	flowIDs, err := q.repo.ListQueuedFlowIDs()
+	if err != nil {
+		return fmt.Errorf("failed to list flows with queued requests: %w", err)
+	}
+
+	for _, flowID := range flowIDs {
+		if ctx.Err() != nil {
+			return ctx.Err()
+		}
+		if err := q.replayFlow(ctx, flowID); err != nil {
+			log.Error().Err(err).Int64("flow_id", flowID).Msg("Failed to replay queued requests")
+		}
+	}
+
+	q.mu.Lock()
+	lineageIDs := make([]int64, 0, len(q.counts))
+	for lineageID := range q.counts {
+		lineageIDs = append(lineageIDs, lineageID)
+	}
+	q.mu.Unlock()
+	for _, lineageID := range lineageIDs {
+		if err := q.sync(lineageID); err != nil {
+			log.Error().Err(err).Int64("flow_id", lineageID).Msg("Failed to count queued requests")
+		}
+	}
+	return nil
+}
+
+func (q *IngressQueue) replayFlow(ctx context.Context, lineageID int64) error {
+	versions, err := q.flowRepo.ListAllVersionsByParentID(lineageID)
+	if err != nil {
+		return err
+	}
+	var current *persistence.Flow
+	for i := range versions {
+		if versions[i].IsCurrent {
+			current = &versions[i]
+		}
+	}
+	if current == nil {
+		dead, err := q.repo.DeadLetterBefore(lineageID, time.Now(), "flow no longer exists")
+		q.remove(lineageID, dead)
+		return err
+	}
+
+	// Requests are replayed by the policy they were accepted with, the one of the current version of the lineage.
+	queue := newQueuePolicy(QueueConfig{})
+	if policy, err := q.ingressGuard.policy(lineageID); err == nil && policy != nil && policy.queue != nil {
+		queue = policy.queue
+	}
+	if expired, err := q.repo.DeadLetterBefore(lineageID, time.Now().Add(-queue.maxAge), "expired before it could be delivered"); err != nil {
+		return err
+	} else if expired > 0 {
+		q.remove(lineageID, expired)
+		log.Warn().Int64("flow_id", lineageID).Int64("count", expired).Msg("Queued requests expired before delivery")
+	}
+
+	workerClient, workerFlowID, err := workerClientFor(q.flowWorkerMap, q.workerManager, current.ID)
+	if err != nil {
+		// The flow is not running yet, try again on the next round.
+		return nil
+	}
+
+	for {
+		requests, err := q.repo.ListQueuedByFlowID(lineageID, replayBatchSize)
+		if err != nil {
+			return err
+		}
+		for _, request := range requests {
+			if err := q.deliver(ctx, workerClient, workerFlowID, request); err != nil {
+				attempts := request.Attempts + 1
+				status := persistence.QueuedRequestStatusQueued
+				if attempts >= queue.maxAttempts {
+					status = persistence.QueuedRequestStatusDead
+				}
+				if updateErr := q.repo.UpdateStatus(request.ID, status, attempts, err.Error()); updateErr != nil {
+					return updateErr
+				}
+				if status == persistence.QueuedRequestStatusQueued {
+					// Keep the order, later requests wait until this one is delivered.
+					return err
+				}
+				q.remove(lineageID, 1)
+				log.Warn().Err(err).Int64("queued_request_id", request.ID).Msg("Queued request moved to dead letters")
+				continue
+			}
+			if err := q.repo.Delete(request.ID); err != nil {
+				return err
+			}
+			q.remove(lineageID, 1)
+		}
+		if len(requests) < replayBatchSize {
+			return nil
+		}
+	}
+}
+
+func (q *IngressQueue) deliver(ctx context.Context, workerClient pb.WorkerClient, workerFlowID int64, request persistence.QueuedRequest) error {
+	resp, err := workerClient.Ingest(ctx, &pb.IngestRequest{
+		WorkerFlowId: workerFlowID,
+		Method:       request.Method,
+		Path:         request.Path,
+		ContentType:  request.ContentType,
+		Payload:      request.Payload,
+		Headers:      utils.HeadersToProto(request.GetHeaders()),
+		Query:        request.Query,
+		RemoteAddr:   request.RemoteAddr,
+	})
+	if err != nil {
+		return fmt.Errorf("failed to deliver request: %w", err)
+	}
+	if resp.GetStatusCode() >= http.StatusInternalServerError {
+		return fmt.Errorf("flow responded with status %d", resp.GetStatusCode())
+	}
+	return nil
+}
+
+// workerClientFor returns the client of the worker running the flow and the ID of the flow on that worker.
+func workerClientFor(flowWorkerMap FlowWorkerMap, workerManager WorkerManager, flowID int64) (pb.WorkerClient, int64, error) {
+	workerFlowID, ok := flowWorkerMap.GetFlowWorkerStream(flowID)
+	if !ok {
+		return nil, 0, errFlowNotAssigned
+	}
+
+	workerID, ok := flowWorkerMap.GetFlowWorker(flowID)
+	if !ok {
+		return nil, 0, errFlowNotAssigned
+	}
+
+	workerClient, err := workerManager.GetWorkerClient(&persistence.Worker{ID: workerID})
+	if err != nil {
+		return nil, 0, fmt.Errorf("failed to get worker client: %w", err)
+	}
+	return workerClient, workerFlowID, nil
+}
@@ -0,0 +1,121 @@
+package coordinator
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+
+	"github.com/sananguliyev/airtruct/internal/persistence"
+	pb "github.com/sananguliyev/airtruct/internal/protogen"
+	"github.com/sananguliyev/airtruct/internal/utils"
+)
+
+func newTestIngressQueue(t *testing.T) (*IngressQueue, persistence.QueuedRequestRepository) {
+	db := setupTestDB(t)
+	if err := db.AutoMigrate(&persistence.QueuedRequest{}); err != nil {
+		t.Fatalf("failed to migrate test database: %v", err)
+	}
+	repo := persistence.NewQueuedRequestRepository(db)
+	return NewIngressQueue(repo, persistence.NewFlowRepository(db), NewFlowWorkerMap(), nil, nil, 0), repo
+}
+
+func newQueueTarget(policy *ingressPolicy, headers http.Header) *ingestTarget {
+	return &ingestTarget{
+		flowID: 2,
+		policy: policy,
+		request: &pb.IngestRequest{
+			Method:  http.MethodPost,
+			Path:    "/ingest/2",
+			Payload: testBody,
+			Headers: utils.HeadersToProto(headers),
+		},
+	}
+}
+
+func TestIngressQueueDropsCredentialHeaders(t *testing.T) {
+	queue, repo := newTestIngressQueue(t)
+	policy := compileTestPolicy(t, &IngressPolicyConfig{
+		APIKey:    APIKeyConfig{Key: "k", Header: "X-Token"},
+		Signature: SignatureConfig{Preset: SignaturePresetGitHub, Secret: testSecret},
+	})
+	policy.queue = newQueuePolicy(QueueConfig{})
+	policy.lineageID = 1
+
+	headers := http.Header{}
+	headers.Set("Authorization", "Bearer s3cret")
+	headers.Set("Cookie", "session=s3cret")
+	headers.Set("X-Token", "k")
+	headers.Set("X-Hub-Signature-256", "sha256=abc")
+	headers.Set("X-GitHub-Delivery", "delivery-1")
+
+	if err := queue.Accept(newQueueTarget(policy, headers), httptest.NewRecorder()); err != nil {
+		t.Fatalf("failed to accept request: %v", err)
+	}
+
+	requests, err := repo.ListQueuedByFlowID(1, 10)
+	if err != nil || len(requests) != 1 {
+		t.Fatalf("expected 1 queued request, got %d: %v", len(requests), err)
+	}
+	stored := requests[0].GetHeaders()
+	for _, name := range []string{"Authorization", "Cookie", "X-Token", "X-Hub-Signature-256"} {
+		if stored.Get(name) != "" {
+			t.Errorf("expected %s not to be stored", name)
+		}
+	}
+	if stored.Get("X-GitHub-Delivery") != "delivery-1" {
+		t.Errorf("expected X-GitHub-Delivery to be stored, got %v", stored)
+	}
+}
+
+func TestIngressQueueLimitsConcurrentRequests(t *testing.T) {
+	queue, repo := newTestIngressQueue(t)
+	policy := &ingressPolicy{queue: newQueuePolicy(QueueConfig{MaxRequests: 5}), lineageID: 1}
+
+	var wg sync.WaitGroup
+	for range 20 {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			_ = queue.Accept(newQueueTarget(policy, http.Header{}), httptest.NewRecorder())
+		}()
+	}
+	wg.Wait()
+
+	count, err := repo.CountQueuedByFlowID(1)
+	if err != nil {
+		t.Fatalf("failed to count queued requests: %v", err)
+	}
+	if count != 5 {
+		t.Errorf("expected 5 queued requests, got %d", count)
+	}
+
+	err = queue.Accept(newQueueTarget(policy, http.Header{}), httptest.NewRecorder())
+	if statusOf(err) != http.StatusServiceUnavailable {
+		t.Errorf("expected 503 when the queue is full, got %v", err)
+	}
+	if backlogged, err := queue.Backlogged(newQueueTarget(policy, http.Header{})); err != nil || !backlogged {
+		t.Errorf("expected the flow to be backlogged, got %t: %v", backlogged, err)
+	}
+}
+
+func TestIngressQueueCountsRequeuedDeadLetters(t *testing.T) {
+	queue, repo := newTestIngressQueue(t)
+	policy := &ingressPolicy{queue: newQueuePolicy(QueueConfig{}), lineageID: 1}
+	target := newQueueTarget(policy, http.Header{})
+
+	if backlogged, err := queue.Backlogged(target); err != nil || backlogged {
+		t.Fatalf("expected an empty queue, got %t: %v", backlogged, err)
+	}
+
+	request := &persistence.QueuedRequest{FlowID: 1, FlowVersionID: 2, Method: http.MethodPost}
+	if err := repo.Create(request); err != nil {
+		t.Fatalf("failed to create queued request: %v", err)
+	}
+	if err := queue.sync(1); err != nil {
+		t.Fatalf("failed to sync queue count: %v", err)
+	}
+	if backlogged, err := queue.Backlogged(target); err != nil || !backlogged {
+		t.Errorf("expected the synced queue to be backlogged, got %t: %v", backlogged, err)
+	}
+}
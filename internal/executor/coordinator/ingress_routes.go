@@ -0,0 +1,163 @@
+package coordinator
+
+import (
+	"errors"
+	"fmt"
+	"path"
+	"slices"
+	"strconv"
+	"strings"
+	"sync"
+
+	"github.com/rs/zerolog/log"
+	"gopkg.in/yaml.v3"
+
+	"github.com/sananguliyev/airtruct/internal/persistence"
+)
+
+// defaultHTTPServerPath is the path the http_server input listens on when none is configured.
+const defaultHTTPServerPath = "/post"
+
+// ErrRouteConflict is returned when the slug or route of a flow is already used by another flow.
+var ErrRouteConflict = errors.New("route conflict")
+
+// ReservedRoutePrefixes are served by the coordinator itself and cannot be used as custom routes. Besides the
+// API they cover the pages and assets of the console, new top-level pages of the UI have to be added here.
+var ReservedRoutePrefixes = []string{
+	"/api", "/auth", "/ingest", "/mcp", "/metrics",
+	"/assets", "/index.html", "/favicon.png", "/logo.png",
+	"/login", "/flows", "/workers", "/ingress-queue", "/alerts", "/secrets", "/buffers", "/caches",
+	"/rate-limits", "/mcp-servers", "/files",
+}
+
+func isReservedRoute(route string) bool {
+	for _, prefix := range ReservedRoutePrefixes {
+		if route == prefix || strings.HasPrefix(route, prefix+"/") {
+			return true
+		}
+	}
+	return false
+}
+
+// ValidateFlowRoute checks the slug and custom route of a flow and that no other flow uses them. Versions of
+// the same flow are skipped, since a new version takes over the routes of the one it replaces.
+func ValidateFlowRoute(flow persistence.Flow, others []persistence.Flow) error {
+	if flow.Slug == "" && flow.Route == "" {
+		return nil
+	}
+	if flow.InputComponent != "http_server" {
+		return fmt.Errorf("slug and route are only supported by flows with an http_server input")
+	}
+
+	if flow.Slug != "" {
+		if _, err := strconv.ParseInt(flow.Slug, 10, 64); err == nil {
+			return fmt.Errorf("slug %q cannot be numeric, it would shadow flow IDs", flow.Slug)
+		}
+	}
+
+	if flow.Route != "" {
+		if flow.Route == "/" || path.Clean(flow.Route) != flow.Route {
+			return fmt.Errorf("route %q must be a clean path below /", flow.Route)
+		}
+		if isReservedRoute(flow.Route) {
+			return fmt.Errorf("route %q is reserved by the coordinator", flow.Route)
+		}
+	}
+
+	for _, other := range others {
+		if flowLineageID(other) == flowLineageID(flow) {
+			continue
+		}
+		if flow.Slug != "" && other.Slug == flow.Slug {
+			return fmt.Errorf("%w: slug %q is already used by flow %q", ErrRouteConflict, flow.Slug, other.Name)
+		}
+		if flow.Route != "" && other.Route == flow.Route {
+			return fmt.Errorf("%w: route %q is already used by flow %q", ErrRouteConflict, flow.Route, other.Name)
+		}
+	}
+
+	return nil
+}
+
+// flowLineageID returns the ID shared by all versions of a flow, zero for flows that are not saved yet.
+func flowLineageID(flow persistence.Flow) int64 {
+	if flow.ParentID != nil {
+		return *flow.ParentID
+	}
+	return flow.ID
+}
+
+type ingressRoute struct {
+	flowID int64
+	// path is the path of the flow's http_server input the request is forwarded to.
+	path    string
+	methods []string
+}
+
+func (r ingressRoute) allows(method string) bool {
+	return len(r.methods) == 0 || slices.Contains(r.methods, method)
+}
+
+// IngressRoutes resolves slugs and custom routes to the current version of their flow.
+type IngressRoutes struct {
+	mu     sync.RWMutex
+	slugs  map[string]int64
+	routes map[string]ingressRoute
+}
+
+func NewIngressRoutes() *IngressRoutes {
+	return &IngressRoutes{
+		slugs:  make(map[string]int64),
+		routes: make(map[string]ingressRoute),
+	}
+}
+
+// Sync replaces the routing table with the slugs and routes of the given flows. Routes saved before their path
+// was reserved are skipped, so they never shadow the coordinator.
+func (t *IngressRoutes) Sync(flows []persistence.Flow) {
+	slugs := make(map[string]int64, len(flows))
+	routes := make(map[string]ingressRoute, len(flows))
+	for _, flow := range flows {
+		if flow.Slug != "" {
+			slugs[flow.Slug] = flow.ID
+		}
+		if flow.Route != "" && isReservedRoute(flow.Route) {
+			log.Warn().Int64("flow_id", flow.ID).Str("route", flow.Route).Msg("Route of flow is reserved by the coordinator, skipping")
+		} else if flow.Route != "" {
+			routes[flow.Route] = ingressRoute{
+				flowID:  flow.ID,
+				path:    httpServerPath(flow),
+				methods: flow.GetRouteMethods(),
+			}
+		}
+	}
+
+	t.mu.Lock()
+	t.slugs = slugs
+	t.routes = routes
+	t.mu.Unlock()
+}
+
+func (t *IngressRoutes) Slug(slug string) (int64, bool) {
+	t.mu.RLock()
+	defer t.mu.RUnlock()
+	id, ok := t.slugs[slug]
+	return id, ok
+}
+
+func (t *IngressRoutes) Route(routePath string) (ingressRoute, bool) {
+	t.mu.RLock()
+	defer t.mu.RUnlock()
+	route, ok := t.routes[routePath]
+	return route, ok
+}
+
+func httpServerPath(flow persistence.Flow) string {
+	var inputConfig struct {
+		Path string `yaml:"path"`
+	}
+	if err := yaml.Unmarshal(flow.InputConfig, &inputConfig); err != nil || inputConfig.Path == "" {
+		return defaultHTTPServerPath
+	}
+	return inputConfig.Path
+}
@@ -0,0 +1,70 @@
+package coordinator
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/sananguliyev/airtruct/internal/persistence"
+)
+
+func TestValidateFlowRouteRejectsReservedPaths(t *testing.T) {
+	for _, route := range []string{"/api/v0/flows", "/metrics", "/flows", "/flows/1/edit", "/assets/index.js", "/index.html", "/login", "/alerts"} {
+		flow := testHTTPServerFlow("path: /post")
+		flow.Route = route
+		if err := ValidateFlowRoute(flow, nil); err == nil {
+			t.Errorf("expected route %q to be reserved", route)
+		}
+	}
+
+	for _, route := range []string{"/hooks/shopify", "/flowsync", "/api-hooks"} {
+		flow := testHTTPServerFlow("path: /post")
+		flow.Route = route
+		if err := ValidateFlowRoute(flow, nil); err != nil {
+			t.Errorf("expected route %q to be allowed, got %v", route, err)
+		}
+	}
+}
+
+func TestValidateFlowRouteConflicts(t *testing.T) {
+	parentID := int64(1)
+	flow := testHTTPServerFlow("path: /post")
+	flow.ID, flow.ParentID, flow.Slug, flow.Route = 2, &parentID, "orders", "/hooks/orders"
+
+	previousVersion := testHTTPServerFlow("path: /post")
+	previousVersion.Slug, previousVersion.Route = "orders", "/hooks/orders"
+	if err := ValidateFlowRoute(flow, []persistence.Flow{previousVersion}); err != nil {
+		t.Errorf("expected versions of the same flow to share routes, got %v", err)
+	}
+
+	other := testHTTPServerFlow("path: /post")
+	other.ID, other.Name, other.Route = 5, "other", "/hooks/orders"
+	if err := ValidateFlowRoute(flow, []persistence.Flow{other}); !errors.Is(err, ErrRouteConflict) {
+		t.Errorf("expected route conflict, got %v", err)
+	}
+
+	flow.Slug = "42"
+	if err := ValidateFlowRoute(flow, nil); err == nil {
+		t.Error("expected numeric slug to be rejected")
+	}
+}
+
+func TestIngressRoutesSyncSkipsReservedRoutes(t *testing.T) {
+	routed := testHTTPServerFlow("path: /webhook")
+	routed.Route, routed.RouteMethods = "/hooks/orders", "POST"
+	legacy := testHTTPServerFlow("path: /post")
+	legacy.ID, legacy.Route = 2, "/flows"
+
+	routes := NewIngressRoutes()
+	routes.Sync([]persistence.Flow{routed, legacy})
+
+	route, ok := routes.Route("/hooks/orders")
+	if !ok || route.flowID != 1 || route.path != "/webhook" {
+		t.Fatalf("unexpected route: %+v", route)
+	}
+	if !route.allows("POST") || route.allows("GET") {
+		t.Errorf("unexpected methods: %v", route.methods)
+	}
+	if _, ok := routes.Route("/flows"); ok {
+		t.Error("expected reserved route to be skipped")
+	}
+}
@@ -2,18 +2,39 @@ package coordinator
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"io"
 	"net/http"
+	"net/http/httptest"
+	"os"
 	"regexp"
 	"strconv"
+	"strings"
+	"time"
 
+	"github.com/rs/zerolog/log"
+	"go.opentelemetry.io/otel/attribute"
+	otelcodes "go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/trace"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/sananguliyev/airtruct/internal/config"
+	"github.com/sananguliyev/airtruct/internal/mcp"
+	"github.com/sananguliyev/airtruct/internal/metrics"
 	"github.com/sananguliyev/airtruct/internal/persistence"
 	pb "github.com/sananguliyev/airtruct/internal/protogen"
+	"github.com/sananguliyev/airtruct/internal/ratelimiter"
+	"github.com/sananguliyev/airtruct/internal/utils"
 )
 
 type RequestForwarder interface {
-	ForwardRequestToWorker(ctx context.Context, r *http.Request) (int32, []byte, error)
+	ForwardRequestToWorker(ctx context.Context, r *http.Request) (*pb.IngestResponse, error)
+	ForwardStreamToWorker(ctx context.Context, r *http.Request, w http.ResponseWriter) error
+	SyncRoutes() error
+	HasRoute(path string) bool
+	ReplayQueue(ctx context.Context) error
 }
 
 type requestForwarder struct {
@@ -21,79 +42,479 @@ type requestForwarder struct {
 	flowWorkerMap FlowWorkerMap
 	flowRepo      persistence.FlowRepository
 	pathRegex       *regexp.Regexp
+	headerFilter    *HeaderFilter
+	ingressGuard    *IngressGuard
+	routes          *IngressRoutes
+	ingressQueue    *IngressQueue
+	defaultLimits   ingressLimits
+	concurrency     *concurrencyLimiter
+	inFlight        *inFlightCounter
+	rateLimiter     RateLimiter
+}
+
+// RateLimiter checks requests against the rate limit resources.
+type RateLimiter interface {
+	Check(label, key string, cost int64) (*ratelimiter.CheckResult, error)
+	Release(label, key, leaseID string) error
 }
 
+// errRequestQueued tells the caller to queue the request instead of forwarding it.
+var errRequestQueued = errors.New("request has to be queued")
+
 func NewRequestForwarder(
 	workerManager WorkerManager,
 	flowWorkerMap FlowWorkerMap,
 	flowRepo persistence.FlowRepository,
+	headerFilter *HeaderFilter,
+	ingressGuard *IngressGuard,
+	ingressQueue *IngressQueue,
+	ingressConfig *config.IngressConfig,
+	rateLimiter RateLimiter,
 ) RequestForwarder {
 	return &requestForwarder{
 		workerManager:   workerManager,
 		flowWorkerMap: flowWorkerMap,
 		flowRepo:      flowRepo,
-		pathRegex:       regexp.MustCompile(`^/ingest/(\d+)(/.*)?$`),
+		pathRegex:       regexp.MustCompile(`^/ingest/([^/]+)(/.*)?$`),
+		headerFilter:    headerFilter,
+		ingressGuard:    ingressGuard,
+		routes:          NewIngressRoutes(),
+		ingressQueue:    ingressQueue,
+		defaultLimits: ingressLimits{
+			maxBodySize: ingressConfig.MaxBodySize,
+			timeout:     ingressConfig.RequestTimeout,
+		},
+		concurrency: newConcurrencyLimiter(ingressConfig.MaxConcurrentRequests),
+		inFlight:    newInFlightCounter(),
+		rateLimiter: rateLimiter,
 	}
 }
 
-func (f *requestForwarder) ForwardRequestToWorker(ctx context.Context, r *http.Request) (int32, []byte, error) {
-	var err error
-	var id int64
-	var componentPath string
+func (f *requestForwarder) ForwardRequestToWorker(ctx context.Context, r *http.Request) (resp *pb.IngestResponse, err error) {
+	target, err := f.prepareIngest(r, nil)
+	if err != nil {
+		return nil, err
+	}
+	defer target.release()
+	defer f.observeForward(ctx, target, time.Now(), &err)
+
+	ctx, cancel := target.withTimeout(ctx)
+	defer cancel()
 
-	matches := f.pathRegex.FindStringSubmatch(r.URL.Path)
-	if matches == nil {
-		return 0, nil, fmt.Errorf("invalid path format")
+	workerClient, err := f.dispatch(target)
+	if errors.Is(err, errRequestQueued) {
+		return f.queueResponse(target)
+	} else if err != nil {
+		return nil, err
 	}
 
-	id, err = strconv.ParseInt(matches[1], 10, 64)
+	resp, err = workerClient.Ingest(ctx, target.request)
 	if err != nil {
-		return 0, nil, fmt.Errorf("invalid ID format")
+		if target.queueable() && status.Code(err) == codes.Unavailable {
+			return f.queueResponse(target)
+		}
+		if target.timedOut(ctx) {
+			return nil, target.timeoutError()
+		}
+		return nil, fmt.Errorf("failed to forward request to worker: %w", err)
 	}
+	target.delivered = resp.GetStatusCode() < http.StatusInternalServerError
 
-	if len(matches) > 2 && matches[2] != "" {
-		componentPath = matches[2]
+	headers := f.headerFilter.Filter(utils.HeadersFromProto(resp.GetHeaders()))
+	for key, values := range target.responseHeaders {
+		headers[key] = values
 	}
+	resp.Headers = utils.HeadersToProto(headers)
+	return resp, nil
+}
 
-	workerFlowID, ok := f.flowWorkerMap.GetFlowWorkerStream(id)
-	if !ok {
-		flow, err := f.flowRepo.FindByID(id)
+// ForwardStreamToWorker forwards the request to the worker running the flow and writes the response to w
+// chunk by chunk as the flow produces it. Errors after the response has started are only logged, since the
+// status code has already been sent.
+func (f *requestForwarder) ForwardStreamToWorker(ctx context.Context, r *http.Request, w http.ResponseWriter) (err error) {
+	target, err := f.prepareIngest(r, w)
+	if err != nil {
+		return err
+	}
+	defer target.release()
+	defer f.observeForward(ctx, target, time.Now(), &err)
+
+	ctx, cancel := target.withTimeout(ctx)
+	defer cancel()
+
+	workerClient, err := f.dispatch(target)
+	if errors.Is(err, errRequestQueued) {
+		return f.ingressQueue.Accept(target, w)
+	} else if err != nil {
+		return err
+	}
+	ingestRequest := target.request
+
+	stream, err := workerClient.IngestStream(ctx, ingestRequest)
+	if err != nil {
+		if target.timedOut(ctx) {
+			return target.timeoutError()
+		}
+		return fmt.Errorf("failed to forward request to worker: %w", err)
+	}
+
+	flusher, _ := w.(http.Flusher)
+	started := false
+	for {
+		frame, err := stream.Recv()
+		if err == io.EOF {
+			return nil
+		}
 		if err != nil {
-			return 0, nil, fmt.Errorf("failed to look up flow %d: %w", id, err)
+			if !started {
+				if target.queueable() && status.Code(err) == codes.Unavailable {
+					return f.ingressQueue.Accept(target, w)
+				}
+				if target.timedOut(ctx) {
+					return target.timeoutError()
+				}
+				return fmt.Errorf("failed to forward request to worker: %w", err)
+			}
+			log.Warn().Err(err).Int64("worker_flow_id", ingestRequest.GetWorkerFlowId()).Msg("Response stream from worker ended unexpectedly")
+			return nil
 		}
-		if flow == nil {
-			return 0, nil, fmt.Errorf("flow %d not found", id)
+
+		switch {
+		case frame.GetHead() != nil:
+			head := frame.GetHead()
+			for key, values := range f.headerFilter.Filter(utils.HeadersFromProto(head.GetHeaders())) {
+				for _, value := range values {
+					w.Header().Add(key, value)
+				}
+			}
+			for key, values := range target.responseHeaders {
+				w.Header()[key] = values
+			}
+			w.WriteHeader(int(head.GetStatusCode()))
+			target.delivered = head.GetStatusCode() < http.StatusInternalServerError
+			started = true
+		case frame.GetChunk() != nil:
+			if !started {
+				// The response starts without a head, with the implicit 200 OK.
+				target.delivered = true
+			}
+			if _, err := w.Write(frame.GetChunk()); err != nil {
+				// The client went away, cancelling ctx stops the flow as well.
+				log.Debug().Err(err).Int64("worker_flow_id", ingestRequest.GetWorkerFlowId()).Msg("Failed to write response chunk")
+				return nil
+			}
+			started = true
+		}
+		if flusher != nil {
+			flusher.Flush()
+		}
+	}
+}
+
+// observeForward records how long forwarding the request took and annotates the span of the request with the
+// flow, err points to the result of the forwarding.
+func (f *requestForwarder) observeForward(ctx context.Context, target *ingestTarget, start time.Time, err *error) {
+	name, version := f.ingressGuard.FlowLabels(target.flowID)
+	metrics.IngestForwardDuration.
+		WithLabelValues(name, version, metrics.Result(*err)).
+		Observe(time.Since(start).Seconds())
+
+	span := trace.SpanFromContext(ctx)
+	span.SetAttributes(
+		attribute.String("airtruct.flow", name),
+		attribute.String("airtruct.flow_version", version),
+		attribute.Int64("airtruct.worker_flow_id", target.request.GetWorkerFlowId()),
+	)
+	if *err != nil {
+		span.SetStatus(otelcodes.Error, (*err).Error())
+	}
+}
+
+func (f *requestForwarder) queueResponse(target *ingestTarget) (*pb.IngestResponse, error) {
+	recorder := httptest.NewRecorder()
+	if err := f.ingressQueue.Accept(target, recorder); err != nil {
+		return nil, err
+	}
+	return &pb.IngestResponse{
+		StatusCode: int32(recorder.Code),
+		Response:   recorder.Body.Bytes(),
+		Headers:    utils.HeadersToProto(recorder.Header()),
+	}, nil
+}
+
+func (f *requestForwarder) ReplayQueue(ctx context.Context) error {
+	return f.ingressQueue.Replay(ctx)
+}
+
+func (f *requestForwarder) SyncRoutes() error {
+	flows, err := f.flowRepo.ListAllCurrentWithRoutes()
+	if err != nil {
+		return fmt.Errorf("failed to list flow routes: %w", err)
+	}
+	f.routes.Sync(flows)
+	return nil
+}
+
+func (f *requestForwarder) HasRoute(path string) bool {
+	_, ok := f.routes.Route(path)
+	return ok
+}
+
+// resolveFlow returns the flow a request is addressed to and the path within its input. Requests either use
+// /ingest/{id or slug}/{path} or the custom route of a flow.
+func (f *requestForwarder) resolveFlow(r *http.Request) (int64, string, error) {
+	if matches := f.pathRegex.FindStringSubmatch(r.URL.Path); matches != nil {
+		if id, err := strconv.ParseInt(matches[1], 10, 64); err == nil {
+			return id, matches[2], nil
 		}
-		return 0, nil, fmt.Errorf("flow %d exists but is not currently assigned to any worker", id)
+		id, ok := f.routes.Slug(matches[1])
+		if !ok {
+			return 0, "", newIngressError(http.StatusNotFound, "flow %q not found", matches[1])
+		}
+		return id, matches[2], nil
 	}
 
-	workerID, ok := f.flowWorkerMap.GetFlowWorker(id)
+	route, ok := f.routes.Route(r.URL.Path)
 	if !ok {
-		return 0, nil, fmt.Errorf("flow %d exists but is not currently assigned to any worker", id)
+		return 0, "", newIngressError(http.StatusNotFound, "no flow is registered at %s", r.URL.Path)
+	}
+	if !route.allows(r.Method) {
+		err := newIngressError(http.StatusMethodNotAllowed, "method %s is not allowed", r.Method)
+		err.Header = http.Header{"Allow": {strings.Join(route.methods, ", ")}}
+		return 0, "", err
+	}
+	return route.flowID, route.path, nil
+}
+
+// ingestTarget is a request that passed the ingress policy of its flow. It holds a slot of the coordinator
+// and of the flow until release is called.
+type ingestTarget struct {
+	flowID  int64
+	policy  *ingressPolicy
+	limits  ingressLimits
+	request *pb.IngestRequest
+	// responseHeaders are added to the response of the flow, e.g. the rate limit headers.
+	responseHeaders http.Header
+	release         func()
+	// parent is the context of the request before the flow timeout was applied.
+	parent context.Context
+	// nonce is the replay nonce recorded for the request. It is forgotten on release unless the flow answered
+	// without a server error or the request was queued, so senders can retry requests that failed.
+	nonce     string
+	delivered bool
+}
+
+// queueable reports whether the request can wait in the queue of its flow while the flow is not running. MCP
+// tool calls are never queued, the client waits for their result.
+func (t *ingestTarget) queueable() bool {
+	return t.policy != nil && t.policy.queue != nil && t.request.GetMcpCallId() == ""
+}
+
+func (t *ingestTarget) withTimeout(ctx context.Context) (context.Context, context.CancelFunc) {
+	t.parent = ctx
+	if t.limits.timeout <= 0 {
+		return context.WithCancel(ctx)
+	}
+	return context.WithTimeout(ctx, t.limits.timeout)
+}
+
+// timedOut reports whether ctx ended because of the flow timeout rather than the caller.
+func (t *ingestTarget) timedOut(ctx context.Context) bool {
+	return errors.Is(ctx.Err(), context.DeadlineExceeded) && t.parent != nil && t.parent.Err() == nil
+}
+
+func (t *ingestTarget) timeoutError() error {
+	return newIngressError(http.StatusGatewayTimeout, "flow %d did not respond within %s", t.flowID, t.limits.timeout)
+}
+
+// prepareIngest admits the request, reads its body within the limits of the flow and checks it against the
+// ingress policy. w is used to extend the read deadline and may be nil.
+func (f *requestForwarder) prepareIngest(r *http.Request, w http.ResponseWriter) (*ingestTarget, error) {
+	if !f.concurrency.tryAcquire() {
+		err := newIngressError(http.StatusServiceUnavailable, "coordinator is handling too many requests")
+		err.Header = http.Header{"Retry-After": {"1"}}
+		return nil, err
+	}
+
+	target, err := f.admit(r, w)
+	if err != nil {
+		f.concurrency.release()
+		return nil, err
+	}
+	flowRelease := target.release
+	target.release = func() {
+		flowRelease()
+		f.concurrency.release()
+	}
+	return target, nil
+}
+
+func (f *requestForwarder) admit(r *http.Request, w http.ResponseWriter) (*ingestTarget, error) {
+	id, componentPath, err := f.resolveFlow(r)
+	if err != nil {
+		return nil, err
+	}
+
+	policy, err := f.ingressGuard.Load(id)
+	if err != nil {
+		return nil, err
+	}
+	// The limits are those of the current version, shared by the requests to every version of the flow.
+	limits := f.defaultLimits
+	inFlightID := id
+	if policy != nil {
+		limits = limits.merge(policy.limits)
+		inFlightID = policy.lineageID
+	}
+
+	if !f.inFlight.tryAcquire(inFlightID, limits.maxInFlight) {
+		err := newIngressError(http.StatusTooManyRequests, "flow %d is handling too many requests", id)
+		err.Header = http.Header{"Retry-After": {"1"}}
+		return nil, err
 	}
 
-	workerClient, err := f.workerManager.GetWorkerClient(&persistence.Worker{ID: workerID})
+	var nonce string
+	bodyBytes, err := f.readBody(r, w, limits)
+	if err == nil {
+		nonce, err = f.ingressGuard.Check(policy, r, bodyBytes)
+	}
+	var responseHeaders http.Header
+	releaseLease := func() {}
+	if err == nil {
+		responseHeaders, releaseLease, err = f.checkRateLimit(id, policy, r)
+	}
 	if err != nil {
-		return 0, nil, fmt.Errorf("failed to get worker client: %w", err)
+		f.ingressGuard.ForgetNonce(nonce)
+		f.inFlight.release(inFlightID)
+		return nil, err
+	}
+
+	headers := f.headerFilter.Filter(r.Header)
+	// The call ID is sent in its own field.
+	headers.Del(mcp.CallIDHeader)
+
+	target := &ingestTarget{
+		flowID: id,
+		policy: policy,
+		limits: limits,
+		request: &pb.IngestRequest{
+			Method:      r.Method,
+			Path:        componentPath,
+			ContentType: r.Header.Get("Content-Type"),
+			Payload:     bodyBytes,
+			McpCallId:   r.Header.Get(mcp.CallIDHeader),
+			Headers:     utils.HeadersToProto(headers),
+			Query:       r.URL.RawQuery,
+			RemoteAddr:  r.RemoteAddr,
+		},
+		responseHeaders: responseHeaders,
+		nonce:           nonce,
+	}
+	target.release = func() {
+		if !target.delivered {
+			f.ingressGuard.ForgetNonce(target.nonce)
+		}
+		releaseLease()
+		f.inFlight.release(inFlightID)
+	}
+	return target, nil
+}
+
+// checkRateLimit counts the request against the rate limit of the flow and returns the headers describing
+// the remaining quota, and a function returning the lease of a concurrency rate limit once the request is done.
+func (f *requestForwarder) checkRateLimit(flowID int64, policy *ingressPolicy, r *http.Request) (http.Header, func(), error) {
+	noop := func() {}
+	if policy == nil || policy.rateLimit == nil {
+		return nil, noop, nil
 	}
 
-	bodyBytes, err := io.ReadAll(r.Body)
+	label, key := policy.rateLimit.Label, policy.rateLimitKey(r)
+	result, err := f.rateLimiter.Check(label, key, policy.rateLimit.Cost)
 	if err != nil {
-		return 0, nil, fmt.Errorf("failed to read request body: %w", err)
+		log.Error().Err(err).Int64("flow_id", flowID).Str("label", label).Msg("Failed to check ingress rate limit")
+		return nil, noop, newIngressError(http.StatusInternalServerError, "rate limit of flow %d is unavailable", flowID)
 	}
+	if !result.Allowed {
+		ingressErr := newIngressError(http.StatusTooManyRequests, "rate limit exceeded, retry after %dms", result.RetryAfterMs)
+		ingressErr.Header = result.Headers()
+		return nil, noop, ingressErr
+	}
+	if result.LeaseID == "" {
+		return result.Headers(), noop, nil
+	}
+	release := func() {
+		if err := f.rateLimiter.Release(label, key, result.LeaseID); err != nil {
+			log.Warn().Err(err).Int64("flow_id", flowID).Str("label", label).Msg("Failed to release ingress rate limit lease")
+		}
+	}
+	return result.Headers(), release, nil
+}
+
+func (f *requestForwarder) readBody(r *http.Request, w http.ResponseWriter, limits ingressLimits) ([]byte, error) {
 	defer r.Body.Close()
 
-	resp, err := workerClient.Ingest(ctx, &pb.IngestRequest{
-		WorkerFlowId: workerFlowID,
-		Method:         r.Method,
-		Path:           componentPath,
-		ContentType:    r.Header.Get("Content-Type"),
-		Payload:        bodyBytes,
-	})
+	if limits.maxBodySize > 0 && r.ContentLength > limits.maxBodySize {
+		return nil, newIngressError(http.StatusRequestEntityTooLarge, "request body exceeds %d bytes", limits.maxBodySize)
+	}
+	if w != nil && limits.timeout > 0 {
+		// Not every writer supports deadlines, e.g. MCP tool calls, their body is already in memory. The deadline
+		// is cleared once the body is read, the server would otherwise cancel the request when it passes.
+		controller := http.NewResponseController(w)
+		if controller.SetReadDeadline(time.Now().Add(limits.timeout)) == nil {
+			defer controller.SetReadDeadline(time.Time{})
+		}
+	}
+
+	body := r.Body
+	if limits.maxBodySize > 0 {
+		body = http.MaxBytesReader(w, r.Body, limits.maxBodySize)
+	}
+	bodyBytes, err := io.ReadAll(body)
+	if err != nil {
+		var maxBytesErr *http.MaxBytesError
+		if errors.As(err, &maxBytesErr) {
+			return nil, newIngressError(http.StatusRequestEntityTooLarge, "request body exceeds %d bytes", limits.maxBodySize)
+		}
+		if errors.Is(err, os.ErrDeadlineExceeded) {
+			return nil, newIngressError(http.StatusRequestTimeout, "request body was not received within %s", limits.timeout)
+		}
+		return nil, fmt.Errorf("failed to read request body: %w", err)
+	}
+	return bodyBytes, nil
+}
+
+// dispatch returns the client of the worker running the target flow. errRequestQueued is returned instead when
+// the request has to be queued, because the flow is not running or earlier requests are still queued.
+func (f *requestForwarder) dispatch(target *ingestTarget) (pb.WorkerClient, error) {
+	if target.queueable() {
+		backlogged, err := f.ingressQueue.Backlogged(target)
+		if err != nil {
+			return nil, err
+		}
+		if backlogged {
+			return nil, errRequestQueued
+		}
+	}
 
+	workerClient, workerFlowID, err := workerClientFor(f.flowWorkerMap, f.workerManager, target.flowID)
 	if err != nil {
-		return 0, nil, fmt.Errorf("failed to forward request to worker: %w", err)
+		if target.queueable() {
+			return nil, errRequestQueued
+		}
+		if !errors.Is(err, errFlowNotAssigned) {
+			return nil, err
+		}
+
+		flow, err := f.flowRepo.FindByID(target.flowID)
+		if err != nil {
+			return nil, fmt.Errorf("failed to look up flow %d: %w", target.flowID, err)
+		}
+		if flow == nil {
+			return nil, fmt.Errorf("flow %d not found", target.flowID)
+		}
+		return nil, fmt.Errorf("flow %d exists but is not currently assigned to any worker", target.flowID)
 	}
 
-	return resp.StatusCode, resp.Response, nil
+	target.request.WorkerFlowId = workerFlowID
+	return workerClient, nil
 }
@@ -0,0 +1,241 @@
+package coordinator
+
+import (
+	"bytes"
+	"database/sql"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"testing"
+
+	"gorm.io/driver/sqlite"
+	"gorm.io/gorm"
+	_ "modernc.org/sqlite"
+
+	"github.com/sananguliyev/airtruct/internal/config"
+	"github.com/sananguliyev/airtruct/internal/persistence"
+	"github.com/sananguliyev/airtruct/internal/ratelimiter"
+)
+
+type fakeRateLimiter struct {
+	allowed []bool
+}
+
+func (l *fakeRateLimiter) Check(string, string, int64) (*ratelimiter.CheckResult, error) {
+	allowed := l.allowed[0]
+	l.allowed = l.allowed[1:]
+	return &ratelimiter.CheckResult{Allowed: allowed, Limit: 1}, nil
+}
+
+func (l *fakeRateLimiter) Release(string, string, string) error {
+	return nil
+}
+
+func setupTestDB(t *testing.T) *gorm.DB {
+	sqlDB, err := sql.Open("sqlite", ":memory:")
+	if err != nil {
+		t.Fatalf("failed to open sqlite connection: %v", err)
+	}
+	// Every connection opens its own in-memory database.
+	sqlDB.SetMaxOpenConns(1)
+	db, err := gorm.Open(sqlite.New(sqlite.Config{Conn: sqlDB}), &gorm.Config{})
+	if err != nil {
+		t.Fatalf("failed to open test database: %v", err)
+	}
+
+	err = db.AutoMigrate(&persistence.Buffer{}, &persistence.Flow{}, &persistence.FlowProcessor{}, &persistence.FlowCache{})
+	if err != nil {
+		t.Fatalf("failed to migrate test database: %v", err)
+	}
+	return db
+}
+
+func TestRejectedSignedRequestCanBeRetried(t *testing.T) {
+	flowRepo := persistence.NewFlowRepository(setupTestDB(t))
+	flow := &persistence.Flow{
+		Name:           "webhooks",
+		InputComponent: "http_server",
+		InputConfig: []byte(`ingress:
+  signature:
+    preset: github
+    secret: ` + testSecret + `
+  replay:
+    enabled: true
+  rate_limit:
+    label: webhooks
+`),
+		OutputComponent: "drop",
+		OutputConfig:    []byte("{}"),
+		IsCurrent:       true,
+		Status:          persistence.FlowStatusActive,
+	}
+	if err := flowRepo.Create(flow); err != nil {
+		t.Fatalf("failed to create flow: %v", err)
+	}
+
+	rateLimiter := &fakeRateLimiter{allowed: []bool{false, true, true}}
+	forwarder := newTestRequestForwarder(flowRepo, nil, rateLimiter)
+
+	send := func() error {
+		r := httptest.NewRequest(http.MethodPost, "/ingest/"+strconv.FormatInt(flow.ID, 10), bytes.NewReader(testBody))
+		r.Header.Set("X-Hub-Signature-256", "sha256="+hexSign(testBody))
+		r.Header.Set("X-GitHub-Delivery", "delivery-1")
+		_, err := forwarder.ForwardRequestToWorker(t.Context(), r)
+		return err
+	}
+
+	if err := send(); statusOf(err) != http.StatusTooManyRequests {
+		t.Fatalf("expected 429, got %v", err)
+	}
+	// The flow is not running, the request fails without reaching it.
+	if err := send(); err == nil || statusOf(err) == http.StatusConflict {
+		t.Fatalf("expected the retry after 429 to reach the flow lookup, got %v", err)
+	}
+	if err := send(); err == nil || statusOf(err) == http.StatusConflict {
+		t.Fatalf("expected the retry of an undelivered request to be accepted, got %v", err)
+	}
+}
+
+// createTestFlowVersions saves a flow with an http_server input for every input config, each one a new version
+// of the previous, and returns the ID of the first version.
+func createTestFlowVersions(t *testing.T, flowRepo persistence.FlowRepository, inputConfigs ...string) int64 {
+	t.Helper()
+	flow := &persistence.Flow{
+		Name:            "webhooks",
+		InputComponent:  "http_server",
+		InputConfig:     []byte(inputConfigs[0]),
+		OutputComponent: "drop",
+		OutputConfig:    []byte("{}"),
+		IsCurrent:       true,
+		Status:          persistence.FlowStatusActive,
+	}
+	if err := flowRepo.Create(flow); err != nil {
+		t.Fatalf("failed to create flow: %v", err)
+	}
+	rootID := flow.ID
+	for _, inputConfig := range inputConfigs[1:] {
+		flow.InputConfig = []byte(inputConfig)
+		if err := flowRepo.Update(flow); err != nil {
+			t.Fatalf("failed to update flow: %v", err)
+		}
+	}
+	return rootID
+}
+
+func newTestRequestForwarder(flowRepo persistence.FlowRepository, ingressQueue *IngressQueue, rateLimiter RateLimiter) RequestForwarder {
+	return NewRequestForwarder(
+		nil,
+		NewFlowWorkerMap(),
+		flowRepo,
+		NewHeaderFilter(nil, nil),
+		NewIngressGuard(flowRepo, nil, nil),
+		ingressQueue,
+		&config.IngressConfig{},
+		rateLimiter,
+	)
+}
+
+func TestStableURLChecksCurrentVersionPolicy(t *testing.T) {
+	flowRepo := persistence.NewFlowRepository(setupTestDB(t))
+	rootID := createTestFlowVersions(t, flowRepo, "{}", `ingress:
+  api_key:
+    key: k
+    header: X-API-Key
+`)
+	forwarder := newTestRequestForwarder(flowRepo, nil, nil)
+
+	send := func(key string) error {
+		r := httptest.NewRequest(http.MethodPost, "/ingest/"+strconv.FormatInt(rootID, 10), bytes.NewReader(testBody))
+		if key != "" {
+			r.Header.Set("X-API-Key", key)
+		}
+		_, err := forwarder.ForwardRequestToWorker(t.Context(), r)
+		return err
+	}
+
+	if err := send(""); statusOf(err) != http.StatusUnauthorized {
+		t.Fatalf("expected 401 without the API key of the current version, got %v", err)
+	}
+	// The flow is not running, the request fails without reaching it.
+	if err := send("k"); err == nil || statusOf(err) == http.StatusUnauthorized {
+		t.Fatalf("expected the request with the API key to pass the policy, got %v", err)
+	}
+}
+
+func TestStableURLQueuesByCurrentVersionPolicy(t *testing.T) {
+	db := setupTestDB(t)
+	if err := db.AutoMigrate(&persistence.QueuedRequest{}); err != nil {
+		t.Fatalf("failed to migrate test database: %v", err)
+	}
+	flowRepo := persistence.NewFlowRepository(db)
+	queuedRequestRepo := persistence.NewQueuedRequestRepository(db)
+	rootID := createTestFlowVersions(t, flowRepo, "{}", `ingress:
+  queue:
+    enabled: true
+`)
+	ingressQueue := NewIngressQueue(queuedRequestRepo, flowRepo, NewFlowWorkerMap(), nil, NewIngressGuard(flowRepo, nil, nil), 0)
+	forwarder := newTestRequestForwarder(flowRepo, ingressQueue, nil)
+
+	r := httptest.NewRequest(http.MethodPost, "/ingest/"+strconv.FormatInt(rootID, 10), bytes.NewReader(testBody))
+	resp, err := forwarder.ForwardRequestToWorker(t.Context(), r)
+	if err != nil {
+		t.Fatalf("expected the request to be queued, got %v", err)
+	}
+	if resp.GetStatusCode() != http.StatusAccepted {
+		t.Fatalf("expected 202, got %d", resp.GetStatusCode())
+	}
+
+	count, err := queuedRequestRepo.CountQueuedByFlowID(rootID)
+	if err != nil {
+		t.Fatalf("failed to count queued requests: %v", err)
+	}
+	if count != 1 {
+		t.Errorf("expected 1 queued request, got %d", count)
+	}
+}
+
+func TestStableURLUsesCurrentVersionLimits(t *testing.T) {
+	flowRepo := persistence.NewFlowRepository(setupTestDB(t))
+	rootID := createTestFlowVersions(t, flowRepo, "{}", `ingress:
+  limits:
+    max_body_size: 4B
+    max_in_flight: 1
+`)
+	currentID := rootID + 1
+	forwarder := newTestRequestForwarder(flowRepo, nil, nil).(*requestForwarder)
+
+	newRequest := func(flowID int64, body []byte) *http.Request {
+		return httptest.NewRequest(http.MethodPost, "/ingest/"+strconv.FormatInt(flowID, 10), bytes.NewReader(body))
+	}
+
+	if _, err := forwarder.ForwardRequestToWorker(t.Context(), newRequest(rootID, testBody)); statusOf(err) != http.StatusRequestEntityTooLarge {
+		t.Fatalf("expected 413 for a body over the limit of the current version, got %v", err)
+	}
+
+	// Requests to the first and the current version share the in-flight limit of the flow.
+	target, err := forwarder.admit(newRequest(rootID, []byte("ok")), nil)
+	if err != nil {
+		t.Fatalf("expected the first request to be admitted, got %v", err)
+	}
+	if _, err := forwarder.admit(newRequest(currentID, []byte("ok")), nil); statusOf(err) != http.StatusTooManyRequests {
+		t.Fatalf("expected 429 while the flow handles a request, got %v", err)
+	}
+	target.release()
+	if _, err := forwarder.admit(newRequest(currentID, []byte("ok")), nil); err != nil {
+		t.Fatalf("expected a request to be admitted once the slot was released, got %v", err)
+	}
+}
+
+func TestStableURLEnforcesCurrentVersionRateLimit(t *testing.T) {
+	flowRepo := persistence.NewFlowRepository(setupTestDB(t))
+	rootID := createTestFlowVersions(t, flowRepo, "{}", `ingress:
+  rate_limit:
+    label: webhooks
+`)
+	forwarder := newTestRequestForwarder(flowRepo, nil, &fakeRateLimiter{allowed: []bool{false}})
+
+	r := httptest.NewRequest(http.MethodPost, "/ingest/"+strconv.FormatInt(rootID, 10), bytes.NewReader(testBody))
+	if _, err := forwarder.ForwardRequestToWorker(t.Context(), r); statusOf(err) != http.StatusTooManyRequests {
+		t.Fatalf("expected 429 from the rate limit of the current version, got %v", err)
+	}
+}
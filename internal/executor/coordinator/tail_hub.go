@@ -0,0 +1,164 @@
+package coordinator
+
+import (
+	"slices"
+	"strings"
+	"sync"
+	"sync/atomic"
+
+	"github.com/sananguliyev/airtruct/internal/metrics"
+	"github.com/sananguliyev/airtruct/internal/persistence"
+)
+
+const (
+	// TailBufferSize is the number of items kept for a live tail subscriber until it reads them.
+	TailBufferSize = 256
+
+	TailStreamEvents = "events"
+	TailStreamLogs   = "logs"
+)
+
+// EventTailFilter selects the events delivered to a live tail, zero values do not filter.
+type EventTailFilter struct {
+	// FlowID is the initial version of the flow, events of all of its versions match.
+	FlowID         int64
+	FlowVersionID  int64
+	WorkerFlowID   int64
+	Section        string
+	ComponentLabel string
+	Type           persistence.EventType
+	TraceID        string
+	// Search is matched case-insensitively against the content and metadata.
+	Search string
+}
+
+func (f EventTailFilter) Matches(flowID int64, event *persistence.Event) bool {
+	switch {
+	case f.FlowID != flowID,
+		f.FlowVersionID != 0 && f.FlowVersionID != event.FlowID,
+		f.WorkerFlowID != 0 && f.WorkerFlowID != event.WorkerFlowID,
+		f.Section != "" && f.Section != event.Section,
+		f.ComponentLabel != "" && f.ComponentLabel != event.ComponentLabel,
+		f.Type != "" && f.Type != event.Type,
+		f.TraceID != "" && f.TraceID != event.TraceID:
+		return false
+	}
+	return containsFold(f.Search, event.Content, string(event.Meta))
+}
+
+// LogTailFilter selects the log lines delivered to a live tail, zero values do not filter.
+type LogTailFilter struct {
+	// FlowID is the initial version of the flow, lines of all of its versions match.
+	FlowID        int64
+	FlowVersionID int64
+	WorkerID      string
+	WorkerFlowID  int64
+	// MinLevel selects the lines of the level and above.
+	MinLevel string
+	// Search is matched case-insensitively against the message and fields.
+	Search string
+}
+
+func (f LogTailFilter) Matches(flowID int64, line *persistence.FlowLog) bool {
+	switch {
+	case f.FlowID != flowID,
+		f.FlowVersionID != 0 && f.FlowVersionID != line.FlowID,
+		f.WorkerID != "" && f.WorkerID != line.WorkerID,
+		f.WorkerFlowID != 0 && f.WorkerFlowID != line.WorkerFlowID,
+		f.MinLevel != "" && !slices.Contains(persistence.FlowLogLevelsFrom(f.MinLevel), line.Level):
+		return false
+	}
+	return containsFold(f.Search, line.Message, line.Fields)
+}
+
+// containsFold reports whether any of the values contains search ignoring case, an empty search is always found.
+func containsFold(search string, values ...string) bool {
+	if search == "" {
+		return true
+	}
+	search = strings.ToLower(search)
+	for _, value := range values {
+		if strings.Contains(strings.ToLower(value), search) {
+			return true
+		}
+	}
+	return false
+}
+
+// TailHub fans the stored items of flows, e.g. events or log lines, out to the live tails of the coordinator. A
+// subscriber that does not keep up misses items instead of slowing the ingestion down.
+type TailHub[T any] struct {
+	stream      string
+	mu          sync.RWMutex
+	subscribers map[*TailSubscription[T]]struct{}
+}
+
+// NewTailHub creates a hub, stream names the items in the metrics.
+func NewTailHub[T any](stream string) *TailHub[T] {
+	return &TailHub[T]{stream: stream, subscribers: make(map[*TailSubscription[T]]struct{})}
+}
+
+// TailSubscription receives the items matching its filter until it is closed.
+type TailSubscription[T any] struct {
+	hub     *TailHub[T]
+	match   func(flowID int64, item T) bool
+	items   chan T
+	dropped atomic.Uint64
+	once    sync.Once
+}
+
+// Subscribe starts delivering the items that match, buffering up to size of them.
+func (h *TailHub[T]) Subscribe(match func(flowID int64, item T) bool, size int) *TailSubscription[T] {
+	subscription := &TailSubscription[T]{
+		hub:   h,
+		match: match,
+		items: make(chan T, size),
+	}
+
+	h.mu.Lock()
+	h.subscribers[subscription] = struct{}{}
+	h.mu.Unlock()
+	metrics.TailSubscribers.WithLabelValues(h.stream).Inc()
+
+	return subscription
+}
+
+// Publish delivers the items of the flow, identified by its initial version, to the matching subscribers.
+func (h *TailHub[T]) Publish(flowID int64, items []T) {
+	h.mu.RLock()
+	defer h.mu.RUnlock()
+
+	for subscription := range h.subscribers {
+		for _, item := range items {
+			if !subscription.match(flowID, item) {
+				continue
+			}
+			select {
+			case subscription.items <- item:
+			default:
+				subscription.dropped.Add(1)
+				metrics.TailDropped.WithLabelValues(h.stream).Inc()
+			}
+		}
+	}
+}
+
+// Items delivers the matching items, it is never closed.
+func (s *TailSubscription[T]) Items() <-chan T {
+	return s.items
+}
+
+// TakeDropped returns the number of items missed since the previous call because the buffer was full.
+func (s *TailSubscription[T]) TakeDropped() uint64 {
+	return s.dropped.Swap(0)
+}
+
+// Close stops the delivery of items.
+func (s *TailSubscription[T]) Close() {
+	s.once.Do(func() {
+		s.hub.mu.Lock()
+		delete(s.hub.subscribers, s)
+		s.hub.mu.Unlock()
+		metrics.TailSubscribers.WithLabelValues(s.hub.stream).Dec()
+	})
+}
@@ -27,6 +27,10 @@ func ValidateFlow(flow persistence.Flow) error {
 		msgs = append(msgs, fmt.Sprintf("[input/%s] %s", flow.InputComponent, inputErr))
 	}
 
+	if _, err := ParseIngressPolicy(flow); err != nil {
+		msgs = append(msgs, fmt.Sprintf("[input/%s ingress] %s", flow.InputComponent, err))
+	}
+
 	for _, proc := range flow.Processors {
 		procMap, err := b.buildProcessorConfig(proc)
 		if err != nil {
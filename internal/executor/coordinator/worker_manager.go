@@ -6,6 +6,7 @@ import (
 
 	"github.com/rs/zerolog/log"
 
+	"github.com/sananguliyev/airtruct/internal/metrics"
 	"github.com/sananguliyev/airtruct/internal/persistence"
 	pb "github.com/sananguliyev/airtruct/internal/protogen"
 )
@@ -59,6 +60,7 @@ func (m *workerManager) DeactivateWorker(workerID string) error {
 	}
 
 	m.clientManager.RemoveClient(workerID)
+	metrics.WorkerHeartbeats.Forget(workerID)
 
 	log.Info().Str("worker_id", workerID).Msg("Worker is unhealthy and deactivated")
 	return nil
@@ -2,6 +2,7 @@ package executor
 
 import (
 	"context"
+	"net/http"
 
 	"github.com/sananguliyev/airtruct/internal/executor/worker"
 	"github.com/sananguliyev/airtruct/internal/persistence"
@@ -11,6 +12,8 @@ import (
 	"google.golang.org/grpc"
 )
 
+type IngestRequest = worker.IngestRequest
+
 type IngestResult = worker.IngestResult
 
 type WorkerExecutor interface {
@@ -21,9 +24,11 @@ type WorkerExecutor interface {
 	FetchWorkerFlowStatus(ctx context.Context, workerFlowID int64) (*persistence.WorkerFlowStatus, error)
 	DeleteWorkerFlow(ctx context.Context, workerFlowID int64) error
 	ShipLogs(context.Context)
+	ShipFlowLogs(context.Context)
 	ShipMetrics(context.Context)
 	ConsumeFlowQueue(context.Context)
-	IngestData(ctx context.Context, workerFlowID int64, method, path, contentType string, payload []byte) (*IngestResult, error)
+	IngestData(ctx context.Context, workerFlowID int64, in *IngestRequest) (*IngestResult, error)
+	IngestStream(ctx context.Context, workerFlowID int64, in *IngestRequest, w http.ResponseWriter) error
 }
 
 type workerExecutor struct {
@@ -64,6 +69,10 @@ func (e *workerExecutor) ShipLogs(ctx context.Context) {
 	e.worker.ShipLogs(ctx)
 }
 
+func (e *workerExecutor) ShipFlowLogs(ctx context.Context) {
+	e.worker.ShipFlowLogs(ctx)
+}
+
 func (e *workerExecutor) ShipMetrics(ctx context.Context) {
 	e.worker.ShipMetrics(ctx)
 }
@@ -72,6 +81,10 @@ func (e *workerExecutor) ConsumeFlowQueue(ctx context.Context) {
 	e.worker.ConsumeFlowQueue(ctx)
 }
 
-func (e *workerExecutor) IngestData(ctx context.Context, workerFlowID int64, method, path, contentType string, payload []byte) (*IngestResult, error) {
-	return e.worker.IngestData(ctx, workerFlowID, method, path, contentType, payload)
+func (e *workerExecutor) IngestData(ctx context.Context, workerFlowID int64, in *IngestRequest) (*IngestResult, error) {
+	return e.worker.IngestData(ctx, workerFlowID, in)
+}
+
+func (e *workerExecutor) IngestStream(ctx context.Context, workerFlowID int64, in *IngestRequest, w http.ResponseWriter) error {
+	return e.worker.IngestStream(ctx, workerFlowID, in, w)
 }
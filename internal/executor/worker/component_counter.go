@@ -0,0 +1,80 @@
+package worker
+
+import (
+	"maps"
+	"sync"
+
+	"github.com/warpstreamlabs/bento/public/service"
+
+	"github.com/sananguliyev/airtruct/internal/persistence"
+)
+
+// ComponentCounts holds the cumulative counts of the components of a flow, keyed by component label.
+type ComponentCounts struct {
+	Input     map[string]uint64
+	Processor map[string]uint64
+	Output    map[string]uint64
+	Errors    map[string]uint64
+}
+
+// ComponentCounter counts the traced events of every component of a flow while they are drained for shipping,
+// the tracing summary itself only keeps totals.
+type ComponentCounter struct {
+	mu     sync.Mutex
+	counts ComponentCounts
+}
+
+func NewComponentCounter() *ComponentCounter {
+	return &ComponentCounter{
+		counts: ComponentCounts{
+			Input:     make(map[string]uint64),
+			Processor: make(map[string]uint64),
+			Output:    make(map[string]uint64),
+			Errors:    make(map[string]uint64),
+		},
+	}
+}
+
+// Add counts the events of a component: messages read by inputs, messages received by processors and outputs,
+// and errors of any component.
+func (c *ComponentCounter) Add(section, componentLabel string, events []service.TracingEvent) {
+	var counted service.TracingEventType
+	var counts map[string]uint64
+	switch persistence.FlowSection(section) {
+	case persistence.FlowSectionInput:
+		counted, counts = service.TracingEventProduce, c.counts.Input
+	case persistence.FlowSectionPipeline:
+		counted, counts = service.TracingEventConsume, c.counts.Processor
+	case persistence.FlowSectionOutput:
+		counted, counts = service.TracingEventConsume, c.counts.Output
+	default:
+		return
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	// Components are listed even without counted events so their errors can be matched to a section.
+	counts[componentLabel] += 0
+	for _, event := range events {
+		switch event.Type {
+		case counted:
+			counts[componentLabel]++
+		case service.TracingEventError:
+			c.counts.Errors[componentLabel]++
+		}
+	}
+}
+
+// Snapshot returns a copy of the counts.
+func (c *ComponentCounter) Snapshot() ComponentCounts {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	return ComponentCounts{
+		Input:     maps.Clone(c.counts.Input),
+		Processor: maps.Clone(c.counts.Processor),
+		Output:    maps.Clone(c.counts.Output),
+		Errors:    maps.Clone(c.counts.Errors),
+	}
+}
@@ -0,0 +1,81 @@
+package worker
+
+import (
+	"sync"
+	"testing"
+
+	"github.com/warpstreamlabs/bento/public/service"
+)
+
+func TestComponentCounterAdd(t *testing.T) {
+	counter := NewComponentCounter()
+
+	counter.Add("input", "in", []service.TracingEvent{
+		{Type: service.TracingEventProduce},
+		{Type: service.TracingEventProduce},
+		{Type: service.TracingEventError},
+	})
+	counter.Add("pipeline", "mapping", []service.TracingEvent{
+		{Type: service.TracingEventConsume},
+		{Type: service.TracingEventProduce},
+	})
+	counter.Add("pipeline", "idle", nil)
+	counter.Add("output", "out", []service.TracingEvent{
+		{Type: service.TracingEventConsume},
+		{Type: service.TracingEventError},
+	})
+	counter.Add("unknown", "other", []service.TracingEvent{{Type: service.TracingEventConsume}})
+
+	counts := counter.Snapshot()
+	if counts.Input["in"] != 2 {
+		t.Errorf("expected inputs to count produced messages, got %d", counts.Input["in"])
+	}
+	if counts.Processor["mapping"] != 1 {
+		t.Errorf("expected processors to count consumed messages, got %d", counts.Processor["mapping"])
+	}
+	if count, ok := counts.Processor["idle"]; !ok || count != 0 {
+		t.Errorf("expected components without events to be listed, got %v", counts.Processor)
+	}
+	if counts.Output["out"] != 1 {
+		t.Errorf("expected outputs to count consumed messages, got %d", counts.Output["out"])
+	}
+	if counts.Errors["in"] != 1 || counts.Errors["out"] != 1 {
+		t.Errorf("unexpected errors: %v", counts.Errors)
+	}
+	if _, ok := counts.Errors["other"]; ok {
+		t.Error("expected components of unknown sections to be ignored")
+	}
+}
+
+func TestComponentCounterSnapshotIsCopy(t *testing.T) {
+	counter := NewComponentCounter()
+	counter.Add("input", "in", []service.TracingEvent{{Type: service.TracingEventProduce}})
+
+	snapshot := counter.Snapshot()
+	counter.Add("input", "in", []service.TracingEvent{{Type: service.TracingEventProduce}})
+	snapshot.Input["in"] = 100
+
+	if counts := counter.Snapshot(); counts.Input["in"] != 2 {
+		t.Errorf("expected the snapshot to be independent of the counter, got %d", counts.Input["in"])
+	}
+}
+
+func TestComponentCounterConcurrent(t *testing.T) {
+	counter := NewComponentCounter()
+	var wg sync.WaitGroup
+	for range 10 {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for range 100 {
+				counter.Add("pipeline", "mapping", []service.TracingEvent{{Type: service.TracingEventConsume}})
+				counter.Snapshot()
+			}
+		}()
+	}
+	wg.Wait()
+
+	if counts := counter.Snapshot(); counts.Processor["mapping"] != 1000 {
+		t.Errorf("expected 1000 counted messages, got %d", counts.Processor["mapping"])
+	}
+}
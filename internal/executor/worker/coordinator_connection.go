@@ -20,7 +20,7 @@ type CoordinatorConnection interface {
 	SetFlowManager(flowManager any)
 	GetClient() pb.CoordinatorClient
 	UpdateWorkerFlowStatus(ctx context.Context, workerFlowID int64, status pb.WorkerFlowStatus) error
-	IngestMetrics(ctx context.Context, workerFlowID int64, inputEvents, processorErrors, outputEvents uint64) error
+	IngestMetrics(ctx context.Context, workerFlowID int64, inputEvents, processorErrors, outputEvents uint64, components ComponentCounts) error
 }
 
 type flowManagerInterface interface {
@@ -205,12 +205,16 @@ func (c *coordinatorConnection) UpdateWorkerFlowStatus(ctx context.Context, work
 	return nil
 }
 
-func (c *coordinatorConnection) IngestMetrics(ctx context.Context, workerFlowID int64, inputEvents, processorErrors, outputEvents uint64) error {
+func (c *coordinatorConnection) IngestMetrics(ctx context.Context, workerFlowID int64, inputEvents, processorErrors, outputEvents uint64, components ComponentCounts) error {
 	_, err := c.coordinatorClient.IngestMetrics(ctx, &pb.MetricsRequest{
 		WorkerFlowId:  workerFlowID,
 		InputEvents:     inputEvents,
 		ProcessorErrors: processorErrors,
 		OutputEvents:    outputEvents,
+		InputEventsByComponent:     components.Input,
+		ProcessorEventsByComponent: components.Processor,
+		OutputEventsByComponent:    components.Output,
+		ErrorsByComponent:          components.Errors,
 	})
 	if err != nil {
 		log.Error().Err(err).Msg("Failed to send metrics")
@@ -0,0 +1,99 @@
+package worker
+
+import (
+	"sync"
+
+	"github.com/sananguliyev/airtruct/internal/metrics"
+	pb "github.com/sananguliyev/airtruct/internal/protogen"
+)
+
+// EventBuffer holds the events drained from the flows until they are shipped. It is bounded, when it is full
+// the oldest events are dropped to make room so a slow or unreachable coordinator never stalls the flows.
+type EventBuffer struct {
+	mu      sync.Mutex
+	events  []*pb.Event
+	head    int
+	size    int
+	dropped map[int64]uint64
+	// ready is signalled when events are added.
+	ready chan struct{}
+}
+
+func NewEventBuffer(capacity int) *EventBuffer {
+	return &EventBuffer{
+		events:  make([]*pb.Event, capacity),
+		dropped: make(map[int64]uint64),
+		ready:   make(chan struct{}, 1),
+	}
+}
+
+// Push adds the events, dropping the oldest buffered ones when there is no room left.
+func (b *EventBuffer) Push(events ...*pb.Event) {
+	if len(events) == 0 {
+		return
+	}
+
+	b.mu.Lock()
+	for _, event := range events {
+		if b.size == len(b.events) {
+			oldest := b.events[b.head]
+			b.dropped[oldest.GetWorkerFlowId()]++
+			metrics.WorkerEventsDropped.Inc()
+			b.events[b.head] = nil
+			b.head = (b.head + 1) % len(b.events)
+			b.size--
+		}
+		b.events[(b.head+b.size)%len(b.events)] = event
+		b.size++
+	}
+	metrics.WorkerEventBufferDepth.Set(float64(b.size))
+	b.mu.Unlock()
+
+	select {
+	case b.ready <- struct{}{}:
+	default:
+	}
+}
+
+// Pop removes and returns up to max of the oldest events.
+func (b *EventBuffer) Pop(max int) []*pb.Event {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	n := min(max, b.size)
+	events := make([]*pb.Event, n)
+	for i := range events {
+		events[i] = b.events[b.head]
+		b.events[b.head] = nil
+		b.head = (b.head + 1) % len(b.events)
+	}
+	b.size -= n
+	metrics.WorkerEventBufferDepth.Set(float64(b.size))
+	return events
+}
+
+// Len returns the number of buffered events.
+func (b *EventBuffer) Len() int {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	return b.size
+}
+
+// TakeDropped returns the events dropped by worker flow since the previous call.
+func (b *EventBuffer) TakeDropped() map[int64]uint64 {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	if len(b.dropped) == 0 {
+		return nil
+	}
+	dropped := b.dropped
+	b.dropped = make(map[int64]uint64)
+	return dropped
+}
+
+// Ready is signalled when events were added.
+func (b *EventBuffer) Ready() <-chan struct{} {
+	return b.ready
+}
@@ -20,11 +20,23 @@ import (
 	"github.com/sananguliyev/airtruct/internal/logger"
 	"github.com/sananguliyev/airtruct/internal/persistence"
 	pb "github.com/sananguliyev/airtruct/internal/protogen"
+	"github.com/sananguliyev/airtruct/internal/tracing"
 	"github.com/sananguliyev/airtruct/internal/vault"
 )
 
+type IngestRequest struct {
+	Method      string
+	Path        string
+	Query       string
+	ContentType string
+	RemoteAddr  string
+	Headers     http.Header
+	Payload     []byte
+}
+
 type IngestResult struct {
 	StatusCode int
+	Headers    http.Header
 	Response   []byte
 }
 
@@ -34,6 +46,8 @@ type ServiceFlow struct {
 	Mux            http.Handler
 	Status         persistence.WorkerFlowStatus
 	TracingSummary *service.TracingSummary
+	// ComponentCounter counts the traced events per component as they are shipped.
+	ComponentCounter *ComponentCounter
 }
 
 type FlowManager interface {
@@ -42,7 +56,8 @@ type FlowManager interface {
 	GetFlow(workerFlowID int64) (*ServiceFlow, bool)
 	GetFlowStatus(workerFlowID int64) (*persistence.WorkerFlowStatus, error)
 	DeleteFlow(workerFlowID int64) error
-	IngestData(workerFlowID int64, method, path, contentType string, payload []byte) (*IngestResult, error)
+	IngestData(ctx context.Context, workerFlowID int64, in *IngestRequest) (*IngestResult, error)
+	IngestStream(ctx context.Context, workerFlowID int64, in *IngestRequest, w http.ResponseWriter) error
 	GetAllFlows() map[int64]*ServiceFlow
 	GetRunningFlowIDs() []int64
 	StopFlow(workerFlowID int64) error
@@ -55,13 +70,16 @@ type flowManager struct {
 	flows               map[int64]*ServiceFlow
 	coordinatorConnection CoordinatorConnection
 	vaultProvider         vault.VaultProvider
+	// logWriter receives the log lines of the flows.
+	logWriter io.Writer
 }
 
-func NewFlowManager(coordinatorConnection CoordinatorConnection, vaultProvider vault.VaultProvider) FlowManager {
+func NewFlowManager(coordinatorConnection CoordinatorConnection, vaultProvider vault.VaultProvider, logWriter io.Writer) FlowManager {
 	return &flowManager{
 		flows:               make(map[int64]*ServiceFlow),
 		coordinatorConnection: coordinatorConnection,
 		vaultProvider:         vaultProvider,
+		logWriter:             logWriter,
 	}
 }
 
@@ -92,7 +110,7 @@ func (m *flowManager) AddFlow(workerFlowID int64, config string) error {
 	streamMux := newSafeMux()
 	streamBuilder.SetHTTPMux(streamMux)
 
-	slogLogger := logger.NewSlogLogger("INFO", map[string]any{
+	slogLogger := logger.NewSlogLoggerWithWriter(m.logWriter, "INFO", map[string]any{
 		"@service":         "airtruct",
 		"worker_flow_id": workerFlowID,
 	})
@@ -124,6 +142,7 @@ func (m *flowManager) AddFlow(workerFlowID int64, config string) error {
 		Mux:            streamMux,
 		Status:         persistence.WorkerFlowStatusRunning,
 		TracingSummary: tracingSummary,
+		ComponentCounter: NewComponentCounter(),
 	}
 
 	m.flows[workerFlowID] = serviceStream
@@ -177,47 +196,27 @@ func (m *flowManager) DeleteFlow(workerFlowID int64) error {
 	return nil
 }
 
-func (m *flowManager) IngestData(workerFlowID int64, method, path, contentType string, payload []byte) (*IngestResult, error) {
-	m.mu.RLock()
-	defer m.mu.RUnlock()
-
+func (m *flowManager) IngestData(ctx context.Context, workerFlowID int64, in *IngestRequest) (*IngestResult, error) {
 	result := &IngestResult{}
 
-	flow, exists := m.flows[workerFlowID]
-	if !exists {
-		log.Debug().Int64("worker_flow_id", workerFlowID).Msg("flow is not running on this worker")
-		return nil, fmt.Errorf("flow is assigned but not yet running on this worker (worker_flow_id: %d)", workerFlowID)
-	}
-
 	rr := httptest.NewRecorder()
-	req, err := http.NewRequest(method, path, bytes.NewBuffer(payload))
-	if err != nil {
-		log.Error().
-			Err(err).
-			Int64("worker_flow_id", workerFlowID).
-			Str("content_type", contentType).
-			Str("method", method).
-			Str("path", path).
-			Bytes("payload", payload).
-			Msg("Failed to create new request")
+	if err := m.IngestStream(ctx, workerFlowID, in, rr); err != nil {
 		return nil, err
 	}
-
-	req.Header.Set("Content-Type", contentType)
-
-	flow.Mux.ServeHTTP(rr, req)
 	result.StatusCode = rr.Code
+	result.Headers = rr.Header()
 
 	if rr.Body != nil {
+		var err error
 		result.Response, err = io.ReadAll(rr.Body)
 		if err != nil {
 			log.Error().
 				Err(err).
 				Int64("worker_flow_id", workerFlowID).
-				Str("content_type", contentType).
-				Str("method", method).
-				Str("path", path).
-				Bytes("payload", payload).
+				Str("content_type", in.ContentType).
+				Str("method", in.Method).
+				Str("path", in.Path).
+				Bytes("payload", in.Payload).
 				Msg("Failed to read response body")
 			result.Response = []byte("Failed to read response body")
 		}
@@ -226,6 +225,54 @@ func (m *flowManager) IngestData(workerFlowID int64, method, path, contentType s
 	return result, nil
 }
 
+// IngestStream serves the request with the flow's HTTP handler, writing the response to w as it is produced.
+func (m *flowManager) IngestStream(ctx context.Context, workerFlowID int64, in *IngestRequest, w http.ResponseWriter) error {
+	// Long-running requests must not hold the lock, otherwise flow assignment
+	// and removal would block until they finish.
+	m.mu.RLock()
+	flow, exists := m.flows[workerFlowID]
+	m.mu.RUnlock()
+
+	if !exists {
+		log.Debug().Int64("worker_flow_id", workerFlowID).Msg("flow is not running on this worker")
+		return fmt.Errorf("flow is assigned but not yet running on this worker (worker_flow_id: %d)", workerFlowID)
+	}
+
+	target := in.Path
+	if in.Query != "" {
+		target += "?" + in.Query
+	}
+
+	req, err := http.NewRequestWithContext(ctx, in.Method, target, bytes.NewBuffer(in.Payload))
+	if err != nil {
+		log.Error().
+			Err(err).
+			Int64("worker_flow_id", workerFlowID).
+			Str("content_type", in.ContentType).
+			Str("method", in.Method).
+			Str("path", in.Path).
+			Bytes("payload", in.Payload).
+			Msg("Failed to create new request")
+		return err
+	}
+
+	for key, values := range in.Headers {
+		for _, value := range values {
+			req.Header.Add(key, value)
+		}
+	}
+	req.Header.Set("Content-Type", in.ContentType)
+	// The flow input continues the trace of the Ingest call rather than the one of the original client.
+	tracing.Inject(ctx, req.Header)
+	if in.RemoteAddr != "" {
+		req.RemoteAddr = in.RemoteAddr
+	}
+
+	flow.Mux.ServeHTTP(w, req)
+
+	return nil
+}
+
 func (m *flowManager) GetAllFlows() map[int64]*ServiceFlow {
 	m.mu.RLock()
 	defer m.mu.RUnlock()
@@ -321,7 +368,7 @@ func (m *flowManager) StartFlow(ctx context.Context, workerFlowID int64) {
 
 			log.Info().Int64("worker_flow_id", workerFlowID).Str("status", string(flowStatus)).Msg("Finishing flow")
 
-			m.shipMetrics(ctx, workerFlowID, flow.TracingSummary)
+			m.shipMetrics(ctx, workerFlowID, flow)
 
 			if err := m.DeleteFlow(workerFlowID); err != nil {
 				log.Debug().Err(err).Int64("worker_flow_id", workerFlowID).Msg("Flow already deleted")
@@ -366,17 +413,18 @@ func (m *flowManager) StartFlow(ctx context.Context, workerFlowID int64) {
 	}()
 }
 
-func (m *flowManager) shipMetrics(ctx context.Context, workerFlowID int64, tracingSummary *service.TracingSummary) {
-	if tracingSummary == nil {
+func (m *flowManager) shipMetrics(ctx context.Context, workerFlowID int64, flow *ServiceFlow) {
+	if flow.TracingSummary == nil {
 		return
 	}
 
 	err := m.coordinatorConnection.IngestMetrics(
 		ctx,
 		workerFlowID,
-		tracingSummary.TotalInput(),
-		tracingSummary.TotalProcessorErrors(),
-		tracingSummary.TotalOutput(),
+		flow.TracingSummary.TotalInput(),
+		flow.TracingSummary.TotalProcessorErrors(),
+		flow.TracingSummary.TotalOutput(),
+		flow.ComponentCounter.Snapshot(),
 	)
 	if err != nil {
 		log.Error().Err(err).Int64("worker_flow_id", workerFlowID).Msg("Failed to ship metrics")
@@ -7,6 +7,7 @@ import (
 
 	"github.com/rs/zerolog/log"
 
+	"github.com/sananguliyev/airtruct/internal/metrics"
 	pb "github.com/sananguliyev/airtruct/internal/protogen"
 )
 
@@ -47,6 +48,7 @@ func (q *flowQueue) AddFlowToQueue(workerFlowID int64, config string, files []*p
 
 	select {
 	case q.queue <- item:
+		metrics.WorkerFlowQueueDepth.Set(float64(len(q.queue)))
 		log.Info().Int64("worker_flow_id", workerFlowID).Msg("Stream added to queue")
 		return nil
 	case <-time.After(EventStreamMaxDelay):
@@ -59,6 +61,7 @@ func (q *flowQueue) ConsumeFlowQueue(ctx context.Context) {
 	for {
 		select {
 		case item := <-q.queue:
+			metrics.WorkerFlowQueueDepth.Set(float64(len(q.queue)))
 			log.Info().Int64("worker_flow_id", item.WorkerFlowID).Msg("Processing stream from queue")
 
 			if err := q.flowManager.WriteFiles(item.Files); err != nil {
@@ -0,0 +1,89 @@
+package worker
+
+import (
+	"sync"
+
+	"google.golang.org/protobuf/types/known/structpb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/sananguliyev/airtruct/internal/logger"
+	"github.com/sananguliyev/airtruct/internal/metrics"
+	pb "github.com/sananguliyev/airtruct/internal/protogen"
+)
+
+// LogBuffer holds the log lines of the flows until they are shipped. It is bounded, when it is full the oldest
+// lines are dropped to make room so a slow or unreachable coordinator never stalls the flows.
+type LogBuffer struct {
+	mu      sync.Mutex
+	logs    []*pb.FlowLog
+	head    int
+	size    int
+	dropped map[int64]uint64
+}
+
+func NewLogBuffer(capacity int) *LogBuffer {
+	return &LogBuffer{
+		logs:    make([]*pb.FlowLog, capacity),
+		dropped: make(map[int64]uint64),
+	}
+}
+
+// Collect adds a log line written by a flow, it is meant to be passed to logger.NewFlowLogWriter.
+// It must not log itself as it is called while a line is written.
+func (b *LogBuffer) Collect(record logger.FlowLog) {
+	line := &pb.FlowLog{
+		WorkerFlowId: record.WorkerFlowID,
+		Level:        record.Level,
+		Message:      record.Message,
+		CreatedAt:    timestamppb.New(record.Time),
+	}
+	if len(record.Fields) > 0 {
+		// Fields decoded from JSON always convert, a line with fields that do not is kept without them.
+		if fields, err := structpb.NewStruct(record.Fields); err == nil {
+			line.Fields = fields
+		}
+	}
+
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	if b.size == len(b.logs) {
+		oldest := b.logs[b.head]
+		b.dropped[oldest.GetWorkerFlowId()]++
+		metrics.WorkerLogsDropped.Inc()
+		b.logs[b.head] = nil
+		b.head = (b.head + 1) % len(b.logs)
+		b.size--
+	}
+	b.logs[(b.head+b.size)%len(b.logs)] = line
+	b.size++
+}
+
+// Pop removes and returns up to max of the oldest lines.
+func (b *LogBuffer) Pop(max int) []*pb.FlowLog {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	n := min(max, b.size)
+	logs := make([]*pb.FlowLog, n)
+	for i := range logs {
+		logs[i] = b.logs[b.head]
+		b.logs[b.head] = nil
+		b.head = (b.head + 1) % len(b.logs)
+	}
+	b.size -= n
+	return logs
+}
+
+// TakeDropped returns the lines dropped by worker flow since the previous call.
+func (b *LogBuffer) TakeDropped() map[int64]uint64 {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	if len(b.dropped) == 0 {
+		return nil
+	}
+	dropped := b.dropped
+	b.dropped = make(map[int64]uint64)
+	return dropped
+}
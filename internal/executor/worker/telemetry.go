@@ -2,12 +2,17 @@ package worker
 
 import (
 	"context"
+	"errors"
+	"fmt"
 	"io"
 	"time"
 
+	"github.com/google/uuid"
 	"github.com/rs/zerolog/log"
 	"github.com/warpstreamlabs/bento/public/service"
+	"google.golang.org/grpc"
 	"google.golang.org/protobuf/types/known/structpb"
+	"google.golang.org/protobuf/types/known/timestamppb"
 
 	"github.com/sananguliyev/airtruct/internal/persistence"
 	pb "github.com/sananguliyev/airtruct/internal/protogen"
@@ -15,106 +20,274 @@ import (
 
 type TelemetryManager interface {
 	ShipLogs(ctx context.Context)
+	ShipFlowLogs(ctx context.Context)
 	ShipMetrics(ctx context.Context)
 }
 
+const (
+	EventBufferSize        = 10000
+	EventBatchSize         = 500
+	EventBatchInterval     = 500 * time.Millisecond
+	EventDrainInterval     = 100 * time.Millisecond
+	EventMaxUnackedBatches = 8
+	LogBufferSize          = 10000
+	LogBatchSize           = 1000
+	LogShipInterval        = time.Second
+)
+
 type telemetryManager struct {
 	coordinatorConnection CoordinatorConnection
 	flowManager         FlowManager
+	eventBuffer           *EventBuffer
+	logBuffer             *LogBuffer
+	// sequence is the sequence number of the last batch, unacked the batches sent and not yet acknowledged in
+	// sequence order. Both are only used by ShipLogs.
+	sequence uint64
+	unacked  []*pb.EventBatch
+	// eventKeyPrefix makes the keys of the events unique to this process, as sequences restart with it.
+	eventKeyPrefix string
 }
 
-func NewTelemetryManager(coordinatorConnection CoordinatorConnection, flowManager FlowManager) TelemetryManager {
+func NewTelemetryManager(coordinatorConnection CoordinatorConnection, flowManager FlowManager, logBuffer *LogBuffer) TelemetryManager {
 	return &telemetryManager{
 		coordinatorConnection: coordinatorConnection,
 		flowManager:         flowManager,
+		eventBuffer:           NewEventBuffer(EventBufferSize),
+		logBuffer:             logBuffer,
+		eventKeyPrefix:        uuid.NewString(),
 	}
 }
 
 func (t *telemetryManager) ShipLogs(ctx context.Context) {
+	go t.collectEvents(ctx)
+
 	retryDelay := time.Second
+	for {
+		started := time.Now()
+		err := t.shipEvents(ctx)
+		if ctx.Err() != nil {
+			log.Info().Msg("ShipLogs context done, closing event stream")
+			return
+		}
+		log.Error().Err(err).Int("unacked_batches", len(t.unacked)).Msg("Event stream failed, re-establishing connection")
+
+		// A stream that was healthy for a while resets the backoff.
+		if time.Since(started) > EventStreamMaxDelay {
+			retryDelay = time.Second
+		}
+		select {
+		case <-ctx.Done():
+			return
+		case <-time.After(retryDelay):
+		}
+		retryDelay = min(retryDelay*2, EventStreamMaxDelay)
+	}
+}
+
+// collectEvents drains the traced events of the flows into the event buffer until ctx is done.
+func (t *telemetryManager) collectEvents(ctx context.Context) {
+	ticker := time.NewTicker(EventDrainInterval)
+	defer ticker.Stop()
 
 	for {
-		eventStreamClient, err := t.coordinatorConnection.GetClient().IngestEvents(ctx)
-		if err != nil {
-			log.Error().Err(err).Msg("Failed to create event stream client")
-			retryDelay *= 2
-			time.Sleep(min(retryDelay, EventStreamMaxDelay))
-			continue
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+
+		for workerFlowID, flow := range t.flowManager.GetAllFlows() {
+			tracingSummary := flow.TracingSummary
+			eventGetters := map[string]func(bool) map[string][]service.TracingEvent{
+				string(persistence.FlowSectionInput):    tracingSummary.InputEvents,
+				string(persistence.FlowSectionPipeline): tracingSummary.ProcessorEvents,
+				string(persistence.FlowSectionOutput):   tracingSummary.OutputEvents,
+			}
+
+			for section, getEvents := range eventGetters {
+				for componentLabel, events := range getEvents(true) {
+					flow.ComponentCounter.Add(section, componentLabel, events)
+
+					pbEvents := make([]*pb.Event, 0, len(events))
+					for _, event := range events {
+						metaStruct, err := structpb.NewStruct(event.Meta)
+						if err != nil {
+							log.Error().
+								Err(err).
+								Int64("worker_flow_id", workerFlowID).
+								Str("component_label", componentLabel).
+								Str("event_type", string(event.Type)).
+								Str("event_content", event.Content).
+								Any("event_meta", event.Meta).
+								Msg("Failed to convert meta field to pb struct")
+							continue
+						}
+
+						pbEvents = append(pbEvents, &pb.Event{
+							WorkerFlowId:   workerFlowID,
+							ComponentLabel: componentLabel,
+							Section:        section,
+							Type:           string(event.Type),
+							Content:        event.Content,
+							Meta:           metaStruct,
+							TraceId:        event.FlowID,
+							CreatedAt:      timestamppb.New(event.Timestamp),
+						})
+					}
+					t.eventBuffer.Push(pbEvents...)
+				}
+			}
 		}
-		retryDelay = time.Second
+	}
+}
+
+// shipEvents sends the buffered events in batches over a new event stream until the stream fails or ctx is done.
+// Batches left unacknowledged by a previous stream are sent again first. At most EventMaxUnackedBatches are
+// awaiting an ack at any time, meanwhile new events wait in the buffer.
+func (t *telemetryManager) shipEvents(ctx context.Context) error {
+	streamCtx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	stream, err := t.coordinatorConnection.GetClient().IngestEvents(streamCtx)
+	if err != nil {
+		return fmt.Errorf("failed to create event stream client: %w", err)
+	}
 
+	acks := make(chan uint64, EventMaxUnackedBatches)
+	recvErr := make(chan error, 1)
+	go func() {
 		for {
+			ack, err := stream.Recv()
+			if err != nil {
+				recvErr <- err
+				return
+			}
 			select {
-			case <-ctx.Done():
-				log.Info().Msg("ShipLogs context done, closing event stream")
-				if err := eventStreamClient.CloseSend(); err != nil {
-					log.Error().Err(err).Msg("Error closing send event stream")
-				}
+			case acks <- ack.GetSequence():
+			case <-streamCtx.Done():
 				return
-			default:
-				sentEvents := 0
-				flows := t.flowManager.GetAllFlows()
-
-				for workerFlowID, flow := range flows {
-					tracingSummary := flow.TracingSummary
-					eventGetters := map[string]func(bool) map[string][]service.TracingEvent{
-						string(persistence.FlowSectionInput):    tracingSummary.InputEvents,
-						string(persistence.FlowSectionPipeline): tracingSummary.ProcessorEvents,
-						string(persistence.FlowSectionOutput):   tracingSummary.OutputEvents,
-					}
+			}
+		}
+	}()
 
-					for section, getEvents := range eventGetters {
-						for componentLabel, events := range getEvents(true) {
-							for _, event := range events {
-								metaStruct, err := structpb.NewStruct(event.Meta)
-								if err != nil {
-									log.Error().
-										Err(err).
-										Int64("worker_flow_id", workerFlowID).
-										Str("component_label", componentLabel).
-										Str("event_type", string(event.Type)).
-										Str("event_content", event.Content).
-										Any("event_meta", event.Meta).
-										Msg("Failed to convert meta field to pb struct")
-									continue
-								}
-
-								if err := eventStreamClient.Send(&pb.Event{
-									WorkerFlowId: workerFlowID,
-									ComponentLabel: componentLabel,
-									Section:        section,
-									Type:           string(event.Type),
-									Content:        event.Content,
-									Meta:           metaStruct,
-									TraceId:         event.FlowID,
-								}); err != nil {
-									log.Error().Err(err).Msg("Failed to send event, re-establishing connection")
-									goto ReconnectEventStream
-								}
-								sentEvents++
-
-								_, err = eventStreamClient.Recv()
-								if err == io.EOF {
-									log.Info().Msg("Server closed the connection")
-									goto ReconnectEventStream
-								}
-								if err != nil {
-									log.Error().Err(err).Msg("Failed to receive acknowledgment, re-establishing connection")
-									goto ReconnectEventStream
-								}
-							}
-						}
-					}
+	for _, batch := range t.unacked {
+		if err := stream.Send(batch); err != nil {
+			return fmt.Errorf("failed to resend event batch: %w", err)
+		}
+	}
+
+	ticker := time.NewTicker(EventBatchInterval)
+	defer ticker.Stop()
+
+	for {
+		canSend := len(t.unacked) < EventMaxUnackedBatches
+		if canSend && t.eventBuffer.Len() >= EventBatchSize {
+			if err := t.sendBatch(stream); err != nil {
+				return err
+			}
+			continue
+		}
+
+		select {
+		case <-ctx.Done():
+			if err := stream.CloseSend(); err != nil {
+				log.Error().Err(err).Msg("Error closing send event stream")
+			}
+			return ctx.Err()
+		case err := <-recvErr:
+			if err == io.EOF {
+				return errors.New("coordinator closed the event stream")
+			}
+			return fmt.Errorf("failed to receive acknowledgment: %w", err)
+		case sequence := <-acks:
+			t.acknowledge(sequence)
+		case <-t.eventBuffer.Ready():
+		case <-ticker.C:
+			// Partial batches are sent once per interval so events of quiet flows show up promptly.
+			if canSend {
+				if err := t.sendBatch(stream); err != nil {
+					return err
 				}
+			}
+		}
+	}
+}
+
+// sendBatch sends the oldest buffered events as the next batch, it sends nothing when there is nothing to report.
+func (t *telemetryManager) sendBatch(stream grpc.BidiStreamingClient[pb.EventBatch, pb.EventAck]) error {
+	events := t.eventBuffer.Pop(EventBatchSize)
+	dropped := t.eventBuffer.TakeDropped()
+	if len(events) == 0 && len(dropped) == 0 {
+		return nil
+	}
 
-				if sentEvents == 0 {
-					time.Sleep(100 * time.Millisecond)
+	t.sequence++
+	// Keys stay the same when the batch is sent again after a reconnect, so the coordinator stores it once.
+	for i, event := range events {
+		event.Key = fmt.Sprintf("%s:%d:%d", t.eventKeyPrefix, t.sequence, i)
+	}
+	batch := &pb.EventBatch{
+		Sequence: t.sequence,
+		Events:   events,
+		Dropped:  dropped,
+	}
+	// The batch is kept until acknowledged so a failed send is retried on the next stream.
+	t.unacked = append(t.unacked, batch)
+
+	if err := stream.Send(batch); err != nil {
+		return fmt.Errorf("failed to send event batch: %w", err)
+	}
+	return nil
+}
+
+// acknowledge forgets the batches up to and including sequence.
+func (t *telemetryManager) acknowledge(sequence uint64) {
+	acked := 0
+	for acked < len(t.unacked) && t.unacked[acked].GetSequence() <= sequence {
+		acked++
+	}
+	t.unacked = t.unacked[acked:]
+}
+
+// ShipFlowLogs sends the buffered log lines of the flows to the coordinator once per LogShipInterval until ctx is
+// done. A batch that fails to send is retried on the next tick while new lines wait in the buffer. Its own log
+// lines are not tagged with a worker flow so they are never shipped themselves.
+func (t *telemetryManager) ShipFlowLogs(ctx context.Context) {
+	ticker := time.NewTicker(LogShipInterval)
+	defer ticker.Stop()
+
+	var pending *pb.IngestLogsRequest
+	for {
+		select {
+		case <-ctx.Done():
+			log.Info().Msg("ShipFlowLogs context done, stopping log shipping")
+			return
+		case <-ticker.C:
+		}
+
+		for {
+			if pending == nil {
+				pending = &pb.IngestLogsRequest{
+					Logs:    t.logBuffer.Pop(LogBatchSize),
+					Dropped: t.logBuffer.TakeDropped(),
 				}
+				if len(pending.Logs) == 0 && len(pending.Dropped) == 0 {
+					pending = nil
+					break
+				}
+			}
+
+			if _, err := t.coordinatorConnection.GetClient().IngestLogs(ctx, pending); err != nil {
+				log.Error().Err(err).Int("lines", len(pending.Logs)).Msg("Failed to ship flow logs")
+				break
+			}
+			full := len(pending.Logs) == LogBatchSize
+			pending = nil
+			// A full batch means more lines may be waiting, they are sent right away.
+			if !full {
+				break
 			}
 		}
-	ReconnectEventStream:
-		log.Info().Msg("Attempting to reconnect flow...")
-		time.Sleep(retryDelay)
 	}
 }
 
@@ -129,6 +302,7 @@ func (t *telemetryManager) ShipMetrics(ctx context.Context) {
 			tracingSummary.TotalInput(),
 			tracingSummary.TotalProcessorErrors(),
 			tracingSummary.TotalOutput(),
+			flow.ComponentCounter.Snapshot(),
 		)
 		if err != nil {
 			log.Error().Err(err).Msg("Failed to send metrics")
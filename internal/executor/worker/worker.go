@@ -2,9 +2,12 @@ package worker
 
 import (
 	"context"
+	"net/http"
+	"os"
 
 	"google.golang.org/grpc"
 
+	"github.com/sananguliyev/airtruct/internal/logger"
 	"github.com/sananguliyev/airtruct/internal/persistence"
 	pb "github.com/sananguliyev/airtruct/internal/protogen"
 	"github.com/sananguliyev/airtruct/internal/vault"
@@ -18,9 +21,11 @@ type WorkerExecutor interface {
 	FetchWorkerFlowStatus(ctx context.Context, workerFlowID int64) (*persistence.WorkerFlowStatus, error)
 	DeleteWorkerFlow(ctx context.Context, workerFlowID int64) error
 	ShipLogs(context.Context)
+	ShipFlowLogs(context.Context)
 	ShipMetrics(context.Context)
 	ConsumeFlowQueue(context.Context)
-	IngestData(ctx context.Context, workerFlowID int64, method, path, contentType string, payload []byte) (*IngestResult, error)
+	IngestData(ctx context.Context, workerFlowID int64, in *IngestRequest) (*IngestResult, error)
+	IngestStream(ctx context.Context, workerFlowID int64, in *IngestRequest, w http.ResponseWriter) error
 }
 
 type workerExecutor struct {
@@ -33,13 +38,16 @@ type workerExecutor struct {
 func NewWorkerExecutor(ctx context.Context, grpcConn *grpc.ClientConn, grpcPort uint32, vaultProvider vault.VaultProvider) WorkerExecutor {
 	coordinatorConnection := NewCoordinatorConnection(ctx, grpcConn, grpcPort)
 
-	flowManager := NewFlowManager(coordinatorConnection, vaultProvider)
+	// Only the lines of the loggers of the flows are shipped to the coordinator as the logs of the flow. The lines
+	// the worker logs about a flow can carry its payloads, they stay in the output of the worker.
+	logBuffer := NewLogBuffer(LogBufferSize)
+	flowManager := NewFlowManager(coordinatorConnection, vaultProvider, logger.NewFlowLogWriter(os.Stdout, logBuffer.Collect))
 
 	coordinatorConnection.SetFlowManager(flowManager)
 
 	flowQueue := NewFlowQueue(flowManager)
 
-	telemetryManager := NewTelemetryManager(coordinatorConnection, flowManager)
+	telemetryManager := NewTelemetryManager(coordinatorConnection, flowManager, logBuffer)
 
 	return &workerExecutor{
 		coordinatorConnection: coordinatorConnection,
@@ -77,6 +85,10 @@ func (e *workerExecutor) ShipLogs(ctx context.Context) {
 	e.telemetryManager.ShipLogs(ctx)
 }
 
+func (e *workerExecutor) ShipFlowLogs(ctx context.Context) {
+	e.telemetryManager.ShipFlowLogs(ctx)
+}
+
 func (e *workerExecutor) ShipMetrics(ctx context.Context) {
 	e.telemetryManager.ShipMetrics(ctx)
 }
@@ -85,6 +97,10 @@ func (e *workerExecutor) ConsumeFlowQueue(ctx context.Context) {
 	e.flowQueue.ConsumeFlowQueue(ctx)
 }
 
-func (e *workerExecutor) IngestData(ctx context.Context, workerFlowID int64, method, path, contentType string, payload []byte) (*IngestResult, error) {
-	return e.flowManager.IngestData(workerFlowID, method, path, contentType, payload)
+func (e *workerExecutor) IngestData(ctx context.Context, workerFlowID int64, in *IngestRequest) (*IngestResult, error) {
+	return e.flowManager.IngestData(ctx, workerFlowID, in)
+}
+
+func (e *workerExecutor) IngestStream(ctx context.Context, workerFlowID int64, in *IngestRequest, w http.ResponseWriter) error {
+	return e.flowManager.IngestStream(ctx, workerFlowID, in, w)
 }
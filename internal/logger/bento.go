@@ -229,6 +229,11 @@ func (h *slogHandler) WithGroup(name string) slog.Handler {
 }
 
 func NewSlogLogger(level string, staticFields map[string]any) *slog.Logger {
+	return NewSlogLoggerWithWriter(os.Stdout, level, staticFields)
+}
+
+// NewSlogLoggerWithWriter is NewSlogLogger writing its lines to w.
+func NewSlogLoggerWithWriter(w io.Writer, level string, staticFields map[string]any) *slog.Logger {
 	var logLevel zerolog.Level
 	switch level {
 	case "DEBUG", "debug":
@@ -244,7 +249,7 @@ func NewSlogLogger(level string, staticFields map[string]any) *slog.Logger {
 	}
 
 	zerolog.TimeFieldFormat = zerolog.TimeFormatUnix
-	logger := zerolog.New(os.Stdout).With().Timestamp().Logger().Level(logLevel)
+	logger := zerolog.New(w).With().Timestamp().Logger().Level(logLevel)
 
 	if len(staticFields) > 0 {
 		ctx := logger.With()
@@ -0,0 +1,93 @@
+package logger
+
+import (
+	"bytes"
+	"encoding/json"
+	"io"
+	"strconv"
+	"time"
+
+	"github.com/rs/zerolog"
+)
+
+// FlowLogField is the field tagging the log lines of a flow with its worker flow.
+const FlowLogField = "worker_flow_id"
+
+// FlowLog is a log line of a flow.
+type FlowLog struct {
+	WorkerFlowID int64
+	Level        string
+	Message      string
+	Fields       map[string]any
+	Time         time.Time
+}
+
+type flowLogWriter struct {
+	out     io.Writer
+	collect func(FlowLog)
+}
+
+// NewFlowLogWriter writes the zerolog lines to out and hands the ones tagged with FlowLogField to collect.
+func NewFlowLogWriter(out io.Writer, collect func(FlowLog)) io.Writer {
+	return &flowLogWriter{out: out, collect: collect}
+}
+
+func (w *flowLogWriter) Write(p []byte) (int, error) {
+	n, err := w.out.Write(p)
+	if record, ok := parseFlowLog(p); ok {
+		w.collect(record)
+	}
+	return n, err
+}
+
+func parseFlowLog(line []byte) (FlowLog, bool) {
+	if !bytes.Contains(line, []byte(`"`+FlowLogField+`"`)) {
+		return FlowLog{}, false
+	}
+
+	decoder := json.NewDecoder(bytes.NewReader(line))
+	decoder.UseNumber()
+	var fields map[string]any
+	if err := decoder.Decode(&fields); err != nil {
+		return FlowLog{}, false
+	}
+
+	workerFlowID, ok := parseWorkerFlowID(fields[FlowLogField])
+	if !ok {
+		return FlowLog{}, false
+	}
+
+	record := FlowLog{
+		WorkerFlowID: workerFlowID,
+		Level:        zerolog.InfoLevel.String(),
+		Time:         time.Now(),
+	}
+	if level, ok := fields[zerolog.LevelFieldName].(string); ok && level != "" {
+		record.Level = level
+	}
+	if message, ok := fields[zerolog.MessageFieldName].(string); ok {
+		record.Message = message
+	}
+
+	// The time of the line is dropped, it is written in seconds while lines are collected as they are written.
+	for _, key := range []string{FlowLogField, zerolog.LevelFieldName, zerolog.MessageFieldName, zerolog.TimestampFieldName, "@service"} {
+		delete(fields, key)
+	}
+	if len(fields) > 0 {
+		record.Fields = fields
+	}
+
+	return record, true
+}
+
+func parseWorkerFlowID(value any) (int64, bool) {
+	switch v := value.(type) {
+	case json.Number:
+		id, err := v.Int64()
+		return id, err == nil
+	case string:
+		id, err := strconv.ParseInt(v, 10, 64)
+		return id, err == nil
+	}
+	return 0, false
+}
@@ -0,0 +1,95 @@
+package logger
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/rs/zerolog"
+)
+
+func TestFlowLogWriterCollectsFlowLines(t *testing.T) {
+	var out bytes.Buffer
+	var collected []FlowLog
+	writer := NewFlowLogWriter(&out, func(record FlowLog) {
+		collected = append(collected, record)
+	})
+
+	log := zerolog.New(writer).With().Timestamp().Logger()
+	log.Warn().Int64("worker_flow_id", 42).Str("label", "http_in").Msg("Connection lost")
+	log.Info().Msg("Worker started")
+
+	if bytes.Count(out.Bytes(), []byte("\n")) != 2 {
+		t.Errorf("Expected both lines to be written, got %q", out.String())
+	}
+
+	if len(collected) != 1 {
+		t.Fatalf("Expected 1 collected line, got %d", len(collected))
+	}
+
+	record := collected[0]
+	if record.WorkerFlowID != 42 {
+		t.Errorf("Expected worker flow 42, got %d", record.WorkerFlowID)
+	}
+	if record.Level != "warn" {
+		t.Errorf("Expected level 'warn', got '%s'", record.Level)
+	}
+	if record.Message != "Connection lost" {
+		t.Errorf("Expected message 'Connection lost', got '%s'", record.Message)
+	}
+	if record.Time.IsZero() {
+		t.Error("Expected time to be set")
+	}
+	if len(record.Fields) != 1 || record.Fields["label"] != "http_in" {
+		t.Errorf("Expected only the label field, got %v", record.Fields)
+	}
+}
+
+func TestFlowLogWriterCollectsSlogLines(t *testing.T) {
+	var collected []FlowLog
+	writer := NewFlowLogWriter(&bytes.Buffer{}, func(record FlowLog) {
+		collected = append(collected, record)
+	})
+
+	slogLogger := NewSlogLoggerWithWriter(writer, "INFO", map[string]any{
+		"@service":       "airtruct",
+		"worker_flow_id": int64(7),
+	})
+	slogLogger.Debug("Not written")
+	slogLogger.Error("Failed to connect", "path", "root.input")
+
+	if len(collected) != 1 {
+		t.Fatalf("Expected 1 collected line, got %d", len(collected))
+	}
+
+	record := collected[0]
+	if record.WorkerFlowID != 7 || record.Level != "error" || record.Message != "Failed to connect" {
+		t.Errorf("Unexpected record %+v", record)
+	}
+	if _, ok := record.Fields["@service"]; ok {
+		t.Error("Expected the service field to be dropped")
+	}
+	if record.Fields["path"] != "root.input" {
+		t.Errorf("Expected path field 'root.input', got %v", record.Fields["path"])
+	}
+}
+
+func TestFlowLogWriterIgnoresInvalidLines(t *testing.T) {
+	collected := 0
+	writer := NewFlowLogWriter(&bytes.Buffer{}, func(FlowLog) {
+		collected++
+	})
+
+	for _, line := range []string{
+		`worker_flow_id=1 msg="logfmt"`,
+		`{"worker_flow_id":"abc","message":"invalid id"}`,
+		`{"worker_flow_id":1,"message":`,
+	} {
+		if _, err := writer.Write([]byte(line + "\n")); err != nil {
+			t.Fatalf("Unexpected error: %v", err)
+		}
+	}
+
+	if collected != 0 {
+		t.Errorf("Expected no collected lines, got %d", collected)
+	}
+}
@@ -0,0 +1,106 @@
+package mcp
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"regexp"
+	"time"
+
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/mark3labs/mcp-go/server"
+	"github.com/rs/zerolog/log"
+
+	"github.com/sananguliyev/airtruct/internal/persistence"
+)
+
+const (
+	redactedValue = "[REDACTED]"
+	// maxAuditArgumentsSize caps the stored arguments, larger ones are replaced with a truncation marker.
+	// The hash still covers the full payload.
+	maxAuditArgumentsSize = 4096
+)
+
+// truncatedArguments is stored instead of arguments larger than maxAuditArgumentsSize, so the record always
+// holds valid JSON.
+type truncatedArguments struct {
+	Truncated bool `json:"truncated"`
+	Size      int  `json:"size"`
+}
+
+var sensitiveArgumentKey = regexp.MustCompile(`(?i)password|passphrase|secret|token|authorization|api[_-]?key|credential|private[_-]?key|cookie`)
+
+// newToolCallRecord starts an audit record for a tool call with the caller's session details and a redacted
+// copy of its arguments.
+func newToolCallRecord(ctx context.Context, tool string, request mcp.CallToolRequest) *persistence.MCPToolCall {
+	record := &persistence.MCPToolCall{
+		Tool:   tool,
+		Status: persistence.MCPToolCallStatusSuccess,
+	}
+
+	if session := server.ClientSessionFromContext(ctx); session != nil {
+		record.SessionID = session.SessionID()
+		if withInfo, ok := session.(server.SessionWithClientInfo); ok {
+			info := withInfo.GetClientInfo()
+			record.ClientName = info.Name
+			record.ClientVersion = info.Version
+		}
+	}
+
+	args := request.GetArguments()
+	payload, err := json.Marshal(args)
+	if err != nil {
+		payload = []byte("{}")
+	}
+	sum := sha256.Sum256(payload)
+	record.ArgumentsHash = hex.EncodeToString(sum[:])
+
+	redacted, err := json.Marshal(redactArguments(args))
+	if err != nil {
+		redacted = []byte("{}")
+	}
+	if len(redacted) > maxAuditArgumentsSize {
+		redacted, _ = json.Marshal(truncatedArguments{Truncated: true, Size: len(redacted)})
+	}
+	record.Arguments = string(redacted)
+
+	return record
+}
+
+func redactArguments(value any) any {
+	switch v := value.(type) {
+	case map[string]any:
+		redacted := make(map[string]any, len(v))
+		for key, item := range v {
+			if sensitiveArgumentKey.MatchString(key) {
+				redacted[key] = redactedValue
+				continue
+			}
+			redacted[key] = redactArguments(item)
+		}
+		return redacted
+	case []any:
+		redacted := make([]any, len(v))
+		for i, item := range v {
+			redacted[i] = redactArguments(item)
+		}
+		return redacted
+	default:
+		return v
+	}
+}
+
+// failToolCall marks the record as failed and returns the matching error result for the client.
+func failToolCall(record *persistence.MCPToolCall, status persistence.MCPToolCallStatus, message string) *mcp.CallToolResult {
+	record.Status = status
+	record.Error = message
+	return mcp.NewToolResultError(message)
+}
+
+func (h *MCPHandler) saveToolCall(record *persistence.MCPToolCall, started time.Time) {
+	record.LatencyMs = time.Since(started).Milliseconds()
+	if err := h.toolCallRepo.Create(record); err != nil {
+		log.Error().Err(err).Str("tool", record.Tool).Msg("Failed to record MCP tool call")
+	}
+}
@@ -0,0 +1,61 @@
+package mcp
+
+import (
+	"encoding/json"
+	"strings"
+	"testing"
+
+	"github.com/mark3labs/mcp-go/mcp"
+)
+
+func callToolRequest(args map[string]any) mcp.CallToolRequest {
+	return mcp.CallToolRequest{Params: mcp.CallToolParams{Name: "tool", Arguments: args}}
+}
+
+func TestNewToolCallRecordRedactsArguments(t *testing.T) {
+	record := newToolCallRecord(t.Context(), "tool", callToolRequest(map[string]any{
+		"query":    "orders",
+		"apiKey":   "k-123",
+		"nested":   map[string]any{"password": "hunter2", "user": "alice"},
+		"tokens":   []any{"a"},
+		"headers":  []any{map[string]any{"Authorization": "Bearer x"}},
+		"quantity": 3,
+	}))
+
+	if strings.Contains(record.Arguments, "k-123") || strings.Contains(record.Arguments, "hunter2") ||
+		strings.Contains(record.Arguments, "Bearer x") {
+		t.Fatalf("sensitive value stored: %s", record.Arguments)
+	}
+
+	var args map[string]any
+	if err := json.Unmarshal([]byte(record.Arguments), &args); err != nil {
+		t.Fatalf("stored arguments are not valid JSON: %v", err)
+	}
+	if args["query"] != "orders" || args["tokens"] != redactedValue {
+		t.Errorf("unexpected arguments: %v", args)
+	}
+	if nested := args["nested"].(map[string]any); nested["user"] != "alice" || nested["password"] != redactedValue {
+		t.Errorf("unexpected nested arguments: %v", nested)
+	}
+	if len(record.ArgumentsHash) != 64 {
+		t.Errorf("unexpected hash: %s", record.ArgumentsHash)
+	}
+}
+
+func TestNewToolCallRecordTruncatesLargeArguments(t *testing.T) {
+	large := strings.Repeat("é", maxAuditArgumentsSize)
+	record := newToolCallRecord(t.Context(), "tool", callToolRequest(map[string]any{"text": large}))
+
+	var marker truncatedArguments
+	if err := json.Unmarshal([]byte(record.Arguments), &marker); err != nil {
+		t.Fatalf("stored arguments are not valid JSON: %v", err)
+	}
+	if !marker.Truncated || marker.Size <= maxAuditArgumentsSize {
+		t.Errorf("unexpected truncation marker: %s", record.Arguments)
+	}
+
+	small := newToolCallRecord(t.Context(), "tool", callToolRequest(map[string]any{"text": large[:100]}))
+	if strings.Contains(small.Arguments, "truncated") {
+		t.Errorf("small arguments truncated: %s", small.Arguments)
+	}
+}
@@ -0,0 +1,167 @@
+package mcp
+
+import (
+	"context"
+	"fmt"
+	"sync"
+
+	"github.com/google/uuid"
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/mark3labs/mcp-go/server"
+	"github.com/rs/zerolog/log"
+)
+
+// CallIDHeader carries the in-flight tool call ID from the coordinator to the flow,
+// so that processors can report progress for the call that triggered them.
+const CallIDHeader = "X-Airtruct-Mcp-Call-Id"
+
+// requestIDMetaKey is set by the before-call hook since tool handlers don't get the JSON-RPC request ID.
+const requestIDMetaKey = "airtruct/request_id"
+
+type toolCall struct {
+	ctx           context.Context
+	cancel        context.CancelFunc
+	progressToken mcp.ProgressToken
+	requestKey    string
+}
+
+type callRegistry struct {
+	mu sync.Mutex
+	// call ID -> in-flight call
+	calls map[string]*toolCall
+	// session ID + JSON-RPC request ID -> call ID, used to resolve cancellations
+	requests map[string]string
+}
+
+func newCallRegistry() *callRegistry {
+	return &callRegistry{
+		calls:    make(map[string]*toolCall),
+		requests: make(map[string]string),
+	}
+}
+
+func (r *callRegistry) register(ctx context.Context, cancel context.CancelFunc, request mcp.CallToolRequest) string {
+	callID := uuid.NewString()
+	call := &toolCall{
+		ctx:    ctx,
+		cancel: cancel,
+	}
+
+	if meta := request.Params.Meta; meta != nil {
+		call.progressToken = meta.ProgressToken
+		if requestID, ok := meta.AdditionalFields[requestIDMetaKey].(string); ok {
+			call.requestKey = requestKey(ctx, requestID)
+		}
+	}
+
+	r.mu.Lock()
+	r.calls[callID] = call
+	if call.requestKey != "" {
+		r.requests[call.requestKey] = callID
+	}
+	r.mu.Unlock()
+
+	return callID
+}
+
+func (r *callRegistry) unregister(callID string) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	call, ok := r.calls[callID]
+	if !ok {
+		return
+	}
+	delete(r.calls, callID)
+	if call.requestKey != "" {
+		delete(r.requests, call.requestKey)
+	}
+}
+
+func (r *callRegistry) get(callID string) (*toolCall, bool) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	call, ok := r.calls[callID]
+	return call, ok
+}
+
+func (r *callRegistry) cancelRequest(key string) bool {
+	r.mu.Lock()
+	callID, ok := r.requests[key]
+	var call *toolCall
+	if ok {
+		call = r.calls[callID]
+	}
+	r.mu.Unlock()
+
+	if call == nil {
+		return false
+	}
+	call.cancel()
+	return true
+}
+
+func requestKey(ctx context.Context, requestID string) string {
+	sessionID := ""
+	if session := server.ClientSessionFromContext(ctx); session != nil {
+		sessionID = session.SessionID()
+	}
+	return sessionID + "/" + requestID
+}
+
+func (h *MCPHandler) beforeCallTool(_ context.Context, id any, request *mcp.CallToolRequest) {
+	if request.Params.Meta == nil {
+		request.Params.Meta = &mcp.Meta{}
+	}
+	if request.Params.Meta.AdditionalFields == nil {
+		request.Params.Meta.AdditionalFields = make(map[string]any)
+	}
+	request.Params.Meta.AdditionalFields[requestIDMetaKey] = mcp.NewRequestId(id).String()
+}
+
+func (h *MCPHandler) handleCancelled(ctx context.Context, notification mcp.JSONRPCNotification) {
+	requestID, ok := notification.Params.AdditionalFields["requestId"]
+	if !ok {
+		return
+	}
+
+	key := requestKey(ctx, mcp.NewRequestId(requestID).String())
+	if !h.calls.cancelRequest(key) {
+		log.Debug().Str("request", key).Msg("Received cancellation for unknown MCP tool call")
+		return
+	}
+
+	reason, _ := notification.Params.AdditionalFields["reason"].(string)
+	log.Info().Str("request", key).Str("reason", reason).Msg("MCP tool call cancelled by client")
+}
+
+// ReportProgress sends a progress notification to the client waiting on the given tool call.
+// It is a no-op when the client didn't ask for progress updates.
+func (h *MCPHandler) ReportProgress(callID string, progress float64, total *float64, message string) error {
+	call, ok := h.calls.get(callID)
+	if !ok {
+		return fmt.Errorf("tool call %s is not in progress", callID)
+	}
+
+	if call.progressToken == nil {
+		return nil
+	}
+
+	params := map[string]any{
+		"progressToken": call.progressToken,
+		"progress":      progress,
+	}
+	if total != nil {
+		params["total"] = *total
+	}
+	if message != "" {
+		params["message"] = message
+	}
+
+	if err := h.mcpServer.SendNotificationToClient(call.ctx, "notifications/progress", params); err != nil {
+		return fmt.Errorf("failed to send progress notification: %w", err)
+	}
+
+	return nil
+}
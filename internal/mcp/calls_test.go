@@ -0,0 +1,126 @@
+package mcp
+
+import (
+	"context"
+	"testing"
+
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/mark3labs/mcp-go/server"
+)
+
+// startTestCall registers a tool call of the session the way the tool handlers do, after the before-call hook
+// recorded the JSON-RPC request ID of the call. Request IDs are passed as decoded from JSON, numbers as float64.
+func startTestCall(t *testing.T, h *MCPHandler, session *testSession, requestID any, progressToken mcp.ProgressToken) (context.Context, string) {
+	t.Helper()
+	ctx := h.mcpServer.WithContext(t.Context(), session)
+	ctx, cancel := context.WithCancel(ctx)
+	t.Cleanup(cancel)
+
+	request := callToolRequest(nil)
+	if progressToken != nil {
+		request.Params.Meta = &mcp.Meta{ProgressToken: progressToken}
+	}
+	h.beforeCallTool(ctx, requestID, &request)
+	return ctx, h.calls.register(ctx, cancel, request)
+}
+
+func newTestCallHandler() *MCPHandler {
+	return &MCPHandler{calls: newCallRegistry(), mcpServer: server.NewMCPServer("test", "1.0")}
+}
+
+func newTestSession(id string) *testSession {
+	return &testSession{id: id, notifications: make(chan mcp.JSONRPCNotification, 4)}
+}
+
+func cancelledNotification(requestID any) mcp.JSONRPCNotification {
+	notification := mcp.JSONRPCNotification{}
+	notification.Method = "notifications/cancelled"
+	notification.Params.AdditionalFields = map[string]any{"requestId": requestID, "reason": "user aborted"}
+	return notification
+}
+
+func TestCallRegistryRegisterAndUnregister(t *testing.T) {
+	h := newTestCallHandler()
+	session := newTestSession("session-1")
+	ctx, callID := startTestCall(t, h, session, float64(7), mcp.ProgressToken("token-1"))
+
+	call, ok := h.calls.get(callID)
+	if !ok {
+		t.Fatal("expected the call to be registered")
+	}
+	if call.progressToken != mcp.ProgressToken("token-1") {
+		t.Errorf("expected progress token token-1, got %v", call.progressToken)
+	}
+	if call.requestKey != "session-1/int64:7" {
+		t.Errorf("expected request key session-1/int64:7, got %q", call.requestKey)
+	}
+
+	h.calls.unregister(callID)
+	if _, ok := h.calls.get(callID); ok {
+		t.Error("expected the call to be removed")
+	}
+	if h.calls.cancelRequest(requestKey(ctx, "int64:7")) {
+		t.Error("expected the request of a removed call to be unknown")
+	}
+	if ctx.Err() != nil {
+		t.Error("expected removing the call not to cancel it")
+	}
+	// Removing a call twice is a no-op.
+	h.calls.unregister(callID)
+}
+
+func TestHandleCancelledCancelsCallOfSession(t *testing.T) {
+	h := newTestCallHandler()
+	session := newTestSession("session-1")
+	other := newTestSession("session-2")
+	ctx, _ := startTestCall(t, h, session, float64(7), nil)
+	otherCtx, _ := startTestCall(t, h, other, "request-8", nil)
+
+	// A cancellation only applies to the requests of the session that sent it.
+	h.handleCancelled(h.mcpServer.WithContext(t.Context(), other), cancelledNotification(float64(7)))
+	if ctx.Err() != nil {
+		t.Fatal("expected the call not to be cancelled by another session")
+	}
+
+	h.handleCancelled(h.mcpServer.WithContext(t.Context(), session), cancelledNotification(float64(7)))
+	if ctx.Err() == nil {
+		t.Error("expected the call to be cancelled")
+	}
+	if otherCtx.Err() != nil {
+		t.Error("expected the call of the other session not to be cancelled")
+	}
+}
+
+func TestReportProgressByCallID(t *testing.T) {
+	h := newTestCallHandler()
+	session := newTestSession("session-1")
+	_, callID := startTestCall(t, h, session, float64(7), mcp.ProgressToken("token-1"))
+	_, silentCallID := startTestCall(t, h, session, float64(8), nil)
+
+	total := 10.0
+	if err := h.ReportProgress(callID, 3, &total, "halfway"); err != nil {
+		t.Fatalf("ReportProgress returned error: %v", err)
+	}
+	select {
+	case notification := <-session.notifications:
+		fields := notification.Params.AdditionalFields
+		if notification.Method != "notifications/progress" || fields["progressToken"] != mcp.ProgressToken("token-1") ||
+			fields["progress"] != 3.0 || fields["total"] != 10.0 || fields["message"] != "halfway" {
+			t.Errorf("unexpected notification: %+v", notification)
+		}
+	default:
+		t.Fatal("expected a progress notification")
+	}
+
+	// Calls without a progress token report nothing.
+	if err := h.ReportProgress(silentCallID, 1, nil, ""); err != nil {
+		t.Fatalf("ReportProgress returned error: %v", err)
+	}
+	if len(session.notifications) != 0 {
+		t.Error("expected no notification for a call without a progress token")
+	}
+
+	if err := h.ReportProgress("unknown", 1, nil, ""); err == nil {
+		t.Error("expected an error for a call that is not in progress")
+	}
+}
@@ -7,6 +7,7 @@ import (
 	"fmt"
 	"net/http"
 	"sync"
+	"time"
 
 	"github.com/mark3labs/mcp-go/mcp"
 	"github.com/mark3labs/mcp-go/server"
@@ -14,44 +15,81 @@ import (
 	"gopkg.in/yaml.v3"
 
 	"github.com/sananguliyev/airtruct/internal/persistence"
+	"github.com/sananguliyev/airtruct/internal/ratelimiter"
+	"github.com/sananguliyev/airtruct/internal/vault"
 )
 
 type RequestForwarder interface {
-	ForwardRequestToWorker(ctx context.Context, r *http.Request) (int32, []byte, error)
+	ForwardStreamToWorker(ctx context.Context, r *http.Request, w http.ResponseWriter) error
 }
 
+type RateLimiter interface {
+	Check(label, key string, cost int64) (*ratelimiter.CheckResult, error)
+	Release(label, key, leaseID string) error
+}
+
+const defaultToolTimeout = 60 * time.Second
+
 type toolConfig struct {
 	Name        string          `yaml:"name"`
 	Description string          `yaml:"description"`
 	InputSchema json.RawMessage `yaml:"input_schema"`
+	Timeout     time.Duration   `yaml:"timeout"`
+	RateLimit   *toolRateLimit  `yaml:"rate_limit"`
 }
 
 type MCPHandler struct {
-	mcpServer   *server.MCPServer
-	httpHandler *server.StreamableHTTPServer
-	flowRepo    persistence.FlowRepository
-	forwarder   RequestForwarder
-	mu          sync.RWMutex
+	mcpServer     *server.MCPServer
+	httpHandler   *server.StreamableHTTPServer
+	flowRepo      persistence.FlowRepository
+	mcpServerRepo persistence.MCPServerRepository
+	toolCallRepo  persistence.MCPToolCallRepository
+	secretRepo    persistence.SecretRepository
+	aesgcm        *vault.AESGCM
+	rateLimiter   RateLimiter
+	forwarder     RequestForwarder
+	calls         *callRegistry
+	mu            sync.RWMutex
 	// tool name -> flow ID used for forwarding via /ingest/{flowID}
 	toolFlowMap map[string]int64
+	// MCP server version ID -> upstream client, only touched by SyncTools
+	upstreams map[int64]*upstream
 }
 
-func NewMCPHandler(flowRepo persistence.FlowRepository, forwarder RequestForwarder, version string) *MCPHandler {
-	mcpServer := server.NewMCPServer(
+func NewMCPHandler(
+	flowRepo persistence.FlowRepository,
+	mcpServerRepo persistence.MCPServerRepository,
+	toolCallRepo persistence.MCPToolCallRepository,
+	secretRepo persistence.SecretRepository,
+	aesgcm *vault.AESGCM,
+	rateLimiter RateLimiter,
+	forwarder RequestForwarder,
+	version string,
+) *MCPHandler {
+	h := &MCPHandler{
+		flowRepo:      flowRepo,
+		mcpServerRepo: mcpServerRepo,
+		toolCallRepo:  toolCallRepo,
+		secretRepo:    secretRepo,
+		aesgcm:        aesgcm,
+		rateLimiter:   rateLimiter,
+		forwarder:     forwarder,
+		calls:         newCallRegistry(),
+		toolFlowMap:   make(map[string]int64),
+		upstreams:     make(map[int64]*upstream),
+	}
+
+	hooks := &server.Hooks{}
+	hooks.AddBeforeCallTool(h.beforeCallTool)
+
+	h.mcpServer = server.NewMCPServer(
 		"airtruct",
 		version,
 		server.WithToolCapabilities(true),
+		server.WithHooks(hooks),
 	)
-
-	httpHandler := server.NewStreamableHTTPServer(mcpServer)
-
-	h := &MCPHandler{
-		mcpServer:   mcpServer,
-		httpHandler: httpHandler,
-		flowRepo:    flowRepo,
-		forwarder:   forwarder,
-		toolFlowMap: make(map[string]int64),
-	}
+	h.mcpServer.AddNotificationHandler("notifications/cancelled", h.handleCancelled)
+	h.httpHandler = server.NewStreamableHTTPServer(h.mcpServer, server.WithHTTPContextFunc(withHTTPRequest))
 
 	h.SyncTools()
 	return h
@@ -101,10 +139,12 @@ func (h *MCPHandler) SyncTools() {
 		tool := mcp.NewToolWithRawSchema(cfg.Name, cfg.Description, cfg.InputSchema)
 		newTools = append(newTools, server.ServerTool{
 			Tool:    tool,
-			Handler: h.createToolHandler(flowID),
+			Handler: h.createToolHandler(cfg.Name, flowID, flow.ID, cfg.Timeout, cfg.RateLimit),
 		})
 	}
 
+	newTools = append(newTools, h.syncUpstreams(newToolMap)...)
+
 	h.mu.Lock()
 	h.toolFlowMap = newToolMap
 	h.mu.Unlock()
@@ -114,32 +154,69 @@ func (h *MCPHandler) SyncTools() {
 	log.Debug().Int("tool_count", len(newTools)).Msg("MCP tools synced")
 }
 
-func (h *MCPHandler) createToolHandler(flowID int64) server.ToolHandlerFunc {
+func (h *MCPHandler) createToolHandler(name string, flowID, flowVersionID int64, timeout time.Duration, rateLimit *toolRateLimit) server.ToolHandlerFunc {
 	return func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		started := time.Now()
+		record := newToolCallRecord(ctx, name, request)
+		record.FlowID = &flowID
+		record.FlowVersionID = &flowVersionID
+		defer h.saveToolCall(record, started)
+
+		ctx, span := startToolSpan(ctx, name, flowID, flowVersionID, request)
+		defer endToolSpan(span, record)
+
+		if rateLimit != nil {
+			key := rateLimit.key(ctx, flowID)
+			result, err := h.rateLimiter.Check(rateLimit.Label, key, rateLimit.Cost)
+			if err != nil {
+				log.Error().Err(err).Str("label", rateLimit.Label).Str("tool", name).Msg("Failed to check MCP tool rate limit")
+				return failToolCall(record, persistence.MCPToolCallStatusError, fmt.Sprintf("failed to check rate limit: %v", err)), nil
+			}
+			if !result.Allowed {
+				return failToolCall(record, persistence.MCPToolCallStatusDenied, fmt.Sprintf("rate limit exceeded, retry after %dms", result.RetryAfterMs)), nil
+			}
+			defer h.releaseRateLimit(rateLimit.Label, key, result.LeaseID)
+		}
+
+		ctx, cancel := context.WithTimeout(ctx, timeout)
+		defer cancel()
+
+		callID := h.calls.register(ctx, cancel, request)
+		defer h.calls.unregister(callID)
+
 		args := request.GetArguments()
 
 		payload, err := json.Marshal(args)
 		if err != nil {
-			return mcp.NewToolResultError(fmt.Sprintf("failed to marshal arguments: %v", err)), nil
+			return failToolCall(record, persistence.MCPToolCallStatusError, fmt.Sprintf("failed to marshal arguments: %v", err)), nil
 		}
 
 		path := fmt.Sprintf("/ingest/%d/", flowID)
 		req, err := http.NewRequestWithContext(ctx, http.MethodPost, path, bytes.NewReader(payload))
 		if err != nil {
-			return mcp.NewToolResultError(fmt.Sprintf("failed to create request: %v", err)), nil
+			return failToolCall(record, persistence.MCPToolCallStatusError, fmt.Sprintf("failed to create request: %v", err)), nil
 		}
 		req.Header.Set("Content-Type", "application/json")
-
-		statusCode, response, err := h.forwarder.ForwardRequestToWorker(ctx, req)
-		if err != nil {
-			return mcp.NewToolResultError(fmt.Sprintf("tool execution failed: %v", err)), nil
+		req.Header.Set(CallIDHeader, callID)
+
+		rw := newToolResponseWriter(h, callID)
+		err = h.forwarder.ForwardStreamToWorker(ctx, req, rw)
+		// A stream interrupted by the deadline or a cancellation ends without an error.
+		if err != nil || ctx.Err() != nil {
+			switch ctx.Err() {
+			case context.DeadlineExceeded:
+				return failToolCall(record, persistence.MCPToolCallStatusTimeout, fmt.Sprintf("tool execution timed out after %s", timeout)), nil
+			case context.Canceled:
+				return failToolCall(record, persistence.MCPToolCallStatusCancelled, "tool execution was cancelled"), nil
+			}
+			return failToolCall(record, persistence.MCPToolCallStatusError, fmt.Sprintf("tool execution failed: %v", err)), nil
 		}
 
-		if statusCode >= 400 {
-			return mcp.NewToolResultError(fmt.Sprintf("tool returned status %d: %s", statusCode, string(response))), nil
+		if rw.statusCode >= 400 {
+			return failToolCall(record, persistence.MCPToolCallStatusError, fmt.Sprintf("tool returned status %d: %s", rw.statusCode, rw.body.String())), nil
 		}
 
-		return mcp.NewToolResultText(string(response)), nil
+		return mcp.NewToolResultText(rw.body.String()), nil
 	}
 }
 
@@ -158,6 +235,26 @@ func parseToolConfig(inputConfig []byte) (*toolConfig, error) {
 		cfg.Description = desc
 	}
 
+	cfg.Timeout = defaultToolTimeout
+	if timeout, ok := raw["timeout"].(string); ok && timeout != "" {
+		d, err := time.ParseDuration(timeout)
+		if err != nil {
+			return nil, fmt.Errorf("invalid timeout: %w", err)
+		}
+		if d <= 0 {
+			return nil, fmt.Errorf("timeout must be positive")
+		}
+		cfg.Timeout = d
+	}
+
+	if rawRateLimit, ok := raw["rate_limit"].(map[string]any); ok {
+		rateLimit, err := parseToolRateLimit(rawRateLimit)
+		if err != nil {
+			return nil, err
+		}
+		cfg.RateLimit = rateLimit
+	}
+
 	if schema, ok := raw["input_schema"]; ok {
 		jsonSchema, err := propertyListToJSONSchema(schema)
 		if err != nil {
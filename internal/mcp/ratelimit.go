@@ -0,0 +1,131 @@
+package mcp
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"math"
+	"net"
+	"net/http"
+
+	"github.com/mark3labs/mcp-go/server"
+	"github.com/rs/zerolog/log"
+)
+
+const (
+	rateLimitKeySession = "session"
+	rateLimitKeyClient  = "client"
+	rateLimitKeyIP      = "ip"
+	rateLimitKeyHeader  = "header"
+	rateLimitKeyGlobal  = "global"
+)
+
+// toolRateLimit applies a rate limit resource to the calls of a tool, counted separately per key. Calls are
+// keyed by MCP session unless configured otherwise.
+type toolRateLimit struct {
+	Label  string
+	Key    string
+	Header string
+	Cost   int64
+}
+
+func parseToolRateLimit(raw map[string]any) (*toolRateLimit, error) {
+	rateLimit := &toolRateLimit{Key: rateLimitKeySession}
+	rateLimit.Label, _ = raw["label"].(string)
+	if rateLimit.Label == "" {
+		return nil, nil
+	}
+
+	if key, ok := raw["key"].(string); ok && key != "" {
+		rateLimit.Key = key
+	}
+	switch rateLimit.Key {
+	case rateLimitKeySession, rateLimitKeyClient, rateLimitKeyIP, rateLimitKeyGlobal:
+	case rateLimitKeyHeader:
+		rateLimit.Header, _ = raw["header"].(string)
+		if rateLimit.Header == "" {
+			return nil, fmt.Errorf("rate_limit.header is required when keyed by header")
+		}
+	default:
+		return nil, fmt.Errorf("unknown rate_limit.key %q", rateLimit.Key)
+	}
+
+	if value, ok := raw["cost"]; ok && value != nil {
+		// Depending on how the config was decoded the cost is any kind of number.
+		var cost float64
+		switch v := value.(type) {
+		case int:
+			cost = float64(v)
+		case int64:
+			cost = float64(v)
+		case uint64:
+			cost = float64(v)
+		case float64:
+			cost = v
+		case json.Number:
+			var err error
+			if cost, err = v.Float64(); err != nil {
+				return nil, fmt.Errorf("rate_limit.cost must be a number, got %q", v)
+			}
+		default:
+			return nil, fmt.Errorf("rate_limit.cost must be a number, got %T", value)
+		}
+		if cost < 0 {
+			return nil, fmt.Errorf("rate_limit.cost cannot be negative")
+		}
+		rateLimit.Cost = int64(math.Ceil(cost))
+	}
+	return rateLimit, nil
+}
+
+// key returns the key the call is counted under, calls of all versions of the tool's flow share it.
+func (l *toolRateLimit) key(ctx context.Context, flowID int64) string {
+	var client string
+	switch l.Key {
+	case rateLimitKeySession, rateLimitKeyClient:
+		if session := server.ClientSessionFromContext(ctx); session != nil {
+			client = session.SessionID()
+			if withInfo, ok := session.(server.SessionWithClientInfo); ok && l.Key == rateLimitKeyClient {
+				client = withInfo.GetClientInfo().Name
+			}
+		}
+	case rateLimitKeyIP:
+		if r, ok := ctx.Value(httpRequestContextKey{}).(*httpRequestInfo); ok {
+			client = r.remoteIP
+		}
+	case rateLimitKeyHeader:
+		if r, ok := ctx.Value(httpRequestContextKey{}).(*httpRequestInfo); ok {
+			client = r.header.Get(l.Header)
+		}
+	}
+	return fmt.Sprintf("mcp/%d/%s:%s", flowID, l.Key, client)
+}
+
+// releaseRateLimit returns the lease a concurrency rate limit handed out for a tool call once the call is done.
+func (h *MCPHandler) releaseRateLimit(label, key, leaseID string) {
+	if leaseID == "" {
+		return
+	}
+	if err := h.rateLimiter.Release(label, key, leaseID); err != nil {
+		log.Warn().Err(err).Str("label", label).Msg("Failed to release MCP tool rate limit lease")
+	}
+}
+
+type httpRequestContextKey struct{}
+
+// httpRequestInfo is the part of the HTTP request carrying an MCP message that tool handlers may need.
+type httpRequestInfo struct {
+	remoteIP string
+	header   http.Header
+}
+
+func withHTTPRequest(ctx context.Context, r *http.Request) context.Context {
+	host, _, err := net.SplitHostPort(r.RemoteAddr)
+	if err != nil {
+		host = r.RemoteAddr
+	}
+	return context.WithValue(ctx, httpRequestContextKey{}, &httpRequestInfo{
+		remoteIP: host,
+		header:   r.Header.Clone(),
+	})
+}
@@ -0,0 +1,149 @@
+package mcp
+
+import (
+	"context"
+	"encoding/json"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/mark3labs/mcp-go/server"
+)
+
+// testSession is an MCP session of a client that introduced itself, notifications sent to it are buffered.
+type testSession struct {
+	id            string
+	clientInfo    mcp.Implementation
+	notifications chan mcp.JSONRPCNotification
+}
+
+func (s *testSession) Initialize()                                         {}
+func (s *testSession) Initialized() bool                                   { return true }
+func (s *testSession) NotificationChannel() chan<- mcp.JSONRPCNotification { return s.notifications }
+func (s *testSession) SessionID() string                                   { return s.id }
+func (s *testSession) GetClientInfo() mcp.Implementation                   { return s.clientInfo }
+func (s *testSession) SetClientInfo(clientInfo mcp.Implementation)         { s.clientInfo = clientInfo }
+func (s *testSession) GetClientCapabilities() mcp.ClientCapabilities       { return mcp.ClientCapabilities{} }
+func (s *testSession) SetClientCapabilities(mcp.ClientCapabilities)        {}
+
+func TestParseToolRateLimit(t *testing.T) {
+	tests := []struct {
+		name    string
+		raw     map[string]any
+		want    *toolRateLimit
+		wantErr bool
+	}{
+		{
+			name: "without label",
+			raw:  map[string]any{"key": "ip"},
+		},
+		{
+			name: "keyed by session by default",
+			raw:  map[string]any{"label": "tools"},
+			want: &toolRateLimit{Label: "tools", Key: rateLimitKeySession},
+		},
+		{
+			name: "keyed by header",
+			raw:  map[string]any{"label": "tools", "key": "header", "header": "X-Tenant"},
+			want: &toolRateLimit{Label: "tools", Key: rateLimitKeyHeader, Header: "X-Tenant"},
+		},
+		{
+			name:    "keyed by header without header",
+			raw:     map[string]any{"label": "tools", "key": "header"},
+			wantErr: true,
+		},
+		{
+			name:    "unknown key",
+			raw:     map[string]any{"label": "tools", "key": "user"},
+			wantErr: true,
+		},
+		{
+			name: "int cost",
+			raw:  map[string]any{"label": "tools", "cost": 3},
+			want: &toolRateLimit{Label: "tools", Key: rateLimitKeySession, Cost: 3},
+		},
+		{
+			name: "int64 cost",
+			raw:  map[string]any{"label": "tools", "cost": int64(3)},
+			want: &toolRateLimit{Label: "tools", Key: rateLimitKeySession, Cost: 3},
+		},
+		{
+			name: "uint64 cost",
+			raw:  map[string]any{"label": "tools", "cost": uint64(3)},
+			want: &toolRateLimit{Label: "tools", Key: rateLimitKeySession, Cost: 3},
+		},
+		{
+			name: "float64 cost",
+			raw:  map[string]any{"label": "tools", "cost": float64(3)},
+			want: &toolRateLimit{Label: "tools", Key: rateLimitKeySession, Cost: 3},
+		},
+		{
+			name: "fractional cost is rounded up",
+			raw:  map[string]any{"label": "tools", "cost": 2.5},
+			want: &toolRateLimit{Label: "tools", Key: rateLimitKeySession, Cost: 3},
+		},
+		{
+			name: "json number cost",
+			raw:  map[string]any{"label": "tools", "cost": json.Number("3")},
+			want: &toolRateLimit{Label: "tools", Key: rateLimitKeySession, Cost: 3},
+		},
+		{
+			name:    "negative cost",
+			raw:     map[string]any{"label": "tools", "cost": -1},
+			wantErr: true,
+		},
+		{
+			name:    "cost that is not a number",
+			raw:     map[string]any{"label": "tools", "cost": "3"},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := parseToolRateLimit(tt.raw)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("parseToolRateLimit() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if tt.want == nil {
+				if got != nil {
+					t.Errorf("expected no rate limit, got %+v", got)
+				}
+				return
+			}
+			if got == nil || *got != *tt.want {
+				t.Errorf("expected %+v, got %+v", tt.want, got)
+			}
+		})
+	}
+}
+
+func TestToolRateLimitKey(t *testing.T) {
+	session := &testSession{id: "session-1", clientInfo: mcp.Implementation{Name: "claude-desktop"}}
+	r := httptest.NewRequest("POST", "/mcp", nil)
+	r.RemoteAddr = "192.0.2.10:51234"
+	r.Header.Set("X-Tenant", "acme")
+
+	ctx := server.NewMCPServer("test", "1.0").WithContext(withHTTPRequest(context.Background(), r), session)
+
+	tests := []struct {
+		rateLimit toolRateLimit
+		ctx       context.Context
+		want      string
+	}{
+		{rateLimit: toolRateLimit{Key: rateLimitKeySession}, ctx: ctx, want: "mcp/7/session:session-1"},
+		{rateLimit: toolRateLimit{Key: rateLimitKeyClient}, ctx: ctx, want: "mcp/7/client:claude-desktop"},
+		{rateLimit: toolRateLimit{Key: rateLimitKeyIP}, ctx: ctx, want: "mcp/7/ip:192.0.2.10"},
+		{rateLimit: toolRateLimit{Key: rateLimitKeyHeader, Header: "X-Tenant"}, ctx: ctx, want: "mcp/7/header:acme"},
+		{rateLimit: toolRateLimit{Key: rateLimitKeyGlobal}, ctx: ctx, want: "mcp/7/global:"},
+		// Calls without a session or request share the empty key.
+		{rateLimit: toolRateLimit{Key: rateLimitKeySession}, ctx: context.Background(), want: "mcp/7/session:"},
+		{rateLimit: toolRateLimit{Key: rateLimitKeyIP}, ctx: context.Background(), want: "mcp/7/ip:"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.want, func(t *testing.T) {
+			if got := tt.rateLimit.key(tt.ctx, 7); got != tt.want {
+				t.Errorf("expected key %q, got %q", tt.want, got)
+			}
+		})
+	}
+}
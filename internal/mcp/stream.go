@@ -0,0 +1,62 @@
+package mcp
+
+import (
+	"bytes"
+	"net/http"
+
+	"github.com/rs/zerolog/log"
+)
+
+// toolResponseWriter collects the response of a flow for the tool result. Once the flow writes
+// more than one chunk, every chunk is also relayed to the client as a progress notification,
+// so clients that asked for progress can show partial results while the tool is running.
+type toolResponseWriter struct {
+	handler    *MCPHandler
+	callID     string
+	header     http.Header
+	statusCode int
+	body       bytes.Buffer
+	chunks     int
+	firstChunk []byte
+}
+
+func newToolResponseWriter(handler *MCPHandler, callID string) *toolResponseWriter {
+	return &toolResponseWriter{
+		handler:    handler,
+		callID:     callID,
+		header:     make(http.Header),
+		statusCode: http.StatusOK,
+	}
+}
+
+func (w *toolResponseWriter) Header() http.Header {
+	return w.header
+}
+
+func (w *toolResponseWriter) WriteHeader(statusCode int) {
+	w.statusCode = statusCode
+}
+
+func (w *toolResponseWriter) Write(p []byte) (int, error) {
+	w.body.Write(p)
+	w.chunks++
+
+	// A single write is a plain response, it is only returned as the tool result.
+	switch w.chunks {
+	case 1:
+		w.firstChunk = bytes.Clone(p)
+		return len(p), nil
+	case 2:
+		w.relayChunk(1, w.firstChunk)
+		w.firstChunk = nil
+	}
+	w.relayChunk(w.chunks, p)
+
+	return len(p), nil
+}
+
+func (w *toolResponseWriter) relayChunk(n int, chunk []byte) {
+	if err := w.handler.ReportProgress(w.callID, float64(n), nil, string(chunk)); err != nil {
+		log.Debug().Err(err).Str("call_id", w.callID).Msg("Failed to relay MCP tool response chunk")
+	}
+}
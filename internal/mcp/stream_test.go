@@ -0,0 +1,38 @@
+package mcp
+
+import (
+	"net/http"
+	"testing"
+)
+
+func TestToolResponseWriterCollectsChunks(t *testing.T) {
+	w := newToolResponseWriter(&MCPHandler{calls: newCallRegistry()}, "call-1")
+
+	w.WriteHeader(http.StatusCreated)
+	for _, chunk := range []string{"a", "b", "c"} {
+		if n, err := w.Write([]byte(chunk)); err != nil || n != 1 {
+			t.Fatalf("Write(%q) = %d, %v", chunk, n, err)
+		}
+	}
+
+	if w.statusCode != http.StatusCreated {
+		t.Errorf("expected status 201, got %d", w.statusCode)
+	}
+	if w.body.String() != "abc" {
+		t.Errorf("unexpected body: %q", w.body.String())
+	}
+	if w.chunks != 3 || w.firstChunk != nil {
+		t.Errorf("expected the first chunk to be relayed once the second arrived, chunks %d", w.chunks)
+	}
+}
+
+func TestToolResponseWriterKeepsSingleWrite(t *testing.T) {
+	w := newToolResponseWriter(&MCPHandler{calls: newCallRegistry()}, "call-1")
+
+	if _, err := w.Write([]byte("plain")); err != nil {
+		t.Fatalf("failed to write: %v", err)
+	}
+	if string(w.firstChunk) != "plain" || w.body.String() != "plain" {
+		t.Errorf("expected a single write to be kept as the result, got %q", w.body.String())
+	}
+}
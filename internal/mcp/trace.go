@@ -0,0 +1,44 @@
+package mcp
+
+import (
+	"context"
+
+	"github.com/mark3labs/mcp-go/mcp"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/trace"
+
+	"github.com/sananguliyev/airtruct/internal/persistence"
+	"github.com/sananguliyev/airtruct/internal/tracing"
+)
+
+// startToolSpan starts the span of a tool call. Clients that cannot set HTTP headers may pass the W3C trace
+// context in the _meta of the call, it takes precedence over the one of the HTTP request.
+func startToolSpan(ctx context.Context, name string, flowID, flowVersionID int64, request mcp.CallToolRequest) (context.Context, trace.Span) {
+	if request.Params.Meta != nil {
+		carrier := make(map[string]string)
+		for _, key := range []string{"traceparent", "tracestate", "baggage"} {
+			if value, ok := request.Params.Meta.AdditionalFields[key].(string); ok {
+				carrier[key] = value
+			}
+		}
+		if len(carrier) > 0 {
+			ctx = tracing.Extract(ctx, carrier)
+		}
+	}
+
+	return tracing.Tracer().Start(ctx, "mcp.tool_call", trace.WithAttributes(
+		attribute.String("mcp.tool", name),
+		attribute.Int64("airtruct.flow_id", flowID),
+		attribute.Int64("airtruct.flow_version_id", flowVersionID),
+	))
+}
+
+// endToolSpan ends the span of a tool call with the outcome recorded for it.
+func endToolSpan(span trace.Span, record *persistence.MCPToolCall) {
+	span.SetAttributes(attribute.String("mcp.tool_call.status", string(record.Status)))
+	if record.Status != persistence.MCPToolCallStatusSuccess {
+		span.SetStatus(codes.Error, record.Error)
+	}
+	span.End()
+}
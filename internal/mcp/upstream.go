@@ -0,0 +1,461 @@
+package mcp
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path"
+	"strings"
+	"sync"
+	"time"
+
+	mcpclient "github.com/mark3labs/mcp-go/client"
+	"github.com/mark3labs/mcp-go/client/transport"
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/mark3labs/mcp-go/server"
+	"github.com/rs/zerolog/log"
+	"github.com/warpstreamlabs/bento/public/bloblang"
+	"github.com/warpstreamlabs/bento/public/service"
+	"gopkg.in/yaml.v3"
+
+	"github.com/sananguliyev/airtruct/internal/persistence"
+)
+
+const (
+	UpstreamTransportStreamableHTTP = "streamable_http"
+	UpstreamTransportSSE            = "sse"
+
+	defaultUpstreamTimeout = 60 * time.Second
+	// Tool lists of upstream servers rarely change, so they are not fetched on every sync.
+	upstreamToolsRefreshInterval = time.Minute
+	// rateLimitWildcard applies a rate limit to every tool of an upstream server.
+	rateLimitWildcard = "*"
+)
+
+// UpstreamConfig is the configuration of an upstream MCP server whose tools are re-exported
+// through the coordinator's MCP endpoint.
+type UpstreamConfig struct {
+	URL          string            `yaml:"url"`
+	Headers      map[string]string `yaml:"headers"`
+	BearerToken  string            `yaml:"bearer_token"`
+	Prefix       string            `yaml:"prefix"`
+	AllowedTools []string          `yaml:"allowed_tools"`
+	DeniedTools  []string          `yaml:"denied_tools"`
+	ArgsMapping  string            `yaml:"args_mapping"`
+	RateLimits   map[string]string `yaml:"rate_limits"`
+	Timeout      string            `yaml:"timeout"`
+}
+
+// ParseUpstreamConfig parses and validates the configuration of an upstream MCP server.
+func ParseUpstreamConfig(component string, config []byte) (*UpstreamConfig, error) {
+	if component != UpstreamTransportStreamableHTTP && component != UpstreamTransportSSE {
+		return nil, fmt.Errorf("unsupported transport: %s", component)
+	}
+
+	cfg := &UpstreamConfig{}
+	if err := yaml.Unmarshal(config, cfg); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal config: %w", err)
+	}
+
+	if cfg.URL == "" {
+		return nil, fmt.Errorf("url is required")
+	}
+	for _, pattern := range append(cfg.AllowedTools, cfg.DeniedTools...) {
+		if _, err := path.Match(pattern, ""); err != nil {
+			return nil, fmt.Errorf("invalid tool pattern %q: %w", pattern, err)
+		}
+	}
+	if cfg.ArgsMapping != "" {
+		if _, err := bloblang.Parse(cfg.ArgsMapping); err != nil {
+			return nil, fmt.Errorf("invalid args_mapping: %w", err)
+		}
+	}
+	if cfg.Timeout != "" {
+		d, err := time.ParseDuration(cfg.Timeout)
+		if err != nil {
+			return nil, fmt.Errorf("invalid timeout: %w", err)
+		}
+		if d <= 0 {
+			return nil, fmt.Errorf("timeout must be positive")
+		}
+	}
+
+	return cfg, nil
+}
+
+type upstream struct {
+	id          int64
+	label       string
+	component   string
+	cfg         *UpstreamConfig
+	argsMapping *bloblang.Executor
+	timeout     time.Duration
+
+	// SSE streams live as long as the context passed to Start, so each upstream owns one.
+	sessionCtx    context.Context
+	sessionCancel context.CancelFunc
+
+	mu          sync.Mutex
+	client      *mcpclient.Client
+	tools       []mcp.Tool
+	refreshedAt time.Time
+}
+
+func (h *MCPHandler) newUpstream(srv persistence.MCPServer) (*upstream, error) {
+	cfg, err := ParseUpstreamConfig(srv.Component, srv.Config)
+	if err != nil {
+		return nil, err
+	}
+
+	u := &upstream{
+		id:        srv.ID,
+		label:     srv.Label,
+		component: srv.Component,
+		cfg:       cfg,
+		timeout:   defaultUpstreamTimeout,
+	}
+
+	if cfg.ArgsMapping != "" {
+		if u.argsMapping, err = bloblang.Parse(cfg.ArgsMapping); err != nil {
+			return nil, fmt.Errorf("invalid args_mapping: %w", err)
+		}
+	}
+	if cfg.Timeout != "" {
+		if u.timeout, err = time.ParseDuration(cfg.Timeout); err != nil {
+			return nil, fmt.Errorf("invalid timeout: %w", err)
+		}
+	}
+
+	if u.cfg.URL, err = h.expandSecrets(cfg.URL); err != nil {
+		return nil, err
+	}
+	headers := make(map[string]string, len(cfg.Headers)+1)
+	for k, v := range cfg.Headers {
+		if headers[k], err = h.expandSecrets(v); err != nil {
+			return nil, err
+		}
+	}
+	if cfg.BearerToken != "" {
+		token, err := h.expandSecrets(cfg.BearerToken)
+		if err != nil {
+			return nil, err
+		}
+		headers["Authorization"] = "Bearer " + token
+	}
+	u.cfg.Headers = headers
+
+	u.sessionCtx, u.sessionCancel = context.WithCancel(context.Background())
+	return u, nil
+}
+
+// expandSecrets replaces ${KEY} references with the decrypted value of the secret KEY.
+func (h *MCPHandler) expandSecrets(s string) (string, error) {
+	var missing []string
+	expanded := os.Expand(s, func(key string) string {
+		secret, err := h.secretRepo.GetByKey(key)
+		if err != nil || secret == nil {
+			missing = append(missing, key)
+			return ""
+		}
+		value, err := h.aesgcm.Decrypt(secret.EncryptedValue)
+		if err != nil {
+			missing = append(missing, key)
+			return ""
+		}
+		return value
+	})
+	if len(missing) > 0 {
+		return "", fmt.Errorf("failed to resolve secrets: %v", missing)
+	}
+	return expanded, nil
+}
+
+func (u *upstream) connect(ctx context.Context) (*mcpclient.Client, error) {
+	if u.client != nil {
+		return u.client, nil
+	}
+
+	var client *mcpclient.Client
+	var err error
+	switch u.component {
+	case UpstreamTransportSSE:
+		client, err = mcpclient.NewSSEMCPClient(u.cfg.URL, transport.WithHeaders(u.cfg.Headers))
+	default:
+		client, err = mcpclient.NewStreamableHttpClient(u.cfg.URL, transport.WithHTTPHeaders(u.cfg.Headers))
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to create MCP client: %w", err)
+	}
+
+	if err = client.Start(u.sessionCtx); err != nil {
+		return nil, fmt.Errorf("failed to start MCP client: %w", err)
+	}
+
+	initReq := mcp.InitializeRequest{}
+	initReq.Params.ProtocolVersion = mcp.LATEST_PROTOCOL_VERSION
+	initReq.Params.ClientInfo = mcp.Implementation{Name: "airtruct"}
+	if _, err = client.Initialize(ctx, initReq); err != nil {
+		_ = client.Close()
+		return nil, fmt.Errorf("failed to initialize MCP session: %w", err)
+	}
+
+	u.client = client
+	return client, nil
+}
+
+func (u *upstream) resetLocked() {
+	if u.client != nil {
+		_ = u.client.Close()
+		u.client = nil
+	}
+}
+
+func (u *upstream) close() {
+	u.mu.Lock()
+	defer u.mu.Unlock()
+
+	u.sessionCancel()
+	u.resetLocked()
+}
+
+// listTools returns the upstream tool list, fetching it again once it is older than the refresh
+// interval. The previous list is kept when the upstream server can't be reached.
+func (u *upstream) listTools(ctx context.Context) ([]mcp.Tool, error) {
+	u.mu.Lock()
+	defer u.mu.Unlock()
+
+	if !u.refreshedAt.IsZero() && time.Since(u.refreshedAt) < upstreamToolsRefreshInterval {
+		return u.tools, nil
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, u.timeout)
+	defer cancel()
+
+	client, err := u.connect(ctx)
+	if err != nil {
+		u.refreshedAt = time.Now()
+		return u.tools, err
+	}
+
+	result, err := client.ListTools(ctx, mcp.ListToolsRequest{})
+	u.refreshedAt = time.Now()
+	if err != nil {
+		u.resetLocked()
+		return u.tools, fmt.Errorf("failed to list tools: %w", err)
+	}
+
+	u.tools = result.Tools
+	return u.tools, nil
+}
+
+func (u *upstream) callTool(ctx context.Context, name string, args map[string]any) (*mcp.CallToolResult, error) {
+	u.mu.Lock()
+	client, err := u.connect(ctx)
+	u.mu.Unlock()
+	if err != nil {
+		return nil, err
+	}
+
+	result, err := client.CallTool(ctx, mcp.CallToolRequest{
+		Params: mcp.CallToolParams{
+			Name:      name,
+			Arguments: args,
+		},
+	})
+	if err != nil {
+		u.mu.Lock()
+		if u.client == client {
+			u.resetLocked()
+		}
+		u.mu.Unlock()
+		return nil, err
+	}
+
+	return result, nil
+}
+
+func (u *upstream) exposes(name string) bool {
+	for _, pattern := range u.cfg.DeniedTools {
+		if ok, _ := path.Match(pattern, name); ok {
+			return false
+		}
+	}
+	if len(u.cfg.AllowedTools) == 0 {
+		return true
+	}
+	for _, pattern := range u.cfg.AllowedTools {
+		if ok, _ := path.Match(pattern, name); ok {
+			return true
+		}
+	}
+	return false
+}
+
+func (u *upstream) rateLimitFor(name string) (string, bool) {
+	if label, ok := u.cfg.RateLimits[name]; ok {
+		return label, true
+	}
+	label, ok := u.cfg.RateLimits[rateLimitWildcard]
+	return label, ok
+}
+
+// rewriteArguments applies the args mapping, with the upstream tool name available as the `tool` metadata.
+func (u *upstream) rewriteArguments(name string, args map[string]any) (map[string]any, error) {
+	if u.argsMapping == nil {
+		return args, nil
+	}
+
+	msg := service.NewMessage(nil)
+	msg.SetStructuredMut(args)
+	msg.MetaSetMut("tool", name)
+
+	res, err := msg.BloblangQuery(u.argsMapping)
+	if err != nil {
+		return nil, fmt.Errorf("failed to execute args_mapping: %w", err)
+	}
+	if res == nil {
+		return args, nil
+	}
+
+	structured, err := res.AsStructured()
+	if err != nil {
+		return nil, fmt.Errorf("args_mapping result is not structured: %w", err)
+	}
+	rewritten, ok := structured.(map[string]any)
+	if !ok {
+		return nil, fmt.Errorf("args_mapping must evaluate to an object, got %T", structured)
+	}
+	return rewritten, nil
+}
+
+// syncUpstreams keeps a client per current upstream server version and returns the tools to re-export.
+// Names already taken by flow tools are skipped.
+func (h *MCPHandler) syncUpstreams(taken map[string]int64) []server.ServerTool {
+	servers, err := h.mcpServerRepo.ListAll()
+	if err != nil {
+		log.Error().Err(err).Msg("Failed to list upstream MCP servers")
+		return nil
+	}
+
+	current := make(map[int64]*upstream, len(servers))
+	for _, srv := range servers {
+		if u, ok := h.upstreams[srv.ID]; ok {
+			current[srv.ID] = u
+			continue
+		}
+		u, err := h.newUpstream(srv)
+		if err != nil {
+			log.Warn().Err(err).Str("label", srv.Label).Msg("Failed to configure upstream MCP server")
+			continue
+		}
+		current[srv.ID] = u
+	}
+
+	for id, u := range h.upstreams {
+		if _, ok := current[id]; !ok {
+			u.close()
+		}
+	}
+	h.upstreams = current
+
+	var tools []server.ServerTool
+	exposed := make(map[string]struct{})
+	for _, srv := range servers {
+		u, ok := current[srv.ID]
+		if !ok {
+			continue
+		}
+
+		upstreamTools, err := u.listTools(context.Background())
+		if err != nil {
+			log.Warn().Err(err).Str("label", u.label).Msg("Failed to list tools of upstream MCP server")
+		}
+
+		for _, tool := range upstreamTools {
+			if !u.exposes(tool.Name) {
+				continue
+			}
+
+			name := u.cfg.Prefix + tool.Name
+			if _, exists := taken[name]; exists {
+				log.Warn().Str("tool", name).Str("label", u.label).Msg("Upstream MCP tool name is taken by a flow, skipping")
+				continue
+			}
+			if _, exists := exposed[name]; exists {
+				log.Warn().Str("tool", name).Str("label", u.label).Msg("Duplicate upstream MCP tool name, skipping")
+				continue
+			}
+			exposed[name] = struct{}{}
+
+			exported := tool
+			exported.Name = name
+			tools = append(tools, server.ServerTool{
+				Tool:    exported,
+				Handler: h.createUpstreamToolHandler(u, name, tool.Name),
+			})
+		}
+	}
+
+	return tools
+}
+
+func (h *MCPHandler) createUpstreamToolHandler(u *upstream, exportedName, name string) server.ToolHandlerFunc {
+	return func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		started := time.Now()
+		record := newToolCallRecord(ctx, exportedName, request)
+		record.MCPServerID = &u.id
+		defer h.saveToolCall(record, started)
+
+		if label, ok := u.rateLimitFor(name); ok {
+			key := u.label + "/" + name
+			result, err := h.rateLimiter.Check(label, key, 1)
+			if err != nil {
+				log.Error().Err(err).Str("label", label).Str("tool", name).Msg("Failed to check MCP tool rate limit")
+				return failToolCall(record, persistence.MCPToolCallStatusError, fmt.Sprintf("failed to check rate limit: %v", err)), nil
+			}
+			if !result.Allowed {
+				return failToolCall(record, persistence.MCPToolCallStatusDenied, fmt.Sprintf("rate limit exceeded, retry after %dms", result.RetryAfterMs)), nil
+			}
+			defer h.releaseRateLimit(label, key, result.LeaseID)
+		}
+
+		ctx, cancel := context.WithTimeout(ctx, u.timeout)
+		defer cancel()
+
+		callID := h.calls.register(ctx, cancel, request)
+		defer h.calls.unregister(callID)
+
+		args, err := u.rewriteArguments(name, request.GetArguments())
+		if err != nil {
+			return failToolCall(record, persistence.MCPToolCallStatusError, err.Error()), nil
+		}
+
+		result, err := u.callTool(ctx, name, args)
+		if err != nil {
+			switch ctx.Err() {
+			case context.DeadlineExceeded:
+				return failToolCall(record, persistence.MCPToolCallStatusTimeout, fmt.Sprintf("tool execution timed out after %s", u.timeout)), nil
+			case context.Canceled:
+				return failToolCall(record, persistence.MCPToolCallStatusCancelled, "tool execution was cancelled"), nil
+			}
+			return failToolCall(record, persistence.MCPToolCallStatusError, fmt.Sprintf("upstream tool call failed: %v", err)), nil
+		}
+
+		if result.IsError {
+			record.Status = persistence.MCPToolCallStatusError
+			record.Error = resultText(result)
+		}
+
+		return result, nil
+	}
+}
+
+func resultText(result *mcp.CallToolResult) string {
+	var texts []string
+	for _, content := range result.Content {
+		if text, ok := mcp.AsTextContent(content); ok {
+			texts = append(texts, text.Text)
+		}
+	}
+	return strings.Join(texts, "\n")
+}
@@ -0,0 +1,154 @@
+package mcp
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/mark3labs/mcp-go/server"
+	"github.com/warpstreamlabs/bento/public/bloblang"
+)
+
+type authHeaderKey struct{}
+
+func TestParseUpstreamConfig(t *testing.T) {
+	tests := []struct {
+		name      string
+		component string
+		config    string
+		wantErr   bool
+	}{
+		{name: "streamable http", component: UpstreamTransportStreamableHTTP, config: "url: http://localhost/mcp"},
+		{name: "sse", component: UpstreamTransportSSE, config: "url: http://localhost/sse"},
+		{name: "unknown transport", component: "stdio", config: "url: http://localhost/mcp", wantErr: true},
+		{name: "missing url", component: UpstreamTransportStreamableHTTP, config: "prefix: gh_", wantErr: true},
+		{name: "invalid pattern", component: UpstreamTransportStreamableHTTP, config: "url: http://x\nallowed_tools: ['[']", wantErr: true},
+		{name: "invalid mapping", component: UpstreamTransportStreamableHTTP, config: "url: http://x\nargs_mapping: 'root = ('", wantErr: true},
+		{name: "invalid timeout", component: UpstreamTransportStreamableHTTP, config: "url: http://x\ntimeout: soon", wantErr: true},
+		{name: "negative timeout", component: UpstreamTransportStreamableHTTP, config: "url: http://x\ntimeout: -1s", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := ParseUpstreamConfig(tt.component, []byte(tt.config))
+			if (err != nil) != tt.wantErr {
+				t.Errorf("expected error %t, got %v", tt.wantErr, err)
+			}
+		})
+	}
+}
+
+func TestUpstreamExposes(t *testing.T) {
+	u := &upstream{cfg: &UpstreamConfig{
+		AllowedTools: []string{"get_*", "search"},
+		DeniedTools:  []string{"get_secret*"},
+	}}
+
+	tests := map[string]bool{
+		"get_issue":      true,
+		"search":         true,
+		"get_secret_key": false,
+		"delete_issue":   false,
+	}
+	for name, want := range tests {
+		if got := u.exposes(name); got != want {
+			t.Errorf("exposes(%q) = %t, want %t", name, got, want)
+		}
+	}
+
+	all := &upstream{cfg: &UpstreamConfig{DeniedTools: []string{"delete_*"}}}
+	if !all.exposes("anything") || all.exposes("delete_repo") {
+		t.Error("expected every tool but the denied ones to be exposed without allowed_tools")
+	}
+}
+
+func TestUpstreamRateLimitFor(t *testing.T) {
+	u := &upstream{cfg: &UpstreamConfig{RateLimits: map[string]string{"search": "search-limit", "*": "default"}}}
+
+	if label, ok := u.rateLimitFor("search"); !ok || label != "search-limit" {
+		t.Errorf("expected tool rate limit, got %q", label)
+	}
+	if label, ok := u.rateLimitFor("get_issue"); !ok || label != "default" {
+		t.Errorf("expected wildcard rate limit, got %q", label)
+	}
+
+	none := &upstream{cfg: &UpstreamConfig{}}
+	if _, ok := none.rateLimitFor("search"); ok {
+		t.Error("expected no rate limit")
+	}
+}
+
+func TestUpstreamRewriteArguments(t *testing.T) {
+	mapping, err := bloblang.Parse(`root = this
+root.tool = @tool`)
+	if err != nil {
+		t.Fatalf("failed to parse mapping: %v", err)
+	}
+	u := &upstream{cfg: &UpstreamConfig{}, argsMapping: mapping}
+
+	args, err := u.rewriteArguments("search", map[string]any{"q": "airtruct"})
+	if err != nil {
+		t.Fatalf("failed to rewrite arguments: %v", err)
+	}
+	if args["q"] != "airtruct" || args["tool"] != "search" {
+		t.Errorf("unexpected arguments: %v", args)
+	}
+
+	notObject, _ := bloblang.Parse(`root = "text"`)
+	u.argsMapping = notObject
+	if _, err = u.rewriteArguments("search", map[string]any{}); err == nil {
+		t.Error("expected error when the mapping does not evaluate to an object")
+	}
+}
+
+func TestUpstreamForwardsToolCalls(t *testing.T) {
+	mcpServer := server.NewMCPServer("upstream", "1.0.0")
+	mcpServer.AddTool(mcp.NewTool("echo"), func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		if auth, _ := ctx.Value(authHeaderKey{}).(string); auth != "Bearer s3cret" {
+			return mcp.NewToolResultError("unauthorized"), nil
+		}
+		payload, _ := json.Marshal(request.GetArguments())
+		return mcp.NewToolResultText(string(payload)), nil
+	})
+	mcpServer.AddTool(mcp.NewTool("other"), func(context.Context, mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		return mcp.NewToolResultText("other"), nil
+	})
+
+	srv := server.NewTestStreamableHTTPServer(mcpServer, server.WithHTTPContextFunc(func(ctx context.Context, r *http.Request) context.Context {
+		return context.WithValue(ctx, authHeaderKey{}, r.Header.Get("Authorization"))
+	}))
+	t.Cleanup(srv.Close)
+
+	u := &upstream{
+		component: UpstreamTransportStreamableHTTP,
+		cfg: &UpstreamConfig{
+			URL:     srv.URL + "/mcp",
+			Headers: map[string]string{"Authorization": "Bearer s3cret"},
+		},
+		timeout: 5 * time.Second,
+	}
+	u.sessionCtx, u.sessionCancel = context.WithCancel(context.Background())
+	t.Cleanup(u.close)
+
+	tools, err := u.listTools(t.Context())
+	if err != nil {
+		t.Fatalf("failed to list tools: %v", err)
+	}
+	if len(tools) != 2 {
+		t.Fatalf("expected 2 tools, got %d", len(tools))
+	}
+
+	result, err := u.callTool(t.Context(), "echo", map[string]any{"q": "airtruct"})
+	if err != nil {
+		t.Fatalf("failed to call tool: %v", err)
+	}
+	if result.IsError {
+		t.Fatalf("unexpected tool error: %s", resultText(result))
+	}
+	if text := resultText(result); text != `{"q":"airtruct"}` {
+		t.Errorf("unexpected result: %s", text)
+	}
+}
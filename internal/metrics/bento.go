@@ -0,0 +1,184 @@
+package metrics
+
+import (
+	"context"
+	"errors"
+	"regexp"
+	"strings"
+	"sync"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/warpstreamlabs/bento/public/service"
+)
+
+// BentoExporterName is the metrics exporter flows use to report into Registry. The coordinator sets it in the
+// config of every flow it assigns.
+const BentoExporterName = "airtruct"
+
+const (
+	befFlow    = "flow"
+	befVersion = "version"
+)
+
+var invalidNameChars = regexp.MustCompile(`[^a-zA-Z0-9_]`)
+
+func init() {
+	spec := service.NewConfigSpec().
+		Summary("Exports the metrics of a flow into the metrics of the Airtruct node.").
+		Field(service.NewStringField(befFlow).Description("The name of the flow.")).
+		Field(service.NewStringField(befVersion).Description("The version of the flow."))
+
+	err := service.RegisterMetricsExporter(BentoExporterName, spec, func(conf *service.ParsedConfig, _ *service.Logger) (service.MetricsExporter, error) {
+		flow, err := conf.FieldString(befFlow)
+		if err != nil {
+			return nil, err
+		}
+		version, err := conf.FieldString(befVersion)
+		if err != nil {
+			return nil, err
+		}
+		return &flowExporter{flow: flow, version: version}, nil
+	})
+	if err != nil {
+		panic(err)
+	}
+}
+
+// flowVecs holds the metric vectors shared by all flows of the node, keyed by name and label keys.
+var flowVecs = struct {
+	sync.Mutex
+	vecs map[string]flowVec
+}{vecs: make(map[string]flowVec)}
+
+type flowVec interface {
+	prometheus.Collector
+	DeletePartialMatch(labels prometheus.Labels) int
+}
+
+// flowExporter reports the metrics of one flow. Bento metric names are prefixed with airtruct_flow_ and get
+// the flow and version labels, timers become histograms in seconds.
+type flowExporter struct {
+	flow    string
+	version string
+
+	mu   sync.Mutex
+	used []flowVec
+}
+
+func (e *flowExporter) NewCounterCtor(name string, labelKeys ...string) service.MetricsExporterCounterCtor {
+	vec, ok := e.vec(metricName(name, "_total"), labelKeys, func(name string, labels []string) flowVec {
+		return prometheus.NewCounterVec(prometheus.CounterOpts{Name: name, Help: "Bento counter."}, labels)
+	}).(*prometheus.CounterVec)
+	return func(labelValues ...string) service.MetricsExporterCounter {
+		if !ok {
+			return noopMetric{}
+		}
+		return counter{vec.WithLabelValues(e.labelValues(labelValues)...)}
+	}
+}
+
+func (e *flowExporter) NewTimerCtor(name string, labelKeys ...string) service.MetricsExporterTimerCtor {
+	vec, ok := e.vec(metricName(strings.TrimSuffix(name, "_ns"), "_seconds"), labelKeys, func(name string, labels []string) flowVec {
+		return prometheus.NewHistogramVec(prometheus.HistogramOpts{Name: name, Help: "Bento timer.", Buckets: latencyBuckets}, labels)
+	}).(*prometheus.HistogramVec)
+	return func(labelValues ...string) service.MetricsExporterTimer {
+		if !ok {
+			return noopMetric{}
+		}
+		return timer{vec.WithLabelValues(e.labelValues(labelValues)...)}
+	}
+}
+
+func (e *flowExporter) NewGaugeCtor(name string, labelKeys ...string) service.MetricsExporterGaugeCtor {
+	vec, ok := e.vec(metricName(name, ""), labelKeys, func(name string, labels []string) flowVec {
+		return prometheus.NewGaugeVec(prometheus.GaugeOpts{Name: name, Help: "Bento gauge."}, labels)
+	}).(*prometheus.GaugeVec)
+	return func(labelValues ...string) service.MetricsExporterGauge {
+		if !ok {
+			return noopMetric{}
+		}
+		return gauge{vec.WithLabelValues(e.labelValues(labelValues)...)}
+	}
+}
+
+// Close drops the series of the flow, a stopped flow version does not report anymore.
+func (e *flowExporter) Close(context.Context) error {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+
+	for _, vec := range e.used {
+		vec.DeletePartialMatch(prometheus.Labels{befFlow: e.flow, befVersion: e.version})
+	}
+	e.used = nil
+	return nil
+}
+
+// vec returns the vector of the metric, creating and registering it the first time a flow uses it. It returns
+// nil when the metric cannot be registered, e.g. when its name is taken by another metric type.
+func (e *flowExporter) vec(name string, labelKeys []string, create func(name string, labels []string) flowVec) flowVec {
+	labels := make([]string, 0, len(labelKeys)+2)
+	labels = append(labels, befFlow, befVersion)
+	for _, key := range labelKeys {
+		labels = append(labels, invalidNameChars.ReplaceAllString(key, "_"))
+	}
+	key := name + "|" + strings.Join(labels, ",")
+
+	flowVecs.Lock()
+	vec, ok := flowVecs.vecs[key]
+	if !ok {
+		vec = create(name, labels)
+		if err := Registry.Register(vec); err != nil {
+			var registered prometheus.AlreadyRegisteredError
+			isRegistered := errors.As(err, &registered)
+			existing, isVec := registered.ExistingCollector.(flowVec)
+			if !isRegistered || !isVec {
+				flowVecs.Unlock()
+				return nil
+			}
+			vec = existing
+		}
+		flowVecs.vecs[key] = vec
+	}
+	flowVecs.Unlock()
+
+	e.mu.Lock()
+	e.used = append(e.used, vec)
+	e.mu.Unlock()
+	return vec
+}
+
+func (e *flowExporter) labelValues(values []string) []string {
+	return append([]string{e.flow, e.version}, values...)
+}
+
+func metricName(name, suffix string) string {
+	name = invalidNameChars.ReplaceAllString(name, "_")
+	if suffix != "" && !strings.HasSuffix(name, suffix) {
+		name += suffix
+	}
+	return prometheus.BuildFQName(namespace, "flow", name)
+}
+
+type counter struct{ prometheus.Counter }
+
+func (c counter) Incr(count int64) {
+	c.Add(float64(count))
+}
+
+type timer struct{ prometheus.Observer }
+
+func (t timer) Timing(delta int64) {
+	t.Observe(float64(delta) / 1e9)
+}
+
+type gauge struct{ prometheus.Gauge }
+
+func (g gauge) Set(value int64) {
+	g.Gauge.Set(float64(value))
+}
+
+type noopMetric struct{}
+
+func (noopMetric) Incr(int64)   {}
+func (noopMetric) Timing(int64) {}
+func (noopMetric) Set(int64)    {}
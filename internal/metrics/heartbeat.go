@@ -0,0 +1,56 @@
+package metrics
+
+import (
+	"sync"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+// heartbeatCollector reports the time since the last heartbeat of each worker when it is scraped, so the age
+// keeps growing while a worker is silent.
+type heartbeatCollector struct {
+	desc *prometheus.Desc
+
+	mu       sync.Mutex
+	lastSeen map[string]time.Time
+}
+
+func newHeartbeatCollector() *heartbeatCollector {
+	return &heartbeatCollector{
+		desc: prometheus.NewDesc(
+			prometheus.BuildFQName(namespace, "worker", "heartbeat_age_seconds"),
+			"Seconds since the last heartbeat of the worker.",
+			[]string{"worker"}, nil,
+		),
+		lastSeen: make(map[string]time.Time),
+	}
+}
+
+// Observe records a heartbeat of the worker.
+func (c *heartbeatCollector) Observe(workerID string) {
+	c.mu.Lock()
+	c.lastSeen[workerID] = time.Now()
+	c.mu.Unlock()
+}
+
+// Forget stops reporting the worker, e.g. once it was deactivated.
+func (c *heartbeatCollector) Forget(workerID string) {
+	c.mu.Lock()
+	delete(c.lastSeen, workerID)
+	c.mu.Unlock()
+}
+
+func (c *heartbeatCollector) Describe(ch chan<- *prometheus.Desc) {
+	ch <- c.desc
+}
+
+func (c *heartbeatCollector) Collect(ch chan<- prometheus.Metric) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	now := time.Now()
+	for workerID, lastSeen := range c.lastSeen {
+		ch <- prometheus.MustNewConstMetric(c.desc, prometheus.GaugeValue, now.Sub(lastSeen).Seconds(), workerID)
+	}
+}
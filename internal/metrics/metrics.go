@@ -0,0 +1,168 @@
+package metrics
+
+import (
+	"net/http"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/collectors"
+	"github.com/prometheus/client_golang/prometheus/promhttp"
+)
+
+const namespace = "airtruct"
+
+// latencyBuckets covers latencies from 100µs to about 26s.
+var latencyBuckets = prometheus.ExponentialBuckets(0.0001, 4, 10)
+
+// Registry holds the metrics of the node, it is served by Handler.
+var Registry = prometheus.NewRegistry()
+
+var (
+	// AlertNotifications counts the notifications of alerts sent to channels by their outcome.
+	AlertNotifications = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Namespace: namespace,
+		Name:      "alert_notifications_total",
+		Help:      "Notifications of alerts sent to channels.",
+	}, []string{"channel", "result"})
+
+	// AlertsFired counts the alerts fired by the coordinator, including silenced ones.
+	AlertsFired = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Namespace: namespace,
+		Name:      "alerts_fired_total",
+		Help:      "Alerts fired by the coordinator.",
+	}, []string{"type"})
+
+	// EventsDropped counts the events workers dropped from their full buffers, as reported to the coordinator.
+	EventsDropped = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Namespace: namespace,
+		Name:      "events_dropped_total",
+		Help:      "Events dropped by workers before they reached the coordinator.",
+	}, []string{"flow", "version"})
+
+	// FlowAssignmentDuration is the time it takes the coordinator to build the config of a flow and hand it
+	// to a worker.
+	FlowAssignmentDuration = prometheus.NewHistogramVec(prometheus.HistogramOpts{
+		Namespace: namespace,
+		Name:      "flow_assignment_duration_seconds",
+		Help:      "Time taken to assign a flow to a worker.",
+		Buckets:   latencyBuckets,
+	}, []string{"flow", "version", "result"})
+
+	// FlowLeaseRenewals counts the flow leases renewed by worker heartbeats.
+	FlowLeaseRenewals = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Namespace: namespace,
+		Name:      "flow_lease_renewals_total",
+		Help:      "Flow leases renewed by worker heartbeats.",
+	}, []string{"worker"})
+
+	// FlowLeaseExpiries counts the flow leases that expired or were refused on heartbeat.
+	FlowLeaseExpiries = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Namespace: namespace,
+		Name:      "flow_lease_expiries_total",
+		Help:      "Flow leases that expired or were refused on heartbeat.",
+	}, []string{"worker"})
+
+	// IngestForwardDuration is the time from admitting an ingest request until the worker finished
+	// responding to it.
+	IngestForwardDuration = prometheus.NewHistogramVec(prometheus.HistogramOpts{
+		Namespace: namespace,
+		Name:      "ingest_forward_duration_seconds",
+		Help:      "Time taken to forward an ingest request to a worker and receive its response.",
+		Buckets:   latencyBuckets,
+	}, []string{"flow", "version", "result"})
+
+	// LogsDropped counts the log lines of flows workers dropped from their full buffers, as reported to the
+	// coordinator.
+	LogsDropped = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Namespace: namespace,
+		Name:      "logs_dropped_total",
+		Help:      "Log lines of flows dropped by workers before they reached the coordinator.",
+	}, []string{"flow", "version"})
+
+	// RateLimitChecks counts the rate limit checks of the coordinator by their outcome.
+	RateLimitChecks = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Namespace: namespace,
+		Name:      "rate_limit_checks_total",
+		Help:      "Rate limit checks by rate limit and result.",
+	}, []string{"rate_limit", "result"})
+
+	// TailDropped counts the items not delivered to live tail subscribers that fell behind.
+	TailDropped = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Namespace: namespace,
+		Name:      "tail_dropped_total",
+		Help:      "Items dropped for live tail subscribers whose buffer was full.",
+	}, []string{"stream"})
+
+	// TailSubscribers is the number of open live tails.
+	TailSubscribers = prometheus.NewGaugeVec(prometheus.GaugeOpts{
+		Namespace: namespace,
+		Name:      "tail_subscribers",
+		Help:      "Open live tails of flow events and logs.",
+	}, []string{"stream"})
+
+	// WorkerEventBufferDepth is the number of events waiting in the worker to be shipped.
+	WorkerEventBufferDepth = prometheus.NewGauge(prometheus.GaugeOpts{
+		Namespace: namespace,
+		Name:      "worker_event_buffer_depth",
+		Help:      "Events buffered by the worker that are waiting to be shipped.",
+	})
+
+	// WorkerEventsDropped counts the events the worker dropped because its buffer was full.
+	WorkerEventsDropped = prometheus.NewCounter(prometheus.CounterOpts{
+		Namespace: namespace,
+		Name:      "worker_events_dropped_total",
+		Help:      "Events dropped by the worker because its event buffer was full.",
+	})
+
+	// WorkerLogsDropped counts the log lines of flows the worker dropped because its buffer was full.
+	WorkerLogsDropped = prometheus.NewCounter(prometheus.CounterOpts{
+		Namespace: namespace,
+		Name:      "worker_logs_dropped_total",
+		Help:      "Log lines of flows dropped by the worker because its log buffer was full.",
+	})
+
+	// WorkerFlowQueueDepth is the number of flows waiting to be started by the worker.
+	WorkerFlowQueueDepth = prometheus.NewGauge(prometheus.GaugeOpts{
+		Namespace: namespace,
+		Name:      "worker_flow_queue_depth",
+		Help:      "Flows assigned to the worker that are waiting to be started.",
+	})
+
+	// WorkerHeartbeats tracks the last heartbeat of every active worker.
+	WorkerHeartbeats = newHeartbeatCollector()
+)
+
+func init() {
+	Registry.MustRegister(
+		collectors.NewGoCollector(),
+		collectors.NewProcessCollector(collectors.ProcessCollectorOpts{}),
+		AlertNotifications,
+		AlertsFired,
+		EventsDropped,
+		FlowAssignmentDuration,
+		FlowLeaseRenewals,
+		FlowLeaseExpiries,
+		IngestForwardDuration,
+		LogsDropped,
+		RateLimitChecks,
+		TailDropped,
+		TailSubscribers,
+		WorkerEventBufferDepth,
+		WorkerEventsDropped,
+		WorkerLogsDropped,
+		WorkerFlowQueueDepth,
+		WorkerHeartbeats,
+	)
+}
+
+// Handler serves the metrics of the node in the Prometheus exposition format.
+func Handler() http.Handler {
+	return promhttp.HandlerFor(Registry, promhttp.HandlerOpts{Registry: Registry})
+}
+
+// Result returns the result label of an operation that returned err.
+func Result(err error) string {
+	if err != nil {
+		return "error"
+	}
+	return "success"
+}
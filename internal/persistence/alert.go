@@ -0,0 +1,155 @@
+package persistence
+
+import (
+	"errors"
+	"time"
+
+	pb "github.com/sananguliyev/airtruct/internal/protogen"
+	"google.golang.org/protobuf/types/known/timestamppb"
+	"gorm.io/gorm"
+)
+
+type AlertStatus string
+
+const (
+	AlertStatusFiring   AlertStatus = "firing"
+	AlertStatusResolved AlertStatus = "resolved"
+)
+
+// Alert is a firing, or once firing, alert of a rule about a subject.
+type Alert struct {
+	ID       int64         `json:"id" gorm:"primaryKey"`
+	RuleID   int64         `json:"rule_id" gorm:"not null"`
+	RuleName string        `json:"rule_name" gorm:"not null"`
+	Type     AlertRuleType `json:"type" gorm:"not null"`
+	// Subject identifies what the alert is about, e.g. "flow:12" or "worker:abc", a rule fires at most one alert per
+	// subject at a time.
+	Subject    string      `json:"subject" gorm:"not null"`
+	FlowID     int64       `json:"flow_id" gorm:"not null"`
+	WorkerID   string      `json:"worker_id" gorm:"not null"`
+	Message    string      `json:"message" gorm:"not null"`
+	Status     AlertStatus `json:"status" gorm:"not null"`
+	Silenced   bool        `json:"silenced" gorm:"not null"`
+	StartedAt  time.Time   `json:"started_at" gorm:"not null"`
+	ResolvedAt *time.Time  `json:"resolved_at"`
+}
+
+func (a *Alert) ToProto() *pb.Alert {
+	var resolvedAt *timestamppb.Timestamp
+	if a.ResolvedAt != nil {
+		resolvedAt = timestamppb.New(*a.ResolvedAt)
+	}
+
+	return &pb.Alert{
+		Id:         a.ID,
+		RuleId:     a.RuleID,
+		RuleName:   a.RuleName,
+		Type:       string(a.Type),
+		Status:     string(a.Status),
+		FlowId:     a.FlowID,
+		WorkerId:   a.WorkerID,
+		Message:    a.Message,
+		Silenced:   a.Silenced,
+		StartedAt:  timestamppb.New(a.StartedAt),
+		ResolvedAt: resolvedAt,
+	}
+}
+
+type AlertFilter struct {
+	Status    string
+	RuleID    int64
+	FlowID    int64
+	StartTime time.Time
+	EndTime   time.Time
+	Limit     int
+	Offset    int
+}
+
+type AlertRepository interface {
+	Create(alert *Alert) error
+	FindFiring(ruleID int64, subject string) (*Alert, error)
+	ListFiring() ([]Alert, error)
+	Resolve(id int64, at time.Time) error
+	List(filter AlertFilter) ([]Alert, int64, error)
+}
+
+type alertRepository struct {
+	db *gorm.DB
+}
+
+func NewAlertRepository(db *gorm.DB) AlertRepository {
+	return &alertRepository{db: db}
+}
+
+func (r *alertRepository) Create(alert *Alert) error {
+	alert.StartedAt = time.Now()
+	alert.Status = AlertStatusFiring
+	return r.db.Create(alert).Error
+}
+
+func (r *alertRepository) FindFiring(ruleID int64, subject string) (*Alert, error) {
+	var alert Alert
+	err := r.db.
+		Where("status = ? AND rule_id = ? AND subject = ?", AlertStatusFiring, ruleID, subject).
+		First(&alert).
+		Error
+	if err != nil && !errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, err
+	} else if errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, nil
+	}
+	return &alert, nil
+}
+
+func (r *alertRepository) ListFiring() ([]Alert, error) {
+	var alerts []Alert
+	err := r.db.Where("status = ?", AlertStatusFiring).Order("id ASC").Find(&alerts).Error
+	return alerts, err
+}
+
+func (r *alertRepository) Resolve(id int64, at time.Time) error {
+	return r.db.
+		Model(&Alert{}).
+		Where("id = ? AND status = ?", id, AlertStatusFiring).
+		Updates(map[string]any{
+			"status":      AlertStatusResolved,
+			"resolved_at": at,
+		}).
+		Error
+}
+
+func (r *alertRepository) List(filter AlertFilter) ([]Alert, int64, error) {
+	query := r.db.Model(&Alert{})
+	if filter.Status != "" {
+		query = query.Where("status = ?", filter.Status)
+	}
+	if filter.RuleID != 0 {
+		query = query.Where("rule_id = ?", filter.RuleID)
+	}
+	if filter.FlowID != 0 {
+		query = query.Where("flow_id = ?", filter.FlowID)
+	}
+	if !filter.StartTime.IsZero() {
+		query = query.Where("started_at >= ?", filter.StartTime)
+	}
+	if !filter.EndTime.IsZero() {
+		query = query.Where("started_at <= ?", filter.EndTime)
+	}
+
+	var total int64
+	if err := query.Count(&total).Error; err != nil {
+		return nil, 0, err
+	}
+
+	var alerts []Alert
+	err := query.
+		Order("started_at DESC, id DESC").
+		Limit(filter.Limit).
+		Offset(filter.Offset).
+		Find(&alerts).
+		Error
+	if err != nil {
+		return nil, 0, err
+	}
+	return alerts, total, nil
+}
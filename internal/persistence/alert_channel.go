@@ -0,0 +1,142 @@
+package persistence
+
+import (
+	"errors"
+	"strings"
+	"time"
+
+	pb "github.com/sananguliyev/airtruct/internal/protogen"
+	"google.golang.org/protobuf/types/known/timestamppb"
+	"gorm.io/gorm"
+)
+
+type AlertChannelType string
+
+const (
+	AlertChannelTypeWebhook AlertChannelType = "webhook"
+	AlertChannelTypeSlack   AlertChannelType = "slack"
+	AlertChannelTypeEmail   AlertChannelType = "email"
+)
+
+// AlertChannel is where notifications of alerts are sent. Webhook and Slack channels post to URL, email channels
+// send to Recipients.
+type AlertChannel struct {
+	ID         int64            `json:"id" gorm:"primaryKey"`
+	Name       string           `json:"name" gorm:"not null"`
+	Type       AlertChannelType `json:"type" gorm:"not null"`
+	URL        string           `json:"url" gorm:"not null"`
+	Recipients string           `json:"recipients" gorm:"not null"`
+	CreatedAt  time.Time        `json:"created_at" gorm:"not null"`
+	UpdatedAt  *time.Time       `json:"updated_at"`
+}
+
+func (c *AlertChannel) ToProto() *pb.AlertChannel {
+	var updatedAt *timestamppb.Timestamp
+	if c.UpdatedAt != nil {
+		updatedAt = timestamppb.New(*c.UpdatedAt)
+	}
+
+	return &pb.AlertChannel{
+		Id:         c.ID,
+		Name:       c.Name,
+		Type:       string(c.Type),
+		Url:        c.URL,
+		Recipients: c.GetRecipients(),
+		CreatedAt:  timestamppb.New(c.CreatedAt),
+		UpdatedAt:  updatedAt,
+	}
+}
+
+func (c *AlertChannel) FromProto(p *pb.AlertChannel) {
+	c.ID = p.GetId()
+	c.Name = p.GetName()
+	c.Type = AlertChannelType(p.GetType())
+	c.URL = strings.TrimSpace(p.GetUrl())
+	c.SetRecipients(p.GetRecipients())
+}
+
+// GetRecipients returns the email addresses of an email channel.
+func (c *AlertChannel) GetRecipients() []string {
+	if c.Recipients == "" {
+		return nil
+	}
+	return strings.Split(c.Recipients, ",")
+}
+
+func (c *AlertChannel) SetRecipients(recipients []string) {
+	normalized := make([]string, 0, len(recipients))
+	for _, recipient := range recipients {
+		if recipient = strings.TrimSpace(recipient); recipient != "" {
+			normalized = append(normalized, recipient)
+		}
+	}
+	c.Recipients = strings.Join(normalized, ",")
+}
+
+type AlertChannelRepository interface {
+	Create(channel *AlertChannel) error
+	Update(channel *AlertChannel) error
+	FindByID(id int64) (*AlertChannel, error)
+	FindByIDs(ids []int64) ([]AlertChannel, error)
+	Delete(id int64) error
+	ListAll() ([]AlertChannel, error)
+}
+
+type alertChannelRepository struct {
+	db *gorm.DB
+}
+
+func NewAlertChannelRepository(db *gorm.DB) AlertChannelRepository {
+	return &alertChannelRepository{db: db}
+}
+
+func (r *alertChannelRepository) Create(channel *AlertChannel) error {
+	channel.CreatedAt = time.Now()
+	return r.db.Create(channel).Error
+}
+
+func (r *alertChannelRepository) Update(channel *AlertChannel) error {
+	now := time.Now()
+	channel.UpdatedAt = &now
+	return r.db.
+		Model(&AlertChannel{}).
+		Where("id = ?", channel.ID).
+		Updates(map[string]any{
+			"name":       channel.Name,
+			"type":       channel.Type,
+			"url":        channel.URL,
+			"recipients": channel.Recipients,
+			"updated_at": channel.UpdatedAt,
+		}).
+		Error
+}
+
+func (r *alertChannelRepository) FindByID(id int64) (*AlertChannel, error) {
+	var channel AlertChannel
+	err := r.db.Where("id = ?", id).First(&channel).Error
+	if err != nil && !errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, err
+	} else if errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, nil
+	}
+	return &channel, nil
+}
+
+func (r *alertChannelRepository) FindByIDs(ids []int64) ([]AlertChannel, error) {
+	var channels []AlertChannel
+	if len(ids) == 0 {
+		return channels, nil
+	}
+	err := r.db.Where("id IN ?", ids).Order("id ASC").Find(&channels).Error
+	return channels, err
+}
+
+func (r *alertChannelRepository) Delete(id int64) error {
+	return r.db.Delete(&AlertChannel{}, id).Error
+}
+
+func (r *alertChannelRepository) ListAll() ([]AlertChannel, error) {
+	var channels []AlertChannel
+	err := r.db.Order("name ASC").Find(&channels).Error
+	return channels, err
+}
@@ -0,0 +1,181 @@
+package persistence
+
+import (
+	"errors"
+	"strconv"
+	"strings"
+	"time"
+
+	pb "github.com/sananguliyev/airtruct/internal/protogen"
+	"google.golang.org/protobuf/types/known/timestamppb"
+	"gorm.io/gorm"
+)
+
+type AlertRuleType string
+
+const (
+	// AlertRuleTypeFlowFailed fires when a worker reports that a flow failed.
+	AlertRuleTypeFlowFailed AlertRuleType = "flow_failed"
+	// AlertRuleTypeErrorRate fires when the share of input messages with processor errors of a flow is above the
+	// threshold over the window.
+	AlertRuleTypeErrorRate AlertRuleType = "error_rate"
+	// AlertRuleTypeNoInput fires when an active flow read no input over the window.
+	AlertRuleTypeNoInput AlertRuleType = "no_input"
+	// AlertRuleTypeWorkerLost fires when a worker stops sending heartbeats and is marked inactive.
+	AlertRuleTypeWorkerLost AlertRuleType = "worker_lost"
+	// AlertRuleTypeLeaseExpired fires when the lease of a running flow expires.
+	AlertRuleTypeLeaseExpired AlertRuleType = "lease_expired"
+)
+
+// Windowed tells whether rules of the type are evaluated over a window of flow metrics.
+func (t AlertRuleType) Windowed() bool {
+	return t == AlertRuleTypeErrorRate || t == AlertRuleTypeNoInput
+}
+
+type AlertRule struct {
+	ID   int64         `json:"id" gorm:"primaryKey"`
+	Name string        `json:"name" gorm:"not null"`
+	Type AlertRuleType `json:"type" gorm:"not null"`
+	// FlowID is the first version of the flow the rule watches, all flows are watched when it is 0.
+	FlowID        int64   `json:"flow_id" gorm:"not null"`
+	Threshold     float64 `json:"threshold" gorm:"not null"`
+	WindowMinutes int64   `json:"window_minutes" gorm:"not null"`
+	// ChannelIDs holds the IDs of the channels notified, comma-separated.
+	ChannelIDs string     `json:"channel_ids" gorm:"not null"`
+	Enabled    bool       `json:"enabled" gorm:"not null"`
+	CreatedAt  time.Time  `json:"created_at" gorm:"not null"`
+	UpdatedAt  *time.Time `json:"updated_at"`
+}
+
+func (r *AlertRule) ToProto() *pb.AlertRule {
+	var updatedAt *timestamppb.Timestamp
+	if r.UpdatedAt != nil {
+		updatedAt = timestamppb.New(*r.UpdatedAt)
+	}
+
+	return &pb.AlertRule{
+		Id:            r.ID,
+		Name:          r.Name,
+		Type:          string(r.Type),
+		FlowId:        r.FlowID,
+		Threshold:     r.Threshold,
+		WindowMinutes: r.WindowMinutes,
+		ChannelIds:    r.GetChannelIDs(),
+		Enabled:       r.Enabled,
+		CreatedAt:     timestamppb.New(r.CreatedAt),
+		UpdatedAt:     updatedAt,
+	}
+}
+
+func (r *AlertRule) FromProto(p *pb.AlertRule) {
+	r.ID = p.GetId()
+	r.Name = p.GetName()
+	r.Type = AlertRuleType(p.GetType())
+	r.FlowID = p.GetFlowId()
+	r.Threshold = p.GetThreshold()
+	r.WindowMinutes = p.GetWindowMinutes()
+	r.SetChannelIDs(p.GetChannelIds())
+	r.Enabled = p.GetEnabled()
+}
+
+// Window returns the period windowed rules look back on.
+func (r *AlertRule) Window() time.Duration {
+	return time.Duration(r.WindowMinutes) * time.Minute
+}
+
+func (r *AlertRule) GetChannelIDs() []int64 {
+	if r.ChannelIDs == "" {
+		return nil
+	}
+	parts := strings.Split(r.ChannelIDs, ",")
+	ids := make([]int64, 0, len(parts))
+	for _, part := range parts {
+		if id, err := strconv.ParseInt(part, 10, 64); err == nil {
+			ids = append(ids, id)
+		}
+	}
+	return ids
+}
+
+func (r *AlertRule) SetChannelIDs(ids []int64) {
+	parts := make([]string, 0, len(ids))
+	for _, id := range ids {
+		parts = append(parts, strconv.FormatInt(id, 10))
+	}
+	r.ChannelIDs = strings.Join(parts, ",")
+}
+
+type AlertRuleRepository interface {
+	Create(rule *AlertRule) error
+	Update(rule *AlertRule) error
+	FindByID(id int64) (*AlertRule, error)
+	Delete(id int64) error
+	ListAll() ([]AlertRule, error)
+	ListEnabled() ([]AlertRule, error)
+	ListEnabledByType(ruleType AlertRuleType) ([]AlertRule, error)
+}
+
+type alertRuleRepository struct {
+	db *gorm.DB
+}
+
+func NewAlertRuleRepository(db *gorm.DB) AlertRuleRepository {
+	return &alertRuleRepository{db: db}
+}
+
+func (r *alertRuleRepository) Create(rule *AlertRule) error {
+	rule.CreatedAt = time.Now()
+	return r.db.Create(rule).Error
+}
+
+func (r *alertRuleRepository) Update(rule *AlertRule) error {
+	now := time.Now()
+	rule.UpdatedAt = &now
+	return r.db.
+		Model(&AlertRule{}).
+		Where("id = ?", rule.ID).
+		Updates(map[string]any{
+			"name":           rule.Name,
+			"type":           rule.Type,
+			"flow_id":        rule.FlowID,
+			"threshold":      rule.Threshold,
+			"window_minutes": rule.WindowMinutes,
+			"channel_ids":    rule.ChannelIDs,
+			"enabled":        rule.Enabled,
+			"updated_at":     rule.UpdatedAt,
+		}).
+		Error
+}
+
+func (r *alertRuleRepository) FindByID(id int64) (*AlertRule, error) {
+	var rule AlertRule
+	err := r.db.Where("id = ?", id).First(&rule).Error
+	if err != nil && !errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, err
+	} else if errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, nil
+	}
+	return &rule, nil
+}
+
+func (r *alertRuleRepository) Delete(id int64) error {
+	return r.db.Delete(&AlertRule{}, id).Error
+}
+
+func (r *alertRuleRepository) ListAll() ([]AlertRule, error) {
+	var rules []AlertRule
+	err := r.db.Order("name ASC").Find(&rules).Error
+	return rules, err
+}
+
+func (r *alertRuleRepository) ListEnabled() ([]AlertRule, error) {
+	var rules []AlertRule
+	err := r.db.Where("enabled = ?", true).Order("id ASC").Find(&rules).Error
+	return rules, err
+}
+
+func (r *alertRuleRepository) ListEnabledByType(ruleType AlertRuleType) ([]AlertRule, error) {
+	var rules []AlertRule
+	err := r.db.Where("enabled = ? AND type = ?", true, ruleType).Order("id ASC").Find(&rules).Error
+	return rules, err
+}
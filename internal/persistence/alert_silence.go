@@ -0,0 +1,121 @@
+package persistence
+
+import (
+	"errors"
+	"strings"
+	"time"
+
+	pb "github.com/sananguliyev/airtruct/internal/protogen"
+	"google.golang.org/protobuf/types/known/timestamppb"
+	"gorm.io/gorm"
+)
+
+// AlertSilence mutes the notifications of the alerts it matches between StartsAt and EndsAt. A zero RuleID or
+// FlowID and an empty WorkerID match any.
+type AlertSilence struct {
+	ID        int64     `json:"id" gorm:"primaryKey"`
+	RuleID    int64     `json:"rule_id" gorm:"not null"`
+	FlowID    int64     `json:"flow_id" gorm:"not null"`
+	WorkerID  string    `json:"worker_id" gorm:"not null"`
+	Comment   string    `json:"comment" gorm:"not null"`
+	StartsAt  time.Time `json:"starts_at" gorm:"not null"`
+	EndsAt    time.Time `json:"ends_at" gorm:"not null"`
+	CreatedAt time.Time `json:"created_at" gorm:"not null"`
+}
+
+func (s *AlertSilence) ToProto() *pb.AlertSilence {
+	return &pb.AlertSilence{
+		Id:        s.ID,
+		RuleId:    s.RuleID,
+		FlowId:    s.FlowID,
+		WorkerId:  s.WorkerID,
+		Comment:   s.Comment,
+		StartsAt:  timestamppb.New(s.StartsAt),
+		EndsAt:    timestamppb.New(s.EndsAt),
+		CreatedAt: timestamppb.New(s.CreatedAt),
+	}
+}
+
+func (s *AlertSilence) FromProto(p *pb.AlertSilence) {
+	s.ID = p.GetId()
+	s.RuleID = p.GetRuleId()
+	s.FlowID = p.GetFlowId()
+	s.WorkerID = strings.TrimSpace(p.GetWorkerId())
+	s.Comment = p.GetComment()
+	s.StartsAt = time.Now()
+	if p.GetStartsAt() != nil {
+		s.StartsAt = p.GetStartsAt().AsTime()
+	}
+	s.EndsAt = p.GetEndsAt().AsTime()
+}
+
+// Matches tells whether the silence mutes the alert at the given time.
+func (s *AlertSilence) Matches(alert *Alert, at time.Time) bool {
+	if at.Before(s.StartsAt) || !at.Before(s.EndsAt) {
+		return false
+	}
+	if s.RuleID != 0 && s.RuleID != alert.RuleID {
+		return false
+	}
+	if s.FlowID != 0 && s.FlowID != alert.FlowID {
+		return false
+	}
+	return s.WorkerID == "" || s.WorkerID == alert.WorkerID
+}
+
+type AlertSilenceRepository interface {
+	Create(silence *AlertSilence) error
+	FindByID(id int64) (*AlertSilence, error)
+	Delete(id int64) error
+	List(includeExpired bool) ([]AlertSilence, error)
+	ListActive(at time.Time) ([]AlertSilence, error)
+}
+
+type alertSilenceRepository struct {
+	db *gorm.DB
+}
+
+func NewAlertSilenceRepository(db *gorm.DB) AlertSilenceRepository {
+	return &alertSilenceRepository{db: db}
+}
+
+func (r *alertSilenceRepository) Create(silence *AlertSilence) error {
+	silence.CreatedAt = time.Now()
+	return r.db.Create(silence).Error
+}
+
+func (r *alertSilenceRepository) FindByID(id int64) (*AlertSilence, error) {
+	var silence AlertSilence
+	err := r.db.Where("id = ?", id).First(&silence).Error
+	if err != nil && !errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, err
+	} else if errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, nil
+	}
+	return &silence, nil
+}
+
+func (r *alertSilenceRepository) Delete(id int64) error {
+	return r.db.Delete(&AlertSilence{}, id).Error
+}
+
+// List returns the silences that did not end yet, or all of them when includeExpired is set, the latest ending
+// first.
+func (r *alertSilenceRepository) List(includeExpired bool) ([]AlertSilence, error) {
+	var silences []AlertSilence
+	query := r.db.Order("ends_at DESC, id DESC")
+	if !includeExpired {
+		query = query.Where("ends_at > ?", time.Now())
+	}
+	err := query.Find(&silences).Error
+	return silences, err
+}
+
+func (r *alertSilenceRepository) ListActive(at time.Time) ([]AlertSilence, error) {
+	var silences []AlertSilence
+	err := r.db.
+		Where("starts_at <= ? AND ends_at > ?", at, at).
+		Find(&silences).
+		Error
+	return silences, err
+}
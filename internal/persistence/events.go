@@ -1,10 +1,14 @@
 package persistence
 
 import (
+	"database/sql"
 	"encoding/json"
+	"slices"
+	"strings"
 	"time"
 
 	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
 )
 
 type EventType string
@@ -28,14 +32,35 @@ type Event struct {
 	Content        string          `json:"content" gorm:"not null"`
 	Meta           json.RawMessage `json:"meta" gorm:"not null"`
 	CreatedAt      time.Time       `json:"created_at"`
+	// Key is set by the worker and identifies the event across retried sends, events without a key are never
+	// deduplicated.
+	Key *string `json:"key,omitempty" gorm:"uniqueIndex"`
 
 	Worker WorkerFlow `json:"worker" gorm:"foreignKey:WorkerFlowID"`
 }
 
+// EventFilter selects the events of the flow versions, zero values do not filter.
+type EventFilter struct {
+	FlowIDs        []int64
+	WorkerFlowID   int64
+	Section        string
+	ComponentLabel string
+	Type           EventType
+	TraceID        string
+	// Search is matched case-insensitively against the content and metadata.
+	Search    string
+	StartTime time.Time
+	EndTime   time.Time
+	Limit     int
+	Offset    int
+}
+
 type EventRepository interface {
-	AddEvent(event *Event) error
+	AddEvents(events []*Event) ([]*Event, error)
 	ListEventsByWorkerFlow(workerID int64, preload bool) ([]*Event, error)
-	ListEventsByFlowIDs(flowIDs []int64, limit, offset int, startTime, endTime time.Time) ([]*Event, int64, error)
+	ListEvents(filter EventFilter) ([]*Event, int64, error)
+	ListEventsByTraceID(traceID string) ([]*Event, error)
+	DeleteExpired() error
 }
 
 type eventRepository struct {
@@ -46,9 +71,87 @@ func NewEventRepository(db *gorm.DB) EventRepository {
 	return &eventRepository{db: db}
 }
 
-func (r *eventRepository) AddEvent(event *Event) error {
-	event.CreatedAt = time.Now()
-	return r.db.Create(event).Error
+// eventInsertBatchSize keeps the parameters of a bulk insert within the limits of the database drivers.
+const eventInsertBatchSize = 100
+
+// AddEvents stores the events in bulk, events without a creation time get the current one. Events whose key is
+// already stored are skipped, it returns the events that were stored.
+func (r *eventRepository) AddEvents(events []*Event) ([]*Event, error) {
+	if len(events) == 0 {
+		return nil, nil
+	}
+
+	now := time.Now()
+	for _, event := range events {
+		if event.CreatedAt.IsZero() {
+			event.CreatedAt = now
+		}
+	}
+
+	added := make([]*Event, 0, len(events))
+	for chunk := range slices.Chunk(events, eventInsertBatchSize) {
+		chunkAdded, err := r.insertEvents(chunk)
+		if err != nil {
+			return nil, err
+		}
+		added = append(added, chunkAdded...)
+	}
+	return added, nil
+}
+
+// insertEvents inserts the events skipping those whose key is already stored. Gorm scans the returned IDs into
+// the events in order, which assigns the IDs of skipped events to the next ones, so the statement is only built
+// by gorm and the returned rows are matched to the events by key.
+func (r *eventRepository) insertEvents(events []*Event) ([]*Event, error) {
+	stmt := r.db.
+		Session(&gorm.Session{DryRun: true}).
+		Clauses(
+			clause.OnConflict{Columns: []clause.Column{{Name: "key"}}, DoNothing: true},
+			clause.Returning{Columns: []clause.Column{{Name: "id"}, {Name: "key"}}},
+		).
+		Create(events).
+		Statement
+
+	rows, err := r.db.Raw(stmt.SQL.String(), stmt.Vars...).Rows()
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	keyed := make(map[string]int64)
+	// Events without a key are always inserted, their rows are returned in the order of the events.
+	var unkeyed []int64
+	for rows.Next() {
+		var id int64
+		var key sql.NullString
+		if err := rows.Scan(&id, &key); err != nil {
+			return nil, err
+		}
+		if key.Valid {
+			keyed[key.String] = id
+		} else {
+			unkeyed = append(unkeyed, id)
+		}
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	added := make([]*Event, 0, len(events))
+	for _, event := range events {
+		if event.Key == nil {
+			if len(unkeyed) == 0 {
+				continue
+			}
+			event.ID, unkeyed = unkeyed[0], unkeyed[1:]
+		} else if id, ok := keyed[*event.Key]; ok {
+			event.ID = id
+		} else {
+			continue
+		}
+		added = append(added, event)
+	}
+	return added, nil
 }
 
 func (r *eventRepository) ListEventsByWorkerFlow(workerFlowID int64, preload bool) ([]*Event, error) {
@@ -64,25 +167,17 @@ func (r *eventRepository) ListEventsByWorkerFlow(workerFlowID int64, preload boo
 	return events, nil
 }
 
-func (r *eventRepository) ListEventsByFlowIDs(flowIDs []int64, limit, offset int, startTime, endTime time.Time) ([]*Event, int64, error) {
+// ListEvents pages the events matching the filter by trace, the most recent traces first. It returns the matching
+// events of the traces in the page and the number of traces with matching events.
+func (r *eventRepository) ListEvents(filter EventFilter) ([]*Event, int64, error) {
 	var events []*Event
 	var totalFlows int64
 
-	baseWhere := "flow_id IN ? AND trace_id != ''"
-	baseArgs := []any{flowIDs}
-
-	if !startTime.IsZero() {
-		baseWhere += " AND created_at >= ?"
-		baseArgs = append(baseArgs, startTime)
-	}
-	if !endTime.IsZero() {
-		baseWhere += " AND created_at <= ?"
-		baseArgs = append(baseArgs, endTime)
-	}
+	where := r.eventFilterScope(filter)
 
 	// Count distinct trace_ids for pagination
 	if err := r.db.Model(&Event{}).
-		Where(baseWhere, baseArgs...).
+		Scopes(where).
 		Distinct("trace_id").
 		Count(&totalFlows).Error; err != nil {
 		return nil, 0, err
@@ -95,11 +190,11 @@ func (r *eventRepository) ListEventsByFlowIDs(flowIDs []int64, limit, offset int
 	var traceIDs []flowResult
 	if err := r.db.Model(&Event{}).
 		Select("trace_id, MAX(created_at) as max_ts").
-		Where(baseWhere, baseArgs...).
+		Scopes(where).
 		Group("trace_id").
 		Order("max_ts DESC").
-		Limit(limit).
-		Offset(offset).
+		Limit(filter.Limit).
+		Offset(filter.Offset).
 		Find(&traceIDs).Error; err != nil {
 		return nil, 0, err
 	}
@@ -108,13 +203,14 @@ func (r *eventRepository) ListEventsByFlowIDs(flowIDs []int64, limit, offset int
 		return events, totalFlows, nil
 	}
 
-	// Fetch ALL events for the selected trace_ids
+	// Fetch the matching events of the selected trace_ids
 	ids := make([]string, len(traceIDs))
 	for i, f := range traceIDs {
 		ids[i] = f.TraceID
 	}
 
 	if err := r.db.
+		Scopes(where).
 		Where("trace_id IN ?", ids).
 		Order("created_at DESC").
 		Find(&events).Error; err != nil {
@@ -123,3 +219,124 @@ func (r *eventRepository) ListEventsByFlowIDs(flowIDs []int64, limit, offset int
 
 	return events, totalFlows, nil
 }
+
+func (r *eventRepository) eventFilterScope(filter EventFilter) func(*gorm.DB) *gorm.DB {
+	return func(db *gorm.DB) *gorm.DB {
+		db = db.Where("flow_id IN ? AND trace_id != ''", filter.FlowIDs)
+		if filter.WorkerFlowID != 0 {
+			db = db.Where("worker_flow_id = ?", filter.WorkerFlowID)
+		}
+		if filter.Section != "" {
+			db = db.Where("section = ?", filter.Section)
+		}
+		if filter.ComponentLabel != "" {
+			db = db.Where("component_label = ?", filter.ComponentLabel)
+		}
+		if filter.Type != "" {
+			db = db.Where("type = ?", filter.Type)
+		}
+		if filter.TraceID != "" {
+			db = db.Where("trace_id = ?", filter.TraceID)
+		}
+		if filter.Search != "" {
+			pattern := "%" + likeEscaper.Replace(strings.ToLower(filter.Search)) + "%"
+			db = db.Where(
+				"(LOWER(content) LIKE ? ESCAPE '\\' OR LOWER("+r.metaText()+") LIKE ? ESCAPE '\\')",
+				pattern,
+				pattern,
+			)
+		}
+		if !filter.StartTime.IsZero() {
+			db = db.Where("created_at >= ?", filter.StartTime)
+		}
+		if !filter.EndTime.IsZero() {
+			db = db.Where("created_at <= ?", filter.EndTime)
+		}
+		return db
+	}
+}
+
+var likeEscaper = strings.NewReplacer(`\`, `\\`, `%`, `\%`, `_`, `\_`)
+
+// metaText is the expression reading the binary metadata column as text.
+func (r *eventRepository) metaText() string {
+	if r.db.Dialector.Name() == "postgres" {
+		return "convert_from(meta, 'UTF8')"
+	}
+	return "CAST(meta AS TEXT)"
+}
+
+// ListEventsByTraceID returns the events of the trace in the order they were reported.
+func (r *eventRepository) ListEventsByTraceID(traceID string) ([]*Event, error) {
+	var events []*Event
+	err := r.db.
+		Where("trace_id = ?", traceID).
+		Order("created_at ASC, id ASC").
+		Find(&events).
+		Error
+	if err != nil {
+		return nil, err
+	}
+	return events, nil
+}
+
+// DeleteExpired drops the events of every flow past the retention set on its current version, older than the
+// retention days or beyond the newest retention rows. The retention covers the events of all versions.
+func (r *eventRepository) DeleteExpired() error {
+	var flows []Flow
+	err := r.db.
+		Select("id", "parent_id", "event_retention_days", "event_retention_rows").
+		Where("is_current = true AND (event_retention_days > 0 OR event_retention_rows > 0)").
+		Find(&flows).
+		Error
+	if err != nil {
+		return err
+	}
+
+	for _, flow := range flows {
+		rootID := flow.ID
+		if flow.ParentID != nil {
+			rootID = *flow.ParentID
+		}
+		versions := r.db.Model(&Flow{}).Select("id").Where("id = ? OR parent_id = ?", rootID, rootID)
+
+		if flow.EventRetentionDays > 0 {
+			cutoff := time.Now().AddDate(0, 0, -int(flow.EventRetentionDays))
+			err := r.db.
+				Where("flow_id IN (?) AND created_at < ?", versions, cutoff).
+				Delete(&Event{}).
+				Error
+			if err != nil {
+				return err
+			}
+		}
+
+		if flow.EventRetentionRows > 0 {
+			// The newest event past the limit, it and every older one are dropped.
+			var oldest []Event
+			err := r.db.
+				Select("id").
+				Where("flow_id IN (?)", versions).
+				Order("id DESC").
+				Offset(int(flow.EventRetentionRows)).
+				Limit(1).
+				Find(&oldest).
+				Error
+			if err != nil {
+				return err
+			}
+			if len(oldest) == 0 {
+				continue
+			}
+
+			err = r.db.
+				Where("flow_id IN (?) AND id <= ?", versions, oldest[0].ID).
+				Delete(&Event{}).
+				Error
+			if err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
@@ -0,0 +1,151 @@
+package persistence
+
+import (
+	"fmt"
+	"sync"
+	"testing"
+)
+
+func newTestEvent(key, content string) *Event {
+	event := &Event{
+		WorkerFlowID: 1,
+		FlowID:       1,
+		TraceID:      "trace-1",
+		Section:      "input",
+		Type:         EventTypeConsume,
+		Content:      content,
+		Meta:         []byte(`{}`),
+	}
+	if key != "" {
+		event.Key = &key
+	}
+	return event
+}
+
+func TestEventAddEventsSkipsStoredKeys(t *testing.T) {
+	db := setupTestDB(t)
+	repo := NewEventRepository(db)
+
+	added, err := repo.AddEvents([]*Event{newTestEvent("worker:1:0", "a"), newTestEvent("worker:1:1", "b"), newTestEvent("", "c")})
+	if err != nil {
+		t.Fatalf("AddEvents returned error: %v", err)
+	}
+	if len(added) != 3 {
+		t.Fatalf("expected 3 events added, got %d", len(added))
+	}
+
+	// The batch is sent again after a reconnect, followed by the next batch.
+	added, err = repo.AddEvents([]*Event{
+		newTestEvent("worker:1:0", "a"),
+		newTestEvent("worker:1:1", "b"),
+		newTestEvent("", "c"),
+		newTestEvent("worker:2:0", "d"),
+	})
+	if err != nil {
+		t.Fatalf("AddEvents returned error: %v", err)
+	}
+	if len(added) != 2 {
+		t.Fatalf("expected 2 events added, got %d", len(added))
+	}
+	for _, event := range added {
+		if event.ID == 0 {
+			t.Errorf("expected added event %q to have an id", event.Content)
+		}
+	}
+	if added[0].Content != "c" || added[1].Content != "d" {
+		t.Errorf("expected events c and d to be added, got %q and %q", added[0].Content, added[1].Content)
+	}
+
+	var count int64
+	if err := db.Model(&Event{}).Count(&count).Error; err != nil {
+		t.Fatalf("failed to count events: %v", err)
+	}
+	if count != 5 {
+		t.Errorf("expected 5 stored events, got %d", count)
+	}
+}
+
+func TestEventAddEventsConcurrentResend(t *testing.T) {
+	repo := NewEventRepository(setupTestDB(t))
+
+	// The batch is sent again on a new stream while the first send is still being stored.
+	newBatch := func() []*Event {
+		events := make([]*Event, 250)
+		for i := range events {
+			events[i] = newTestEvent(fmt.Sprintf("worker:1:%d", i), "a")
+		}
+		return events
+	}
+
+	var wg sync.WaitGroup
+	added := make([]int, 2)
+	errs := make([]error, 2)
+	for i := range added {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			events, err := repo.AddEvents(newBatch())
+			added[i], errs[i] = len(events), err
+		}()
+	}
+	wg.Wait()
+
+	for _, err := range errs {
+		if err != nil {
+			t.Fatalf("AddEvents returned error: %v", err)
+		}
+	}
+	if added[0]+added[1] != 250 {
+		t.Errorf("expected 250 events added in total, got %d and %d", added[0], added[1])
+	}
+}
+
+func TestEventListEventsSearchEscapesWildcards(t *testing.T) {
+	repo := NewEventRepository(setupTestDB(t))
+
+	contents := []string{"100% done", "1000 done", "user_id", "userXid", `C:\temp`, `C:temp`}
+	events := make([]*Event, len(contents))
+	for i, content := range contents {
+		events[i] = newTestEvent("", content)
+		events[i].TraceID = content
+	}
+	events[1].Meta = []byte(`{"progress":"100% done"}`)
+	if _, err := repo.AddEvents(events); err != nil {
+		t.Fatalf("AddEvents returned error: %v", err)
+	}
+
+	tests := []struct {
+		search string
+		want   []string
+	}{
+		{search: "100%", want: []string{"100% done", "1000 done"}},
+		{search: "0% D", want: []string{"100% done", "1000 done"}},
+		{search: "r_i", want: []string{"user_id"}},
+		{search: "_", want: []string{"user_id"}},
+		{search: "%", want: []string{"100% done", "1000 done"}},
+		{search: `:\t`, want: []string{`C:\temp`}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.search, func(t *testing.T) {
+			found, total, err := repo.ListEvents(EventFilter{FlowIDs: []int64{1}, Search: tt.search, Limit: 10})
+			if err != nil {
+				t.Fatalf("ListEvents returned error: %v", err)
+			}
+			if total != int64(len(tt.want)) {
+				t.Errorf("expected %d matching traces, got %d", len(tt.want), total)
+			}
+			got := make(map[string]bool, len(found))
+			for _, event := range found {
+				got[event.Content] = true
+			}
+			if len(got) != len(tt.want) {
+				t.Errorf("expected events %v, got %v", tt.want, got)
+			}
+			for _, content := range tt.want {
+				if !got[content] {
+					t.Errorf("expected event %q to match, got %v", content, got)
+				}
+			}
+		})
+	}
+}
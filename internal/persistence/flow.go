@@ -1,7 +1,9 @@
 package persistence
 
 import (
+	"encoding/json"
 	"errors"
+	"strings"
 	"time"
 
 	pb "github.com/sananguliyev/airtruct/internal/protogen"
@@ -37,6 +39,15 @@ type Flow struct {
 	IsCurrent       bool         `json:"is_current" gorm:"default:true"`
 	IsReady         bool         `json:"is_ready" gorm:"default:false"`
 	BuilderState       []byte       `json:"builder_state"`
+	Slug            string       `json:"slug"`
+	Route           string       `json:"route"`
+	RouteMethods    string       `json:"route_methods"`
+	// EventSampleRate is the share of traces whose events are stored, all are when it is nil.
+	EventSampleRate     *float64 `json:"event_sample_rate"`
+	EventMaxContentSize int64    `json:"event_max_content_size"`
+	EventRedactions     string   `json:"event_redactions"`
+	EventRetentionDays  int64    `json:"event_retention_days"`
+	EventRetentionRows  int64    `json:"event_retention_rows"`
 	Status          FlowStatus `json:"status" gorm:"not null"`
 	CreatedAt       time.Time    `json:"created_at" gorm:"not null"`
 	UpdatedAt       *time.Time   `json:"updated_at"`
@@ -73,6 +84,10 @@ func (s *Flow) ToProto() *pb.Flow {
 		UpdatedAt:       updatedAt,
 		IsHttpServer:    s.InputComponent == "http_server",
 		IsMcpTool:       s.InputComponent == "mcp_tool",
+		Slug:            s.Slug,
+		Route:           s.Route,
+		RouteMethods:    s.GetRouteMethods(),
+		EventSettings:   s.GetEventSettings(),
 	}
 
 	for i, processor := range s.Processors {
@@ -102,11 +117,100 @@ func (s *Flow) FromProto(p *pb.Flow) {
 	s.IsCurrent = p.GetIsCurrent()
 	s.IsReady = p.GetIsReady()
 	s.BuilderState = []byte(p.GetBuilderState())
+	s.Slug = p.GetSlug()
+	s.Route = p.GetRoute()
+	s.SetRouteMethods(p.GetRouteMethods())
+	s.SetEventSettings(p.GetEventSettings())
 	s.Status = FlowStatus(p.GetStatus())
 	s.CreatedAt = p.CreatedAt.AsTime()
 	s.UpdatedAt = &updatedAt
 }
 
+// GetRouteMethods returns the HTTP methods accepted on the custom route, none means any method.
+func (s *Flow) GetRouteMethods() []string {
+	if s.RouteMethods == "" {
+		return nil
+	}
+	return strings.Split(s.RouteMethods, ",")
+}
+
+func (s *Flow) SetRouteMethods(methods []string) {
+	normalized := make([]string, 0, len(methods))
+	for _, method := range methods {
+		if method = strings.ToUpper(strings.TrimSpace(method)); method != "" {
+			normalized = append(normalized, method)
+		}
+	}
+	s.RouteMethods = strings.Join(normalized, ",")
+}
+
+// EventRedaction replaces sensitive parts of the content of stored events: the value at Path of JSON content, or
+// every match of Pattern or of the pattern of Preset.
+type EventRedaction struct {
+	Path        string `json:"path,omitempty"`
+	Pattern     string `json:"pattern,omitempty"`
+	Preset      string `json:"preset,omitempty"`
+	Replacement string `json:"replacement,omitempty"`
+}
+
+// GetEventRedactions returns the redactions applied to the events of the flow.
+func (s *Flow) GetEventRedactions() []EventRedaction {
+	if s.EventRedactions == "" {
+		return nil
+	}
+	var redactions []EventRedaction
+	_ = json.Unmarshal([]byte(s.EventRedactions), &redactions)
+	return redactions
+}
+
+func (s *Flow) SetEventRedactions(redactions []EventRedaction) {
+	if len(redactions) == 0 {
+		s.EventRedactions = ""
+		return
+	}
+	data, _ := json.Marshal(redactions)
+	s.EventRedactions = string(data)
+}
+
+func (s *Flow) GetEventSettings() *pb.EventSettings {
+	settings := &pb.EventSettings{
+		SampleRate:     s.EventSampleRate,
+		MaxContentSize: s.EventMaxContentSize,
+		RetentionDays:  s.EventRetentionDays,
+		RetentionRows:  s.EventRetentionRows,
+	}
+	for _, redaction := range s.GetEventRedactions() {
+		settings.Redactions = append(settings.Redactions, &pb.EventSettings_Redaction{
+			Path:        redaction.Path,
+			Pattern:     redaction.Pattern,
+			Preset:      redaction.Preset,
+			Replacement: redaction.Replacement,
+		})
+	}
+	return settings
+}
+
+func (s *Flow) SetEventSettings(settings *pb.EventSettings) {
+	if settings == nil {
+		settings = &pb.EventSettings{}
+	}
+	s.EventSampleRate = settings.SampleRate
+	s.EventMaxContentSize = settings.MaxContentSize
+	s.EventRetentionDays = settings.RetentionDays
+	s.EventRetentionRows = settings.RetentionRows
+
+	redactions := make([]EventRedaction, 0, len(settings.Redactions))
+	for _, redaction := range settings.Redactions {
+		redactions = append(redactions, EventRedaction{
+			Path:        redaction.GetPath(),
+			Pattern:     redaction.GetPattern(),
+			Preset:      redaction.GetPreset(),
+			Replacement: redaction.GetReplacement(),
+		})
+	}
+	s.SetEventRedactions(redactions)
+}
+
 type FlowRepository interface {
 	Create(flow *Flow) error
 	Update(flow *Flow) error
@@ -116,6 +220,7 @@ type FlowRepository interface {
 	ListAllByStatuses(...FlowStatus) ([]Flow, error)
 	ListAllActiveAndNonAssigned() ([]Flow, error)
 	ListAllVersionsByParentID(parentID int64) ([]Flow, error)
+	ListAllCurrentWithRoutes() ([]Flow, error)
 }
 
 type flowRepository struct {
@@ -257,3 +362,14 @@ func (r *flowRepository) ListAllVersionsByParentID(parentID int64) ([]Flow, erro
 	}
 	return flows, nil
 }
+
+func (r *flowRepository) ListAllCurrentWithRoutes() ([]Flow, error) {
+	var flows []Flow
+	err := r.db.
+		Where("is_current = true AND (slug <> '' OR route <> '')").
+		Find(&flows).Error
+	if err != nil {
+		return nil, err
+	}
+	return flows, nil
+}
@@ -0,0 +1,156 @@
+package persistence
+
+import (
+	"time"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// WorkerFlowComponentMetric holds the cumulative counts last reported by a worker for a component of a worker flow.
+type WorkerFlowComponentMetric struct {
+	ID             int64       `json:"id" gorm:"primaryKey"`
+	WorkerFlowID   int64       `json:"worker_flow_id" gorm:"not null;uniqueIndex:idx_worker_flow_component_metrics_component"`
+	Section        FlowSection `json:"section" gorm:"not null;uniqueIndex:idx_worker_flow_component_metrics_component"`
+	ComponentLabel string      `json:"component_label" gorm:"not null;uniqueIndex:idx_worker_flow_component_metrics_component"`
+	Events         int64       `json:"events" gorm:"not null"`
+	Errors         int64       `json:"errors" gorm:"not null"`
+	UpdatedAt      time.Time   `json:"updated_at" gorm:"not null"`
+}
+
+// FlowComponentMetric counts the events and errors of a flow component within the minute starting at Bucket.
+type FlowComponentMetric struct {
+	ID             int64       `json:"id" gorm:"primaryKey"`
+	FlowID         int64       `json:"flow_id" gorm:"not null;uniqueIndex:idx_flow_component_metrics_component_bucket"`
+	Section        FlowSection `json:"section" gorm:"not null;uniqueIndex:idx_flow_component_metrics_component_bucket"`
+	ComponentLabel string      `json:"component_label" gorm:"not null;uniqueIndex:idx_flow_component_metrics_component_bucket"`
+	Bucket         time.Time   `json:"bucket" gorm:"not null;uniqueIndex:idx_flow_component_metrics_component_bucket"`
+	Events         int64       `json:"events" gorm:"not null"`
+	Errors         int64       `json:"errors" gorm:"not null"`
+}
+
+// flowComponentMetricRetention is how long component counters are kept, the longest range they are requested for.
+const flowComponentMetricRetention = 30 * 24 * time.Hour
+
+type FlowComponentMetricRepository interface {
+	Record(flowID, workerFlowID int64, reported []WorkerFlowComponentMetric) error
+	ListByFlowIDs(flowIDs []int64, since time.Time) ([]FlowComponentMetric, error)
+	DeleteExpired() error
+}
+
+type flowComponentMetricRepository struct {
+	db  *gorm.DB
+	now func() time.Time
+}
+
+func NewFlowComponentMetricRepository(db *gorm.DB) FlowComponentMetricRepository {
+	return &flowComponentMetricRepository{db: db, now: time.Now}
+}
+
+// Record stores the cumulative counts reported for the components of a worker flow and adds the increase since
+// the previous report to the counters of the current minute.
+func (r *flowComponentMetricRepository) Record(flowID, workerFlowID int64, reported []WorkerFlowComponentMetric) error {
+	if len(reported) == 0 {
+		return nil
+	}
+
+	now := r.now()
+	bucket := now.UTC().Truncate(time.Minute)
+
+	return r.db.Transaction(func(tx *gorm.DB) error {
+		var previous []WorkerFlowComponentMetric
+		if err := tx.Where("worker_flow_id = ?", workerFlowID).Find(&previous).Error; err != nil {
+			return err
+		}
+
+		type componentKey struct {
+			section FlowSection
+			label   string
+		}
+		last := make(map[componentKey]WorkerFlowComponentMetric, len(previous))
+		for _, metric := range previous {
+			last[componentKey{metric.Section, metric.ComponentLabel}] = metric
+		}
+
+		current := make([]*WorkerFlowComponentMetric, 0, len(reported))
+		counters := make([]*FlowComponentMetric, 0, len(reported))
+		for _, metric := range reported {
+			prev := last[componentKey{metric.Section, metric.ComponentLabel}]
+			events, errors := metric.Events-prev.Events, metric.Errors-prev.Errors
+			// Counts only grow while a worker flow runs, lower ones are stale reports.
+			if events < 0 || errors < 0 || events+errors == 0 {
+				continue
+			}
+
+			current = append(current, &WorkerFlowComponentMetric{
+				WorkerFlowID:   workerFlowID,
+				Section:        metric.Section,
+				ComponentLabel: metric.ComponentLabel,
+				Events:         metric.Events,
+				Errors:         metric.Errors,
+				UpdatedAt:      now,
+			})
+			counters = append(counters, &FlowComponentMetric{
+				FlowID:         flowID,
+				Section:        metric.Section,
+				ComponentLabel: metric.ComponentLabel,
+				Bucket:         bucket,
+				Events:         events,
+				Errors:         errors,
+			})
+		}
+		if len(current) == 0 {
+			return nil
+		}
+
+		err := tx.
+			Clauses(clause.OnConflict{
+				Columns:   []clause.Column{{Name: "worker_flow_id"}, {Name: "section"}, {Name: "component_label"}},
+				DoUpdates: clause.AssignmentColumns([]string{"events", "errors", "updated_at"}),
+			}).
+			Create(current).
+			Error
+		if err != nil {
+			return err
+		}
+
+		return tx.
+			Clauses(clause.OnConflict{
+				Columns: []clause.Column{{Name: "flow_id"}, {Name: "section"}, {Name: "component_label"}, {Name: "bucket"}},
+				DoUpdates: clause.Assignments(map[string]any{
+					"events": gorm.Expr("flow_component_metrics.events + excluded.events"),
+					"errors": gorm.Expr("flow_component_metrics.errors + excluded.errors"),
+				}),
+			}).
+			Create(counters).
+			Error
+	})
+}
+
+func (r *flowComponentMetricRepository) ListByFlowIDs(flowIDs []int64, since time.Time) ([]FlowComponentMetric, error) {
+	var metrics []FlowComponentMetric
+	err := r.db.
+		Where("flow_id IN ? AND bucket >= ?", flowIDs, since).
+		Order("bucket ASC").
+		Find(&metrics).
+		Error
+	return metrics, err
+}
+
+// DeleteExpired drops the counters past their retention, and the last reports of worker flows that stopped
+// reporting as long ago.
+func (r *flowComponentMetricRepository) DeleteExpired() error {
+	cutoff := r.now().UTC().Add(-flowComponentMetricRetention)
+	err := r.db.
+		Where("bucket < ?", cutoff).
+		Delete(&FlowComponentMetric{}).
+		Error
+	if err != nil {
+		return err
+	}
+
+	return r.db.
+		Where("updated_at < ?", cutoff).
+		Delete(&WorkerFlowComponentMetric{}).
+		Error
+}
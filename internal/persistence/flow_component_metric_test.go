@@ -0,0 +1,121 @@
+package persistence
+
+import (
+	"database/sql"
+	"testing"
+	"time"
+
+	"gorm.io/driver/sqlite"
+	"gorm.io/gorm"
+	_ "modernc.org/sqlite"
+)
+
+func setupTestDB(t *testing.T) *gorm.DB {
+	sqlDB, err := sql.Open("sqlite", ":memory:")
+	if err != nil {
+		t.Fatalf("failed to open sqlite connection: %v", err)
+	}
+	// Every connection opens its own in-memory database.
+	sqlDB.SetMaxOpenConns(1)
+	db, err := gorm.Open(sqlite.New(sqlite.Config{Conn: sqlDB}), &gorm.Config{})
+	if err != nil {
+		t.Fatalf("failed to open test database: %v", err)
+	}
+
+	err = db.AutoMigrate(&WorkerFlow{}, &FlowMetric{}, &WorkerFlowComponentMetric{}, &FlowComponentMetric{}, &Event{})
+	if err != nil {
+		t.Fatalf("failed to migrate test database: %v", err)
+	}
+	return db
+}
+
+func TestFlowComponentMetricRecordCountsIncreases(t *testing.T) {
+	db := setupTestDB(t)
+	repo := &flowComponentMetricRepository{db: db}
+	minute := time.Date(2026, 3, 1, 10, 15, 0, 0, time.UTC)
+
+	record := func(at time.Time, events, errors int64) {
+		t.Helper()
+		repo.now = func() time.Time { return at }
+		err := repo.Record(1, 7, []WorkerFlowComponentMetric{
+			{Section: FlowSectionPipeline, ComponentLabel: "mapping", Events: events, Errors: errors},
+		})
+		if err != nil {
+			t.Fatalf("failed to record metrics: %v", err)
+		}
+	}
+
+	record(minute.Add(10*time.Second), 5, 1)
+	record(minute.Add(59*time.Second), 8, 1)
+	// A stale report with lower counts is ignored.
+	record(minute.Add(59*time.Second+500*time.Millisecond), 6, 1)
+	record(minute.Add(time.Minute), 10, 2)
+
+	metrics, err := repo.ListByFlowIDs([]int64{1}, minute.Add(-time.Hour))
+	if err != nil {
+		t.Fatalf("failed to list metrics: %v", err)
+	}
+	if len(metrics) != 2 {
+		t.Fatalf("expected 2 minute buckets, got %d", len(metrics))
+	}
+	if !metrics[0].Bucket.Equal(minute) || metrics[0].Events != 8 || metrics[0].Errors != 1 {
+		t.Errorf("unexpected first bucket: %+v", metrics[0])
+	}
+	if !metrics[1].Bucket.Equal(minute.Add(time.Minute)) || metrics[1].Events != 2 || metrics[1].Errors != 1 {
+		t.Errorf("unexpected second bucket: %+v", metrics[1])
+	}
+}
+
+func TestFlowComponentMetricRecordKeepsComponentsApart(t *testing.T) {
+	db := setupTestDB(t)
+	repo := &flowComponentMetricRepository{db: db, now: func() time.Time {
+		return time.Date(2026, 3, 1, 10, 15, 30, 0, time.UTC)
+	}}
+
+	err := repo.Record(1, 7, []WorkerFlowComponentMetric{
+		{Section: FlowSectionInput, ComponentLabel: "in", Events: 3},
+		{Section: FlowSectionOutput, ComponentLabel: "in", Events: 2},
+		{Section: FlowSectionPipeline, ComponentLabel: "idle"},
+	})
+	if err != nil {
+		t.Fatalf("failed to record metrics: %v", err)
+	}
+	// Another worker flow of the same flow starts its counts from zero.
+	if err := repo.Record(1, 8, []WorkerFlowComponentMetric{{Section: FlowSectionInput, ComponentLabel: "in", Events: 4}}); err != nil {
+		t.Fatalf("failed to record metrics: %v", err)
+	}
+
+	metrics, err := repo.ListByFlowIDs([]int64{1}, time.Time{})
+	if err != nil {
+		t.Fatalf("failed to list metrics: %v", err)
+	}
+	counts := map[FlowSection]int64{}
+	for _, metric := range metrics {
+		counts[metric.Section] += metric.Events
+	}
+	if len(metrics) != 2 || counts[FlowSectionInput] != 7 || counts[FlowSectionOutput] != 2 {
+		t.Errorf("unexpected metrics: %+v", metrics)
+	}
+}
+
+func TestFlowComponentMetricDeleteExpired(t *testing.T) {
+	db := setupTestDB(t)
+	now := time.Date(2026, 3, 1, 10, 15, 0, 0, time.UTC)
+	repo := &flowComponentMetricRepository{db: db, now: func() time.Time { return now }}
+
+	old := now.Add(-flowComponentMetricRetention - time.Minute)
+	db.Create(&FlowComponentMetric{FlowID: 1, Section: FlowSectionInput, ComponentLabel: "in", Bucket: old, Events: 1})
+	db.Create(&FlowComponentMetric{FlowID: 1, Section: FlowSectionInput, ComponentLabel: "in", Bucket: now, Events: 1})
+	db.Create(&WorkerFlowComponentMetric{WorkerFlowID: 7, Section: FlowSectionInput, ComponentLabel: "in", Events: 1, UpdatedAt: old})
+
+	if err := repo.DeleteExpired(); err != nil {
+		t.Fatalf("failed to delete expired metrics: %v", err)
+	}
+
+	var counters, reports int64
+	db.Model(&FlowComponentMetric{}).Count(&counters)
+	db.Model(&WorkerFlowComponentMetric{}).Count(&reports)
+	if counters != 1 || reports != 0 {
+		t.Errorf("expected 1 counter and no reports left, got %d and %d", counters, reports)
+	}
+}
@@ -0,0 +1,147 @@
+package persistence
+
+import (
+	"encoding/json"
+	"slices"
+	"strings"
+	"time"
+
+	pb "github.com/sananguliyev/airtruct/internal/protogen"
+	"google.golang.org/protobuf/types/known/structpb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+	"gorm.io/gorm"
+)
+
+// FlowLogLevels are the levels of log lines from the lowest to the highest.
+var FlowLogLevels = []string{"trace", "debug", "info", "warn", "error", "fatal", "panic"}
+
+// FlowLogLevelsFrom returns the level and the levels above it, or nil when level is empty or unknown.
+func FlowLogLevelsFrom(level string) []string {
+	i := slices.Index(FlowLogLevels, level)
+	if i == -1 {
+		return nil
+	}
+	return FlowLogLevels[i:]
+}
+
+type FlowLog struct {
+	ID           int64  `json:"id" gorm:"primaryKey"`
+	FlowID       int64  `json:"flow_id" gorm:"not null"`
+	WorkerFlowID int64  `json:"worker_flow_id" gorm:"not null"`
+	WorkerID     string `json:"worker_id" gorm:"not null"`
+	Level        string `json:"level" gorm:"not null"`
+	Message      string `json:"message" gorm:"not null"`
+	// Fields holds the fields of the line as a JSON object, it is empty when the line has none.
+	Fields    string    `json:"fields" gorm:"not null"`
+	CreatedAt time.Time `json:"created_at" gorm:"not null"`
+}
+
+func (l *FlowLog) ToProto() *pb.FlowLog {
+	var fields map[string]any
+	if l.Fields != "" {
+		_ = json.Unmarshal([]byte(l.Fields), &fields)
+	}
+	fieldsStruct, _ := structpb.NewStruct(fields)
+
+	return &pb.FlowLog{
+		Id:           l.ID,
+		WorkerFlowId: l.WorkerFlowID,
+		WorkerId:     l.WorkerID,
+		Level:        l.Level,
+		Message:      l.Message,
+		Fields:       fieldsStruct,
+		CreatedAt:    timestamppb.New(l.CreatedAt),
+	}
+}
+
+type FlowLogFilter struct {
+	FlowIDs      []int64
+	WorkerID     string
+	WorkerFlowID int64
+	// MinLevel selects the lines of the level and above.
+	MinLevel string
+	// Search is matched case-insensitively against the message and fields.
+	Search    string
+	StartTime time.Time
+	EndTime   time.Time
+	Limit     int
+	Offset    int
+}
+
+type FlowLogRepository interface {
+	AddLogs(logs []*FlowLog) error
+	List(filter FlowLogFilter) ([]FlowLog, int64, error)
+	DeleteExpired() error
+}
+
+type flowLogRepository struct {
+	db        *gorm.DB
+	retention time.Duration
+}
+
+// NewFlowLogRepository stores the log lines of flows, lines older than retention are dropped, a zero retention keeps
+// them forever.
+func NewFlowLogRepository(db *gorm.DB, retention time.Duration) FlowLogRepository {
+	return &flowLogRepository{db: db, retention: retention}
+}
+
+// flowLogInsertBatchSize keeps the parameters of a bulk insert within the limits of the database drivers.
+const flowLogInsertBatchSize = 100
+
+func (r *flowLogRepository) AddLogs(logs []*FlowLog) error {
+	if len(logs) == 0 {
+		return nil
+	}
+	return r.db.CreateInBatches(logs, flowLogInsertBatchSize).Error
+}
+
+func (r *flowLogRepository) List(filter FlowLogFilter) ([]FlowLog, int64, error) {
+	query := r.db.Model(&FlowLog{}).Where("flow_id IN ?", filter.FlowIDs)
+	if filter.WorkerID != "" {
+		query = query.Where("worker_id = ?", filter.WorkerID)
+	}
+	if filter.WorkerFlowID != 0 {
+		query = query.Where("worker_flow_id = ?", filter.WorkerFlowID)
+	}
+	if levels := FlowLogLevelsFrom(filter.MinLevel); levels != nil {
+		query = query.Where("level IN ?", levels)
+	}
+	if filter.Search != "" {
+		pattern := "%" + likeEscaper.Replace(strings.ToLower(filter.Search)) + "%"
+		query = query.Where("(LOWER(message) LIKE ? ESCAPE '\\' OR LOWER(fields) LIKE ? ESCAPE '\\')", pattern, pattern)
+	}
+	if !filter.StartTime.IsZero() {
+		query = query.Where("created_at >= ?", filter.StartTime)
+	}
+	if !filter.EndTime.IsZero() {
+		query = query.Where("created_at <= ?", filter.EndTime)
+	}
+
+	var total int64
+	if err := query.Count(&total).Error; err != nil {
+		return nil, 0, err
+	}
+
+	var logs []FlowLog
+	err := query.
+		Order("created_at DESC, id DESC").
+		Limit(filter.Limit).
+		Offset(filter.Offset).
+		Find(&logs).
+		Error
+	if err != nil {
+		return nil, 0, err
+	}
+	return logs, total, nil
+}
+
+// DeleteExpired drops the lines past the retention.
+func (r *flowLogRepository) DeleteExpired() error {
+	if r.retention <= 0 {
+		return nil
+	}
+	return r.db.
+		Where("created_at < ?", time.Now().Add(-r.retention)).
+		Delete(&FlowLog{}).
+		Error
+}
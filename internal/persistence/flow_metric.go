@@ -0,0 +1,162 @@
+package persistence
+
+import (
+	"time"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+type FlowMetricResolution string
+
+const (
+	FlowMetricResolutionMinute FlowMetricResolution = "minute"
+	FlowMetricResolutionHour   FlowMetricResolution = "hour"
+	FlowMetricResolutionDay    FlowMetricResolution = "day"
+)
+
+// Width returns the time covered by a bucket of the resolution.
+func (r FlowMetricResolution) Width() time.Duration {
+	switch r {
+	case FlowMetricResolutionMinute:
+		return time.Minute
+	case FlowMetricResolutionHour:
+		return time.Hour
+	default:
+		return 24 * time.Hour
+	}
+}
+
+// Retention returns how long buckets of the resolution are kept.
+func (r FlowMetricResolution) Retention() time.Duration {
+	switch r {
+	case FlowMetricResolutionMinute:
+		return 48 * time.Hour
+	case FlowMetricResolutionHour:
+		return 35 * 24 * time.Hour
+	default:
+		return 400 * 24 * time.Hour
+	}
+}
+
+var flowMetricResolutions = []FlowMetricResolution{
+	FlowMetricResolutionMinute,
+	FlowMetricResolutionHour,
+	FlowMetricResolutionDay,
+}
+
+// FlowMetric holds the events a flow version handled within the bucket of the given resolution starting at Bucket.
+type FlowMetric struct {
+	ID              int64                `json:"id" gorm:"primaryKey"`
+	FlowID          int64                `json:"flow_id" gorm:"not null;uniqueIndex:idx_flow_metrics_flow_resolution_bucket"`
+	Resolution      FlowMetricResolution `json:"resolution" gorm:"not null;uniqueIndex:idx_flow_metrics_flow_resolution_bucket"`
+	Bucket          time.Time            `json:"bucket" gorm:"not null;uniqueIndex:idx_flow_metrics_flow_resolution_bucket"`
+	InputEvents     int64                `json:"input_events" gorm:"not null"`
+	ProcessorErrors int64                `json:"processor_errors" gorm:"not null"`
+	OutputEvents    int64                `json:"output_events" gorm:"not null"`
+}
+
+type FlowMetricRepository interface {
+	Record(workerFlowID int64, inputEvents, processorErrors, outputEvents uint64) error
+	List(flowIDs []int64, resolution FlowMetricResolution, since time.Time) ([]FlowMetric, error)
+	DeleteExpired() error
+}
+
+type flowMetricRepository struct {
+	db  *gorm.DB
+	now func() time.Time
+}
+
+func NewFlowMetricRepository(db *gorm.DB) FlowMetricRepository {
+	return &flowMetricRepository{db: db, now: time.Now}
+}
+
+// Record stores the cumulative counters reported for a worker flow and adds the increase since the previous
+// report to the minute, hour and day buckets of its flow version.
+func (r *flowMetricRepository) Record(workerFlowID int64, inputEvents, processorErrors, outputEvents uint64) error {
+	now := r.now()
+
+	return r.db.Transaction(func(tx *gorm.DB) error {
+		var workerFlow WorkerFlow
+		if err := tx.First(&workerFlow, workerFlowID).Error; err != nil {
+			return err
+		}
+
+		// Counters only grow while a worker flow runs, lower ones are stale reports.
+		input := int64(inputEvents) - int64(workerFlow.InputEvents)
+		errors := int64(processorErrors) - int64(workerFlow.ProcessorErrors)
+		output := int64(outputEvents) - int64(workerFlow.OutputEvents)
+		if input < 0 || errors < 0 || output < 0 || input+errors+output == 0 {
+			return nil
+		}
+
+		err := tx.
+			Model(&WorkerFlow{}).
+			Where("id = ?", workerFlowID).
+			Updates(map[string]any{
+				"input_events":     inputEvents,
+				"processor_errors": processorErrors,
+				"output_events":    outputEvents,
+				"updated_at":       now,
+			}).
+			Error
+		if err != nil {
+			return err
+		}
+
+		metrics := make([]*FlowMetric, 0, len(flowMetricResolutions))
+		for _, resolution := range flowMetricResolutions {
+			metrics = append(metrics, &FlowMetric{
+				FlowID:          workerFlow.FlowID,
+				Resolution:      resolution,
+				Bucket:          now.UTC().Truncate(resolution.Width()),
+				InputEvents:     input,
+				ProcessorErrors: errors,
+				OutputEvents:    output,
+			})
+		}
+
+		return tx.
+			Clauses(clause.OnConflict{
+				Columns: []clause.Column{{Name: "flow_id"}, {Name: "resolution"}, {Name: "bucket"}},
+				DoUpdates: clause.Assignments(map[string]any{
+					"input_events":     gorm.Expr("flow_metrics.input_events + excluded.input_events"),
+					"processor_errors": gorm.Expr("flow_metrics.processor_errors + excluded.processor_errors"),
+					"output_events":    gorm.Expr("flow_metrics.output_events + excluded.output_events"),
+				}),
+			}).
+			Create(metrics).
+			Error
+	})
+}
+
+// List returns the buckets of the resolution since the given time, of the given flow versions or of all flows
+// when flowIDs is empty.
+func (r *flowMetricRepository) List(flowIDs []int64, resolution FlowMetricResolution, since time.Time) ([]FlowMetric, error) {
+	query := r.db.Where("resolution = ? AND bucket >= ?", resolution, since.UTC())
+	if len(flowIDs) > 0 {
+		query = query.Where("flow_id IN ?", flowIDs)
+	}
+
+	var metrics []FlowMetric
+	err := query.
+		Order("bucket ASC").
+		Find(&metrics).
+		Error
+	return metrics, err
+}
+
+// DeleteExpired drops the buckets past the retention of their resolution.
+func (r *flowMetricRepository) DeleteExpired() error {
+	for _, resolution := range flowMetricResolutions {
+		cutoff := r.now().UTC().Add(-resolution.Retention())
+		err := r.db.
+			Where("resolution = ? AND bucket < ?", resolution, cutoff).
+			Delete(&FlowMetric{}).
+			Error
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -0,0 +1,132 @@
+package persistence
+
+import (
+	"testing"
+	"time"
+)
+
+func createTestWorkerFlow(t *testing.T, repo *flowMetricRepository, flowID int64) int64 {
+	t.Helper()
+	workerFlow := &WorkerFlow{WorkerID: "worker-1", FlowID: flowID, Status: WorkerFlowStatusRunning, CreatedAt: time.Now()}
+	if err := repo.db.Create(workerFlow).Error; err != nil {
+		t.Fatalf("failed to create worker flow: %v", err)
+	}
+	return workerFlow.ID
+}
+
+func TestFlowMetricRecordRollupBoundaries(t *testing.T) {
+	repo := &flowMetricRepository{db: setupTestDB(t)}
+	workerFlowID := createTestWorkerFlow(t, repo, 1)
+
+	// The last second of a day, and the first second of the next one.
+	lastSecond := time.Date(2026, 3, 1, 23, 59, 59, 0, time.UTC)
+	reports := []struct {
+		at     time.Time
+		input  uint64
+		output uint64
+	}{
+		{at: lastSecond.Add(-time.Minute), input: 1, output: 1},
+		{at: lastSecond, input: 3, output: 2},
+		{at: lastSecond.Add(time.Second), input: 7, output: 5},
+	}
+	for _, report := range reports {
+		repo.now = func() time.Time { return report.at }
+		if err := repo.Record(workerFlowID, report.input, 0, report.output); err != nil {
+			t.Fatalf("failed to record metrics: %v", err)
+		}
+	}
+
+	tests := []struct {
+		resolution FlowMetricResolution
+		want       map[time.Time][2]int64
+	}{
+		{
+			resolution: FlowMetricResolutionMinute,
+			want: map[time.Time][2]int64{
+				time.Date(2026, 3, 1, 23, 58, 0, 0, time.UTC): {1, 1},
+				time.Date(2026, 3, 1, 23, 59, 0, 0, time.UTC): {2, 1},
+				time.Date(2026, 3, 2, 0, 0, 0, 0, time.UTC):   {4, 3},
+			},
+		},
+		{
+			resolution: FlowMetricResolutionHour,
+			want: map[time.Time][2]int64{
+				time.Date(2026, 3, 1, 23, 0, 0, 0, time.UTC): {3, 2},
+				time.Date(2026, 3, 2, 0, 0, 0, 0, time.UTC):  {4, 3},
+			},
+		},
+		{
+			resolution: FlowMetricResolutionDay,
+			want: map[time.Time][2]int64{
+				time.Date(2026, 3, 1, 0, 0, 0, 0, time.UTC): {3, 2},
+				time.Date(2026, 3, 2, 0, 0, 0, 0, time.UTC): {4, 3},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(string(tt.resolution), func(t *testing.T) {
+			metrics, err := repo.List([]int64{1}, tt.resolution, time.Time{})
+			if err != nil {
+				t.Fatalf("failed to list metrics: %v", err)
+			}
+			if len(metrics) != len(tt.want) {
+				t.Fatalf("expected %d buckets, got %d: %+v", len(tt.want), len(metrics), metrics)
+			}
+			for _, metric := range metrics {
+				want, ok := tt.want[metric.Bucket.UTC()]
+				if !ok {
+					t.Errorf("unexpected bucket %s", metric.Bucket)
+					continue
+				}
+				if metric.InputEvents != want[0] || metric.OutputEvents != want[1] {
+					t.Errorf("bucket %s: expected %d input and %d output events, got %d and %d",
+						metric.Bucket, want[0], want[1], metric.InputEvents, metric.OutputEvents)
+				}
+			}
+		})
+	}
+}
+
+func TestFlowMetricRecordIgnoresStaleReports(t *testing.T) {
+	now := time.Date(2026, 3, 1, 10, 15, 0, 0, time.UTC)
+	repo := &flowMetricRepository{db: setupTestDB(t), now: func() time.Time { return now }}
+	workerFlowID := createTestWorkerFlow(t, repo, 1)
+
+	for _, input := range []uint64{5, 3, 5, 6} {
+		if err := repo.Record(workerFlowID, input, 0, 0); err != nil {
+			t.Fatalf("failed to record metrics: %v", err)
+		}
+	}
+
+	metrics, err := repo.List(nil, FlowMetricResolutionMinute, time.Time{})
+	if err != nil {
+		t.Fatalf("failed to list metrics: %v", err)
+	}
+	if len(metrics) != 1 || metrics[0].InputEvents != 6 {
+		t.Errorf("expected 6 input events in one bucket, got %+v", metrics)
+	}
+}
+
+func TestFlowMetricDeleteExpired(t *testing.T) {
+	db := setupTestDB(t)
+	now := time.Date(2026, 3, 1, 10, 15, 0, 0, time.UTC)
+	repo := &flowMetricRepository{db: db, now: func() time.Time { return now }}
+
+	for _, resolution := range flowMetricResolutions {
+		db.Create(&FlowMetric{FlowID: 1, Resolution: resolution, Bucket: now.Add(-resolution.Retention() - resolution.Width())})
+		db.Create(&FlowMetric{FlowID: 1, Resolution: resolution, Bucket: now.Add(-resolution.Retention() + resolution.Width())})
+	}
+
+	if err := repo.DeleteExpired(); err != nil {
+		t.Fatalf("failed to delete expired metrics: %v", err)
+	}
+
+	for _, resolution := range flowMetricResolutions {
+		var count int64
+		db.Model(&FlowMetric{}).Where("resolution = ?", resolution).Count(&count)
+		if count != 1 {
+			t.Errorf("expected 1 %s bucket within the retention, got %d", resolution, count)
+		}
+	}
+}
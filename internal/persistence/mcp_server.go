@@ -0,0 +1,169 @@
+package persistence
+
+import (
+	"errors"
+	"time"
+
+	pb "github.com/sananguliyev/airtruct/internal/protogen"
+	"google.golang.org/protobuf/types/known/timestamppb"
+	"gorm.io/gorm"
+)
+
+type MCPServer struct {
+	ID        int64      `json:"id" gorm:"primaryKey"`
+	ParentID  *int64     `json:"parent_id"`
+	Label     string     `json:"label" gorm:"not null"`
+	Component string     `json:"component" gorm:"not null"`
+	Config    []byte     `json:"config" gorm:"not null"`
+	IsCurrent bool       `json:"is_current" gorm:"default:true"`
+	CreatedAt time.Time  `json:"created_at" gorm:"not null"`
+	UpdatedAt *time.Time `json:"updated_at"`
+
+	ParentMCPServer *MCPServer `json:"parent_mcp_server" gorm:"foreignKey:ParentID"`
+}
+
+func (s *MCPServer) ToProto() *pb.McpServer {
+	var updatedAt *timestamppb.Timestamp
+	if s.UpdatedAt != nil {
+		updatedAt = timestamppb.New(*s.UpdatedAt)
+	}
+
+	return &pb.McpServer{
+		Id:        s.ID,
+		ParentId:  s.ParentID,
+		Label:     s.Label,
+		Component: s.Component,
+		Config:    string(s.Config),
+		IsCurrent: s.IsCurrent,
+		CreatedAt: timestamppb.New(s.CreatedAt),
+		UpdatedAt: updatedAt,
+	}
+}
+
+func (s *MCPServer) FromProto(p *pb.McpServer) {
+	var updatedAt *time.Time
+	if p.GetUpdatedAt() != nil {
+		t := p.GetUpdatedAt().AsTime()
+		updatedAt = &t
+	}
+
+	s.ID = p.Id
+	s.ParentID = p.ParentId
+	s.Label = p.Label
+	s.Component = p.Component
+	s.Config = []byte(p.Config)
+	s.IsCurrent = p.GetIsCurrent()
+	s.CreatedAt = p.CreatedAt.AsTime()
+	s.UpdatedAt = updatedAt
+}
+
+type MCPServerRepository interface {
+	Create(server *MCPServer) error
+	Update(server *MCPServer) error
+	FindByID(id int64) (*MCPServer, error)
+	FindByLabel(label string) (*MCPServer, error)
+	Delete(id int64) error
+	ListAll() ([]MCPServer, error)
+}
+
+type mcpServerRepository struct {
+	db *gorm.DB
+}
+
+func NewMCPServerRepository(db *gorm.DB) MCPServerRepository {
+	return &mcpServerRepository{db: db}
+}
+
+func (r *mcpServerRepository) Create(server *MCPServer) error {
+	server.CreatedAt = time.Now()
+	server.IsCurrent = true
+	return r.db.Create(server).Error
+}
+
+func (r *mcpServerRepository) Update(server *MCPServer) error {
+	var err error
+
+	tx := r.db.Begin()
+	defer func() {
+		if r := recover(); r != nil {
+			tx.Rollback()
+		}
+	}()
+
+	if err := tx.Error; err != nil {
+		return err
+	}
+
+	err = tx.
+		Model(&MCPServer{}).
+		Where("id = ?", server.ID).
+		Update("is_current", false).Error
+	if err != nil {
+		tx.Rollback()
+		return err
+	}
+
+	var id = server.ID
+	server.CreatedAt = time.Now()
+	if server.ParentID == nil {
+		server.ParentID = &id
+	}
+	server.ID = 0
+	server.IsCurrent = true
+
+	if err = tx.Create(server).Error; err != nil {
+		tx.Rollback()
+		return err
+	}
+
+	return tx.Commit().Error
+}
+
+func (r *mcpServerRepository) FindByID(id int64) (*MCPServer, error) {
+	var server = &MCPServer{
+		ID: id,
+	}
+	err := r.db.
+		Preload("ParentMCPServer").
+		First(server).
+		Error
+	if err != nil && !errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, err
+	} else if errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, nil
+	}
+
+	return server, nil
+}
+
+func (r *mcpServerRepository) FindByLabel(label string) (*MCPServer, error) {
+	var server MCPServer
+	err := r.db.
+		Where("label = ? AND is_current = true", label).
+		First(&server).
+		Error
+	if err != nil && !errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, err
+	} else if errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, nil
+	}
+
+	return &server, nil
+}
+
+func (r *mcpServerRepository) Delete(id int64) error {
+	return r.db.Delete(&MCPServer{}, id).Error
+}
+
+func (r *mcpServerRepository) ListAll() ([]MCPServer, error) {
+	var servers []MCPServer
+	err := r.db.
+		Where("is_current = true").
+		Order("created_at DESC").
+		Find(&servers).
+		Error
+	if err != nil {
+		return nil, err
+	}
+	return servers, nil
+}
@@ -0,0 +1,119 @@
+package persistence
+
+import (
+	"time"
+
+	pb "github.com/sananguliyev/airtruct/internal/protogen"
+	"google.golang.org/protobuf/types/known/timestamppb"
+	"gorm.io/gorm"
+)
+
+type MCPToolCallStatus string
+
+const (
+	MCPToolCallStatusSuccess   MCPToolCallStatus = "success"
+	MCPToolCallStatusError     MCPToolCallStatus = "error"
+	MCPToolCallStatusTimeout   MCPToolCallStatus = "timeout"
+	MCPToolCallStatusCancelled MCPToolCallStatus = "cancelled"
+	MCPToolCallStatusDenied    MCPToolCallStatus = "denied"
+)
+
+type MCPToolCall struct {
+	ID            int64             `json:"id" gorm:"primaryKey"`
+	Tool          string            `json:"tool" gorm:"not null"`
+	FlowID        *int64            `json:"flow_id"`
+	FlowVersionID *int64            `json:"flow_version_id"`
+	MCPServerID   *int64            `json:"mcp_server_id"`
+	SessionID     string            `json:"session_id"`
+	ClientName    string            `json:"client_name"`
+	ClientVersion string            `json:"client_version"`
+	ArgumentsHash string            `json:"arguments_hash" gorm:"not null"`
+	Arguments     string            `json:"arguments" gorm:"not null"`
+	Status        MCPToolCallStatus `json:"status" gorm:"not null"`
+	Error         string            `json:"error"`
+	LatencyMs     int64             `json:"latency_ms" gorm:"not null"`
+	CreatedAt     time.Time         `json:"created_at" gorm:"not null"`
+}
+
+func (c *MCPToolCall) ToProto() *pb.McpToolCall {
+	return &pb.McpToolCall{
+		Id:            c.ID,
+		Tool:          c.Tool,
+		FlowId:        c.FlowID,
+		FlowVersionId: c.FlowVersionID,
+		McpServerId:   c.MCPServerID,
+		SessionId:     c.SessionID,
+		ClientName:    c.ClientName,
+		ClientVersion: c.ClientVersion,
+		ArgumentsHash: c.ArgumentsHash,
+		Arguments:     c.Arguments,
+		Status:        string(c.Status),
+		Error:         c.Error,
+		LatencyMs:     c.LatencyMs,
+		CreatedAt:     timestamppb.New(c.CreatedAt),
+	}
+}
+
+type MCPToolCallFilter struct {
+	Tool      string
+	FlowID    int64
+	Status    string
+	StartTime time.Time
+	EndTime   time.Time
+	Limit     int
+	Offset    int
+}
+
+type MCPToolCallRepository interface {
+	Create(call *MCPToolCall) error
+	List(filter MCPToolCallFilter) ([]MCPToolCall, int64, error)
+}
+
+type mcpToolCallRepository struct {
+	db *gorm.DB
+}
+
+func NewMCPToolCallRepository(db *gorm.DB) MCPToolCallRepository {
+	return &mcpToolCallRepository{db: db}
+}
+
+func (r *mcpToolCallRepository) Create(call *MCPToolCall) error {
+	call.CreatedAt = time.Now()
+	return r.db.Create(call).Error
+}
+
+func (r *mcpToolCallRepository) List(filter MCPToolCallFilter) ([]MCPToolCall, int64, error) {
+	query := r.db.Model(&MCPToolCall{})
+	if filter.Tool != "" {
+		query = query.Where("tool = ?", filter.Tool)
+	}
+	if filter.FlowID != 0 {
+		query = query.Where("flow_id = ?", filter.FlowID)
+	}
+	if filter.Status != "" {
+		query = query.Where("status = ?", filter.Status)
+	}
+	if !filter.StartTime.IsZero() {
+		query = query.Where("created_at >= ?", filter.StartTime)
+	}
+	if !filter.EndTime.IsZero() {
+		query = query.Where("created_at <= ?", filter.EndTime)
+	}
+
+	var total int64
+	if err := query.Count(&total).Error; err != nil {
+		return nil, 0, err
+	}
+
+	var calls []MCPToolCall
+	err := query.
+		Order("created_at DESC").
+		Limit(filter.Limit).
+		Offset(filter.Offset).
+		Find(&calls).
+		Error
+	if err != nil {
+		return nil, 0, err
+	}
+	return calls, total, nil
+}
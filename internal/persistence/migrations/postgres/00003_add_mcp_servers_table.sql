@@ -0,0 +1,12 @@
+CREATE TABLE IF NOT EXISTS mcp_servers (
+    id bigserial PRIMARY KEY,
+    parent_id bigint,
+    label text NOT NULL,
+    component text NOT NULL,
+    config bytea NOT NULL,
+    is_current boolean NOT NULL DEFAULT true,
+    created_at timestamptz NOT NULL,
+    updated_at timestamptz
+);
+CREATE INDEX IF NOT EXISTS idx_mcp_servers_label ON mcp_servers(label);
+CREATE INDEX IF NOT EXISTS idx_mcp_servers_parent_id ON mcp_servers(parent_id);
@@ -0,0 +1,19 @@
+CREATE TABLE IF NOT EXISTS mcp_tool_calls (
+    id bigserial PRIMARY KEY,
+    tool text NOT NULL,
+    flow_id bigint,
+    flow_version_id bigint,
+    mcp_server_id bigint,
+    session_id text,
+    client_name text,
+    client_version text,
+    arguments_hash text NOT NULL,
+    arguments text NOT NULL,
+    status text NOT NULL,
+    error text,
+    latency_ms bigint NOT NULL,
+    created_at timestamptz NOT NULL
+);
+CREATE INDEX IF NOT EXISTS idx_mcp_tool_calls_tool ON mcp_tool_calls(tool);
+CREATE INDEX IF NOT EXISTS idx_mcp_tool_calls_flow_id ON mcp_tool_calls(flow_id);
+CREATE INDEX IF NOT EXISTS idx_mcp_tool_calls_created_at ON mcp_tool_calls(created_at);
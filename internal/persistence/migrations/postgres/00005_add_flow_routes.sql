@@ -0,0 +1,5 @@
+ALTER TABLE flows ADD COLUMN slug text NOT NULL DEFAULT '';
+ALTER TABLE flows ADD COLUMN route text NOT NULL DEFAULT '';
+ALTER TABLE flows ADD COLUMN route_methods text NOT NULL DEFAULT '';
+CREATE INDEX IF NOT EXISTS idx_flows_slug ON flows(slug);
+CREATE INDEX IF NOT EXISTS idx_flows_route ON flows(route);
@@ -0,0 +1,9 @@
+CREATE TABLE IF NOT EXISTS rate_limit_counters (
+    id bigserial PRIMARY KEY,
+    rate_limit_label text NOT NULL,
+    bucket timestamptz NOT NULL,
+    allowed bigint NOT NULL DEFAULT 0,
+    denied bigint NOT NULL DEFAULT 0
+);
+CREATE UNIQUE INDEX IF NOT EXISTS idx_rate_limit_counters_label_bucket ON rate_limit_counters(rate_limit_label, bucket);
+CREATE INDEX IF NOT EXISTS idx_rate_limit_counters_bucket ON rate_limit_counters(bucket);
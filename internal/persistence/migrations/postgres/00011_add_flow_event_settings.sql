@@ -0,0 +1,6 @@
+ALTER TABLE flows ADD COLUMN event_sample_rate double precision;
+ALTER TABLE flows ADD COLUMN event_max_content_size bigint NOT NULL DEFAULT 0;
+ALTER TABLE flows ADD COLUMN event_redactions text NOT NULL DEFAULT '';
+ALTER TABLE flows ADD COLUMN event_retention_days bigint NOT NULL DEFAULT 0;
+ALTER TABLE flows ADD COLUMN event_retention_rows bigint NOT NULL DEFAULT 0;
+CREATE INDEX IF NOT EXISTS idx_events_flow_id_created_at ON events(flow_id, created_at);
@@ -0,0 +1,51 @@
+CREATE TABLE IF NOT EXISTS alert_channels (
+    id bigserial PRIMARY KEY,
+    name text NOT NULL,
+    type text NOT NULL,
+    url text NOT NULL DEFAULT '',
+    recipients text NOT NULL DEFAULT '',
+    created_at timestamptz NOT NULL,
+    updated_at timestamptz
+);
+
+CREATE TABLE IF NOT EXISTS alert_rules (
+    id bigserial PRIMARY KEY,
+    name text NOT NULL,
+    type text NOT NULL,
+    flow_id bigint NOT NULL DEFAULT 0,
+    threshold double precision NOT NULL DEFAULT 0,
+    window_minutes bigint NOT NULL DEFAULT 0,
+    channel_ids text NOT NULL DEFAULT '',
+    enabled boolean NOT NULL DEFAULT true,
+    created_at timestamptz NOT NULL,
+    updated_at timestamptz
+);
+
+CREATE TABLE IF NOT EXISTS alert_silences (
+    id bigserial PRIMARY KEY,
+    rule_id bigint NOT NULL DEFAULT 0,
+    flow_id bigint NOT NULL DEFAULT 0,
+    worker_id text NOT NULL DEFAULT '',
+    comment text NOT NULL DEFAULT '',
+    starts_at timestamptz NOT NULL,
+    ends_at timestamptz NOT NULL,
+    created_at timestamptz NOT NULL
+);
+CREATE INDEX IF NOT EXISTS idx_alert_silences_ends_at ON alert_silences(ends_at);
+
+CREATE TABLE IF NOT EXISTS alerts (
+    id bigserial PRIMARY KEY,
+    rule_id bigint NOT NULL,
+    rule_name text NOT NULL,
+    type text NOT NULL,
+    subject text NOT NULL,
+    flow_id bigint NOT NULL DEFAULT 0,
+    worker_id text NOT NULL DEFAULT '',
+    message text NOT NULL,
+    status text NOT NULL,
+    silenced boolean NOT NULL DEFAULT false,
+    started_at timestamptz NOT NULL,
+    resolved_at timestamptz
+);
+CREATE INDEX IF NOT EXISTS idx_alerts_status_rule_id_subject ON alerts(status, rule_id, subject);
+CREATE INDEX IF NOT EXISTS idx_alerts_started_at ON alerts(started_at);
@@ -0,0 +1,12 @@
+CREATE TABLE IF NOT EXISTS mcp_servers (
+    id integer PRIMARY KEY,
+    parent_id integer,
+    label text NOT NULL,
+    component text NOT NULL,
+    config blob NOT NULL,
+    is_current boolean NOT NULL DEFAULT true,
+    created_at datetime NOT NULL,
+    updated_at datetime
+);
+CREATE INDEX IF NOT EXISTS idx_mcp_servers_label ON mcp_servers(label);
+CREATE INDEX IF NOT EXISTS idx_mcp_servers_parent_id ON mcp_servers(parent_id);
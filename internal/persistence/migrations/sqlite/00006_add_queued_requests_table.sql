@@ -0,0 +1,19 @@
+CREATE TABLE IF NOT EXISTS queued_requests (
+    id integer PRIMARY KEY,
+    flow_id integer NOT NULL,
+    flow_version_id integer NOT NULL,
+    method text NOT NULL,
+    path text NOT NULL,
+    query text NOT NULL,
+    content_type text NOT NULL,
+    remote_addr text NOT NULL,
+    headers blob,
+    payload blob,
+    status text NOT NULL,
+    attempts integer NOT NULL DEFAULT 0,
+    last_error text,
+    created_at datetime NOT NULL,
+    updated_at datetime
+);
+CREATE INDEX IF NOT EXISTS idx_queued_requests_flow_id_status ON queued_requests(flow_id, status);
+CREATE INDEX IF NOT EXISTS idx_queued_requests_created_at ON queued_requests(created_at);
@@ -0,0 +1,2 @@
+ALTER TABLE rate_limit_states ADD COLUMN previous_tokens real NOT NULL DEFAULT 0;
+ALTER TABLE rate_limit_states ADD COLUMN entries blob;
@@ -0,0 +1,22 @@
+CREATE TABLE IF NOT EXISTS worker_flow_component_metrics (
+    id integer PRIMARY KEY,
+    worker_flow_id integer NOT NULL,
+    section text NOT NULL,
+    component_label text NOT NULL,
+    events integer NOT NULL DEFAULT 0,
+    errors integer NOT NULL DEFAULT 0,
+    updated_at datetime NOT NULL
+);
+CREATE UNIQUE INDEX IF NOT EXISTS idx_worker_flow_component_metrics_component ON worker_flow_component_metrics(worker_flow_id, section, component_label);
+
+CREATE TABLE IF NOT EXISTS flow_component_metrics (
+    id integer PRIMARY KEY,
+    flow_id integer NOT NULL,
+    section text NOT NULL,
+    component_label text NOT NULL,
+    bucket datetime NOT NULL,
+    events integer NOT NULL DEFAULT 0,
+    errors integer NOT NULL DEFAULT 0
+);
+CREATE UNIQUE INDEX IF NOT EXISTS idx_flow_component_metrics_component_bucket ON flow_component_metrics(flow_id, section, component_label, bucket);
+CREATE INDEX IF NOT EXISTS idx_flow_component_metrics_bucket ON flow_component_metrics(bucket);
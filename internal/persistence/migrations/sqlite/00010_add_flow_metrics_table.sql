@@ -0,0 +1,11 @@
+CREATE TABLE IF NOT EXISTS flow_metrics (
+    id integer PRIMARY KEY,
+    flow_id integer NOT NULL,
+    resolution text NOT NULL,
+    bucket datetime NOT NULL,
+    input_events integer NOT NULL DEFAULT 0,
+    processor_errors integer NOT NULL DEFAULT 0,
+    output_events integer NOT NULL DEFAULT 0
+);
+CREATE UNIQUE INDEX IF NOT EXISTS idx_flow_metrics_flow_resolution_bucket ON flow_metrics(flow_id, resolution, bucket);
+CREATE INDEX IF NOT EXISTS idx_flow_metrics_resolution_bucket ON flow_metrics(resolution, bucket);
@@ -0,0 +1,6 @@
+ALTER TABLE flows ADD COLUMN event_sample_rate real;
+ALTER TABLE flows ADD COLUMN event_max_content_size integer NOT NULL DEFAULT 0;
+ALTER TABLE flows ADD COLUMN event_redactions text NOT NULL DEFAULT '';
+ALTER TABLE flows ADD COLUMN event_retention_days integer NOT NULL DEFAULT 0;
+ALTER TABLE flows ADD COLUMN event_retention_rows integer NOT NULL DEFAULT 0;
+CREATE INDEX IF NOT EXISTS idx_events_flow_id_created_at ON events(flow_id, created_at);
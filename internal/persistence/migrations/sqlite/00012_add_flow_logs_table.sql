@@ -0,0 +1,12 @@
+CREATE TABLE IF NOT EXISTS flow_logs (
+    id integer PRIMARY KEY,
+    flow_id integer NOT NULL,
+    worker_flow_id integer NOT NULL,
+    worker_id text NOT NULL,
+    level text NOT NULL,
+    message text NOT NULL,
+    fields text NOT NULL DEFAULT '',
+    created_at datetime NOT NULL
+);
+CREATE INDEX IF NOT EXISTS idx_flow_logs_flow_id_created_at ON flow_logs(flow_id, created_at);
+CREATE INDEX IF NOT EXISTS idx_flow_logs_created_at ON flow_logs(created_at);
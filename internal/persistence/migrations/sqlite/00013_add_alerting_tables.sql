@@ -0,0 +1,51 @@
+CREATE TABLE IF NOT EXISTS alert_channels (
+    id integer PRIMARY KEY,
+    name text NOT NULL,
+    type text NOT NULL,
+    url text NOT NULL DEFAULT '',
+    recipients text NOT NULL DEFAULT '',
+    created_at datetime NOT NULL,
+    updated_at datetime
+);
+
+CREATE TABLE IF NOT EXISTS alert_rules (
+    id integer PRIMARY KEY,
+    name text NOT NULL,
+    type text NOT NULL,
+    flow_id integer NOT NULL DEFAULT 0,
+    threshold real NOT NULL DEFAULT 0,
+    window_minutes integer NOT NULL DEFAULT 0,
+    channel_ids text NOT NULL DEFAULT '',
+    enabled boolean NOT NULL DEFAULT true,
+    created_at datetime NOT NULL,
+    updated_at datetime
+);
+
+CREATE TABLE IF NOT EXISTS alert_silences (
+    id integer PRIMARY KEY,
+    rule_id integer NOT NULL DEFAULT 0,
+    flow_id integer NOT NULL DEFAULT 0,
+    worker_id text NOT NULL DEFAULT '',
+    comment text NOT NULL DEFAULT '',
+    starts_at datetime NOT NULL,
+    ends_at datetime NOT NULL,
+    created_at datetime NOT NULL
+);
+CREATE INDEX IF NOT EXISTS idx_alert_silences_ends_at ON alert_silences(ends_at);
+
+CREATE TABLE IF NOT EXISTS alerts (
+    id integer PRIMARY KEY,
+    rule_id integer NOT NULL,
+    rule_name text NOT NULL,
+    type text NOT NULL,
+    subject text NOT NULL,
+    flow_id integer NOT NULL DEFAULT 0,
+    worker_id text NOT NULL DEFAULT '',
+    message text NOT NULL,
+    status text NOT NULL,
+    silenced boolean NOT NULL DEFAULT false,
+    started_at datetime NOT NULL,
+    resolved_at datetime
+);
+CREATE INDEX IF NOT EXISTS idx_alerts_status_rule_id_subject ON alerts(status, rule_id, subject);
+CREATE INDEX IF NOT EXISTS idx_alerts_started_at ON alerts(started_at);
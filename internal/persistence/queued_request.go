@@ -0,0 +1,266 @@
+package persistence
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+	"time"
+
+	pb "github.com/sananguliyev/airtruct/internal/protogen"
+	"google.golang.org/protobuf/types/known/timestamppb"
+	"gorm.io/gorm"
+)
+
+type QueuedRequestStatus string
+
+const (
+	QueuedRequestStatusQueued QueuedRequestStatus = "queued"
+	QueuedRequestStatusDead   QueuedRequestStatus = "dead"
+)
+
+// QueuedRequest is an ingest request accepted by the coordinator while its flow was not running. FlowID is
+// the first version of the flow, so requests are replayed to whichever version is current.
+type QueuedRequest struct {
+	ID            int64               `json:"id" gorm:"primaryKey"`
+	FlowID        int64               `json:"flow_id" gorm:"not null"`
+	FlowVersionID int64               `json:"flow_version_id" gorm:"not null"`
+	Method        string              `json:"method" gorm:"not null"`
+	Path          string              `json:"path" gorm:"not null"`
+	Query         string              `json:"query" gorm:"not null"`
+	ContentType   string              `json:"content_type" gorm:"not null"`
+	RemoteAddr    string              `json:"remote_addr" gorm:"not null"`
+	Headers       []byte              `json:"headers"`
+	Payload       []byte              `json:"payload"`
+	Status        QueuedRequestStatus `json:"status" gorm:"not null"`
+	Attempts      int                 `json:"attempts" gorm:"not null"`
+	LastError     string              `json:"last_error"`
+	CreatedAt     time.Time           `json:"created_at" gorm:"not null"`
+	UpdatedAt     *time.Time          `json:"updated_at"`
+}
+
+// credentialHeaders are never stored with a queued request, the coordinator checks them before queueing and
+// the dead letter API would return them otherwise.
+var credentialHeaders = []string{
+	"Authorization",
+	"Proxy-Authorization",
+	"Cookie",
+	"X-API-Key",
+	"X-Hub-Signature",
+	"X-Hub-Signature-256",
+	"Stripe-Signature",
+	"X-Shopify-Hmac-Sha256",
+	"X-Slack-Signature",
+}
+
+func stripCredentialHeaders(headers http.Header) {
+	for _, name := range credentialHeaders {
+		headers.Del(name)
+	}
+}
+
+func (q *QueuedRequest) GetHeaders() http.Header {
+	headers := make(http.Header)
+	if len(q.Headers) > 0 {
+		_ = json.Unmarshal(q.Headers, &headers)
+	}
+	return headers
+}
+
+// SetHeaders stores the headers without the credential headers.
+func (q *QueuedRequest) SetHeaders(headers http.Header) {
+	headers = headers.Clone()
+	stripCredentialHeaders(headers)
+	q.Headers, _ = json.Marshal(headers)
+}
+
+func (q *QueuedRequest) ToProto() *pb.QueuedRequest {
+	var updatedAt *timestamppb.Timestamp
+	if q.UpdatedAt != nil {
+		updatedAt = timestamppb.New(*q.UpdatedAt)
+	}
+
+	var headers []byte
+	if len(q.Headers) > 0 {
+		// Requests queued before credential headers were dropped may still have them.
+		stored := q.GetHeaders()
+		stripCredentialHeaders(stored)
+		headers, _ = json.Marshal(stored)
+	}
+
+	return &pb.QueuedRequest{
+		Id:            q.ID,
+		FlowId:        q.FlowID,
+		FlowVersionId: q.FlowVersionID,
+		Method:        q.Method,
+		Path:          q.Path,
+		Query:         q.Query,
+		ContentType:   q.ContentType,
+		RemoteAddr:    q.RemoteAddr,
+		Headers:       string(headers),
+		Payload:       q.Payload,
+		Status:        string(q.Status),
+		Attempts:      int32(q.Attempts),
+		LastError:     q.LastError,
+		CreatedAt:     timestamppb.New(q.CreatedAt),
+		UpdatedAt:     updatedAt,
+	}
+}
+
+type QueuedRequestFilter struct {
+	FlowID int64
+	Status string
+	Limit  int
+	Offset int
+}
+
+type QueuedRequestRepository interface {
+	Create(request *QueuedRequest) error
+	FindByID(id int64) (*QueuedRequest, error)
+	List(filter QueuedRequestFilter) ([]QueuedRequest, int64, error)
+	ListQueuedFlowIDs() ([]int64, error)
+	ListQueuedByFlowID(flowID int64, limit int) ([]QueuedRequest, error)
+	CountQueuedByFlowID(flowID int64) (int64, error)
+	UpdateStatus(id int64, status QueuedRequestStatus, attempts int, lastError string) error
+	Requeue(id int64) error
+	DeadLetterBefore(flowID int64, before time.Time, reason string) (int64, error)
+	DeleteDeadBefore(before time.Time) (int64, error)
+	Delete(id int64) error
+}
+
+type queuedRequestRepository struct {
+	db *gorm.DB
+}
+
+func NewQueuedRequestRepository(db *gorm.DB) QueuedRequestRepository {
+	return &queuedRequestRepository{db: db}
+}
+
+func (r *queuedRequestRepository) Create(request *QueuedRequest) error {
+	request.CreatedAt = time.Now()
+	request.Status = QueuedRequestStatusQueued
+	return r.db.Create(request).Error
+}
+
+func (r *queuedRequestRepository) FindByID(id int64) (*QueuedRequest, error) {
+	var request QueuedRequest
+	err := r.db.Where("id = ?", id).First(&request).Error
+	if err != nil && !errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, err
+	} else if errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, nil
+	}
+	return &request, nil
+}
+
+func (r *queuedRequestRepository) List(filter QueuedRequestFilter) ([]QueuedRequest, int64, error) {
+	query := r.db.Model(&QueuedRequest{})
+	if filter.FlowID != 0 {
+		query = query.Where("flow_id = ?", filter.FlowID)
+	}
+	if filter.Status != "" {
+		query = query.Where("status = ?", filter.Status)
+	}
+
+	var total int64
+	if err := query.Count(&total).Error; err != nil {
+		return nil, 0, err
+	}
+
+	var requests []QueuedRequest
+	err := query.
+		Order("id DESC").
+		Limit(filter.Limit).
+		Offset(filter.Offset).
+		Find(&requests).
+		Error
+	if err != nil {
+		return nil, 0, err
+	}
+	return requests, total, nil
+}
+
+func (r *queuedRequestRepository) ListQueuedFlowIDs() ([]int64, error) {
+	var flowIDs []int64
+	err := r.db.
+		Model(&QueuedRequest{}).
+		Where("status = ?", QueuedRequestStatusQueued).
+		Distinct().
+		Pluck("flow_id", &flowIDs).
+		Error
+	return flowIDs, err
+}
+
+func (r *queuedRequestRepository) ListQueuedByFlowID(flowID int64, limit int) ([]QueuedRequest, error) {
+	var requests []QueuedRequest
+	err := r.db.
+		Where("flow_id = ? AND status = ?", flowID, QueuedRequestStatusQueued).
+		Order("id ASC").
+		Limit(limit).
+		Find(&requests).
+		Error
+	return requests, err
+}
+
+func (r *queuedRequestRepository) CountQueuedByFlowID(flowID int64) (int64, error) {
+	var count int64
+	err := r.db.
+		Model(&QueuedRequest{}).
+		Where("flow_id = ? AND status = ?", flowID, QueuedRequestStatusQueued).
+		Count(&count).
+		Error
+	return count, err
+}
+
+func (r *queuedRequestRepository) UpdateStatus(id int64, status QueuedRequestStatus, attempts int, lastError string) error {
+	return r.db.
+		Model(&QueuedRequest{}).
+		Where("id = ?", id).
+		Updates(map[string]any{
+			"status":     status,
+			"attempts":   attempts,
+			"last_error": lastError,
+			"updated_at": time.Now(),
+		}).
+		Error
+}
+
+// Requeue moves a dead letter back to the queue. It restarts the max age of the request, its ID keeps it in
+// front of requests queued later.
+func (r *queuedRequestRepository) Requeue(id int64) error {
+	now := time.Now()
+	return r.db.
+		Model(&QueuedRequest{}).
+		Where("id = ?", id).
+		Updates(map[string]any{
+			"status":     QueuedRequestStatusQueued,
+			"attempts":   0,
+			"last_error": "",
+			"created_at": now,
+			"updated_at": now,
+		}).
+		Error
+}
+
+// DeadLetterBefore moves requests of the flow that were queued before the given time to the dead letters.
+func (r *queuedRequestRepository) DeadLetterBefore(flowID int64, before time.Time, reason string) (int64, error) {
+	result := r.db.
+		Model(&QueuedRequest{}).
+		Where("flow_id = ? AND status = ? AND created_at < ?", flowID, QueuedRequestStatusQueued, before).
+		Updates(map[string]any{
+			"status":     QueuedRequestStatusDead,
+			"last_error": reason,
+			"updated_at": time.Now(),
+		})
+	return result.RowsAffected, result.Error
+}
+
+func (r *queuedRequestRepository) DeleteDeadBefore(before time.Time) (int64, error) {
+	result := r.db.
+		Where("status = ? AND created_at < ?", QueuedRequestStatusDead, before).
+		Delete(&QueuedRequest{})
+	return result.RowsAffected, result.Error
+}
+
+func (r *queuedRequestRepository) Delete(id int64) error {
+	return r.db.Delete(&QueuedRequest{}, id).Error
+}
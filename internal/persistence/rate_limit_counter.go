@@ -0,0 +1,63 @@
+package persistence
+
+import (
+	"time"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// RateLimitCounter counts the allowed and denied checks of a rate limit within the minute starting at Bucket.
+type RateLimitCounter struct {
+	ID             int64     `json:"id" gorm:"primaryKey"`
+	RateLimitLabel string    `json:"rate_limit_label" gorm:"not null;uniqueIndex:idx_rate_limit_counters_label_bucket"`
+	Bucket         time.Time `json:"bucket" gorm:"not null;uniqueIndex:idx_rate_limit_counters_label_bucket"`
+	Allowed        int64     `json:"allowed" gorm:"not null"`
+	Denied         int64     `json:"denied" gorm:"not null"`
+}
+
+type RateLimitCounterRepository interface {
+	Increment(counters []*RateLimitCounter) error
+	ListByLabel(label string, since time.Time) ([]RateLimitCounter, error)
+	DeleteOlderThan(duration time.Duration) error
+}
+
+type rateLimitCounterRepository struct {
+	db *gorm.DB
+}
+
+func NewRateLimitCounterRepository(db *gorm.DB) RateLimitCounterRepository {
+	return &rateLimitCounterRepository{db: db}
+}
+
+// Increment adds the counts to the stored counters of the same label and bucket, creating missing ones.
+func (r *rateLimitCounterRepository) Increment(counters []*RateLimitCounter) error {
+	return r.db.
+		Clauses(clause.OnConflict{
+			Columns: []clause.Column{{Name: "rate_limit_label"}, {Name: "bucket"}},
+			DoUpdates: clause.Assignments(map[string]any{
+				"allowed": gorm.Expr("rate_limit_counters.allowed + excluded.allowed"),
+				"denied":  gorm.Expr("rate_limit_counters.denied + excluded.denied"),
+			}),
+		}).
+		CreateInBatches(counters, 500).
+		Error
+}
+
+func (r *rateLimitCounterRepository) ListByLabel(label string, since time.Time) ([]RateLimitCounter, error) {
+	var counters []RateLimitCounter
+	err := r.db.
+		Where("rate_limit_label = ? AND bucket >= ?", label, since).
+		Order("bucket ASC").
+		Find(&counters).
+		Error
+	return counters, err
+}
+
+func (r *rateLimitCounterRepository) DeleteOlderThan(duration time.Duration) error {
+	cutoff := time.Now().Add(-duration)
+	return r.db.
+		Where("bucket < ?", cutoff).
+		Delete(&RateLimitCounter{}).
+		Error
+}
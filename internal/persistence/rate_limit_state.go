@@ -5,14 +5,20 @@ import (
 	"time"
 
 	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
 )
 
+// RateLimitState is the usage of a rate limit by one key. Tokens and LastRefillAt hold the bucket or the
+// current window, PreviousTokens the usage of the previous window and Entries the request log or leases as JSON,
+// depending on the algorithm of the rate limit.
 type RateLimitState struct {
 	ID             int64     `json:"id" gorm:"primaryKey"`
 	RateLimitLabel string    `json:"rate_limit_label" gorm:"not null;index:idx_label_key"`
 	Key            string    `json:"key" gorm:"not null;index:idx_label_key"`
 	Tokens         float64   `json:"tokens" gorm:"not null"`
 	LastRefillAt   time.Time `json:"last_refill_at" gorm:"not null"`
+	PreviousTokens float64   `json:"previous_tokens" gorm:"not null;default:0"`
+	Entries        []byte    `json:"entries"`
 	CreatedAt      time.Time `json:"created_at" gorm:"not null"`
 	UpdatedAt      time.Time `json:"updated_at" gorm:"not null"`
 }
@@ -20,6 +26,9 @@ type RateLimitState struct {
 type RateLimitStateRepository interface {
 	GetOrCreate(label, key string, initialTokens float64) (*RateLimitState, error)
 	Update(state *RateLimitState) error
+	SaveAll(states []*RateLimitState) error
+	List(label, key string, limit, offset int) ([]RateLimitState, int64, error)
+	Delete(label, key string) error
 	DeleteOlderThan(duration time.Duration) error
 }
 
@@ -67,6 +76,52 @@ func (r *rateLimitStateRepository) Update(state *RateLimitState) error {
 	return r.db.Save(state).Error
 }
 
+// SaveAll upserts the states in batches, keeping their UpdatedAt. States deleted in the meantime are created
+// again with their IDs.
+func (r *rateLimitStateRepository) SaveAll(states []*RateLimitState) error {
+	return r.db.
+		Clauses(clause.OnConflict{
+			Columns:   []clause.Column{{Name: "id"}},
+			DoUpdates: clause.AssignmentColumns([]string{"tokens", "last_refill_at", "previous_tokens", "entries", "updated_at"}),
+		}).
+		CreateInBatches(states, 500).
+		Error
+}
+
+// List returns the states of the label, most recently used first. A non-empty key filters by key prefix.
+func (r *rateLimitStateRepository) List(label, key string, limit, offset int) ([]RateLimitState, int64, error) {
+	query := r.db.Model(&RateLimitState{}).Where("rate_limit_label = ?", label)
+	if key != "" {
+		query = query.Where("key LIKE ?", key+"%")
+	}
+
+	var total int64
+	if err := query.Count(&total).Error; err != nil {
+		return nil, 0, err
+	}
+
+	var states []RateLimitState
+	err := query.
+		Order("updated_at DESC").
+		Limit(limit).
+		Offset(offset).
+		Find(&states).
+		Error
+	if err != nil {
+		return nil, 0, err
+	}
+	return states, total, nil
+}
+
+// Delete removes the state of the key, or of every key of the label when key is empty.
+func (r *rateLimitStateRepository) Delete(label, key string) error {
+	query := r.db.Where("rate_limit_label = ?", label)
+	if key != "" {
+		query = query.Where("key = ?", key)
+	}
+	return query.Delete(&RateLimitState{}).Error
+}
+
 func (r *rateLimitStateRepository) DeleteOlderThan(duration time.Duration) error {
 	cutoff := time.Now().Add(-duration)
 	return r.db.
@@ -42,7 +42,6 @@ type WorkerFlowRepository interface {
 	FindByID(id int64) (*WorkerFlow, error)
 	FindByWorkerIDAndFlowID(workerID string, flowID int64) (*WorkerFlow, error)
 	UpdateStatus(id int64, status WorkerFlowStatus) error
-	UpdateMetrics(id int64, inputEvents, processorErrors, outputEvents uint64) error
 	UpdateLeaseExpiry(id int64, expiresAt time.Time) error
 	FindRunningWithExpiredLeases() ([]WorkerFlow, error)
 	StopAllRunningAndWaitingByWorkerID(workerID string) error
@@ -82,19 +81,6 @@ func (r *workerFlowRepository) UpdateStatus(id int64, status WorkerFlowStatus) e
 		Error
 }
 
-func (r *workerFlowRepository) UpdateMetrics(id int64, inputEvents, processorErrors, outputEvents uint64) error {
-	return r.db.
-		Model(&WorkerFlow{}).
-		Where("id = ?", id).
-		Updates(map[string]any{
-			"input_events":     inputEvents,
-			"processor_errors": processorErrors,
-			"output_events":    outputEvents,
-			"updated_at":       time.Now(),
-		}).
-		Error
-}
-
 func (r *workerFlowRepository) FindByID(id int64) (*WorkerFlow, error) {
 	var workerFlow = &WorkerFlow{
 		ID: id,
@@ -144,6 +144,10 @@ type Flow struct {
 	IsMcpTool       bool                   `protobuf:"varint,17,opt,name=is_mcp_tool,proto3" json:"is_mcp_tool,omitempty"`
 	IsReady         bool                   `protobuf:"varint,18,opt,name=is_ready,proto3" json:"is_ready,omitempty"`
 	BuilderState    string                 `protobuf:"bytes,19,opt,name=builder_state,proto3" json:"builder_state,omitempty"`
+	Slug            string                 `protobuf:"bytes,20,opt,name=slug,proto3" json:"slug,omitempty"`
+	Route           string                 `protobuf:"bytes,21,opt,name=route,proto3" json:"route,omitempty"`
+	RouteMethods    []string               `protobuf:"bytes,22,rep,name=route_methods,proto3" json:"route_methods,omitempty"`
+	EventSettings   *EventSettings         `protobuf:"bytes,23,opt,name=event_settings,proto3" json:"event_settings,omitempty"`
 	unknownFields   protoimpl.UnknownFields
 	sizeCache       protoimpl.SizeCache
 }
@@ -311,6 +315,112 @@ func (x *Flow) GetBuilderState() string {
 	return ""
 }
 
+func (x *Flow) GetSlug() string {
+	if x != nil {
+		return x.Slug
+	}
+	return ""
+}
+
+func (x *Flow) GetRoute() string {
+	if x != nil {
+		return x.Route
+	}
+	return ""
+}
+
+func (x *Flow) GetRouteMethods() []string {
+	if x != nil {
+		return x.RouteMethods
+	}
+	return nil
+}
+
+func (x *Flow) GetEventSettings() *EventSettings {
+	if x != nil {
+		return x.EventSettings
+	}
+	return nil
+}
+
+// EventSettings control which tracing events of a flow are stored, what is stored of their content and for how
+// long. Zero values keep every event, in full, forever.
+type EventSettings struct {
+	state          protoimpl.MessageState     `protogen:"open.v1"`
+	SampleRate     *float64                   `protobuf:"fixed64,1,opt,name=sample_rate,proto3,oneof" json:"sample_rate,omitempty"`
+	MaxContentSize int64                      `protobuf:"varint,2,opt,name=max_content_size,proto3" json:"max_content_size,omitempty"`
+	Redactions     []*EventSettings_Redaction `protobuf:"bytes,3,rep,name=redactions,proto3" json:"redactions,omitempty"`
+	RetentionDays  int64                      `protobuf:"varint,4,opt,name=retention_days,proto3" json:"retention_days,omitempty"`
+	RetentionRows  int64                      `protobuf:"varint,5,opt,name=retention_rows,proto3" json:"retention_rows,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *EventSettings) Reset() {
+	*x = EventSettings{}
+	mi := &file_common_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *EventSettings) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EventSettings) ProtoMessage() {}
+
+func (x *EventSettings) ProtoReflect() protoreflect.Message {
+	mi := &file_common_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EventSettings.ProtoReflect.Descriptor instead.
+func (*EventSettings) Descriptor() ([]byte, []int) {
+	return file_common_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *EventSettings) GetSampleRate() float64 {
+	if x != nil && x.SampleRate != nil {
+		return *x.SampleRate
+	}
+	return 0
+}
+
+func (x *EventSettings) GetMaxContentSize() int64 {
+	if x != nil {
+		return x.MaxContentSize
+	}
+	return 0
+}
+
+func (x *EventSettings) GetRedactions() []*EventSettings_Redaction {
+	if x != nil {
+		return x.Redactions
+	}
+	return nil
+}
+
+func (x *EventSettings) GetRetentionDays() int64 {
+	if x != nil {
+		return x.RetentionDays
+	}
+	return 0
+}
+
+func (x *EventSettings) GetRetentionRows() int64 {
+	if x != nil {
+		return x.RetentionRows
+	}
+	return 0
+}
+
 type Secret struct {
 	state          protoimpl.MessageState `protogen:"open.v1"`
 	Key            string                 `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
@@ -322,7 +432,7 @@ type Secret struct {
 
 func (x *Secret) Reset() {
 	*x = Secret{}
-	mi := &file_common_proto_msgTypes[2]
+	mi := &file_common_proto_msgTypes[3]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -334,7 +444,7 @@ func (x *Secret) String() string {
 func (*Secret) ProtoMessage() {}
 
 func (x *Secret) ProtoReflect() protoreflect.Message {
-	mi := &file_common_proto_msgTypes[2]
+	mi := &file_common_proto_msgTypes[3]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -347,7 +457,7 @@ func (x *Secret) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Secret.ProtoReflect.Descriptor instead.
 func (*Secret) Descriptor() ([]byte, []int) {
-	return file_common_proto_rawDescGZIP(), []int{2}
+	return file_common_proto_rawDescGZIP(), []int{3}
 }
 
 func (x *Secret) GetKey() string {
@@ -387,7 +497,7 @@ type Cache struct {
 
 func (x *Cache) Reset() {
 	*x = Cache{}
-	mi := &file_common_proto_msgTypes[3]
+	mi := &file_common_proto_msgTypes[4]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -399,7 +509,7 @@ func (x *Cache) String() string {
 func (*Cache) ProtoMessage() {}
 
 func (x *Cache) ProtoReflect() protoreflect.Message {
-	mi := &file_common_proto_msgTypes[3]
+	mi := &file_common_proto_msgTypes[4]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -412,7 +522,7 @@ func (x *Cache) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Cache.ProtoReflect.Descriptor instead.
 func (*Cache) Descriptor() ([]byte, []int) {
-	return file_common_proto_rawDescGZIP(), []int{3}
+	return file_common_proto_rawDescGZIP(), []int{4}
 }
 
 func (x *Cache) GetId() int64 {
@@ -487,7 +597,7 @@ type Buffer struct {
 
 func (x *Buffer) Reset() {
 	*x = Buffer{}
-	mi := &file_common_proto_msgTypes[4]
+	mi := &file_common_proto_msgTypes[5]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -499,7 +609,7 @@ func (x *Buffer) String() string {
 func (*Buffer) ProtoMessage() {}
 
 func (x *Buffer) ProtoReflect() protoreflect.Message {
-	mi := &file_common_proto_msgTypes[4]
+	mi := &file_common_proto_msgTypes[5]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -512,7 +622,7 @@ func (x *Buffer) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Buffer.ProtoReflect.Descriptor instead.
 func (*Buffer) Descriptor() ([]byte, []int) {
-	return file_common_proto_rawDescGZIP(), []int{4}
+	return file_common_proto_rawDescGZIP(), []int{5}
 }
 
 func (x *Buffer) GetId() int64 {
@@ -587,7 +697,7 @@ type RateLimit struct {
 
 func (x *RateLimit) Reset() {
 	*x = RateLimit{}
-	mi := &file_common_proto_msgTypes[5]
+	mi := &file_common_proto_msgTypes[6]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -599,7 +709,7 @@ func (x *RateLimit) String() string {
 func (*RateLimit) ProtoMessage() {}
 
 func (x *RateLimit) ProtoReflect() protoreflect.Message {
-	mi := &file_common_proto_msgTypes[5]
+	mi := &file_common_proto_msgTypes[6]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -612,7 +722,7 @@ func (x *RateLimit) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use RateLimit.ProtoReflect.Descriptor instead.
 func (*RateLimit) Descriptor() ([]byte, []int) {
-	return file_common_proto_rawDescGZIP(), []int{5}
+	return file_common_proto_rawDescGZIP(), []int{6}
 }
 
 func (x *RateLimit) GetId() int64 {
@@ -687,7 +797,7 @@ type File struct {
 
 func (x *File) Reset() {
 	*x = File{}
-	mi := &file_common_proto_msgTypes[6]
+	mi := &file_common_proto_msgTypes[7]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -699,7 +809,7 @@ func (x *File) String() string {
 func (*File) ProtoMessage() {}
 
 func (x *File) ProtoReflect() protoreflect.Message {
-	mi := &file_common_proto_msgTypes[6]
+	mi := &file_common_proto_msgTypes[7]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -712,7 +822,7 @@ func (x *File) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use File.ProtoReflect.Descriptor instead.
 func (*File) Descriptor() ([]byte, []int) {
-	return file_common_proto_rawDescGZIP(), []int{6}
+	return file_common_proto_rawDescGZIP(), []int{7}
 }
 
 func (x *File) GetId() int64 {
@@ -771,30 +881,35 @@ func (x *File) GetUpdatedAt() *timestamppb.Timestamp {
 	return nil
 }
 
-type RateLimitCheckRequest struct {
+type McpServer struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Label         string                 `protobuf:"bytes,1,opt,name=label,proto3" json:"label,omitempty"`
-	Key           string                 `protobuf:"bytes,2,opt,name=key,proto3" json:"key,omitempty"`
-	Cost          int64                  `protobuf:"varint,3,opt,name=cost,proto3" json:"cost,omitempty"`
+	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	ParentId      *int64                 `protobuf:"varint,2,opt,name=parent_id,proto3,oneof" json:"parent_id,omitempty"`
+	Label         string                 `protobuf:"bytes,3,opt,name=label,proto3" json:"label,omitempty"`
+	Component     string                 `protobuf:"bytes,4,opt,name=component,proto3" json:"component,omitempty"`
+	Config        string                 `protobuf:"bytes,5,opt,name=config,proto3" json:"config,omitempty"`
+	IsCurrent     bool                   `protobuf:"varint,6,opt,name=is_current,proto3" json:"is_current,omitempty"`
+	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=created_at,proto3" json:"created_at,omitempty"`
+	UpdatedAt     *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=updated_at,proto3,oneof" json:"updated_at,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *RateLimitCheckRequest) Reset() {
-	*x = RateLimitCheckRequest{}
-	mi := &file_common_proto_msgTypes[7]
+func (x *McpServer) Reset() {
+	*x = McpServer{}
+	mi := &file_common_proto_msgTypes[8]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *RateLimitCheckRequest) String() string {
+func (x *McpServer) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*RateLimitCheckRequest) ProtoMessage() {}
+func (*McpServer) ProtoMessage() {}
 
-func (x *RateLimitCheckRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_common_proto_msgTypes[7]
+func (x *McpServer) ProtoReflect() protoreflect.Message {
+	mi := &file_common_proto_msgTypes[8]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -805,58 +920,97 @@ func (x *RateLimitCheckRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use RateLimitCheckRequest.ProtoReflect.Descriptor instead.
-func (*RateLimitCheckRequest) Descriptor() ([]byte, []int) {
-	return file_common_proto_rawDescGZIP(), []int{7}
+// Deprecated: Use McpServer.ProtoReflect.Descriptor instead.
+func (*McpServer) Descriptor() ([]byte, []int) {
+	return file_common_proto_rawDescGZIP(), []int{8}
 }
 
-func (x *RateLimitCheckRequest) GetLabel() string {
+func (x *McpServer) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *McpServer) GetParentId() int64 {
+	if x != nil && x.ParentId != nil {
+		return *x.ParentId
+	}
+	return 0
+}
+
+func (x *McpServer) GetLabel() string {
 	if x != nil {
 		return x.Label
 	}
 	return ""
 }
 
-func (x *RateLimitCheckRequest) GetKey() string {
+func (x *McpServer) GetComponent() string {
 	if x != nil {
-		return x.Key
+		return x.Component
 	}
 	return ""
 }
 
-func (x *RateLimitCheckRequest) GetCost() int64 {
+func (x *McpServer) GetConfig() string {
 	if x != nil {
-		return x.Cost
+		return x.Config
 	}
-	return 0
+	return ""
 }
 
-type RateLimitCheckResponse struct {
+func (x *McpServer) GetIsCurrent() bool {
+	if x != nil {
+		return x.IsCurrent
+	}
+	return false
+}
+
+func (x *McpServer) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *McpServer) GetUpdatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return nil
+}
+
+// AlertChannel is where notifications of alerts are sent: a generic webhook, a Slack-compatible webhook or email
+// recipients.
+type AlertChannel struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Allowed       bool                   `protobuf:"varint,1,opt,name=allowed,proto3" json:"allowed,omitempty"`
-	RetryAfterMs  int64                  `protobuf:"varint,2,opt,name=retry_after_ms,json=retryAfterMs,proto3" json:"retry_after_ms,omitempty"`
-	Remaining     int64                  `protobuf:"varint,3,opt,name=remaining,proto3" json:"remaining,omitempty"`
-	Limit         int64                  `protobuf:"varint,4,opt,name=limit,proto3" json:"limit,omitempty"`
-	ResetAt       int64                  `protobuf:"varint,5,opt,name=reset_at,json=resetAt,proto3" json:"reset_at,omitempty"`
+	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Type          string                 `protobuf:"bytes,3,opt,name=type,proto3" json:"type,omitempty"`
+	Url           string                 `protobuf:"bytes,4,opt,name=url,proto3" json:"url,omitempty"`
+	Recipients    []string               `protobuf:"bytes,5,rep,name=recipients,proto3" json:"recipients,omitempty"`
+	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=created_at,proto3" json:"created_at,omitempty"`
+	UpdatedAt     *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=updated_at,proto3,oneof" json:"updated_at,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *RateLimitCheckResponse) Reset() {
-	*x = RateLimitCheckResponse{}
-	mi := &file_common_proto_msgTypes[8]
+func (x *AlertChannel) Reset() {
+	*x = AlertChannel{}
+	mi := &file_common_proto_msgTypes[9]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *RateLimitCheckResponse) String() string {
+func (x *AlertChannel) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*RateLimitCheckResponse) ProtoMessage() {}
+func (*AlertChannel) ProtoMessage() {}
 
-func (x *RateLimitCheckResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_common_proto_msgTypes[8]
+func (x *AlertChannel) ProtoReflect() protoreflect.Message {
+	mi := &file_common_proto_msgTypes[9]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -867,70 +1021,94 @@ func (x *RateLimitCheckResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use RateLimitCheckResponse.ProtoReflect.Descriptor instead.
-func (*RateLimitCheckResponse) Descriptor() ([]byte, []int) {
-	return file_common_proto_rawDescGZIP(), []int{8}
+// Deprecated: Use AlertChannel.ProtoReflect.Descriptor instead.
+func (*AlertChannel) Descriptor() ([]byte, []int) {
+	return file_common_proto_rawDescGZIP(), []int{9}
 }
 
-func (x *RateLimitCheckResponse) GetAllowed() bool {
+func (x *AlertChannel) GetId() int64 {
 	if x != nil {
-		return x.Allowed
+		return x.Id
 	}
-	return false
+	return 0
 }
 
-func (x *RateLimitCheckResponse) GetRetryAfterMs() int64 {
+func (x *AlertChannel) GetName() string {
 	if x != nil {
-		return x.RetryAfterMs
+		return x.Name
 	}
-	return 0
+	return ""
 }
 
-func (x *RateLimitCheckResponse) GetRemaining() int64 {
+func (x *AlertChannel) GetType() string {
 	if x != nil {
-		return x.Remaining
+		return x.Type
 	}
-	return 0
+	return ""
 }
 
-func (x *RateLimitCheckResponse) GetLimit() int64 {
+func (x *AlertChannel) GetUrl() string {
 	if x != nil {
-		return x.Limit
+		return x.Url
 	}
-	return 0
+	return ""
 }
 
-func (x *RateLimitCheckResponse) GetResetAt() int64 {
+func (x *AlertChannel) GetRecipients() []string {
 	if x != nil {
-		return x.ResetAt
+		return x.Recipients
 	}
-	return 0
+	return nil
 }
 
-type Flow_Processor struct {
+func (x *AlertChannel) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *AlertChannel) GetUpdatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return nil
+}
+
+// AlertRule is a condition the coordinator watches. flow_id limits flow rules to a flow, all flows are watched when
+// it is 0. threshold is the share of input messages with processor errors for error_rate rules, window_minutes the
+// period error_rate and no_input rules look back on.
+type AlertRule struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Label         string                 `protobuf:"bytes,1,opt,name=label,proto3" json:"label,omitempty"`
-	Component     string                 `protobuf:"bytes,2,opt,name=component,proto3" json:"component,omitempty"`
-	Config        string                 `protobuf:"bytes,3,opt,name=config,proto3" json:"config,omitempty"`
+	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Type          string                 `protobuf:"bytes,3,opt,name=type,proto3" json:"type,omitempty"`
+	FlowId        int64                  `protobuf:"varint,4,opt,name=flow_id,proto3" json:"flow_id,omitempty"`
+	Threshold     float64                `protobuf:"fixed64,5,opt,name=threshold,proto3" json:"threshold,omitempty"`
+	WindowMinutes int64                  `protobuf:"varint,6,opt,name=window_minutes,proto3" json:"window_minutes,omitempty"`
+	ChannelIds    []int64                `protobuf:"varint,7,rep,packed,name=channel_ids,proto3" json:"channel_ids,omitempty"`
+	Enabled       bool                   `protobuf:"varint,8,opt,name=enabled,proto3" json:"enabled,omitempty"`
+	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,9,opt,name=created_at,proto3" json:"created_at,omitempty"`
+	UpdatedAt     *timestamppb.Timestamp `protobuf:"bytes,10,opt,name=updated_at,proto3,oneof" json:"updated_at,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *Flow_Processor) Reset() {
-	*x = Flow_Processor{}
-	mi := &file_common_proto_msgTypes[9]
+func (x *AlertRule) Reset() {
+	*x = AlertRule{}
+	mi := &file_common_proto_msgTypes[10]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *Flow_Processor) String() string {
+func (x *AlertRule) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Flow_Processor) ProtoMessage() {}
+func (*AlertRule) ProtoMessage() {}
 
-func (x *Flow_Processor) ProtoReflect() protoreflect.Message {
-	mi := &file_common_proto_msgTypes[9]
+func (x *AlertRule) ProtoReflect() protoreflect.Message {
+	mi := &file_common_proto_msgTypes[10]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -941,44 +1119,652 @@ func (x *Flow_Processor) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Flow_Processor.ProtoReflect.Descriptor instead.
-func (*Flow_Processor) Descriptor() ([]byte, []int) {
-	return file_common_proto_rawDescGZIP(), []int{1, 0}
+// Deprecated: Use AlertRule.ProtoReflect.Descriptor instead.
+func (*AlertRule) Descriptor() ([]byte, []int) {
+	return file_common_proto_rawDescGZIP(), []int{10}
 }
 
-func (x *Flow_Processor) GetLabel() string {
+func (x *AlertRule) GetId() int64 {
 	if x != nil {
-		return x.Label
+		return x.Id
 	}
-	return ""
+	return 0
 }
 
-func (x *Flow_Processor) GetComponent() string {
+func (x *AlertRule) GetName() string {
 	if x != nil {
-		return x.Component
+		return x.Name
 	}
 	return ""
 }
 
-func (x *Flow_Processor) GetConfig() string {
+func (x *AlertRule) GetType() string {
 	if x != nil {
-		return x.Config
+		return x.Type
 	}
 	return ""
 }
 
-var file_common_proto_extTypes = []protoimpl.ExtensionInfo{
-	{
-		ExtendedType:  (*descriptorpb.EnumValueOptions)(nil),
-		ExtensionType: (*string)(nil),
-		Field:         51234,
-		Name:          "protorender.string_value",
-		Tag:           "bytes,51234,opt,name=string_value",
-		Filename:      "common.proto",
-	},
+func (x *AlertRule) GetFlowId() int64 {
+	if x != nil {
+		return x.FlowId
+	}
+	return 0
 }
 
-// Extension fields to descriptorpb.EnumValueOptions.
+func (x *AlertRule) GetThreshold() float64 {
+	if x != nil {
+		return x.Threshold
+	}
+	return 0
+}
+
+func (x *AlertRule) GetWindowMinutes() int64 {
+	if x != nil {
+		return x.WindowMinutes
+	}
+	return 0
+}
+
+func (x *AlertRule) GetChannelIds() []int64 {
+	if x != nil {
+		return x.ChannelIds
+	}
+	return nil
+}
+
+func (x *AlertRule) GetEnabled() bool {
+	if x != nil {
+		return x.Enabled
+	}
+	return false
+}
+
+func (x *AlertRule) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *AlertRule) GetUpdatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return nil
+}
+
+// AlertSilence mutes the notifications of matching alerts between starts_at and ends_at. Zero or empty matchers
+// match any rule, flow or worker.
+type AlertSilence struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	RuleId        int64                  `protobuf:"varint,2,opt,name=rule_id,proto3" json:"rule_id,omitempty"`
+	FlowId        int64                  `protobuf:"varint,3,opt,name=flow_id,proto3" json:"flow_id,omitempty"`
+	WorkerId      string                 `protobuf:"bytes,4,opt,name=worker_id,proto3" json:"worker_id,omitempty"`
+	Comment       string                 `protobuf:"bytes,5,opt,name=comment,proto3" json:"comment,omitempty"`
+	StartsAt      *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=starts_at,proto3" json:"starts_at,omitempty"`
+	EndsAt        *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=ends_at,proto3" json:"ends_at,omitempty"`
+	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=created_at,proto3" json:"created_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AlertSilence) Reset() {
+	*x = AlertSilence{}
+	mi := &file_common_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AlertSilence) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AlertSilence) ProtoMessage() {}
+
+func (x *AlertSilence) ProtoReflect() protoreflect.Message {
+	mi := &file_common_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AlertSilence.ProtoReflect.Descriptor instead.
+func (*AlertSilence) Descriptor() ([]byte, []int) {
+	return file_common_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *AlertSilence) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *AlertSilence) GetRuleId() int64 {
+	if x != nil {
+		return x.RuleId
+	}
+	return 0
+}
+
+func (x *AlertSilence) GetFlowId() int64 {
+	if x != nil {
+		return x.FlowId
+	}
+	return 0
+}
+
+func (x *AlertSilence) GetWorkerId() string {
+	if x != nil {
+		return x.WorkerId
+	}
+	return ""
+}
+
+func (x *AlertSilence) GetComment() string {
+	if x != nil {
+		return x.Comment
+	}
+	return ""
+}
+
+func (x *AlertSilence) GetStartsAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.StartsAt
+	}
+	return nil
+}
+
+func (x *AlertSilence) GetEndsAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.EndsAt
+	}
+	return nil
+}
+
+func (x *AlertSilence) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+// Alert is an occurrence of an alert rule, firing until its condition clears.
+type Alert struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	RuleId        int64                  `protobuf:"varint,2,opt,name=rule_id,proto3" json:"rule_id,omitempty"`
+	RuleName      string                 `protobuf:"bytes,3,opt,name=rule_name,proto3" json:"rule_name,omitempty"`
+	Type          string                 `protobuf:"bytes,4,opt,name=type,proto3" json:"type,omitempty"`
+	Status        string                 `protobuf:"bytes,5,opt,name=status,proto3" json:"status,omitempty"`
+	FlowId        int64                  `protobuf:"varint,6,opt,name=flow_id,proto3" json:"flow_id,omitempty"`
+	WorkerId      string                 `protobuf:"bytes,7,opt,name=worker_id,proto3" json:"worker_id,omitempty"`
+	Message       string                 `protobuf:"bytes,8,opt,name=message,proto3" json:"message,omitempty"`
+	Silenced      bool                   `protobuf:"varint,9,opt,name=silenced,proto3" json:"silenced,omitempty"`
+	StartedAt     *timestamppb.Timestamp `protobuf:"bytes,10,opt,name=started_at,proto3" json:"started_at,omitempty"`
+	ResolvedAt    *timestamppb.Timestamp `protobuf:"bytes,11,opt,name=resolved_at,proto3,oneof" json:"resolved_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Alert) Reset() {
+	*x = Alert{}
+	mi := &file_common_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Alert) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Alert) ProtoMessage() {}
+
+func (x *Alert) ProtoReflect() protoreflect.Message {
+	mi := &file_common_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Alert.ProtoReflect.Descriptor instead.
+func (*Alert) Descriptor() ([]byte, []int) {
+	return file_common_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *Alert) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *Alert) GetRuleId() int64 {
+	if x != nil {
+		return x.RuleId
+	}
+	return 0
+}
+
+func (x *Alert) GetRuleName() string {
+	if x != nil {
+		return x.RuleName
+	}
+	return ""
+}
+
+func (x *Alert) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+func (x *Alert) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *Alert) GetFlowId() int64 {
+	if x != nil {
+		return x.FlowId
+	}
+	return 0
+}
+
+func (x *Alert) GetWorkerId() string {
+	if x != nil {
+		return x.WorkerId
+	}
+	return ""
+}
+
+func (x *Alert) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *Alert) GetSilenced() bool {
+	if x != nil {
+		return x.Silenced
+	}
+	return false
+}
+
+func (x *Alert) GetStartedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.StartedAt
+	}
+	return nil
+}
+
+func (x *Alert) GetResolvedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ResolvedAt
+	}
+	return nil
+}
+
+type RateLimitCheckRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Label         string                 `protobuf:"bytes,1,opt,name=label,proto3" json:"label,omitempty"`
+	Key           string                 `protobuf:"bytes,2,opt,name=key,proto3" json:"key,omitempty"`
+	Cost          int64                  `protobuf:"varint,3,opt,name=cost,proto3" json:"cost,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RateLimitCheckRequest) Reset() {
+	*x = RateLimitCheckRequest{}
+	mi := &file_common_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RateLimitCheckRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RateLimitCheckRequest) ProtoMessage() {}
+
+func (x *RateLimitCheckRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_common_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RateLimitCheckRequest.ProtoReflect.Descriptor instead.
+func (*RateLimitCheckRequest) Descriptor() ([]byte, []int) {
+	return file_common_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *RateLimitCheckRequest) GetLabel() string {
+	if x != nil {
+		return x.Label
+	}
+	return ""
+}
+
+func (x *RateLimitCheckRequest) GetKey() string {
+	if x != nil {
+		return x.Key
+	}
+	return ""
+}
+
+func (x *RateLimitCheckRequest) GetCost() int64 {
+	if x != nil {
+		return x.Cost
+	}
+	return 0
+}
+
+type RateLimitCheckResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Allowed       bool                   `protobuf:"varint,1,opt,name=allowed,proto3" json:"allowed,omitempty"`
+	RetryAfterMs  int64                  `protobuf:"varint,2,opt,name=retry_after_ms,json=retryAfterMs,proto3" json:"retry_after_ms,omitempty"`
+	Remaining     int64                  `protobuf:"varint,3,opt,name=remaining,proto3" json:"remaining,omitempty"`
+	Limit         int64                  `protobuf:"varint,4,opt,name=limit,proto3" json:"limit,omitempty"`
+	ResetAt       int64                  `protobuf:"varint,5,opt,name=reset_at,json=resetAt,proto3" json:"reset_at,omitempty"`
+	LeaseId       string                 `protobuf:"bytes,6,opt,name=lease_id,json=leaseId,proto3" json:"lease_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RateLimitCheckResponse) Reset() {
+	*x = RateLimitCheckResponse{}
+	mi := &file_common_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RateLimitCheckResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RateLimitCheckResponse) ProtoMessage() {}
+
+func (x *RateLimitCheckResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_common_proto_msgTypes[14]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RateLimitCheckResponse.ProtoReflect.Descriptor instead.
+func (*RateLimitCheckResponse) Descriptor() ([]byte, []int) {
+	return file_common_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *RateLimitCheckResponse) GetAllowed() bool {
+	if x != nil {
+		return x.Allowed
+	}
+	return false
+}
+
+func (x *RateLimitCheckResponse) GetRetryAfterMs() int64 {
+	if x != nil {
+		return x.RetryAfterMs
+	}
+	return 0
+}
+
+func (x *RateLimitCheckResponse) GetRemaining() int64 {
+	if x != nil {
+		return x.Remaining
+	}
+	return 0
+}
+
+func (x *RateLimitCheckResponse) GetLimit() int64 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+func (x *RateLimitCheckResponse) GetResetAt() int64 {
+	if x != nil {
+		return x.ResetAt
+	}
+	return 0
+}
+
+func (x *RateLimitCheckResponse) GetLeaseId() string {
+	if x != nil {
+		return x.LeaseId
+	}
+	return ""
+}
+
+type RateLimitReleaseRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Label         string                 `protobuf:"bytes,1,opt,name=label,proto3" json:"label,omitempty"`
+	Key           string                 `protobuf:"bytes,2,opt,name=key,proto3" json:"key,omitempty"`
+	LeaseId       string                 `protobuf:"bytes,3,opt,name=lease_id,json=leaseId,proto3" json:"lease_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RateLimitReleaseRequest) Reset() {
+	*x = RateLimitReleaseRequest{}
+	mi := &file_common_proto_msgTypes[15]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RateLimitReleaseRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RateLimitReleaseRequest) ProtoMessage() {}
+
+func (x *RateLimitReleaseRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_common_proto_msgTypes[15]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RateLimitReleaseRequest.ProtoReflect.Descriptor instead.
+func (*RateLimitReleaseRequest) Descriptor() ([]byte, []int) {
+	return file_common_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *RateLimitReleaseRequest) GetLabel() string {
+	if x != nil {
+		return x.Label
+	}
+	return ""
+}
+
+func (x *RateLimitReleaseRequest) GetKey() string {
+	if x != nil {
+		return x.Key
+	}
+	return ""
+}
+
+func (x *RateLimitReleaseRequest) GetLeaseId() string {
+	if x != nil {
+		return x.LeaseId
+	}
+	return ""
+}
+
+type Flow_Processor struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Label         string                 `protobuf:"bytes,1,opt,name=label,proto3" json:"label,omitempty"`
+	Component     string                 `protobuf:"bytes,2,opt,name=component,proto3" json:"component,omitempty"`
+	Config        string                 `protobuf:"bytes,3,opt,name=config,proto3" json:"config,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Flow_Processor) Reset() {
+	*x = Flow_Processor{}
+	mi := &file_common_proto_msgTypes[16]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Flow_Processor) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Flow_Processor) ProtoMessage() {}
+
+func (x *Flow_Processor) ProtoReflect() protoreflect.Message {
+	mi := &file_common_proto_msgTypes[16]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Flow_Processor.ProtoReflect.Descriptor instead.
+func (*Flow_Processor) Descriptor() ([]byte, []int) {
+	return file_common_proto_rawDescGZIP(), []int{1, 0}
+}
+
+func (x *Flow_Processor) GetLabel() string {
+	if x != nil {
+		return x.Label
+	}
+	return ""
+}
+
+func (x *Flow_Processor) GetComponent() string {
+	if x != nil {
+		return x.Component
+	}
+	return ""
+}
+
+func (x *Flow_Processor) GetConfig() string {
+	if x != nil {
+		return x.Config
+	}
+	return ""
+}
+
+type EventSettings_Redaction struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Path          string                 `protobuf:"bytes,1,opt,name=path,proto3" json:"path,omitempty"`
+	Pattern       string                 `protobuf:"bytes,2,opt,name=pattern,proto3" json:"pattern,omitempty"`
+	Preset        string                 `protobuf:"bytes,3,opt,name=preset,proto3" json:"preset,omitempty"`
+	Replacement   string                 `protobuf:"bytes,4,opt,name=replacement,proto3" json:"replacement,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *EventSettings_Redaction) Reset() {
+	*x = EventSettings_Redaction{}
+	mi := &file_common_proto_msgTypes[17]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *EventSettings_Redaction) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EventSettings_Redaction) ProtoMessage() {}
+
+func (x *EventSettings_Redaction) ProtoReflect() protoreflect.Message {
+	mi := &file_common_proto_msgTypes[17]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EventSettings_Redaction.ProtoReflect.Descriptor instead.
+func (*EventSettings_Redaction) Descriptor() ([]byte, []int) {
+	return file_common_proto_rawDescGZIP(), []int{2, 0}
+}
+
+func (x *EventSettings_Redaction) GetPath() string {
+	if x != nil {
+		return x.Path
+	}
+	return ""
+}
+
+func (x *EventSettings_Redaction) GetPattern() string {
+	if x != nil {
+		return x.Pattern
+	}
+	return ""
+}
+
+func (x *EventSettings_Redaction) GetPreset() string {
+	if x != nil {
+		return x.Preset
+	}
+	return ""
+}
+
+func (x *EventSettings_Redaction) GetReplacement() string {
+	if x != nil {
+		return x.Replacement
+	}
+	return ""
+}
+
+var file_common_proto_extTypes = []protoimpl.ExtensionInfo{
+	{
+		ExtendedType:  (*descriptorpb.EnumValueOptions)(nil),
+		ExtensionType: (*string)(nil),
+		Field:         51234,
+		Name:          "protorender.string_value",
+		Tag:           "bytes,51234,opt,name=string_value",
+		Filename:      "common.proto",
+	},
+}
+
+// Extension fields to descriptorpb.EnumValueOptions.
 var (
 	// optional string string_value = 51234;
 	E_StringValue = &file_common_proto_extTypes[0]
@@ -990,7 +1776,7 @@ const file_common_proto_rawDesc = "" +
 	"\n" +
 	"\fcommon.proto\x12\vprotorender\x1a google/protobuf/descriptor.proto\x1a\x1fgoogle/protobuf/timestamp.proto\x1a\x17validate/validate.proto\"*\n" +
 	"\x0eCommonResponse\x12\x18\n" +
-	"\amessage\x18\x01 \x01(\tR\amessage\"\xe7\a\n" +
+	"\amessage\x18\x01 \x01(\tR\amessage\"\xfb\t\n" +
 	"\x04Flow\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\x03R\x02id\x12!\n" +
 	"\tparent_id\x18\x02 \x01(\x03H\x00R\tparent_id\x88\x01\x01\x12\x1d\n" +
@@ -1019,7 +1805,11 @@ const file_common_proto_rawDesc = "" +
 	"\tbuffer_id\x18\x10 \x01(\x03H\x02R\tbuffer_id\x88\x01\x01\x12 \n" +
 	"\vis_mcp_tool\x18\x11 \x01(\bR\vis_mcp_tool\x12\x1a\n" +
 	"\bis_ready\x18\x12 \x01(\bR\bis_ready\x12$\n" +
-	"\rbuilder_state\x18\x13 \x01(\tR\rbuilder_state\x1au\n" +
+	"\rbuilder_state\x18\x13 \x01(\tR\rbuilder_state\x125\n" +
+	"\x04slug\x18\x14 \x01(\tB!\xfaB\x1er\x1c\x18d2\x18^([a-z0-9][a-z0-9_-]*)?$R\x04slug\x127\n" +
+	"\x05route\x18\x15 \x01(\tB!\xfaB\x1er\x1c\x18\xff\x012\x17^(/[a-zA-Z0-9._~/-]*)?$R\x05route\x12^\n" +
+	"\rroute_methods\x18\x16 \x03(\tB8\xfaB5\x92\x012\"0r.R\x03GETR\x04HEADR\x04POSTR\x03PUTR\x05PATCHR\x06DELETER\aOPTIONSR\rroute_methods\x12B\n" +
+	"\x0eevent_settings\x18\x17 \x01(\v2\x1a.protorender.EventSettingsR\x0eevent_settings\x1au\n" +
 	"\tProcessor\x122\n" +
 	"\x05label\x18\x01 \x01(\tB\x1c\xfaB\x19r\x17\x10\x01\x18d2\x11^[a-zA-Z0-9 _-]+$R\x05label\x12\x1c\n" +
 	"\tcomponent\x18\x02 \x01(\tR\tcomponent\x12\x16\n" +
@@ -1028,7 +1818,21 @@ const file_common_proto_rawDesc = "" +
 	"_parent_idB\r\n" +
 	"\v_updated_atB\f\n" +
 	"\n" +
-	"_buffer_id\"\x80\x01\n" +
+	"_buffer_id\"\xec\x03\n" +
+	"\rEventSettings\x12>\n" +
+	"\vsample_rate\x18\x01 \x01(\x01B\x17\xfaB\x14\x12\x12\x19\x00\x00\x00\x00\x00\x00\xf0?)\x00\x00\x00\x00\x00\x00\x00\x00H\x00R\vsample_rate\x88\x01\x01\x123\n" +
+	"\x10max_content_size\x18\x02 \x01(\x03B\a\xfaB\x04\"\x02(\x00R\x10max_content_size\x12D\n" +
+	"\n" +
+	"redactions\x18\x03 \x03(\v2$.protorender.EventSettings.RedactionR\n" +
+	"redactions\x12/\n" +
+	"\x0eretention_days\x18\x04 \x01(\x03B\a\xfaB\x04\"\x02(\x00R\x0eretention_days\x12/\n" +
+	"\x0eretention_rows\x18\x05 \x01(\x03B\a\xfaB\x04\"\x02(\x00R\x0eretention_rows\x1a\xad\x01\n" +
+	"\tRedaction\x12\x1c\n" +
+	"\x04path\x18\x01 \x01(\tB\b\xfaB\x05r\x03\x18\xff\x01R\x04path\x12\"\n" +
+	"\apattern\x18\x02 \x01(\tB\b\xfaB\x05r\x03\x18\xe8\aR\apattern\x123\n" +
+	"\x06preset\x18\x03 \x01(\tB\x1b\xfaB\x18r\x16R\x00R\x05emailR\vcard_numberR\x06preset\x12)\n" +
+	"\vreplacement\x18\x04 \x01(\tB\a\xfaB\x04r\x02\x18dR\vreplacementB\x0e\n" +
+	"\f_sample_rate\"\x80\x01\n" +
 	"\x06Secret\x12\x10\n" +
 	"\x03key\x18\x01 \x01(\tR\x03key\x12(\n" +
 	"\x0fencrypted_value\x18\x02 \x01(\tR\x0fencrypted_value\x12:\n" +
@@ -1106,18 +1910,103 @@ const file_common_proto_rawDesc = "" +
 	"updated_at\x88\x01\x01B\f\n" +
 	"\n" +
 	"_parent_idB\r\n" +
-	"\v_updated_at\"j\n" +
+	"\v_updated_at\"\xfe\x02\n" +
+	"\tMcpServer\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\x12!\n" +
+	"\tparent_id\x18\x02 \x01(\x03H\x00R\tparent_id\x88\x01\x01\x121\n" +
+	"\x05label\x18\x03 \x01(\tB\x1b\xfaB\x18r\x16\x10\x01\x18d2\x10^[a-zA-Z0-9_-]+$R\x05label\x129\n" +
+	"\tcomponent\x18\x04 \x01(\tB\x1b\xfaB\x18r\x16R\x0fstreamable_httpR\x03sseR\tcomponent\x12\x16\n" +
+	"\x06config\x18\x05 \x01(\tR\x06config\x12\x1e\n" +
+	"\n" +
+	"is_current\x18\x06 \x01(\bR\n" +
+	"is_current\x12:\n" +
+	"\n" +
+	"created_at\x18\a \x01(\v2\x1a.google.protobuf.TimestampR\n" +
+	"created_at\x12?\n" +
+	"\n" +
+	"updated_at\x18\b \x01(\v2\x1a.google.protobuf.TimestampH\x01R\n" +
+	"updated_at\x88\x01\x01B\f\n" +
+	"\n" +
+	"_parent_idB\r\n" +
+	"\v_updated_at\"\xc7\x02\n" +
+	"\fAlertChannel\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\x12\x1d\n" +
+	"\x04name\x18\x02 \x01(\tB\t\xfaB\x06r\x04\x10\x01\x18dR\x04name\x120\n" +
+	"\x04type\x18\x03 \x01(\tB\x1c\xfaB\x19r\x17R\awebhookR\x05slackR\x05emailR\x04type\x12\x1a\n" +
+	"\x03url\x18\x04 \x01(\tB\b\xfaB\x05r\x03\x18\x80\x10R\x03url\x12.\n" +
+	"\n" +
+	"recipients\x18\x05 \x03(\tB\x0e\xfaB\v\x92\x01\b\x102\"\x04r\x02`\x01R\n" +
+	"recipients\x12:\n" +
+	"\n" +
+	"created_at\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampR\n" +
+	"created_at\x12?\n" +
+	"\n" +
+	"updated_at\x18\a \x01(\v2\x1a.google.protobuf.TimestampH\x00R\n" +
+	"updated_at\x88\x01\x01B\r\n" +
+	"\v_updated_at\"\xfa\x03\n" +
+	"\tAlertRule\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\x12\x1d\n" +
+	"\x04name\x18\x02 \x01(\tB\t\xfaB\x06r\x04\x10\x01\x18dR\x04name\x12X\n" +
+	"\x04type\x18\x03 \x01(\tBD\xfaBAr?R\vflow_failedR\n" +
+	"error_rateR\bno_inputR\vworker_lostR\rlease_expiredR\x04type\x12!\n" +
+	"\aflow_id\x18\x04 \x01(\x03B\a\xfaB\x04\"\x02(\x00R\aflow_id\x125\n" +
+	"\tthreshold\x18\x05 \x01(\x01B\x17\xfaB\x14\x12\x12\x19\x00\x00\x00\x00\x00\x00\xf0?)\x00\x00\x00\x00\x00\x00\x00\x00R\tthreshold\x122\n" +
+	"\x0ewindow_minutes\x18\x06 \x01(\x03B\n" +
+	"\xfaB\a\"\x05\x18\xa0\v(\x00R\x0ewindow_minutes\x120\n" +
+	"\vchannel_ids\x18\a \x03(\x03B\x0e\xfaB\v\x92\x01\b\x10\x14\"\x04\"\x02 \x00R\vchannel_ids\x12\x18\n" +
+	"\aenabled\x18\b \x01(\bR\aenabled\x12:\n" +
+	"\n" +
+	"created_at\x18\t \x01(\v2\x1a.google.protobuf.TimestampR\n" +
+	"created_at\x12?\n" +
+	"\n" +
+	"updated_at\x18\n" +
+	" \x01(\v2\x1a.google.protobuf.TimestampH\x00R\n" +
+	"updated_at\x88\x01\x01B\r\n" +
+	"\v_updated_at\"\xe6\x02\n" +
+	"\fAlertSilence\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\x12!\n" +
+	"\arule_id\x18\x02 \x01(\x03B\a\xfaB\x04\"\x02(\x00R\arule_id\x12!\n" +
+	"\aflow_id\x18\x03 \x01(\x03B\a\xfaB\x04\"\x02(\x00R\aflow_id\x12&\n" +
+	"\tworker_id\x18\x04 \x01(\tB\b\xfaB\x05r\x03\x18\xff\x01R\tworker_id\x12\"\n" +
+	"\acomment\x18\x05 \x01(\tB\b\xfaB\x05r\x03\x18\xe8\aR\acomment\x128\n" +
+	"\tstarts_at\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampR\tstarts_at\x12>\n" +
+	"\aends_at\x18\a \x01(\v2\x1a.google.protobuf.TimestampB\b\xfaB\x05\xb2\x01\x02\b\x01R\aends_at\x12:\n" +
+	"\n" +
+	"created_at\x18\b \x01(\v2\x1a.google.protobuf.TimestampR\n" +
+	"created_at\"\xf8\x02\n" +
+	"\x05Alert\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\x12\x18\n" +
+	"\arule_id\x18\x02 \x01(\x03R\arule_id\x12\x1c\n" +
+	"\trule_name\x18\x03 \x01(\tR\trule_name\x12\x12\n" +
+	"\x04type\x18\x04 \x01(\tR\x04type\x12\x16\n" +
+	"\x06status\x18\x05 \x01(\tR\x06status\x12\x18\n" +
+	"\aflow_id\x18\x06 \x01(\x03R\aflow_id\x12\x1c\n" +
+	"\tworker_id\x18\a \x01(\tR\tworker_id\x12\x18\n" +
+	"\amessage\x18\b \x01(\tR\amessage\x12\x1a\n" +
+	"\bsilenced\x18\t \x01(\bR\bsilenced\x12:\n" +
+	"\n" +
+	"started_at\x18\n" +
+	" \x01(\v2\x1a.google.protobuf.TimestampR\n" +
+	"started_at\x12A\n" +
+	"\vresolved_at\x18\v \x01(\v2\x1a.google.protobuf.TimestampH\x00R\vresolved_at\x88\x01\x01B\x0e\n" +
+	"\f_resolved_at\"j\n" +
 	"\x15RateLimitCheckRequest\x12\x1f\n" +
 	"\x05label\x18\x01 \x01(\tB\t\xfaB\x06r\x04\x10\x01\x18dR\x05label\x12\x1c\n" +
 	"\x03key\x18\x02 \x01(\tB\n" +
 	"\xfaB\ar\x05\x10\x01\x18\xff\x01R\x03key\x12\x12\n" +
-	"\x04cost\x18\x03 \x01(\x03R\x04cost\"\xa7\x01\n" +
+	"\x04cost\x18\x03 \x01(\x03R\x04cost\"\xc2\x01\n" +
 	"\x16RateLimitCheckResponse\x12\x18\n" +
 	"\aallowed\x18\x01 \x01(\bR\aallowed\x12$\n" +
 	"\x0eretry_after_ms\x18\x02 \x01(\x03R\fretryAfterMs\x12\x1c\n" +
 	"\tremaining\x18\x03 \x01(\x03R\tremaining\x12\x14\n" +
 	"\x05limit\x18\x04 \x01(\x03R\x05limit\x12\x19\n" +
-	"\breset_at\x18\x05 \x01(\x03R\aresetAt*\x96\x01\n" +
+	"\breset_at\x18\x05 \x01(\x03R\aresetAt\x12\x19\n" +
+	"\blease_id\x18\x06 \x01(\tR\aleaseId\"|\n" +
+	"\x17RateLimitReleaseRequest\x12\x1f\n" +
+	"\x05label\x18\x01 \x01(\tB\t\xfaB\x06r\x04\x10\x01\x18dR\x05label\x12\x1c\n" +
+	"\x03key\x18\x02 \x01(\tB\n" +
+	"\xfaB\ar\x05\x10\x01\x18\xff\x01R\x03key\x12\"\n" +
+	"\blease_id\x18\x03 \x01(\tB\a\xfaB\x04r\x02\x10\x01R\aleaseId*\x96\x01\n" +
 	"\x10WorkerFlowStatus\x12\x18\n" +
 	"\awaiting\x10\x00\x1a\v\x92\x82\x19\awaiting\x12\x18\n" +
 	"\arunning\x10\x01\x1a\v\x92\x82\x19\arunning\x12\x18\n" +
@@ -1140,41 +2029,62 @@ func file_common_proto_rawDescGZIP() []byte {
 }
 
 var file_common_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
-var file_common_proto_msgTypes = make([]protoimpl.MessageInfo, 10)
+var file_common_proto_msgTypes = make([]protoimpl.MessageInfo, 18)
 var file_common_proto_goTypes = []any{
 	(WorkerFlowStatus)(0),                 // 0: protorender.WorkerFlowStatus
 	(*CommonResponse)(nil),                // 1: protorender.CommonResponse
 	(*Flow)(nil),                          // 2: protorender.Flow
-	(*Secret)(nil),                        // 3: protorender.Secret
-	(*Cache)(nil),                         // 4: protorender.Cache
-	(*Buffer)(nil),                        // 5: protorender.Buffer
-	(*RateLimit)(nil),                     // 6: protorender.RateLimit
-	(*File)(nil),                          // 7: protorender.File
-	(*RateLimitCheckRequest)(nil),         // 8: protorender.RateLimitCheckRequest
-	(*RateLimitCheckResponse)(nil),        // 9: protorender.RateLimitCheckResponse
-	(*Flow_Processor)(nil),                // 10: protorender.Flow.Processor
-	(*timestamppb.Timestamp)(nil),         // 11: google.protobuf.Timestamp
-	(*descriptorpb.EnumValueOptions)(nil), // 12: google.protobuf.EnumValueOptions
+	(*EventSettings)(nil),                 // 3: protorender.EventSettings
+	(*Secret)(nil),                        // 4: protorender.Secret
+	(*Cache)(nil),                         // 5: protorender.Cache
+	(*Buffer)(nil),                        // 6: protorender.Buffer
+	(*RateLimit)(nil),                     // 7: protorender.RateLimit
+	(*File)(nil),                          // 8: protorender.File
+	(*McpServer)(nil),                     // 9: protorender.McpServer
+	(*AlertChannel)(nil),                  // 10: protorender.AlertChannel
+	(*AlertRule)(nil),                     // 11: protorender.AlertRule
+	(*AlertSilence)(nil),                  // 12: protorender.AlertSilence
+	(*Alert)(nil),                         // 13: protorender.Alert
+	(*RateLimitCheckRequest)(nil),         // 14: protorender.RateLimitCheckRequest
+	(*RateLimitCheckResponse)(nil),        // 15: protorender.RateLimitCheckResponse
+	(*RateLimitReleaseRequest)(nil),       // 16: protorender.RateLimitReleaseRequest
+	(*Flow_Processor)(nil),                // 17: protorender.Flow.Processor
+	(*EventSettings_Redaction)(nil),       // 18: protorender.EventSettings.Redaction
+	(*timestamppb.Timestamp)(nil),         // 19: google.protobuf.Timestamp
+	(*descriptorpb.EnumValueOptions)(nil), // 20: google.protobuf.EnumValueOptions
 }
 var file_common_proto_depIdxs = []int32{
-	11, // 0: protorender.Flow.created_at:type_name -> google.protobuf.Timestamp
-	11, // 1: protorender.Flow.updated_at:type_name -> google.protobuf.Timestamp
-	10, // 2: protorender.Flow.processors:type_name -> protorender.Flow.Processor
-	11, // 3: protorender.Secret.created_at:type_name -> google.protobuf.Timestamp
-	11, // 4: protorender.Cache.created_at:type_name -> google.protobuf.Timestamp
-	11, // 5: protorender.Cache.updated_at:type_name -> google.protobuf.Timestamp
-	11, // 6: protorender.Buffer.created_at:type_name -> google.protobuf.Timestamp
-	11, // 7: protorender.Buffer.updated_at:type_name -> google.protobuf.Timestamp
-	11, // 8: protorender.RateLimit.created_at:type_name -> google.protobuf.Timestamp
-	11, // 9: protorender.RateLimit.updated_at:type_name -> google.protobuf.Timestamp
-	11, // 10: protorender.File.created_at:type_name -> google.protobuf.Timestamp
-	11, // 11: protorender.File.updated_at:type_name -> google.protobuf.Timestamp
-	12, // 12: protorender.string_value:extendee -> google.protobuf.EnumValueOptions
-	13, // [13:13] is the sub-list for method output_type
-	13, // [13:13] is the sub-list for method input_type
-	13, // [13:13] is the sub-list for extension type_name
-	12, // [12:13] is the sub-list for extension extendee
-	0,  // [0:12] is the sub-list for field type_name
+	19, // 0: protorender.Flow.created_at:type_name -> google.protobuf.Timestamp
+	19, // 1: protorender.Flow.updated_at:type_name -> google.protobuf.Timestamp
+	17, // 2: protorender.Flow.processors:type_name -> protorender.Flow.Processor
+	3,  // 3: protorender.Flow.event_settings:type_name -> protorender.EventSettings
+	18, // 4: protorender.EventSettings.redactions:type_name -> protorender.EventSettings.Redaction
+	19, // 5: protorender.Secret.created_at:type_name -> google.protobuf.Timestamp
+	19, // 6: protorender.Cache.created_at:type_name -> google.protobuf.Timestamp
+	19, // 7: protorender.Cache.updated_at:type_name -> google.protobuf.Timestamp
+	19, // 8: protorender.Buffer.created_at:type_name -> google.protobuf.Timestamp
+	19, // 9: protorender.Buffer.updated_at:type_name -> google.protobuf.Timestamp
+	19, // 10: protorender.RateLimit.created_at:type_name -> google.protobuf.Timestamp
+	19, // 11: protorender.RateLimit.updated_at:type_name -> google.protobuf.Timestamp
+	19, // 12: protorender.File.created_at:type_name -> google.protobuf.Timestamp
+	19, // 13: protorender.File.updated_at:type_name -> google.protobuf.Timestamp
+	19, // 14: protorender.McpServer.created_at:type_name -> google.protobuf.Timestamp
+	19, // 15: protorender.McpServer.updated_at:type_name -> google.protobuf.Timestamp
+	19, // 16: protorender.AlertChannel.created_at:type_name -> google.protobuf.Timestamp
+	19, // 17: protorender.AlertChannel.updated_at:type_name -> google.protobuf.Timestamp
+	19, // 18: protorender.AlertRule.created_at:type_name -> google.protobuf.Timestamp
+	19, // 19: protorender.AlertRule.updated_at:type_name -> google.protobuf.Timestamp
+	19, // 20: protorender.AlertSilence.starts_at:type_name -> google.protobuf.Timestamp
+	19, // 21: protorender.AlertSilence.ends_at:type_name -> google.protobuf.Timestamp
+	19, // 22: protorender.AlertSilence.created_at:type_name -> google.protobuf.Timestamp
+	19, // 23: protorender.Alert.started_at:type_name -> google.protobuf.Timestamp
+	19, // 24: protorender.Alert.resolved_at:type_name -> google.protobuf.Timestamp
+	20, // 25: protorender.string_value:extendee -> google.protobuf.EnumValueOptions
+	26, // [26:26] is the sub-list for method output_type
+	26, // [26:26] is the sub-list for method input_type
+	26, // [26:26] is the sub-list for extension type_name
+	25, // [25:26] is the sub-list for extension extendee
+	0,  // [0:25] is the sub-list for field type_name
 }
 
 func init() { file_common_proto_init() }
@@ -1183,17 +2093,22 @@ func file_common_proto_init() {
 		return
 	}
 	file_common_proto_msgTypes[1].OneofWrappers = []any{}
-	file_common_proto_msgTypes[3].OneofWrappers = []any{}
+	file_common_proto_msgTypes[2].OneofWrappers = []any{}
 	file_common_proto_msgTypes[4].OneofWrappers = []any{}
 	file_common_proto_msgTypes[5].OneofWrappers = []any{}
 	file_common_proto_msgTypes[6].OneofWrappers = []any{}
+	file_common_proto_msgTypes[7].OneofWrappers = []any{}
+	file_common_proto_msgTypes[8].OneofWrappers = []any{}
+	file_common_proto_msgTypes[9].OneofWrappers = []any{}
+	file_common_proto_msgTypes[10].OneofWrappers = []any{}
+	file_common_proto_msgTypes[12].OneofWrappers = []any{}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_common_proto_rawDesc), len(file_common_proto_rawDesc)),
 			NumEnums:      1,
-			NumMessages:   10,
+			NumMessages:   18,
 			NumExtensions: 1,
 			NumServices:   0,
 		},
@@ -307,6 +307,95 @@ func (m *Flow) validate(all bool) error {
 
 	// no validation rules for BuilderState
 
+	if utf8.RuneCountInString(m.GetSlug()) > 100 {
+		err := FlowValidationError{
+			field:  "Slug",
+			reason: "value length must be at most 100 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if !_Flow_Slug_Pattern.MatchString(m.GetSlug()) {
+		err := FlowValidationError{
+			field:  "Slug",
+			reason: "value does not match regex pattern \"^([a-z0-9][a-z0-9_-]*)?$\"",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if utf8.RuneCountInString(m.GetRoute()) > 255 {
+		err := FlowValidationError{
+			field:  "Route",
+			reason: "value length must be at most 255 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if !_Flow_Route_Pattern.MatchString(m.GetRoute()) {
+		err := FlowValidationError{
+			field:  "Route",
+			reason: "value does not match regex pattern \"^(/[a-zA-Z0-9._~/-]*)?$\"",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	for idx, item := range m.GetRouteMethods() {
+		_, _ = idx, item
+
+		if _, ok := _Flow_RouteMethods_InLookup[item]; !ok {
+			err := FlowValidationError{
+				field:  fmt.Sprintf("RouteMethods[%v]", idx),
+				reason: "value must be in list [GET HEAD POST PUT PATCH DELETE OPTIONS]",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+	}
+
+	if all {
+		switch v := interface{}(m.GetEventSettings()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, FlowValidationError{
+					field:  "EventSettings",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, FlowValidationError{
+					field:  "EventSettings",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetEventSettings()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return FlowValidationError{
+				field:  "EventSettings",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
 	if m.ParentId != nil {
 		// no validation rules for ParentId
 	}
@@ -436,6 +525,202 @@ var _Flow_Status_InLookup = map[string]struct{}{
 	"failed":    {},
 }
 
+var _Flow_Slug_Pattern = regexp.MustCompile("^([a-z0-9][a-z0-9_-]*)?$")
+
+var _Flow_Route_Pattern = regexp.MustCompile("^(/[a-zA-Z0-9._~/-]*)?$")
+
+var _Flow_RouteMethods_InLookup = map[string]struct{}{
+	"GET":     {},
+	"HEAD":    {},
+	"POST":    {},
+	"PUT":     {},
+	"PATCH":   {},
+	"DELETE":  {},
+	"OPTIONS": {},
+}
+
+// Validate checks the field values on EventSettings with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *EventSettings) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on EventSettings with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in EventSettingsMultiError, or
+// nil if none found.
+func (m *EventSettings) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *EventSettings) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if m.GetMaxContentSize() < 0 {
+		err := EventSettingsValidationError{
+			field:  "MaxContentSize",
+			reason: "value must be greater than or equal to 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	for idx, item := range m.GetRedactions() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, EventSettingsValidationError{
+						field:  fmt.Sprintf("Redactions[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, EventSettingsValidationError{
+						field:  fmt.Sprintf("Redactions[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return EventSettingsValidationError{
+					field:  fmt.Sprintf("Redactions[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if m.GetRetentionDays() < 0 {
+		err := EventSettingsValidationError{
+			field:  "RetentionDays",
+			reason: "value must be greater than or equal to 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if m.GetRetentionRows() < 0 {
+		err := EventSettingsValidationError{
+			field:  "RetentionRows",
+			reason: "value must be greater than or equal to 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if m.SampleRate != nil {
+
+		if val := m.GetSampleRate(); val < 0 || val > 1 {
+			err := EventSettingsValidationError{
+				field:  "SampleRate",
+				reason: "value must be inside range [0, 1]",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return EventSettingsMultiError(errors)
+	}
+
+	return nil
+}
+
+// EventSettingsMultiError is an error wrapping multiple validation errors
+// returned by EventSettings.ValidateAll() if the designated constraints
+// aren't met.
+type EventSettingsMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m EventSettingsMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m EventSettingsMultiError) AllErrors() []error { return m }
+
+// EventSettingsValidationError is the validation error returned by
+// EventSettings.Validate if the designated constraints aren't met.
+type EventSettingsValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e EventSettingsValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e EventSettingsValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e EventSettingsValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e EventSettingsValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e EventSettingsValidationError) ErrorName() string { return "EventSettingsValidationError" }
+
+// Error satisfies the builtin error interface
+func (e EventSettingsValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sEventSettings.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = EventSettingsValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = EventSettingsValidationError{}
+
 // Validate checks the field values on Secret with the rules defined in the
 // proto definition for this message. If any rules are violated, the first
 // error encountered is returned, or nil if there are no violations.
@@ -1379,30 +1664,32 @@ var _ interface {
 
 var _File_Key_Pattern = regexp.MustCompile("^[a-zA-Z0-9._/ -]+$")
 
-// Validate checks the field values on RateLimitCheckRequest with the rules
-// defined in the proto definition for this message. If any rules are
-// violated, the first error encountered is returned, or nil if there are no violations.
-func (m *RateLimitCheckRequest) Validate() error {
+// Validate checks the field values on McpServer with the rules defined in the
+// proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *McpServer) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on RateLimitCheckRequest with the rules
-// defined in the proto definition for this message. If any rules are
-// violated, the result is a list of violation errors wrapped in
-// RateLimitCheckRequestMultiError, or nil if none found.
-func (m *RateLimitCheckRequest) ValidateAll() error {
+// ValidateAll checks the field values on McpServer with the rules defined in
+// the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in McpServerMultiError, or nil
+// if none found.
+func (m *McpServer) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *RateLimitCheckRequest) validate(all bool) error {
+func (m *McpServer) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
+	// no validation rules for Id
+
 	if l := utf8.RuneCountInString(m.GetLabel()); l < 1 || l > 100 {
-		err := RateLimitCheckRequestValidationError{
+		err := McpServerValidationError{
 			field:  "Label",
 			reason: "value length must be between 1 and 100 runes, inclusive",
 		}
@@ -1412,10 +1699,10 @@ func (m *RateLimitCheckRequest) validate(all bool) error {
 		errors = append(errors, err)
 	}
 
-	if l := utf8.RuneCountInString(m.GetKey()); l < 1 || l > 255 {
-		err := RateLimitCheckRequestValidationError{
-			field:  "Key",
-			reason: "value length must be between 1 and 255 runes, inclusive",
+	if !_McpServer_Label_Pattern.MatchString(m.GetLabel()) {
+		err := McpServerValidationError{
+			field:  "Label",
+			reason: "value does not match regex pattern \"^[a-zA-Z0-9_-]+$\"",
 		}
 		if !all {
 			return err
@@ -1423,37 +1710,1173 @@ func (m *RateLimitCheckRequest) validate(all bool) error {
 		errors = append(errors, err)
 	}
 
-	// no validation rules for Cost
-
-	if len(errors) > 0 {
-		return RateLimitCheckRequestMultiError(errors)
+	if _, ok := _McpServer_Component_InLookup[m.GetComponent()]; !ok {
+		err := McpServerValidationError{
+			field:  "Component",
+			reason: "value must be in list [streamable_http sse]",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
 	}
 
-	return nil
-}
-
-// RateLimitCheckRequestMultiError is an error wrapping multiple validation
-// errors returned by RateLimitCheckRequest.ValidateAll() if the designated
-// constraints aren't met.
-type RateLimitCheckRequestMultiError []error
-
-// Error returns a concatenation of all the error messages it wraps.
-func (m RateLimitCheckRequestMultiError) Error() string {
-	msgs := make([]string, 0, len(m))
-	for _, err := range m {
-		msgs = append(msgs, err.Error())
-	}
-	return strings.Join(msgs, "; ")
-}
+	// no validation rules for Config
 
-// AllErrors returns a list of validation violation errors.
-func (m RateLimitCheckRequestMultiError) AllErrors() []error { return m }
+	// no validation rules for IsCurrent
 
-// RateLimitCheckRequestValidationError is the validation error returned by
-// RateLimitCheckRequest.Validate if the designated constraints aren't met.
-type RateLimitCheckRequestValidationError struct {
-	field  string
-	reason string
+	if all {
+		switch v := interface{}(m.GetCreatedAt()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, McpServerValidationError{
+					field:  "CreatedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, McpServerValidationError{
+					field:  "CreatedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetCreatedAt()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return McpServerValidationError{
+				field:  "CreatedAt",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if m.ParentId != nil {
+		// no validation rules for ParentId
+	}
+
+	if m.UpdatedAt != nil {
+
+		if all {
+			switch v := interface{}(m.GetUpdatedAt()).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, McpServerValidationError{
+						field:  "UpdatedAt",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, McpServerValidationError{
+						field:  "UpdatedAt",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(m.GetUpdatedAt()).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return McpServerValidationError{
+					field:  "UpdatedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return McpServerMultiError(errors)
+	}
+
+	return nil
+}
+
+// McpServerMultiError is an error wrapping multiple validation errors returned
+// by McpServer.ValidateAll() if the designated constraints aren't met.
+type McpServerMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m McpServerMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m McpServerMultiError) AllErrors() []error { return m }
+
+// McpServerValidationError is the validation error returned by
+// McpServer.Validate if the designated constraints aren't met.
+type McpServerValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e McpServerValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e McpServerValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e McpServerValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e McpServerValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e McpServerValidationError) ErrorName() string { return "McpServerValidationError" }
+
+// Error satisfies the builtin error interface
+func (e McpServerValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sMcpServer.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = McpServerValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = McpServerValidationError{}
+
+var _McpServer_Label_Pattern = regexp.MustCompile("^[a-zA-Z0-9_-]+$")
+
+var _McpServer_Component_InLookup = map[string]struct{}{
+	"streamable_http": {},
+	"sse":             {},
+}
+
+// Validate checks the field values on AlertChannel with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *AlertChannel) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on AlertChannel with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in AlertChannelMultiError, or
+// nil if none found.
+func (m *AlertChannel) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *AlertChannel) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Id
+
+	if l := utf8.RuneCountInString(m.GetName()); l < 1 || l > 100 {
+		err := AlertChannelValidationError{
+			field:  "Name",
+			reason: "value length must be between 1 and 100 runes, inclusive",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if _, ok := _AlertChannel_Type_InLookup[m.GetType()]; !ok {
+		err := AlertChannelValidationError{
+			field:  "Type",
+			reason: "value must be in list [webhook slack email]",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if utf8.RuneCountInString(m.GetUrl()) > 2048 {
+		err := AlertChannelValidationError{
+			field:  "Url",
+			reason: "value length must be at most 2048 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(m.GetRecipients()) > 50 {
+		err := AlertChannelValidationError{
+			field:  "Recipients",
+			reason: "value must contain no more than 50 item(s)",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	for idx, item := range m.GetRecipients() {
+		_, _ = idx, item
+
+		if err := m._validateEmail(item); err != nil {
+			err = AlertChannelValidationError{
+				field:  fmt.Sprintf("Recipients[%v]", idx),
+				reason: "value must be a valid email address",
+				cause:  err,
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+	}
+
+	if all {
+		switch v := interface{}(m.GetCreatedAt()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, AlertChannelValidationError{
+					field:  "CreatedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, AlertChannelValidationError{
+					field:  "CreatedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetCreatedAt()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return AlertChannelValidationError{
+				field:  "CreatedAt",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if m.UpdatedAt != nil {
+
+		if all {
+			switch v := interface{}(m.GetUpdatedAt()).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, AlertChannelValidationError{
+						field:  "UpdatedAt",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, AlertChannelValidationError{
+						field:  "UpdatedAt",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(m.GetUpdatedAt()).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return AlertChannelValidationError{
+					field:  "UpdatedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return AlertChannelMultiError(errors)
+	}
+
+	return nil
+}
+
+func (m *AlertChannel) _validateHostname(host string) error {
+	s := strings.ToLower(strings.TrimSuffix(host, "."))
+
+	if len(host) > 253 {
+		return errors.New("hostname cannot exceed 253 characters")
+	}
+
+	for _, part := range strings.Split(s, ".") {
+		if l := len(part); l == 0 || l > 63 {
+			return errors.New("hostname part must be non-empty and cannot exceed 63 characters")
+		}
+
+		if part[0] == '-' {
+			return errors.New("hostname parts cannot begin with hyphens")
+		}
+
+		if part[len(part)-1] == '-' {
+			return errors.New("hostname parts cannot end with hyphens")
+		}
+
+		for _, r := range part {
+			if (r < 'a' || r > 'z') && (r < '0' || r > '9') && r != '-' {
+				return fmt.Errorf("hostname parts can only contain alphanumeric characters or hyphens, got %q", string(r))
+			}
+		}
+	}
+
+	return nil
+}
+
+func (m *AlertChannel) _validateEmail(addr string) error {
+	a, err := mail.ParseAddress(addr)
+	if err != nil {
+		return err
+	}
+	addr = a.Address
+
+	if len(addr) > 254 {
+		return errors.New("email addresses cannot exceed 254 characters")
+	}
+
+	parts := strings.SplitN(addr, "@", 2)
+
+	if len(parts[0]) > 64 {
+		return errors.New("email address local phrase cannot exceed 64 characters")
+	}
+
+	return m._validateHostname(parts[1])
+}
+
+// AlertChannelMultiError is an error wrapping multiple validation errors
+// returned by AlertChannel.ValidateAll() if the designated constraints aren't met.
+type AlertChannelMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m AlertChannelMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m AlertChannelMultiError) AllErrors() []error { return m }
+
+// AlertChannelValidationError is the validation error returned by
+// AlertChannel.Validate if the designated constraints aren't met.
+type AlertChannelValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e AlertChannelValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e AlertChannelValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e AlertChannelValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e AlertChannelValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e AlertChannelValidationError) ErrorName() string { return "AlertChannelValidationError" }
+
+// Error satisfies the builtin error interface
+func (e AlertChannelValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sAlertChannel.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = AlertChannelValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = AlertChannelValidationError{}
+
+var _AlertChannel_Type_InLookup = map[string]struct{}{
+	"webhook": {},
+	"slack":   {},
+	"email":   {},
+}
+
+// Validate checks the field values on AlertRule with the rules defined in the
+// proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *AlertRule) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on AlertRule with the rules defined in
+// the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in AlertRuleMultiError, or nil
+// if none found.
+func (m *AlertRule) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *AlertRule) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Id
+
+	if l := utf8.RuneCountInString(m.GetName()); l < 1 || l > 100 {
+		err := AlertRuleValidationError{
+			field:  "Name",
+			reason: "value length must be between 1 and 100 runes, inclusive",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if _, ok := _AlertRule_Type_InLookup[m.GetType()]; !ok {
+		err := AlertRuleValidationError{
+			field:  "Type",
+			reason: "value must be in list [flow_failed error_rate no_input worker_lost lease_expired]",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if m.GetFlowId() < 0 {
+		err := AlertRuleValidationError{
+			field:  "FlowId",
+			reason: "value must be greater than or equal to 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if val := m.GetThreshold(); val < 0 || val > 1 {
+		err := AlertRuleValidationError{
+			field:  "Threshold",
+			reason: "value must be inside range [0, 1]",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if val := m.GetWindowMinutes(); val < 0 || val > 1440 {
+		err := AlertRuleValidationError{
+			field:  "WindowMinutes",
+			reason: "value must be inside range [0, 1440]",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(m.GetChannelIds()) > 20 {
+		err := AlertRuleValidationError{
+			field:  "ChannelIds",
+			reason: "value must contain no more than 20 item(s)",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	for idx, item := range m.GetChannelIds() {
+		_, _ = idx, item
+
+		if item <= 0 {
+			err := AlertRuleValidationError{
+				field:  fmt.Sprintf("ChannelIds[%v]", idx),
+				reason: "value must be greater than 0",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+	}
+
+	// no validation rules for Enabled
+
+	if all {
+		switch v := interface{}(m.GetCreatedAt()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, AlertRuleValidationError{
+					field:  "CreatedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, AlertRuleValidationError{
+					field:  "CreatedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetCreatedAt()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return AlertRuleValidationError{
+				field:  "CreatedAt",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if m.UpdatedAt != nil {
+
+		if all {
+			switch v := interface{}(m.GetUpdatedAt()).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, AlertRuleValidationError{
+						field:  "UpdatedAt",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, AlertRuleValidationError{
+						field:  "UpdatedAt",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(m.GetUpdatedAt()).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return AlertRuleValidationError{
+					field:  "UpdatedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return AlertRuleMultiError(errors)
+	}
+
+	return nil
+}
+
+// AlertRuleMultiError is an error wrapping multiple validation errors returned
+// by AlertRule.ValidateAll() if the designated constraints aren't met.
+type AlertRuleMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m AlertRuleMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m AlertRuleMultiError) AllErrors() []error { return m }
+
+// AlertRuleValidationError is the validation error returned by
+// AlertRule.Validate if the designated constraints aren't met.
+type AlertRuleValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e AlertRuleValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e AlertRuleValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e AlertRuleValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e AlertRuleValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e AlertRuleValidationError) ErrorName() string { return "AlertRuleValidationError" }
+
+// Error satisfies the builtin error interface
+func (e AlertRuleValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sAlertRule.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = AlertRuleValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = AlertRuleValidationError{}
+
+var _AlertRule_Type_InLookup = map[string]struct{}{
+	"flow_failed":   {},
+	"error_rate":    {},
+	"no_input":      {},
+	"worker_lost":   {},
+	"lease_expired": {},
+}
+
+// Validate checks the field values on AlertSilence with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *AlertSilence) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on AlertSilence with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in AlertSilenceMultiError, or
+// nil if none found.
+func (m *AlertSilence) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *AlertSilence) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Id
+
+	if m.GetRuleId() < 0 {
+		err := AlertSilenceValidationError{
+			field:  "RuleId",
+			reason: "value must be greater than or equal to 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if m.GetFlowId() < 0 {
+		err := AlertSilenceValidationError{
+			field:  "FlowId",
+			reason: "value must be greater than or equal to 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if utf8.RuneCountInString(m.GetWorkerId()) > 255 {
+		err := AlertSilenceValidationError{
+			field:  "WorkerId",
+			reason: "value length must be at most 255 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if utf8.RuneCountInString(m.GetComment()) > 1000 {
+		err := AlertSilenceValidationError{
+			field:  "Comment",
+			reason: "value length must be at most 1000 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if all {
+		switch v := interface{}(m.GetStartsAt()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, AlertSilenceValidationError{
+					field:  "StartsAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, AlertSilenceValidationError{
+					field:  "StartsAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetStartsAt()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return AlertSilenceValidationError{
+				field:  "StartsAt",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if m.GetEndsAt() == nil {
+		err := AlertSilenceValidationError{
+			field:  "EndsAt",
+			reason: "value is required",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if all {
+		switch v := interface{}(m.GetCreatedAt()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, AlertSilenceValidationError{
+					field:  "CreatedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, AlertSilenceValidationError{
+					field:  "CreatedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetCreatedAt()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return AlertSilenceValidationError{
+				field:  "CreatedAt",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return AlertSilenceMultiError(errors)
+	}
+
+	return nil
+}
+
+// AlertSilenceMultiError is an error wrapping multiple validation errors
+// returned by AlertSilence.ValidateAll() if the designated constraints aren't met.
+type AlertSilenceMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m AlertSilenceMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m AlertSilenceMultiError) AllErrors() []error { return m }
+
+// AlertSilenceValidationError is the validation error returned by
+// AlertSilence.Validate if the designated constraints aren't met.
+type AlertSilenceValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e AlertSilenceValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e AlertSilenceValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e AlertSilenceValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e AlertSilenceValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e AlertSilenceValidationError) ErrorName() string { return "AlertSilenceValidationError" }
+
+// Error satisfies the builtin error interface
+func (e AlertSilenceValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sAlertSilence.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = AlertSilenceValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = AlertSilenceValidationError{}
+
+// Validate checks the field values on Alert with the rules defined in the
+// proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *Alert) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on Alert with the rules defined in the
+// proto definition for this message. If any rules are violated, the result is
+// a list of violation errors wrapped in AlertMultiError, or nil if none found.
+func (m *Alert) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *Alert) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Id
+
+	// no validation rules for RuleId
+
+	// no validation rules for RuleName
+
+	// no validation rules for Type
+
+	// no validation rules for Status
+
+	// no validation rules for FlowId
+
+	// no validation rules for WorkerId
+
+	// no validation rules for Message
+
+	// no validation rules for Silenced
+
+	if all {
+		switch v := interface{}(m.GetStartedAt()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, AlertValidationError{
+					field:  "StartedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, AlertValidationError{
+					field:  "StartedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetStartedAt()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return AlertValidationError{
+				field:  "StartedAt",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if m.ResolvedAt != nil {
+
+		if all {
+			switch v := interface{}(m.GetResolvedAt()).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, AlertValidationError{
+						field:  "ResolvedAt",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, AlertValidationError{
+						field:  "ResolvedAt",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(m.GetResolvedAt()).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return AlertValidationError{
+					field:  "ResolvedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return AlertMultiError(errors)
+	}
+
+	return nil
+}
+
+// AlertMultiError is an error wrapping multiple validation errors returned by
+// Alert.ValidateAll() if the designated constraints aren't met.
+type AlertMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m AlertMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m AlertMultiError) AllErrors() []error { return m }
+
+// AlertValidationError is the validation error returned by Alert.Validate if
+// the designated constraints aren't met.
+type AlertValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e AlertValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e AlertValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e AlertValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e AlertValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e AlertValidationError) ErrorName() string { return "AlertValidationError" }
+
+// Error satisfies the builtin error interface
+func (e AlertValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sAlert.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = AlertValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = AlertValidationError{}
+
+// Validate checks the field values on RateLimitCheckRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *RateLimitCheckRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on RateLimitCheckRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// RateLimitCheckRequestMultiError, or nil if none found.
+func (m *RateLimitCheckRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *RateLimitCheckRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if l := utf8.RuneCountInString(m.GetLabel()); l < 1 || l > 100 {
+		err := RateLimitCheckRequestValidationError{
+			field:  "Label",
+			reason: "value length must be between 1 and 100 runes, inclusive",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if l := utf8.RuneCountInString(m.GetKey()); l < 1 || l > 255 {
+		err := RateLimitCheckRequestValidationError{
+			field:  "Key",
+			reason: "value length must be between 1 and 255 runes, inclusive",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	// no validation rules for Cost
+
+	if len(errors) > 0 {
+		return RateLimitCheckRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// RateLimitCheckRequestMultiError is an error wrapping multiple validation
+// errors returned by RateLimitCheckRequest.ValidateAll() if the designated
+// constraints aren't met.
+type RateLimitCheckRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m RateLimitCheckRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m RateLimitCheckRequestMultiError) AllErrors() []error { return m }
+
+// RateLimitCheckRequestValidationError is the validation error returned by
+// RateLimitCheckRequest.Validate if the designated constraints aren't met.
+type RateLimitCheckRequestValidationError struct {
+	field  string
+	reason string
 	cause  error
 	key    bool
 }
@@ -1537,6 +2960,8 @@ func (m *RateLimitCheckResponse) validate(all bool) error {
 
 	// no validation rules for ResetAt
 
+	// no validation rules for LeaseId
+
 	if len(errors) > 0 {
 		return RateLimitCheckResponseMultiError(errors)
 	}
@@ -1617,6 +3042,141 @@ var _ interface {
 	ErrorName() string
 } = RateLimitCheckResponseValidationError{}
 
+// Validate checks the field values on RateLimitReleaseRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *RateLimitReleaseRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on RateLimitReleaseRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// RateLimitReleaseRequestMultiError, or nil if none found.
+func (m *RateLimitReleaseRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *RateLimitReleaseRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if l := utf8.RuneCountInString(m.GetLabel()); l < 1 || l > 100 {
+		err := RateLimitReleaseRequestValidationError{
+			field:  "Label",
+			reason: "value length must be between 1 and 100 runes, inclusive",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if l := utf8.RuneCountInString(m.GetKey()); l < 1 || l > 255 {
+		err := RateLimitReleaseRequestValidationError{
+			field:  "Key",
+			reason: "value length must be between 1 and 255 runes, inclusive",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if utf8.RuneCountInString(m.GetLeaseId()) < 1 {
+		err := RateLimitReleaseRequestValidationError{
+			field:  "LeaseId",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return RateLimitReleaseRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// RateLimitReleaseRequestMultiError is an error wrapping multiple validation
+// errors returned by RateLimitReleaseRequest.ValidateAll() if the designated
+// constraints aren't met.
+type RateLimitReleaseRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m RateLimitReleaseRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m RateLimitReleaseRequestMultiError) AllErrors() []error { return m }
+
+// RateLimitReleaseRequestValidationError is the validation error returned by
+// RateLimitReleaseRequest.Validate if the designated constraints aren't met.
+type RateLimitReleaseRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e RateLimitReleaseRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e RateLimitReleaseRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e RateLimitReleaseRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e RateLimitReleaseRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e RateLimitReleaseRequestValidationError) ErrorName() string {
+	return "RateLimitReleaseRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e RateLimitReleaseRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sRateLimitReleaseRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = RateLimitReleaseRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = RateLimitReleaseRequestValidationError{}
+
 // Validate checks the field values on Flow_Processor with the rules defined in
 // the proto definition for this message. If any rules are violated, the first
 // error encountered is returned, or nil if there are no violations.
@@ -1744,3 +3304,155 @@ var _ interface {
 } = Flow_ProcessorValidationError{}
 
 var _Flow_Processor_Label_Pattern = regexp.MustCompile("^[a-zA-Z0-9 _-]+$")
+
+// Validate checks the field values on EventSettings_Redaction with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *EventSettings_Redaction) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on EventSettings_Redaction with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// EventSettings_RedactionMultiError, or nil if none found.
+func (m *EventSettings_Redaction) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *EventSettings_Redaction) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if utf8.RuneCountInString(m.GetPath()) > 255 {
+		err := EventSettings_RedactionValidationError{
+			field:  "Path",
+			reason: "value length must be at most 255 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if utf8.RuneCountInString(m.GetPattern()) > 1000 {
+		err := EventSettings_RedactionValidationError{
+			field:  "Pattern",
+			reason: "value length must be at most 1000 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if _, ok := _EventSettings_Redaction_Preset_InLookup[m.GetPreset()]; !ok {
+		err := EventSettings_RedactionValidationError{
+			field:  "Preset",
+			reason: "value must be in list [ email card_number]",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if utf8.RuneCountInString(m.GetReplacement()) > 100 {
+		err := EventSettings_RedactionValidationError{
+			field:  "Replacement",
+			reason: "value length must be at most 100 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return EventSettings_RedactionMultiError(errors)
+	}
+
+	return nil
+}
+
+// EventSettings_RedactionMultiError is an error wrapping multiple validation
+// errors returned by EventSettings_Redaction.ValidateAll() if the designated
+// constraints aren't met.
+type EventSettings_RedactionMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m EventSettings_RedactionMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m EventSettings_RedactionMultiError) AllErrors() []error { return m }
+
+// EventSettings_RedactionValidationError is the validation error returned by
+// EventSettings_Redaction.Validate if the designated constraints aren't met.
+type EventSettings_RedactionValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e EventSettings_RedactionValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e EventSettings_RedactionValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e EventSettings_RedactionValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e EventSettings_RedactionValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e EventSettings_RedactionValidationError) ErrorName() string {
+	return "EventSettings_RedactionValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e EventSettings_RedactionValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sEventSettings_Redaction.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = EventSettings_RedactionValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = EventSettings_RedactionValidationError{}
+
+var _EventSettings_Redaction_Preset_InLookup = map[string]struct{}{
+	"":            {},
+	"email":       {},
+	"card_number": {},
+}
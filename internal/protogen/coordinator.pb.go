@@ -577,8 +577,10 @@ type Event struct {
 	TraceId        string                 `protobuf:"bytes,7,opt,name=trace_id,json=traceId,proto3" json:"trace_id,omitempty"`
 	Id             int64                  `protobuf:"varint,8,opt,name=id,proto3" json:"id,omitempty"`
 	CreatedAt      *timestamppb.Timestamp `protobuf:"bytes,9,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
-	unknownFields  protoimpl.UnknownFields
-	sizeCache      protoimpl.SizeCache
+	// Key identifies the event across the sends of its batch, the coordinator stores an event once per key.
+	Key           string `protobuf:"bytes,10,opt,name=key,proto3" json:"key,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
 func (x *Event) Reset() {
@@ -674,31 +676,39 @@ func (x *Event) GetCreatedAt() *timestamppb.Timestamp {
 	return nil
 }
 
-type ListEventsRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	FlowId        int64                  `protobuf:"varint,1,opt,name=flow_id,json=flowId,proto3" json:"flow_id,omitempty"`
-	Limit         int64                  `protobuf:"varint,2,opt,name=limit,proto3" json:"limit,omitempty"`
-	Offset        int64                  `protobuf:"varint,3,opt,name=offset,proto3" json:"offset,omitempty"`
-	StartTime     *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=start_time,json=startTime,proto3" json:"start_time,omitempty"`
-	EndTime       *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=end_time,json=endTime,proto3" json:"end_time,omitempty"`
+func (x *Event) GetKey() string {
+	if x != nil {
+		return x.Key
+	}
+	return ""
+}
+
+// EventBatch carries the events a worker drained from its flows. Sequence numbers grow with every batch a worker
+// sends, batches sent again after a reconnect keep theirs.
+type EventBatch struct {
+	state    protoimpl.MessageState `protogen:"open.v1"`
+	Sequence uint64                 `protobuf:"varint,1,opt,name=sequence,proto3" json:"sequence,omitempty"`
+	Events   []*Event               `protobuf:"bytes,2,rep,name=events,proto3" json:"events,omitempty"`
+	// Events dropped from the buffer of the worker since its previous batch because it was full, by worker flow.
+	Dropped       map[int64]uint64 `protobuf:"bytes,3,rep,name=dropped,proto3" json:"dropped,omitempty" protobuf_key:"varint,1,opt,name=key" protobuf_val:"varint,2,opt,name=value"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ListEventsRequest) Reset() {
-	*x = ListEventsRequest{}
+func (x *EventBatch) Reset() {
+	*x = EventBatch{}
 	mi := &file_coordinator_proto_msgTypes[12]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ListEventsRequest) String() string {
+func (x *EventBatch) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListEventsRequest) ProtoMessage() {}
+func (*EventBatch) ProtoMessage() {}
 
-func (x *ListEventsRequest) ProtoReflect() protoreflect.Message {
+func (x *EventBatch) ProtoReflect() protoreflect.Message {
 	mi := &file_coordinator_proto_msgTypes[12]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -710,68 +720,54 @@ func (x *ListEventsRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListEventsRequest.ProtoReflect.Descriptor instead.
-func (*ListEventsRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use EventBatch.ProtoReflect.Descriptor instead.
+func (*EventBatch) Descriptor() ([]byte, []int) {
 	return file_coordinator_proto_rawDescGZIP(), []int{12}
 }
 
-func (x *ListEventsRequest) GetFlowId() int64 {
-	if x != nil {
-		return x.FlowId
-	}
-	return 0
-}
-
-func (x *ListEventsRequest) GetLimit() int64 {
-	if x != nil {
-		return x.Limit
-	}
-	return 0
-}
-
-func (x *ListEventsRequest) GetOffset() int64 {
+func (x *EventBatch) GetSequence() uint64 {
 	if x != nil {
-		return x.Offset
+		return x.Sequence
 	}
 	return 0
 }
 
-func (x *ListEventsRequest) GetStartTime() *timestamppb.Timestamp {
+func (x *EventBatch) GetEvents() []*Event {
 	if x != nil {
-		return x.StartTime
+		return x.Events
 	}
 	return nil
 }
 
-func (x *ListEventsRequest) GetEndTime() *timestamppb.Timestamp {
+func (x *EventBatch) GetDropped() map[int64]uint64 {
 	if x != nil {
-		return x.EndTime
+		return x.Dropped
 	}
 	return nil
 }
 
-type ListEventsResponse struct {
+// EventAck confirms that the batches up to and including sequence were stored.
+type EventAck struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Data          []*Event               `protobuf:"bytes,1,rep,name=data,proto3" json:"data,omitempty"`
-	Total         int64                  `protobuf:"varint,2,opt,name=total,proto3" json:"total,omitempty"`
+	Sequence      uint64                 `protobuf:"varint,1,opt,name=sequence,proto3" json:"sequence,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ListEventsResponse) Reset() {
-	*x = ListEventsResponse{}
+func (x *EventAck) Reset() {
+	*x = EventAck{}
 	mi := &file_coordinator_proto_msgTypes[13]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ListEventsResponse) String() string {
+func (x *EventAck) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListEventsResponse) ProtoMessage() {}
+func (*EventAck) ProtoMessage() {}
 
-func (x *ListEventsResponse) ProtoReflect() protoreflect.Message {
+func (x *EventAck) ProtoReflect() protoreflect.Message {
 	mi := &file_coordinator_proto_msgTypes[13]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -783,52 +779,52 @@ func (x *ListEventsResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListEventsResponse.ProtoReflect.Descriptor instead.
-func (*ListEventsResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use EventAck.ProtoReflect.Descriptor instead.
+func (*EventAck) Descriptor() ([]byte, []int) {
 	return file_coordinator_proto_rawDescGZIP(), []int{13}
 }
 
-func (x *ListEventsResponse) GetData() []*Event {
-	if x != nil {
-		return x.Data
-	}
-	return nil
-}
-
-func (x *ListEventsResponse) GetTotal() int64 {
+func (x *EventAck) GetSequence() uint64 {
 	if x != nil {
-		return x.Total
+		return x.Sequence
 	}
 	return 0
 }
 
-type MetricsRequest struct {
-	state                      protoimpl.MessageState `protogen:"open.v1"`
-	WorkerFlowId               int64                  `protobuf:"varint,1,opt,name=worker_flow_id,json=workerFlowId,proto3" json:"worker_flow_id,omitempty"`
-	InputEvents                uint64                 `protobuf:"varint,2,opt,name=input_events,json=inputEvents,proto3" json:"input_events,omitempty"`
-	ProcessorErrors            uint64                 `protobuf:"varint,3,opt,name=processor_errors,json=processorErrors,proto3" json:"processor_errors,omitempty"`
-	OutputEvents               uint64                 `protobuf:"varint,4,opt,name=output_events,json=outputEvents,proto3" json:"output_events,omitempty"`
-	InputEventsByComponent     map[string]uint64      `protobuf:"bytes,5,rep,name=input_events_by_component,json=inputEventsByComponent,proto3" json:"input_events_by_component,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"varint,2,opt,name=value"`
-	ProcessorEventsByComponent map[string]uint64      `protobuf:"bytes,6,rep,name=processor_events_by_component,json=processorEventsByComponent,proto3" json:"processor_events_by_component,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"varint,2,opt,name=value"`
-	OutputEventsByComponent    map[string]uint64      `protobuf:"bytes,7,rep,name=output_events_by_component,json=outputEventsByComponent,proto3" json:"output_events_by_component,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"varint,2,opt,name=value"`
-	unknownFields              protoimpl.UnknownFields
-	sizeCache                  protoimpl.SizeCache
+type ListEventsRequest struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	FlowId         int64                  `protobuf:"varint,1,opt,name=flow_id,json=flowId,proto3" json:"flow_id,omitempty"`
+	Limit          int64                  `protobuf:"varint,2,opt,name=limit,proto3" json:"limit,omitempty"`
+	Offset         int64                  `protobuf:"varint,3,opt,name=offset,proto3" json:"offset,omitempty"`
+	StartTime      *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=start_time,json=startTime,proto3" json:"start_time,omitempty"`
+	EndTime        *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=end_time,json=endTime,proto3" json:"end_time,omitempty"`
+	Section        string                 `protobuf:"bytes,6,opt,name=section,proto3" json:"section,omitempty"`
+	ComponentLabel string                 `protobuf:"bytes,7,opt,name=component_label,json=componentLabel,proto3" json:"component_label,omitempty"`
+	Type           string                 `protobuf:"bytes,8,opt,name=type,proto3" json:"type,omitempty"`
+	TraceId        string                 `protobuf:"bytes,9,opt,name=trace_id,json=traceId,proto3" json:"trace_id,omitempty"`
+	WorkerFlowId   int64                  `protobuf:"varint,10,opt,name=worker_flow_id,json=workerFlowId,proto3" json:"worker_flow_id,omitempty"`
+	// Version of the flow the events were reported for, it must belong to flow_id.
+	FlowVersionId int64 `protobuf:"varint,11,opt,name=flow_version_id,json=flowVersionId,proto3" json:"flow_version_id,omitempty"`
+	// Case-insensitive text searched in the content and metadata of the events.
+	Search        string `protobuf:"bytes,12,opt,name=search,proto3" json:"search,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *MetricsRequest) Reset() {
-	*x = MetricsRequest{}
+func (x *ListEventsRequest) Reset() {
+	*x = ListEventsRequest{}
 	mi := &file_coordinator_proto_msgTypes[14]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *MetricsRequest) String() string {
+func (x *ListEventsRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*MetricsRequest) ProtoMessage() {}
+func (*ListEventsRequest) ProtoMessage() {}
 
-func (x *MetricsRequest) ProtoReflect() protoreflect.Message {
+func (x *ListEventsRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_coordinator_proto_msgTypes[14]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -840,80 +836,119 @@ func (x *MetricsRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use MetricsRequest.ProtoReflect.Descriptor instead.
-func (*MetricsRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use ListEventsRequest.ProtoReflect.Descriptor instead.
+func (*ListEventsRequest) Descriptor() ([]byte, []int) {
 	return file_coordinator_proto_rawDescGZIP(), []int{14}
 }
 
-func (x *MetricsRequest) GetWorkerFlowId() int64 {
+func (x *ListEventsRequest) GetFlowId() int64 {
 	if x != nil {
-		return x.WorkerFlowId
+		return x.FlowId
 	}
 	return 0
 }
 
-func (x *MetricsRequest) GetInputEvents() uint64 {
+func (x *ListEventsRequest) GetLimit() int64 {
 	if x != nil {
-		return x.InputEvents
+		return x.Limit
 	}
 	return 0
 }
 
-func (x *MetricsRequest) GetProcessorErrors() uint64 {
+func (x *ListEventsRequest) GetOffset() int64 {
 	if x != nil {
-		return x.ProcessorErrors
+		return x.Offset
 	}
 	return 0
 }
 
-func (x *MetricsRequest) GetOutputEvents() uint64 {
+func (x *ListEventsRequest) GetStartTime() *timestamppb.Timestamp {
 	if x != nil {
-		return x.OutputEvents
+		return x.StartTime
 	}
-	return 0
+	return nil
 }
 
-func (x *MetricsRequest) GetInputEventsByComponent() map[string]uint64 {
+func (x *ListEventsRequest) GetEndTime() *timestamppb.Timestamp {
 	if x != nil {
-		return x.InputEventsByComponent
+		return x.EndTime
 	}
 	return nil
 }
 
-func (x *MetricsRequest) GetProcessorEventsByComponent() map[string]uint64 {
+func (x *ListEventsRequest) GetSection() string {
 	if x != nil {
-		return x.ProcessorEventsByComponent
+		return x.Section
 	}
-	return nil
+	return ""
 }
 
-func (x *MetricsRequest) GetOutputEventsByComponent() map[string]uint64 {
+func (x *ListEventsRequest) GetComponentLabel() string {
 	if x != nil {
-		return x.OutputEventsByComponent
+		return x.ComponentLabel
 	}
-	return nil
+	return ""
 }
 
-type GetAnalyticsRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
+func (x *ListEventsRequest) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+func (x *ListEventsRequest) GetTraceId() string {
+	if x != nil {
+		return x.TraceId
+	}
+	return ""
+}
+
+func (x *ListEventsRequest) GetWorkerFlowId() int64 {
+	if x != nil {
+		return x.WorkerFlowId
+	}
+	return 0
+}
+
+func (x *ListEventsRequest) GetFlowVersionId() int64 {
+	if x != nil {
+		return x.FlowVersionId
+	}
+	return 0
+}
+
+func (x *ListEventsRequest) GetSearch() string {
+	if x != nil {
+		return x.Search
+	}
+	return ""
+}
+
+type ListEventsResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Events matching the filters of the listed traces.
+	Data []*Event `protobuf:"bytes,1,rep,name=data,proto3" json:"data,omitempty"`
+	// Number of traces with events matching the filters.
+	Total         int64 `protobuf:"varint,2,opt,name=total,proto3" json:"total,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetAnalyticsRequest) Reset() {
-	*x = GetAnalyticsRequest{}
+func (x *ListEventsResponse) Reset() {
+	*x = ListEventsResponse{}
 	mi := &file_coordinator_proto_msgTypes[15]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetAnalyticsRequest) String() string {
+func (x *ListEventsResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetAnalyticsRequest) ProtoMessage() {}
+func (*ListEventsResponse) ProtoMessage() {}
 
-func (x *GetAnalyticsRequest) ProtoReflect() protoreflect.Message {
+func (x *ListEventsResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_coordinator_proto_msgTypes[15]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -925,42 +960,55 @@ func (x *GetAnalyticsRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetAnalyticsRequest.ProtoReflect.Descriptor instead.
-func (*GetAnalyticsRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use ListEventsResponse.ProtoReflect.Descriptor instead.
+func (*ListEventsResponse) Descriptor() ([]byte, []int) {
 	return file_coordinator_proto_rawDescGZIP(), []int{15}
 }
 
-type GetAnalyticsResponse struct {
-	state                protoimpl.MessageState                  `protogen:"open.v1"`
-	TotalFlows           int64                                   `protobuf:"varint,1,opt,name=total_flows,proto3" json:"total_flows,omitempty"`
-	FlowsByStatus        []*GetAnalyticsResponse_FlowStatusCount `protobuf:"bytes,2,rep,name=flows_by_status,proto3" json:"flows_by_status,omitempty"`
-	TotalInputEvents     uint64                                  `protobuf:"varint,3,opt,name=total_input_events,proto3" json:"total_input_events,omitempty"`
-	TotalOutputEvents    uint64                                  `protobuf:"varint,4,opt,name=total_output_events,proto3" json:"total_output_events,omitempty"`
-	TotalProcessorErrors uint64                                  `protobuf:"varint,5,opt,name=total_processor_errors,proto3" json:"total_processor_errors,omitempty"`
-	ActiveWorkers        int64                                   `protobuf:"varint,6,opt,name=active_workers,proto3" json:"active_workers,omitempty"`
-	TotalEvents          int64                                   `protobuf:"varint,7,opt,name=total_events,proto3" json:"total_events,omitempty"`
-	ErrorEvents          int64                                   `protobuf:"varint,8,opt,name=error_events,proto3" json:"error_events,omitempty"`
-	EventsOverTime       []*GetAnalyticsResponse_TimeSeriesPoint `protobuf:"bytes,9,rep,name=events_over_time,proto3" json:"events_over_time,omitempty"`
-	TopInputComponents   []*GetAnalyticsResponse_ComponentCount  `protobuf:"bytes,10,rep,name=top_input_components,proto3" json:"top_input_components,omitempty"`
-	TopOutputComponents  []*GetAnalyticsResponse_ComponentCount  `protobuf:"bytes,11,rep,name=top_output_components,proto3" json:"top_output_components,omitempty"`
-	unknownFields        protoimpl.UnknownFields
-	sizeCache            protoimpl.SizeCache
+func (x *ListEventsResponse) GetData() []*Event {
+	if x != nil {
+		return x.Data
+	}
+	return nil
 }
 
-func (x *GetAnalyticsResponse) Reset() {
-	*x = GetAnalyticsResponse{}
+func (x *ListEventsResponse) GetTotal() int64 {
+	if x != nil {
+		return x.Total
+	}
+	return 0
+}
+
+type TailEventsRequest struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	FlowId         int64                  `protobuf:"varint,1,opt,name=flow_id,json=flowId,proto3" json:"flow_id,omitempty"`
+	Section        string                 `protobuf:"bytes,2,opt,name=section,proto3" json:"section,omitempty"`
+	ComponentLabel string                 `protobuf:"bytes,3,opt,name=component_label,json=componentLabel,proto3" json:"component_label,omitempty"`
+	Type           string                 `protobuf:"bytes,4,opt,name=type,proto3" json:"type,omitempty"`
+	TraceId        string                 `protobuf:"bytes,5,opt,name=trace_id,json=traceId,proto3" json:"trace_id,omitempty"`
+	WorkerFlowId   int64                  `protobuf:"varint,6,opt,name=worker_flow_id,json=workerFlowId,proto3" json:"worker_flow_id,omitempty"`
+	// Version of the flow the events were reported for, it must belong to flow_id.
+	FlowVersionId int64 `protobuf:"varint,7,opt,name=flow_version_id,json=flowVersionId,proto3" json:"flow_version_id,omitempty"`
+	// Case-insensitive text searched in the content and metadata of the events.
+	Search        string `protobuf:"bytes,8,opt,name=search,proto3" json:"search,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TailEventsRequest) Reset() {
+	*x = TailEventsRequest{}
 	mi := &file_coordinator_proto_msgTypes[16]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetAnalyticsResponse) String() string {
+func (x *TailEventsRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetAnalyticsResponse) ProtoMessage() {}
+func (*TailEventsRequest) ProtoMessage() {}
 
-func (x *GetAnalyticsResponse) ProtoReflect() protoreflect.Message {
+func (x *TailEventsRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_coordinator_proto_msgTypes[16]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -972,111 +1020,3058 @@ func (x *GetAnalyticsResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetAnalyticsResponse.ProtoReflect.Descriptor instead.
-func (*GetAnalyticsResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use TailEventsRequest.ProtoReflect.Descriptor instead.
+func (*TailEventsRequest) Descriptor() ([]byte, []int) {
 	return file_coordinator_proto_rawDescGZIP(), []int{16}
 }
 
-func (x *GetAnalyticsResponse) GetTotalFlows() int64 {
+func (x *TailEventsRequest) GetFlowId() int64 {
 	if x != nil {
-		return x.TotalFlows
+		return x.FlowId
 	}
 	return 0
 }
 
-func (x *GetAnalyticsResponse) GetFlowsByStatus() []*GetAnalyticsResponse_FlowStatusCount {
+func (x *TailEventsRequest) GetSection() string {
 	if x != nil {
-		return x.FlowsByStatus
+		return x.Section
 	}
-	return nil
+	return ""
 }
 
-func (x *GetAnalyticsResponse) GetTotalInputEvents() uint64 {
+func (x *TailEventsRequest) GetComponentLabel() string {
 	if x != nil {
-		return x.TotalInputEvents
+		return x.ComponentLabel
 	}
-	return 0
+	return ""
 }
 
-func (x *GetAnalyticsResponse) GetTotalOutputEvents() uint64 {
+func (x *TailEventsRequest) GetType() string {
 	if x != nil {
-		return x.TotalOutputEvents
+		return x.Type
 	}
-	return 0
+	return ""
 }
 
-func (x *GetAnalyticsResponse) GetTotalProcessorErrors() uint64 {
+func (x *TailEventsRequest) GetTraceId() string {
 	if x != nil {
-		return x.TotalProcessorErrors
+		return x.TraceId
 	}
-	return 0
+	return ""
 }
 
-func (x *GetAnalyticsResponse) GetActiveWorkers() int64 {
+func (x *TailEventsRequest) GetWorkerFlowId() int64 {
 	if x != nil {
-		return x.ActiveWorkers
+		return x.WorkerFlowId
 	}
 	return 0
 }
 
-func (x *GetAnalyticsResponse) GetTotalEvents() int64 {
+func (x *TailEventsRequest) GetFlowVersionId() int64 {
 	if x != nil {
-		return x.TotalEvents
+		return x.FlowVersionId
 	}
 	return 0
 }
 
-func (x *GetAnalyticsResponse) GetErrorEvents() int64 {
+func (x *TailEventsRequest) GetSearch() string {
 	if x != nil {
-		return x.ErrorEvents
+		return x.Search
+	}
+	return ""
+}
+
+type TailEventsResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Event *Event                 `protobuf:"bytes,1,opt,name=event,proto3" json:"event,omitempty"`
+	// Events missed since the previous message because the subscriber fell behind.
+	Dropped       uint64 `protobuf:"varint,2,opt,name=dropped,proto3" json:"dropped,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TailEventsResponse) Reset() {
+	*x = TailEventsResponse{}
+	mi := &file_coordinator_proto_msgTypes[17]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TailEventsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TailEventsResponse) ProtoMessage() {}
+
+func (x *TailEventsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_coordinator_proto_msgTypes[17]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TailEventsResponse.ProtoReflect.Descriptor instead.
+func (*TailEventsResponse) Descriptor() ([]byte, []int) {
+	return file_coordinator_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *TailEventsResponse) GetEvent() *Event {
+	if x != nil {
+		return x.Event
+	}
+	return nil
+}
+
+func (x *TailEventsResponse) GetDropped() uint64 {
+	if x != nil {
+		return x.Dropped
+	}
+	return 0
+}
+
+// FlowLog is a log line written by a flow on a worker.
+type FlowLog struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	WorkerFlowId  int64                  `protobuf:"varint,2,opt,name=worker_flow_id,json=workerFlowId,proto3" json:"worker_flow_id,omitempty"`
+	WorkerId      string                 `protobuf:"bytes,3,opt,name=worker_id,json=workerId,proto3" json:"worker_id,omitempty"`
+	Level         string                 `protobuf:"bytes,4,opt,name=level,proto3" json:"level,omitempty"`
+	Message       string                 `protobuf:"bytes,5,opt,name=message,proto3" json:"message,omitempty"`
+	Fields        *structpb.Struct       `protobuf:"bytes,6,opt,name=fields,proto3" json:"fields,omitempty"`
+	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *FlowLog) Reset() {
+	*x = FlowLog{}
+	mi := &file_coordinator_proto_msgTypes[18]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *FlowLog) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FlowLog) ProtoMessage() {}
+
+func (x *FlowLog) ProtoReflect() protoreflect.Message {
+	mi := &file_coordinator_proto_msgTypes[18]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FlowLog.ProtoReflect.Descriptor instead.
+func (*FlowLog) Descriptor() ([]byte, []int) {
+	return file_coordinator_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *FlowLog) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *FlowLog) GetWorkerFlowId() int64 {
+	if x != nil {
+		return x.WorkerFlowId
+	}
+	return 0
+}
+
+func (x *FlowLog) GetWorkerId() string {
+	if x != nil {
+		return x.WorkerId
+	}
+	return ""
+}
+
+func (x *FlowLog) GetLevel() string {
+	if x != nil {
+		return x.Level
+	}
+	return ""
+}
+
+func (x *FlowLog) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *FlowLog) GetFields() *structpb.Struct {
+	if x != nil {
+		return x.Fields
+	}
+	return nil
+}
+
+func (x *FlowLog) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+type IngestLogsRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Logs  []*FlowLog             `protobuf:"bytes,1,rep,name=logs,proto3" json:"logs,omitempty"`
+	// Log lines dropped from the buffer of the worker since its previous request because it was full, by worker flow.
+	Dropped       map[int64]uint64 `protobuf:"bytes,2,rep,name=dropped,proto3" json:"dropped,omitempty" protobuf_key:"varint,1,opt,name=key" protobuf_val:"varint,2,opt,name=value"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *IngestLogsRequest) Reset() {
+	*x = IngestLogsRequest{}
+	mi := &file_coordinator_proto_msgTypes[19]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *IngestLogsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*IngestLogsRequest) ProtoMessage() {}
+
+func (x *IngestLogsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_coordinator_proto_msgTypes[19]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use IngestLogsRequest.ProtoReflect.Descriptor instead.
+func (*IngestLogsRequest) Descriptor() ([]byte, []int) {
+	return file_coordinator_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *IngestLogsRequest) GetLogs() []*FlowLog {
+	if x != nil {
+		return x.Logs
+	}
+	return nil
+}
+
+func (x *IngestLogsRequest) GetDropped() map[int64]uint64 {
+	if x != nil {
+		return x.Dropped
+	}
+	return nil
+}
+
+type ListFlowLogsRequest struct {
+	state     protoimpl.MessageState `protogen:"open.v1"`
+	FlowId    int64                  `protobuf:"varint,1,opt,name=flow_id,json=flowId,proto3" json:"flow_id,omitempty"`
+	Limit     int64                  `protobuf:"varint,2,opt,name=limit,proto3" json:"limit,omitempty"`
+	Offset    int64                  `protobuf:"varint,3,opt,name=offset,proto3" json:"offset,omitempty"`
+	StartTime *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=start_time,json=startTime,proto3" json:"start_time,omitempty"`
+	EndTime   *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=end_time,json=endTime,proto3" json:"end_time,omitempty"`
+	// Lowest level of the listed lines, e.g. warn lists warn, error, fatal and panic lines.
+	Level        string `protobuf:"bytes,6,opt,name=level,proto3" json:"level,omitempty"`
+	WorkerId     string `protobuf:"bytes,7,opt,name=worker_id,json=workerId,proto3" json:"worker_id,omitempty"`
+	WorkerFlowId int64  `protobuf:"varint,8,opt,name=worker_flow_id,json=workerFlowId,proto3" json:"worker_flow_id,omitempty"`
+	// Version of the flow the lines were written by, it must belong to flow_id.
+	FlowVersionId int64 `protobuf:"varint,9,opt,name=flow_version_id,json=flowVersionId,proto3" json:"flow_version_id,omitempty"`
+	// Case-insensitive text searched in the message and fields of the lines.
+	Search        string `protobuf:"bytes,10,opt,name=search,proto3" json:"search,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListFlowLogsRequest) Reset() {
+	*x = ListFlowLogsRequest{}
+	mi := &file_coordinator_proto_msgTypes[20]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListFlowLogsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListFlowLogsRequest) ProtoMessage() {}
+
+func (x *ListFlowLogsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_coordinator_proto_msgTypes[20]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListFlowLogsRequest.ProtoReflect.Descriptor instead.
+func (*ListFlowLogsRequest) Descriptor() ([]byte, []int) {
+	return file_coordinator_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *ListFlowLogsRequest) GetFlowId() int64 {
+	if x != nil {
+		return x.FlowId
+	}
+	return 0
+}
+
+func (x *ListFlowLogsRequest) GetLimit() int64 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+func (x *ListFlowLogsRequest) GetOffset() int64 {
+	if x != nil {
+		return x.Offset
+	}
+	return 0
+}
+
+func (x *ListFlowLogsRequest) GetStartTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.StartTime
+	}
+	return nil
+}
+
+func (x *ListFlowLogsRequest) GetEndTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.EndTime
+	}
+	return nil
+}
+
+func (x *ListFlowLogsRequest) GetLevel() string {
+	if x != nil {
+		return x.Level
+	}
+	return ""
+}
+
+func (x *ListFlowLogsRequest) GetWorkerId() string {
+	if x != nil {
+		return x.WorkerId
+	}
+	return ""
+}
+
+func (x *ListFlowLogsRequest) GetWorkerFlowId() int64 {
+	if x != nil {
+		return x.WorkerFlowId
+	}
+	return 0
+}
+
+func (x *ListFlowLogsRequest) GetFlowVersionId() int64 {
+	if x != nil {
+		return x.FlowVersionId
+	}
+	return 0
+}
+
+func (x *ListFlowLogsRequest) GetSearch() string {
+	if x != nil {
+		return x.Search
+	}
+	return ""
+}
+
+type ListFlowLogsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Data          []*FlowLog             `protobuf:"bytes,1,rep,name=data,proto3" json:"data,omitempty"`
+	Total         int64                  `protobuf:"varint,2,opt,name=total,proto3" json:"total,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListFlowLogsResponse) Reset() {
+	*x = ListFlowLogsResponse{}
+	mi := &file_coordinator_proto_msgTypes[21]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListFlowLogsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListFlowLogsResponse) ProtoMessage() {}
+
+func (x *ListFlowLogsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_coordinator_proto_msgTypes[21]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListFlowLogsResponse.ProtoReflect.Descriptor instead.
+func (*ListFlowLogsResponse) Descriptor() ([]byte, []int) {
+	return file_coordinator_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *ListFlowLogsResponse) GetData() []*FlowLog {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+func (x *ListFlowLogsResponse) GetTotal() int64 {
+	if x != nil {
+		return x.Total
+	}
+	return 0
+}
+
+type TailFlowLogsRequest struct {
+	state  protoimpl.MessageState `protogen:"open.v1"`
+	FlowId int64                  `protobuf:"varint,1,opt,name=flow_id,json=flowId,proto3" json:"flow_id,omitempty"`
+	// Lowest level of the streamed lines.
+	Level        string `protobuf:"bytes,2,opt,name=level,proto3" json:"level,omitempty"`
+	WorkerId     string `protobuf:"bytes,3,opt,name=worker_id,json=workerId,proto3" json:"worker_id,omitempty"`
+	WorkerFlowId int64  `protobuf:"varint,4,opt,name=worker_flow_id,json=workerFlowId,proto3" json:"worker_flow_id,omitempty"`
+	// Version of the flow the lines were written by, it must belong to flow_id.
+	FlowVersionId int64 `protobuf:"varint,5,opt,name=flow_version_id,json=flowVersionId,proto3" json:"flow_version_id,omitempty"`
+	// Case-insensitive text searched in the message and fields of the lines.
+	Search        string `protobuf:"bytes,6,opt,name=search,proto3" json:"search,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TailFlowLogsRequest) Reset() {
+	*x = TailFlowLogsRequest{}
+	mi := &file_coordinator_proto_msgTypes[22]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TailFlowLogsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TailFlowLogsRequest) ProtoMessage() {}
+
+func (x *TailFlowLogsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_coordinator_proto_msgTypes[22]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TailFlowLogsRequest.ProtoReflect.Descriptor instead.
+func (*TailFlowLogsRequest) Descriptor() ([]byte, []int) {
+	return file_coordinator_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *TailFlowLogsRequest) GetFlowId() int64 {
+	if x != nil {
+		return x.FlowId
+	}
+	return 0
+}
+
+func (x *TailFlowLogsRequest) GetLevel() string {
+	if x != nil {
+		return x.Level
+	}
+	return ""
+}
+
+func (x *TailFlowLogsRequest) GetWorkerId() string {
+	if x != nil {
+		return x.WorkerId
+	}
+	return ""
+}
+
+func (x *TailFlowLogsRequest) GetWorkerFlowId() int64 {
+	if x != nil {
+		return x.WorkerFlowId
+	}
+	return 0
+}
+
+func (x *TailFlowLogsRequest) GetFlowVersionId() int64 {
+	if x != nil {
+		return x.FlowVersionId
+	}
+	return 0
+}
+
+func (x *TailFlowLogsRequest) GetSearch() string {
+	if x != nil {
+		return x.Search
+	}
+	return ""
+}
+
+type TailFlowLogsResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Log   *FlowLog               `protobuf:"bytes,1,opt,name=log,proto3" json:"log,omitempty"`
+	// Lines missed since the previous message because the subscriber fell behind.
+	Dropped       uint64 `protobuf:"varint,2,opt,name=dropped,proto3" json:"dropped,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TailFlowLogsResponse) Reset() {
+	*x = TailFlowLogsResponse{}
+	mi := &file_coordinator_proto_msgTypes[23]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TailFlowLogsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TailFlowLogsResponse) ProtoMessage() {}
+
+func (x *TailFlowLogsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_coordinator_proto_msgTypes[23]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TailFlowLogsResponse.ProtoReflect.Descriptor instead.
+func (*TailFlowLogsResponse) Descriptor() ([]byte, []int) {
+	return file_coordinator_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *TailFlowLogsResponse) GetLog() *FlowLog {
+	if x != nil {
+		return x.Log
+	}
+	return nil
+}
+
+func (x *TailFlowLogsResponse) GetDropped() uint64 {
+	if x != nil {
+		return x.Dropped
+	}
+	return 0
+}
+
+type GetTraceRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	TraceId       string                 `protobuf:"bytes,1,opt,name=trace_id,json=traceId,proto3" json:"trace_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetTraceRequest) Reset() {
+	*x = GetTraceRequest{}
+	mi := &file_coordinator_proto_msgTypes[24]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetTraceRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetTraceRequest) ProtoMessage() {}
+
+func (x *GetTraceRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_coordinator_proto_msgTypes[24]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetTraceRequest.ProtoReflect.Descriptor instead.
+func (*GetTraceRequest) Descriptor() ([]byte, []int) {
+	return file_coordinator_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *GetTraceRequest) GetTraceId() string {
+	if x != nil {
+		return x.TraceId
+	}
+	return ""
+}
+
+// TraceComponent is a component a traced message went through with the events it reported for it.
+type TraceComponent struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	Section        string                 `protobuf:"bytes,1,opt,name=section,proto3" json:"section,omitempty"`
+	ComponentLabel string                 `protobuf:"bytes,2,opt,name=component_label,json=componentLabel,proto3" json:"component_label,omitempty"`
+	StartedAt      *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=started_at,json=startedAt,proto3" json:"started_at,omitempty"`
+	EndedAt        *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=ended_at,json=endedAt,proto3" json:"ended_at,omitempty"`
+	Errors         int64                  `protobuf:"varint,5,opt,name=errors,proto3" json:"errors,omitempty"`
+	Events         []*Event               `protobuf:"bytes,6,rep,name=events,proto3" json:"events,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *TraceComponent) Reset() {
+	*x = TraceComponent{}
+	mi := &file_coordinator_proto_msgTypes[25]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TraceComponent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TraceComponent) ProtoMessage() {}
+
+func (x *TraceComponent) ProtoReflect() protoreflect.Message {
+	mi := &file_coordinator_proto_msgTypes[25]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TraceComponent.ProtoReflect.Descriptor instead.
+func (*TraceComponent) Descriptor() ([]byte, []int) {
+	return file_coordinator_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *TraceComponent) GetSection() string {
+	if x != nil {
+		return x.Section
+	}
+	return ""
+}
+
+func (x *TraceComponent) GetComponentLabel() string {
+	if x != nil {
+		return x.ComponentLabel
+	}
+	return ""
+}
+
+func (x *TraceComponent) GetStartedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.StartedAt
+	}
+	return nil
+}
+
+func (x *TraceComponent) GetEndedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.EndedAt
+	}
+	return nil
+}
+
+func (x *TraceComponent) GetErrors() int64 {
+	if x != nil {
+		return x.Errors
+	}
+	return 0
+}
+
+func (x *TraceComponent) GetEvents() []*Event {
+	if x != nil {
+		return x.Events
+	}
+	return nil
+}
+
+type GetTraceResponse struct {
+	state     protoimpl.MessageState `protogen:"open.v1"`
+	TraceId   string                 `protobuf:"bytes,1,opt,name=trace_id,json=traceId,proto3" json:"trace_id,omitempty"`
+	FlowId    int64                  `protobuf:"varint,2,opt,name=flow_id,json=flowId,proto3" json:"flow_id,omitempty"`
+	StartedAt *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=started_at,json=startedAt,proto3" json:"started_at,omitempty"`
+	EndedAt   *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=ended_at,json=endedAt,proto3" json:"ended_at,omitempty"`
+	// Components in the order the message reached them.
+	Timeline      []*TraceComponent `protobuf:"bytes,5,rep,name=timeline,proto3" json:"timeline,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetTraceResponse) Reset() {
+	*x = GetTraceResponse{}
+	mi := &file_coordinator_proto_msgTypes[26]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetTraceResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetTraceResponse) ProtoMessage() {}
+
+func (x *GetTraceResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_coordinator_proto_msgTypes[26]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetTraceResponse.ProtoReflect.Descriptor instead.
+func (*GetTraceResponse) Descriptor() ([]byte, []int) {
+	return file_coordinator_proto_rawDescGZIP(), []int{26}
+}
+
+func (x *GetTraceResponse) GetTraceId() string {
+	if x != nil {
+		return x.TraceId
+	}
+	return ""
+}
+
+func (x *GetTraceResponse) GetFlowId() int64 {
+	if x != nil {
+		return x.FlowId
+	}
+	return 0
+}
+
+func (x *GetTraceResponse) GetStartedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.StartedAt
+	}
+	return nil
+}
+
+func (x *GetTraceResponse) GetEndedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.EndedAt
+	}
+	return nil
+}
+
+func (x *GetTraceResponse) GetTimeline() []*TraceComponent {
+	if x != nil {
+		return x.Timeline
+	}
+	return nil
+}
+
+type MetricsRequest struct {
+	state                      protoimpl.MessageState `protogen:"open.v1"`
+	WorkerFlowId               int64                  `protobuf:"varint,1,opt,name=worker_flow_id,json=workerFlowId,proto3" json:"worker_flow_id,omitempty"`
+	InputEvents                uint64                 `protobuf:"varint,2,opt,name=input_events,json=inputEvents,proto3" json:"input_events,omitempty"`
+	ProcessorErrors            uint64                 `protobuf:"varint,3,opt,name=processor_errors,json=processorErrors,proto3" json:"processor_errors,omitempty"`
+	OutputEvents               uint64                 `protobuf:"varint,4,opt,name=output_events,json=outputEvents,proto3" json:"output_events,omitempty"`
+	InputEventsByComponent     map[string]uint64      `protobuf:"bytes,5,rep,name=input_events_by_component,json=inputEventsByComponent,proto3" json:"input_events_by_component,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"varint,2,opt,name=value"`
+	ProcessorEventsByComponent map[string]uint64      `protobuf:"bytes,6,rep,name=processor_events_by_component,json=processorEventsByComponent,proto3" json:"processor_events_by_component,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"varint,2,opt,name=value"`
+	OutputEventsByComponent    map[string]uint64      `protobuf:"bytes,7,rep,name=output_events_by_component,json=outputEventsByComponent,proto3" json:"output_events_by_component,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"varint,2,opt,name=value"`
+	// Errors traced by the components of all sections, keyed by component label.
+	ErrorsByComponent map[string]uint64 `protobuf:"bytes,8,rep,name=errors_by_component,json=errorsByComponent,proto3" json:"errors_by_component,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"varint,2,opt,name=value"`
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
+}
+
+func (x *MetricsRequest) Reset() {
+	*x = MetricsRequest{}
+	mi := &file_coordinator_proto_msgTypes[27]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MetricsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MetricsRequest) ProtoMessage() {}
+
+func (x *MetricsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_coordinator_proto_msgTypes[27]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MetricsRequest.ProtoReflect.Descriptor instead.
+func (*MetricsRequest) Descriptor() ([]byte, []int) {
+	return file_coordinator_proto_rawDescGZIP(), []int{27}
+}
+
+func (x *MetricsRequest) GetWorkerFlowId() int64 {
+	if x != nil {
+		return x.WorkerFlowId
+	}
+	return 0
+}
+
+func (x *MetricsRequest) GetInputEvents() uint64 {
+	if x != nil {
+		return x.InputEvents
+	}
+	return 0
+}
+
+func (x *MetricsRequest) GetProcessorErrors() uint64 {
+	if x != nil {
+		return x.ProcessorErrors
+	}
+	return 0
+}
+
+func (x *MetricsRequest) GetOutputEvents() uint64 {
+	if x != nil {
+		return x.OutputEvents
+	}
+	return 0
+}
+
+func (x *MetricsRequest) GetInputEventsByComponent() map[string]uint64 {
+	if x != nil {
+		return x.InputEventsByComponent
+	}
+	return nil
+}
+
+func (x *MetricsRequest) GetProcessorEventsByComponent() map[string]uint64 {
+	if x != nil {
+		return x.ProcessorEventsByComponent
+	}
+	return nil
+}
+
+func (x *MetricsRequest) GetOutputEventsByComponent() map[string]uint64 {
+	if x != nil {
+		return x.OutputEventsByComponent
+	}
+	return nil
+}
+
+func (x *MetricsRequest) GetErrorsByComponent() map[string]uint64 {
+	if x != nil {
+		return x.ErrorsByComponent
+	}
+	return nil
+}
+
+type GetFlowMetricsRequest struct {
+	state  protoimpl.MessageState `protogen:"open.v1"`
+	FlowId int64                  `protobuf:"varint,1,opt,name=flow_id,json=flowId,proto3" json:"flow_id,omitempty"`
+	// Time range ending now, e.g. 15m, 6h or 7d, defaults to 1h.
+	Range string `protobuf:"bytes,2,opt,name=range,proto3" json:"range,omitempty"`
+	// Width of the points, e.g. 1m or 1h, about sixty points per range when empty.
+	Step          string `protobuf:"bytes,3,opt,name=step,proto3" json:"step,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetFlowMetricsRequest) Reset() {
+	*x = GetFlowMetricsRequest{}
+	mi := &file_coordinator_proto_msgTypes[28]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetFlowMetricsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetFlowMetricsRequest) ProtoMessage() {}
+
+func (x *GetFlowMetricsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_coordinator_proto_msgTypes[28]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetFlowMetricsRequest.ProtoReflect.Descriptor instead.
+func (*GetFlowMetricsRequest) Descriptor() ([]byte, []int) {
+	return file_coordinator_proto_rawDescGZIP(), []int{28}
+}
+
+func (x *GetFlowMetricsRequest) GetFlowId() int64 {
+	if x != nil {
+		return x.FlowId
+	}
+	return 0
+}
+
+func (x *GetFlowMetricsRequest) GetRange() string {
+	if x != nil {
+		return x.Range
+	}
+	return ""
+}
+
+func (x *GetFlowMetricsRequest) GetStep() string {
+	if x != nil {
+		return x.Step
+	}
+	return ""
+}
+
+type GetFlowMetricsResponse struct {
+	state         protoimpl.MessageState              `protogen:"open.v1"`
+	Components    []*GetFlowMetricsResponse_Component `protobuf:"bytes,1,rep,name=components,proto3" json:"components,omitempty"`
+	Step          string                              `protobuf:"bytes,2,opt,name=step,proto3" json:"step,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetFlowMetricsResponse) Reset() {
+	*x = GetFlowMetricsResponse{}
+	mi := &file_coordinator_proto_msgTypes[29]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetFlowMetricsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetFlowMetricsResponse) ProtoMessage() {}
+
+func (x *GetFlowMetricsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_coordinator_proto_msgTypes[29]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetFlowMetricsResponse.ProtoReflect.Descriptor instead.
+func (*GetFlowMetricsResponse) Descriptor() ([]byte, []int) {
+	return file_coordinator_proto_rawDescGZIP(), []int{29}
+}
+
+func (x *GetFlowMetricsResponse) GetComponents() []*GetFlowMetricsResponse_Component {
+	if x != nil {
+		return x.Components
+	}
+	return nil
+}
+
+func (x *GetFlowMetricsResponse) GetStep() string {
+	if x != nil {
+		return x.Step
+	}
+	return ""
+}
+
+type GetAnalyticsRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Time range ending now, 30d when empty.
+	Range string `protobuf:"bytes,1,opt,name=range,proto3" json:"range,omitempty"`
+	// Limits the analytics to all versions of the flow when set.
+	FlowId        int64 `protobuf:"varint,2,opt,name=flow_id,proto3" json:"flow_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetAnalyticsRequest) Reset() {
+	*x = GetAnalyticsRequest{}
+	mi := &file_coordinator_proto_msgTypes[30]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetAnalyticsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetAnalyticsRequest) ProtoMessage() {}
+
+func (x *GetAnalyticsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_coordinator_proto_msgTypes[30]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetAnalyticsRequest.ProtoReflect.Descriptor instead.
+func (*GetAnalyticsRequest) Descriptor() ([]byte, []int) {
+	return file_coordinator_proto_rawDescGZIP(), []int{30}
+}
+
+func (x *GetAnalyticsRequest) GetRange() string {
+	if x != nil {
+		return x.Range
+	}
+	return ""
+}
+
+func (x *GetAnalyticsRequest) GetFlowId() int64 {
+	if x != nil {
+		return x.FlowId
+	}
+	return 0
+}
+
+type GetAnalyticsResponse struct {
+	state                protoimpl.MessageState                  `protogen:"open.v1"`
+	TotalFlows           int64                                   `protobuf:"varint,1,opt,name=total_flows,proto3" json:"total_flows,omitempty"`
+	FlowsByStatus        []*GetAnalyticsResponse_FlowStatusCount `protobuf:"bytes,2,rep,name=flows_by_status,proto3" json:"flows_by_status,omitempty"`
+	TotalInputEvents     uint64                                  `protobuf:"varint,3,opt,name=total_input_events,proto3" json:"total_input_events,omitempty"`
+	TotalOutputEvents    uint64                                  `protobuf:"varint,4,opt,name=total_output_events,proto3" json:"total_output_events,omitempty"`
+	TotalProcessorErrors uint64                                  `protobuf:"varint,5,opt,name=total_processor_errors,proto3" json:"total_processor_errors,omitempty"`
+	ActiveWorkers        int64                                   `protobuf:"varint,6,opt,name=active_workers,proto3" json:"active_workers,omitempty"`
+	TotalEvents          int64                                   `protobuf:"varint,7,opt,name=total_events,proto3" json:"total_events,omitempty"`
+	ErrorEvents          int64                                   `protobuf:"varint,8,opt,name=error_events,proto3" json:"error_events,omitempty"`
+	EventsOverTime       []*GetAnalyticsResponse_TimeSeriesPoint `protobuf:"bytes,9,rep,name=events_over_time,proto3" json:"events_over_time,omitempty"`
+	TopInputComponents   []*GetAnalyticsResponse_ComponentCount  `protobuf:"bytes,10,rep,name=top_input_components,proto3" json:"top_input_components,omitempty"`
+	TopOutputComponents  []*GetAnalyticsResponse_ComponentCount  `protobuf:"bytes,11,rep,name=top_output_components,proto3" json:"top_output_components,omitempty"`
+	ToolCalls            []*GetAnalyticsResponse_ToolCallStats   `protobuf:"bytes,12,rep,name=tool_calls,proto3" json:"tool_calls,omitempty"`
+	unknownFields        protoimpl.UnknownFields
+	sizeCache            protoimpl.SizeCache
+}
+
+func (x *GetAnalyticsResponse) Reset() {
+	*x = GetAnalyticsResponse{}
+	mi := &file_coordinator_proto_msgTypes[31]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetAnalyticsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetAnalyticsResponse) ProtoMessage() {}
+
+func (x *GetAnalyticsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_coordinator_proto_msgTypes[31]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetAnalyticsResponse.ProtoReflect.Descriptor instead.
+func (*GetAnalyticsResponse) Descriptor() ([]byte, []int) {
+	return file_coordinator_proto_rawDescGZIP(), []int{31}
+}
+
+func (x *GetAnalyticsResponse) GetTotalFlows() int64 {
+	if x != nil {
+		return x.TotalFlows
+	}
+	return 0
+}
+
+func (x *GetAnalyticsResponse) GetFlowsByStatus() []*GetAnalyticsResponse_FlowStatusCount {
+	if x != nil {
+		return x.FlowsByStatus
+	}
+	return nil
+}
+
+func (x *GetAnalyticsResponse) GetTotalInputEvents() uint64 {
+	if x != nil {
+		return x.TotalInputEvents
+	}
+	return 0
+}
+
+func (x *GetAnalyticsResponse) GetTotalOutputEvents() uint64 {
+	if x != nil {
+		return x.TotalOutputEvents
+	}
+	return 0
+}
+
+func (x *GetAnalyticsResponse) GetTotalProcessorErrors() uint64 {
+	if x != nil {
+		return x.TotalProcessorErrors
+	}
+	return 0
+}
+
+func (x *GetAnalyticsResponse) GetActiveWorkers() int64 {
+	if x != nil {
+		return x.ActiveWorkers
+	}
+	return 0
+}
+
+func (x *GetAnalyticsResponse) GetTotalEvents() int64 {
+	if x != nil {
+		return x.TotalEvents
+	}
+	return 0
+}
+
+func (x *GetAnalyticsResponse) GetErrorEvents() int64 {
+	if x != nil {
+		return x.ErrorEvents
+	}
+	return 0
+}
+
+func (x *GetAnalyticsResponse) GetEventsOverTime() []*GetAnalyticsResponse_TimeSeriesPoint {
+	if x != nil {
+		return x.EventsOverTime
+	}
+	return nil
+}
+
+func (x *GetAnalyticsResponse) GetTopInputComponents() []*GetAnalyticsResponse_ComponentCount {
+	if x != nil {
+		return x.TopInputComponents
+	}
+	return nil
+}
+
+func (x *GetAnalyticsResponse) GetTopOutputComponents() []*GetAnalyticsResponse_ComponentCount {
+	if x != nil {
+		return x.TopOutputComponents
+	}
+	return nil
+}
+
+func (x *GetAnalyticsResponse) GetToolCalls() []*GetAnalyticsResponse_ToolCallStats {
+	if x != nil {
+		return x.ToolCalls
+	}
+	return nil
+}
+
+type SecretRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Key           string                 `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
+	Value         string                 `protobuf:"bytes,2,opt,name=value,proto3" json:"value,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SecretRequest) Reset() {
+	*x = SecretRequest{}
+	mi := &file_coordinator_proto_msgTypes[32]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SecretRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SecretRequest) ProtoMessage() {}
+
+func (x *SecretRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_coordinator_proto_msgTypes[32]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SecretRequest.ProtoReflect.Descriptor instead.
+func (*SecretRequest) Descriptor() ([]byte, []int) {
+	return file_coordinator_proto_rawDescGZIP(), []int{32}
+}
+
+func (x *SecretRequest) GetKey() string {
+	if x != nil {
+		return x.Key
+	}
+	return ""
+}
+
+func (x *SecretRequest) GetValue() string {
+	if x != nil {
+		return x.Value
+	}
+	return ""
+}
+
+type ListSecretsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Data          []*Secret              `protobuf:"bytes,1,rep,name=data,proto3" json:"data,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListSecretsResponse) Reset() {
+	*x = ListSecretsResponse{}
+	mi := &file_coordinator_proto_msgTypes[33]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListSecretsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListSecretsResponse) ProtoMessage() {}
+
+func (x *ListSecretsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_coordinator_proto_msgTypes[33]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListSecretsResponse.ProtoReflect.Descriptor instead.
+func (*ListSecretsResponse) Descriptor() ([]byte, []int) {
+	return file_coordinator_proto_rawDescGZIP(), []int{33}
+}
+
+func (x *ListSecretsResponse) GetData() []*Secret {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+type SecretResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Data          *Secret                `protobuf:"bytes,1,opt,name=data,proto3" json:"data,omitempty"`
+	Meta          *CommonResponse        `protobuf:"bytes,2,opt,name=meta,proto3" json:"meta,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SecretResponse) Reset() {
+	*x = SecretResponse{}
+	mi := &file_coordinator_proto_msgTypes[34]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SecretResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SecretResponse) ProtoMessage() {}
+
+func (x *SecretResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_coordinator_proto_msgTypes[34]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SecretResponse.ProtoReflect.Descriptor instead.
+func (*SecretResponse) Descriptor() ([]byte, []int) {
+	return file_coordinator_proto_rawDescGZIP(), []int{34}
+}
+
+func (x *SecretResponse) GetData() *Secret {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+func (x *SecretResponse) GetMeta() *CommonResponse {
+	if x != nil {
+		return x.Meta
+	}
+	return nil
+}
+
+type ListCachesResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Data          []*Cache               `protobuf:"bytes,1,rep,name=data,proto3" json:"data,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListCachesResponse) Reset() {
+	*x = ListCachesResponse{}
+	mi := &file_coordinator_proto_msgTypes[35]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListCachesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListCachesResponse) ProtoMessage() {}
+
+func (x *ListCachesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_coordinator_proto_msgTypes[35]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListCachesResponse.ProtoReflect.Descriptor instead.
+func (*ListCachesResponse) Descriptor() ([]byte, []int) {
+	return file_coordinator_proto_rawDescGZIP(), []int{35}
+}
+
+func (x *ListCachesResponse) GetData() []*Cache {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+type GetCacheRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetCacheRequest) Reset() {
+	*x = GetCacheRequest{}
+	mi := &file_coordinator_proto_msgTypes[36]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetCacheRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetCacheRequest) ProtoMessage() {}
+
+func (x *GetCacheRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_coordinator_proto_msgTypes[36]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetCacheRequest.ProtoReflect.Descriptor instead.
+func (*GetCacheRequest) Descriptor() ([]byte, []int) {
+	return file_coordinator_proto_rawDescGZIP(), []int{36}
+}
+
+func (x *GetCacheRequest) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+type CacheResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Data          *Cache                 `protobuf:"bytes,1,opt,name=data,proto3" json:"data,omitempty"`
+	Meta          *CommonResponse        `protobuf:"bytes,2,opt,name=meta,proto3" json:"meta,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CacheResponse) Reset() {
+	*x = CacheResponse{}
+	mi := &file_coordinator_proto_msgTypes[37]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CacheResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CacheResponse) ProtoMessage() {}
+
+func (x *CacheResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_coordinator_proto_msgTypes[37]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CacheResponse.ProtoReflect.Descriptor instead.
+func (*CacheResponse) Descriptor() ([]byte, []int) {
+	return file_coordinator_proto_rawDescGZIP(), []int{37}
+}
+
+func (x *CacheResponse) GetData() *Cache {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+func (x *CacheResponse) GetMeta() *CommonResponse {
+	if x != nil {
+		return x.Meta
+	}
+	return nil
+}
+
+type ListRateLimitsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Data          []*RateLimit           `protobuf:"bytes,1,rep,name=data,proto3" json:"data,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListRateLimitsResponse) Reset() {
+	*x = ListRateLimitsResponse{}
+	mi := &file_coordinator_proto_msgTypes[38]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListRateLimitsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListRateLimitsResponse) ProtoMessage() {}
+
+func (x *ListRateLimitsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_coordinator_proto_msgTypes[38]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListRateLimitsResponse.ProtoReflect.Descriptor instead.
+func (*ListRateLimitsResponse) Descriptor() ([]byte, []int) {
+	return file_coordinator_proto_rawDescGZIP(), []int{38}
+}
+
+func (x *ListRateLimitsResponse) GetData() []*RateLimit {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+type GetBufferRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetBufferRequest) Reset() {
+	*x = GetBufferRequest{}
+	mi := &file_coordinator_proto_msgTypes[39]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetBufferRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetBufferRequest) ProtoMessage() {}
+
+func (x *GetBufferRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_coordinator_proto_msgTypes[39]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetBufferRequest.ProtoReflect.Descriptor instead.
+func (*GetBufferRequest) Descriptor() ([]byte, []int) {
+	return file_coordinator_proto_rawDescGZIP(), []int{39}
+}
+
+func (x *GetBufferRequest) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+type BufferResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Data          *Buffer                `protobuf:"bytes,1,opt,name=data,proto3" json:"data,omitempty"`
+	Meta          *CommonResponse        `protobuf:"bytes,2,opt,name=meta,proto3" json:"meta,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BufferResponse) Reset() {
+	*x = BufferResponse{}
+	mi := &file_coordinator_proto_msgTypes[40]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BufferResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BufferResponse) ProtoMessage() {}
+
+func (x *BufferResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_coordinator_proto_msgTypes[40]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BufferResponse.ProtoReflect.Descriptor instead.
+func (*BufferResponse) Descriptor() ([]byte, []int) {
+	return file_coordinator_proto_rawDescGZIP(), []int{40}
+}
+
+func (x *BufferResponse) GetData() *Buffer {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+func (x *BufferResponse) GetMeta() *CommonResponse {
+	if x != nil {
+		return x.Meta
+	}
+	return nil
+}
+
+type ListBuffersResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Data          []*Buffer              `protobuf:"bytes,1,rep,name=data,proto3" json:"data,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListBuffersResponse) Reset() {
+	*x = ListBuffersResponse{}
+	mi := &file_coordinator_proto_msgTypes[41]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListBuffersResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListBuffersResponse) ProtoMessage() {}
+
+func (x *ListBuffersResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_coordinator_proto_msgTypes[41]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListBuffersResponse.ProtoReflect.Descriptor instead.
+func (*ListBuffersResponse) Descriptor() ([]byte, []int) {
+	return file_coordinator_proto_rawDescGZIP(), []int{41}
+}
+
+func (x *ListBuffersResponse) GetData() []*Buffer {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+type ListFilesResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Data          []*File                `protobuf:"bytes,1,rep,name=data,proto3" json:"data,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListFilesResponse) Reset() {
+	*x = ListFilesResponse{}
+	mi := &file_coordinator_proto_msgTypes[42]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListFilesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListFilesResponse) ProtoMessage() {}
+
+func (x *ListFilesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_coordinator_proto_msgTypes[42]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListFilesResponse.ProtoReflect.Descriptor instead.
+func (*ListFilesResponse) Descriptor() ([]byte, []int) {
+	return file_coordinator_proto_rawDescGZIP(), []int{42}
+}
+
+func (x *ListFilesResponse) GetData() []*File {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+type GetFileRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetFileRequest) Reset() {
+	*x = GetFileRequest{}
+	mi := &file_coordinator_proto_msgTypes[43]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetFileRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetFileRequest) ProtoMessage() {}
+
+func (x *GetFileRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_coordinator_proto_msgTypes[43]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetFileRequest.ProtoReflect.Descriptor instead.
+func (*GetFileRequest) Descriptor() ([]byte, []int) {
+	return file_coordinator_proto_rawDescGZIP(), []int{43}
+}
+
+func (x *GetFileRequest) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+type FileResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Data          *File                  `protobuf:"bytes,1,opt,name=data,proto3" json:"data,omitempty"`
+	Meta          *CommonResponse        `protobuf:"bytes,2,opt,name=meta,proto3" json:"meta,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *FileResponse) Reset() {
+	*x = FileResponse{}
+	mi := &file_coordinator_proto_msgTypes[44]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *FileResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FileResponse) ProtoMessage() {}
+
+func (x *FileResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_coordinator_proto_msgTypes[44]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FileResponse.ProtoReflect.Descriptor instead.
+func (*FileResponse) Descriptor() ([]byte, []int) {
+	return file_coordinator_proto_rawDescGZIP(), []int{44}
+}
+
+func (x *FileResponse) GetData() *File {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+func (x *FileResponse) GetMeta() *CommonResponse {
+	if x != nil {
+		return x.Meta
+	}
+	return nil
+}
+
+type GetRateLimitRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetRateLimitRequest) Reset() {
+	*x = GetRateLimitRequest{}
+	mi := &file_coordinator_proto_msgTypes[45]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetRateLimitRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetRateLimitRequest) ProtoMessage() {}
+
+func (x *GetRateLimitRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_coordinator_proto_msgTypes[45]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetRateLimitRequest.ProtoReflect.Descriptor instead.
+func (*GetRateLimitRequest) Descriptor() ([]byte, []int) {
+	return file_coordinator_proto_rawDescGZIP(), []int{45}
+}
+
+func (x *GetRateLimitRequest) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+type RateLimitResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Data          *RateLimit             `protobuf:"bytes,1,opt,name=data,proto3" json:"data,omitempty"`
+	Meta          *CommonResponse        `protobuf:"bytes,2,opt,name=meta,proto3" json:"meta,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RateLimitResponse) Reset() {
+	*x = RateLimitResponse{}
+	mi := &file_coordinator_proto_msgTypes[46]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RateLimitResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RateLimitResponse) ProtoMessage() {}
+
+func (x *RateLimitResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_coordinator_proto_msgTypes[46]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RateLimitResponse.ProtoReflect.Descriptor instead.
+func (*RateLimitResponse) Descriptor() ([]byte, []int) {
+	return file_coordinator_proto_rawDescGZIP(), []int{46}
+}
+
+func (x *RateLimitResponse) GetData() *RateLimit {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+func (x *RateLimitResponse) GetMeta() *CommonResponse {
+	if x != nil {
+		return x.Meta
+	}
+	return nil
+}
+
+type ListMcpServersResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Data          []*McpServer           `protobuf:"bytes,1,rep,name=data,proto3" json:"data,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListMcpServersResponse) Reset() {
+	*x = ListMcpServersResponse{}
+	mi := &file_coordinator_proto_msgTypes[47]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListMcpServersResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListMcpServersResponse) ProtoMessage() {}
+
+func (x *ListMcpServersResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_coordinator_proto_msgTypes[47]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListMcpServersResponse.ProtoReflect.Descriptor instead.
+func (*ListMcpServersResponse) Descriptor() ([]byte, []int) {
+	return file_coordinator_proto_rawDescGZIP(), []int{47}
+}
+
+func (x *ListMcpServersResponse) GetData() []*McpServer {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+type GetMcpServerRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetMcpServerRequest) Reset() {
+	*x = GetMcpServerRequest{}
+	mi := &file_coordinator_proto_msgTypes[48]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetMcpServerRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetMcpServerRequest) ProtoMessage() {}
+
+func (x *GetMcpServerRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_coordinator_proto_msgTypes[48]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetMcpServerRequest.ProtoReflect.Descriptor instead.
+func (*GetMcpServerRequest) Descriptor() ([]byte, []int) {
+	return file_coordinator_proto_rawDescGZIP(), []int{48}
+}
+
+func (x *GetMcpServerRequest) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+type McpServerResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Data          *McpServer             `protobuf:"bytes,1,opt,name=data,proto3" json:"data,omitempty"`
+	Meta          *CommonResponse        `protobuf:"bytes,2,opt,name=meta,proto3" json:"meta,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *McpServerResponse) Reset() {
+	*x = McpServerResponse{}
+	mi := &file_coordinator_proto_msgTypes[49]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *McpServerResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*McpServerResponse) ProtoMessage() {}
+
+func (x *McpServerResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_coordinator_proto_msgTypes[49]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use McpServerResponse.ProtoReflect.Descriptor instead.
+func (*McpServerResponse) Descriptor() ([]byte, []int) {
+	return file_coordinator_proto_rawDescGZIP(), []int{49}
+}
+
+func (x *McpServerResponse) GetData() *McpServer {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+func (x *McpServerResponse) GetMeta() *CommonResponse {
+	if x != nil {
+		return x.Meta
+	}
+	return nil
+}
+
+type ToolProgressRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	CallId        string                 `protobuf:"bytes,1,opt,name=call_id,json=callId,proto3" json:"call_id,omitempty"`
+	Progress      float64                `protobuf:"fixed64,2,opt,name=progress,proto3" json:"progress,omitempty"`
+	Total         *float64               `protobuf:"fixed64,3,opt,name=total,proto3,oneof" json:"total,omitempty"`
+	Message       string                 `protobuf:"bytes,4,opt,name=message,proto3" json:"message,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ToolProgressRequest) Reset() {
+	*x = ToolProgressRequest{}
+	mi := &file_coordinator_proto_msgTypes[50]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ToolProgressRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ToolProgressRequest) ProtoMessage() {}
+
+func (x *ToolProgressRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_coordinator_proto_msgTypes[50]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ToolProgressRequest.ProtoReflect.Descriptor instead.
+func (*ToolProgressRequest) Descriptor() ([]byte, []int) {
+	return file_coordinator_proto_rawDescGZIP(), []int{50}
+}
+
+func (x *ToolProgressRequest) GetCallId() string {
+	if x != nil {
+		return x.CallId
+	}
+	return ""
+}
+
+func (x *ToolProgressRequest) GetProgress() float64 {
+	if x != nil {
+		return x.Progress
+	}
+	return 0
+}
+
+func (x *ToolProgressRequest) GetTotal() float64 {
+	if x != nil && x.Total != nil {
+		return *x.Total
+	}
+	return 0
+}
+
+func (x *ToolProgressRequest) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+type McpToolCall struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Tool          string                 `protobuf:"bytes,2,opt,name=tool,proto3" json:"tool,omitempty"`
+	FlowId        *int64                 `protobuf:"varint,3,opt,name=flow_id,json=flowId,proto3,oneof" json:"flow_id,omitempty"`
+	FlowVersionId *int64                 `protobuf:"varint,4,opt,name=flow_version_id,json=flowVersionId,proto3,oneof" json:"flow_version_id,omitempty"`
+	McpServerId   *int64                 `protobuf:"varint,5,opt,name=mcp_server_id,json=mcpServerId,proto3,oneof" json:"mcp_server_id,omitempty"`
+	SessionId     string                 `protobuf:"bytes,6,opt,name=session_id,json=sessionId,proto3" json:"session_id,omitempty"`
+	ClientName    string                 `protobuf:"bytes,7,opt,name=client_name,json=clientName,proto3" json:"client_name,omitempty"`
+	ClientVersion string                 `protobuf:"bytes,8,opt,name=client_version,json=clientVersion,proto3" json:"client_version,omitempty"`
+	ArgumentsHash string                 `protobuf:"bytes,9,opt,name=arguments_hash,json=argumentsHash,proto3" json:"arguments_hash,omitempty"`
+	Arguments     string                 `protobuf:"bytes,10,opt,name=arguments,proto3" json:"arguments,omitempty"`
+	Status        string                 `protobuf:"bytes,11,opt,name=status,proto3" json:"status,omitempty"`
+	Error         string                 `protobuf:"bytes,12,opt,name=error,proto3" json:"error,omitempty"`
+	LatencyMs     int64                  `protobuf:"varint,13,opt,name=latency_ms,json=latencyMs,proto3" json:"latency_ms,omitempty"`
+	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,14,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *McpToolCall) Reset() {
+	*x = McpToolCall{}
+	mi := &file_coordinator_proto_msgTypes[51]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *McpToolCall) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*McpToolCall) ProtoMessage() {}
+
+func (x *McpToolCall) ProtoReflect() protoreflect.Message {
+	mi := &file_coordinator_proto_msgTypes[51]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use McpToolCall.ProtoReflect.Descriptor instead.
+func (*McpToolCall) Descriptor() ([]byte, []int) {
+	return file_coordinator_proto_rawDescGZIP(), []int{51}
+}
+
+func (x *McpToolCall) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *McpToolCall) GetTool() string {
+	if x != nil {
+		return x.Tool
+	}
+	return ""
+}
+
+func (x *McpToolCall) GetFlowId() int64 {
+	if x != nil && x.FlowId != nil {
+		return *x.FlowId
+	}
+	return 0
+}
+
+func (x *McpToolCall) GetFlowVersionId() int64 {
+	if x != nil && x.FlowVersionId != nil {
+		return *x.FlowVersionId
+	}
+	return 0
+}
+
+func (x *McpToolCall) GetMcpServerId() int64 {
+	if x != nil && x.McpServerId != nil {
+		return *x.McpServerId
+	}
+	return 0
+}
+
+func (x *McpToolCall) GetSessionId() string {
+	if x != nil {
+		return x.SessionId
+	}
+	return ""
+}
+
+func (x *McpToolCall) GetClientName() string {
+	if x != nil {
+		return x.ClientName
+	}
+	return ""
+}
+
+func (x *McpToolCall) GetClientVersion() string {
+	if x != nil {
+		return x.ClientVersion
+	}
+	return ""
+}
+
+func (x *McpToolCall) GetArgumentsHash() string {
+	if x != nil {
+		return x.ArgumentsHash
+	}
+	return ""
+}
+
+func (x *McpToolCall) GetArguments() string {
+	if x != nil {
+		return x.Arguments
+	}
+	return ""
+}
+
+func (x *McpToolCall) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *McpToolCall) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+func (x *McpToolCall) GetLatencyMs() int64 {
+	if x != nil {
+		return x.LatencyMs
+	}
+	return 0
+}
+
+func (x *McpToolCall) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+type ListToolCallsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Tool          string                 `protobuf:"bytes,1,opt,name=tool,proto3" json:"tool,omitempty"`
+	FlowId        int64                  `protobuf:"varint,2,opt,name=flow_id,json=flowId,proto3" json:"flow_id,omitempty"`
+	Status        string                 `protobuf:"bytes,3,opt,name=status,proto3" json:"status,omitempty"`
+	Limit         int64                  `protobuf:"varint,4,opt,name=limit,proto3" json:"limit,omitempty"`
+	Offset        int64                  `protobuf:"varint,5,opt,name=offset,proto3" json:"offset,omitempty"`
+	StartTime     *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=start_time,json=startTime,proto3" json:"start_time,omitempty"`
+	EndTime       *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=end_time,json=endTime,proto3" json:"end_time,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListToolCallsRequest) Reset() {
+	*x = ListToolCallsRequest{}
+	mi := &file_coordinator_proto_msgTypes[52]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListToolCallsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListToolCallsRequest) ProtoMessage() {}
+
+func (x *ListToolCallsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_coordinator_proto_msgTypes[52]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListToolCallsRequest.ProtoReflect.Descriptor instead.
+func (*ListToolCallsRequest) Descriptor() ([]byte, []int) {
+	return file_coordinator_proto_rawDescGZIP(), []int{52}
+}
+
+func (x *ListToolCallsRequest) GetTool() string {
+	if x != nil {
+		return x.Tool
+	}
+	return ""
+}
+
+func (x *ListToolCallsRequest) GetFlowId() int64 {
+	if x != nil {
+		return x.FlowId
+	}
+	return 0
+}
+
+func (x *ListToolCallsRequest) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *ListToolCallsRequest) GetLimit() int64 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+func (x *ListToolCallsRequest) GetOffset() int64 {
+	if x != nil {
+		return x.Offset
+	}
+	return 0
+}
+
+func (x *ListToolCallsRequest) GetStartTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.StartTime
+	}
+	return nil
+}
+
+func (x *ListToolCallsRequest) GetEndTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.EndTime
+	}
+	return nil
+}
+
+type ListToolCallsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Data          []*McpToolCall         `protobuf:"bytes,1,rep,name=data,proto3" json:"data,omitempty"`
+	Total         int64                  `protobuf:"varint,2,opt,name=total,proto3" json:"total,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListToolCallsResponse) Reset() {
+	*x = ListToolCallsResponse{}
+	mi := &file_coordinator_proto_msgTypes[53]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListToolCallsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListToolCallsResponse) ProtoMessage() {}
+
+func (x *ListToolCallsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_coordinator_proto_msgTypes[53]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListToolCallsResponse.ProtoReflect.Descriptor instead.
+func (*ListToolCallsResponse) Descriptor() ([]byte, []int) {
+	return file_coordinator_proto_rawDescGZIP(), []int{53}
+}
+
+func (x *ListToolCallsResponse) GetData() []*McpToolCall {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+func (x *ListToolCallsResponse) GetTotal() int64 {
+	if x != nil {
+		return x.Total
+	}
+	return 0
+}
+
+type RateLimitKey struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Key           string                 `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
+	Remaining     int64                  `protobuf:"varint,2,opt,name=remaining,proto3" json:"remaining,omitempty"`
+	Limit         int64                  `protobuf:"varint,3,opt,name=limit,proto3" json:"limit,omitempty"`
+	ResetAt       int64                  `protobuf:"varint,4,opt,name=reset_at,proto3" json:"reset_at,omitempty"`
+	LastRefillAt  *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=last_refill_at,proto3" json:"last_refill_at,omitempty"`
+	UpdatedAt     *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=updated_at,proto3" json:"updated_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RateLimitKey) Reset() {
+	*x = RateLimitKey{}
+	mi := &file_coordinator_proto_msgTypes[54]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RateLimitKey) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RateLimitKey) ProtoMessage() {}
+
+func (x *RateLimitKey) ProtoReflect() protoreflect.Message {
+	mi := &file_coordinator_proto_msgTypes[54]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RateLimitKey.ProtoReflect.Descriptor instead.
+func (*RateLimitKey) Descriptor() ([]byte, []int) {
+	return file_coordinator_proto_rawDescGZIP(), []int{54}
+}
+
+func (x *RateLimitKey) GetKey() string {
+	if x != nil {
+		return x.Key
+	}
+	return ""
+}
+
+func (x *RateLimitKey) GetRemaining() int64 {
+	if x != nil {
+		return x.Remaining
+	}
+	return 0
+}
+
+func (x *RateLimitKey) GetLimit() int64 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+func (x *RateLimitKey) GetResetAt() int64 {
+	if x != nil {
+		return x.ResetAt
+	}
+	return 0
+}
+
+func (x *RateLimitKey) GetLastRefillAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.LastRefillAt
+	}
+	return nil
+}
+
+func (x *RateLimitKey) GetUpdatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return nil
+}
+
+type ListRateLimitKeysRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Key           string                 `protobuf:"bytes,2,opt,name=key,proto3" json:"key,omitempty"`
+	Limit         int64                  `protobuf:"varint,3,opt,name=limit,proto3" json:"limit,omitempty"`
+	Offset        int64                  `protobuf:"varint,4,opt,name=offset,proto3" json:"offset,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListRateLimitKeysRequest) Reset() {
+	*x = ListRateLimitKeysRequest{}
+	mi := &file_coordinator_proto_msgTypes[55]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListRateLimitKeysRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListRateLimitKeysRequest) ProtoMessage() {}
+
+func (x *ListRateLimitKeysRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_coordinator_proto_msgTypes[55]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListRateLimitKeysRequest.ProtoReflect.Descriptor instead.
+func (*ListRateLimitKeysRequest) Descriptor() ([]byte, []int) {
+	return file_coordinator_proto_rawDescGZIP(), []int{55}
+}
+
+func (x *ListRateLimitKeysRequest) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *ListRateLimitKeysRequest) GetKey() string {
+	if x != nil {
+		return x.Key
+	}
+	return ""
+}
+
+func (x *ListRateLimitKeysRequest) GetLimit() int64 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+func (x *ListRateLimitKeysRequest) GetOffset() int64 {
+	if x != nil {
+		return x.Offset
+	}
+	return 0
+}
+
+type ListRateLimitKeysResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Data          []*RateLimitKey        `protobuf:"bytes,1,rep,name=data,proto3" json:"data,omitempty"`
+	Total         int64                  `protobuf:"varint,2,opt,name=total,proto3" json:"total,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListRateLimitKeysResponse) Reset() {
+	*x = ListRateLimitKeysResponse{}
+	mi := &file_coordinator_proto_msgTypes[56]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListRateLimitKeysResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListRateLimitKeysResponse) ProtoMessage() {}
+
+func (x *ListRateLimitKeysResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_coordinator_proto_msgTypes[56]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListRateLimitKeysResponse.ProtoReflect.Descriptor instead.
+func (*ListRateLimitKeysResponse) Descriptor() ([]byte, []int) {
+	return file_coordinator_proto_rawDescGZIP(), []int{56}
+}
+
+func (x *ListRateLimitKeysResponse) GetData() []*RateLimitKey {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+func (x *ListRateLimitKeysResponse) GetTotal() int64 {
+	if x != nil {
+		return x.Total
+	}
+	return 0
+}
+
+type ResetRateLimitRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Key           string                 `protobuf:"bytes,2,opt,name=key,proto3" json:"key,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ResetRateLimitRequest) Reset() {
+	*x = ResetRateLimitRequest{}
+	mi := &file_coordinator_proto_msgTypes[57]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ResetRateLimitRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ResetRateLimitRequest) ProtoMessage() {}
+
+func (x *ResetRateLimitRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_coordinator_proto_msgTypes[57]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ResetRateLimitRequest.ProtoReflect.Descriptor instead.
+func (*ResetRateLimitRequest) Descriptor() ([]byte, []int) {
+	return file_coordinator_proto_rawDescGZIP(), []int{57}
+}
+
+func (x *ResetRateLimitRequest) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *ResetRateLimitRequest) GetKey() string {
+	if x != nil {
+		return x.Key
+	}
+	return ""
+}
+
+type GetRateLimitStatsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Hours         int64                  `protobuf:"varint,2,opt,name=hours,proto3" json:"hours,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetRateLimitStatsRequest) Reset() {
+	*x = GetRateLimitStatsRequest{}
+	mi := &file_coordinator_proto_msgTypes[58]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetRateLimitStatsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetRateLimitStatsRequest) ProtoMessage() {}
+
+func (x *GetRateLimitStatsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_coordinator_proto_msgTypes[58]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetRateLimitStatsRequest.ProtoReflect.Descriptor instead.
+func (*GetRateLimitStatsRequest) Descriptor() ([]byte, []int) {
+	return file_coordinator_proto_rawDescGZIP(), []int{58}
+}
+
+func (x *GetRateLimitStatsRequest) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *GetRateLimitStatsRequest) GetHours() int64 {
+	if x != nil {
+		return x.Hours
+	}
+	return 0
+}
+
+type GetRateLimitStatsResponse struct {
+	state         protoimpl.MessageState             `protogen:"open.v1"`
+	Data          []*GetRateLimitStatsResponse_Point `protobuf:"bytes,1,rep,name=data,proto3" json:"data,omitempty"`
+	TotalAllowed  int64                              `protobuf:"varint,2,opt,name=total_allowed,proto3" json:"total_allowed,omitempty"`
+	TotalDenied   int64                              `protobuf:"varint,3,opt,name=total_denied,proto3" json:"total_denied,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetRateLimitStatsResponse) Reset() {
+	*x = GetRateLimitStatsResponse{}
+	mi := &file_coordinator_proto_msgTypes[59]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetRateLimitStatsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetRateLimitStatsResponse) ProtoMessage() {}
+
+func (x *GetRateLimitStatsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_coordinator_proto_msgTypes[59]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetRateLimitStatsResponse.ProtoReflect.Descriptor instead.
+func (*GetRateLimitStatsResponse) Descriptor() ([]byte, []int) {
+	return file_coordinator_proto_rawDescGZIP(), []int{59}
+}
+
+func (x *GetRateLimitStatsResponse) GetData() []*GetRateLimitStatsResponse_Point {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+func (x *GetRateLimitStatsResponse) GetTotalAllowed() int64 {
+	if x != nil {
+		return x.TotalAllowed
+	}
+	return 0
+}
+
+func (x *GetRateLimitStatsResponse) GetTotalDenied() int64 {
+	if x != nil {
+		return x.TotalDenied
+	}
+	return 0
+}
+
+type QueuedRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	FlowId        int64                  `protobuf:"varint,2,opt,name=flow_id,proto3" json:"flow_id,omitempty"`
+	FlowVersionId int64                  `protobuf:"varint,3,opt,name=flow_version_id,proto3" json:"flow_version_id,omitempty"`
+	Method        string                 `protobuf:"bytes,4,opt,name=method,proto3" json:"method,omitempty"`
+	Path          string                 `protobuf:"bytes,5,opt,name=path,proto3" json:"path,omitempty"`
+	Query         string                 `protobuf:"bytes,6,opt,name=query,proto3" json:"query,omitempty"`
+	ContentType   string                 `protobuf:"bytes,7,opt,name=content_type,proto3" json:"content_type,omitempty"`
+	RemoteAddr    string                 `protobuf:"bytes,8,opt,name=remote_addr,proto3" json:"remote_addr,omitempty"`
+	Headers       string                 `protobuf:"bytes,9,opt,name=headers,proto3" json:"headers,omitempty"`
+	Payload       []byte                 `protobuf:"bytes,10,opt,name=payload,proto3" json:"payload,omitempty"`
+	Status        string                 `protobuf:"bytes,11,opt,name=status,proto3" json:"status,omitempty"`
+	Attempts      int32                  `protobuf:"varint,12,opt,name=attempts,proto3" json:"attempts,omitempty"`
+	LastError     string                 `protobuf:"bytes,13,opt,name=last_error,proto3" json:"last_error,omitempty"`
+	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,14,opt,name=created_at,proto3" json:"created_at,omitempty"`
+	UpdatedAt     *timestamppb.Timestamp `protobuf:"bytes,15,opt,name=updated_at,proto3,oneof" json:"updated_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *QueuedRequest) Reset() {
+	*x = QueuedRequest{}
+	mi := &file_coordinator_proto_msgTypes[60]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *QueuedRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*QueuedRequest) ProtoMessage() {}
+
+func (x *QueuedRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_coordinator_proto_msgTypes[60]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use QueuedRequest.ProtoReflect.Descriptor instead.
+func (*QueuedRequest) Descriptor() ([]byte, []int) {
+	return file_coordinator_proto_rawDescGZIP(), []int{60}
+}
+
+func (x *QueuedRequest) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *QueuedRequest) GetFlowId() int64 {
+	if x != nil {
+		return x.FlowId
+	}
+	return 0
+}
+
+func (x *QueuedRequest) GetFlowVersionId() int64 {
+	if x != nil {
+		return x.FlowVersionId
+	}
+	return 0
+}
+
+func (x *QueuedRequest) GetMethod() string {
+	if x != nil {
+		return x.Method
+	}
+	return ""
+}
+
+func (x *QueuedRequest) GetPath() string {
+	if x != nil {
+		return x.Path
+	}
+	return ""
+}
+
+func (x *QueuedRequest) GetQuery() string {
+	if x != nil {
+		return x.Query
+	}
+	return ""
+}
+
+func (x *QueuedRequest) GetContentType() string {
+	if x != nil {
+		return x.ContentType
+	}
+	return ""
+}
+
+func (x *QueuedRequest) GetRemoteAddr() string {
+	if x != nil {
+		return x.RemoteAddr
+	}
+	return ""
+}
+
+func (x *QueuedRequest) GetHeaders() string {
+	if x != nil {
+		return x.Headers
+	}
+	return ""
+}
+
+func (x *QueuedRequest) GetPayload() []byte {
+	if x != nil {
+		return x.Payload
+	}
+	return nil
+}
+
+func (x *QueuedRequest) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *QueuedRequest) GetAttempts() int32 {
+	if x != nil {
+		return x.Attempts
+	}
+	return 0
+}
+
+func (x *QueuedRequest) GetLastError() string {
+	if x != nil {
+		return x.LastError
+	}
+	return ""
+}
+
+func (x *QueuedRequest) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *QueuedRequest) GetUpdatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return nil
+}
+
+type ListQueuedRequestsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	FlowId        int64                  `protobuf:"varint,1,opt,name=flow_id,json=flowId,proto3" json:"flow_id,omitempty"`
+	Status        string                 `protobuf:"bytes,2,opt,name=status,proto3" json:"status,omitempty"`
+	Limit         int64                  `protobuf:"varint,3,opt,name=limit,proto3" json:"limit,omitempty"`
+	Offset        int64                  `protobuf:"varint,4,opt,name=offset,proto3" json:"offset,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListQueuedRequestsRequest) Reset() {
+	*x = ListQueuedRequestsRequest{}
+	mi := &file_coordinator_proto_msgTypes[61]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListQueuedRequestsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListQueuedRequestsRequest) ProtoMessage() {}
+
+func (x *ListQueuedRequestsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_coordinator_proto_msgTypes[61]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListQueuedRequestsRequest.ProtoReflect.Descriptor instead.
+func (*ListQueuedRequestsRequest) Descriptor() ([]byte, []int) {
+	return file_coordinator_proto_rawDescGZIP(), []int{61}
+}
+
+func (x *ListQueuedRequestsRequest) GetFlowId() int64 {
+	if x != nil {
+		return x.FlowId
+	}
+	return 0
+}
+
+func (x *ListQueuedRequestsRequest) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *ListQueuedRequestsRequest) GetLimit() int64 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+func (x *ListQueuedRequestsRequest) GetOffset() int64 {
+	if x != nil {
+		return x.Offset
+	}
+	return 0
+}
+
+type ListQueuedRequestsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Data          []*QueuedRequest       `protobuf:"bytes,1,rep,name=data,proto3" json:"data,omitempty"`
+	Total         int64                  `protobuf:"varint,2,opt,name=total,proto3" json:"total,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListQueuedRequestsResponse) Reset() {
+	*x = ListQueuedRequestsResponse{}
+	mi := &file_coordinator_proto_msgTypes[62]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListQueuedRequestsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListQueuedRequestsResponse) ProtoMessage() {}
+
+func (x *ListQueuedRequestsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_coordinator_proto_msgTypes[62]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return 0
+	return mi.MessageOf(x)
 }
 
-func (x *GetAnalyticsResponse) GetEventsOverTime() []*GetAnalyticsResponse_TimeSeriesPoint {
-	if x != nil {
-		return x.EventsOverTime
-	}
-	return nil
+// Deprecated: Use ListQueuedRequestsResponse.ProtoReflect.Descriptor instead.
+func (*ListQueuedRequestsResponse) Descriptor() ([]byte, []int) {
+	return file_coordinator_proto_rawDescGZIP(), []int{62}
 }
 
-func (x *GetAnalyticsResponse) GetTopInputComponents() []*GetAnalyticsResponse_ComponentCount {
+func (x *ListQueuedRequestsResponse) GetData() []*QueuedRequest {
 	if x != nil {
-		return x.TopInputComponents
+		return x.Data
 	}
 	return nil
 }
 
-func (x *GetAnalyticsResponse) GetTopOutputComponents() []*GetAnalyticsResponse_ComponentCount {
+func (x *ListQueuedRequestsResponse) GetTotal() int64 {
 	if x != nil {
-		return x.TopOutputComponents
+		return x.Total
 	}
-	return nil
+	return 0
 }
 
-type SecretRequest struct {
+type QueuedRequestIdRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Key           string                 `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
-	Value         string                 `protobuf:"bytes,2,opt,name=value,proto3" json:"value,omitempty"`
+	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *SecretRequest) Reset() {
-	*x = SecretRequest{}
-	mi := &file_coordinator_proto_msgTypes[17]
+func (x *QueuedRequestIdRequest) Reset() {
+	*x = QueuedRequestIdRequest{}
+	mi := &file_coordinator_proto_msgTypes[63]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *SecretRequest) String() string {
+func (x *QueuedRequestIdRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SecretRequest) ProtoMessage() {}
+func (*QueuedRequestIdRequest) ProtoMessage() {}
 
-func (x *SecretRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_coordinator_proto_msgTypes[17]
+func (x *QueuedRequestIdRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_coordinator_proto_msgTypes[63]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1087,47 +4082,40 @@ func (x *SecretRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SecretRequest.ProtoReflect.Descriptor instead.
-func (*SecretRequest) Descriptor() ([]byte, []int) {
-	return file_coordinator_proto_rawDescGZIP(), []int{17}
-}
-
-func (x *SecretRequest) GetKey() string {
-	if x != nil {
-		return x.Key
-	}
-	return ""
+// Deprecated: Use QueuedRequestIdRequest.ProtoReflect.Descriptor instead.
+func (*QueuedRequestIdRequest) Descriptor() ([]byte, []int) {
+	return file_coordinator_proto_rawDescGZIP(), []int{63}
 }
 
-func (x *SecretRequest) GetValue() string {
+func (x *QueuedRequestIdRequest) GetId() int64 {
 	if x != nil {
-		return x.Value
+		return x.Id
 	}
-	return ""
+	return 0
 }
 
-type ListSecretsResponse struct {
+type GetAlertRuleRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Data          []*Secret              `protobuf:"bytes,1,rep,name=data,proto3" json:"data,omitempty"`
+	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ListSecretsResponse) Reset() {
-	*x = ListSecretsResponse{}
-	mi := &file_coordinator_proto_msgTypes[18]
+func (x *GetAlertRuleRequest) Reset() {
+	*x = GetAlertRuleRequest{}
+	mi := &file_coordinator_proto_msgTypes[64]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ListSecretsResponse) String() string {
+func (x *GetAlertRuleRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListSecretsResponse) ProtoMessage() {}
+func (*GetAlertRuleRequest) ProtoMessage() {}
 
-func (x *ListSecretsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_coordinator_proto_msgTypes[18]
+func (x *GetAlertRuleRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_coordinator_proto_msgTypes[64]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1138,41 +4126,41 @@ func (x *ListSecretsResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListSecretsResponse.ProtoReflect.Descriptor instead.
-func (*ListSecretsResponse) Descriptor() ([]byte, []int) {
-	return file_coordinator_proto_rawDescGZIP(), []int{18}
+// Deprecated: Use GetAlertRuleRequest.ProtoReflect.Descriptor instead.
+func (*GetAlertRuleRequest) Descriptor() ([]byte, []int) {
+	return file_coordinator_proto_rawDescGZIP(), []int{64}
 }
 
-func (x *ListSecretsResponse) GetData() []*Secret {
+func (x *GetAlertRuleRequest) GetId() int64 {
 	if x != nil {
-		return x.Data
+		return x.Id
 	}
-	return nil
+	return 0
 }
 
-type SecretResponse struct {
+type AlertRuleResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Data          *Secret                `protobuf:"bytes,1,opt,name=data,proto3" json:"data,omitempty"`
+	Data          *AlertRule             `protobuf:"bytes,1,opt,name=data,proto3" json:"data,omitempty"`
 	Meta          *CommonResponse        `protobuf:"bytes,2,opt,name=meta,proto3" json:"meta,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *SecretResponse) Reset() {
-	*x = SecretResponse{}
-	mi := &file_coordinator_proto_msgTypes[19]
+func (x *AlertRuleResponse) Reset() {
+	*x = AlertRuleResponse{}
+	mi := &file_coordinator_proto_msgTypes[65]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *SecretResponse) String() string {
+func (x *AlertRuleResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SecretResponse) ProtoMessage() {}
+func (*AlertRuleResponse) ProtoMessage() {}
 
-func (x *SecretResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_coordinator_proto_msgTypes[19]
+func (x *AlertRuleResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_coordinator_proto_msgTypes[65]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1183,47 +4171,47 @@ func (x *SecretResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SecretResponse.ProtoReflect.Descriptor instead.
-func (*SecretResponse) Descriptor() ([]byte, []int) {
-	return file_coordinator_proto_rawDescGZIP(), []int{19}
+// Deprecated: Use AlertRuleResponse.ProtoReflect.Descriptor instead.
+func (*AlertRuleResponse) Descriptor() ([]byte, []int) {
+	return file_coordinator_proto_rawDescGZIP(), []int{65}
 }
 
-func (x *SecretResponse) GetData() *Secret {
+func (x *AlertRuleResponse) GetData() *AlertRule {
 	if x != nil {
 		return x.Data
 	}
 	return nil
 }
 
-func (x *SecretResponse) GetMeta() *CommonResponse {
+func (x *AlertRuleResponse) GetMeta() *CommonResponse {
 	if x != nil {
 		return x.Meta
 	}
 	return nil
 }
 
-type ListCachesResponse struct {
+type ListAlertRulesResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Data          []*Cache               `protobuf:"bytes,1,rep,name=data,proto3" json:"data,omitempty"`
+	Data          []*AlertRule           `protobuf:"bytes,1,rep,name=data,proto3" json:"data,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ListCachesResponse) Reset() {
-	*x = ListCachesResponse{}
-	mi := &file_coordinator_proto_msgTypes[20]
+func (x *ListAlertRulesResponse) Reset() {
+	*x = ListAlertRulesResponse{}
+	mi := &file_coordinator_proto_msgTypes[66]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ListCachesResponse) String() string {
+func (x *ListAlertRulesResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListCachesResponse) ProtoMessage() {}
+func (*ListAlertRulesResponse) ProtoMessage() {}
 
-func (x *ListCachesResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_coordinator_proto_msgTypes[20]
+func (x *ListAlertRulesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_coordinator_proto_msgTypes[66]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1234,40 +4222,40 @@ func (x *ListCachesResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListCachesResponse.ProtoReflect.Descriptor instead.
-func (*ListCachesResponse) Descriptor() ([]byte, []int) {
-	return file_coordinator_proto_rawDescGZIP(), []int{20}
+// Deprecated: Use ListAlertRulesResponse.ProtoReflect.Descriptor instead.
+func (*ListAlertRulesResponse) Descriptor() ([]byte, []int) {
+	return file_coordinator_proto_rawDescGZIP(), []int{66}
 }
 
-func (x *ListCachesResponse) GetData() []*Cache {
+func (x *ListAlertRulesResponse) GetData() []*AlertRule {
 	if x != nil {
 		return x.Data
 	}
 	return nil
 }
 
-type GetCacheRequest struct {
+type GetAlertChannelRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetCacheRequest) Reset() {
-	*x = GetCacheRequest{}
-	mi := &file_coordinator_proto_msgTypes[21]
+func (x *GetAlertChannelRequest) Reset() {
+	*x = GetAlertChannelRequest{}
+	mi := &file_coordinator_proto_msgTypes[67]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetCacheRequest) String() string {
+func (x *GetAlertChannelRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetCacheRequest) ProtoMessage() {}
+func (*GetAlertChannelRequest) ProtoMessage() {}
 
-func (x *GetCacheRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_coordinator_proto_msgTypes[21]
+func (x *GetAlertChannelRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_coordinator_proto_msgTypes[67]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1278,41 +4266,41 @@ func (x *GetCacheRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetCacheRequest.ProtoReflect.Descriptor instead.
-func (*GetCacheRequest) Descriptor() ([]byte, []int) {
-	return file_coordinator_proto_rawDescGZIP(), []int{21}
+// Deprecated: Use GetAlertChannelRequest.ProtoReflect.Descriptor instead.
+func (*GetAlertChannelRequest) Descriptor() ([]byte, []int) {
+	return file_coordinator_proto_rawDescGZIP(), []int{67}
 }
 
-func (x *GetCacheRequest) GetId() int64 {
+func (x *GetAlertChannelRequest) GetId() int64 {
 	if x != nil {
 		return x.Id
 	}
 	return 0
 }
 
-type CacheResponse struct {
+type AlertChannelResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Data          *Cache                 `protobuf:"bytes,1,opt,name=data,proto3" json:"data,omitempty"`
+	Data          *AlertChannel          `protobuf:"bytes,1,opt,name=data,proto3" json:"data,omitempty"`
 	Meta          *CommonResponse        `protobuf:"bytes,2,opt,name=meta,proto3" json:"meta,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *CacheResponse) Reset() {
-	*x = CacheResponse{}
-	mi := &file_coordinator_proto_msgTypes[22]
+func (x *AlertChannelResponse) Reset() {
+	*x = AlertChannelResponse{}
+	mi := &file_coordinator_proto_msgTypes[68]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *CacheResponse) String() string {
+func (x *AlertChannelResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CacheResponse) ProtoMessage() {}
+func (*AlertChannelResponse) ProtoMessage() {}
 
-func (x *CacheResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_coordinator_proto_msgTypes[22]
+func (x *AlertChannelResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_coordinator_proto_msgTypes[68]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1323,47 +4311,47 @@ func (x *CacheResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CacheResponse.ProtoReflect.Descriptor instead.
-func (*CacheResponse) Descriptor() ([]byte, []int) {
-	return file_coordinator_proto_rawDescGZIP(), []int{22}
+// Deprecated: Use AlertChannelResponse.ProtoReflect.Descriptor instead.
+func (*AlertChannelResponse) Descriptor() ([]byte, []int) {
+	return file_coordinator_proto_rawDescGZIP(), []int{68}
 }
 
-func (x *CacheResponse) GetData() *Cache {
+func (x *AlertChannelResponse) GetData() *AlertChannel {
 	if x != nil {
 		return x.Data
 	}
 	return nil
 }
 
-func (x *CacheResponse) GetMeta() *CommonResponse {
+func (x *AlertChannelResponse) GetMeta() *CommonResponse {
 	if x != nil {
 		return x.Meta
 	}
 	return nil
 }
 
-type ListRateLimitsResponse struct {
+type ListAlertChannelsResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Data          []*RateLimit           `protobuf:"bytes,1,rep,name=data,proto3" json:"data,omitempty"`
+	Data          []*AlertChannel        `protobuf:"bytes,1,rep,name=data,proto3" json:"data,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ListRateLimitsResponse) Reset() {
-	*x = ListRateLimitsResponse{}
-	mi := &file_coordinator_proto_msgTypes[23]
+func (x *ListAlertChannelsResponse) Reset() {
+	*x = ListAlertChannelsResponse{}
+	mi := &file_coordinator_proto_msgTypes[69]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ListRateLimitsResponse) String() string {
+func (x *ListAlertChannelsResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListRateLimitsResponse) ProtoMessage() {}
+func (*ListAlertChannelsResponse) ProtoMessage() {}
 
-func (x *ListRateLimitsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_coordinator_proto_msgTypes[23]
+func (x *ListAlertChannelsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_coordinator_proto_msgTypes[69]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1374,40 +4362,40 @@ func (x *ListRateLimitsResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListRateLimitsResponse.ProtoReflect.Descriptor instead.
-func (*ListRateLimitsResponse) Descriptor() ([]byte, []int) {
-	return file_coordinator_proto_rawDescGZIP(), []int{23}
+// Deprecated: Use ListAlertChannelsResponse.ProtoReflect.Descriptor instead.
+func (*ListAlertChannelsResponse) Descriptor() ([]byte, []int) {
+	return file_coordinator_proto_rawDescGZIP(), []int{69}
 }
 
-func (x *ListRateLimitsResponse) GetData() []*RateLimit {
+func (x *ListAlertChannelsResponse) GetData() []*AlertChannel {
 	if x != nil {
 		return x.Data
 	}
 	return nil
 }
 
-type GetBufferRequest struct {
+type GetAlertSilenceRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetBufferRequest) Reset() {
-	*x = GetBufferRequest{}
-	mi := &file_coordinator_proto_msgTypes[24]
+func (x *GetAlertSilenceRequest) Reset() {
+	*x = GetAlertSilenceRequest{}
+	mi := &file_coordinator_proto_msgTypes[70]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetBufferRequest) String() string {
+func (x *GetAlertSilenceRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetBufferRequest) ProtoMessage() {}
+func (*GetAlertSilenceRequest) ProtoMessage() {}
 
-func (x *GetBufferRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_coordinator_proto_msgTypes[24]
+func (x *GetAlertSilenceRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_coordinator_proto_msgTypes[70]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1418,41 +4406,41 @@ func (x *GetBufferRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetBufferRequest.ProtoReflect.Descriptor instead.
-func (*GetBufferRequest) Descriptor() ([]byte, []int) {
-	return file_coordinator_proto_rawDescGZIP(), []int{24}
+// Deprecated: Use GetAlertSilenceRequest.ProtoReflect.Descriptor instead.
+func (*GetAlertSilenceRequest) Descriptor() ([]byte, []int) {
+	return file_coordinator_proto_rawDescGZIP(), []int{70}
 }
 
-func (x *GetBufferRequest) GetId() int64 {
+func (x *GetAlertSilenceRequest) GetId() int64 {
 	if x != nil {
 		return x.Id
 	}
 	return 0
 }
 
-type BufferResponse struct {
+type AlertSilenceResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Data          *Buffer                `protobuf:"bytes,1,opt,name=data,proto3" json:"data,omitempty"`
+	Data          *AlertSilence          `protobuf:"bytes,1,opt,name=data,proto3" json:"data,omitempty"`
 	Meta          *CommonResponse        `protobuf:"bytes,2,opt,name=meta,proto3" json:"meta,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *BufferResponse) Reset() {
-	*x = BufferResponse{}
-	mi := &file_coordinator_proto_msgTypes[25]
+func (x *AlertSilenceResponse) Reset() {
+	*x = AlertSilenceResponse{}
+	mi := &file_coordinator_proto_msgTypes[71]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *BufferResponse) String() string {
+func (x *AlertSilenceResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*BufferResponse) ProtoMessage() {}
+func (*AlertSilenceResponse) ProtoMessage() {}
 
-func (x *BufferResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_coordinator_proto_msgTypes[25]
+func (x *AlertSilenceResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_coordinator_proto_msgTypes[71]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1463,47 +4451,48 @@ func (x *BufferResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use BufferResponse.ProtoReflect.Descriptor instead.
-func (*BufferResponse) Descriptor() ([]byte, []int) {
-	return file_coordinator_proto_rawDescGZIP(), []int{25}
+// Deprecated: Use AlertSilenceResponse.ProtoReflect.Descriptor instead.
+func (*AlertSilenceResponse) Descriptor() ([]byte, []int) {
+	return file_coordinator_proto_rawDescGZIP(), []int{71}
 }
 
-func (x *BufferResponse) GetData() *Buffer {
+func (x *AlertSilenceResponse) GetData() *AlertSilence {
 	if x != nil {
 		return x.Data
 	}
 	return nil
 }
 
-func (x *BufferResponse) GetMeta() *CommonResponse {
+func (x *AlertSilenceResponse) GetMeta() *CommonResponse {
 	if x != nil {
 		return x.Meta
 	}
 	return nil
 }
 
-type ListBuffersResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Data          []*Buffer              `protobuf:"bytes,1,rep,name=data,proto3" json:"data,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+type ListAlertSilencesRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// include_expired lists the silences that already ended as well.
+	IncludeExpired bool `protobuf:"varint,1,opt,name=include_expired,json=includeExpired,proto3" json:"include_expired,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
 }
 
-func (x *ListBuffersResponse) Reset() {
-	*x = ListBuffersResponse{}
-	mi := &file_coordinator_proto_msgTypes[26]
+func (x *ListAlertSilencesRequest) Reset() {
+	*x = ListAlertSilencesRequest{}
+	mi := &file_coordinator_proto_msgTypes[72]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ListBuffersResponse) String() string {
+func (x *ListAlertSilencesRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListBuffersResponse) ProtoMessage() {}
+func (*ListAlertSilencesRequest) ProtoMessage() {}
 
-func (x *ListBuffersResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_coordinator_proto_msgTypes[26]
+func (x *ListAlertSilencesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_coordinator_proto_msgTypes[72]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1514,40 +4503,40 @@ func (x *ListBuffersResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListBuffersResponse.ProtoReflect.Descriptor instead.
-func (*ListBuffersResponse) Descriptor() ([]byte, []int) {
-	return file_coordinator_proto_rawDescGZIP(), []int{26}
+// Deprecated: Use ListAlertSilencesRequest.ProtoReflect.Descriptor instead.
+func (*ListAlertSilencesRequest) Descriptor() ([]byte, []int) {
+	return file_coordinator_proto_rawDescGZIP(), []int{72}
 }
 
-func (x *ListBuffersResponse) GetData() []*Buffer {
+func (x *ListAlertSilencesRequest) GetIncludeExpired() bool {
 	if x != nil {
-		return x.Data
+		return x.IncludeExpired
 	}
-	return nil
+	return false
 }
 
-type ListFilesResponse struct {
+type ListAlertSilencesResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Data          []*File                `protobuf:"bytes,1,rep,name=data,proto3" json:"data,omitempty"`
+	Data          []*AlertSilence        `protobuf:"bytes,1,rep,name=data,proto3" json:"data,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ListFilesResponse) Reset() {
-	*x = ListFilesResponse{}
-	mi := &file_coordinator_proto_msgTypes[27]
+func (x *ListAlertSilencesResponse) Reset() {
+	*x = ListAlertSilencesResponse{}
+	mi := &file_coordinator_proto_msgTypes[73]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ListFilesResponse) String() string {
+func (x *ListAlertSilencesResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListFilesResponse) ProtoMessage() {}
+func (*ListAlertSilencesResponse) ProtoMessage() {}
 
-func (x *ListFilesResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_coordinator_proto_msgTypes[27]
+func (x *ListAlertSilencesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_coordinator_proto_msgTypes[73]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1558,40 +4547,46 @@ func (x *ListFilesResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListFilesResponse.ProtoReflect.Descriptor instead.
-func (*ListFilesResponse) Descriptor() ([]byte, []int) {
-	return file_coordinator_proto_rawDescGZIP(), []int{27}
+// Deprecated: Use ListAlertSilencesResponse.ProtoReflect.Descriptor instead.
+func (*ListAlertSilencesResponse) Descriptor() ([]byte, []int) {
+	return file_coordinator_proto_rawDescGZIP(), []int{73}
 }
 
-func (x *ListFilesResponse) GetData() []*File {
+func (x *ListAlertSilencesResponse) GetData() []*AlertSilence {
 	if x != nil {
 		return x.Data
 	}
 	return nil
 }
 
-type GetFileRequest struct {
+type ListAlertsRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Status        string                 `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
+	RuleId        int64                  `protobuf:"varint,2,opt,name=rule_id,json=ruleId,proto3" json:"rule_id,omitempty"`
+	FlowId        int64                  `protobuf:"varint,3,opt,name=flow_id,json=flowId,proto3" json:"flow_id,omitempty"`
+	StartTime     *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=start_time,json=startTime,proto3" json:"start_time,omitempty"`
+	EndTime       *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=end_time,json=endTime,proto3" json:"end_time,omitempty"`
+	Limit         int64                  `protobuf:"varint,6,opt,name=limit,proto3" json:"limit,omitempty"`
+	Offset        int64                  `protobuf:"varint,7,opt,name=offset,proto3" json:"offset,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetFileRequest) Reset() {
-	*x = GetFileRequest{}
-	mi := &file_coordinator_proto_msgTypes[28]
+func (x *ListAlertsRequest) Reset() {
+	*x = ListAlertsRequest{}
+	mi := &file_coordinator_proto_msgTypes[74]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetFileRequest) String() string {
+func (x *ListAlertsRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetFileRequest) ProtoMessage() {}
+func (*ListAlertsRequest) ProtoMessage() {}
 
-func (x *GetFileRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_coordinator_proto_msgTypes[28]
+func (x *ListAlertsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_coordinator_proto_msgTypes[74]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1602,41 +4597,83 @@ func (x *GetFileRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetFileRequest.ProtoReflect.Descriptor instead.
-func (*GetFileRequest) Descriptor() ([]byte, []int) {
-	return file_coordinator_proto_rawDescGZIP(), []int{28}
+// Deprecated: Use ListAlertsRequest.ProtoReflect.Descriptor instead.
+func (*ListAlertsRequest) Descriptor() ([]byte, []int) {
+	return file_coordinator_proto_rawDescGZIP(), []int{74}
 }
 
-func (x *GetFileRequest) GetId() int64 {
+func (x *ListAlertsRequest) GetStatus() string {
 	if x != nil {
-		return x.Id
+		return x.Status
+	}
+	return ""
+}
+
+func (x *ListAlertsRequest) GetRuleId() int64 {
+	if x != nil {
+		return x.RuleId
 	}
 	return 0
 }
 
-type FileResponse struct {
+func (x *ListAlertsRequest) GetFlowId() int64 {
+	if x != nil {
+		return x.FlowId
+	}
+	return 0
+}
+
+func (x *ListAlertsRequest) GetStartTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.StartTime
+	}
+	return nil
+}
+
+func (x *ListAlertsRequest) GetEndTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.EndTime
+	}
+	return nil
+}
+
+func (x *ListAlertsRequest) GetLimit() int64 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+func (x *ListAlertsRequest) GetOffset() int64 {
+	if x != nil {
+		return x.Offset
+	}
+	return 0
+}
+
+type ListAlertsResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Data          *File                  `protobuf:"bytes,1,opt,name=data,proto3" json:"data,omitempty"`
-	Meta          *CommonResponse        `protobuf:"bytes,2,opt,name=meta,proto3" json:"meta,omitempty"`
+	Data          []*Alert               `protobuf:"bytes,1,rep,name=data,proto3" json:"data,omitempty"`
+	Total         int64                  `protobuf:"varint,2,opt,name=total,proto3" json:"total,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *FileResponse) Reset() {
-	*x = FileResponse{}
-	mi := &file_coordinator_proto_msgTypes[29]
+func (x *ListAlertsResponse) Reset() {
+	*x = ListAlertsResponse{}
+	mi := &file_coordinator_proto_msgTypes[75]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *FileResponse) String() string {
+func (x *ListAlertsResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*FileResponse) ProtoMessage() {}
+func (*ListAlertsResponse) ProtoMessage() {}
 
-func (x *FileResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_coordinator_proto_msgTypes[29]
+func (x *ListAlertsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_coordinator_proto_msgTypes[75]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1647,47 +4684,50 @@ func (x *FileResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use FileResponse.ProtoReflect.Descriptor instead.
-func (*FileResponse) Descriptor() ([]byte, []int) {
-	return file_coordinator_proto_rawDescGZIP(), []int{29}
-}
-
-func (x *FileResponse) GetData() *File {
+// Deprecated: Use ListAlertsResponse.ProtoReflect.Descriptor instead.
+func (*ListAlertsResponse) Descriptor() ([]byte, []int) {
+	return file_coordinator_proto_rawDescGZIP(), []int{75}
+}
+
+func (x *ListAlertsResponse) GetData() []*Alert {
 	if x != nil {
 		return x.Data
 	}
 	return nil
 }
 
-func (x *FileResponse) GetMeta() *CommonResponse {
+func (x *ListAlertsResponse) GetTotal() int64 {
 	if x != nil {
-		return x.Meta
+		return x.Total
 	}
-	return nil
+	return 0
 }
 
-type GetRateLimitRequest struct {
+type ListWorkersResponse_Worker struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Address       string                 `protobuf:"bytes,2,opt,name=address,proto3" json:"address,omitempty"`
+	Status        string                 `protobuf:"bytes,3,opt,name=status,proto3" json:"status,omitempty"`
+	LastHeartbeat *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=last_heartbeat,proto3" json:"last_heartbeat,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetRateLimitRequest) Reset() {
-	*x = GetRateLimitRequest{}
-	mi := &file_coordinator_proto_msgTypes[30]
+func (x *ListWorkersResponse_Worker) Reset() {
+	*x = ListWorkersResponse_Worker{}
+	mi := &file_coordinator_proto_msgTypes[76]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetRateLimitRequest) String() string {
+func (x *ListWorkersResponse_Worker) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetRateLimitRequest) ProtoMessage() {}
+func (*ListWorkersResponse_Worker) ProtoMessage() {}
 
-func (x *GetRateLimitRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_coordinator_proto_msgTypes[30]
+func (x *ListWorkersResponse_Worker) ProtoReflect() protoreflect.Message {
+	mi := &file_coordinator_proto_msgTypes[76]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1698,41 +4738,63 @@ func (x *GetRateLimitRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetRateLimitRequest.ProtoReflect.Descriptor instead.
-func (*GetRateLimitRequest) Descriptor() ([]byte, []int) {
-	return file_coordinator_proto_rawDescGZIP(), []int{30}
+// Deprecated: Use ListWorkersResponse_Worker.ProtoReflect.Descriptor instead.
+func (*ListWorkersResponse_Worker) Descriptor() ([]byte, []int) {
+	return file_coordinator_proto_rawDescGZIP(), []int{6, 0}
 }
 
-func (x *GetRateLimitRequest) GetId() int64 {
+func (x *ListWorkersResponse_Worker) GetId() string {
 	if x != nil {
 		return x.Id
 	}
-	return 0
+	return ""
 }
 
-type RateLimitResponse struct {
+func (x *ListWorkersResponse_Worker) GetAddress() string {
+	if x != nil {
+		return x.Address
+	}
+	return ""
+}
+
+func (x *ListWorkersResponse_Worker) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *ListWorkersResponse_Worker) GetLastHeartbeat() *timestamppb.Timestamp {
+	if x != nil {
+		return x.LastHeartbeat
+	}
+	return nil
+}
+
+type GetFlowMetricsResponse_Point struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Data          *RateLimit             `protobuf:"bytes,1,opt,name=data,proto3" json:"data,omitempty"`
-	Meta          *CommonResponse        `protobuf:"bytes,2,opt,name=meta,proto3" json:"meta,omitempty"`
+	Timestamp     string                 `protobuf:"bytes,1,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+	Events        int64                  `protobuf:"varint,2,opt,name=events,proto3" json:"events,omitempty"`
+	Errors        int64                  `protobuf:"varint,3,opt,name=errors,proto3" json:"errors,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *RateLimitResponse) Reset() {
-	*x = RateLimitResponse{}
-	mi := &file_coordinator_proto_msgTypes[31]
+func (x *GetFlowMetricsResponse_Point) Reset() {
+	*x = GetFlowMetricsResponse_Point{}
+	mi := &file_coordinator_proto_msgTypes[83]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *RateLimitResponse) String() string {
+func (x *GetFlowMetricsResponse_Point) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*RateLimitResponse) ProtoMessage() {}
+func (*GetFlowMetricsResponse_Point) ProtoMessage() {}
 
-func (x *RateLimitResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_coordinator_proto_msgTypes[31]
+func (x *GetFlowMetricsResponse_Point) ProtoReflect() protoreflect.Message {
+	mi := &file_coordinator_proto_msgTypes[83]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1743,50 +4805,58 @@ func (x *RateLimitResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use RateLimitResponse.ProtoReflect.Descriptor instead.
-func (*RateLimitResponse) Descriptor() ([]byte, []int) {
-	return file_coordinator_proto_rawDescGZIP(), []int{31}
+// Deprecated: Use GetFlowMetricsResponse_Point.ProtoReflect.Descriptor instead.
+func (*GetFlowMetricsResponse_Point) Descriptor() ([]byte, []int) {
+	return file_coordinator_proto_rawDescGZIP(), []int{29, 0}
 }
 
-func (x *RateLimitResponse) GetData() *RateLimit {
+func (x *GetFlowMetricsResponse_Point) GetTimestamp() string {
 	if x != nil {
-		return x.Data
+		return x.Timestamp
 	}
-	return nil
+	return ""
 }
 
-func (x *RateLimitResponse) GetMeta() *CommonResponse {
+func (x *GetFlowMetricsResponse_Point) GetEvents() int64 {
 	if x != nil {
-		return x.Meta
+		return x.Events
 	}
-	return nil
+	return 0
 }
 
-type ListWorkersResponse_Worker struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	Address       string                 `protobuf:"bytes,2,opt,name=address,proto3" json:"address,omitempty"`
-	Status        string                 `protobuf:"bytes,3,opt,name=status,proto3" json:"status,omitempty"`
-	LastHeartbeat *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=last_heartbeat,proto3" json:"last_heartbeat,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+func (x *GetFlowMetricsResponse_Point) GetErrors() int64 {
+	if x != nil {
+		return x.Errors
+	}
+	return 0
 }
 
-func (x *ListWorkersResponse_Worker) Reset() {
-	*x = ListWorkersResponse_Worker{}
-	mi := &file_coordinator_proto_msgTypes[32]
+type GetFlowMetricsResponse_Component struct {
+	state          protoimpl.MessageState          `protogen:"open.v1"`
+	Section        string                          `protobuf:"bytes,1,opt,name=section,proto3" json:"section,omitempty"`
+	ComponentLabel string                          `protobuf:"bytes,2,opt,name=component_label,proto3" json:"component_label,omitempty"`
+	Data           []*GetFlowMetricsResponse_Point `protobuf:"bytes,3,rep,name=data,proto3" json:"data,omitempty"`
+	TotalEvents    int64                           `protobuf:"varint,4,opt,name=total_events,proto3" json:"total_events,omitempty"`
+	TotalErrors    int64                           `protobuf:"varint,5,opt,name=total_errors,proto3" json:"total_errors,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *GetFlowMetricsResponse_Component) Reset() {
+	*x = GetFlowMetricsResponse_Component{}
+	mi := &file_coordinator_proto_msgTypes[84]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ListWorkersResponse_Worker) String() string {
+func (x *GetFlowMetricsResponse_Component) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListWorkersResponse_Worker) ProtoMessage() {}
+func (*GetFlowMetricsResponse_Component) ProtoMessage() {}
 
-func (x *ListWorkersResponse_Worker) ProtoReflect() protoreflect.Message {
-	mi := &file_coordinator_proto_msgTypes[32]
+func (x *GetFlowMetricsResponse_Component) ProtoReflect() protoreflect.Message {
+	mi := &file_coordinator_proto_msgTypes[84]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1797,37 +4867,44 @@ func (x *ListWorkersResponse_Worker) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListWorkersResponse_Worker.ProtoReflect.Descriptor instead.
-func (*ListWorkersResponse_Worker) Descriptor() ([]byte, []int) {
-	return file_coordinator_proto_rawDescGZIP(), []int{6, 0}
+// Deprecated: Use GetFlowMetricsResponse_Component.ProtoReflect.Descriptor instead.
+func (*GetFlowMetricsResponse_Component) Descriptor() ([]byte, []int) {
+	return file_coordinator_proto_rawDescGZIP(), []int{29, 1}
 }
 
-func (x *ListWorkersResponse_Worker) GetId() string {
+func (x *GetFlowMetricsResponse_Component) GetSection() string {
 	if x != nil {
-		return x.Id
+		return x.Section
 	}
 	return ""
 }
 
-func (x *ListWorkersResponse_Worker) GetAddress() string {
+func (x *GetFlowMetricsResponse_Component) GetComponentLabel() string {
 	if x != nil {
-		return x.Address
+		return x.ComponentLabel
 	}
 	return ""
 }
 
-func (x *ListWorkersResponse_Worker) GetStatus() string {
+func (x *GetFlowMetricsResponse_Component) GetData() []*GetFlowMetricsResponse_Point {
 	if x != nil {
-		return x.Status
+		return x.Data
 	}
-	return ""
+	return nil
 }
 
-func (x *ListWorkersResponse_Worker) GetLastHeartbeat() *timestamppb.Timestamp {
+func (x *GetFlowMetricsResponse_Component) GetTotalEvents() int64 {
 	if x != nil {
-		return x.LastHeartbeat
+		return x.TotalEvents
 	}
-	return nil
+	return 0
+}
+
+func (x *GetFlowMetricsResponse_Component) GetTotalErrors() int64 {
+	if x != nil {
+		return x.TotalErrors
+	}
+	return 0
 }
 
 type GetAnalyticsResponse_FlowStatusCount struct {
@@ -1840,7 +4917,7 @@ type GetAnalyticsResponse_FlowStatusCount struct {
 
 func (x *GetAnalyticsResponse_FlowStatusCount) Reset() {
 	*x = GetAnalyticsResponse_FlowStatusCount{}
-	mi := &file_coordinator_proto_msgTypes[36]
+	mi := &file_coordinator_proto_msgTypes[85]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1852,7 +4929,7 @@ func (x *GetAnalyticsResponse_FlowStatusCount) String() string {
 func (*GetAnalyticsResponse_FlowStatusCount) ProtoMessage() {}
 
 func (x *GetAnalyticsResponse_FlowStatusCount) ProtoReflect() protoreflect.Message {
-	mi := &file_coordinator_proto_msgTypes[36]
+	mi := &file_coordinator_proto_msgTypes[85]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1865,7 +4942,7 @@ func (x *GetAnalyticsResponse_FlowStatusCount) ProtoReflect() protoreflect.Messa
 
 // Deprecated: Use GetAnalyticsResponse_FlowStatusCount.ProtoReflect.Descriptor instead.
 func (*GetAnalyticsResponse_FlowStatusCount) Descriptor() ([]byte, []int) {
-	return file_coordinator_proto_rawDescGZIP(), []int{16, 0}
+	return file_coordinator_proto_rawDescGZIP(), []int{31, 0}
 }
 
 func (x *GetAnalyticsResponse_FlowStatusCount) GetStatus() string {
@@ -1892,7 +4969,7 @@ type GetAnalyticsResponse_ComponentCount struct {
 
 func (x *GetAnalyticsResponse_ComponentCount) Reset() {
 	*x = GetAnalyticsResponse_ComponentCount{}
-	mi := &file_coordinator_proto_msgTypes[37]
+	mi := &file_coordinator_proto_msgTypes[86]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1904,7 +4981,7 @@ func (x *GetAnalyticsResponse_ComponentCount) String() string {
 func (*GetAnalyticsResponse_ComponentCount) ProtoMessage() {}
 
 func (x *GetAnalyticsResponse_ComponentCount) ProtoReflect() protoreflect.Message {
-	mi := &file_coordinator_proto_msgTypes[37]
+	mi := &file_coordinator_proto_msgTypes[86]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1917,7 +4994,7 @@ func (x *GetAnalyticsResponse_ComponentCount) ProtoReflect() protoreflect.Messag
 
 // Deprecated: Use GetAnalyticsResponse_ComponentCount.ProtoReflect.Descriptor instead.
 func (*GetAnalyticsResponse_ComponentCount) Descriptor() ([]byte, []int) {
-	return file_coordinator_proto_rawDescGZIP(), []int{16, 1}
+	return file_coordinator_proto_rawDescGZIP(), []int{31, 1}
 }
 
 func (x *GetAnalyticsResponse_ComponentCount) GetComponent() string {
@@ -1946,7 +5023,7 @@ type GetAnalyticsResponse_TimeSeriesPoint struct {
 
 func (x *GetAnalyticsResponse_TimeSeriesPoint) Reset() {
 	*x = GetAnalyticsResponse_TimeSeriesPoint{}
-	mi := &file_coordinator_proto_msgTypes[38]
+	mi := &file_coordinator_proto_msgTypes[87]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1958,7 +5035,7 @@ func (x *GetAnalyticsResponse_TimeSeriesPoint) String() string {
 func (*GetAnalyticsResponse_TimeSeriesPoint) ProtoMessage() {}
 
 func (x *GetAnalyticsResponse_TimeSeriesPoint) ProtoReflect() protoreflect.Message {
-	mi := &file_coordinator_proto_msgTypes[38]
+	mi := &file_coordinator_proto_msgTypes[87]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1971,7 +5048,7 @@ func (x *GetAnalyticsResponse_TimeSeriesPoint) ProtoReflect() protoreflect.Messa
 
 // Deprecated: Use GetAnalyticsResponse_TimeSeriesPoint.ProtoReflect.Descriptor instead.
 func (*GetAnalyticsResponse_TimeSeriesPoint) Descriptor() ([]byte, []int) {
-	return file_coordinator_proto_rawDescGZIP(), []int{16, 2}
+	return file_coordinator_proto_rawDescGZIP(), []int{31, 2}
 }
 
 func (x *GetAnalyticsResponse_TimeSeriesPoint) GetTimestamp() string {
@@ -2002,6 +5079,142 @@ func (x *GetAnalyticsResponse_TimeSeriesPoint) GetErrorEvents() int64 {
 	return 0
 }
 
+type GetAnalyticsResponse_ToolCallStats struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Tool          string                 `protobuf:"bytes,1,opt,name=tool,proto3" json:"tool,omitempty"`
+	Calls         int64                  `protobuf:"varint,2,opt,name=calls,proto3" json:"calls,omitempty"`
+	Errors        int64                  `protobuf:"varint,3,opt,name=errors,proto3" json:"errors,omitempty"`
+	AvgLatencyMs  float64                `protobuf:"fixed64,4,opt,name=avg_latency_ms,proto3" json:"avg_latency_ms,omitempty"`
+	MaxLatencyMs  int64                  `protobuf:"varint,5,opt,name=max_latency_ms,proto3" json:"max_latency_ms,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetAnalyticsResponse_ToolCallStats) Reset() {
+	*x = GetAnalyticsResponse_ToolCallStats{}
+	mi := &file_coordinator_proto_msgTypes[88]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetAnalyticsResponse_ToolCallStats) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetAnalyticsResponse_ToolCallStats) ProtoMessage() {}
+
+func (x *GetAnalyticsResponse_ToolCallStats) ProtoReflect() protoreflect.Message {
+	mi := &file_coordinator_proto_msgTypes[88]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetAnalyticsResponse_ToolCallStats.ProtoReflect.Descriptor instead.
+func (*GetAnalyticsResponse_ToolCallStats) Descriptor() ([]byte, []int) {
+	return file_coordinator_proto_rawDescGZIP(), []int{31, 3}
+}
+
+func (x *GetAnalyticsResponse_ToolCallStats) GetTool() string {
+	if x != nil {
+		return x.Tool
+	}
+	return ""
+}
+
+func (x *GetAnalyticsResponse_ToolCallStats) GetCalls() int64 {
+	if x != nil {
+		return x.Calls
+	}
+	return 0
+}
+
+func (x *GetAnalyticsResponse_ToolCallStats) GetErrors() int64 {
+	if x != nil {
+		return x.Errors
+	}
+	return 0
+}
+
+func (x *GetAnalyticsResponse_ToolCallStats) GetAvgLatencyMs() float64 {
+	if x != nil {
+		return x.AvgLatencyMs
+	}
+	return 0
+}
+
+func (x *GetAnalyticsResponse_ToolCallStats) GetMaxLatencyMs() int64 {
+	if x != nil {
+		return x.MaxLatencyMs
+	}
+	return 0
+}
+
+type GetRateLimitStatsResponse_Point struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Timestamp     string                 `protobuf:"bytes,1,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+	Allowed       int64                  `protobuf:"varint,2,opt,name=allowed,proto3" json:"allowed,omitempty"`
+	Denied        int64                  `protobuf:"varint,3,opt,name=denied,proto3" json:"denied,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetRateLimitStatsResponse_Point) Reset() {
+	*x = GetRateLimitStatsResponse_Point{}
+	mi := &file_coordinator_proto_msgTypes[89]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetRateLimitStatsResponse_Point) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetRateLimitStatsResponse_Point) ProtoMessage() {}
+
+func (x *GetRateLimitStatsResponse_Point) ProtoReflect() protoreflect.Message {
+	mi := &file_coordinator_proto_msgTypes[89]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetRateLimitStatsResponse_Point.ProtoReflect.Descriptor instead.
+func (*GetRateLimitStatsResponse_Point) Descriptor() ([]byte, []int) {
+	return file_coordinator_proto_rawDescGZIP(), []int{59, 0}
+}
+
+func (x *GetRateLimitStatsResponse_Point) GetTimestamp() string {
+	if x != nil {
+		return x.Timestamp
+	}
+	return ""
+}
+
+func (x *GetRateLimitStatsResponse_Point) GetAllowed() int64 {
+	if x != nil {
+		return x.Allowed
+	}
+	return 0
+}
+
+func (x *GetRateLimitStatsResponse_Point) GetDenied() int64 {
+	if x != nil {
+		return x.Denied
+	}
+	return 0
+}
+
 var File_coordinator_proto protoreflect.FileDescriptor
 
 const file_coordinator_proto_rawDesc = "" +
@@ -2040,7 +5253,7 @@ const file_coordinator_proto_rawDesc = "" +
 	"\x02id\x18\x01 \x01(\x03B\a\xfaB\x04\"\x02 \x00R\x02id\"f\n" +
 	"\fFlowResponse\x12%\n" +
 	"\x04data\x18\x01 \x01(\v2\x11.protorender.FlowR\x04data\x12/\n" +
-	"\x04meta\x18\x02 \x01(\v2\x1b.protorender.CommonResponseR\x04meta\"\xe2\x02\n" +
+	"\x04meta\x18\x02 \x01(\v2\x1b.protorender.CommonResponseR\x04meta\"\xf4\x02\n" +
 	"\x05Event\x12$\n" +
 	"\x0eworker_flow_id\x18\x01 \x01(\x03R\fworkerFlowId\x12\x18\n" +
 	"\asection\x18\x02 \x01(\tR\asection\x12'\n" +
@@ -2051,17 +5264,107 @@ const file_coordinator_proto_rawDesc = "" +
 	"\btrace_id\x18\a \x01(\tR\atraceId\x12\x0e\n" +
 	"\x02id\x18\b \x01(\x03R\x02id\x129\n" +
 	"\n" +
-	"created_at\x18\t \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\"\xd5\x01\n" +
+	"created_at\x18\t \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x12\x10\n" +
+	"\x03key\x18\n" +
+	" \x01(\tR\x03key\"\xd0\x01\n" +
+	"\n" +
+	"EventBatch\x12\x1a\n" +
+	"\bsequence\x18\x01 \x01(\x04R\bsequence\x12*\n" +
+	"\x06events\x18\x02 \x03(\v2\x12.protorender.EventR\x06events\x12>\n" +
+	"\adropped\x18\x03 \x03(\v2$.protorender.EventBatch.DroppedEntryR\adropped\x1a:\n" +
+	"\fDroppedEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\x03R\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\x04R\x05value:\x028\x01\"&\n" +
+	"\bEventAck\x12\x1a\n" +
+	"\bsequence\x18\x01 \x01(\x04R\bsequence\"\x9e\x04\n" +
 	"\x11ListEventsRequest\x12 \n" +
 	"\aflow_id\x18\x01 \x01(\x03B\a\xfaB\x04\"\x02 \x00R\x06flowId\x12\x14\n" +
 	"\x05limit\x18\x02 \x01(\x03R\x05limit\x12\x16\n" +
 	"\x06offset\x18\x03 \x01(\x03R\x06offset\x129\n" +
 	"\n" +
 	"start_time\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\tstartTime\x125\n" +
-	"\bend_time\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\aendTime\"R\n" +
+	"\bend_time\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\aendTime\x12:\n" +
+	"\asection\x18\x06 \x01(\tB \xfaB\x1dr\x1bR\x00R\x05inputR\bpipelineR\x06outputR\asection\x12'\n" +
+	"\x0fcomponent_label\x18\a \x01(\tR\x0ecomponentLabel\x12E\n" +
+	"\x04type\x18\b \x01(\tB1\xfaB.r,R\x00R\aPRODUCER\aCONSUMER\x06DELETER\x05ERRORR\aUNKNOWNR\x04type\x12\x19\n" +
+	"\btrace_id\x18\t \x01(\tR\atraceId\x12-\n" +
+	"\x0eworker_flow_id\x18\n" +
+	" \x01(\x03B\a\xfaB\x04\"\x02(\x00R\fworkerFlowId\x12/\n" +
+	"\x0fflow_version_id\x18\v \x01(\x03B\a\xfaB\x04\"\x02(\x00R\rflowVersionId\x12 \n" +
+	"\x06search\x18\f \x01(\tB\b\xfaB\x05r\x03\x18\x80\x02R\x06search\"R\n" +
 	"\x12ListEventsResponse\x12&\n" +
 	"\x04data\x18\x01 \x03(\v2\x12.protorender.EventR\x04data\x12\x14\n" +
-	"\x05total\x18\x02 \x01(\x03R\x05total\"\x83\x06\n" +
+	"\x05total\x18\x02 \x01(\x03R\x05total\"\xfe\x02\n" +
+	"\x11TailEventsRequest\x12 \n" +
+	"\aflow_id\x18\x01 \x01(\x03B\a\xfaB\x04\"\x02 \x00R\x06flowId\x12:\n" +
+	"\asection\x18\x02 \x01(\tB \xfaB\x1dr\x1bR\x00R\x05inputR\bpipelineR\x06outputR\asection\x12'\n" +
+	"\x0fcomponent_label\x18\x03 \x01(\tR\x0ecomponentLabel\x12E\n" +
+	"\x04type\x18\x04 \x01(\tB1\xfaB.r,R\x00R\aPRODUCER\aCONSUMER\x06DELETER\x05ERRORR\aUNKNOWNR\x04type\x12\x19\n" +
+	"\btrace_id\x18\x05 \x01(\tR\atraceId\x12-\n" +
+	"\x0eworker_flow_id\x18\x06 \x01(\x03B\a\xfaB\x04\"\x02(\x00R\fworkerFlowId\x12/\n" +
+	"\x0fflow_version_id\x18\a \x01(\x03B\a\xfaB\x04\"\x02(\x00R\rflowVersionId\x12 \n" +
+	"\x06search\x18\b \x01(\tB\b\xfaB\x05r\x03\x18\x80\x02R\x06search\"X\n" +
+	"\x12TailEventsResponse\x12(\n" +
+	"\x05event\x18\x01 \x01(\v2\x12.protorender.EventR\x05event\x12\x18\n" +
+	"\adropped\x18\x02 \x01(\x04R\adropped\"\xf8\x01\n" +
+	"\aFlowLog\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\x12$\n" +
+	"\x0eworker_flow_id\x18\x02 \x01(\x03R\fworkerFlowId\x12\x1b\n" +
+	"\tworker_id\x18\x03 \x01(\tR\bworkerId\x12\x14\n" +
+	"\x05level\x18\x04 \x01(\tR\x05level\x12\x18\n" +
+	"\amessage\x18\x05 \x01(\tR\amessage\x12/\n" +
+	"\x06fields\x18\x06 \x01(\v2\x17.google.protobuf.StructR\x06fields\x129\n" +
+	"\n" +
+	"created_at\x18\a \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\"\xc0\x01\n" +
+	"\x11IngestLogsRequest\x12(\n" +
+	"\x04logs\x18\x01 \x03(\v2\x14.protorender.FlowLogR\x04logs\x12E\n" +
+	"\adropped\x18\x02 \x03(\v2+.protorender.IngestLogsRequest.DroppedEntryR\adropped\x1a:\n" +
+	"\fDroppedEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\x03R\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\x04R\x05value:\x028\x01\"\xc4\x03\n" +
+	"\x13ListFlowLogsRequest\x12 \n" +
+	"\aflow_id\x18\x01 \x01(\x03B\a\xfaB\x04\"\x02 \x00R\x06flowId\x12\x14\n" +
+	"\x05limit\x18\x02 \x01(\x03R\x05limit\x12\x16\n" +
+	"\x06offset\x18\x03 \x01(\x03R\x06offset\x129\n" +
+	"\n" +
+	"start_time\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\tstartTime\x125\n" +
+	"\bend_time\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\aendTime\x12L\n" +
+	"\x05level\x18\x06 \x01(\tB6\xfaB3r1R\x00R\x05traceR\x05debugR\x04infoR\x04warnR\x05errorR\x05fatalR\x05panicR\x05level\x12\x1b\n" +
+	"\tworker_id\x18\a \x01(\tR\bworkerId\x12-\n" +
+	"\x0eworker_flow_id\x18\b \x01(\x03B\a\xfaB\x04\"\x02(\x00R\fworkerFlowId\x12/\n" +
+	"\x0fflow_version_id\x18\t \x01(\x03B\a\xfaB\x04\"\x02(\x00R\rflowVersionId\x12 \n" +
+	"\x06search\x18\n" +
+	" \x01(\tB\b\xfaB\x05r\x03\x18\x80\x02R\x06search\"V\n" +
+	"\x14ListFlowLogsResponse\x12(\n" +
+	"\x04data\x18\x01 \x03(\v2\x14.protorender.FlowLogR\x04data\x12\x14\n" +
+	"\x05total\x18\x02 \x01(\x03R\x05total\"\xa4\x02\n" +
+	"\x13TailFlowLogsRequest\x12 \n" +
+	"\aflow_id\x18\x01 \x01(\x03B\a\xfaB\x04\"\x02 \x00R\x06flowId\x12L\n" +
+	"\x05level\x18\x02 \x01(\tB6\xfaB3r1R\x00R\x05traceR\x05debugR\x04infoR\x04warnR\x05errorR\x05fatalR\x05panicR\x05level\x12\x1b\n" +
+	"\tworker_id\x18\x03 \x01(\tR\bworkerId\x12-\n" +
+	"\x0eworker_flow_id\x18\x04 \x01(\x03B\a\xfaB\x04\"\x02(\x00R\fworkerFlowId\x12/\n" +
+	"\x0fflow_version_id\x18\x05 \x01(\x03B\a\xfaB\x04\"\x02(\x00R\rflowVersionId\x12 \n" +
+	"\x06search\x18\x06 \x01(\tB\b\xfaB\x05r\x03\x18\x80\x02R\x06search\"X\n" +
+	"\x14TailFlowLogsResponse\x12&\n" +
+	"\x03log\x18\x01 \x01(\v2\x14.protorender.FlowLogR\x03log\x12\x18\n" +
+	"\adropped\x18\x02 \x01(\x04R\adropped\"5\n" +
+	"\x0fGetTraceRequest\x12\"\n" +
+	"\btrace_id\x18\x01 \x01(\tB\a\xfaB\x04r\x02\x10\x01R\atraceId\"\x89\x02\n" +
+	"\x0eTraceComponent\x12\x18\n" +
+	"\asection\x18\x01 \x01(\tR\asection\x12'\n" +
+	"\x0fcomponent_label\x18\x02 \x01(\tR\x0ecomponentLabel\x129\n" +
+	"\n" +
+	"started_at\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\tstartedAt\x125\n" +
+	"\bended_at\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\aendedAt\x12\x16\n" +
+	"\x06errors\x18\x05 \x01(\x03R\x06errors\x12*\n" +
+	"\x06events\x18\x06 \x03(\v2\x12.protorender.EventR\x06events\"\xf1\x01\n" +
+	"\x10GetTraceResponse\x12\x19\n" +
+	"\btrace_id\x18\x01 \x01(\tR\atraceId\x12\x17\n" +
+	"\aflow_id\x18\x02 \x01(\x03R\x06flowId\x129\n" +
+	"\n" +
+	"started_at\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\tstartedAt\x125\n" +
+	"\bended_at\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\aendedAt\x127\n" +
+	"\btimeline\x18\x05 \x03(\v2\x1b.protorender.TraceComponentR\btimeline\"\xad\a\n" +
 	"\x0eMetricsRequest\x12-\n" +
 	"\x0eworker_flow_id\x18\x01 \x01(\x03B\a\xfaB\x04\"\x02 \x00R\fworkerFlowId\x12!\n" +
 	"\finput_events\x18\x02 \x01(\x04R\vinputEvents\x12)\n" +
@@ -2069,7 +5372,8 @@ const file_coordinator_proto_rawDesc = "" +
 	"\routput_events\x18\x04 \x01(\x04R\foutputEvents\x12r\n" +
 	"\x19input_events_by_component\x18\x05 \x03(\v27.protorender.MetricsRequest.InputEventsByComponentEntryR\x16inputEventsByComponent\x12~\n" +
 	"\x1dprocessor_events_by_component\x18\x06 \x03(\v2;.protorender.MetricsRequest.ProcessorEventsByComponentEntryR\x1aprocessorEventsByComponent\x12u\n" +
-	"\x1aoutput_events_by_component\x18\a \x03(\v28.protorender.MetricsRequest.OutputEventsByComponentEntryR\x17outputEventsByComponent\x1aI\n" +
+	"\x1aoutput_events_by_component\x18\a \x03(\v28.protorender.MetricsRequest.OutputEventsByComponentEntryR\x17outputEventsByComponent\x12b\n" +
+	"\x13errors_by_component\x18\b \x03(\v22.protorender.MetricsRequest.ErrorsByComponentEntryR\x11errorsByComponent\x1aI\n" +
 	"\x1bInputEventsByComponentEntry\x12\x10\n" +
 	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
 	"\x05value\x18\x02 \x01(\x04R\x05value:\x028\x01\x1aM\n" +
@@ -2078,8 +5382,32 @@ const file_coordinator_proto_rawDesc = "" +
 	"\x05value\x18\x02 \x01(\x04R\x05value:\x028\x01\x1aJ\n" +
 	"\x1cOutputEventsByComponentEntry\x12\x10\n" +
 	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
-	"\x05value\x18\x02 \x01(\x04R\x05value:\x028\x01\"\x15\n" +
-	"\x13GetAnalyticsRequest\"\xf3\a\n" +
+	"\x05value\x18\x02 \x01(\x04R\x05value:\x028\x01\x1aD\n" +
+	"\x16ErrorsByComponentEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\x04R\x05value:\x028\x01\"c\n" +
+	"\x15GetFlowMetricsRequest\x12 \n" +
+	"\aflow_id\x18\x01 \x01(\x03B\a\xfaB\x04\"\x02 \x00R\x06flowId\x12\x14\n" +
+	"\x05range\x18\x02 \x01(\tR\x05range\x12\x12\n" +
+	"\x04step\x18\x03 \x01(\tR\x04step\"\xab\x03\n" +
+	"\x16GetFlowMetricsResponse\x12M\n" +
+	"\n" +
+	"components\x18\x01 \x03(\v2-.protorender.GetFlowMetricsResponse.ComponentR\n" +
+	"components\x12\x12\n" +
+	"\x04step\x18\x02 \x01(\tR\x04step\x1aU\n" +
+	"\x05Point\x12\x1c\n" +
+	"\ttimestamp\x18\x01 \x01(\tR\ttimestamp\x12\x16\n" +
+	"\x06events\x18\x02 \x01(\x03R\x06events\x12\x16\n" +
+	"\x06errors\x18\x03 \x01(\x03R\x06errors\x1a\xd6\x01\n" +
+	"\tComponent\x12\x18\n" +
+	"\asection\x18\x01 \x01(\tR\asection\x12(\n" +
+	"\x0fcomponent_label\x18\x02 \x01(\tR\x0fcomponent_label\x12=\n" +
+	"\x04data\x18\x03 \x03(\v2).protorender.GetFlowMetricsResponse.PointR\x04data\x12\"\n" +
+	"\ftotal_events\x18\x04 \x01(\x03R\ftotal_events\x12\"\n" +
+	"\ftotal_errors\x18\x05 \x01(\x03R\ftotal_errors\"`\n" +
+	"\x13GetAnalyticsRequest\x12/\n" +
+	"\x05range\x18\x01 \x01(\tB\x19\xfaB\x16r\x14R\x00R\x021hR\x0324hR\x027dR\x0330dR\x05range\x12\x18\n" +
+	"\aflow_id\x18\x02 \x01(\x03R\aflow_id\"\xe8\t\n" +
 	"\x14GetAnalyticsResponse\x12 \n" +
 	"\vtotal_flows\x18\x01 \x01(\x03R\vtotal_flows\x12[\n" +
 	"\x0fflows_by_status\x18\x02 \x03(\v21.protorender.GetAnalyticsResponse.FlowStatusCountR\x0fflows_by_status\x12.\n" +
@@ -2092,7 +5420,10 @@ const file_coordinator_proto_rawDesc = "" +
 	"\x10events_over_time\x18\t \x03(\v21.protorender.GetAnalyticsResponse.TimeSeriesPointR\x10events_over_time\x12d\n" +
 	"\x14top_input_components\x18\n" +
 	" \x03(\v20.protorender.GetAnalyticsResponse.ComponentCountR\x14top_input_components\x12f\n" +
-	"\x15top_output_components\x18\v \x03(\v20.protorender.GetAnalyticsResponse.ComponentCountR\x15top_output_components\x1a?\n" +
+	"\x15top_output_components\x18\v \x03(\v20.protorender.GetAnalyticsResponse.ComponentCountR\x15top_output_components\x12O\n" +
+	"\n" +
+	"tool_calls\x18\f \x03(\v2/.protorender.GetAnalyticsResponse.ToolCallStatsR\n" +
+	"tool_calls\x1a?\n" +
 	"\x0fFlowStatusCount\x12\x16\n" +
 	"\x06status\x18\x01 \x01(\tR\x06status\x12\x14\n" +
 	"\x05count\x18\x02 \x01(\x03R\x05count\x1aD\n" +
@@ -2103,7 +5434,13 @@ const file_coordinator_proto_rawDesc = "" +
 	"\ttimestamp\x18\x01 \x01(\tR\ttimestamp\x12\"\n" +
 	"\finput_events\x18\x02 \x01(\x03R\finput_events\x12$\n" +
 	"\routput_events\x18\x03 \x01(\x03R\routput_events\x12\"\n" +
-	"\ferror_events\x18\x04 \x01(\x03R\ferror_events\"7\n" +
+	"\ferror_events\x18\x04 \x01(\x03R\ferror_events\x1a\xa1\x01\n" +
+	"\rToolCallStats\x12\x12\n" +
+	"\x04tool\x18\x01 \x01(\tR\x04tool\x12\x14\n" +
+	"\x05calls\x18\x02 \x01(\x03R\x05calls\x12\x16\n" +
+	"\x06errors\x18\x03 \x01(\x03R\x06errors\x12&\n" +
+	"\x0eavg_latency_ms\x18\x04 \x01(\x01R\x0eavg_latency_ms\x12&\n" +
+	"\x0emax_latency_ms\x18\x05 \x01(\x03R\x0emax_latency_ms\"7\n" +
 	"\rSecretRequest\x12\x10\n" +
 	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
 	"\x05value\x18\x02 \x01(\tR\x05value\">\n" +
@@ -2139,7 +5476,157 @@ const file_coordinator_proto_rawDesc = "" +
 	"\x02id\x18\x01 \x01(\x03B\a\xfaB\x04\"\x02 \x00R\x02id\"p\n" +
 	"\x11RateLimitResponse\x12*\n" +
 	"\x04data\x18\x01 \x01(\v2\x16.protorender.RateLimitR\x04data\x12/\n" +
-	"\x04meta\x18\x02 \x01(\v2\x1b.protorender.CommonResponseR\x04meta2\xef\x1c\n" +
+	"\x04meta\x18\x02 \x01(\v2\x1b.protorender.CommonResponseR\x04meta\"D\n" +
+	"\x16ListMcpServersResponse\x12*\n" +
+	"\x04data\x18\x01 \x03(\v2\x16.protorender.McpServerR\x04data\".\n" +
+	"\x13GetMcpServerRequest\x12\x17\n" +
+	"\x02id\x18\x01 \x01(\x03B\a\xfaB\x04\"\x02 \x00R\x02id\"p\n" +
+	"\x11McpServerResponse\x12*\n" +
+	"\x04data\x18\x01 \x01(\v2\x16.protorender.McpServerR\x04data\x12/\n" +
+	"\x04meta\x18\x02 \x01(\v2\x1b.protorender.CommonResponseR\x04meta\"\x92\x01\n" +
+	"\x13ToolProgressRequest\x12 \n" +
+	"\acall_id\x18\x01 \x01(\tB\a\xfaB\x04r\x02\x10\x01R\x06callId\x12\x1a\n" +
+	"\bprogress\x18\x02 \x01(\x01R\bprogress\x12\x19\n" +
+	"\x05total\x18\x03 \x01(\x01H\x00R\x05total\x88\x01\x01\x12\x18\n" +
+	"\amessage\x18\x04 \x01(\tR\amessageB\b\n" +
+	"\x06_total\"\x8b\x04\n" +
+	"\vMcpToolCall\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\x12\x12\n" +
+	"\x04tool\x18\x02 \x01(\tR\x04tool\x12\x1c\n" +
+	"\aflow_id\x18\x03 \x01(\x03H\x00R\x06flowId\x88\x01\x01\x12+\n" +
+	"\x0fflow_version_id\x18\x04 \x01(\x03H\x01R\rflowVersionId\x88\x01\x01\x12'\n" +
+	"\rmcp_server_id\x18\x05 \x01(\x03H\x02R\vmcpServerId\x88\x01\x01\x12\x1d\n" +
+	"\n" +
+	"session_id\x18\x06 \x01(\tR\tsessionId\x12\x1f\n" +
+	"\vclient_name\x18\a \x01(\tR\n" +
+	"clientName\x12%\n" +
+	"\x0eclient_version\x18\b \x01(\tR\rclientVersion\x12%\n" +
+	"\x0earguments_hash\x18\t \x01(\tR\rargumentsHash\x12\x1c\n" +
+	"\targuments\x18\n" +
+	" \x01(\tR\targuments\x12\x16\n" +
+	"\x06status\x18\v \x01(\tR\x06status\x12\x14\n" +
+	"\x05error\x18\f \x01(\tR\x05error\x12\x1d\n" +
+	"\n" +
+	"latency_ms\x18\r \x01(\x03R\tlatencyMs\x129\n" +
+	"\n" +
+	"created_at\x18\x0e \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAtB\n" +
+	"\n" +
+	"\b_flow_idB\x12\n" +
+	"\x10_flow_version_idB\x10\n" +
+	"\x0e_mcp_server_id\"\xfb\x01\n" +
+	"\x14ListToolCallsRequest\x12\x12\n" +
+	"\x04tool\x18\x01 \x01(\tR\x04tool\x12\x17\n" +
+	"\aflow_id\x18\x02 \x01(\x03R\x06flowId\x12\x16\n" +
+	"\x06status\x18\x03 \x01(\tR\x06status\x12\x14\n" +
+	"\x05limit\x18\x04 \x01(\x03R\x05limit\x12\x16\n" +
+	"\x06offset\x18\x05 \x01(\x03R\x06offset\x129\n" +
+	"\n" +
+	"start_time\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampR\tstartTime\x125\n" +
+	"\bend_time\x18\a \x01(\v2\x1a.google.protobuf.TimestampR\aendTime\"[\n" +
+	"\x15ListToolCallsResponse\x12,\n" +
+	"\x04data\x18\x01 \x03(\v2\x18.protorender.McpToolCallR\x04data\x12\x14\n" +
+	"\x05total\x18\x02 \x01(\x03R\x05total\"\xf0\x01\n" +
+	"\fRateLimitKey\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x1c\n" +
+	"\tremaining\x18\x02 \x01(\x03R\tremaining\x12\x14\n" +
+	"\x05limit\x18\x03 \x01(\x03R\x05limit\x12\x1a\n" +
+	"\breset_at\x18\x04 \x01(\x03R\breset_at\x12B\n" +
+	"\x0elast_refill_at\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\x0elast_refill_at\x12:\n" +
+	"\n" +
+	"updated_at\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampR\n" +
+	"updated_at\"}\n" +
+	"\x18ListRateLimitKeysRequest\x12\x17\n" +
+	"\x02id\x18\x01 \x01(\x03B\a\xfaB\x04\"\x02 \x00R\x02id\x12\x1a\n" +
+	"\x03key\x18\x02 \x01(\tB\b\xfaB\x05r\x03\x18\xff\x01R\x03key\x12\x14\n" +
+	"\x05limit\x18\x03 \x01(\x03R\x05limit\x12\x16\n" +
+	"\x06offset\x18\x04 \x01(\x03R\x06offset\"`\n" +
+	"\x19ListRateLimitKeysResponse\x12-\n" +
+	"\x04data\x18\x01 \x03(\v2\x19.protorender.RateLimitKeyR\x04data\x12\x14\n" +
+	"\x05total\x18\x02 \x01(\x03R\x05total\"L\n" +
+	"\x15ResetRateLimitRequest\x12\x17\n" +
+	"\x02id\x18\x01 \x01(\x03B\a\xfaB\x04\"\x02 \x00R\x02id\x12\x1a\n" +
+	"\x03key\x18\x02 \x01(\tB\b\xfaB\x05r\x03\x18\xff\x01R\x03key\"U\n" +
+	"\x18GetRateLimitStatsRequest\x12\x17\n" +
+	"\x02id\x18\x01 \x01(\x03B\a\xfaB\x04\"\x02 \x00R\x02id\x12 \n" +
+	"\x05hours\x18\x02 \x01(\x03B\n" +
+	"\xfaB\a\"\x05\x18\xa8\x01(\x00R\x05hours\"\x80\x02\n" +
+	"\x19GetRateLimitStatsResponse\x12@\n" +
+	"\x04data\x18\x01 \x03(\v2,.protorender.GetRateLimitStatsResponse.PointR\x04data\x12$\n" +
+	"\rtotal_allowed\x18\x02 \x01(\x03R\rtotal_allowed\x12\"\n" +
+	"\ftotal_denied\x18\x03 \x01(\x03R\ftotal_denied\x1aW\n" +
+	"\x05Point\x12\x1c\n" +
+	"\ttimestamp\x18\x01 \x01(\tR\ttimestamp\x12\x18\n" +
+	"\aallowed\x18\x02 \x01(\x03R\aallowed\x12\x16\n" +
+	"\x06denied\x18\x03 \x01(\x03R\x06denied\"\xff\x03\n" +
+	"\rQueuedRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\x12\x18\n" +
+	"\aflow_id\x18\x02 \x01(\x03R\aflow_id\x12(\n" +
+	"\x0fflow_version_id\x18\x03 \x01(\x03R\x0fflow_version_id\x12\x16\n" +
+	"\x06method\x18\x04 \x01(\tR\x06method\x12\x12\n" +
+	"\x04path\x18\x05 \x01(\tR\x04path\x12\x14\n" +
+	"\x05query\x18\x06 \x01(\tR\x05query\x12\"\n" +
+	"\fcontent_type\x18\a \x01(\tR\fcontent_type\x12 \n" +
+	"\vremote_addr\x18\b \x01(\tR\vremote_addr\x12\x18\n" +
+	"\aheaders\x18\t \x01(\tR\aheaders\x12\x18\n" +
+	"\apayload\x18\n" +
+	" \x01(\fR\apayload\x12\x16\n" +
+	"\x06status\x18\v \x01(\tR\x06status\x12\x1a\n" +
+	"\battempts\x18\f \x01(\x05R\battempts\x12\x1e\n" +
+	"\n" +
+	"last_error\x18\r \x01(\tR\n" +
+	"last_error\x12:\n" +
+	"\n" +
+	"created_at\x18\x0e \x01(\v2\x1a.google.protobuf.TimestampR\n" +
+	"created_at\x12?\n" +
+	"\n" +
+	"updated_at\x18\x0f \x01(\v2\x1a.google.protobuf.TimestampH\x00R\n" +
+	"updated_at\x88\x01\x01B\r\n" +
+	"\v_updated_at\"\x91\x01\n" +
+	"\x19ListQueuedRequestsRequest\x12\x17\n" +
+	"\aflow_id\x18\x01 \x01(\x03R\x06flowId\x12-\n" +
+	"\x06status\x18\x02 \x01(\tB\x15\xfaB\x12r\x10R\x00R\x06queuedR\x04deadR\x06status\x12\x14\n" +
+	"\x05limit\x18\x03 \x01(\x03R\x05limit\x12\x16\n" +
+	"\x06offset\x18\x04 \x01(\x03R\x06offset\"b\n" +
+	"\x1aListQueuedRequestsResponse\x12.\n" +
+	"\x04data\x18\x01 \x03(\v2\x1a.protorender.QueuedRequestR\x04data\x12\x14\n" +
+	"\x05total\x18\x02 \x01(\x03R\x05total\"1\n" +
+	"\x16QueuedRequestIdRequest\x12\x17\n" +
+	"\x02id\x18\x01 \x01(\x03B\a\xfaB\x04\"\x02 \x00R\x02id\".\n" +
+	"\x13GetAlertRuleRequest\x12\x17\n" +
+	"\x02id\x18\x01 \x01(\x03B\a\xfaB\x04\"\x02 \x00R\x02id\"p\n" +
+	"\x11AlertRuleResponse\x12*\n" +
+	"\x04data\x18\x01 \x01(\v2\x16.protorender.AlertRuleR\x04data\x12/\n" +
+	"\x04meta\x18\x02 \x01(\v2\x1b.protorender.CommonResponseR\x04meta\"D\n" +
+	"\x16ListAlertRulesResponse\x12*\n" +
+	"\x04data\x18\x01 \x03(\v2\x16.protorender.AlertRuleR\x04data\"1\n" +
+	"\x16GetAlertChannelRequest\x12\x17\n" +
+	"\x02id\x18\x01 \x01(\x03B\a\xfaB\x04\"\x02 \x00R\x02id\"v\n" +
+	"\x14AlertChannelResponse\x12-\n" +
+	"\x04data\x18\x01 \x01(\v2\x19.protorender.AlertChannelR\x04data\x12/\n" +
+	"\x04meta\x18\x02 \x01(\v2\x1b.protorender.CommonResponseR\x04meta\"J\n" +
+	"\x19ListAlertChannelsResponse\x12-\n" +
+	"\x04data\x18\x01 \x03(\v2\x19.protorender.AlertChannelR\x04data\"1\n" +
+	"\x16GetAlertSilenceRequest\x12\x17\n" +
+	"\x02id\x18\x01 \x01(\x03B\a\xfaB\x04\"\x02 \x00R\x02id\"v\n" +
+	"\x14AlertSilenceResponse\x12-\n" +
+	"\x04data\x18\x01 \x01(\v2\x19.protorender.AlertSilenceR\x04data\x12/\n" +
+	"\x04meta\x18\x02 \x01(\v2\x1b.protorender.CommonResponseR\x04meta\"C\n" +
+	"\x18ListAlertSilencesRequest\x12'\n" +
+	"\x0finclude_expired\x18\x01 \x01(\bR\x0eincludeExpired\"J\n" +
+	"\x19ListAlertSilencesResponse\x12-\n" +
+	"\x04data\x18\x01 \x03(\v2\x19.protorender.AlertSilenceR\x04data\"\xb3\x02\n" +
+	"\x11ListAlertsRequest\x121\n" +
+	"\x06status\x18\x01 \x01(\tB\x19\xfaB\x16r\x14R\x00R\x06firingR\bresolvedR\x06status\x12 \n" +
+	"\arule_id\x18\x02 \x01(\x03B\a\xfaB\x04\"\x02(\x00R\x06ruleId\x12 \n" +
+	"\aflow_id\x18\x03 \x01(\x03B\a\xfaB\x04\"\x02(\x00R\x06flowId\x129\n" +
+	"\n" +
+	"start_time\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\tstartTime\x125\n" +
+	"\bend_time\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\aendTime\x12\x14\n" +
+	"\x05limit\x18\x06 \x01(\x03R\x05limit\x12\x1f\n" +
+	"\x06offset\x18\a \x01(\x03B\a\xfaB\x04\"\x02(\x00R\x06offset\"R\n" +
+	"\x12ListAlertsResponse\x12&\n" +
+	"\x04data\x18\x01 \x03(\v2\x12.protorender.AlertR\x04data\x12\x14\n" +
+	"\x05total\x18\x02 \x01(\x03R\x05total2\xcb;\n" +
 	"\vCoordinator\x12]\n" +
 	"\x16UpdateWorkerFlowStatus\x12$.protorender.WorkerFlowStatusRequest\x1a\x1b.protorender.CommonResponse\"\x00\x12S\n" +
 	"\x0eRegisterWorker\x12\".protorender.RegisterWorkerRequest\x1a\x1b.protorender.CommonResponse\"\x00\x12W\n" +
@@ -2170,7 +5657,11 @@ const file_coordinator_proto_rawDesc = "" +
 	"\x0fCreateRateLimit\x12\x16.protorender.RateLimit\x1a\x1e.protorender.RateLimitResponse\"\x1a\x82\xd3\xe4\x93\x02\x14:\x01*\"\x0f/v0/rate-limits\x12j\n" +
 	"\x0fUpdateRateLimit\x12\x16.protorender.RateLimit\x1a\x1e.protorender.RateLimitResponse\"\x1f\x82\xd3\xe4\x93\x02\x19:\x01*\x1a\x14/v0/rate-limits/{id}\x12n\n" +
 	"\x0fDeleteRateLimit\x12 .protorender.GetRateLimitRequest\x1a\x1b.protorender.CommonResponse\"\x1c\x82\xd3\xe4\x93\x02\x16*\x14/v0/rate-limits/{id}\x12[\n" +
-	"\x0eCheckRateLimit\x12\".protorender.RateLimitCheckRequest\x1a#.protorender.RateLimitCheckResponse\"\x00\x12\\\n" +
+	"\x0eCheckRateLimit\x12\".protorender.RateLimitCheckRequest\x1a#.protorender.RateLimitCheckResponse\"\x00\x12W\n" +
+	"\x10ReleaseRateLimit\x12$.protorender.RateLimitReleaseRequest\x1a\x1b.protorender.CommonResponse\"\x00\x12\x85\x01\n" +
+	"\x11ListRateLimitKeys\x12%.protorender.ListRateLimitKeysRequest\x1a&.protorender.ListRateLimitKeysResponse\"!\x82\xd3\xe4\x93\x02\x1b\x12\x19/v0/rate-limits/{id}/keys\x12x\n" +
+	"\x0eResetRateLimit\x12\".protorender.ResetRateLimitRequest\x1a\x1b.protorender.CommonResponse\"%\x82\xd3\xe4\x93\x02\x1f:\x01*\"\x1a/v0/rate-limits/{id}/reset\x12\x86\x01\n" +
+	"\x11GetRateLimitStats\x12%.protorender.GetRateLimitStatsRequest\x1a&.protorender.GetRateLimitStatsResponse\"\"\x82\xd3\xe4\x93\x02\x1c\x12\x1a/v0/rate-limits/{id}/stats\x12\\\n" +
 	"\vListBuffers\x12\x16.google.protobuf.Empty\x1a .protorender.ListBuffersResponse\"\x13\x82\xd3\xe4\x93\x02\r\x12\v/v0/buffers\x12a\n" +
 	"\tGetBuffer\x12\x1d.protorender.GetBufferRequest\x1a\x1b.protorender.BufferResponse\"\x18\x82\xd3\xe4\x93\x02\x12\x12\x10/v0/buffers/{id}\x12X\n" +
 	"\fCreateBuffer\x12\x13.protorender.Buffer\x1a\x1b.protorender.BufferResponse\"\x16\x82\xd3\xe4\x93\x02\x10:\x01*\"\v/v0/buffers\x12]\n" +
@@ -2185,9 +5676,44 @@ const file_coordinator_proto_rawDesc = "" +
 	"\n" +
 	"DeleteFile\x12\x1b.protorender.GetFileRequest\x1a\x1b.protorender.CommonResponse\"\x16\x82\xd3\xe4\x93\x02\x10*\x0e/v0/files/{id}\x12q\n" +
 	"\n" +
-	"ListEvents\x12\x1e.protorender.ListEventsRequest\x1a\x1f.protorender.ListEventsResponse\"\"\x82\xd3\xe4\x93\x02\x1c\x12\x1a/v0/flows/{flow_id}/events\x12>\n" +
-	"\fIngestEvents\x12\x12.protorender.Event\x1a\x16.google.protobuf.Empty(\x010\x01\x12F\n" +
-	"\rIngestMetrics\x12\x1b.protorender.MetricsRequest\x1a\x16.google.protobuf.Empty\"\x00\x12j\n" +
+	"ListEvents\x12\x1e.protorender.ListEventsRequest\x1a\x1f.protorender.ListEventsResponse\"\"\x82\xd3\xe4\x93\x02\x1c\x12\x1a/v0/flows/{flow_id}/events\x12O\n" +
+	"\n" +
+	"TailEvents\x12\x1e.protorender.TailEventsRequest\x1a\x1f.protorender.TailEventsResponse0\x01\x12f\n" +
+	"\bGetTrace\x12\x1c.protorender.GetTraceRequest\x1a\x1d.protorender.GetTraceResponse\"\x1d\x82\xd3\xe4\x93\x02\x17\x12\x15/v0/traces/{trace_id}\x12B\n" +
+	"\fIngestEvents\x12\x17.protorender.EventBatch\x1a\x15.protorender.EventAck(\x010\x01\x12F\n" +
+	"\rIngestMetrics\x12\x1b.protorender.MetricsRequest\x1a\x16.google.protobuf.Empty\"\x00\x12F\n" +
+	"\n" +
+	"IngestLogs\x12\x1e.protorender.IngestLogsRequest\x1a\x16.google.protobuf.Empty\"\x00\x12u\n" +
+	"\fListFlowLogs\x12 .protorender.ListFlowLogsRequest\x1a!.protorender.ListFlowLogsResponse\" \x82\xd3\xe4\x93\x02\x1a\x12\x18/v0/flows/{flow_id}/logs\x12U\n" +
+	"\fTailFlowLogs\x12 .protorender.TailFlowLogsRequest\x1a!.protorender.TailFlowLogsResponse0\x01\x12~\n" +
+	"\x0eGetFlowMetrics\x12\".protorender.GetFlowMetricsRequest\x1a#.protorender.GetFlowMetricsResponse\"#\x82\xd3\xe4\x93\x02\x1d\x12\x1b/v0/flows/{flow_id}/metrics\x12U\n" +
+	"\x12ReportToolProgress\x12 .protorender.ToolProgressRequest\x1a\x1b.protorender.CommonResponse\"\x00\x12r\n" +
+	"\rListToolCalls\x12!.protorender.ListToolCallsRequest\x1a\".protorender.ListToolCallsResponse\"\x1a\x82\xd3\xe4\x93\x02\x14\x12\x12/v0/mcp/tool-calls\x12f\n" +
+	"\x0eListMcpServers\x12\x16.google.protobuf.Empty\x1a#.protorender.ListMcpServersResponse\"\x17\x82\xd3\xe4\x93\x02\x11\x12\x0f/v0/mcp-servers\x12n\n" +
+	"\fGetMcpServer\x12 .protorender.GetMcpServerRequest\x1a\x1e.protorender.McpServerResponse\"\x1c\x82\xd3\xe4\x93\x02\x16\x12\x14/v0/mcp-servers/{id}\x12e\n" +
+	"\x0fCreateMcpServer\x12\x16.protorender.McpServer\x1a\x1e.protorender.McpServerResponse\"\x1a\x82\xd3\xe4\x93\x02\x14:\x01*\"\x0f/v0/mcp-servers\x12j\n" +
+	"\x0fUpdateMcpServer\x12\x16.protorender.McpServer\x1a\x1e.protorender.McpServerResponse\"\x1f\x82\xd3\xe4\x93\x02\x19:\x01*\x1a\x14/v0/mcp-servers/{id}\x12n\n" +
+	"\x0fDeleteMcpServer\x12 .protorender.GetMcpServerRequest\x1a\x1b.protorender.CommonResponse\"\x1c\x82\xd3\xe4\x93\x02\x16*\x14/v0/mcp-servers/{id}\x12\x80\x01\n" +
+	"\x12ListQueuedRequests\x12&.protorender.ListQueuedRequestsRequest\x1a'.protorender.ListQueuedRequestsResponse\"\x19\x82\xd3\xe4\x93\x02\x13\x12\x11/v0/ingress/queue\x12|\n" +
+	"\x12RetryQueuedRequest\x12#.protorender.QueuedRequestIdRequest\x1a\x1b.protorender.CommonResponse\"$\x82\xd3\xe4\x93\x02\x1e\"\x1c/v0/ingress/queue/{id}/retry\x12w\n" +
+	"\x13DeleteQueuedRequest\x12#.protorender.QueuedRequestIdRequest\x1a\x1b.protorender.CommonResponse\"\x1e\x82\xd3\xe4\x93\x02\x18*\x16/v0/ingress/queue/{id}\x12a\n" +
+	"\n" +
+	"ListAlerts\x12\x1e.protorender.ListAlertsRequest\x1a\x1f.protorender.ListAlertsResponse\"\x12\x82\xd3\xe4\x93\x02\f\x12\n" +
+	"/v0/alerts\x12g\n" +
+	"\x0eListAlertRules\x12\x16.google.protobuf.Empty\x1a#.protorender.ListAlertRulesResponse\"\x18\x82\xd3\xe4\x93\x02\x12\x12\x10/v0/alerts/rules\x12o\n" +
+	"\fGetAlertRule\x12 .protorender.GetAlertRuleRequest\x1a\x1e.protorender.AlertRuleResponse\"\x1d\x82\xd3\xe4\x93\x02\x17\x12\x15/v0/alerts/rules/{id}\x12f\n" +
+	"\x0fCreateAlertRule\x12\x16.protorender.AlertRule\x1a\x1e.protorender.AlertRuleResponse\"\x1b\x82\xd3\xe4\x93\x02\x15:\x01*\"\x10/v0/alerts/rules\x12k\n" +
+	"\x0fUpdateAlertRule\x12\x16.protorender.AlertRule\x1a\x1e.protorender.AlertRuleResponse\" \x82\xd3\xe4\x93\x02\x1a:\x01*\x1a\x15/v0/alerts/rules/{id}\x12o\n" +
+	"\x0fDeleteAlertRule\x12 .protorender.GetAlertRuleRequest\x1a\x1b.protorender.CommonResponse\"\x1d\x82\xd3\xe4\x93\x02\x17*\x15/v0/alerts/rules/{id}\x12p\n" +
+	"\x11ListAlertChannels\x12\x16.google.protobuf.Empty\x1a&.protorender.ListAlertChannelsResponse\"\x1b\x82\xd3\xe4\x93\x02\x15\x12\x13/v0/alerts/channels\x12{\n" +
+	"\x0fGetAlertChannel\x12#.protorender.GetAlertChannelRequest\x1a!.protorender.AlertChannelResponse\" \x82\xd3\xe4\x93\x02\x1a\x12\x18/v0/alerts/channels/{id}\x12r\n" +
+	"\x12CreateAlertChannel\x12\x19.protorender.AlertChannel\x1a!.protorender.AlertChannelResponse\"\x1e\x82\xd3\xe4\x93\x02\x18:\x01*\"\x13/v0/alerts/channels\x12w\n" +
+	"\x12UpdateAlertChannel\x12\x19.protorender.AlertChannel\x1a!.protorender.AlertChannelResponse\"#\x82\xd3\xe4\x93\x02\x1d:\x01*\x1a\x18/v0/alerts/channels/{id}\x12x\n" +
+	"\x12DeleteAlertChannel\x12#.protorender.GetAlertChannelRequest\x1a\x1b.protorender.CommonResponse\" \x82\xd3\xe4\x93\x02\x1a*\x18/v0/alerts/channels/{id}\x12{\n" +
+	"\x10TestAlertChannel\x12#.protorender.GetAlertChannelRequest\x1a\x1b.protorender.CommonResponse\"%\x82\xd3\xe4\x93\x02\x1f\"\x1d/v0/alerts/channels/{id}/test\x12\x7f\n" +
+	"\x11ListAlertSilences\x12%.protorender.ListAlertSilencesRequest\x1a&.protorender.ListAlertSilencesResponse\"\x1b\x82\xd3\xe4\x93\x02\x15\x12\x13/v0/alerts/silences\x12r\n" +
+	"\x12CreateAlertSilence\x12\x19.protorender.AlertSilence\x1a!.protorender.AlertSilenceResponse\"\x1e\x82\xd3\xe4\x93\x02\x18:\x01*\"\x13/v0/alerts/silences\x12x\n" +
+	"\x12DeleteAlertSilence\x12#.protorender.GetAlertSilenceRequest\x1a\x1b.protorender.CommonResponse\" \x82\xd3\xe4\x93\x02\x1a*\x18/v0/alerts/silences/{id}\x12j\n" +
 	"\fGetAnalytics\x12 .protorender.GetAnalyticsRequest\x1a!.protorender.GetAnalyticsResponse\"\x15\x82\xd3\xe4\x93\x02\x0f\x12\r/v0/analyticsB4Z2github.com/sananguliyev/airtruct/internal/protogenb\x06proto3"
 
 var (
@@ -2202,7 +5728,7 @@ func file_coordinator_proto_rawDescGZIP() []byte {
 	return file_coordinator_proto_rawDescData
 }
 
-var file_coordinator_proto_msgTypes = make([]protoimpl.MessageInfo, 39)
+var file_coordinator_proto_msgTypes = make([]protoimpl.MessageInfo, 90)
 var file_coordinator_proto_goTypes = []any{
 	(*RegisterWorkerRequest)(nil),                // 0: protorender.RegisterWorkerRequest
 	(*DeregisterWorkerRequest)(nil),              // 1: protorender.DeregisterWorkerRequest
@@ -2216,164 +5742,338 @@ var file_coordinator_proto_goTypes = []any{
 	(*GetFlowRequest)(nil),                       // 9: protorender.GetFlowRequest
 	(*FlowResponse)(nil),                         // 10: protorender.FlowResponse
 	(*Event)(nil),                                // 11: protorender.Event
-	(*ListEventsRequest)(nil),                    // 12: protorender.ListEventsRequest
-	(*ListEventsResponse)(nil),                   // 13: protorender.ListEventsResponse
-	(*MetricsRequest)(nil),                       // 14: protorender.MetricsRequest
-	(*GetAnalyticsRequest)(nil),                  // 15: protorender.GetAnalyticsRequest
-	(*GetAnalyticsResponse)(nil),                 // 16: protorender.GetAnalyticsResponse
-	(*SecretRequest)(nil),                        // 17: protorender.SecretRequest
-	(*ListSecretsResponse)(nil),                  // 18: protorender.ListSecretsResponse
-	(*SecretResponse)(nil),                       // 19: protorender.SecretResponse
-	(*ListCachesResponse)(nil),                   // 20: protorender.ListCachesResponse
-	(*GetCacheRequest)(nil),                      // 21: protorender.GetCacheRequest
-	(*CacheResponse)(nil),                        // 22: protorender.CacheResponse
-	(*ListRateLimitsResponse)(nil),               // 23: protorender.ListRateLimitsResponse
-	(*GetBufferRequest)(nil),                     // 24: protorender.GetBufferRequest
-	(*BufferResponse)(nil),                       // 25: protorender.BufferResponse
-	(*ListBuffersResponse)(nil),                  // 26: protorender.ListBuffersResponse
-	(*ListFilesResponse)(nil),                    // 27: protorender.ListFilesResponse
-	(*GetFileRequest)(nil),                       // 28: protorender.GetFileRequest
-	(*FileResponse)(nil),                         // 29: protorender.FileResponse
-	(*GetRateLimitRequest)(nil),                  // 30: protorender.GetRateLimitRequest
-	(*RateLimitResponse)(nil),                    // 31: protorender.RateLimitResponse
-	(*ListWorkersResponse_Worker)(nil),           // 32: protorender.ListWorkersResponse.Worker
-	nil,                                          // 33: protorender.MetricsRequest.InputEventsByComponentEntry
-	nil,                                          // 34: protorender.MetricsRequest.ProcessorEventsByComponentEntry
-	nil,                                          // 35: protorender.MetricsRequest.OutputEventsByComponentEntry
-	(*GetAnalyticsResponse_FlowStatusCount)(nil), // 36: protorender.GetAnalyticsResponse.FlowStatusCount
-	(*GetAnalyticsResponse_ComponentCount)(nil),  // 37: protorender.GetAnalyticsResponse.ComponentCount
-	(*GetAnalyticsResponse_TimeSeriesPoint)(nil), // 38: protorender.GetAnalyticsResponse.TimeSeriesPoint
-	(WorkerFlowStatus)(0),                        // 39: protorender.WorkerFlowStatus
-	(*Flow)(nil),                                 // 40: protorender.Flow
-	(*CommonResponse)(nil),                       // 41: protorender.CommonResponse
-	(*structpb.Struct)(nil),                      // 42: google.protobuf.Struct
-	(*timestamppb.Timestamp)(nil),                // 43: google.protobuf.Timestamp
-	(*Secret)(nil),                               // 44: protorender.Secret
-	(*Cache)(nil),                                // 45: protorender.Cache
-	(*RateLimit)(nil),                            // 46: protorender.RateLimit
-	(*Buffer)(nil),                               // 47: protorender.Buffer
-	(*File)(nil),                                 // 48: protorender.File
-	(*emptypb.Empty)(nil),                        // 49: google.protobuf.Empty
-	(*RateLimitCheckRequest)(nil),                // 50: protorender.RateLimitCheckRequest
-	(*RateLimitCheckResponse)(nil),               // 51: protorender.RateLimitCheckResponse
+	(*EventBatch)(nil),                           // 12: protorender.EventBatch
+	(*EventAck)(nil),                             // 13: protorender.EventAck
+	(*ListEventsRequest)(nil),                    // 14: protorender.ListEventsRequest
+	(*ListEventsResponse)(nil),                   // 15: protorender.ListEventsResponse
+	(*TailEventsRequest)(nil),                    // 16: protorender.TailEventsRequest
+	(*TailEventsResponse)(nil),                   // 17: protorender.TailEventsResponse
+	(*FlowLog)(nil),                              // 18: protorender.FlowLog
+	(*IngestLogsRequest)(nil),                    // 19: protorender.IngestLogsRequest
+	(*ListFlowLogsRequest)(nil),                  // 20: protorender.ListFlowLogsRequest
+	(*ListFlowLogsResponse)(nil),                 // 21: protorender.ListFlowLogsResponse
+	(*TailFlowLogsRequest)(nil),                  // 22: protorender.TailFlowLogsRequest
+	(*TailFlowLogsResponse)(nil),                 // 23: protorender.TailFlowLogsResponse
+	(*GetTraceRequest)(nil),                      // 24: protorender.GetTraceRequest
+	(*TraceComponent)(nil),                       // 25: protorender.TraceComponent
+	(*GetTraceResponse)(nil),                     // 26: protorender.GetTraceResponse
+	(*MetricsRequest)(nil),                       // 27: protorender.MetricsRequest
+	(*GetFlowMetricsRequest)(nil),                // 28: protorender.GetFlowMetricsRequest
+	(*GetFlowMetricsResponse)(nil),               // 29: protorender.GetFlowMetricsResponse
+	(*GetAnalyticsRequest)(nil),                  // 30: protorender.GetAnalyticsRequest
+	(*GetAnalyticsResponse)(nil),                 // 31: protorender.GetAnalyticsResponse
+	(*SecretRequest)(nil),                        // 32: protorender.SecretRequest
+	(*ListSecretsResponse)(nil),                  // 33: protorender.ListSecretsResponse
+	(*SecretResponse)(nil),                       // 34: protorender.SecretResponse
+	(*ListCachesResponse)(nil),                   // 35: protorender.ListCachesResponse
+	(*GetCacheRequest)(nil),                      // 36: protorender.GetCacheRequest
+	(*CacheResponse)(nil),                        // 37: protorender.CacheResponse
+	(*ListRateLimitsResponse)(nil),               // 38: protorender.ListRateLimitsResponse
+	(*GetBufferRequest)(nil),                     // 39: protorender.GetBufferRequest
+	(*BufferResponse)(nil),                       // 40: protorender.BufferResponse
+	(*ListBuffersResponse)(nil),                  // 41: protorender.ListBuffersResponse
+	(*ListFilesResponse)(nil),                    // 42: protorender.ListFilesResponse
+	(*GetFileRequest)(nil),                       // 43: protorender.GetFileRequest
+	(*FileResponse)(nil),                         // 44: protorender.FileResponse
+	(*GetRateLimitRequest)(nil),                  // 45: protorender.GetRateLimitRequest
+	(*RateLimitResponse)(nil),                    // 46: protorender.RateLimitResponse
+	(*ListMcpServersResponse)(nil),               // 47: protorender.ListMcpServersResponse
+	(*GetMcpServerRequest)(nil),                  // 48: protorender.GetMcpServerRequest
+	(*McpServerResponse)(nil),                    // 49: protorender.McpServerResponse
+	(*ToolProgressRequest)(nil),                  // 50: protorender.ToolProgressRequest
+	(*McpToolCall)(nil),                          // 51: protorender.McpToolCall
+	(*ListToolCallsRequest)(nil),                 // 52: protorender.ListToolCallsRequest
+	(*ListToolCallsResponse)(nil),                // 53: protorender.ListToolCallsResponse
+	(*RateLimitKey)(nil),                         // 54: protorender.RateLimitKey
+	(*ListRateLimitKeysRequest)(nil),             // 55: protorender.ListRateLimitKeysRequest
+	(*ListRateLimitKeysResponse)(nil),            // 56: protorender.ListRateLimitKeysResponse
+	(*ResetRateLimitRequest)(nil),                // 57: protorender.ResetRateLimitRequest
+	(*GetRateLimitStatsRequest)(nil),             // 58: protorender.GetRateLimitStatsRequest
+	(*GetRateLimitStatsResponse)(nil),            // 59: protorender.GetRateLimitStatsResponse
+	(*QueuedRequest)(nil),                        // 60: protorender.QueuedRequest
+	(*ListQueuedRequestsRequest)(nil),            // 61: protorender.ListQueuedRequestsRequest
+	(*ListQueuedRequestsResponse)(nil),           // 62: protorender.ListQueuedRequestsResponse
+	(*QueuedRequestIdRequest)(nil),               // 63: protorender.QueuedRequestIdRequest
+	(*GetAlertRuleRequest)(nil),                  // 64: protorender.GetAlertRuleRequest
+	(*AlertRuleResponse)(nil),                    // 65: protorender.AlertRuleResponse
+	(*ListAlertRulesResponse)(nil),               // 66: protorender.ListAlertRulesResponse
+	(*GetAlertChannelRequest)(nil),               // 67: protorender.GetAlertChannelRequest
+	(*AlertChannelResponse)(nil),                 // 68: protorender.AlertChannelResponse
+	(*ListAlertChannelsResponse)(nil),            // 69: protorender.ListAlertChannelsResponse
+	(*GetAlertSilenceRequest)(nil),               // 70: protorender.GetAlertSilenceRequest
+	(*AlertSilenceResponse)(nil),                 // 71: protorender.AlertSilenceResponse
+	(*ListAlertSilencesRequest)(nil),             // 72: protorender.ListAlertSilencesRequest
+	(*ListAlertSilencesResponse)(nil),            // 73: protorender.ListAlertSilencesResponse
+	(*ListAlertsRequest)(nil),                    // 74: protorender.ListAlertsRequest
+	(*ListAlertsResponse)(nil),                   // 75: protorender.ListAlertsResponse
+	(*ListWorkersResponse_Worker)(nil),           // 76: protorender.ListWorkersResponse.Worker
+	nil,                                          // 77: protorender.EventBatch.DroppedEntry
+	nil,                                          // 78: protorender.IngestLogsRequest.DroppedEntry
+	nil,                                          // 79: protorender.MetricsRequest.InputEventsByComponentEntry
+	nil,                                          // 80: protorender.MetricsRequest.ProcessorEventsByComponentEntry
+	nil,                                          // 81: protorender.MetricsRequest.OutputEventsByComponentEntry
+	nil,                                          // 82: protorender.MetricsRequest.ErrorsByComponentEntry
+	(*GetFlowMetricsResponse_Point)(nil),         // 83: protorender.GetFlowMetricsResponse.Point
+	(*GetFlowMetricsResponse_Component)(nil),     // 84: protorender.GetFlowMetricsResponse.Component
+	(*GetAnalyticsResponse_FlowStatusCount)(nil), // 85: protorender.GetAnalyticsResponse.FlowStatusCount
+	(*GetAnalyticsResponse_ComponentCount)(nil),  // 86: protorender.GetAnalyticsResponse.ComponentCount
+	(*GetAnalyticsResponse_TimeSeriesPoint)(nil), // 87: protorender.GetAnalyticsResponse.TimeSeriesPoint
+	(*GetAnalyticsResponse_ToolCallStats)(nil),   // 88: protorender.GetAnalyticsResponse.ToolCallStats
+	(*GetRateLimitStatsResponse_Point)(nil),      // 89: protorender.GetRateLimitStatsResponse.Point
+	(WorkerFlowStatus)(0),                        // 90: protorender.WorkerFlowStatus
+	(*Flow)(nil),                                 // 91: protorender.Flow
+	(*CommonResponse)(nil),                       // 92: protorender.CommonResponse
+	(*structpb.Struct)(nil),                      // 93: google.protobuf.Struct
+	(*timestamppb.Timestamp)(nil),                // 94: google.protobuf.Timestamp
+	(*Secret)(nil),                               // 95: protorender.Secret
+	(*Cache)(nil),                                // 96: protorender.Cache
+	(*RateLimit)(nil),                            // 97: protorender.RateLimit
+	(*Buffer)(nil),                               // 98: protorender.Buffer
+	(*File)(nil),                                 // 99: protorender.File
+	(*McpServer)(nil),                            // 100: protorender.McpServer
+	(*AlertRule)(nil),                            // 101: protorender.AlertRule
+	(*AlertChannel)(nil),                         // 102: protorender.AlertChannel
+	(*AlertSilence)(nil),                         // 103: protorender.AlertSilence
+	(*Alert)(nil),                                // 104: protorender.Alert
+	(*emptypb.Empty)(nil),                        // 105: google.protobuf.Empty
+	(*RateLimitCheckRequest)(nil),                // 106: protorender.RateLimitCheckRequest
+	(*RateLimitReleaseRequest)(nil),              // 107: protorender.RateLimitReleaseRequest
+	(*RateLimitCheckResponse)(nil),               // 108: protorender.RateLimitCheckResponse
 }
 var file_coordinator_proto_depIdxs = []int32{
-	39, // 0: protorender.WorkerFlowStatusRequest.status:type_name -> protorender.WorkerFlowStatus
-	32, // 1: protorender.ListWorkersResponse.data:type_name -> protorender.ListWorkersResponse.Worker
-	40, // 2: protorender.ListFlowsResponse.data:type_name -> protorender.Flow
-	40, // 3: protorender.FlowResponse.data:type_name -> protorender.Flow
-	41, // 4: protorender.FlowResponse.meta:type_name -> protorender.CommonResponse
-	42, // 5: protorender.Event.meta:type_name -> google.protobuf.Struct
-	43, // 6: protorender.Event.created_at:type_name -> google.protobuf.Timestamp
-	43, // 7: protorender.ListEventsRequest.start_time:type_name -> google.protobuf.Timestamp
-	43, // 8: protorender.ListEventsRequest.end_time:type_name -> google.protobuf.Timestamp
-	11, // 9: protorender.ListEventsResponse.data:type_name -> protorender.Event
-	33, // 10: protorender.MetricsRequest.input_events_by_component:type_name -> protorender.MetricsRequest.InputEventsByComponentEntry
-	34, // 11: protorender.MetricsRequest.processor_events_by_component:type_name -> protorender.MetricsRequest.ProcessorEventsByComponentEntry
-	35, // 12: protorender.MetricsRequest.output_events_by_component:type_name -> protorender.MetricsRequest.OutputEventsByComponentEntry
-	36, // 13: protorender.GetAnalyticsResponse.flows_by_status:type_name -> protorender.GetAnalyticsResponse.FlowStatusCount
-	38, // 14: protorender.GetAnalyticsResponse.events_over_time:type_name -> protorender.GetAnalyticsResponse.TimeSeriesPoint
-	37, // 15: protorender.GetAnalyticsResponse.top_input_components:type_name -> protorender.GetAnalyticsResponse.ComponentCount
-	37, // 16: protorender.GetAnalyticsResponse.top_output_components:type_name -> protorender.GetAnalyticsResponse.ComponentCount
-	44, // 17: protorender.ListSecretsResponse.data:type_name -> protorender.Secret
-	44, // 18: protorender.SecretResponse.data:type_name -> protorender.Secret
-	41, // 19: protorender.SecretResponse.meta:type_name -> protorender.CommonResponse
-	45, // 20: protorender.ListCachesResponse.data:type_name -> protorender.Cache
-	45, // 21: protorender.CacheResponse.data:type_name -> protorender.Cache
-	41, // 22: protorender.CacheResponse.meta:type_name -> protorender.CommonResponse
-	46, // 23: protorender.ListRateLimitsResponse.data:type_name -> protorender.RateLimit
-	47, // 24: protorender.BufferResponse.data:type_name -> protorender.Buffer
-	41, // 25: protorender.BufferResponse.meta:type_name -> protorender.CommonResponse
-	47, // 26: protorender.ListBuffersResponse.data:type_name -> protorender.Buffer
-	48, // 27: protorender.ListFilesResponse.data:type_name -> protorender.File
-	48, // 28: protorender.FileResponse.data:type_name -> protorender.File
-	41, // 29: protorender.FileResponse.meta:type_name -> protorender.CommonResponse
-	46, // 30: protorender.RateLimitResponse.data:type_name -> protorender.RateLimit
-	41, // 31: protorender.RateLimitResponse.meta:type_name -> protorender.CommonResponse
-	43, // 32: protorender.ListWorkersResponse.Worker.last_heartbeat:type_name -> google.protobuf.Timestamp
-	4,  // 33: protorender.Coordinator.UpdateWorkerFlowStatus:input_type -> protorender.WorkerFlowStatusRequest
-	0,  // 34: protorender.Coordinator.RegisterWorker:input_type -> protorender.RegisterWorkerRequest
-	1,  // 35: protorender.Coordinator.DeregisterWorker:input_type -> protorender.DeregisterWorkerRequest
-	2,  // 36: protorender.Coordinator.Heartbeat:input_type -> protorender.HeartbeatRequest
-	5,  // 37: protorender.Coordinator.ListWorkers:input_type -> protorender.ListWorkersRequest
-	7,  // 38: protorender.Coordinator.ListFlows:input_type -> protorender.ListFlowsRequest
-	9,  // 39: protorender.Coordinator.GetFlow:input_type -> protorender.GetFlowRequest
-	40, // 40: protorender.Coordinator.CreateFlow:input_type -> protorender.Flow
-	40, // 41: protorender.Coordinator.UpdateFlow:input_type -> protorender.Flow
-	49, // 42: protorender.Coordinator.ListSecrets:input_type -> google.protobuf.Empty
-	17, // 43: protorender.Coordinator.CreateSecret:input_type -> protorender.SecretRequest
-	17, // 44: protorender.Coordinator.UpdateSecret:input_type -> protorender.SecretRequest
-	17, // 45: protorender.Coordinator.GetSecret:input_type -> protorender.SecretRequest
-	17, // 46: protorender.Coordinator.DeleteSecret:input_type -> protorender.SecretRequest
-	49, // 47: protorender.Coordinator.ListCaches:input_type -> google.protobuf.Empty
-	21, // 48: protorender.Coordinator.GetCache:input_type -> protorender.GetCacheRequest
-	45, // 49: protorender.Coordinator.CreateCache:input_type -> protorender.Cache
-	45, // 50: protorender.Coordinator.UpdateCache:input_type -> protorender.Cache
-	21, // 51: protorender.Coordinator.DeleteCache:input_type -> protorender.GetCacheRequest
-	49, // 52: protorender.Coordinator.ListRateLimits:input_type -> google.protobuf.Empty
-	30, // 53: protorender.Coordinator.GetRateLimit:input_type -> protorender.GetRateLimitRequest
-	46, // 54: protorender.Coordinator.CreateRateLimit:input_type -> protorender.RateLimit
-	46, // 55: protorender.Coordinator.UpdateRateLimit:input_type -> protorender.RateLimit
-	30, // 56: protorender.Coordinator.DeleteRateLimit:input_type -> protorender.GetRateLimitRequest
-	50, // 57: protorender.Coordinator.CheckRateLimit:input_type -> protorender.RateLimitCheckRequest
-	49, // 58: protorender.Coordinator.ListBuffers:input_type -> google.protobuf.Empty
-	24, // 59: protorender.Coordinator.GetBuffer:input_type -> protorender.GetBufferRequest
-	47, // 60: protorender.Coordinator.CreateBuffer:input_type -> protorender.Buffer
-	47, // 61: protorender.Coordinator.UpdateBuffer:input_type -> protorender.Buffer
-	24, // 62: protorender.Coordinator.DeleteBuffer:input_type -> protorender.GetBufferRequest
-	49, // 63: protorender.Coordinator.ListFiles:input_type -> google.protobuf.Empty
-	28, // 64: protorender.Coordinator.GetFile:input_type -> protorender.GetFileRequest
-	48, // 65: protorender.Coordinator.CreateFile:input_type -> protorender.File
-	48, // 66: protorender.Coordinator.UpdateFile:input_type -> protorender.File
-	28, // 67: protorender.Coordinator.DeleteFile:input_type -> protorender.GetFileRequest
-	12, // 68: protorender.Coordinator.ListEvents:input_type -> protorender.ListEventsRequest
-	11, // 69: protorender.Coordinator.IngestEvents:input_type -> protorender.Event
-	14, // 70: protorender.Coordinator.IngestMetrics:input_type -> protorender.MetricsRequest
-	15, // 71: protorender.Coordinator.GetAnalytics:input_type -> protorender.GetAnalyticsRequest
-	41, // 72: protorender.Coordinator.UpdateWorkerFlowStatus:output_type -> protorender.CommonResponse
-	41, // 73: protorender.Coordinator.RegisterWorker:output_type -> protorender.CommonResponse
-	41, // 74: protorender.Coordinator.DeregisterWorker:output_type -> protorender.CommonResponse
-	3,  // 75: protorender.Coordinator.Heartbeat:output_type -> protorender.HeartbeatResponse
-	6,  // 76: protorender.Coordinator.ListWorkers:output_type -> protorender.ListWorkersResponse
-	8,  // 77: protorender.Coordinator.ListFlows:output_type -> protorender.ListFlowsResponse
-	10, // 78: protorender.Coordinator.GetFlow:output_type -> protorender.FlowResponse
-	10, // 79: protorender.Coordinator.CreateFlow:output_type -> protorender.FlowResponse
-	10, // 80: protorender.Coordinator.UpdateFlow:output_type -> protorender.FlowResponse
-	18, // 81: protorender.Coordinator.ListSecrets:output_type -> protorender.ListSecretsResponse
-	41, // 82: protorender.Coordinator.CreateSecret:output_type -> protorender.CommonResponse
-	41, // 83: protorender.Coordinator.UpdateSecret:output_type -> protorender.CommonResponse
-	19, // 84: protorender.Coordinator.GetSecret:output_type -> protorender.SecretResponse
-	41, // 85: protorender.Coordinator.DeleteSecret:output_type -> protorender.CommonResponse
-	20, // 86: protorender.Coordinator.ListCaches:output_type -> protorender.ListCachesResponse
-	22, // 87: protorender.Coordinator.GetCache:output_type -> protorender.CacheResponse
-	22, // 88: protorender.Coordinator.CreateCache:output_type -> protorender.CacheResponse
-	22, // 89: protorender.Coordinator.UpdateCache:output_type -> protorender.CacheResponse
-	41, // 90: protorender.Coordinator.DeleteCache:output_type -> protorender.CommonResponse
-	23, // 91: protorender.Coordinator.ListRateLimits:output_type -> protorender.ListRateLimitsResponse
-	31, // 92: protorender.Coordinator.GetRateLimit:output_type -> protorender.RateLimitResponse
-	31, // 93: protorender.Coordinator.CreateRateLimit:output_type -> protorender.RateLimitResponse
-	31, // 94: protorender.Coordinator.UpdateRateLimit:output_type -> protorender.RateLimitResponse
-	41, // 95: protorender.Coordinator.DeleteRateLimit:output_type -> protorender.CommonResponse
-	51, // 96: protorender.Coordinator.CheckRateLimit:output_type -> protorender.RateLimitCheckResponse
-	26, // 97: protorender.Coordinator.ListBuffers:output_type -> protorender.ListBuffersResponse
-	25, // 98: protorender.Coordinator.GetBuffer:output_type -> protorender.BufferResponse
-	25, // 99: protorender.Coordinator.CreateBuffer:output_type -> protorender.BufferResponse
-	25, // 100: protorender.Coordinator.UpdateBuffer:output_type -> protorender.BufferResponse
-	41, // 101: protorender.Coordinator.DeleteBuffer:output_type -> protorender.CommonResponse
-	27, // 102: protorender.Coordinator.ListFiles:output_type -> protorender.ListFilesResponse
-	29, // 103: protorender.Coordinator.GetFile:output_type -> protorender.FileResponse
-	29, // 104: protorender.Coordinator.CreateFile:output_type -> protorender.FileResponse
-	29, // 105: protorender.Coordinator.UpdateFile:output_type -> protorender.FileResponse
-	41, // 106: protorender.Coordinator.DeleteFile:output_type -> protorender.CommonResponse
-	13, // 107: protorender.Coordinator.ListEvents:output_type -> protorender.ListEventsResponse
-	49, // 108: protorender.Coordinator.IngestEvents:output_type -> google.protobuf.Empty
-	49, // 109: protorender.Coordinator.IngestMetrics:output_type -> google.protobuf.Empty
-	16, // 110: protorender.Coordinator.GetAnalytics:output_type -> protorender.GetAnalyticsResponse
-	72, // [72:111] is the sub-list for method output_type
-	33, // [33:72] is the sub-list for method input_type
-	33, // [33:33] is the sub-list for extension type_name
-	33, // [33:33] is the sub-list for extension extendee
-	0,  // [0:33] is the sub-list for field type_name
+	90,  // 0: protorender.WorkerFlowStatusRequest.status:type_name -> protorender.WorkerFlowStatus
+	76,  // 1: protorender.ListWorkersResponse.data:type_name -> protorender.ListWorkersResponse.Worker
+	91,  // 2: protorender.ListFlowsResponse.data:type_name -> protorender.Flow
+	91,  // 3: protorender.FlowResponse.data:type_name -> protorender.Flow
+	92,  // 4: protorender.FlowResponse.meta:type_name -> protorender.CommonResponse
+	93,  // 5: protorender.Event.meta:type_name -> google.protobuf.Struct
+	94,  // 6: protorender.Event.created_at:type_name -> google.protobuf.Timestamp
+	11,  // 7: protorender.EventBatch.events:type_name -> protorender.Event
+	77,  // 8: protorender.EventBatch.dropped:type_name -> protorender.EventBatch.DroppedEntry
+	94,  // 9: protorender.ListEventsRequest.start_time:type_name -> google.protobuf.Timestamp
+	94,  // 10: protorender.ListEventsRequest.end_time:type_name -> google.protobuf.Timestamp
+	11,  // 11: protorender.ListEventsResponse.data:type_name -> protorender.Event
+	11,  // 12: protorender.TailEventsResponse.event:type_name -> protorender.Event
+	93,  // 13: protorender.FlowLog.fields:type_name -> google.protobuf.Struct
+	94,  // 14: protorender.FlowLog.created_at:type_name -> google.protobuf.Timestamp
+	18,  // 15: protorender.IngestLogsRequest.logs:type_name -> protorender.FlowLog
+	78,  // 16: protorender.IngestLogsRequest.dropped:type_name -> protorender.IngestLogsRequest.DroppedEntry
+	94,  // 17: protorender.ListFlowLogsRequest.start_time:type_name -> google.protobuf.Timestamp
+	94,  // 18: protorender.ListFlowLogsRequest.end_time:type_name -> google.protobuf.Timestamp
+	18,  // 19: protorender.ListFlowLogsResponse.data:type_name -> protorender.FlowLog
+	18,  // 20: protorender.TailFlowLogsResponse.log:type_name -> protorender.FlowLog
+	94,  // 21: protorender.TraceComponent.started_at:type_name -> google.protobuf.Timestamp
+	94,  // 22: protorender.TraceComponent.ended_at:type_name -> google.protobuf.Timestamp
+	11,  // 23: protorender.TraceComponent.events:type_name -> protorender.Event
+	94,  // 24: protorender.GetTraceResponse.started_at:type_name -> google.protobuf.Timestamp
+	94,  // 25: protorender.GetTraceResponse.ended_at:type_name -> google.protobuf.Timestamp
+	25,  // 26: protorender.GetTraceResponse.timeline:type_name -> protorender.TraceComponent
+	79,  // 27: protorender.MetricsRequest.input_events_by_component:type_name -> protorender.MetricsRequest.InputEventsByComponentEntry
+	80,  // 28: protorender.MetricsRequest.processor_events_by_component:type_name -> protorender.MetricsRequest.ProcessorEventsByComponentEntry
+	81,  // 29: protorender.MetricsRequest.output_events_by_component:type_name -> protorender.MetricsRequest.OutputEventsByComponentEntry
+	82,  // 30: protorender.MetricsRequest.errors_by_component:type_name -> protorender.MetricsRequest.ErrorsByComponentEntry
+	84,  // 31: protorender.GetFlowMetricsResponse.components:type_name -> protorender.GetFlowMetricsResponse.Component
+	85,  // 32: protorender.GetAnalyticsResponse.flows_by_status:type_name -> protorender.GetAnalyticsResponse.FlowStatusCount
+	87,  // 33: protorender.GetAnalyticsResponse.events_over_time:type_name -> protorender.GetAnalyticsResponse.TimeSeriesPoint
+	86,  // 34: protorender.GetAnalyticsResponse.top_input_components:type_name -> protorender.GetAnalyticsResponse.ComponentCount
+	86,  // 35: protorender.GetAnalyticsResponse.top_output_components:type_name -> protorender.GetAnalyticsResponse.ComponentCount
+	88,  // 36: protorender.GetAnalyticsResponse.tool_calls:type_name -> protorender.GetAnalyticsResponse.ToolCallStats
+	95,  // 37: protorender.ListSecretsResponse.data:type_name -> protorender.Secret
+	95,  // 38: protorender.SecretResponse.data:type_name -> protorender.Secret
+	92,  // 39: protorender.SecretResponse.meta:type_name -> protorender.CommonResponse
+	96,  // 40: protorender.ListCachesResponse.data:type_name -> protorender.Cache
+	96,  // 41: protorender.CacheResponse.data:type_name -> protorender.Cache
+	92,  // 42: protorender.CacheResponse.meta:type_name -> protorender.CommonResponse
+	97,  // 43: protorender.ListRateLimitsResponse.data:type_name -> protorender.RateLimit
+	98,  // 44: protorender.BufferResponse.data:type_name -> protorender.Buffer
+	92,  // 45: protorender.BufferResponse.meta:type_name -> protorender.CommonResponse
+	98,  // 46: protorender.ListBuffersResponse.data:type_name -> protorender.Buffer
+	99,  // 47: protorender.ListFilesResponse.data:type_name -> protorender.File
+	99,  // 48: protorender.FileResponse.data:type_name -> protorender.File
+	92,  // 49: protorender.FileResponse.meta:type_name -> protorender.CommonResponse
+	97,  // 50: protorender.RateLimitResponse.data:type_name -> protorender.RateLimit
+	92,  // 51: protorender.RateLimitResponse.meta:type_name -> protorender.CommonResponse
+	100, // 52: protorender.ListMcpServersResponse.data:type_name -> protorender.McpServer
+	100, // 53: protorender.McpServerResponse.data:type_name -> protorender.McpServer
+	92,  // 54: protorender.McpServerResponse.meta:type_name -> protorender.CommonResponse
+	94,  // 55: protorender.McpToolCall.created_at:type_name -> google.protobuf.Timestamp
+	94,  // 56: protorender.ListToolCallsRequest.start_time:type_name -> google.protobuf.Timestamp
+	94,  // 57: protorender.ListToolCallsRequest.end_time:type_name -> google.protobuf.Timestamp
+	51,  // 58: protorender.ListToolCallsResponse.data:type_name -> protorender.McpToolCall
+	94,  // 59: protorender.RateLimitKey.last_refill_at:type_name -> google.protobuf.Timestamp
+	94,  // 60: protorender.RateLimitKey.updated_at:type_name -> google.protobuf.Timestamp
+	54,  // 61: protorender.ListRateLimitKeysResponse.data:type_name -> protorender.RateLimitKey
+	89,  // 62: protorender.GetRateLimitStatsResponse.data:type_name -> protorender.GetRateLimitStatsResponse.Point
+	94,  // 63: protorender.QueuedRequest.created_at:type_name -> google.protobuf.Timestamp
+	94,  // 64: protorender.QueuedRequest.updated_at:type_name -> google.protobuf.Timestamp
+	60,  // 65: protorender.ListQueuedRequestsResponse.data:type_name -> protorender.QueuedRequest
+	101, // 66: protorender.AlertRuleResponse.data:type_name -> protorender.AlertRule
+	92,  // 67: protorender.AlertRuleResponse.meta:type_name -> protorender.CommonResponse
+	101, // 68: protorender.ListAlertRulesResponse.data:type_name -> protorender.AlertRule
+	102, // 69: protorender.AlertChannelResponse.data:type_name -> protorender.AlertChannel
+	92,  // 70: protorender.AlertChannelResponse.meta:type_name -> protorender.CommonResponse
+	102, // 71: protorender.ListAlertChannelsResponse.data:type_name -> protorender.AlertChannel
+	103, // 72: protorender.AlertSilenceResponse.data:type_name -> protorender.AlertSilence
+	92,  // 73: protorender.AlertSilenceResponse.meta:type_name -> protorender.CommonResponse
+	103, // 74: protorender.ListAlertSilencesResponse.data:type_name -> protorender.AlertSilence
+	94,  // 75: protorender.ListAlertsRequest.start_time:type_name -> google.protobuf.Timestamp
+	94,  // 76: protorender.ListAlertsRequest.end_time:type_name -> google.protobuf.Timestamp
+	104, // 77: protorender.ListAlertsResponse.data:type_name -> protorender.Alert
+	94,  // 78: protorender.ListWorkersResponse.Worker.last_heartbeat:type_name -> google.protobuf.Timestamp
+	83,  // 79: protorender.GetFlowMetricsResponse.Component.data:type_name -> protorender.GetFlowMetricsResponse.Point
+	4,   // 80: protorender.Coordinator.UpdateWorkerFlowStatus:input_type -> protorender.WorkerFlowStatusRequest
+	0,   // 81: protorender.Coordinator.RegisterWorker:input_type -> protorender.RegisterWorkerRequest
+	1,   // 82: protorender.Coordinator.DeregisterWorker:input_type -> protorender.DeregisterWorkerRequest
+	2,   // 83: protorender.Coordinator.Heartbeat:input_type -> protorender.HeartbeatRequest
+	5,   // 84: protorender.Coordinator.ListWorkers:input_type -> protorender.ListWorkersRequest
+	7,   // 85: protorender.Coordinator.ListFlows:input_type -> protorender.ListFlowsRequest
+	9,   // 86: protorender.Coordinator.GetFlow:input_type -> protorender.GetFlowRequest
+	91,  // 87: protorender.Coordinator.CreateFlow:input_type -> protorender.Flow
+	91,  // 88: protorender.Coordinator.UpdateFlow:input_type -> protorender.Flow
+	105, // 89: protorender.Coordinator.ListSecrets:input_type -> google.protobuf.Empty
+	32,  // 90: protorender.Coordinator.CreateSecret:input_type -> protorender.SecretRequest
+	32,  // 91: protorender.Coordinator.UpdateSecret:input_type -> protorender.SecretRequest
+	32,  // 92: protorender.Coordinator.GetSecret:input_type -> protorender.SecretRequest
+	32,  // 93: protorender.Coordinator.DeleteSecret:input_type -> protorender.SecretRequest
+	105, // 94: protorender.Coordinator.ListCaches:input_type -> google.protobuf.Empty
+	36,  // 95: protorender.Coordinator.GetCache:input_type -> protorender.GetCacheRequest
+	96,  // 96: protorender.Coordinator.CreateCache:input_type -> protorender.Cache
+	96,  // 97: protorender.Coordinator.UpdateCache:input_type -> protorender.Cache
+	36,  // 98: protorender.Coordinator.DeleteCache:input_type -> protorender.GetCacheRequest
+	105, // 99: protorender.Coordinator.ListRateLimits:input_type -> google.protobuf.Empty
+	45,  // 100: protorender.Coordinator.GetRateLimit:input_type -> protorender.GetRateLimitRequest
+	97,  // 101: protorender.Coordinator.CreateRateLimit:input_type -> protorender.RateLimit
+	97,  // 102: protorender.Coordinator.UpdateRateLimit:input_type -> protorender.RateLimit
+	45,  // 103: protorender.Coordinator.DeleteRateLimit:input_type -> protorender.GetRateLimitRequest
+	106, // 104: protorender.Coordinator.CheckRateLimit:input_type -> protorender.RateLimitCheckRequest
+	107, // 105: protorender.Coordinator.ReleaseRateLimit:input_type -> protorender.RateLimitReleaseRequest
+	55,  // 106: protorender.Coordinator.ListRateLimitKeys:input_type -> protorender.ListRateLimitKeysRequest
+	57,  // 107: protorender.Coordinator.ResetRateLimit:input_type -> protorender.ResetRateLimitRequest
+	58,  // 108: protorender.Coordinator.GetRateLimitStats:input_type -> protorender.GetRateLimitStatsRequest
+	105, // 109: protorender.Coordinator.ListBuffers:input_type -> google.protobuf.Empty
+	39,  // 110: protorender.Coordinator.GetBuffer:input_type -> protorender.GetBufferRequest
+	98,  // 111: protorender.Coordinator.CreateBuffer:input_type -> protorender.Buffer
+	98,  // 112: protorender.Coordinator.UpdateBuffer:input_type -> protorender.Buffer
+	39,  // 113: protorender.Coordinator.DeleteBuffer:input_type -> protorender.GetBufferRequest
+	105, // 114: protorender.Coordinator.ListFiles:input_type -> google.protobuf.Empty
+	43,  // 115: protorender.Coordinator.GetFile:input_type -> protorender.GetFileRequest
+	99,  // 116: protorender.Coordinator.CreateFile:input_type -> protorender.File
+	99,  // 117: protorender.Coordinator.UpdateFile:input_type -> protorender.File
+	43,  // 118: protorender.Coordinator.DeleteFile:input_type -> protorender.GetFileRequest
+	14,  // 119: protorender.Coordinator.ListEvents:input_type -> protorender.ListEventsRequest
+	16,  // 120: protorender.Coordinator.TailEvents:input_type -> protorender.TailEventsRequest
+	24,  // 121: protorender.Coordinator.GetTrace:input_type -> protorender.GetTraceRequest
+	12,  // 122: protorender.Coordinator.IngestEvents:input_type -> protorender.EventBatch
+	27,  // 123: protorender.Coordinator.IngestMetrics:input_type -> protorender.MetricsRequest
+	19,  // 124: protorender.Coordinator.IngestLogs:input_type -> protorender.IngestLogsRequest
+	20,  // 125: protorender.Coordinator.ListFlowLogs:input_type -> protorender.ListFlowLogsRequest
+	22,  // 126: protorender.Coordinator.TailFlowLogs:input_type -> protorender.TailFlowLogsRequest
+	28,  // 127: protorender.Coordinator.GetFlowMetrics:input_type -> protorender.GetFlowMetricsRequest
+	50,  // 128: protorender.Coordinator.ReportToolProgress:input_type -> protorender.ToolProgressRequest
+	52,  // 129: protorender.Coordinator.ListToolCalls:input_type -> protorender.ListToolCallsRequest
+	105, // 130: protorender.Coordinator.ListMcpServers:input_type -> google.protobuf.Empty
+	48,  // 131: protorender.Coordinator.GetMcpServer:input_type -> protorender.GetMcpServerRequest
+	100, // 132: protorender.Coordinator.CreateMcpServer:input_type -> protorender.McpServer
+	100, // 133: protorender.Coordinator.UpdateMcpServer:input_type -> protorender.McpServer
+	48,  // 134: protorender.Coordinator.DeleteMcpServer:input_type -> protorender.GetMcpServerRequest
+	61,  // 135: protorender.Coordinator.ListQueuedRequests:input_type -> protorender.ListQueuedRequestsRequest
+	63,  // 136: protorender.Coordinator.RetryQueuedRequest:input_type -> protorender.QueuedRequestIdRequest
+	63,  // 137: protorender.Coordinator.DeleteQueuedRequest:input_type -> protorender.QueuedRequestIdRequest
+	74,  // 138: protorender.Coordinator.ListAlerts:input_type -> protorender.ListAlertsRequest
+	105, // 139: protorender.Coordinator.ListAlertRules:input_type -> google.protobuf.Empty
+	64,  // 140: protorender.Coordinator.GetAlertRule:input_type -> protorender.GetAlertRuleRequest
+	101, // 141: protorender.Coordinator.CreateAlertRule:input_type -> protorender.AlertRule
+	101, // 142: protorender.Coordinator.UpdateAlertRule:input_type -> protorender.AlertRule
+	64,  // 143: protorender.Coordinator.DeleteAlertRule:input_type -> protorender.GetAlertRuleRequest
+	105, // 144: protorender.Coordinator.ListAlertChannels:input_type -> google.protobuf.Empty
+	67,  // 145: protorender.Coordinator.GetAlertChannel:input_type -> protorender.GetAlertChannelRequest
+	102, // 146: protorender.Coordinator.CreateAlertChannel:input_type -> protorender.AlertChannel
+	102, // 147: protorender.Coordinator.UpdateAlertChannel:input_type -> protorender.AlertChannel
+	67,  // 148: protorender.Coordinator.DeleteAlertChannel:input_type -> protorender.GetAlertChannelRequest
+	67,  // 149: protorender.Coordinator.TestAlertChannel:input_type -> protorender.GetAlertChannelRequest
+	72,  // 150: protorender.Coordinator.ListAlertSilences:input_type -> protorender.ListAlertSilencesRequest
+	103, // 151: protorender.Coordinator.CreateAlertSilence:input_type -> protorender.AlertSilence
+	70,  // 152: protorender.Coordinator.DeleteAlertSilence:input_type -> protorender.GetAlertSilenceRequest
+	30,  // 153: protorender.Coordinator.GetAnalytics:input_type -> protorender.GetAnalyticsRequest
+	92,  // 154: protorender.Coordinator.UpdateWorkerFlowStatus:output_type -> protorender.CommonResponse
+	92,  // 155: protorender.Coordinator.RegisterWorker:output_type -> protorender.CommonResponse
+	92,  // 156: protorender.Coordinator.DeregisterWorker:output_type -> protorender.CommonResponse
+	3,   // 157: protorender.Coordinator.Heartbeat:output_type -> protorender.HeartbeatResponse
+	6,   // 158: protorender.Coordinator.ListWorkers:output_type -> protorender.ListWorkersResponse
+	8,   // 159: protorender.Coordinator.ListFlows:output_type -> protorender.ListFlowsResponse
+	10,  // 160: protorender.Coordinator.GetFlow:output_type -> protorender.FlowResponse
+	10,  // 161: protorender.Coordinator.CreateFlow:output_type -> protorender.FlowResponse
+	10,  // 162: protorender.Coordinator.UpdateFlow:output_type -> protorender.FlowResponse
+	33,  // 163: protorender.Coordinator.ListSecrets:output_type -> protorender.ListSecretsResponse
+	92,  // 164: protorender.Coordinator.CreateSecret:output_type -> protorender.CommonResponse
+	92,  // 165: protorender.Coordinator.UpdateSecret:output_type -> protorender.CommonResponse
+	34,  // 166: protorender.Coordinator.GetSecret:output_type -> protorender.SecretResponse
+	92,  // 167: protorender.Coordinator.DeleteSecret:output_type -> protorender.CommonResponse
+	35,  // 168: protorender.Coordinator.ListCaches:output_type -> protorender.ListCachesResponse
+	37,  // 169: protorender.Coordinator.GetCache:output_type -> protorender.CacheResponse
+	37,  // 170: protorender.Coordinator.CreateCache:output_type -> protorender.CacheResponse
+	37,  // 171: protorender.Coordinator.UpdateCache:output_type -> protorender.CacheResponse
+	92,  // 172: protorender.Coordinator.DeleteCache:output_type -> protorender.CommonResponse
+	38,  // 173: protorender.Coordinator.ListRateLimits:output_type -> protorender.ListRateLimitsResponse
+	46,  // 174: protorender.Coordinator.GetRateLimit:output_type -> protorender.RateLimitResponse
+	46,  // 175: protorender.Coordinator.CreateRateLimit:output_type -> protorender.RateLimitResponse
+	46,  // 176: protorender.Coordinator.UpdateRateLimit:output_type -> protorender.RateLimitResponse
+	92,  // 177: protorender.Coordinator.DeleteRateLimit:output_type -> protorender.CommonResponse
+	108, // 178: protorender.Coordinator.CheckRateLimit:output_type -> protorender.RateLimitCheckResponse
+	92,  // 179: protorender.Coordinator.ReleaseRateLimit:output_type -> protorender.CommonResponse
+	56,  // 180: protorender.Coordinator.ListRateLimitKeys:output_type -> protorender.ListRateLimitKeysResponse
+	92,  // 181: protorender.Coordinator.ResetRateLimit:output_type -> protorender.CommonResponse
+	59,  // 182: protorender.Coordinator.GetRateLimitStats:output_type -> protorender.GetRateLimitStatsResponse
+	41,  // 183: protorender.Coordinator.ListBuffers:output_type -> protorender.ListBuffersResponse
+	40,  // 184: protorender.Coordinator.GetBuffer:output_type -> protorender.BufferResponse
+	40,  // 185: protorender.Coordinator.CreateBuffer:output_type -> protorender.BufferResponse
+	40,  // 186: protorender.Coordinator.UpdateBuffer:output_type -> protorender.BufferResponse
+	92,  // 187: protorender.Coordinator.DeleteBuffer:output_type -> protorender.CommonResponse
+	42,  // 188: protorender.Coordinator.ListFiles:output_type -> protorender.ListFilesResponse
+	44,  // 189: protorender.Coordinator.GetFile:output_type -> protorender.FileResponse
+	44,  // 190: protorender.Coordinator.CreateFile:output_type -> protorender.FileResponse
+	44,  // 191: protorender.Coordinator.UpdateFile:output_type -> protorender.FileResponse
+	92,  // 192: protorender.Coordinator.DeleteFile:output_type -> protorender.CommonResponse
+	15,  // 193: protorender.Coordinator.ListEvents:output_type -> protorender.ListEventsResponse
+	17,  // 194: protorender.Coordinator.TailEvents:output_type -> protorender.TailEventsResponse
+	26,  // 195: protorender.Coordinator.GetTrace:output_type -> protorender.GetTraceResponse
+	13,  // 196: protorender.Coordinator.IngestEvents:output_type -> protorender.EventAck
+	105, // 197: protorender.Coordinator.IngestMetrics:output_type -> google.protobuf.Empty
+	105, // 198: protorender.Coordinator.IngestLogs:output_type -> google.protobuf.Empty
+	21,  // 199: protorender.Coordinator.ListFlowLogs:output_type -> protorender.ListFlowLogsResponse
+	23,  // 200: protorender.Coordinator.TailFlowLogs:output_type -> protorender.TailFlowLogsResponse
+	29,  // 201: protorender.Coordinator.GetFlowMetrics:output_type -> protorender.GetFlowMetricsResponse
+	92,  // 202: protorender.Coordinator.ReportToolProgress:output_type -> protorender.CommonResponse
+	53,  // 203: protorender.Coordinator.ListToolCalls:output_type -> protorender.ListToolCallsResponse
+	47,  // 204: protorender.Coordinator.ListMcpServers:output_type -> protorender.ListMcpServersResponse
+	49,  // 205: protorender.Coordinator.GetMcpServer:output_type -> protorender.McpServerResponse
+	49,  // 206: protorender.Coordinator.CreateMcpServer:output_type -> protorender.McpServerResponse
+	49,  // 207: protorender.Coordinator.UpdateMcpServer:output_type -> protorender.McpServerResponse
+	92,  // 208: protorender.Coordinator.DeleteMcpServer:output_type -> protorender.CommonResponse
+	62,  // 209: protorender.Coordinator.ListQueuedRequests:output_type -> protorender.ListQueuedRequestsResponse
+	92,  // 210: protorender.Coordinator.RetryQueuedRequest:output_type -> protorender.CommonResponse
+	92,  // 211: protorender.Coordinator.DeleteQueuedRequest:output_type -> protorender.CommonResponse
+	75,  // 212: protorender.Coordinator.ListAlerts:output_type -> protorender.ListAlertsResponse
+	66,  // 213: protorender.Coordinator.ListAlertRules:output_type -> protorender.ListAlertRulesResponse
+	65,  // 214: protorender.Coordinator.GetAlertRule:output_type -> protorender.AlertRuleResponse
+	65,  // 215: protorender.Coordinator.CreateAlertRule:output_type -> protorender.AlertRuleResponse
+	65,  // 216: protorender.Coordinator.UpdateAlertRule:output_type -> protorender.AlertRuleResponse
+	92,  // 217: protorender.Coordinator.DeleteAlertRule:output_type -> protorender.CommonResponse
+	69,  // 218: protorender.Coordinator.ListAlertChannels:output_type -> protorender.ListAlertChannelsResponse
+	68,  // 219: protorender.Coordinator.GetAlertChannel:output_type -> protorender.AlertChannelResponse
+	68,  // 220: protorender.Coordinator.CreateAlertChannel:output_type -> protorender.AlertChannelResponse
+	68,  // 221: protorender.Coordinator.UpdateAlertChannel:output_type -> protorender.AlertChannelResponse
+	92,  // 222: protorender.Coordinator.DeleteAlertChannel:output_type -> protorender.CommonResponse
+	92,  // 223: protorender.Coordinator.TestAlertChannel:output_type -> protorender.CommonResponse
+	73,  // 224: protorender.Coordinator.ListAlertSilences:output_type -> protorender.ListAlertSilencesResponse
+	71,  // 225: protorender.Coordinator.CreateAlertSilence:output_type -> protorender.AlertSilenceResponse
+	92,  // 226: protorender.Coordinator.DeleteAlertSilence:output_type -> protorender.CommonResponse
+	31,  // 227: protorender.Coordinator.GetAnalytics:output_type -> protorender.GetAnalyticsResponse
+	154, // [154:228] is the sub-list for method output_type
+	80,  // [80:154] is the sub-list for method input_type
+	80,  // [80:80] is the sub-list for extension type_name
+	80,  // [80:80] is the sub-list for extension extendee
+	0,   // [0:80] is the sub-list for field type_name
 }
 
 func init() { file_coordinator_proto_init() }
@@ -2382,13 +6082,16 @@ func file_coordinator_proto_init() {
 		return
 	}
 	file_common_proto_init()
+	file_coordinator_proto_msgTypes[50].OneofWrappers = []any{}
+	file_coordinator_proto_msgTypes[51].OneofWrappers = []any{}
+	file_coordinator_proto_msgTypes[60].OneofWrappers = []any{}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_coordinator_proto_rawDesc), len(file_coordinator_proto_rawDesc)),
 			NumEnums:      0,
-			NumMessages:   39,
+			NumMessages:   90,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
@@ -1439,6 +1439,8 @@ func (m *Event) validate(all bool) error {
 		}
 	}
 
+	// no validation rules for Key
+
 	if len(errors) > 0 {
 		return EventMultiError(errors)
 	}
@@ -1524,6 +1526,244 @@ var _Event_Type_InLookup = map[string]struct{}{
 	"UNKNOWN": {},
 }
 
+// Validate checks the field values on EventBatch with the rules defined in the
+// proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *EventBatch) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on EventBatch with the rules defined in
+// the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in EventBatchMultiError, or
+// nil if none found.
+func (m *EventBatch) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *EventBatch) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Sequence
+
+	for idx, item := range m.GetEvents() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, EventBatchValidationError{
+						field:  fmt.Sprintf("Events[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, EventBatchValidationError{
+						field:  fmt.Sprintf("Events[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return EventBatchValidationError{
+					field:  fmt.Sprintf("Events[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	// no validation rules for Dropped
+
+	if len(errors) > 0 {
+		return EventBatchMultiError(errors)
+	}
+
+	return nil
+}
+
+// EventBatchMultiError is an error wrapping multiple validation errors
+// returned by EventBatch.ValidateAll() if the designated constraints aren't met.
+type EventBatchMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m EventBatchMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m EventBatchMultiError) AllErrors() []error { return m }
+
+// EventBatchValidationError is the validation error returned by
+// EventBatch.Validate if the designated constraints aren't met.
+type EventBatchValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e EventBatchValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e EventBatchValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e EventBatchValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e EventBatchValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e EventBatchValidationError) ErrorName() string { return "EventBatchValidationError" }
+
+// Error satisfies the builtin error interface
+func (e EventBatchValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sEventBatch.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = EventBatchValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = EventBatchValidationError{}
+
+// Validate checks the field values on EventAck with the rules defined in the
+// proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *EventAck) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on EventAck with the rules defined in
+// the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in EventAckMultiError, or nil
+// if none found.
+func (m *EventAck) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *EventAck) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Sequence
+
+	if len(errors) > 0 {
+		return EventAckMultiError(errors)
+	}
+
+	return nil
+}
+
+// EventAckMultiError is an error wrapping multiple validation errors returned
+// by EventAck.ValidateAll() if the designated constraints aren't met.
+type EventAckMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m EventAckMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m EventAckMultiError) AllErrors() []error { return m }
+
+// EventAckValidationError is the validation error returned by
+// EventAck.Validate if the designated constraints aren't met.
+type EventAckValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e EventAckValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e EventAckValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e EventAckValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e EventAckValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e EventAckValidationError) ErrorName() string { return "EventAckValidationError" }
+
+// Error satisfies the builtin error interface
+func (e EventAckValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sEventAck.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = EventAckValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = EventAckValidationError{}
+
 // Validate checks the field values on ListEventsRequest with the rules defined
 // in the proto definition for this message. If any rules are violated, the
 // first error encountered is returned, or nil if there are no violations.
@@ -1619,6 +1859,65 @@ func (m *ListEventsRequest) validate(all bool) error {
 		}
 	}
 
+	if _, ok := _ListEventsRequest_Section_InLookup[m.GetSection()]; !ok {
+		err := ListEventsRequestValidationError{
+			field:  "Section",
+			reason: "value must be in list [ input pipeline output]",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	// no validation rules for ComponentLabel
+
+	if _, ok := _ListEventsRequest_Type_InLookup[m.GetType()]; !ok {
+		err := ListEventsRequestValidationError{
+			field:  "Type",
+			reason: "value must be in list [ PRODUCE CONSUME DELETE ERROR UNKNOWN]",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	// no validation rules for TraceId
+
+	if m.GetWorkerFlowId() < 0 {
+		err := ListEventsRequestValidationError{
+			field:  "WorkerFlowId",
+			reason: "value must be greater than or equal to 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if m.GetFlowVersionId() < 0 {
+		err := ListEventsRequestValidationError{
+			field:  "FlowVersionId",
+			reason: "value must be greater than or equal to 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if utf8.RuneCountInString(m.GetSearch()) > 256 {
+		err := ListEventsRequestValidationError{
+			field:  "Search",
+			reason: "value length must be at most 256 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
 	if len(errors) > 0 {
 		return ListEventsRequestMultiError(errors)
 	}
@@ -1699,6 +1998,22 @@ var _ interface {
 	ErrorName() string
 } = ListEventsRequestValidationError{}
 
+var _ListEventsRequest_Section_InLookup = map[string]struct{}{
+	"":         {},
+	"input":    {},
+	"pipeline": {},
+	"output":   {},
+}
+
+var _ListEventsRequest_Type_InLookup = map[string]struct{}{
+	"":        {},
+	"PRODUCE": {},
+	"CONSUME": {},
+	"DELETE":  {},
+	"ERROR":   {},
+	"UNKNOWN": {},
+}
+
 // Validate checks the field values on ListEventsResponse with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
@@ -1837,31 +2152,31 @@ var _ interface {
 	ErrorName() string
 } = ListEventsResponseValidationError{}
 
-// Validate checks the field values on MetricsRequest with the rules defined in
-// the proto definition for this message. If any rules are violated, the first
-// error encountered is returned, or nil if there are no violations.
-func (m *MetricsRequest) Validate() error {
+// Validate checks the field values on TailEventsRequest with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *TailEventsRequest) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on MetricsRequest with the rules defined
-// in the proto definition for this message. If any rules are violated, the
-// result is a list of violation errors wrapped in MetricsRequestMultiError,
-// or nil if none found.
-func (m *MetricsRequest) ValidateAll() error {
+// ValidateAll checks the field values on TailEventsRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// TailEventsRequestMultiError, or nil if none found.
+func (m *TailEventsRequest) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *MetricsRequest) validate(all bool) error {
+func (m *TailEventsRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	if m.GetWorkerFlowId() <= 0 {
-		err := MetricsRequestValidationError{
-			field:  "WorkerFlowId",
+	if m.GetFlowId() <= 0 {
+		err := TailEventsRequestValidationError{
+			field:  "FlowId",
 			reason: "value must be greater than 0",
 		}
 		if !all {
@@ -1870,32 +2185,6197 @@ func (m *MetricsRequest) validate(all bool) error {
 		errors = append(errors, err)
 	}
 
-	// no validation rules for InputEvents
-
-	// no validation rules for ProcessorErrors
+	if _, ok := _TailEventsRequest_Section_InLookup[m.GetSection()]; !ok {
+		err := TailEventsRequestValidationError{
+			field:  "Section",
+			reason: "value must be in list [ input pipeline output]",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	// no validation rules for ComponentLabel
+
+	if _, ok := _TailEventsRequest_Type_InLookup[m.GetType()]; !ok {
+		err := TailEventsRequestValidationError{
+			field:  "Type",
+			reason: "value must be in list [ PRODUCE CONSUME DELETE ERROR UNKNOWN]",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	// no validation rules for TraceId
+
+	if m.GetWorkerFlowId() < 0 {
+		err := TailEventsRequestValidationError{
+			field:  "WorkerFlowId",
+			reason: "value must be greater than or equal to 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if m.GetFlowVersionId() < 0 {
+		err := TailEventsRequestValidationError{
+			field:  "FlowVersionId",
+			reason: "value must be greater than or equal to 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if utf8.RuneCountInString(m.GetSearch()) > 256 {
+		err := TailEventsRequestValidationError{
+			field:  "Search",
+			reason: "value length must be at most 256 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return TailEventsRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// TailEventsRequestMultiError is an error wrapping multiple validation errors
+// returned by TailEventsRequest.ValidateAll() if the designated constraints
+// aren't met.
+type TailEventsRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m TailEventsRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m TailEventsRequestMultiError) AllErrors() []error { return m }
+
+// TailEventsRequestValidationError is the validation error returned by
+// TailEventsRequest.Validate if the designated constraints aren't met.
+type TailEventsRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e TailEventsRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e TailEventsRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e TailEventsRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e TailEventsRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e TailEventsRequestValidationError) ErrorName() string {
+	return "TailEventsRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e TailEventsRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sTailEventsRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = TailEventsRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = TailEventsRequestValidationError{}
+
+var _TailEventsRequest_Section_InLookup = map[string]struct{}{
+	"":         {},
+	"input":    {},
+	"pipeline": {},
+	"output":   {},
+}
+
+var _TailEventsRequest_Type_InLookup = map[string]struct{}{
+	"":        {},
+	"PRODUCE": {},
+	"CONSUME": {},
+	"DELETE":  {},
+	"ERROR":   {},
+	"UNKNOWN": {},
+}
+
+// Validate checks the field values on TailEventsResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *TailEventsResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on TailEventsResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// TailEventsResponseMultiError, or nil if none found.
+func (m *TailEventsResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *TailEventsResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetEvent()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, TailEventsResponseValidationError{
+					field:  "Event",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, TailEventsResponseValidationError{
+					field:  "Event",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetEvent()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return TailEventsResponseValidationError{
+				field:  "Event",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for Dropped
+
+	if len(errors) > 0 {
+		return TailEventsResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// TailEventsResponseMultiError is an error wrapping multiple validation errors
+// returned by TailEventsResponse.ValidateAll() if the designated constraints
+// aren't met.
+type TailEventsResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m TailEventsResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m TailEventsResponseMultiError) AllErrors() []error { return m }
+
+// TailEventsResponseValidationError is the validation error returned by
+// TailEventsResponse.Validate if the designated constraints aren't met.
+type TailEventsResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e TailEventsResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e TailEventsResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e TailEventsResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e TailEventsResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e TailEventsResponseValidationError) ErrorName() string {
+	return "TailEventsResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e TailEventsResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sTailEventsResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = TailEventsResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = TailEventsResponseValidationError{}
+
+// Validate checks the field values on FlowLog with the rules defined in the
+// proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *FlowLog) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on FlowLog with the rules defined in the
+// proto definition for this message. If any rules are violated, the result is
+// a list of violation errors wrapped in FlowLogMultiError, or nil if none found.
+func (m *FlowLog) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *FlowLog) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Id
+
+	// no validation rules for WorkerFlowId
+
+	// no validation rules for WorkerId
+
+	// no validation rules for Level
+
+	// no validation rules for Message
+
+	if all {
+		switch v := interface{}(m.GetFields()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, FlowLogValidationError{
+					field:  "Fields",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, FlowLogValidationError{
+					field:  "Fields",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetFields()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return FlowLogValidationError{
+				field:  "Fields",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetCreatedAt()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, FlowLogValidationError{
+					field:  "CreatedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, FlowLogValidationError{
+					field:  "CreatedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetCreatedAt()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return FlowLogValidationError{
+				field:  "CreatedAt",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return FlowLogMultiError(errors)
+	}
+
+	return nil
+}
+
+// FlowLogMultiError is an error wrapping multiple validation errors returned
+// by FlowLog.ValidateAll() if the designated constraints aren't met.
+type FlowLogMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m FlowLogMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m FlowLogMultiError) AllErrors() []error { return m }
+
+// FlowLogValidationError is the validation error returned by FlowLog.Validate
+// if the designated constraints aren't met.
+type FlowLogValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e FlowLogValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e FlowLogValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e FlowLogValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e FlowLogValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e FlowLogValidationError) ErrorName() string { return "FlowLogValidationError" }
+
+// Error satisfies the builtin error interface
+func (e FlowLogValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sFlowLog.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = FlowLogValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = FlowLogValidationError{}
+
+// Validate checks the field values on IngestLogsRequest with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *IngestLogsRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on IngestLogsRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// IngestLogsRequestMultiError, or nil if none found.
+func (m *IngestLogsRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *IngestLogsRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetLogs() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, IngestLogsRequestValidationError{
+						field:  fmt.Sprintf("Logs[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, IngestLogsRequestValidationError{
+						field:  fmt.Sprintf("Logs[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return IngestLogsRequestValidationError{
+					field:  fmt.Sprintf("Logs[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	// no validation rules for Dropped
+
+	if len(errors) > 0 {
+		return IngestLogsRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// IngestLogsRequestMultiError is an error wrapping multiple validation errors
+// returned by IngestLogsRequest.ValidateAll() if the designated constraints
+// aren't met.
+type IngestLogsRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m IngestLogsRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m IngestLogsRequestMultiError) AllErrors() []error { return m }
+
+// IngestLogsRequestValidationError is the validation error returned by
+// IngestLogsRequest.Validate if the designated constraints aren't met.
+type IngestLogsRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e IngestLogsRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e IngestLogsRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e IngestLogsRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e IngestLogsRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e IngestLogsRequestValidationError) ErrorName() string {
+	return "IngestLogsRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e IngestLogsRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sIngestLogsRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = IngestLogsRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = IngestLogsRequestValidationError{}
+
+// Validate checks the field values on ListFlowLogsRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ListFlowLogsRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListFlowLogsRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ListFlowLogsRequestMultiError, or nil if none found.
+func (m *ListFlowLogsRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListFlowLogsRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if m.GetFlowId() <= 0 {
+		err := ListFlowLogsRequestValidationError{
+			field:  "FlowId",
+			reason: "value must be greater than 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	// no validation rules for Limit
+
+	// no validation rules for Offset
+
+	if all {
+		switch v := interface{}(m.GetStartTime()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, ListFlowLogsRequestValidationError{
+					field:  "StartTime",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, ListFlowLogsRequestValidationError{
+					field:  "StartTime",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetStartTime()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return ListFlowLogsRequestValidationError{
+				field:  "StartTime",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetEndTime()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, ListFlowLogsRequestValidationError{
+					field:  "EndTime",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, ListFlowLogsRequestValidationError{
+					field:  "EndTime",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetEndTime()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return ListFlowLogsRequestValidationError{
+				field:  "EndTime",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if _, ok := _ListFlowLogsRequest_Level_InLookup[m.GetLevel()]; !ok {
+		err := ListFlowLogsRequestValidationError{
+			field:  "Level",
+			reason: "value must be in list [ trace debug info warn error fatal panic]",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	// no validation rules for WorkerId
+
+	if m.GetWorkerFlowId() < 0 {
+		err := ListFlowLogsRequestValidationError{
+			field:  "WorkerFlowId",
+			reason: "value must be greater than or equal to 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if m.GetFlowVersionId() < 0 {
+		err := ListFlowLogsRequestValidationError{
+			field:  "FlowVersionId",
+			reason: "value must be greater than or equal to 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if utf8.RuneCountInString(m.GetSearch()) > 256 {
+		err := ListFlowLogsRequestValidationError{
+			field:  "Search",
+			reason: "value length must be at most 256 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return ListFlowLogsRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListFlowLogsRequestMultiError is an error wrapping multiple validation
+// errors returned by ListFlowLogsRequest.ValidateAll() if the designated
+// constraints aren't met.
+type ListFlowLogsRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListFlowLogsRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListFlowLogsRequestMultiError) AllErrors() []error { return m }
+
+// ListFlowLogsRequestValidationError is the validation error returned by
+// ListFlowLogsRequest.Validate if the designated constraints aren't met.
+type ListFlowLogsRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListFlowLogsRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListFlowLogsRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListFlowLogsRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListFlowLogsRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListFlowLogsRequestValidationError) ErrorName() string {
+	return "ListFlowLogsRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListFlowLogsRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListFlowLogsRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListFlowLogsRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListFlowLogsRequestValidationError{}
+
+var _ListFlowLogsRequest_Level_InLookup = map[string]struct{}{
+	"":      {},
+	"trace": {},
+	"debug": {},
+	"info":  {},
+	"warn":  {},
+	"error": {},
+	"fatal": {},
+	"panic": {},
+}
+
+// Validate checks the field values on ListFlowLogsResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ListFlowLogsResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListFlowLogsResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ListFlowLogsResponseMultiError, or nil if none found.
+func (m *ListFlowLogsResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListFlowLogsResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetData() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ListFlowLogsResponseValidationError{
+						field:  fmt.Sprintf("Data[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ListFlowLogsResponseValidationError{
+						field:  fmt.Sprintf("Data[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ListFlowLogsResponseValidationError{
+					field:  fmt.Sprintf("Data[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	// no validation rules for Total
+
+	if len(errors) > 0 {
+		return ListFlowLogsResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListFlowLogsResponseMultiError is an error wrapping multiple validation
+// errors returned by ListFlowLogsResponse.ValidateAll() if the designated
+// constraints aren't met.
+type ListFlowLogsResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListFlowLogsResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListFlowLogsResponseMultiError) AllErrors() []error { return m }
+
+// ListFlowLogsResponseValidationError is the validation error returned by
+// ListFlowLogsResponse.Validate if the designated constraints aren't met.
+type ListFlowLogsResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListFlowLogsResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListFlowLogsResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListFlowLogsResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListFlowLogsResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListFlowLogsResponseValidationError) ErrorName() string {
+	return "ListFlowLogsResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListFlowLogsResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListFlowLogsResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListFlowLogsResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListFlowLogsResponseValidationError{}
+
+// Validate checks the field values on TailFlowLogsRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *TailFlowLogsRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on TailFlowLogsRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// TailFlowLogsRequestMultiError, or nil if none found.
+func (m *TailFlowLogsRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *TailFlowLogsRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if m.GetFlowId() <= 0 {
+		err := TailFlowLogsRequestValidationError{
+			field:  "FlowId",
+			reason: "value must be greater than 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if _, ok := _TailFlowLogsRequest_Level_InLookup[m.GetLevel()]; !ok {
+		err := TailFlowLogsRequestValidationError{
+			field:  "Level",
+			reason: "value must be in list [ trace debug info warn error fatal panic]",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	// no validation rules for WorkerId
+
+	if m.GetWorkerFlowId() < 0 {
+		err := TailFlowLogsRequestValidationError{
+			field:  "WorkerFlowId",
+			reason: "value must be greater than or equal to 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if m.GetFlowVersionId() < 0 {
+		err := TailFlowLogsRequestValidationError{
+			field:  "FlowVersionId",
+			reason: "value must be greater than or equal to 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if utf8.RuneCountInString(m.GetSearch()) > 256 {
+		err := TailFlowLogsRequestValidationError{
+			field:  "Search",
+			reason: "value length must be at most 256 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return TailFlowLogsRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// TailFlowLogsRequestMultiError is an error wrapping multiple validation
+// errors returned by TailFlowLogsRequest.ValidateAll() if the designated
+// constraints aren't met.
+type TailFlowLogsRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m TailFlowLogsRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m TailFlowLogsRequestMultiError) AllErrors() []error { return m }
+
+// TailFlowLogsRequestValidationError is the validation error returned by
+// TailFlowLogsRequest.Validate if the designated constraints aren't met.
+type TailFlowLogsRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e TailFlowLogsRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e TailFlowLogsRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e TailFlowLogsRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e TailFlowLogsRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e TailFlowLogsRequestValidationError) ErrorName() string {
+	return "TailFlowLogsRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e TailFlowLogsRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sTailFlowLogsRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = TailFlowLogsRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = TailFlowLogsRequestValidationError{}
+
+var _TailFlowLogsRequest_Level_InLookup = map[string]struct{}{
+	"":      {},
+	"trace": {},
+	"debug": {},
+	"info":  {},
+	"warn":  {},
+	"error": {},
+	"fatal": {},
+	"panic": {},
+}
+
+// Validate checks the field values on TailFlowLogsResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *TailFlowLogsResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on TailFlowLogsResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// TailFlowLogsResponseMultiError, or nil if none found.
+func (m *TailFlowLogsResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *TailFlowLogsResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetLog()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, TailFlowLogsResponseValidationError{
+					field:  "Log",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, TailFlowLogsResponseValidationError{
+					field:  "Log",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetLog()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return TailFlowLogsResponseValidationError{
+				field:  "Log",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for Dropped
+
+	if len(errors) > 0 {
+		return TailFlowLogsResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// TailFlowLogsResponseMultiError is an error wrapping multiple validation
+// errors returned by TailFlowLogsResponse.ValidateAll() if the designated
+// constraints aren't met.
+type TailFlowLogsResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m TailFlowLogsResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m TailFlowLogsResponseMultiError) AllErrors() []error { return m }
+
+// TailFlowLogsResponseValidationError is the validation error returned by
+// TailFlowLogsResponse.Validate if the designated constraints aren't met.
+type TailFlowLogsResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e TailFlowLogsResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e TailFlowLogsResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e TailFlowLogsResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e TailFlowLogsResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e TailFlowLogsResponseValidationError) ErrorName() string {
+	return "TailFlowLogsResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e TailFlowLogsResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sTailFlowLogsResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = TailFlowLogsResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = TailFlowLogsResponseValidationError{}
+
+// Validate checks the field values on GetTraceRequest with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *GetTraceRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetTraceRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetTraceRequestMultiError, or nil if none found.
+func (m *GetTraceRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetTraceRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if utf8.RuneCountInString(m.GetTraceId()) < 1 {
+		err := GetTraceRequestValidationError{
+			field:  "TraceId",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return GetTraceRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetTraceRequestMultiError is an error wrapping multiple validation errors
+// returned by GetTraceRequest.ValidateAll() if the designated constraints
+// aren't met.
+type GetTraceRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetTraceRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetTraceRequestMultiError) AllErrors() []error { return m }
+
+// GetTraceRequestValidationError is the validation error returned by
+// GetTraceRequest.Validate if the designated constraints aren't met.
+type GetTraceRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetTraceRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetTraceRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetTraceRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetTraceRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetTraceRequestValidationError) ErrorName() string { return "GetTraceRequestValidationError" }
+
+// Error satisfies the builtin error interface
+func (e GetTraceRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetTraceRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetTraceRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetTraceRequestValidationError{}
+
+// Validate checks the field values on TraceComponent with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *TraceComponent) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on TraceComponent with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in TraceComponentMultiError,
+// or nil if none found.
+func (m *TraceComponent) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *TraceComponent) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Section
+
+	// no validation rules for ComponentLabel
+
+	if all {
+		switch v := interface{}(m.GetStartedAt()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, TraceComponentValidationError{
+					field:  "StartedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, TraceComponentValidationError{
+					field:  "StartedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetStartedAt()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return TraceComponentValidationError{
+				field:  "StartedAt",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetEndedAt()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, TraceComponentValidationError{
+					field:  "EndedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, TraceComponentValidationError{
+					field:  "EndedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetEndedAt()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return TraceComponentValidationError{
+				field:  "EndedAt",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for Errors
+
+	for idx, item := range m.GetEvents() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, TraceComponentValidationError{
+						field:  fmt.Sprintf("Events[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, TraceComponentValidationError{
+						field:  fmt.Sprintf("Events[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return TraceComponentValidationError{
+					field:  fmt.Sprintf("Events[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return TraceComponentMultiError(errors)
+	}
+
+	return nil
+}
+
+// TraceComponentMultiError is an error wrapping multiple validation errors
+// returned by TraceComponent.ValidateAll() if the designated constraints
+// aren't met.
+type TraceComponentMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m TraceComponentMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m TraceComponentMultiError) AllErrors() []error { return m }
+
+// TraceComponentValidationError is the validation error returned by
+// TraceComponent.Validate if the designated constraints aren't met.
+type TraceComponentValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e TraceComponentValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e TraceComponentValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e TraceComponentValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e TraceComponentValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e TraceComponentValidationError) ErrorName() string { return "TraceComponentValidationError" }
+
+// Error satisfies the builtin error interface
+func (e TraceComponentValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sTraceComponent.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = TraceComponentValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = TraceComponentValidationError{}
+
+// Validate checks the field values on GetTraceResponse with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *GetTraceResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetTraceResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetTraceResponseMultiError, or nil if none found.
+func (m *GetTraceResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetTraceResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for TraceId
+
+	// no validation rules for FlowId
+
+	if all {
+		switch v := interface{}(m.GetStartedAt()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, GetTraceResponseValidationError{
+					field:  "StartedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, GetTraceResponseValidationError{
+					field:  "StartedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetStartedAt()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return GetTraceResponseValidationError{
+				field:  "StartedAt",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetEndedAt()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, GetTraceResponseValidationError{
+					field:  "EndedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, GetTraceResponseValidationError{
+					field:  "EndedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetEndedAt()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return GetTraceResponseValidationError{
+				field:  "EndedAt",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	for idx, item := range m.GetTimeline() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, GetTraceResponseValidationError{
+						field:  fmt.Sprintf("Timeline[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, GetTraceResponseValidationError{
+						field:  fmt.Sprintf("Timeline[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return GetTraceResponseValidationError{
+					field:  fmt.Sprintf("Timeline[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return GetTraceResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetTraceResponseMultiError is an error wrapping multiple validation errors
+// returned by GetTraceResponse.ValidateAll() if the designated constraints
+// aren't met.
+type GetTraceResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetTraceResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetTraceResponseMultiError) AllErrors() []error { return m }
+
+// GetTraceResponseValidationError is the validation error returned by
+// GetTraceResponse.Validate if the designated constraints aren't met.
+type GetTraceResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetTraceResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetTraceResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetTraceResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetTraceResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetTraceResponseValidationError) ErrorName() string { return "GetTraceResponseValidationError" }
+
+// Error satisfies the builtin error interface
+func (e GetTraceResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetTraceResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetTraceResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetTraceResponseValidationError{}
+
+// Validate checks the field values on MetricsRequest with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *MetricsRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on MetricsRequest with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in MetricsRequestMultiError,
+// or nil if none found.
+func (m *MetricsRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *MetricsRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if m.GetWorkerFlowId() <= 0 {
+		err := MetricsRequestValidationError{
+			field:  "WorkerFlowId",
+			reason: "value must be greater than 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	// no validation rules for InputEvents
+
+	// no validation rules for ProcessorErrors
+
+	// no validation rules for OutputEvents
+
+	// no validation rules for InputEventsByComponent
+
+	// no validation rules for ProcessorEventsByComponent
+
+	// no validation rules for OutputEventsByComponent
+
+	// no validation rules for ErrorsByComponent
+
+	if len(errors) > 0 {
+		return MetricsRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// MetricsRequestMultiError is an error wrapping multiple validation errors
+// returned by MetricsRequest.ValidateAll() if the designated constraints
+// aren't met.
+type MetricsRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m MetricsRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m MetricsRequestMultiError) AllErrors() []error { return m }
+
+// MetricsRequestValidationError is the validation error returned by
+// MetricsRequest.Validate if the designated constraints aren't met.
+type MetricsRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e MetricsRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e MetricsRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e MetricsRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e MetricsRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e MetricsRequestValidationError) ErrorName() string { return "MetricsRequestValidationError" }
+
+// Error satisfies the builtin error interface
+func (e MetricsRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sMetricsRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = MetricsRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = MetricsRequestValidationError{}
+
+// Validate checks the field values on GetFlowMetricsRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GetFlowMetricsRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetFlowMetricsRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetFlowMetricsRequestMultiError, or nil if none found.
+func (m *GetFlowMetricsRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetFlowMetricsRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if m.GetFlowId() <= 0 {
+		err := GetFlowMetricsRequestValidationError{
+			field:  "FlowId",
+			reason: "value must be greater than 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	// no validation rules for Range
+
+	// no validation rules for Step
+
+	if len(errors) > 0 {
+		return GetFlowMetricsRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetFlowMetricsRequestMultiError is an error wrapping multiple validation
+// errors returned by GetFlowMetricsRequest.ValidateAll() if the designated
+// constraints aren't met.
+type GetFlowMetricsRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetFlowMetricsRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetFlowMetricsRequestMultiError) AllErrors() []error { return m }
+
+// GetFlowMetricsRequestValidationError is the validation error returned by
+// GetFlowMetricsRequest.Validate if the designated constraints aren't met.
+type GetFlowMetricsRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetFlowMetricsRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetFlowMetricsRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetFlowMetricsRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetFlowMetricsRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetFlowMetricsRequestValidationError) ErrorName() string {
+	return "GetFlowMetricsRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetFlowMetricsRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetFlowMetricsRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetFlowMetricsRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetFlowMetricsRequestValidationError{}
+
+// Validate checks the field values on GetFlowMetricsResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GetFlowMetricsResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetFlowMetricsResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetFlowMetricsResponseMultiError, or nil if none found.
+func (m *GetFlowMetricsResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetFlowMetricsResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetComponents() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, GetFlowMetricsResponseValidationError{
+						field:  fmt.Sprintf("Components[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, GetFlowMetricsResponseValidationError{
+						field:  fmt.Sprintf("Components[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return GetFlowMetricsResponseValidationError{
+					field:  fmt.Sprintf("Components[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	// no validation rules for Step
+
+	if len(errors) > 0 {
+		return GetFlowMetricsResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetFlowMetricsResponseMultiError is an error wrapping multiple validation
+// errors returned by GetFlowMetricsResponse.ValidateAll() if the designated
+// constraints aren't met.
+type GetFlowMetricsResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetFlowMetricsResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetFlowMetricsResponseMultiError) AllErrors() []error { return m }
+
+// GetFlowMetricsResponseValidationError is the validation error returned by
+// GetFlowMetricsResponse.Validate if the designated constraints aren't met.
+type GetFlowMetricsResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetFlowMetricsResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetFlowMetricsResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetFlowMetricsResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetFlowMetricsResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetFlowMetricsResponseValidationError) ErrorName() string {
+	return "GetFlowMetricsResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetFlowMetricsResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetFlowMetricsResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetFlowMetricsResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetFlowMetricsResponseValidationError{}
+
+// Validate checks the field values on GetAnalyticsRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GetAnalyticsRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetAnalyticsRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetAnalyticsRequestMultiError, or nil if none found.
+func (m *GetAnalyticsRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetAnalyticsRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if _, ok := _GetAnalyticsRequest_Range_InLookup[m.GetRange()]; !ok {
+		err := GetAnalyticsRequestValidationError{
+			field:  "Range",
+			reason: "value must be in list [ 1h 24h 7d 30d]",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	// no validation rules for FlowId
+
+	if len(errors) > 0 {
+		return GetAnalyticsRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetAnalyticsRequestMultiError is an error wrapping multiple validation
+// errors returned by GetAnalyticsRequest.ValidateAll() if the designated
+// constraints aren't met.
+type GetAnalyticsRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetAnalyticsRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetAnalyticsRequestMultiError) AllErrors() []error { return m }
+
+// GetAnalyticsRequestValidationError is the validation error returned by
+// GetAnalyticsRequest.Validate if the designated constraints aren't met.
+type GetAnalyticsRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetAnalyticsRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetAnalyticsRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetAnalyticsRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetAnalyticsRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetAnalyticsRequestValidationError) ErrorName() string {
+	return "GetAnalyticsRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetAnalyticsRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetAnalyticsRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetAnalyticsRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetAnalyticsRequestValidationError{}
+
+var _GetAnalyticsRequest_Range_InLookup = map[string]struct{}{
+	"":    {},
+	"1h":  {},
+	"24h": {},
+	"7d":  {},
+	"30d": {},
+}
+
+// Validate checks the field values on GetAnalyticsResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GetAnalyticsResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetAnalyticsResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetAnalyticsResponseMultiError, or nil if none found.
+func (m *GetAnalyticsResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetAnalyticsResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for TotalFlows
+
+	for idx, item := range m.GetFlowsByStatus() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, GetAnalyticsResponseValidationError{
+						field:  fmt.Sprintf("FlowsByStatus[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, GetAnalyticsResponseValidationError{
+						field:  fmt.Sprintf("FlowsByStatus[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return GetAnalyticsResponseValidationError{
+					field:  fmt.Sprintf("FlowsByStatus[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	// no validation rules for TotalInputEvents
+
+	// no validation rules for TotalOutputEvents
+
+	// no validation rules for TotalProcessorErrors
+
+	// no validation rules for ActiveWorkers
+
+	// no validation rules for TotalEvents
+
+	// no validation rules for ErrorEvents
+
+	for idx, item := range m.GetEventsOverTime() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, GetAnalyticsResponseValidationError{
+						field:  fmt.Sprintf("EventsOverTime[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, GetAnalyticsResponseValidationError{
+						field:  fmt.Sprintf("EventsOverTime[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return GetAnalyticsResponseValidationError{
+					field:  fmt.Sprintf("EventsOverTime[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	for idx, item := range m.GetTopInputComponents() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, GetAnalyticsResponseValidationError{
+						field:  fmt.Sprintf("TopInputComponents[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, GetAnalyticsResponseValidationError{
+						field:  fmt.Sprintf("TopInputComponents[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return GetAnalyticsResponseValidationError{
+					field:  fmt.Sprintf("TopInputComponents[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	for idx, item := range m.GetTopOutputComponents() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, GetAnalyticsResponseValidationError{
+						field:  fmt.Sprintf("TopOutputComponents[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, GetAnalyticsResponseValidationError{
+						field:  fmt.Sprintf("TopOutputComponents[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return GetAnalyticsResponseValidationError{
+					field:  fmt.Sprintf("TopOutputComponents[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	for idx, item := range m.GetToolCalls() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, GetAnalyticsResponseValidationError{
+						field:  fmt.Sprintf("ToolCalls[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, GetAnalyticsResponseValidationError{
+						field:  fmt.Sprintf("ToolCalls[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return GetAnalyticsResponseValidationError{
+					field:  fmt.Sprintf("ToolCalls[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return GetAnalyticsResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetAnalyticsResponseMultiError is an error wrapping multiple validation
+// errors returned by GetAnalyticsResponse.ValidateAll() if the designated
+// constraints aren't met.
+type GetAnalyticsResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetAnalyticsResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetAnalyticsResponseMultiError) AllErrors() []error { return m }
+
+// GetAnalyticsResponseValidationError is the validation error returned by
+// GetAnalyticsResponse.Validate if the designated constraints aren't met.
+type GetAnalyticsResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetAnalyticsResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetAnalyticsResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetAnalyticsResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetAnalyticsResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetAnalyticsResponseValidationError) ErrorName() string {
+	return "GetAnalyticsResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetAnalyticsResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetAnalyticsResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetAnalyticsResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetAnalyticsResponseValidationError{}
+
+// Validate checks the field values on SecretRequest with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *SecretRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on SecretRequest with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in SecretRequestMultiError, or
+// nil if none found.
+func (m *SecretRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *SecretRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Key
+
+	// no validation rules for Value
+
+	if len(errors) > 0 {
+		return SecretRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// SecretRequestMultiError is an error wrapping multiple validation errors
+// returned by SecretRequest.ValidateAll() if the designated constraints
+// aren't met.
+type SecretRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m SecretRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m SecretRequestMultiError) AllErrors() []error { return m }
+
+// SecretRequestValidationError is the validation error returned by
+// SecretRequest.Validate if the designated constraints aren't met.
+type SecretRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e SecretRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e SecretRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e SecretRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e SecretRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e SecretRequestValidationError) ErrorName() string { return "SecretRequestValidationError" }
+
+// Error satisfies the builtin error interface
+func (e SecretRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sSecretRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = SecretRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = SecretRequestValidationError{}
+
+// Validate checks the field values on ListSecretsResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ListSecretsResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListSecretsResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ListSecretsResponseMultiError, or nil if none found.
+func (m *ListSecretsResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListSecretsResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetData() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ListSecretsResponseValidationError{
+						field:  fmt.Sprintf("Data[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ListSecretsResponseValidationError{
+						field:  fmt.Sprintf("Data[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ListSecretsResponseValidationError{
+					field:  fmt.Sprintf("Data[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return ListSecretsResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListSecretsResponseMultiError is an error wrapping multiple validation
+// errors returned by ListSecretsResponse.ValidateAll() if the designated
+// constraints aren't met.
+type ListSecretsResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListSecretsResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListSecretsResponseMultiError) AllErrors() []error { return m }
+
+// ListSecretsResponseValidationError is the validation error returned by
+// ListSecretsResponse.Validate if the designated constraints aren't met.
+type ListSecretsResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListSecretsResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListSecretsResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListSecretsResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListSecretsResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListSecretsResponseValidationError) ErrorName() string {
+	return "ListSecretsResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListSecretsResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListSecretsResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListSecretsResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListSecretsResponseValidationError{}
+
+// Validate checks the field values on SecretResponse with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *SecretResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on SecretResponse with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in SecretResponseMultiError,
+// or nil if none found.
+func (m *SecretResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *SecretResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetData()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, SecretResponseValidationError{
+					field:  "Data",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, SecretResponseValidationError{
+					field:  "Data",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetData()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return SecretResponseValidationError{
+				field:  "Data",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetMeta()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, SecretResponseValidationError{
+					field:  "Meta",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, SecretResponseValidationError{
+					field:  "Meta",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetMeta()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return SecretResponseValidationError{
+				field:  "Meta",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return SecretResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// SecretResponseMultiError is an error wrapping multiple validation errors
+// returned by SecretResponse.ValidateAll() if the designated constraints
+// aren't met.
+type SecretResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m SecretResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m SecretResponseMultiError) AllErrors() []error { return m }
+
+// SecretResponseValidationError is the validation error returned by
+// SecretResponse.Validate if the designated constraints aren't met.
+type SecretResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e SecretResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e SecretResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e SecretResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e SecretResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e SecretResponseValidationError) ErrorName() string { return "SecretResponseValidationError" }
+
+// Error satisfies the builtin error interface
+func (e SecretResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sSecretResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = SecretResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = SecretResponseValidationError{}
+
+// Validate checks the field values on ListCachesResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ListCachesResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListCachesResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ListCachesResponseMultiError, or nil if none found.
+func (m *ListCachesResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListCachesResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetData() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ListCachesResponseValidationError{
+						field:  fmt.Sprintf("Data[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ListCachesResponseValidationError{
+						field:  fmt.Sprintf("Data[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ListCachesResponseValidationError{
+					field:  fmt.Sprintf("Data[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return ListCachesResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListCachesResponseMultiError is an error wrapping multiple validation errors
+// returned by ListCachesResponse.ValidateAll() if the designated constraints
+// aren't met.
+type ListCachesResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListCachesResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListCachesResponseMultiError) AllErrors() []error { return m }
+
+// ListCachesResponseValidationError is the validation error returned by
+// ListCachesResponse.Validate if the designated constraints aren't met.
+type ListCachesResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListCachesResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListCachesResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListCachesResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListCachesResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListCachesResponseValidationError) ErrorName() string {
+	return "ListCachesResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListCachesResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListCachesResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListCachesResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListCachesResponseValidationError{}
+
+// Validate checks the field values on GetCacheRequest with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *GetCacheRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetCacheRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetCacheRequestMultiError, or nil if none found.
+func (m *GetCacheRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetCacheRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if m.GetId() <= 0 {
+		err := GetCacheRequestValidationError{
+			field:  "Id",
+			reason: "value must be greater than 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return GetCacheRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetCacheRequestMultiError is an error wrapping multiple validation errors
+// returned by GetCacheRequest.ValidateAll() if the designated constraints
+// aren't met.
+type GetCacheRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetCacheRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetCacheRequestMultiError) AllErrors() []error { return m }
+
+// GetCacheRequestValidationError is the validation error returned by
+// GetCacheRequest.Validate if the designated constraints aren't met.
+type GetCacheRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetCacheRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetCacheRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetCacheRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetCacheRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetCacheRequestValidationError) ErrorName() string { return "GetCacheRequestValidationError" }
+
+// Error satisfies the builtin error interface
+func (e GetCacheRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetCacheRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetCacheRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetCacheRequestValidationError{}
+
+// Validate checks the field values on CacheResponse with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *CacheResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on CacheResponse with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in CacheResponseMultiError, or
+// nil if none found.
+func (m *CacheResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *CacheResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetData()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, CacheResponseValidationError{
+					field:  "Data",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, CacheResponseValidationError{
+					field:  "Data",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetData()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return CacheResponseValidationError{
+				field:  "Data",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetMeta()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, CacheResponseValidationError{
+					field:  "Meta",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, CacheResponseValidationError{
+					field:  "Meta",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetMeta()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return CacheResponseValidationError{
+				field:  "Meta",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return CacheResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// CacheResponseMultiError is an error wrapping multiple validation errors
+// returned by CacheResponse.ValidateAll() if the designated constraints
+// aren't met.
+type CacheResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m CacheResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m CacheResponseMultiError) AllErrors() []error { return m }
+
+// CacheResponseValidationError is the validation error returned by
+// CacheResponse.Validate if the designated constraints aren't met.
+type CacheResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e CacheResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e CacheResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e CacheResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e CacheResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e CacheResponseValidationError) ErrorName() string { return "CacheResponseValidationError" }
+
+// Error satisfies the builtin error interface
+func (e CacheResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sCacheResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = CacheResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = CacheResponseValidationError{}
+
+// Validate checks the field values on ListRateLimitsResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ListRateLimitsResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListRateLimitsResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ListRateLimitsResponseMultiError, or nil if none found.
+func (m *ListRateLimitsResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListRateLimitsResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetData() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ListRateLimitsResponseValidationError{
+						field:  fmt.Sprintf("Data[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ListRateLimitsResponseValidationError{
+						field:  fmt.Sprintf("Data[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ListRateLimitsResponseValidationError{
+					field:  fmt.Sprintf("Data[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return ListRateLimitsResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListRateLimitsResponseMultiError is an error wrapping multiple validation
+// errors returned by ListRateLimitsResponse.ValidateAll() if the designated
+// constraints aren't met.
+type ListRateLimitsResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListRateLimitsResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListRateLimitsResponseMultiError) AllErrors() []error { return m }
+
+// ListRateLimitsResponseValidationError is the validation error returned by
+// ListRateLimitsResponse.Validate if the designated constraints aren't met.
+type ListRateLimitsResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListRateLimitsResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListRateLimitsResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListRateLimitsResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListRateLimitsResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListRateLimitsResponseValidationError) ErrorName() string {
+	return "ListRateLimitsResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListRateLimitsResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListRateLimitsResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListRateLimitsResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListRateLimitsResponseValidationError{}
+
+// Validate checks the field values on GetBufferRequest with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *GetBufferRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetBufferRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetBufferRequestMultiError, or nil if none found.
+func (m *GetBufferRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetBufferRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if m.GetId() <= 0 {
+		err := GetBufferRequestValidationError{
+			field:  "Id",
+			reason: "value must be greater than 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return GetBufferRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetBufferRequestMultiError is an error wrapping multiple validation errors
+// returned by GetBufferRequest.ValidateAll() if the designated constraints
+// aren't met.
+type GetBufferRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetBufferRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetBufferRequestMultiError) AllErrors() []error { return m }
+
+// GetBufferRequestValidationError is the validation error returned by
+// GetBufferRequest.Validate if the designated constraints aren't met.
+type GetBufferRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetBufferRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetBufferRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetBufferRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetBufferRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetBufferRequestValidationError) ErrorName() string { return "GetBufferRequestValidationError" }
+
+// Error satisfies the builtin error interface
+func (e GetBufferRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetBufferRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetBufferRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetBufferRequestValidationError{}
+
+// Validate checks the field values on BufferResponse with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *BufferResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on BufferResponse with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in BufferResponseMultiError,
+// or nil if none found.
+func (m *BufferResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *BufferResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetData()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, BufferResponseValidationError{
+					field:  "Data",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, BufferResponseValidationError{
+					field:  "Data",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetData()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return BufferResponseValidationError{
+				field:  "Data",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetMeta()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, BufferResponseValidationError{
+					field:  "Meta",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, BufferResponseValidationError{
+					field:  "Meta",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetMeta()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return BufferResponseValidationError{
+				field:  "Meta",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return BufferResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// BufferResponseMultiError is an error wrapping multiple validation errors
+// returned by BufferResponse.ValidateAll() if the designated constraints
+// aren't met.
+type BufferResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m BufferResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m BufferResponseMultiError) AllErrors() []error { return m }
+
+// BufferResponseValidationError is the validation error returned by
+// BufferResponse.Validate if the designated constraints aren't met.
+type BufferResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e BufferResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e BufferResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e BufferResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e BufferResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e BufferResponseValidationError) ErrorName() string { return "BufferResponseValidationError" }
+
+// Error satisfies the builtin error interface
+func (e BufferResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sBufferResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = BufferResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = BufferResponseValidationError{}
+
+// Validate checks the field values on ListBuffersResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ListBuffersResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListBuffersResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ListBuffersResponseMultiError, or nil if none found.
+func (m *ListBuffersResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListBuffersResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetData() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ListBuffersResponseValidationError{
+						field:  fmt.Sprintf("Data[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ListBuffersResponseValidationError{
+						field:  fmt.Sprintf("Data[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ListBuffersResponseValidationError{
+					field:  fmt.Sprintf("Data[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return ListBuffersResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListBuffersResponseMultiError is an error wrapping multiple validation
+// errors returned by ListBuffersResponse.ValidateAll() if the designated
+// constraints aren't met.
+type ListBuffersResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListBuffersResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListBuffersResponseMultiError) AllErrors() []error { return m }
+
+// ListBuffersResponseValidationError is the validation error returned by
+// ListBuffersResponse.Validate if the designated constraints aren't met.
+type ListBuffersResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListBuffersResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListBuffersResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListBuffersResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListBuffersResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListBuffersResponseValidationError) ErrorName() string {
+	return "ListBuffersResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListBuffersResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListBuffersResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListBuffersResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListBuffersResponseValidationError{}
+
+// Validate checks the field values on ListFilesResponse with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *ListFilesResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListFilesResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ListFilesResponseMultiError, or nil if none found.
+func (m *ListFilesResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListFilesResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetData() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ListFilesResponseValidationError{
+						field:  fmt.Sprintf("Data[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ListFilesResponseValidationError{
+						field:  fmt.Sprintf("Data[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ListFilesResponseValidationError{
+					field:  fmt.Sprintf("Data[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return ListFilesResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListFilesResponseMultiError is an error wrapping multiple validation errors
+// returned by ListFilesResponse.ValidateAll() if the designated constraints
+// aren't met.
+type ListFilesResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListFilesResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListFilesResponseMultiError) AllErrors() []error { return m }
+
+// ListFilesResponseValidationError is the validation error returned by
+// ListFilesResponse.Validate if the designated constraints aren't met.
+type ListFilesResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListFilesResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListFilesResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListFilesResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListFilesResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListFilesResponseValidationError) ErrorName() string {
+	return "ListFilesResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListFilesResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListFilesResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListFilesResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListFilesResponseValidationError{}
+
+// Validate checks the field values on GetFileRequest with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *GetFileRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetFileRequest with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in GetFileRequestMultiError,
+// or nil if none found.
+func (m *GetFileRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetFileRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if m.GetId() <= 0 {
+		err := GetFileRequestValidationError{
+			field:  "Id",
+			reason: "value must be greater than 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return GetFileRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetFileRequestMultiError is an error wrapping multiple validation errors
+// returned by GetFileRequest.ValidateAll() if the designated constraints
+// aren't met.
+type GetFileRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetFileRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetFileRequestMultiError) AllErrors() []error { return m }
+
+// GetFileRequestValidationError is the validation error returned by
+// GetFileRequest.Validate if the designated constraints aren't met.
+type GetFileRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetFileRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetFileRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetFileRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetFileRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetFileRequestValidationError) ErrorName() string { return "GetFileRequestValidationError" }
+
+// Error satisfies the builtin error interface
+func (e GetFileRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetFileRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetFileRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetFileRequestValidationError{}
+
+// Validate checks the field values on FileResponse with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *FileResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on FileResponse with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in FileResponseMultiError, or
+// nil if none found.
+func (m *FileResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *FileResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetData()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, FileResponseValidationError{
+					field:  "Data",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, FileResponseValidationError{
+					field:  "Data",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetData()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return FileResponseValidationError{
+				field:  "Data",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetMeta()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, FileResponseValidationError{
+					field:  "Meta",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, FileResponseValidationError{
+					field:  "Meta",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetMeta()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return FileResponseValidationError{
+				field:  "Meta",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return FileResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// FileResponseMultiError is an error wrapping multiple validation errors
+// returned by FileResponse.ValidateAll() if the designated constraints aren't met.
+type FileResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m FileResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m FileResponseMultiError) AllErrors() []error { return m }
+
+// FileResponseValidationError is the validation error returned by
+// FileResponse.Validate if the designated constraints aren't met.
+type FileResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e FileResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e FileResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e FileResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e FileResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e FileResponseValidationError) ErrorName() string { return "FileResponseValidationError" }
+
+// Error satisfies the builtin error interface
+func (e FileResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sFileResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = FileResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = FileResponseValidationError{}
+
+// Validate checks the field values on GetRateLimitRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GetRateLimitRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetRateLimitRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetRateLimitRequestMultiError, or nil if none found.
+func (m *GetRateLimitRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetRateLimitRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if m.GetId() <= 0 {
+		err := GetRateLimitRequestValidationError{
+			field:  "Id",
+			reason: "value must be greater than 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return GetRateLimitRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetRateLimitRequestMultiError is an error wrapping multiple validation
+// errors returned by GetRateLimitRequest.ValidateAll() if the designated
+// constraints aren't met.
+type GetRateLimitRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetRateLimitRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetRateLimitRequestMultiError) AllErrors() []error { return m }
+
+// GetRateLimitRequestValidationError is the validation error returned by
+// GetRateLimitRequest.Validate if the designated constraints aren't met.
+type GetRateLimitRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetRateLimitRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetRateLimitRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetRateLimitRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetRateLimitRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetRateLimitRequestValidationError) ErrorName() string {
+	return "GetRateLimitRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetRateLimitRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetRateLimitRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetRateLimitRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetRateLimitRequestValidationError{}
+
+// Validate checks the field values on RateLimitResponse with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *RateLimitResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on RateLimitResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// RateLimitResponseMultiError, or nil if none found.
+func (m *RateLimitResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *RateLimitResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetData()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, RateLimitResponseValidationError{
+					field:  "Data",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, RateLimitResponseValidationError{
+					field:  "Data",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetData()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return RateLimitResponseValidationError{
+				field:  "Data",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetMeta()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, RateLimitResponseValidationError{
+					field:  "Meta",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, RateLimitResponseValidationError{
+					field:  "Meta",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetMeta()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return RateLimitResponseValidationError{
+				field:  "Meta",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return RateLimitResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// RateLimitResponseMultiError is an error wrapping multiple validation errors
+// returned by RateLimitResponse.ValidateAll() if the designated constraints
+// aren't met.
+type RateLimitResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m RateLimitResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m RateLimitResponseMultiError) AllErrors() []error { return m }
+
+// RateLimitResponseValidationError is the validation error returned by
+// RateLimitResponse.Validate if the designated constraints aren't met.
+type RateLimitResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e RateLimitResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e RateLimitResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e RateLimitResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e RateLimitResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e RateLimitResponseValidationError) ErrorName() string {
+	return "RateLimitResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e RateLimitResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sRateLimitResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = RateLimitResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = RateLimitResponseValidationError{}
+
+// Validate checks the field values on ListMcpServersResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ListMcpServersResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListMcpServersResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ListMcpServersResponseMultiError, or nil if none found.
+func (m *ListMcpServersResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListMcpServersResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetData() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ListMcpServersResponseValidationError{
+						field:  fmt.Sprintf("Data[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ListMcpServersResponseValidationError{
+						field:  fmt.Sprintf("Data[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ListMcpServersResponseValidationError{
+					field:  fmt.Sprintf("Data[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return ListMcpServersResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListMcpServersResponseMultiError is an error wrapping multiple validation
+// errors returned by ListMcpServersResponse.ValidateAll() if the designated
+// constraints aren't met.
+type ListMcpServersResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListMcpServersResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListMcpServersResponseMultiError) AllErrors() []error { return m }
+
+// ListMcpServersResponseValidationError is the validation error returned by
+// ListMcpServersResponse.Validate if the designated constraints aren't met.
+type ListMcpServersResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListMcpServersResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListMcpServersResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListMcpServersResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListMcpServersResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListMcpServersResponseValidationError) ErrorName() string {
+	return "ListMcpServersResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListMcpServersResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListMcpServersResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListMcpServersResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListMcpServersResponseValidationError{}
+
+// Validate checks the field values on GetMcpServerRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GetMcpServerRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetMcpServerRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetMcpServerRequestMultiError, or nil if none found.
+func (m *GetMcpServerRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetMcpServerRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if m.GetId() <= 0 {
+		err := GetMcpServerRequestValidationError{
+			field:  "Id",
+			reason: "value must be greater than 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return GetMcpServerRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetMcpServerRequestMultiError is an error wrapping multiple validation
+// errors returned by GetMcpServerRequest.ValidateAll() if the designated
+// constraints aren't met.
+type GetMcpServerRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetMcpServerRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetMcpServerRequestMultiError) AllErrors() []error { return m }
+
+// GetMcpServerRequestValidationError is the validation error returned by
+// GetMcpServerRequest.Validate if the designated constraints aren't met.
+type GetMcpServerRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetMcpServerRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetMcpServerRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetMcpServerRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetMcpServerRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetMcpServerRequestValidationError) ErrorName() string {
+	return "GetMcpServerRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetMcpServerRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetMcpServerRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetMcpServerRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetMcpServerRequestValidationError{}
+
+// Validate checks the field values on McpServerResponse with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *McpServerResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on McpServerResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// McpServerResponseMultiError, or nil if none found.
+func (m *McpServerResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *McpServerResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetData()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, McpServerResponseValidationError{
+					field:  "Data",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, McpServerResponseValidationError{
+					field:  "Data",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetData()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return McpServerResponseValidationError{
+				field:  "Data",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetMeta()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, McpServerResponseValidationError{
+					field:  "Meta",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, McpServerResponseValidationError{
+					field:  "Meta",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetMeta()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return McpServerResponseValidationError{
+				field:  "Meta",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return McpServerResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// McpServerResponseMultiError is an error wrapping multiple validation errors
+// returned by McpServerResponse.ValidateAll() if the designated constraints
+// aren't met.
+type McpServerResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m McpServerResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m McpServerResponseMultiError) AllErrors() []error { return m }
+
+// McpServerResponseValidationError is the validation error returned by
+// McpServerResponse.Validate if the designated constraints aren't met.
+type McpServerResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e McpServerResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e McpServerResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e McpServerResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e McpServerResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e McpServerResponseValidationError) ErrorName() string {
+	return "McpServerResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e McpServerResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sMcpServerResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = McpServerResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = McpServerResponseValidationError{}
+
+// Validate checks the field values on ToolProgressRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ToolProgressRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ToolProgressRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ToolProgressRequestMultiError, or nil if none found.
+func (m *ToolProgressRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ToolProgressRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if utf8.RuneCountInString(m.GetCallId()) < 1 {
+		err := ToolProgressRequestValidationError{
+			field:  "CallId",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	// no validation rules for Progress
+
+	// no validation rules for Message
+
+	if m.Total != nil {
+		// no validation rules for Total
+	}
+
+	if len(errors) > 0 {
+		return ToolProgressRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// ToolProgressRequestMultiError is an error wrapping multiple validation
+// errors returned by ToolProgressRequest.ValidateAll() if the designated
+// constraints aren't met.
+type ToolProgressRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ToolProgressRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ToolProgressRequestMultiError) AllErrors() []error { return m }
+
+// ToolProgressRequestValidationError is the validation error returned by
+// ToolProgressRequest.Validate if the designated constraints aren't met.
+type ToolProgressRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ToolProgressRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ToolProgressRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ToolProgressRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ToolProgressRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ToolProgressRequestValidationError) ErrorName() string {
+	return "ToolProgressRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ToolProgressRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sToolProgressRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ToolProgressRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ToolProgressRequestValidationError{}
+
+// Validate checks the field values on McpToolCall with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *McpToolCall) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on McpToolCall with the rules defined in
+// the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in McpToolCallMultiError, or
+// nil if none found.
+func (m *McpToolCall) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *McpToolCall) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Id
+
+	// no validation rules for Tool
+
+	// no validation rules for SessionId
+
+	// no validation rules for ClientName
+
+	// no validation rules for ClientVersion
+
+	// no validation rules for ArgumentsHash
+
+	// no validation rules for Arguments
+
+	// no validation rules for Status
+
+	// no validation rules for Error
+
+	// no validation rules for LatencyMs
+
+	if all {
+		switch v := interface{}(m.GetCreatedAt()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, McpToolCallValidationError{
+					field:  "CreatedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, McpToolCallValidationError{
+					field:  "CreatedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetCreatedAt()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return McpToolCallValidationError{
+				field:  "CreatedAt",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if m.FlowId != nil {
+		// no validation rules for FlowId
+	}
+
+	if m.FlowVersionId != nil {
+		// no validation rules for FlowVersionId
+	}
+
+	if m.McpServerId != nil {
+		// no validation rules for McpServerId
+	}
+
+	if len(errors) > 0 {
+		return McpToolCallMultiError(errors)
+	}
+
+	return nil
+}
+
+// McpToolCallMultiError is an error wrapping multiple validation errors
+// returned by McpToolCall.ValidateAll() if the designated constraints aren't met.
+type McpToolCallMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m McpToolCallMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m McpToolCallMultiError) AllErrors() []error { return m }
+
+// McpToolCallValidationError is the validation error returned by
+// McpToolCall.Validate if the designated constraints aren't met.
+type McpToolCallValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e McpToolCallValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e McpToolCallValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e McpToolCallValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e McpToolCallValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e McpToolCallValidationError) ErrorName() string { return "McpToolCallValidationError" }
+
+// Error satisfies the builtin error interface
+func (e McpToolCallValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sMcpToolCall.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = McpToolCallValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = McpToolCallValidationError{}
+
+// Validate checks the field values on ListToolCallsRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ListToolCallsRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListToolCallsRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ListToolCallsRequestMultiError, or nil if none found.
+func (m *ListToolCallsRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListToolCallsRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Tool
+
+	// no validation rules for FlowId
+
+	// no validation rules for Status
+
+	// no validation rules for Limit
+
+	// no validation rules for Offset
+
+	if all {
+		switch v := interface{}(m.GetStartTime()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, ListToolCallsRequestValidationError{
+					field:  "StartTime",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, ListToolCallsRequestValidationError{
+					field:  "StartTime",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetStartTime()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return ListToolCallsRequestValidationError{
+				field:  "StartTime",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetEndTime()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, ListToolCallsRequestValidationError{
+					field:  "EndTime",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, ListToolCallsRequestValidationError{
+					field:  "EndTime",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetEndTime()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return ListToolCallsRequestValidationError{
+				field:  "EndTime",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return ListToolCallsRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListToolCallsRequestMultiError is an error wrapping multiple validation
+// errors returned by ListToolCallsRequest.ValidateAll() if the designated
+// constraints aren't met.
+type ListToolCallsRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListToolCallsRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListToolCallsRequestMultiError) AllErrors() []error { return m }
+
+// ListToolCallsRequestValidationError is the validation error returned by
+// ListToolCallsRequest.Validate if the designated constraints aren't met.
+type ListToolCallsRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListToolCallsRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListToolCallsRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListToolCallsRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListToolCallsRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListToolCallsRequestValidationError) ErrorName() string {
+	return "ListToolCallsRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListToolCallsRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListToolCallsRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListToolCallsRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListToolCallsRequestValidationError{}
+
+// Validate checks the field values on ListToolCallsResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ListToolCallsResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListToolCallsResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ListToolCallsResponseMultiError, or nil if none found.
+func (m *ListToolCallsResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListToolCallsResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetData() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ListToolCallsResponseValidationError{
+						field:  fmt.Sprintf("Data[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ListToolCallsResponseValidationError{
+						field:  fmt.Sprintf("Data[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ListToolCallsResponseValidationError{
+					field:  fmt.Sprintf("Data[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	// no validation rules for Total
+
+	if len(errors) > 0 {
+		return ListToolCallsResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListToolCallsResponseMultiError is an error wrapping multiple validation
+// errors returned by ListToolCallsResponse.ValidateAll() if the designated
+// constraints aren't met.
+type ListToolCallsResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListToolCallsResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListToolCallsResponseMultiError) AllErrors() []error { return m }
+
+// ListToolCallsResponseValidationError is the validation error returned by
+// ListToolCallsResponse.Validate if the designated constraints aren't met.
+type ListToolCallsResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListToolCallsResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListToolCallsResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListToolCallsResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListToolCallsResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListToolCallsResponseValidationError) ErrorName() string {
+	return "ListToolCallsResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListToolCallsResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListToolCallsResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListToolCallsResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListToolCallsResponseValidationError{}
+
+// Validate checks the field values on RateLimitKey with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *RateLimitKey) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on RateLimitKey with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in RateLimitKeyMultiError, or
+// nil if none found.
+func (m *RateLimitKey) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *RateLimitKey) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Key
+
+	// no validation rules for Remaining
+
+	// no validation rules for Limit
+
+	// no validation rules for ResetAt
+
+	if all {
+		switch v := interface{}(m.GetLastRefillAt()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, RateLimitKeyValidationError{
+					field:  "LastRefillAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, RateLimitKeyValidationError{
+					field:  "LastRefillAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetLastRefillAt()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return RateLimitKeyValidationError{
+				field:  "LastRefillAt",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetUpdatedAt()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, RateLimitKeyValidationError{
+					field:  "UpdatedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, RateLimitKeyValidationError{
+					field:  "UpdatedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetUpdatedAt()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return RateLimitKeyValidationError{
+				field:  "UpdatedAt",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return RateLimitKeyMultiError(errors)
+	}
+
+	return nil
+}
+
+// RateLimitKeyMultiError is an error wrapping multiple validation errors
+// returned by RateLimitKey.ValidateAll() if the designated constraints aren't met.
+type RateLimitKeyMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m RateLimitKeyMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m RateLimitKeyMultiError) AllErrors() []error { return m }
+
+// RateLimitKeyValidationError is the validation error returned by
+// RateLimitKey.Validate if the designated constraints aren't met.
+type RateLimitKeyValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e RateLimitKeyValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e RateLimitKeyValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e RateLimitKeyValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e RateLimitKeyValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e RateLimitKeyValidationError) ErrorName() string { return "RateLimitKeyValidationError" }
+
+// Error satisfies the builtin error interface
+func (e RateLimitKeyValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sRateLimitKey.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = RateLimitKeyValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = RateLimitKeyValidationError{}
+
+// Validate checks the field values on ListRateLimitKeysRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ListRateLimitKeysRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListRateLimitKeysRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ListRateLimitKeysRequestMultiError, or nil if none found.
+func (m *ListRateLimitKeysRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListRateLimitKeysRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if m.GetId() <= 0 {
+		err := ListRateLimitKeysRequestValidationError{
+			field:  "Id",
+			reason: "value must be greater than 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if utf8.RuneCountInString(m.GetKey()) > 255 {
+		err := ListRateLimitKeysRequestValidationError{
+			field:  "Key",
+			reason: "value length must be at most 255 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	// no validation rules for Limit
+
+	// no validation rules for Offset
+
+	if len(errors) > 0 {
+		return ListRateLimitKeysRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListRateLimitKeysRequestMultiError is an error wrapping multiple validation
+// errors returned by ListRateLimitKeysRequest.ValidateAll() if the designated
+// constraints aren't met.
+type ListRateLimitKeysRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListRateLimitKeysRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListRateLimitKeysRequestMultiError) AllErrors() []error { return m }
+
+// ListRateLimitKeysRequestValidationError is the validation error returned by
+// ListRateLimitKeysRequest.Validate if the designated constraints aren't met.
+type ListRateLimitKeysRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListRateLimitKeysRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListRateLimitKeysRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListRateLimitKeysRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListRateLimitKeysRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListRateLimitKeysRequestValidationError) ErrorName() string {
+	return "ListRateLimitKeysRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListRateLimitKeysRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
 
-	// no validation rules for OutputEvents
+	key := ""
+	if e.key {
+		key = "key for "
+	}
 
-	// no validation rules for InputEventsByComponent
+	return fmt.Sprintf(
+		"invalid %sListRateLimitKeysRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
 
-	// no validation rules for ProcessorEventsByComponent
+var _ error = ListRateLimitKeysRequestValidationError{}
 
-	// no validation rules for OutputEventsByComponent
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListRateLimitKeysRequestValidationError{}
+
+// Validate checks the field values on ListRateLimitKeysResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ListRateLimitKeysResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListRateLimitKeysResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ListRateLimitKeysResponseMultiError, or nil if none found.
+func (m *ListRateLimitKeysResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListRateLimitKeysResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetData() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ListRateLimitKeysResponseValidationError{
+						field:  fmt.Sprintf("Data[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ListRateLimitKeysResponseValidationError{
+						field:  fmt.Sprintf("Data[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ListRateLimitKeysResponseValidationError{
+					field:  fmt.Sprintf("Data[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	// no validation rules for Total
+
+	if len(errors) > 0 {
+		return ListRateLimitKeysResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListRateLimitKeysResponseMultiError is an error wrapping multiple validation
+// errors returned by ListRateLimitKeysResponse.ValidateAll() if the
+// designated constraints aren't met.
+type ListRateLimitKeysResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListRateLimitKeysResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListRateLimitKeysResponseMultiError) AllErrors() []error { return m }
+
+// ListRateLimitKeysResponseValidationError is the validation error returned by
+// ListRateLimitKeysResponse.Validate if the designated constraints aren't met.
+type ListRateLimitKeysResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListRateLimitKeysResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListRateLimitKeysResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListRateLimitKeysResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListRateLimitKeysResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListRateLimitKeysResponseValidationError) ErrorName() string {
+	return "ListRateLimitKeysResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListRateLimitKeysResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListRateLimitKeysResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListRateLimitKeysResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListRateLimitKeysResponseValidationError{}
+
+// Validate checks the field values on ResetRateLimitRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ResetRateLimitRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ResetRateLimitRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ResetRateLimitRequestMultiError, or nil if none found.
+func (m *ResetRateLimitRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ResetRateLimitRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if m.GetId() <= 0 {
+		err := ResetRateLimitRequestValidationError{
+			field:  "Id",
+			reason: "value must be greater than 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if utf8.RuneCountInString(m.GetKey()) > 255 {
+		err := ResetRateLimitRequestValidationError{
+			field:  "Key",
+			reason: "value length must be at most 255 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return ResetRateLimitRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// ResetRateLimitRequestMultiError is an error wrapping multiple validation
+// errors returned by ResetRateLimitRequest.ValidateAll() if the designated
+// constraints aren't met.
+type ResetRateLimitRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ResetRateLimitRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ResetRateLimitRequestMultiError) AllErrors() []error { return m }
+
+// ResetRateLimitRequestValidationError is the validation error returned by
+// ResetRateLimitRequest.Validate if the designated constraints aren't met.
+type ResetRateLimitRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ResetRateLimitRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ResetRateLimitRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ResetRateLimitRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ResetRateLimitRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ResetRateLimitRequestValidationError) ErrorName() string {
+	return "ResetRateLimitRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ResetRateLimitRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sResetRateLimitRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ResetRateLimitRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ResetRateLimitRequestValidationError{}
+
+// Validate checks the field values on GetRateLimitStatsRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GetRateLimitStatsRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetRateLimitStatsRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetRateLimitStatsRequestMultiError, or nil if none found.
+func (m *GetRateLimitStatsRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetRateLimitStatsRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if m.GetId() <= 0 {
+		err := GetRateLimitStatsRequestValidationError{
+			field:  "Id",
+			reason: "value must be greater than 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if val := m.GetHours(); val < 0 || val > 168 {
+		err := GetRateLimitStatsRequestValidationError{
+			field:  "Hours",
+			reason: "value must be inside range [0, 168]",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
 
 	if len(errors) > 0 {
-		return MetricsRequestMultiError(errors)
+		return GetRateLimitStatsRequestMultiError(errors)
 	}
 
 	return nil
 }
 
-// MetricsRequestMultiError is an error wrapping multiple validation errors
-// returned by MetricsRequest.ValidateAll() if the designated constraints
-// aren't met.
-type MetricsRequestMultiError []error
+// GetRateLimitStatsRequestMultiError is an error wrapping multiple validation
+// errors returned by GetRateLimitStatsRequest.ValidateAll() if the designated
+// constraints aren't met.
+type GetRateLimitStatsRequestMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m MetricsRequestMultiError) Error() string {
+func (m GetRateLimitStatsRequestMultiError) Error() string {
 	msgs := make([]string, 0, len(m))
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -1904,11 +8384,11 @@ func (m MetricsRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m MetricsRequestMultiError) AllErrors() []error { return m }
+func (m GetRateLimitStatsRequestMultiError) AllErrors() []error { return m }
 
-// MetricsRequestValidationError is the validation error returned by
-// MetricsRequest.Validate if the designated constraints aren't met.
-type MetricsRequestValidationError struct {
+// GetRateLimitStatsRequestValidationError is the validation error returned by
+// GetRateLimitStatsRequest.Validate if the designated constraints aren't met.
+type GetRateLimitStatsRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -1916,22 +8396,24 @@ type MetricsRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e MetricsRequestValidationError) Field() string { return e.field }
+func (e GetRateLimitStatsRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e MetricsRequestValidationError) Reason() string { return e.reason }
+func (e GetRateLimitStatsRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e MetricsRequestValidationError) Cause() error { return e.cause }
+func (e GetRateLimitStatsRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e MetricsRequestValidationError) Key() bool { return e.key }
+func (e GetRateLimitStatsRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e MetricsRequestValidationError) ErrorName() string { return "MetricsRequestValidationError" }
+func (e GetRateLimitStatsRequestValidationError) ErrorName() string {
+	return "GetRateLimitStatsRequestValidationError"
+}
 
 // Error satisfies the builtin error interface
-func (e MetricsRequestValidationError) Error() string {
+func (e GetRateLimitStatsRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -1943,14 +8425,14 @@ func (e MetricsRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sMetricsRequest.%s: %s%s",
+		"invalid %sGetRateLimitStatsRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = MetricsRequestValidationError{}
+var _ error = GetRateLimitStatsRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -1958,44 +8440,82 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = MetricsRequestValidationError{}
+} = GetRateLimitStatsRequestValidationError{}
 
-// Validate checks the field values on GetAnalyticsRequest with the rules
+// Validate checks the field values on GetRateLimitStatsResponse with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *GetAnalyticsRequest) Validate() error {
+func (m *GetRateLimitStatsResponse) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on GetAnalyticsRequest with the rules
-// defined in the proto definition for this message. If any rules are
+// ValidateAll checks the field values on GetRateLimitStatsResponse with the
+// rules defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// GetAnalyticsRequestMultiError, or nil if none found.
-func (m *GetAnalyticsRequest) ValidateAll() error {
+// GetRateLimitStatsResponseMultiError, or nil if none found.
+func (m *GetRateLimitStatsResponse) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *GetAnalyticsRequest) validate(all bool) error {
+func (m *GetRateLimitStatsResponse) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
+	for idx, item := range m.GetData() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, GetRateLimitStatsResponseValidationError{
+						field:  fmt.Sprintf("Data[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, GetRateLimitStatsResponseValidationError{
+						field:  fmt.Sprintf("Data[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return GetRateLimitStatsResponseValidationError{
+					field:  fmt.Sprintf("Data[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	// no validation rules for TotalAllowed
+
+	// no validation rules for TotalDenied
+
 	if len(errors) > 0 {
-		return GetAnalyticsRequestMultiError(errors)
+		return GetRateLimitStatsResponseMultiError(errors)
 	}
 
 	return nil
 }
 
-// GetAnalyticsRequestMultiError is an error wrapping multiple validation
-// errors returned by GetAnalyticsRequest.ValidateAll() if the designated
-// constraints aren't met.
-type GetAnalyticsRequestMultiError []error
+// GetRateLimitStatsResponseMultiError is an error wrapping multiple validation
+// errors returned by GetRateLimitStatsResponse.ValidateAll() if the
+// designated constraints aren't met.
+type GetRateLimitStatsResponseMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m GetAnalyticsRequestMultiError) Error() string {
+func (m GetRateLimitStatsResponseMultiError) Error() string {
 	msgs := make([]string, 0, len(m))
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -2004,11 +8524,11 @@ func (m GetAnalyticsRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m GetAnalyticsRequestMultiError) AllErrors() []error { return m }
+func (m GetRateLimitStatsResponseMultiError) AllErrors() []error { return m }
 
-// GetAnalyticsRequestValidationError is the validation error returned by
-// GetAnalyticsRequest.Validate if the designated constraints aren't met.
-type GetAnalyticsRequestValidationError struct {
+// GetRateLimitStatsResponseValidationError is the validation error returned by
+// GetRateLimitStatsResponse.Validate if the designated constraints aren't met.
+type GetRateLimitStatsResponseValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -2016,24 +8536,24 @@ type GetAnalyticsRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e GetAnalyticsRequestValidationError) Field() string { return e.field }
+func (e GetRateLimitStatsResponseValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e GetAnalyticsRequestValidationError) Reason() string { return e.reason }
+func (e GetRateLimitStatsResponseValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e GetAnalyticsRequestValidationError) Cause() error { return e.cause }
+func (e GetRateLimitStatsResponseValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e GetAnalyticsRequestValidationError) Key() bool { return e.key }
+func (e GetRateLimitStatsResponseValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e GetAnalyticsRequestValidationError) ErrorName() string {
-	return "GetAnalyticsRequestValidationError"
+func (e GetRateLimitStatsResponseValidationError) ErrorName() string {
+	return "GetRateLimitStatsResponseValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e GetAnalyticsRequestValidationError) Error() string {
+func (e GetRateLimitStatsResponseValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -2045,14 +8565,14 @@ func (e GetAnalyticsRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sGetAnalyticsRequest.%s: %s%s",
+		"invalid %sGetRateLimitStatsResponse.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = GetAnalyticsRequestValidationError{}
+var _ error = GetRateLimitStatsResponseValidationError{}
 
 var _ interface {
 	Field() string
@@ -2060,58 +8580,110 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = GetAnalyticsRequestValidationError{}
+} = GetRateLimitStatsResponseValidationError{}
 
-// Validate checks the field values on GetAnalyticsResponse with the rules
-// defined in the proto definition for this message. If any rules are
-// violated, the first error encountered is returned, or nil if there are no violations.
-func (m *GetAnalyticsResponse) Validate() error {
+// Validate checks the field values on QueuedRequest with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *QueuedRequest) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on GetAnalyticsResponse with the rules
-// defined in the proto definition for this message. If any rules are
-// violated, the result is a list of violation errors wrapped in
-// GetAnalyticsResponseMultiError, or nil if none found.
-func (m *GetAnalyticsResponse) ValidateAll() error {
+// ValidateAll checks the field values on QueuedRequest with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in QueuedRequestMultiError, or
+// nil if none found.
+func (m *QueuedRequest) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *GetAnalyticsResponse) validate(all bool) error {
+func (m *QueuedRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for TotalFlows
+	// no validation rules for Id
 
-	for idx, item := range m.GetFlowsByStatus() {
-		_, _ = idx, item
+	// no validation rules for FlowId
+
+	// no validation rules for FlowVersionId
+
+	// no validation rules for Method
+
+	// no validation rules for Path
+
+	// no validation rules for Query
+
+	// no validation rules for ContentType
+
+	// no validation rules for RemoteAddr
+
+	// no validation rules for Headers
+
+	// no validation rules for Payload
+
+	// no validation rules for Status
+
+	// no validation rules for Attempts
+
+	// no validation rules for LastError
+
+	if all {
+		switch v := interface{}(m.GetCreatedAt()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, QueuedRequestValidationError{
+					field:  "CreatedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, QueuedRequestValidationError{
+					field:  "CreatedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetCreatedAt()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return QueuedRequestValidationError{
+				field:  "CreatedAt",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if m.UpdatedAt != nil {
 
 		if all {
-			switch v := interface{}(item).(type) {
+			switch v := interface{}(m.GetUpdatedAt()).(type) {
 			case interface{ ValidateAll() error }:
 				if err := v.ValidateAll(); err != nil {
-					errors = append(errors, GetAnalyticsResponseValidationError{
-						field:  fmt.Sprintf("FlowsByStatus[%v]", idx),
+					errors = append(errors, QueuedRequestValidationError{
+						field:  "UpdatedAt",
 						reason: "embedded message failed validation",
 						cause:  err,
 					})
 				}
 			case interface{ Validate() error }:
 				if err := v.Validate(); err != nil {
-					errors = append(errors, GetAnalyticsResponseValidationError{
-						field:  fmt.Sprintf("FlowsByStatus[%v]", idx),
+					errors = append(errors, QueuedRequestValidationError{
+						field:  "UpdatedAt",
 						reason: "embedded message failed validation",
 						cause:  err,
 					})
 				}
 			}
-		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+		} else if v, ok := interface{}(m.GetUpdatedAt()).(interface{ Validate() error }); ok {
 			if err := v.Validate(); err != nil {
-				return GetAnalyticsResponseValidationError{
-					field:  fmt.Sprintf("FlowsByStatus[%v]", idx),
+				return QueuedRequestValidationError{
+					field:  "UpdatedAt",
 					reason: "embedded message failed validation",
 					cause:  err,
 				}
@@ -2120,103 +8692,248 @@ func (m *GetAnalyticsResponse) validate(all bool) error {
 
 	}
 
-	// no validation rules for TotalInputEvents
+	if len(errors) > 0 {
+		return QueuedRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// QueuedRequestMultiError is an error wrapping multiple validation errors
+// returned by QueuedRequest.ValidateAll() if the designated constraints
+// aren't met.
+type QueuedRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m QueuedRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m QueuedRequestMultiError) AllErrors() []error { return m }
+
+// QueuedRequestValidationError is the validation error returned by
+// QueuedRequest.Validate if the designated constraints aren't met.
+type QueuedRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e QueuedRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e QueuedRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e QueuedRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e QueuedRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e QueuedRequestValidationError) ErrorName() string { return "QueuedRequestValidationError" }
+
+// Error satisfies the builtin error interface
+func (e QueuedRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sQueuedRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = QueuedRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = QueuedRequestValidationError{}
+
+// Validate checks the field values on ListQueuedRequestsRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ListQueuedRequestsRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListQueuedRequestsRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ListQueuedRequestsRequestMultiError, or nil if none found.
+func (m *ListQueuedRequestsRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListQueuedRequestsRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for FlowId
+
+	if _, ok := _ListQueuedRequestsRequest_Status_InLookup[m.GetStatus()]; !ok {
+		err := ListQueuedRequestsRequestValidationError{
+			field:  "Status",
+			reason: "value must be in list [ queued dead]",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	// no validation rules for Limit
+
+	// no validation rules for Offset
+
+	if len(errors) > 0 {
+		return ListQueuedRequestsRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListQueuedRequestsRequestMultiError is an error wrapping multiple validation
+// errors returned by ListQueuedRequestsRequest.ValidateAll() if the
+// designated constraints aren't met.
+type ListQueuedRequestsRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListQueuedRequestsRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListQueuedRequestsRequestMultiError) AllErrors() []error { return m }
 
-	// no validation rules for TotalOutputEvents
+// ListQueuedRequestsRequestValidationError is the validation error returned by
+// ListQueuedRequestsRequest.Validate if the designated constraints aren't met.
+type ListQueuedRequestsRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
 
-	// no validation rules for TotalProcessorErrors
+// Field function returns field value.
+func (e ListQueuedRequestsRequestValidationError) Field() string { return e.field }
 
-	// no validation rules for ActiveWorkers
+// Reason function returns reason value.
+func (e ListQueuedRequestsRequestValidationError) Reason() string { return e.reason }
 
-	// no validation rules for TotalEvents
+// Cause function returns cause value.
+func (e ListQueuedRequestsRequestValidationError) Cause() error { return e.cause }
 
-	// no validation rules for ErrorEvents
+// Key function returns key value.
+func (e ListQueuedRequestsRequestValidationError) Key() bool { return e.key }
 
-	for idx, item := range m.GetEventsOverTime() {
-		_, _ = idx, item
+// ErrorName returns error name.
+func (e ListQueuedRequestsRequestValidationError) ErrorName() string {
+	return "ListQueuedRequestsRequestValidationError"
+}
 
-		if all {
-			switch v := interface{}(item).(type) {
-			case interface{ ValidateAll() error }:
-				if err := v.ValidateAll(); err != nil {
-					errors = append(errors, GetAnalyticsResponseValidationError{
-						field:  fmt.Sprintf("EventsOverTime[%v]", idx),
-						reason: "embedded message failed validation",
-						cause:  err,
-					})
-				}
-			case interface{ Validate() error }:
-				if err := v.Validate(); err != nil {
-					errors = append(errors, GetAnalyticsResponseValidationError{
-						field:  fmt.Sprintf("EventsOverTime[%v]", idx),
-						reason: "embedded message failed validation",
-						cause:  err,
-					})
-				}
-			}
-		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
-			if err := v.Validate(); err != nil {
-				return GetAnalyticsResponseValidationError{
-					field:  fmt.Sprintf("EventsOverTime[%v]", idx),
-					reason: "embedded message failed validation",
-					cause:  err,
-				}
-			}
-		}
+// Error satisfies the builtin error interface
+func (e ListQueuedRequestsRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
 
+	key := ""
+	if e.key {
+		key = "key for "
 	}
 
-	for idx, item := range m.GetTopInputComponents() {
-		_, _ = idx, item
+	return fmt.Sprintf(
+		"invalid %sListQueuedRequestsRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
 
-		if all {
-			switch v := interface{}(item).(type) {
-			case interface{ ValidateAll() error }:
-				if err := v.ValidateAll(); err != nil {
-					errors = append(errors, GetAnalyticsResponseValidationError{
-						field:  fmt.Sprintf("TopInputComponents[%v]", idx),
-						reason: "embedded message failed validation",
-						cause:  err,
-					})
-				}
-			case interface{ Validate() error }:
-				if err := v.Validate(); err != nil {
-					errors = append(errors, GetAnalyticsResponseValidationError{
-						field:  fmt.Sprintf("TopInputComponents[%v]", idx),
-						reason: "embedded message failed validation",
-						cause:  err,
-					})
-				}
-			}
-		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
-			if err := v.Validate(); err != nil {
-				return GetAnalyticsResponseValidationError{
-					field:  fmt.Sprintf("TopInputComponents[%v]", idx),
-					reason: "embedded message failed validation",
-					cause:  err,
-				}
-			}
-		}
+var _ error = ListQueuedRequestsRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListQueuedRequestsRequestValidationError{}
+
+var _ListQueuedRequestsRequest_Status_InLookup = map[string]struct{}{
+	"":       {},
+	"queued": {},
+	"dead":   {},
+}
+
+// Validate checks the field values on ListQueuedRequestsResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ListQueuedRequestsResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListQueuedRequestsResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ListQueuedRequestsResponseMultiError, or nil if none found.
+func (m *ListQueuedRequestsResponse) ValidateAll() error {
+	return m.validate(true)
+}
 
+func (m *ListQueuedRequestsResponse) validate(all bool) error {
+	if m == nil {
+		return nil
 	}
 
-	for idx, item := range m.GetTopOutputComponents() {
+	var errors []error
+
+	for idx, item := range m.GetData() {
 		_, _ = idx, item
 
 		if all {
 			switch v := interface{}(item).(type) {
 			case interface{ ValidateAll() error }:
 				if err := v.ValidateAll(); err != nil {
-					errors = append(errors, GetAnalyticsResponseValidationError{
-						field:  fmt.Sprintf("TopOutputComponents[%v]", idx),
+					errors = append(errors, ListQueuedRequestsResponseValidationError{
+						field:  fmt.Sprintf("Data[%v]", idx),
 						reason: "embedded message failed validation",
 						cause:  err,
 					})
 				}
 			case interface{ Validate() error }:
 				if err := v.Validate(); err != nil {
-					errors = append(errors, GetAnalyticsResponseValidationError{
-						field:  fmt.Sprintf("TopOutputComponents[%v]", idx),
+					errors = append(errors, ListQueuedRequestsResponseValidationError{
+						field:  fmt.Sprintf("Data[%v]", idx),
 						reason: "embedded message failed validation",
 						cause:  err,
 					})
@@ -2224,8 +8941,8 @@ func (m *GetAnalyticsResponse) validate(all bool) error {
 			}
 		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
 			if err := v.Validate(); err != nil {
-				return GetAnalyticsResponseValidationError{
-					field:  fmt.Sprintf("TopOutputComponents[%v]", idx),
+				return ListQueuedRequestsResponseValidationError{
+					field:  fmt.Sprintf("Data[%v]", idx),
 					reason: "embedded message failed validation",
 					cause:  err,
 				}
@@ -2234,20 +8951,22 @@ func (m *GetAnalyticsResponse) validate(all bool) error {
 
 	}
 
+	// no validation rules for Total
+
 	if len(errors) > 0 {
-		return GetAnalyticsResponseMultiError(errors)
+		return ListQueuedRequestsResponseMultiError(errors)
 	}
 
 	return nil
 }
 
-// GetAnalyticsResponseMultiError is an error wrapping multiple validation
-// errors returned by GetAnalyticsResponse.ValidateAll() if the designated
-// constraints aren't met.
-type GetAnalyticsResponseMultiError []error
+// ListQueuedRequestsResponseMultiError is an error wrapping multiple
+// validation errors returned by ListQueuedRequestsResponse.ValidateAll() if
+// the designated constraints aren't met.
+type ListQueuedRequestsResponseMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m GetAnalyticsResponseMultiError) Error() string {
+func (m ListQueuedRequestsResponseMultiError) Error() string {
 	msgs := make([]string, 0, len(m))
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -2256,11 +8975,11 @@ func (m GetAnalyticsResponseMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m GetAnalyticsResponseMultiError) AllErrors() []error { return m }
+func (m ListQueuedRequestsResponseMultiError) AllErrors() []error { return m }
 
-// GetAnalyticsResponseValidationError is the validation error returned by
-// GetAnalyticsResponse.Validate if the designated constraints aren't met.
-type GetAnalyticsResponseValidationError struct {
+// ListQueuedRequestsResponseValidationError is the validation error returned
+// by ListQueuedRequestsResponse.Validate if the designated constraints aren't met.
+type ListQueuedRequestsResponseValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -2268,24 +8987,24 @@ type GetAnalyticsResponseValidationError struct {
 }
 
 // Field function returns field value.
-func (e GetAnalyticsResponseValidationError) Field() string { return e.field }
+func (e ListQueuedRequestsResponseValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e GetAnalyticsResponseValidationError) Reason() string { return e.reason }
+func (e ListQueuedRequestsResponseValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e GetAnalyticsResponseValidationError) Cause() error { return e.cause }
+func (e ListQueuedRequestsResponseValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e GetAnalyticsResponseValidationError) Key() bool { return e.key }
+func (e ListQueuedRequestsResponseValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e GetAnalyticsResponseValidationError) ErrorName() string {
-	return "GetAnalyticsResponseValidationError"
+func (e ListQueuedRequestsResponseValidationError) ErrorName() string {
+	return "ListQueuedRequestsResponseValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e GetAnalyticsResponseValidationError) Error() string {
+func (e ListQueuedRequestsResponseValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -2297,14 +9016,14 @@ func (e GetAnalyticsResponseValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sGetAnalyticsResponse.%s: %s%s",
+		"invalid %sListQueuedRequestsResponse.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = GetAnalyticsResponseValidationError{}
+var _ error = ListQueuedRequestsResponseValidationError{}
 
 var _ interface {
 	Field() string
@@ -2312,48 +9031,55 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = GetAnalyticsResponseValidationError{}
+} = ListQueuedRequestsResponseValidationError{}
 
-// Validate checks the field values on SecretRequest with the rules defined in
-// the proto definition for this message. If any rules are violated, the first
-// error encountered is returned, or nil if there are no violations.
-func (m *SecretRequest) Validate() error {
+// Validate checks the field values on QueuedRequestIdRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *QueuedRequestIdRequest) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on SecretRequest with the rules defined
-// in the proto definition for this message. If any rules are violated, the
-// result is a list of violation errors wrapped in SecretRequestMultiError, or
-// nil if none found.
-func (m *SecretRequest) ValidateAll() error {
+// ValidateAll checks the field values on QueuedRequestIdRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// QueuedRequestIdRequestMultiError, or nil if none found.
+func (m *QueuedRequestIdRequest) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *SecretRequest) validate(all bool) error {
+func (m *QueuedRequestIdRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for Key
-
-	// no validation rules for Value
+	if m.GetId() <= 0 {
+		err := QueuedRequestIdRequestValidationError{
+			field:  "Id",
+			reason: "value must be greater than 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
 
 	if len(errors) > 0 {
-		return SecretRequestMultiError(errors)
+		return QueuedRequestIdRequestMultiError(errors)
 	}
 
 	return nil
 }
 
-// SecretRequestMultiError is an error wrapping multiple validation errors
-// returned by SecretRequest.ValidateAll() if the designated constraints
-// aren't met.
-type SecretRequestMultiError []error
+// QueuedRequestIdRequestMultiError is an error wrapping multiple validation
+// errors returned by QueuedRequestIdRequest.ValidateAll() if the designated
+// constraints aren't met.
+type QueuedRequestIdRequestMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m SecretRequestMultiError) Error() string {
+func (m QueuedRequestIdRequestMultiError) Error() string {
 	msgs := make([]string, 0, len(m))
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -2362,11 +9088,11 @@ func (m SecretRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m SecretRequestMultiError) AllErrors() []error { return m }
+func (m QueuedRequestIdRequestMultiError) AllErrors() []error { return m }
 
-// SecretRequestValidationError is the validation error returned by
-// SecretRequest.Validate if the designated constraints aren't met.
-type SecretRequestValidationError struct {
+// QueuedRequestIdRequestValidationError is the validation error returned by
+// QueuedRequestIdRequest.Validate if the designated constraints aren't met.
+type QueuedRequestIdRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -2374,22 +9100,24 @@ type SecretRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e SecretRequestValidationError) Field() string { return e.field }
+func (e QueuedRequestIdRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e SecretRequestValidationError) Reason() string { return e.reason }
+func (e QueuedRequestIdRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e SecretRequestValidationError) Cause() error { return e.cause }
+func (e QueuedRequestIdRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e SecretRequestValidationError) Key() bool { return e.key }
+func (e QueuedRequestIdRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e SecretRequestValidationError) ErrorName() string { return "SecretRequestValidationError" }
+func (e QueuedRequestIdRequestValidationError) ErrorName() string {
+	return "QueuedRequestIdRequestValidationError"
+}
 
 // Error satisfies the builtin error interface
-func (e SecretRequestValidationError) Error() string {
+func (e QueuedRequestIdRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -2401,14 +9129,14 @@ func (e SecretRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sSecretRequest.%s: %s%s",
+		"invalid %sQueuedRequestIdRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = SecretRequestValidationError{}
+var _ error = QueuedRequestIdRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -2416,78 +9144,55 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = SecretRequestValidationError{}
+} = QueuedRequestIdRequestValidationError{}
 
-// Validate checks the field values on ListSecretsResponse with the rules
+// Validate checks the field values on GetAlertRuleRequest with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *ListSecretsResponse) Validate() error {
+func (m *GetAlertRuleRequest) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on ListSecretsResponse with the rules
+// ValidateAll checks the field values on GetAlertRuleRequest with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// ListSecretsResponseMultiError, or nil if none found.
-func (m *ListSecretsResponse) ValidateAll() error {
+// GetAlertRuleRequestMultiError, or nil if none found.
+func (m *GetAlertRuleRequest) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *ListSecretsResponse) validate(all bool) error {
+func (m *GetAlertRuleRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	for idx, item := range m.GetData() {
-		_, _ = idx, item
-
-		if all {
-			switch v := interface{}(item).(type) {
-			case interface{ ValidateAll() error }:
-				if err := v.ValidateAll(); err != nil {
-					errors = append(errors, ListSecretsResponseValidationError{
-						field:  fmt.Sprintf("Data[%v]", idx),
-						reason: "embedded message failed validation",
-						cause:  err,
-					})
-				}
-			case interface{ Validate() error }:
-				if err := v.Validate(); err != nil {
-					errors = append(errors, ListSecretsResponseValidationError{
-						field:  fmt.Sprintf("Data[%v]", idx),
-						reason: "embedded message failed validation",
-						cause:  err,
-					})
-				}
-			}
-		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
-			if err := v.Validate(); err != nil {
-				return ListSecretsResponseValidationError{
-					field:  fmt.Sprintf("Data[%v]", idx),
-					reason: "embedded message failed validation",
-					cause:  err,
-				}
-			}
+	if m.GetId() <= 0 {
+		err := GetAlertRuleRequestValidationError{
+			field:  "Id",
+			reason: "value must be greater than 0",
 		}
-
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
 	}
 
 	if len(errors) > 0 {
-		return ListSecretsResponseMultiError(errors)
+		return GetAlertRuleRequestMultiError(errors)
 	}
 
 	return nil
 }
 
-// ListSecretsResponseMultiError is an error wrapping multiple validation
-// errors returned by ListSecretsResponse.ValidateAll() if the designated
+// GetAlertRuleRequestMultiError is an error wrapping multiple validation
+// errors returned by GetAlertRuleRequest.ValidateAll() if the designated
 // constraints aren't met.
-type ListSecretsResponseMultiError []error
+type GetAlertRuleRequestMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m ListSecretsResponseMultiError) Error() string {
+func (m GetAlertRuleRequestMultiError) Error() string {
 	msgs := make([]string, 0, len(m))
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -2496,11 +9201,11 @@ func (m ListSecretsResponseMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m ListSecretsResponseMultiError) AllErrors() []error { return m }
+func (m GetAlertRuleRequestMultiError) AllErrors() []error { return m }
 
-// ListSecretsResponseValidationError is the validation error returned by
-// ListSecretsResponse.Validate if the designated constraints aren't met.
-type ListSecretsResponseValidationError struct {
+// GetAlertRuleRequestValidationError is the validation error returned by
+// GetAlertRuleRequest.Validate if the designated constraints aren't met.
+type GetAlertRuleRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -2508,24 +9213,24 @@ type ListSecretsResponseValidationError struct {
 }
 
 // Field function returns field value.
-func (e ListSecretsResponseValidationError) Field() string { return e.field }
+func (e GetAlertRuleRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e ListSecretsResponseValidationError) Reason() string { return e.reason }
+func (e GetAlertRuleRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e ListSecretsResponseValidationError) Cause() error { return e.cause }
+func (e GetAlertRuleRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e ListSecretsResponseValidationError) Key() bool { return e.key }
+func (e GetAlertRuleRequestValidationError) Key() bool { return e.key }
 
-// ErrorName returns error name.
-func (e ListSecretsResponseValidationError) ErrorName() string {
-	return "ListSecretsResponseValidationError"
+// ErrorName returns error name.
+func (e GetAlertRuleRequestValidationError) ErrorName() string {
+	return "GetAlertRuleRequestValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e ListSecretsResponseValidationError) Error() string {
+func (e GetAlertRuleRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -2537,14 +9242,14 @@ func (e ListSecretsResponseValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sListSecretsResponse.%s: %s%s",
+		"invalid %sGetAlertRuleRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = ListSecretsResponseValidationError{}
+var _ error = GetAlertRuleRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -2552,24 +9257,24 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = ListSecretsResponseValidationError{}
+} = GetAlertRuleRequestValidationError{}
 
-// Validate checks the field values on SecretResponse with the rules defined in
-// the proto definition for this message. If any rules are violated, the first
-// error encountered is returned, or nil if there are no violations.
-func (m *SecretResponse) Validate() error {
+// Validate checks the field values on AlertRuleResponse with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *AlertRuleResponse) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on SecretResponse with the rules defined
-// in the proto definition for this message. If any rules are violated, the
-// result is a list of violation errors wrapped in SecretResponseMultiError,
-// or nil if none found.
-func (m *SecretResponse) ValidateAll() error {
+// ValidateAll checks the field values on AlertRuleResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// AlertRuleResponseMultiError, or nil if none found.
+func (m *AlertRuleResponse) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *SecretResponse) validate(all bool) error {
+func (m *AlertRuleResponse) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
@@ -2580,7 +9285,7 @@ func (m *SecretResponse) validate(all bool) error {
 		switch v := interface{}(m.GetData()).(type) {
 		case interface{ ValidateAll() error }:
 			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, SecretResponseValidationError{
+				errors = append(errors, AlertRuleResponseValidationError{
 					field:  "Data",
 					reason: "embedded message failed validation",
 					cause:  err,
@@ -2588,7 +9293,7 @@ func (m *SecretResponse) validate(all bool) error {
 			}
 		case interface{ Validate() error }:
 			if err := v.Validate(); err != nil {
-				errors = append(errors, SecretResponseValidationError{
+				errors = append(errors, AlertRuleResponseValidationError{
 					field:  "Data",
 					reason: "embedded message failed validation",
 					cause:  err,
@@ -2597,7 +9302,7 @@ func (m *SecretResponse) validate(all bool) error {
 		}
 	} else if v, ok := interface{}(m.GetData()).(interface{ Validate() error }); ok {
 		if err := v.Validate(); err != nil {
-			return SecretResponseValidationError{
+			return AlertRuleResponseValidationError{
 				field:  "Data",
 				reason: "embedded message failed validation",
 				cause:  err,
@@ -2609,7 +9314,7 @@ func (m *SecretResponse) validate(all bool) error {
 		switch v := interface{}(m.GetMeta()).(type) {
 		case interface{ ValidateAll() error }:
 			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, SecretResponseValidationError{
+				errors = append(errors, AlertRuleResponseValidationError{
 					field:  "Meta",
 					reason: "embedded message failed validation",
 					cause:  err,
@@ -2617,7 +9322,7 @@ func (m *SecretResponse) validate(all bool) error {
 			}
 		case interface{ Validate() error }:
 			if err := v.Validate(); err != nil {
-				errors = append(errors, SecretResponseValidationError{
+				errors = append(errors, AlertRuleResponseValidationError{
 					field:  "Meta",
 					reason: "embedded message failed validation",
 					cause:  err,
@@ -2626,7 +9331,7 @@ func (m *SecretResponse) validate(all bool) error {
 		}
 	} else if v, ok := interface{}(m.GetMeta()).(interface{ Validate() error }); ok {
 		if err := v.Validate(); err != nil {
-			return SecretResponseValidationError{
+			return AlertRuleResponseValidationError{
 				field:  "Meta",
 				reason: "embedded message failed validation",
 				cause:  err,
@@ -2635,19 +9340,19 @@ func (m *SecretResponse) validate(all bool) error {
 	}
 
 	if len(errors) > 0 {
-		return SecretResponseMultiError(errors)
+		return AlertRuleResponseMultiError(errors)
 	}
 
 	return nil
 }
 
-// SecretResponseMultiError is an error wrapping multiple validation errors
-// returned by SecretResponse.ValidateAll() if the designated constraints
+// AlertRuleResponseMultiError is an error wrapping multiple validation errors
+// returned by AlertRuleResponse.ValidateAll() if the designated constraints
 // aren't met.
-type SecretResponseMultiError []error
+type AlertRuleResponseMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m SecretResponseMultiError) Error() string {
+func (m AlertRuleResponseMultiError) Error() string {
 	msgs := make([]string, 0, len(m))
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -2656,11 +9361,11 @@ func (m SecretResponseMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m SecretResponseMultiError) AllErrors() []error { return m }
+func (m AlertRuleResponseMultiError) AllErrors() []error { return m }
 
-// SecretResponseValidationError is the validation error returned by
-// SecretResponse.Validate if the designated constraints aren't met.
-type SecretResponseValidationError struct {
+// AlertRuleResponseValidationError is the validation error returned by
+// AlertRuleResponse.Validate if the designated constraints aren't met.
+type AlertRuleResponseValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -2668,22 +9373,24 @@ type SecretResponseValidationError struct {
 }
 
 // Field function returns field value.
-func (e SecretResponseValidationError) Field() string { return e.field }
+func (e AlertRuleResponseValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e SecretResponseValidationError) Reason() string { return e.reason }
+func (e AlertRuleResponseValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e SecretResponseValidationError) Cause() error { return e.cause }
+func (e AlertRuleResponseValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e SecretResponseValidationError) Key() bool { return e.key }
+func (e AlertRuleResponseValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e SecretResponseValidationError) ErrorName() string { return "SecretResponseValidationError" }
+func (e AlertRuleResponseValidationError) ErrorName() string {
+	return "AlertRuleResponseValidationError"
+}
 
 // Error satisfies the builtin error interface
-func (e SecretResponseValidationError) Error() string {
+func (e AlertRuleResponseValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -2695,14 +9402,14 @@ func (e SecretResponseValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sSecretResponse.%s: %s%s",
+		"invalid %sAlertRuleResponse.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = SecretResponseValidationError{}
+var _ error = AlertRuleResponseValidationError{}
 
 var _ interface {
 	Field() string
@@ -2710,24 +9417,24 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = SecretResponseValidationError{}
+} = AlertRuleResponseValidationError{}
 
-// Validate checks the field values on ListCachesResponse with the rules
+// Validate checks the field values on ListAlertRulesResponse with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *ListCachesResponse) Validate() error {
+func (m *ListAlertRulesResponse) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on ListCachesResponse with the rules
+// ValidateAll checks the field values on ListAlertRulesResponse with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// ListCachesResponseMultiError, or nil if none found.
-func (m *ListCachesResponse) ValidateAll() error {
+// ListAlertRulesResponseMultiError, or nil if none found.
+func (m *ListAlertRulesResponse) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *ListCachesResponse) validate(all bool) error {
+func (m *ListAlertRulesResponse) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
@@ -2741,7 +9448,7 @@ func (m *ListCachesResponse) validate(all bool) error {
 			switch v := interface{}(item).(type) {
 			case interface{ ValidateAll() error }:
 				if err := v.ValidateAll(); err != nil {
-					errors = append(errors, ListCachesResponseValidationError{
+					errors = append(errors, ListAlertRulesResponseValidationError{
 						field:  fmt.Sprintf("Data[%v]", idx),
 						reason: "embedded message failed validation",
 						cause:  err,
@@ -2749,7 +9456,7 @@ func (m *ListCachesResponse) validate(all bool) error {
 				}
 			case interface{ Validate() error }:
 				if err := v.Validate(); err != nil {
-					errors = append(errors, ListCachesResponseValidationError{
+					errors = append(errors, ListAlertRulesResponseValidationError{
 						field:  fmt.Sprintf("Data[%v]", idx),
 						reason: "embedded message failed validation",
 						cause:  err,
@@ -2758,7 +9465,7 @@ func (m *ListCachesResponse) validate(all bool) error {
 			}
 		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
 			if err := v.Validate(); err != nil {
-				return ListCachesResponseValidationError{
+				return ListAlertRulesResponseValidationError{
 					field:  fmt.Sprintf("Data[%v]", idx),
 					reason: "embedded message failed validation",
 					cause:  err,
@@ -2769,19 +9476,19 @@ func (m *ListCachesResponse) validate(all bool) error {
 	}
 
 	if len(errors) > 0 {
-		return ListCachesResponseMultiError(errors)
+		return ListAlertRulesResponseMultiError(errors)
 	}
 
 	return nil
 }
 
-// ListCachesResponseMultiError is an error wrapping multiple validation errors
-// returned by ListCachesResponse.ValidateAll() if the designated constraints
-// aren't met.
-type ListCachesResponseMultiError []error
+// ListAlertRulesResponseMultiError is an error wrapping multiple validation
+// errors returned by ListAlertRulesResponse.ValidateAll() if the designated
+// constraints aren't met.
+type ListAlertRulesResponseMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m ListCachesResponseMultiError) Error() string {
+func (m ListAlertRulesResponseMultiError) Error() string {
 	msgs := make([]string, 0, len(m))
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -2790,11 +9497,11 @@ func (m ListCachesResponseMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m ListCachesResponseMultiError) AllErrors() []error { return m }
+func (m ListAlertRulesResponseMultiError) AllErrors() []error { return m }
 
-// ListCachesResponseValidationError is the validation error returned by
-// ListCachesResponse.Validate if the designated constraints aren't met.
-type ListCachesResponseValidationError struct {
+// ListAlertRulesResponseValidationError is the validation error returned by
+// ListAlertRulesResponse.Validate if the designated constraints aren't met.
+type ListAlertRulesResponseValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -2802,24 +9509,24 @@ type ListCachesResponseValidationError struct {
 }
 
 // Field function returns field value.
-func (e ListCachesResponseValidationError) Field() string { return e.field }
+func (e ListAlertRulesResponseValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e ListCachesResponseValidationError) Reason() string { return e.reason }
+func (e ListAlertRulesResponseValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e ListCachesResponseValidationError) Cause() error { return e.cause }
+func (e ListAlertRulesResponseValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e ListCachesResponseValidationError) Key() bool { return e.key }
+func (e ListAlertRulesResponseValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e ListCachesResponseValidationError) ErrorName() string {
-	return "ListCachesResponseValidationError"
+func (e ListAlertRulesResponseValidationError) ErrorName() string {
+	return "ListAlertRulesResponseValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e ListCachesResponseValidationError) Error() string {
+func (e ListAlertRulesResponseValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -2831,14 +9538,14 @@ func (e ListCachesResponseValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sListCachesResponse.%s: %s%s",
+		"invalid %sListAlertRulesResponse.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = ListCachesResponseValidationError{}
+var _ error = ListAlertRulesResponseValidationError{}
 
 var _ interface {
 	Field() string
@@ -2846,24 +9553,24 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = ListCachesResponseValidationError{}
+} = ListAlertRulesResponseValidationError{}
 
-// Validate checks the field values on GetCacheRequest with the rules defined
-// in the proto definition for this message. If any rules are violated, the
-// first error encountered is returned, or nil if there are no violations.
-func (m *GetCacheRequest) Validate() error {
+// Validate checks the field values on GetAlertChannelRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GetAlertChannelRequest) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on GetCacheRequest with the rules
+// ValidateAll checks the field values on GetAlertChannelRequest with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// GetCacheRequestMultiError, or nil if none found.
-func (m *GetCacheRequest) ValidateAll() error {
+// GetAlertChannelRequestMultiError, or nil if none found.
+func (m *GetAlertChannelRequest) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *GetCacheRequest) validate(all bool) error {
+func (m *GetAlertChannelRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
@@ -2871,7 +9578,7 @@ func (m *GetCacheRequest) validate(all bool) error {
 	var errors []error
 
 	if m.GetId() <= 0 {
-		err := GetCacheRequestValidationError{
+		err := GetAlertChannelRequestValidationError{
 			field:  "Id",
 			reason: "value must be greater than 0",
 		}
@@ -2882,19 +9589,19 @@ func (m *GetCacheRequest) validate(all bool) error {
 	}
 
 	if len(errors) > 0 {
-		return GetCacheRequestMultiError(errors)
+		return GetAlertChannelRequestMultiError(errors)
 	}
 
 	return nil
 }
 
-// GetCacheRequestMultiError is an error wrapping multiple validation errors
-// returned by GetCacheRequest.ValidateAll() if the designated constraints
-// aren't met.
-type GetCacheRequestMultiError []error
+// GetAlertChannelRequestMultiError is an error wrapping multiple validation
+// errors returned by GetAlertChannelRequest.ValidateAll() if the designated
+// constraints aren't met.
+type GetAlertChannelRequestMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m GetCacheRequestMultiError) Error() string {
+func (m GetAlertChannelRequestMultiError) Error() string {
 	msgs := make([]string, 0, len(m))
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -2903,11 +9610,11 @@ func (m GetCacheRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m GetCacheRequestMultiError) AllErrors() []error { return m }
+func (m GetAlertChannelRequestMultiError) AllErrors() []error { return m }
 
-// GetCacheRequestValidationError is the validation error returned by
-// GetCacheRequest.Validate if the designated constraints aren't met.
-type GetCacheRequestValidationError struct {
+// GetAlertChannelRequestValidationError is the validation error returned by
+// GetAlertChannelRequest.Validate if the designated constraints aren't met.
+type GetAlertChannelRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -2915,22 +9622,24 @@ type GetCacheRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e GetCacheRequestValidationError) Field() string { return e.field }
+func (e GetAlertChannelRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e GetCacheRequestValidationError) Reason() string { return e.reason }
+func (e GetAlertChannelRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e GetCacheRequestValidationError) Cause() error { return e.cause }
+func (e GetAlertChannelRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e GetCacheRequestValidationError) Key() bool { return e.key }
+func (e GetAlertChannelRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e GetCacheRequestValidationError) ErrorName() string { return "GetCacheRequestValidationError" }
+func (e GetAlertChannelRequestValidationError) ErrorName() string {
+	return "GetAlertChannelRequestValidationError"
+}
 
 // Error satisfies the builtin error interface
-func (e GetCacheRequestValidationError) Error() string {
+func (e GetAlertChannelRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -2942,14 +9651,14 @@ func (e GetCacheRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sGetCacheRequest.%s: %s%s",
+		"invalid %sGetAlertChannelRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = GetCacheRequestValidationError{}
+var _ error = GetAlertChannelRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -2957,24 +9666,24 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = GetCacheRequestValidationError{}
+} = GetAlertChannelRequestValidationError{}
 
-// Validate checks the field values on CacheResponse with the rules defined in
-// the proto definition for this message. If any rules are violated, the first
-// error encountered is returned, or nil if there are no violations.
-func (m *CacheResponse) Validate() error {
+// Validate checks the field values on AlertChannelResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *AlertChannelResponse) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on CacheResponse with the rules defined
-// in the proto definition for this message. If any rules are violated, the
-// result is a list of violation errors wrapped in CacheResponseMultiError, or
-// nil if none found.
-func (m *CacheResponse) ValidateAll() error {
+// ValidateAll checks the field values on AlertChannelResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// AlertChannelResponseMultiError, or nil if none found.
+func (m *AlertChannelResponse) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *CacheResponse) validate(all bool) error {
+func (m *AlertChannelResponse) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
@@ -2985,7 +9694,7 @@ func (m *CacheResponse) validate(all bool) error {
 		switch v := interface{}(m.GetData()).(type) {
 		case interface{ ValidateAll() error }:
 			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, CacheResponseValidationError{
+				errors = append(errors, AlertChannelResponseValidationError{
 					field:  "Data",
 					reason: "embedded message failed validation",
 					cause:  err,
@@ -2993,7 +9702,7 @@ func (m *CacheResponse) validate(all bool) error {
 			}
 		case interface{ Validate() error }:
 			if err := v.Validate(); err != nil {
-				errors = append(errors, CacheResponseValidationError{
+				errors = append(errors, AlertChannelResponseValidationError{
 					field:  "Data",
 					reason: "embedded message failed validation",
 					cause:  err,
@@ -3002,7 +9711,7 @@ func (m *CacheResponse) validate(all bool) error {
 		}
 	} else if v, ok := interface{}(m.GetData()).(interface{ Validate() error }); ok {
 		if err := v.Validate(); err != nil {
-			return CacheResponseValidationError{
+			return AlertChannelResponseValidationError{
 				field:  "Data",
 				reason: "embedded message failed validation",
 				cause:  err,
@@ -3014,7 +9723,7 @@ func (m *CacheResponse) validate(all bool) error {
 		switch v := interface{}(m.GetMeta()).(type) {
 		case interface{ ValidateAll() error }:
 			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, CacheResponseValidationError{
+				errors = append(errors, AlertChannelResponseValidationError{
 					field:  "Meta",
 					reason: "embedded message failed validation",
 					cause:  err,
@@ -3022,7 +9731,7 @@ func (m *CacheResponse) validate(all bool) error {
 			}
 		case interface{ Validate() error }:
 			if err := v.Validate(); err != nil {
-				errors = append(errors, CacheResponseValidationError{
+				errors = append(errors, AlertChannelResponseValidationError{
 					field:  "Meta",
 					reason: "embedded message failed validation",
 					cause:  err,
@@ -3031,7 +9740,7 @@ func (m *CacheResponse) validate(all bool) error {
 		}
 	} else if v, ok := interface{}(m.GetMeta()).(interface{ Validate() error }); ok {
 		if err := v.Validate(); err != nil {
-			return CacheResponseValidationError{
+			return AlertChannelResponseValidationError{
 				field:  "Meta",
 				reason: "embedded message failed validation",
 				cause:  err,
@@ -3040,19 +9749,19 @@ func (m *CacheResponse) validate(all bool) error {
 	}
 
 	if len(errors) > 0 {
-		return CacheResponseMultiError(errors)
+		return AlertChannelResponseMultiError(errors)
 	}
 
 	return nil
 }
 
-// CacheResponseMultiError is an error wrapping multiple validation errors
-// returned by CacheResponse.ValidateAll() if the designated constraints
-// aren't met.
-type CacheResponseMultiError []error
+// AlertChannelResponseMultiError is an error wrapping multiple validation
+// errors returned by AlertChannelResponse.ValidateAll() if the designated
+// constraints aren't met.
+type AlertChannelResponseMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m CacheResponseMultiError) Error() string {
+func (m AlertChannelResponseMultiError) Error() string {
 	msgs := make([]string, 0, len(m))
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -3061,11 +9770,11 @@ func (m CacheResponseMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m CacheResponseMultiError) AllErrors() []error { return m }
+func (m AlertChannelResponseMultiError) AllErrors() []error { return m }
 
-// CacheResponseValidationError is the validation error returned by
-// CacheResponse.Validate if the designated constraints aren't met.
-type CacheResponseValidationError struct {
+// AlertChannelResponseValidationError is the validation error returned by
+// AlertChannelResponse.Validate if the designated constraints aren't met.
+type AlertChannelResponseValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -3073,22 +9782,24 @@ type CacheResponseValidationError struct {
 }
 
 // Field function returns field value.
-func (e CacheResponseValidationError) Field() string { return e.field }
+func (e AlertChannelResponseValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e CacheResponseValidationError) Reason() string { return e.reason }
+func (e AlertChannelResponseValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e CacheResponseValidationError) Cause() error { return e.cause }
+func (e AlertChannelResponseValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e CacheResponseValidationError) Key() bool { return e.key }
+func (e AlertChannelResponseValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e CacheResponseValidationError) ErrorName() string { return "CacheResponseValidationError" }
+func (e AlertChannelResponseValidationError) ErrorName() string {
+	return "AlertChannelResponseValidationError"
+}
 
 // Error satisfies the builtin error interface
-func (e CacheResponseValidationError) Error() string {
+func (e AlertChannelResponseValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -3100,14 +9811,14 @@ func (e CacheResponseValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sCacheResponse.%s: %s%s",
+		"invalid %sAlertChannelResponse.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = CacheResponseValidationError{}
+var _ error = AlertChannelResponseValidationError{}
 
 var _ interface {
 	Field() string
@@ -3115,24 +9826,24 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = CacheResponseValidationError{}
+} = AlertChannelResponseValidationError{}
 
-// Validate checks the field values on ListRateLimitsResponse with the rules
+// Validate checks the field values on ListAlertChannelsResponse with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *ListRateLimitsResponse) Validate() error {
+func (m *ListAlertChannelsResponse) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on ListRateLimitsResponse with the rules
-// defined in the proto definition for this message. If any rules are
+// ValidateAll checks the field values on ListAlertChannelsResponse with the
+// rules defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// ListRateLimitsResponseMultiError, or nil if none found.
-func (m *ListRateLimitsResponse) ValidateAll() error {
+// ListAlertChannelsResponseMultiError, or nil if none found.
+func (m *ListAlertChannelsResponse) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *ListRateLimitsResponse) validate(all bool) error {
+func (m *ListAlertChannelsResponse) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
@@ -3146,7 +9857,7 @@ func (m *ListRateLimitsResponse) validate(all bool) error {
 			switch v := interface{}(item).(type) {
 			case interface{ ValidateAll() error }:
 				if err := v.ValidateAll(); err != nil {
-					errors = append(errors, ListRateLimitsResponseValidationError{
+					errors = append(errors, ListAlertChannelsResponseValidationError{
 						field:  fmt.Sprintf("Data[%v]", idx),
 						reason: "embedded message failed validation",
 						cause:  err,
@@ -3154,7 +9865,7 @@ func (m *ListRateLimitsResponse) validate(all bool) error {
 				}
 			case interface{ Validate() error }:
 				if err := v.Validate(); err != nil {
-					errors = append(errors, ListRateLimitsResponseValidationError{
+					errors = append(errors, ListAlertChannelsResponseValidationError{
 						field:  fmt.Sprintf("Data[%v]", idx),
 						reason: "embedded message failed validation",
 						cause:  err,
@@ -3163,7 +9874,7 @@ func (m *ListRateLimitsResponse) validate(all bool) error {
 			}
 		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
 			if err := v.Validate(); err != nil {
-				return ListRateLimitsResponseValidationError{
+				return ListAlertChannelsResponseValidationError{
 					field:  fmt.Sprintf("Data[%v]", idx),
 					reason: "embedded message failed validation",
 					cause:  err,
@@ -3174,19 +9885,19 @@ func (m *ListRateLimitsResponse) validate(all bool) error {
 	}
 
 	if len(errors) > 0 {
-		return ListRateLimitsResponseMultiError(errors)
+		return ListAlertChannelsResponseMultiError(errors)
 	}
 
 	return nil
 }
 
-// ListRateLimitsResponseMultiError is an error wrapping multiple validation
-// errors returned by ListRateLimitsResponse.ValidateAll() if the designated
-// constraints aren't met.
-type ListRateLimitsResponseMultiError []error
+// ListAlertChannelsResponseMultiError is an error wrapping multiple validation
+// errors returned by ListAlertChannelsResponse.ValidateAll() if the
+// designated constraints aren't met.
+type ListAlertChannelsResponseMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m ListRateLimitsResponseMultiError) Error() string {
+func (m ListAlertChannelsResponseMultiError) Error() string {
 	msgs := make([]string, 0, len(m))
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -3195,11 +9906,11 @@ func (m ListRateLimitsResponseMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m ListRateLimitsResponseMultiError) AllErrors() []error { return m }
+func (m ListAlertChannelsResponseMultiError) AllErrors() []error { return m }
 
-// ListRateLimitsResponseValidationError is the validation error returned by
-// ListRateLimitsResponse.Validate if the designated constraints aren't met.
-type ListRateLimitsResponseValidationError struct {
+// ListAlertChannelsResponseValidationError is the validation error returned by
+// ListAlertChannelsResponse.Validate if the designated constraints aren't met.
+type ListAlertChannelsResponseValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -3207,24 +9918,24 @@ type ListRateLimitsResponseValidationError struct {
 }
 
 // Field function returns field value.
-func (e ListRateLimitsResponseValidationError) Field() string { return e.field }
+func (e ListAlertChannelsResponseValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e ListRateLimitsResponseValidationError) Reason() string { return e.reason }
+func (e ListAlertChannelsResponseValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e ListRateLimitsResponseValidationError) Cause() error { return e.cause }
+func (e ListAlertChannelsResponseValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e ListRateLimitsResponseValidationError) Key() bool { return e.key }
+func (e ListAlertChannelsResponseValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e ListRateLimitsResponseValidationError) ErrorName() string {
-	return "ListRateLimitsResponseValidationError"
+func (e ListAlertChannelsResponseValidationError) ErrorName() string {
+	return "ListAlertChannelsResponseValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e ListRateLimitsResponseValidationError) Error() string {
+func (e ListAlertChannelsResponseValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -3236,14 +9947,14 @@ func (e ListRateLimitsResponseValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sListRateLimitsResponse.%s: %s%s",
+		"invalid %sListAlertChannelsResponse.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = ListRateLimitsResponseValidationError{}
+var _ error = ListAlertChannelsResponseValidationError{}
 
 var _ interface {
 	Field() string
@@ -3251,24 +9962,24 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = ListRateLimitsResponseValidationError{}
+} = ListAlertChannelsResponseValidationError{}
 
-// Validate checks the field values on GetBufferRequest with the rules defined
-// in the proto definition for this message. If any rules are violated, the
-// first error encountered is returned, or nil if there are no violations.
-func (m *GetBufferRequest) Validate() error {
+// Validate checks the field values on GetAlertSilenceRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GetAlertSilenceRequest) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on GetBufferRequest with the rules
+// ValidateAll checks the field values on GetAlertSilenceRequest with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// GetBufferRequestMultiError, or nil if none found.
-func (m *GetBufferRequest) ValidateAll() error {
+// GetAlertSilenceRequestMultiError, or nil if none found.
+func (m *GetAlertSilenceRequest) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *GetBufferRequest) validate(all bool) error {
+func (m *GetAlertSilenceRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
@@ -3276,7 +9987,7 @@ func (m *GetBufferRequest) validate(all bool) error {
 	var errors []error
 
 	if m.GetId() <= 0 {
-		err := GetBufferRequestValidationError{
+		err := GetAlertSilenceRequestValidationError{
 			field:  "Id",
 			reason: "value must be greater than 0",
 		}
@@ -3287,19 +9998,19 @@ func (m *GetBufferRequest) validate(all bool) error {
 	}
 
 	if len(errors) > 0 {
-		return GetBufferRequestMultiError(errors)
+		return GetAlertSilenceRequestMultiError(errors)
 	}
 
 	return nil
 }
 
-// GetBufferRequestMultiError is an error wrapping multiple validation errors
-// returned by GetBufferRequest.ValidateAll() if the designated constraints
-// aren't met.
-type GetBufferRequestMultiError []error
+// GetAlertSilenceRequestMultiError is an error wrapping multiple validation
+// errors returned by GetAlertSilenceRequest.ValidateAll() if the designated
+// constraints aren't met.
+type GetAlertSilenceRequestMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m GetBufferRequestMultiError) Error() string {
+func (m GetAlertSilenceRequestMultiError) Error() string {
 	msgs := make([]string, 0, len(m))
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -3308,11 +10019,11 @@ func (m GetBufferRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m GetBufferRequestMultiError) AllErrors() []error { return m }
+func (m GetAlertSilenceRequestMultiError) AllErrors() []error { return m }
 
-// GetBufferRequestValidationError is the validation error returned by
-// GetBufferRequest.Validate if the designated constraints aren't met.
-type GetBufferRequestValidationError struct {
+// GetAlertSilenceRequestValidationError is the validation error returned by
+// GetAlertSilenceRequest.Validate if the designated constraints aren't met.
+type GetAlertSilenceRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -3320,22 +10031,24 @@ type GetBufferRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e GetBufferRequestValidationError) Field() string { return e.field }
+func (e GetAlertSilenceRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e GetBufferRequestValidationError) Reason() string { return e.reason }
+func (e GetAlertSilenceRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e GetBufferRequestValidationError) Cause() error { return e.cause }
+func (e GetAlertSilenceRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e GetBufferRequestValidationError) Key() bool { return e.key }
+func (e GetAlertSilenceRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e GetBufferRequestValidationError) ErrorName() string { return "GetBufferRequestValidationError" }
+func (e GetAlertSilenceRequestValidationError) ErrorName() string {
+	return "GetAlertSilenceRequestValidationError"
+}
 
 // Error satisfies the builtin error interface
-func (e GetBufferRequestValidationError) Error() string {
+func (e GetAlertSilenceRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -3347,14 +10060,14 @@ func (e GetBufferRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sGetBufferRequest.%s: %s%s",
+		"invalid %sGetAlertSilenceRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = GetBufferRequestValidationError{}
+var _ error = GetAlertSilenceRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -3362,24 +10075,24 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = GetBufferRequestValidationError{}
+} = GetAlertSilenceRequestValidationError{}
 
-// Validate checks the field values on BufferResponse with the rules defined in
-// the proto definition for this message. If any rules are violated, the first
-// error encountered is returned, or nil if there are no violations.
-func (m *BufferResponse) Validate() error {
+// Validate checks the field values on AlertSilenceResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *AlertSilenceResponse) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on BufferResponse with the rules defined
-// in the proto definition for this message. If any rules are violated, the
-// result is a list of violation errors wrapped in BufferResponseMultiError,
-// or nil if none found.
-func (m *BufferResponse) ValidateAll() error {
+// ValidateAll checks the field values on AlertSilenceResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// AlertSilenceResponseMultiError, or nil if none found.
+func (m *AlertSilenceResponse) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *BufferResponse) validate(all bool) error {
+func (m *AlertSilenceResponse) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
@@ -3390,7 +10103,7 @@ func (m *BufferResponse) validate(all bool) error {
 		switch v := interface{}(m.GetData()).(type) {
 		case interface{ ValidateAll() error }:
 			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, BufferResponseValidationError{
+				errors = append(errors, AlertSilenceResponseValidationError{
 					field:  "Data",
 					reason: "embedded message failed validation",
 					cause:  err,
@@ -3398,7 +10111,7 @@ func (m *BufferResponse) validate(all bool) error {
 			}
 		case interface{ Validate() error }:
 			if err := v.Validate(); err != nil {
-				errors = append(errors, BufferResponseValidationError{
+				errors = append(errors, AlertSilenceResponseValidationError{
 					field:  "Data",
 					reason: "embedded message failed validation",
 					cause:  err,
@@ -3407,7 +10120,7 @@ func (m *BufferResponse) validate(all bool) error {
 		}
 	} else if v, ok := interface{}(m.GetData()).(interface{ Validate() error }); ok {
 		if err := v.Validate(); err != nil {
-			return BufferResponseValidationError{
+			return AlertSilenceResponseValidationError{
 				field:  "Data",
 				reason: "embedded message failed validation",
 				cause:  err,
@@ -3419,7 +10132,7 @@ func (m *BufferResponse) validate(all bool) error {
 		switch v := interface{}(m.GetMeta()).(type) {
 		case interface{ ValidateAll() error }:
 			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, BufferResponseValidationError{
+				errors = append(errors, AlertSilenceResponseValidationError{
 					field:  "Meta",
 					reason: "embedded message failed validation",
 					cause:  err,
@@ -3427,7 +10140,7 @@ func (m *BufferResponse) validate(all bool) error {
 			}
 		case interface{ Validate() error }:
 			if err := v.Validate(); err != nil {
-				errors = append(errors, BufferResponseValidationError{
+				errors = append(errors, AlertSilenceResponseValidationError{
 					field:  "Meta",
 					reason: "embedded message failed validation",
 					cause:  err,
@@ -3436,7 +10149,7 @@ func (m *BufferResponse) validate(all bool) error {
 		}
 	} else if v, ok := interface{}(m.GetMeta()).(interface{ Validate() error }); ok {
 		if err := v.Validate(); err != nil {
-			return BufferResponseValidationError{
+			return AlertSilenceResponseValidationError{
 				field:  "Meta",
 				reason: "embedded message failed validation",
 				cause:  err,
@@ -3445,19 +10158,19 @@ func (m *BufferResponse) validate(all bool) error {
 	}
 
 	if len(errors) > 0 {
-		return BufferResponseMultiError(errors)
+		return AlertSilenceResponseMultiError(errors)
 	}
 
 	return nil
 }
 
-// BufferResponseMultiError is an error wrapping multiple validation errors
-// returned by BufferResponse.ValidateAll() if the designated constraints
-// aren't met.
-type BufferResponseMultiError []error
+// AlertSilenceResponseMultiError is an error wrapping multiple validation
+// errors returned by AlertSilenceResponse.ValidateAll() if the designated
+// constraints aren't met.
+type AlertSilenceResponseMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m BufferResponseMultiError) Error() string {
+func (m AlertSilenceResponseMultiError) Error() string {
 	msgs := make([]string, 0, len(m))
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -3466,11 +10179,11 @@ func (m BufferResponseMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m BufferResponseMultiError) AllErrors() []error { return m }
+func (m AlertSilenceResponseMultiError) AllErrors() []error { return m }
 
-// BufferResponseValidationError is the validation error returned by
-// BufferResponse.Validate if the designated constraints aren't met.
-type BufferResponseValidationError struct {
+// AlertSilenceResponseValidationError is the validation error returned by
+// AlertSilenceResponse.Validate if the designated constraints aren't met.
+type AlertSilenceResponseValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -3478,22 +10191,24 @@ type BufferResponseValidationError struct {
 }
 
 // Field function returns field value.
-func (e BufferResponseValidationError) Field() string { return e.field }
+func (e AlertSilenceResponseValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e BufferResponseValidationError) Reason() string { return e.reason }
+func (e AlertSilenceResponseValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e BufferResponseValidationError) Cause() error { return e.cause }
+func (e AlertSilenceResponseValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e BufferResponseValidationError) Key() bool { return e.key }
+func (e AlertSilenceResponseValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e BufferResponseValidationError) ErrorName() string { return "BufferResponseValidationError" }
+func (e AlertSilenceResponseValidationError) ErrorName() string {
+	return "AlertSilenceResponseValidationError"
+}
 
 // Error satisfies the builtin error interface
-func (e BufferResponseValidationError) Error() string {
+func (e AlertSilenceResponseValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -3505,14 +10220,14 @@ func (e BufferResponseValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sBufferResponse.%s: %s%s",
+		"invalid %sAlertSilenceResponse.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = BufferResponseValidationError{}
+var _ error = AlertSilenceResponseValidationError{}
 
 var _ interface {
 	Field() string
@@ -3520,78 +10235,46 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = BufferResponseValidationError{}
+} = AlertSilenceResponseValidationError{}
 
-// Validate checks the field values on ListBuffersResponse with the rules
+// Validate checks the field values on ListAlertSilencesRequest with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *ListBuffersResponse) Validate() error {
+func (m *ListAlertSilencesRequest) Validate() error {
 	return m.validate(false)
 }
-
-// ValidateAll checks the field values on ListBuffersResponse with the rules
-// defined in the proto definition for this message. If any rules are
+
+// ValidateAll checks the field values on ListAlertSilencesRequest with the
+// rules defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// ListBuffersResponseMultiError, or nil if none found.
-func (m *ListBuffersResponse) ValidateAll() error {
+// ListAlertSilencesRequestMultiError, or nil if none found.
+func (m *ListAlertSilencesRequest) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *ListBuffersResponse) validate(all bool) error {
+func (m *ListAlertSilencesRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	for idx, item := range m.GetData() {
-		_, _ = idx, item
-
-		if all {
-			switch v := interface{}(item).(type) {
-			case interface{ ValidateAll() error }:
-				if err := v.ValidateAll(); err != nil {
-					errors = append(errors, ListBuffersResponseValidationError{
-						field:  fmt.Sprintf("Data[%v]", idx),
-						reason: "embedded message failed validation",
-						cause:  err,
-					})
-				}
-			case interface{ Validate() error }:
-				if err := v.Validate(); err != nil {
-					errors = append(errors, ListBuffersResponseValidationError{
-						field:  fmt.Sprintf("Data[%v]", idx),
-						reason: "embedded message failed validation",
-						cause:  err,
-					})
-				}
-			}
-		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
-			if err := v.Validate(); err != nil {
-				return ListBuffersResponseValidationError{
-					field:  fmt.Sprintf("Data[%v]", idx),
-					reason: "embedded message failed validation",
-					cause:  err,
-				}
-			}
-		}
-
-	}
+	// no validation rules for IncludeExpired
 
 	if len(errors) > 0 {
-		return ListBuffersResponseMultiError(errors)
+		return ListAlertSilencesRequestMultiError(errors)
 	}
 
 	return nil
 }
 
-// ListBuffersResponseMultiError is an error wrapping multiple validation
-// errors returned by ListBuffersResponse.ValidateAll() if the designated
+// ListAlertSilencesRequestMultiError is an error wrapping multiple validation
+// errors returned by ListAlertSilencesRequest.ValidateAll() if the designated
 // constraints aren't met.
-type ListBuffersResponseMultiError []error
+type ListAlertSilencesRequestMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m ListBuffersResponseMultiError) Error() string {
+func (m ListAlertSilencesRequestMultiError) Error() string {
 	msgs := make([]string, 0, len(m))
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -3600,11 +10283,11 @@ func (m ListBuffersResponseMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m ListBuffersResponseMultiError) AllErrors() []error { return m }
+func (m ListAlertSilencesRequestMultiError) AllErrors() []error { return m }
 
-// ListBuffersResponseValidationError is the validation error returned by
-// ListBuffersResponse.Validate if the designated constraints aren't met.
-type ListBuffersResponseValidationError struct {
+// ListAlertSilencesRequestValidationError is the validation error returned by
+// ListAlertSilencesRequest.Validate if the designated constraints aren't met.
+type ListAlertSilencesRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -3612,24 +10295,24 @@ type ListBuffersResponseValidationError struct {
 }
 
 // Field function returns field value.
-func (e ListBuffersResponseValidationError) Field() string { return e.field }
+func (e ListAlertSilencesRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e ListBuffersResponseValidationError) Reason() string { return e.reason }
+func (e ListAlertSilencesRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e ListBuffersResponseValidationError) Cause() error { return e.cause }
+func (e ListAlertSilencesRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e ListBuffersResponseValidationError) Key() bool { return e.key }
+func (e ListAlertSilencesRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e ListBuffersResponseValidationError) ErrorName() string {
-	return "ListBuffersResponseValidationError"
+func (e ListAlertSilencesRequestValidationError) ErrorName() string {
+	return "ListAlertSilencesRequestValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e ListBuffersResponseValidationError) Error() string {
+func (e ListAlertSilencesRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -3641,14 +10324,14 @@ func (e ListBuffersResponseValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sListBuffersResponse.%s: %s%s",
+		"invalid %sListAlertSilencesRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = ListBuffersResponseValidationError{}
+var _ error = ListAlertSilencesRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -3656,24 +10339,24 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = ListBuffersResponseValidationError{}
+} = ListAlertSilencesRequestValidationError{}
 
-// Validate checks the field values on ListFilesResponse with the rules defined
-// in the proto definition for this message. If any rules are violated, the
-// first error encountered is returned, or nil if there are no violations.
-func (m *ListFilesResponse) Validate() error {
+// Validate checks the field values on ListAlertSilencesResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ListAlertSilencesResponse) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on ListFilesResponse with the rules
-// defined in the proto definition for this message. If any rules are
+// ValidateAll checks the field values on ListAlertSilencesResponse with the
+// rules defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// ListFilesResponseMultiError, or nil if none found.
-func (m *ListFilesResponse) ValidateAll() error {
+// ListAlertSilencesResponseMultiError, or nil if none found.
+func (m *ListAlertSilencesResponse) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *ListFilesResponse) validate(all bool) error {
+func (m *ListAlertSilencesResponse) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
@@ -3687,7 +10370,7 @@ func (m *ListFilesResponse) validate(all bool) error {
 			switch v := interface{}(item).(type) {
 			case interface{ ValidateAll() error }:
 				if err := v.ValidateAll(); err != nil {
-					errors = append(errors, ListFilesResponseValidationError{
+					errors = append(errors, ListAlertSilencesResponseValidationError{
 						field:  fmt.Sprintf("Data[%v]", idx),
 						reason: "embedded message failed validation",
 						cause:  err,
@@ -3695,7 +10378,7 @@ func (m *ListFilesResponse) validate(all bool) error {
 				}
 			case interface{ Validate() error }:
 				if err := v.Validate(); err != nil {
-					errors = append(errors, ListFilesResponseValidationError{
+					errors = append(errors, ListAlertSilencesResponseValidationError{
 						field:  fmt.Sprintf("Data[%v]", idx),
 						reason: "embedded message failed validation",
 						cause:  err,
@@ -3704,7 +10387,7 @@ func (m *ListFilesResponse) validate(all bool) error {
 			}
 		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
 			if err := v.Validate(); err != nil {
-				return ListFilesResponseValidationError{
+				return ListAlertSilencesResponseValidationError{
 					field:  fmt.Sprintf("Data[%v]", idx),
 					reason: "embedded message failed validation",
 					cause:  err,
@@ -3715,19 +10398,19 @@ func (m *ListFilesResponse) validate(all bool) error {
 	}
 
 	if len(errors) > 0 {
-		return ListFilesResponseMultiError(errors)
+		return ListAlertSilencesResponseMultiError(errors)
 	}
 
 	return nil
 }
 
-// ListFilesResponseMultiError is an error wrapping multiple validation errors
-// returned by ListFilesResponse.ValidateAll() if the designated constraints
-// aren't met.
-type ListFilesResponseMultiError []error
+// ListAlertSilencesResponseMultiError is an error wrapping multiple validation
+// errors returned by ListAlertSilencesResponse.ValidateAll() if the
+// designated constraints aren't met.
+type ListAlertSilencesResponseMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m ListFilesResponseMultiError) Error() string {
+func (m ListAlertSilencesResponseMultiError) Error() string {
 	msgs := make([]string, 0, len(m))
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -3736,11 +10419,11 @@ func (m ListFilesResponseMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m ListFilesResponseMultiError) AllErrors() []error { return m }
+func (m ListAlertSilencesResponseMultiError) AllErrors() []error { return m }
 
-// ListFilesResponseValidationError is the validation error returned by
-// ListFilesResponse.Validate if the designated constraints aren't met.
-type ListFilesResponseValidationError struct {
+// ListAlertSilencesResponseValidationError is the validation error returned by
+// ListAlertSilencesResponse.Validate if the designated constraints aren't met.
+type ListAlertSilencesResponseValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -3748,24 +10431,24 @@ type ListFilesResponseValidationError struct {
 }
 
 // Field function returns field value.
-func (e ListFilesResponseValidationError) Field() string { return e.field }
+func (e ListAlertSilencesResponseValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e ListFilesResponseValidationError) Reason() string { return e.reason }
+func (e ListAlertSilencesResponseValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e ListFilesResponseValidationError) Cause() error { return e.cause }
+func (e ListAlertSilencesResponseValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e ListFilesResponseValidationError) Key() bool { return e.key }
+func (e ListAlertSilencesResponseValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e ListFilesResponseValidationError) ErrorName() string {
-	return "ListFilesResponseValidationError"
+func (e ListAlertSilencesResponseValidationError) ErrorName() string {
+	return "ListAlertSilencesResponseValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e ListFilesResponseValidationError) Error() string {
+func (e ListAlertSilencesResponseValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -3777,14 +10460,14 @@ func (e ListFilesResponseValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sListFilesResponse.%s: %s%s",
+		"invalid %sListAlertSilencesResponse.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = ListFilesResponseValidationError{}
+var _ error = ListAlertSilencesResponseValidationError{}
 
 var _ interface {
 	Field() string
@@ -3792,34 +10475,127 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = ListFilesResponseValidationError{}
+} = ListAlertSilencesResponseValidationError{}
 
-// Validate checks the field values on GetFileRequest with the rules defined in
-// the proto definition for this message. If any rules are violated, the first
-// error encountered is returned, or nil if there are no violations.
-func (m *GetFileRequest) Validate() error {
+// Validate checks the field values on ListAlertsRequest with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *ListAlertsRequest) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on GetFileRequest with the rules defined
-// in the proto definition for this message. If any rules are violated, the
-// result is a list of violation errors wrapped in GetFileRequestMultiError,
-// or nil if none found.
-func (m *GetFileRequest) ValidateAll() error {
+// ValidateAll checks the field values on ListAlertsRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ListAlertsRequestMultiError, or nil if none found.
+func (m *ListAlertsRequest) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *GetFileRequest) validate(all bool) error {
+func (m *ListAlertsRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	if m.GetId() <= 0 {
-		err := GetFileRequestValidationError{
-			field:  "Id",
-			reason: "value must be greater than 0",
+	if _, ok := _ListAlertsRequest_Status_InLookup[m.GetStatus()]; !ok {
+		err := ListAlertsRequestValidationError{
+			field:  "Status",
+			reason: "value must be in list [ firing resolved]",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if m.GetRuleId() < 0 {
+		err := ListAlertsRequestValidationError{
+			field:  "RuleId",
+			reason: "value must be greater than or equal to 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if m.GetFlowId() < 0 {
+		err := ListAlertsRequestValidationError{
+			field:  "FlowId",
+			reason: "value must be greater than or equal to 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if all {
+		switch v := interface{}(m.GetStartTime()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, ListAlertsRequestValidationError{
+					field:  "StartTime",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, ListAlertsRequestValidationError{
+					field:  "StartTime",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetStartTime()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return ListAlertsRequestValidationError{
+				field:  "StartTime",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetEndTime()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, ListAlertsRequestValidationError{
+					field:  "EndTime",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, ListAlertsRequestValidationError{
+					field:  "EndTime",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetEndTime()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return ListAlertsRequestValidationError{
+				field:  "EndTime",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for Limit
+
+	if m.GetOffset() < 0 {
+		err := ListAlertsRequestValidationError{
+			field:  "Offset",
+			reason: "value must be greater than or equal to 0",
 		}
 		if !all {
 			return err
@@ -3828,19 +10604,19 @@ func (m *GetFileRequest) validate(all bool) error {
 	}
 
 	if len(errors) > 0 {
-		return GetFileRequestMultiError(errors)
+		return ListAlertsRequestMultiError(errors)
 	}
 
 	return nil
 }
 
-// GetFileRequestMultiError is an error wrapping multiple validation errors
-// returned by GetFileRequest.ValidateAll() if the designated constraints
+// ListAlertsRequestMultiError is an error wrapping multiple validation errors
+// returned by ListAlertsRequest.ValidateAll() if the designated constraints
 // aren't met.
-type GetFileRequestMultiError []error
+type ListAlertsRequestMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m GetFileRequestMultiError) Error() string {
+func (m ListAlertsRequestMultiError) Error() string {
 	msgs := make([]string, 0, len(m))
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -3849,11 +10625,11 @@ func (m GetFileRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m GetFileRequestMultiError) AllErrors() []error { return m }
+func (m ListAlertsRequestMultiError) AllErrors() []error { return m }
 
-// GetFileRequestValidationError is the validation error returned by
-// GetFileRequest.Validate if the designated constraints aren't met.
-type GetFileRequestValidationError struct {
+// ListAlertsRequestValidationError is the validation error returned by
+// ListAlertsRequest.Validate if the designated constraints aren't met.
+type ListAlertsRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -3861,22 +10637,24 @@ type GetFileRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e GetFileRequestValidationError) Field() string { return e.field }
+func (e ListAlertsRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e GetFileRequestValidationError) Reason() string { return e.reason }
+func (e ListAlertsRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e GetFileRequestValidationError) Cause() error { return e.cause }
+func (e ListAlertsRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e GetFileRequestValidationError) Key() bool { return e.key }
+func (e ListAlertsRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e GetFileRequestValidationError) ErrorName() string { return "GetFileRequestValidationError" }
+func (e ListAlertsRequestValidationError) ErrorName() string {
+	return "ListAlertsRequestValidationError"
+}
 
 // Error satisfies the builtin error interface
-func (e GetFileRequestValidationError) Error() string {
+func (e ListAlertsRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -3888,14 +10666,14 @@ func (e GetFileRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sGetFileRequest.%s: %s%s",
+		"invalid %sListAlertsRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = GetFileRequestValidationError{}
+var _ error = ListAlertsRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -3903,101 +10681,86 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = GetFileRequestValidationError{}
+} = ListAlertsRequestValidationError{}
 
-// Validate checks the field values on FileResponse with the rules defined in
-// the proto definition for this message. If any rules are violated, the first
-// error encountered is returned, or nil if there are no violations.
-func (m *FileResponse) Validate() error {
-	return m.validate(false)
+var _ListAlertsRequest_Status_InLookup = map[string]struct{}{
+	"":         {},
+	"firing":   {},
+	"resolved": {},
 }
 
-// ValidateAll checks the field values on FileResponse with the rules defined
-// in the proto definition for this message. If any rules are violated, the
-// result is a list of violation errors wrapped in FileResponseMultiError, or
-// nil if none found.
-func (m *FileResponse) ValidateAll() error {
-	return m.validate(true)
+// Validate checks the field values on ListAlertsResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ListAlertsResponse) Validate() error {
+	return m.validate(false)
 }
 
-func (m *FileResponse) validate(all bool) error {
-	if m == nil {
-		return nil
-	}
-
-	var errors []error
-
-	if all {
-		switch v := interface{}(m.GetData()).(type) {
-		case interface{ ValidateAll() error }:
-			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, FileResponseValidationError{
-					field:  "Data",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
-		case interface{ Validate() error }:
-			if err := v.Validate(); err != nil {
-				errors = append(errors, FileResponseValidationError{
-					field:  "Data",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
-		}
-	} else if v, ok := interface{}(m.GetData()).(interface{ Validate() error }); ok {
-		if err := v.Validate(); err != nil {
-			return FileResponseValidationError{
-				field:  "Data",
-				reason: "embedded message failed validation",
-				cause:  err,
-			}
-		}
+// ValidateAll checks the field values on ListAlertsResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ListAlertsResponseMultiError, or nil if none found.
+func (m *ListAlertsResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListAlertsResponse) validate(all bool) error {
+	if m == nil {
+		return nil
 	}
 
-	if all {
-		switch v := interface{}(m.GetMeta()).(type) {
-		case interface{ ValidateAll() error }:
-			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, FileResponseValidationError{
-					field:  "Meta",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
+	var errors []error
+
+	for idx, item := range m.GetData() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ListAlertsResponseValidationError{
+						field:  fmt.Sprintf("Data[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ListAlertsResponseValidationError{
+						field:  fmt.Sprintf("Data[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
 			}
-		case interface{ Validate() error }:
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
 			if err := v.Validate(); err != nil {
-				errors = append(errors, FileResponseValidationError{
-					field:  "Meta",
+				return ListAlertsResponseValidationError{
+					field:  fmt.Sprintf("Data[%v]", idx),
 					reason: "embedded message failed validation",
 					cause:  err,
-				})
-			}
-		}
-	} else if v, ok := interface{}(m.GetMeta()).(interface{ Validate() error }); ok {
-		if err := v.Validate(); err != nil {
-			return FileResponseValidationError{
-				field:  "Meta",
-				reason: "embedded message failed validation",
-				cause:  err,
+				}
 			}
 		}
+
 	}
 
+	// no validation rules for Total
+
 	if len(errors) > 0 {
-		return FileResponseMultiError(errors)
+		return ListAlertsResponseMultiError(errors)
 	}
 
 	return nil
 }
 
-// FileResponseMultiError is an error wrapping multiple validation errors
-// returned by FileResponse.ValidateAll() if the designated constraints aren't met.
-type FileResponseMultiError []error
+// ListAlertsResponseMultiError is an error wrapping multiple validation errors
+// returned by ListAlertsResponse.ValidateAll() if the designated constraints
+// aren't met.
+type ListAlertsResponseMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m FileResponseMultiError) Error() string {
+func (m ListAlertsResponseMultiError) Error() string {
 	msgs := make([]string, 0, len(m))
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -4006,11 +10769,11 @@ func (m FileResponseMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m FileResponseMultiError) AllErrors() []error { return m }
+func (m ListAlertsResponseMultiError) AllErrors() []error { return m }
 
-// FileResponseValidationError is the validation error returned by
-// FileResponse.Validate if the designated constraints aren't met.
-type FileResponseValidationError struct {
+// ListAlertsResponseValidationError is the validation error returned by
+// ListAlertsResponse.Validate if the designated constraints aren't met.
+type ListAlertsResponseValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -4018,22 +10781,24 @@ type FileResponseValidationError struct {
 }
 
 // Field function returns field value.
-func (e FileResponseValidationError) Field() string { return e.field }
+func (e ListAlertsResponseValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e FileResponseValidationError) Reason() string { return e.reason }
+func (e ListAlertsResponseValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e FileResponseValidationError) Cause() error { return e.cause }
+func (e ListAlertsResponseValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e FileResponseValidationError) Key() bool { return e.key }
+func (e ListAlertsResponseValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e FileResponseValidationError) ErrorName() string { return "FileResponseValidationError" }
+func (e ListAlertsResponseValidationError) ErrorName() string {
+	return "ListAlertsResponseValidationError"
+}
 
 // Error satisfies the builtin error interface
-func (e FileResponseValidationError) Error() string {
+func (e ListAlertsResponseValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -4045,14 +10810,14 @@ func (e FileResponseValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sFileResponse.%s: %s%s",
+		"invalid %sListAlertsResponse.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = FileResponseValidationError{}
+var _ error = ListAlertsResponseValidationError{}
 
 var _ interface {
 	Field() string
@@ -4060,55 +10825,79 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = FileResponseValidationError{}
+} = ListAlertsResponseValidationError{}
 
-// Validate checks the field values on GetRateLimitRequest with the rules
-// defined in the proto definition for this message. If any rules are
+// Validate checks the field values on ListWorkersResponse_Worker with the
+// rules defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *GetRateLimitRequest) Validate() error {
+func (m *ListWorkersResponse_Worker) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on GetRateLimitRequest with the rules
-// defined in the proto definition for this message. If any rules are
+// ValidateAll checks the field values on ListWorkersResponse_Worker with the
+// rules defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// GetRateLimitRequestMultiError, or nil if none found.
-func (m *GetRateLimitRequest) ValidateAll() error {
+// ListWorkersResponse_WorkerMultiError, or nil if none found.
+func (m *ListWorkersResponse_Worker) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *GetRateLimitRequest) validate(all bool) error {
+func (m *ListWorkersResponse_Worker) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	if m.GetId() <= 0 {
-		err := GetRateLimitRequestValidationError{
-			field:  "Id",
-			reason: "value must be greater than 0",
+	// no validation rules for Id
+
+	// no validation rules for Address
+
+	// no validation rules for Status
+
+	if all {
+		switch v := interface{}(m.GetLastHeartbeat()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, ListWorkersResponse_WorkerValidationError{
+					field:  "LastHeartbeat",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, ListWorkersResponse_WorkerValidationError{
+					field:  "LastHeartbeat",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
 		}
-		if !all {
-			return err
+	} else if v, ok := interface{}(m.GetLastHeartbeat()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return ListWorkersResponse_WorkerValidationError{
+				field:  "LastHeartbeat",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
 		}
-		errors = append(errors, err)
 	}
 
 	if len(errors) > 0 {
-		return GetRateLimitRequestMultiError(errors)
+		return ListWorkersResponse_WorkerMultiError(errors)
 	}
 
 	return nil
 }
 
-// GetRateLimitRequestMultiError is an error wrapping multiple validation
-// errors returned by GetRateLimitRequest.ValidateAll() if the designated
-// constraints aren't met.
-type GetRateLimitRequestMultiError []error
+// ListWorkersResponse_WorkerMultiError is an error wrapping multiple
+// validation errors returned by ListWorkersResponse_Worker.ValidateAll() if
+// the designated constraints aren't met.
+type ListWorkersResponse_WorkerMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m GetRateLimitRequestMultiError) Error() string {
+func (m ListWorkersResponse_WorkerMultiError) Error() string {
 	msgs := make([]string, 0, len(m))
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -4117,11 +10906,11 @@ func (m GetRateLimitRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m GetRateLimitRequestMultiError) AllErrors() []error { return m }
+func (m ListWorkersResponse_WorkerMultiError) AllErrors() []error { return m }
 
-// GetRateLimitRequestValidationError is the validation error returned by
-// GetRateLimitRequest.Validate if the designated constraints aren't met.
-type GetRateLimitRequestValidationError struct {
+// ListWorkersResponse_WorkerValidationError is the validation error returned
+// by ListWorkersResponse_Worker.Validate if the designated constraints aren't met.
+type ListWorkersResponse_WorkerValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -4129,24 +10918,24 @@ type GetRateLimitRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e GetRateLimitRequestValidationError) Field() string { return e.field }
+func (e ListWorkersResponse_WorkerValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e GetRateLimitRequestValidationError) Reason() string { return e.reason }
+func (e ListWorkersResponse_WorkerValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e GetRateLimitRequestValidationError) Cause() error { return e.cause }
+func (e ListWorkersResponse_WorkerValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e GetRateLimitRequestValidationError) Key() bool { return e.key }
+func (e ListWorkersResponse_WorkerValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e GetRateLimitRequestValidationError) ErrorName() string {
-	return "GetRateLimitRequestValidationError"
+func (e ListWorkersResponse_WorkerValidationError) ErrorName() string {
+	return "ListWorkersResponse_WorkerValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e GetRateLimitRequestValidationError) Error() string {
+func (e ListWorkersResponse_WorkerValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -4158,14 +10947,14 @@ func (e GetRateLimitRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sGetRateLimitRequest.%s: %s%s",
+		"invalid %sListWorkersResponse_Worker.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = GetRateLimitRequestValidationError{}
+var _ error = ListWorkersResponse_WorkerValidationError{}
 
 var _ interface {
 	Field() string
@@ -4173,102 +10962,50 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = GetRateLimitRequestValidationError{}
+} = ListWorkersResponse_WorkerValidationError{}
 
-// Validate checks the field values on RateLimitResponse with the rules defined
-// in the proto definition for this message. If any rules are violated, the
-// first error encountered is returned, or nil if there are no violations.
-func (m *RateLimitResponse) Validate() error {
+// Validate checks the field values on GetFlowMetricsResponse_Point with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GetFlowMetricsResponse_Point) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on RateLimitResponse with the rules
-// defined in the proto definition for this message. If any rules are
+// ValidateAll checks the field values on GetFlowMetricsResponse_Point with the
+// rules defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// RateLimitResponseMultiError, or nil if none found.
-func (m *RateLimitResponse) ValidateAll() error {
+// GetFlowMetricsResponse_PointMultiError, or nil if none found.
+func (m *GetFlowMetricsResponse_Point) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *RateLimitResponse) validate(all bool) error {
+func (m *GetFlowMetricsResponse_Point) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	if all {
-		switch v := interface{}(m.GetData()).(type) {
-		case interface{ ValidateAll() error }:
-			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, RateLimitResponseValidationError{
-					field:  "Data",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
-		case interface{ Validate() error }:
-			if err := v.Validate(); err != nil {
-				errors = append(errors, RateLimitResponseValidationError{
-					field:  "Data",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
-		}
-	} else if v, ok := interface{}(m.GetData()).(interface{ Validate() error }); ok {
-		if err := v.Validate(); err != nil {
-			return RateLimitResponseValidationError{
-				field:  "Data",
-				reason: "embedded message failed validation",
-				cause:  err,
-			}
-		}
-	}
+	// no validation rules for Timestamp
 
-	if all {
-		switch v := interface{}(m.GetMeta()).(type) {
-		case interface{ ValidateAll() error }:
-			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, RateLimitResponseValidationError{
-					field:  "Meta",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
-		case interface{ Validate() error }:
-			if err := v.Validate(); err != nil {
-				errors = append(errors, RateLimitResponseValidationError{
-					field:  "Meta",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
-		}
-	} else if v, ok := interface{}(m.GetMeta()).(interface{ Validate() error }); ok {
-		if err := v.Validate(); err != nil {
-			return RateLimitResponseValidationError{
-				field:  "Meta",
-				reason: "embedded message failed validation",
-				cause:  err,
-			}
-		}
-	}
+	// no validation rules for Events
+
+	// no validation rules for Errors
 
 	if len(errors) > 0 {
-		return RateLimitResponseMultiError(errors)
+		return GetFlowMetricsResponse_PointMultiError(errors)
 	}
 
 	return nil
 }
 
-// RateLimitResponseMultiError is an error wrapping multiple validation errors
-// returned by RateLimitResponse.ValidateAll() if the designated constraints
-// aren't met.
-type RateLimitResponseMultiError []error
+// GetFlowMetricsResponse_PointMultiError is an error wrapping multiple
+// validation errors returned by GetFlowMetricsResponse_Point.ValidateAll() if
+// the designated constraints aren't met.
+type GetFlowMetricsResponse_PointMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m RateLimitResponseMultiError) Error() string {
+func (m GetFlowMetricsResponse_PointMultiError) Error() string {
 	msgs := make([]string, 0, len(m))
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -4277,11 +11014,12 @@ func (m RateLimitResponseMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m RateLimitResponseMultiError) AllErrors() []error { return m }
+func (m GetFlowMetricsResponse_PointMultiError) AllErrors() []error { return m }
 
-// RateLimitResponseValidationError is the validation error returned by
-// RateLimitResponse.Validate if the designated constraints aren't met.
-type RateLimitResponseValidationError struct {
+// GetFlowMetricsResponse_PointValidationError is the validation error returned
+// by GetFlowMetricsResponse_Point.Validate if the designated constraints
+// aren't met.
+type GetFlowMetricsResponse_PointValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -4289,24 +11027,24 @@ type RateLimitResponseValidationError struct {
 }
 
 // Field function returns field value.
-func (e RateLimitResponseValidationError) Field() string { return e.field }
+func (e GetFlowMetricsResponse_PointValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e RateLimitResponseValidationError) Reason() string { return e.reason }
+func (e GetFlowMetricsResponse_PointValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e RateLimitResponseValidationError) Cause() error { return e.cause }
+func (e GetFlowMetricsResponse_PointValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e RateLimitResponseValidationError) Key() bool { return e.key }
+func (e GetFlowMetricsResponse_PointValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e RateLimitResponseValidationError) ErrorName() string {
-	return "RateLimitResponseValidationError"
+func (e GetFlowMetricsResponse_PointValidationError) ErrorName() string {
+	return "GetFlowMetricsResponse_PointValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e RateLimitResponseValidationError) Error() string {
+func (e GetFlowMetricsResponse_PointValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -4318,14 +11056,14 @@ func (e RateLimitResponseValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sRateLimitResponse.%s: %s%s",
+		"invalid %sGetFlowMetricsResponse_Point.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = RateLimitResponseValidationError{}
+var _ error = GetFlowMetricsResponse_PointValidationError{}
 
 var _ interface {
 	Field() string
@@ -4333,79 +11071,88 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = RateLimitResponseValidationError{}
+} = GetFlowMetricsResponse_PointValidationError{}
 
-// Validate checks the field values on ListWorkersResponse_Worker with the
-// rules defined in the proto definition for this message. If any rules are
-// violated, the first error encountered is returned, or nil if there are no violations.
-func (m *ListWorkersResponse_Worker) Validate() error {
+// Validate checks the field values on GetFlowMetricsResponse_Component with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the first error encountered is returned, or nil if there are
+// no violations.
+func (m *GetFlowMetricsResponse_Component) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on ListWorkersResponse_Worker with the
-// rules defined in the proto definition for this message. If any rules are
-// violated, the result is a list of violation errors wrapped in
-// ListWorkersResponse_WorkerMultiError, or nil if none found.
-func (m *ListWorkersResponse_Worker) ValidateAll() error {
+// ValidateAll checks the field values on GetFlowMetricsResponse_Component with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the result is a list of violation errors wrapped in
+// GetFlowMetricsResponse_ComponentMultiError, or nil if none found.
+func (m *GetFlowMetricsResponse_Component) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *ListWorkersResponse_Worker) validate(all bool) error {
+func (m *GetFlowMetricsResponse_Component) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for Id
+	// no validation rules for Section
 
-	// no validation rules for Address
+	// no validation rules for ComponentLabel
 
-	// no validation rules for Status
+	for idx, item := range m.GetData() {
+		_, _ = idx, item
 
-	if all {
-		switch v := interface{}(m.GetLastHeartbeat()).(type) {
-		case interface{ ValidateAll() error }:
-			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, ListWorkersResponse_WorkerValidationError{
-					field:  "LastHeartbeat",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, GetFlowMetricsResponse_ComponentValidationError{
+						field:  fmt.Sprintf("Data[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, GetFlowMetricsResponse_ComponentValidationError{
+						field:  fmt.Sprintf("Data[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
 			}
-		case interface{ Validate() error }:
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
 			if err := v.Validate(); err != nil {
-				errors = append(errors, ListWorkersResponse_WorkerValidationError{
-					field:  "LastHeartbeat",
+				return GetFlowMetricsResponse_ComponentValidationError{
+					field:  fmt.Sprintf("Data[%v]", idx),
 					reason: "embedded message failed validation",
 					cause:  err,
-				})
-			}
-		}
-	} else if v, ok := interface{}(m.GetLastHeartbeat()).(interface{ Validate() error }); ok {
-		if err := v.Validate(); err != nil {
-			return ListWorkersResponse_WorkerValidationError{
-				field:  "LastHeartbeat",
-				reason: "embedded message failed validation",
-				cause:  err,
+				}
 			}
 		}
+
 	}
 
+	// no validation rules for TotalEvents
+
+	// no validation rules for TotalErrors
+
 	if len(errors) > 0 {
-		return ListWorkersResponse_WorkerMultiError(errors)
+		return GetFlowMetricsResponse_ComponentMultiError(errors)
 	}
 
 	return nil
 }
 
-// ListWorkersResponse_WorkerMultiError is an error wrapping multiple
-// validation errors returned by ListWorkersResponse_Worker.ValidateAll() if
-// the designated constraints aren't met.
-type ListWorkersResponse_WorkerMultiError []error
+// GetFlowMetricsResponse_ComponentMultiError is an error wrapping multiple
+// validation errors returned by
+// GetFlowMetricsResponse_Component.ValidateAll() if the designated
+// constraints aren't met.
+type GetFlowMetricsResponse_ComponentMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m ListWorkersResponse_WorkerMultiError) Error() string {
+func (m GetFlowMetricsResponse_ComponentMultiError) Error() string {
 	msgs := make([]string, 0, len(m))
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -4414,11 +11161,12 @@ func (m ListWorkersResponse_WorkerMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m ListWorkersResponse_WorkerMultiError) AllErrors() []error { return m }
+func (m GetFlowMetricsResponse_ComponentMultiError) AllErrors() []error { return m }
 
-// ListWorkersResponse_WorkerValidationError is the validation error returned
-// by ListWorkersResponse_Worker.Validate if the designated constraints aren't met.
-type ListWorkersResponse_WorkerValidationError struct {
+// GetFlowMetricsResponse_ComponentValidationError is the validation error
+// returned by GetFlowMetricsResponse_Component.Validate if the designated
+// constraints aren't met.
+type GetFlowMetricsResponse_ComponentValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -4426,24 +11174,24 @@ type ListWorkersResponse_WorkerValidationError struct {
 }
 
 // Field function returns field value.
-func (e ListWorkersResponse_WorkerValidationError) Field() string { return e.field }
+func (e GetFlowMetricsResponse_ComponentValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e ListWorkersResponse_WorkerValidationError) Reason() string { return e.reason }
+func (e GetFlowMetricsResponse_ComponentValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e ListWorkersResponse_WorkerValidationError) Cause() error { return e.cause }
+func (e GetFlowMetricsResponse_ComponentValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e ListWorkersResponse_WorkerValidationError) Key() bool { return e.key }
+func (e GetFlowMetricsResponse_ComponentValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e ListWorkersResponse_WorkerValidationError) ErrorName() string {
-	return "ListWorkersResponse_WorkerValidationError"
+func (e GetFlowMetricsResponse_ComponentValidationError) ErrorName() string {
+	return "GetFlowMetricsResponse_ComponentValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e ListWorkersResponse_WorkerValidationError) Error() string {
+func (e GetFlowMetricsResponse_ComponentValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -4455,14 +11203,14 @@ func (e ListWorkersResponse_WorkerValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sListWorkersResponse_Worker.%s: %s%s",
+		"invalid %sGetFlowMetricsResponse_Component.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = ListWorkersResponse_WorkerValidationError{}
+var _ error = GetFlowMetricsResponse_ComponentValidationError{}
 
 var _ interface {
 	Field() string
@@ -4470,7 +11218,7 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = ListWorkersResponse_WorkerValidationError{}
+} = GetFlowMetricsResponse_ComponentValidationError{}
 
 // Validate checks the field values on GetAnalyticsResponse_FlowStatusCount
 // with the rules defined in the proto definition for this message. If any
@@ -4802,3 +11550,227 @@ var _ interface {
 	Cause() error
 	ErrorName() string
 } = GetAnalyticsResponse_TimeSeriesPointValidationError{}
+
+// Validate checks the field values on GetAnalyticsResponse_ToolCallStats with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the first error encountered is returned, or nil if there are
+// no violations.
+func (m *GetAnalyticsResponse_ToolCallStats) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetAnalyticsResponse_ToolCallStats
+// with the rules defined in the proto definition for this message. If any
+// rules are violated, the result is a list of violation errors wrapped in
+// GetAnalyticsResponse_ToolCallStatsMultiError, or nil if none found.
+func (m *GetAnalyticsResponse_ToolCallStats) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetAnalyticsResponse_ToolCallStats) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Tool
+
+	// no validation rules for Calls
+
+	// no validation rules for Errors
+
+	// no validation rules for AvgLatencyMs
+
+	// no validation rules for MaxLatencyMs
+
+	if len(errors) > 0 {
+		return GetAnalyticsResponse_ToolCallStatsMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetAnalyticsResponse_ToolCallStatsMultiError is an error wrapping multiple
+// validation errors returned by
+// GetAnalyticsResponse_ToolCallStats.ValidateAll() if the designated
+// constraints aren't met.
+type GetAnalyticsResponse_ToolCallStatsMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetAnalyticsResponse_ToolCallStatsMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetAnalyticsResponse_ToolCallStatsMultiError) AllErrors() []error { return m }
+
+// GetAnalyticsResponse_ToolCallStatsValidationError is the validation error
+// returned by GetAnalyticsResponse_ToolCallStats.Validate if the designated
+// constraints aren't met.
+type GetAnalyticsResponse_ToolCallStatsValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetAnalyticsResponse_ToolCallStatsValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetAnalyticsResponse_ToolCallStatsValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetAnalyticsResponse_ToolCallStatsValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetAnalyticsResponse_ToolCallStatsValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetAnalyticsResponse_ToolCallStatsValidationError) ErrorName() string {
+	return "GetAnalyticsResponse_ToolCallStatsValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetAnalyticsResponse_ToolCallStatsValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetAnalyticsResponse_ToolCallStats.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetAnalyticsResponse_ToolCallStatsValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetAnalyticsResponse_ToolCallStatsValidationError{}
+
+// Validate checks the field values on GetRateLimitStatsResponse_Point with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GetRateLimitStatsResponse_Point) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetRateLimitStatsResponse_Point with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the result is a list of violation errors wrapped in
+// GetRateLimitStatsResponse_PointMultiError, or nil if none found.
+func (m *GetRateLimitStatsResponse_Point) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetRateLimitStatsResponse_Point) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Timestamp
+
+	// no validation rules for Allowed
+
+	// no validation rules for Denied
+
+	if len(errors) > 0 {
+		return GetRateLimitStatsResponse_PointMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetRateLimitStatsResponse_PointMultiError is an error wrapping multiple
+// validation errors returned by GetRateLimitStatsResponse_Point.ValidateAll()
+// if the designated constraints aren't met.
+type GetRateLimitStatsResponse_PointMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetRateLimitStatsResponse_PointMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetRateLimitStatsResponse_PointMultiError) AllErrors() []error { return m }
+
+// GetRateLimitStatsResponse_PointValidationError is the validation error
+// returned by GetRateLimitStatsResponse_Point.Validate if the designated
+// constraints aren't met.
+type GetRateLimitStatsResponse_PointValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetRateLimitStatsResponse_PointValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetRateLimitStatsResponse_PointValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetRateLimitStatsResponse_PointValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetRateLimitStatsResponse_PointValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetRateLimitStatsResponse_PointValidationError) ErrorName() string {
+	return "GetRateLimitStatsResponse_PointValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetRateLimitStatsResponse_PointValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetRateLimitStatsResponse_Point.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetRateLimitStatsResponse_PointValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetRateLimitStatsResponse_PointValidationError{}
@@ -45,6 +45,10 @@ const (
 	Coordinator_UpdateRateLimit_FullMethodName        = "/protorender.Coordinator/UpdateRateLimit"
 	Coordinator_DeleteRateLimit_FullMethodName        = "/protorender.Coordinator/DeleteRateLimit"
 	Coordinator_CheckRateLimit_FullMethodName         = "/protorender.Coordinator/CheckRateLimit"
+	Coordinator_ReleaseRateLimit_FullMethodName       = "/protorender.Coordinator/ReleaseRateLimit"
+	Coordinator_ListRateLimitKeys_FullMethodName      = "/protorender.Coordinator/ListRateLimitKeys"
+	Coordinator_ResetRateLimit_FullMethodName         = "/protorender.Coordinator/ResetRateLimit"
+	Coordinator_GetRateLimitStats_FullMethodName      = "/protorender.Coordinator/GetRateLimitStats"
 	Coordinator_ListBuffers_FullMethodName            = "/protorender.Coordinator/ListBuffers"
 	Coordinator_GetBuffer_FullMethodName              = "/protorender.Coordinator/GetBuffer"
 	Coordinator_CreateBuffer_FullMethodName           = "/protorender.Coordinator/CreateBuffer"
@@ -56,8 +60,39 @@ const (
 	Coordinator_UpdateFile_FullMethodName             = "/protorender.Coordinator/UpdateFile"
 	Coordinator_DeleteFile_FullMethodName             = "/protorender.Coordinator/DeleteFile"
 	Coordinator_ListEvents_FullMethodName             = "/protorender.Coordinator/ListEvents"
+	Coordinator_TailEvents_FullMethodName             = "/protorender.Coordinator/TailEvents"
+	Coordinator_GetTrace_FullMethodName               = "/protorender.Coordinator/GetTrace"
 	Coordinator_IngestEvents_FullMethodName           = "/protorender.Coordinator/IngestEvents"
 	Coordinator_IngestMetrics_FullMethodName          = "/protorender.Coordinator/IngestMetrics"
+	Coordinator_IngestLogs_FullMethodName             = "/protorender.Coordinator/IngestLogs"
+	Coordinator_ListFlowLogs_FullMethodName           = "/protorender.Coordinator/ListFlowLogs"
+	Coordinator_TailFlowLogs_FullMethodName           = "/protorender.Coordinator/TailFlowLogs"
+	Coordinator_GetFlowMetrics_FullMethodName         = "/protorender.Coordinator/GetFlowMetrics"
+	Coordinator_ReportToolProgress_FullMethodName     = "/protorender.Coordinator/ReportToolProgress"
+	Coordinator_ListToolCalls_FullMethodName          = "/protorender.Coordinator/ListToolCalls"
+	Coordinator_ListMcpServers_FullMethodName         = "/protorender.Coordinator/ListMcpServers"
+	Coordinator_GetMcpServer_FullMethodName           = "/protorender.Coordinator/GetMcpServer"
+	Coordinator_CreateMcpServer_FullMethodName        = "/protorender.Coordinator/CreateMcpServer"
+	Coordinator_UpdateMcpServer_FullMethodName        = "/protorender.Coordinator/UpdateMcpServer"
+	Coordinator_DeleteMcpServer_FullMethodName        = "/protorender.Coordinator/DeleteMcpServer"
+	Coordinator_ListQueuedRequests_FullMethodName     = "/protorender.Coordinator/ListQueuedRequests"
+	Coordinator_RetryQueuedRequest_FullMethodName     = "/protorender.Coordinator/RetryQueuedRequest"
+	Coordinator_DeleteQueuedRequest_FullMethodName    = "/protorender.Coordinator/DeleteQueuedRequest"
+	Coordinator_ListAlerts_FullMethodName             = "/protorender.Coordinator/ListAlerts"
+	Coordinator_ListAlertRules_FullMethodName         = "/protorender.Coordinator/ListAlertRules"
+	Coordinator_GetAlertRule_FullMethodName           = "/protorender.Coordinator/GetAlertRule"
+	Coordinator_CreateAlertRule_FullMethodName        = "/protorender.Coordinator/CreateAlertRule"
+	Coordinator_UpdateAlertRule_FullMethodName        = "/protorender.Coordinator/UpdateAlertRule"
+	Coordinator_DeleteAlertRule_FullMethodName        = "/protorender.Coordinator/DeleteAlertRule"
+	Coordinator_ListAlertChannels_FullMethodName      = "/protorender.Coordinator/ListAlertChannels"
+	Coordinator_GetAlertChannel_FullMethodName        = "/protorender.Coordinator/GetAlertChannel"
+	Coordinator_CreateAlertChannel_FullMethodName     = "/protorender.Coordinator/CreateAlertChannel"
+	Coordinator_UpdateAlertChannel_FullMethodName     = "/protorender.Coordinator/UpdateAlertChannel"
+	Coordinator_DeleteAlertChannel_FullMethodName     = "/protorender.Coordinator/DeleteAlertChannel"
+	Coordinator_TestAlertChannel_FullMethodName       = "/protorender.Coordinator/TestAlertChannel"
+	Coordinator_ListAlertSilences_FullMethodName      = "/protorender.Coordinator/ListAlertSilences"
+	Coordinator_CreateAlertSilence_FullMethodName     = "/protorender.Coordinator/CreateAlertSilence"
+	Coordinator_DeleteAlertSilence_FullMethodName     = "/protorender.Coordinator/DeleteAlertSilence"
 	Coordinator_GetAnalytics_FullMethodName           = "/protorender.Coordinator/GetAnalytics"
 )
 
@@ -96,6 +131,10 @@ type CoordinatorClient interface {
 	UpdateRateLimit(ctx context.Context, in *RateLimit, opts ...grpc.CallOption) (*RateLimitResponse, error)
 	DeleteRateLimit(ctx context.Context, in *GetRateLimitRequest, opts ...grpc.CallOption) (*CommonResponse, error)
 	CheckRateLimit(ctx context.Context, in *RateLimitCheckRequest, opts ...grpc.CallOption) (*RateLimitCheckResponse, error)
+	ReleaseRateLimit(ctx context.Context, in *RateLimitReleaseRequest, opts ...grpc.CallOption) (*CommonResponse, error)
+	ListRateLimitKeys(ctx context.Context, in *ListRateLimitKeysRequest, opts ...grpc.CallOption) (*ListRateLimitKeysResponse, error)
+	ResetRateLimit(ctx context.Context, in *ResetRateLimitRequest, opts ...grpc.CallOption) (*CommonResponse, error)
+	GetRateLimitStats(ctx context.Context, in *GetRateLimitStatsRequest, opts ...grpc.CallOption) (*GetRateLimitStatsResponse, error)
 	// Buffer methods
 	ListBuffers(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*ListBuffersResponse, error)
 	GetBuffer(ctx context.Context, in *GetBufferRequest, opts ...grpc.CallOption) (*BufferResponse, error)
@@ -110,8 +149,47 @@ type CoordinatorClient interface {
 	DeleteFile(ctx context.Context, in *GetFileRequest, opts ...grpc.CallOption) (*CommonResponse, error)
 	// Observability methods
 	ListEvents(ctx context.Context, in *ListEventsRequest, opts ...grpc.CallOption) (*ListEventsResponse, error)
-	IngestEvents(ctx context.Context, opts ...grpc.CallOption) (grpc.BidiStreamingClient[Event, emptypb.Empty], error)
+	// TailEvents streams the events of a flow as they are stored. Over HTTP it is served as server-sent events by
+	// GET /api/v0/flows/{flow_id}/events/tail.
+	TailEvents(ctx context.Context, in *TailEventsRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[TailEventsResponse], error)
+	GetTrace(ctx context.Context, in *GetTraceRequest, opts ...grpc.CallOption) (*GetTraceResponse, error)
+	IngestEvents(ctx context.Context, opts ...grpc.CallOption) (grpc.BidiStreamingClient[EventBatch, EventAck], error)
 	IngestMetrics(ctx context.Context, in *MetricsRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	IngestLogs(ctx context.Context, in *IngestLogsRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	ListFlowLogs(ctx context.Context, in *ListFlowLogsRequest, opts ...grpc.CallOption) (*ListFlowLogsResponse, error)
+	// TailFlowLogs streams the log lines of a flow as they are stored. Over HTTP it is served as server-sent events
+	// by GET /api/v0/flows/{flow_id}/logs/tail.
+	TailFlowLogs(ctx context.Context, in *TailFlowLogsRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[TailFlowLogsResponse], error)
+	GetFlowMetrics(ctx context.Context, in *GetFlowMetricsRequest, opts ...grpc.CallOption) (*GetFlowMetricsResponse, error)
+	// MCP methods
+	ReportToolProgress(ctx context.Context, in *ToolProgressRequest, opts ...grpc.CallOption) (*CommonResponse, error)
+	ListToolCalls(ctx context.Context, in *ListToolCallsRequest, opts ...grpc.CallOption) (*ListToolCallsResponse, error)
+	ListMcpServers(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*ListMcpServersResponse, error)
+	GetMcpServer(ctx context.Context, in *GetMcpServerRequest, opts ...grpc.CallOption) (*McpServerResponse, error)
+	CreateMcpServer(ctx context.Context, in *McpServer, opts ...grpc.CallOption) (*McpServerResponse, error)
+	UpdateMcpServer(ctx context.Context, in *McpServer, opts ...grpc.CallOption) (*McpServerResponse, error)
+	DeleteMcpServer(ctx context.Context, in *GetMcpServerRequest, opts ...grpc.CallOption) (*CommonResponse, error)
+	// Ingress queue methods
+	ListQueuedRequests(ctx context.Context, in *ListQueuedRequestsRequest, opts ...grpc.CallOption) (*ListQueuedRequestsResponse, error)
+	RetryQueuedRequest(ctx context.Context, in *QueuedRequestIdRequest, opts ...grpc.CallOption) (*CommonResponse, error)
+	DeleteQueuedRequest(ctx context.Context, in *QueuedRequestIdRequest, opts ...grpc.CallOption) (*CommonResponse, error)
+	// Alerting methods
+	ListAlerts(ctx context.Context, in *ListAlertsRequest, opts ...grpc.CallOption) (*ListAlertsResponse, error)
+	ListAlertRules(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*ListAlertRulesResponse, error)
+	GetAlertRule(ctx context.Context, in *GetAlertRuleRequest, opts ...grpc.CallOption) (*AlertRuleResponse, error)
+	CreateAlertRule(ctx context.Context, in *AlertRule, opts ...grpc.CallOption) (*AlertRuleResponse, error)
+	UpdateAlertRule(ctx context.Context, in *AlertRule, opts ...grpc.CallOption) (*AlertRuleResponse, error)
+	DeleteAlertRule(ctx context.Context, in *GetAlertRuleRequest, opts ...grpc.CallOption) (*CommonResponse, error)
+	ListAlertChannels(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*ListAlertChannelsResponse, error)
+	GetAlertChannel(ctx context.Context, in *GetAlertChannelRequest, opts ...grpc.CallOption) (*AlertChannelResponse, error)
+	CreateAlertChannel(ctx context.Context, in *AlertChannel, opts ...grpc.CallOption) (*AlertChannelResponse, error)
+	UpdateAlertChannel(ctx context.Context, in *AlertChannel, opts ...grpc.CallOption) (*AlertChannelResponse, error)
+	DeleteAlertChannel(ctx context.Context, in *GetAlertChannelRequest, opts ...grpc.CallOption) (*CommonResponse, error)
+	// TestAlertChannel sends a test notification to the channel.
+	TestAlertChannel(ctx context.Context, in *GetAlertChannelRequest, opts ...grpc.CallOption) (*CommonResponse, error)
+	ListAlertSilences(ctx context.Context, in *ListAlertSilencesRequest, opts ...grpc.CallOption) (*ListAlertSilencesResponse, error)
+	CreateAlertSilence(ctx context.Context, in *AlertSilence, opts ...grpc.CallOption) (*AlertSilenceResponse, error)
+	DeleteAlertSilence(ctx context.Context, in *GetAlertSilenceRequest, opts ...grpc.CallOption) (*CommonResponse, error)
 	// Analytics methods
 	GetAnalytics(ctx context.Context, in *GetAnalyticsRequest, opts ...grpc.CallOption) (*GetAnalyticsResponse, error)
 }
@@ -374,6 +452,46 @@ func (c *coordinatorClient) CheckRateLimit(ctx context.Context, in *RateLimitChe
 	return out, nil
 }
 
+func (c *coordinatorClient) ReleaseRateLimit(ctx context.Context, in *RateLimitReleaseRequest, opts ...grpc.CallOption) (*CommonResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CommonResponse)
+	err := c.cc.Invoke(ctx, Coordinator_ReleaseRateLimit_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *coordinatorClient) ListRateLimitKeys(ctx context.Context, in *ListRateLimitKeysRequest, opts ...grpc.CallOption) (*ListRateLimitKeysResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListRateLimitKeysResponse)
+	err := c.cc.Invoke(ctx, Coordinator_ListRateLimitKeys_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *coordinatorClient) ResetRateLimit(ctx context.Context, in *ResetRateLimitRequest, opts ...grpc.CallOption) (*CommonResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CommonResponse)
+	err := c.cc.Invoke(ctx, Coordinator_ResetRateLimit_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *coordinatorClient) GetRateLimitStats(ctx context.Context, in *GetRateLimitStatsRequest, opts ...grpc.CallOption) (*GetRateLimitStatsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetRateLimitStatsResponse)
+	err := c.cc.Invoke(ctx, Coordinator_GetRateLimitStats_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *coordinatorClient) ListBuffers(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*ListBuffersResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(ListBuffersResponse)
@@ -484,18 +602,47 @@ func (c *coordinatorClient) ListEvents(ctx context.Context, in *ListEventsReques
 	return out, nil
 }
 
-func (c *coordinatorClient) IngestEvents(ctx context.Context, opts ...grpc.CallOption) (grpc.BidiStreamingClient[Event, emptypb.Empty], error) {
+func (c *coordinatorClient) TailEvents(ctx context.Context, in *TailEventsRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[TailEventsResponse], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &Coordinator_ServiceDesc.Streams[0], Coordinator_TailEvents_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[TailEventsRequest, TailEventsResponse]{ClientStream: stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type Coordinator_TailEventsClient = grpc.ServerStreamingClient[TailEventsResponse]
+
+func (c *coordinatorClient) GetTrace(ctx context.Context, in *GetTraceRequest, opts ...grpc.CallOption) (*GetTraceResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetTraceResponse)
+	err := c.cc.Invoke(ctx, Coordinator_GetTrace_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *coordinatorClient) IngestEvents(ctx context.Context, opts ...grpc.CallOption) (grpc.BidiStreamingClient[EventBatch, EventAck], error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
-	stream, err := c.cc.NewStream(ctx, &Coordinator_ServiceDesc.Streams[0], Coordinator_IngestEvents_FullMethodName, cOpts...)
+	stream, err := c.cc.NewStream(ctx, &Coordinator_ServiceDesc.Streams[1], Coordinator_IngestEvents_FullMethodName, cOpts...)
 	if err != nil {
 		return nil, err
 	}
-	x := &grpc.GenericClientStream[Event, emptypb.Empty]{ClientStream: stream}
+	x := &grpc.GenericClientStream[EventBatch, EventAck]{ClientStream: stream}
 	return x, nil
 }
 
 // This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
-type Coordinator_IngestEventsClient = grpc.BidiStreamingClient[Event, emptypb.Empty]
+type Coordinator_IngestEventsClient = grpc.BidiStreamingClient[EventBatch, EventAck]
 
 func (c *coordinatorClient) IngestMetrics(ctx context.Context, in *MetricsRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
@@ -507,126 +654,468 @@ func (c *coordinatorClient) IngestMetrics(ctx context.Context, in *MetricsReques
 	return out, nil
 }
 
-func (c *coordinatorClient) GetAnalytics(ctx context.Context, in *GetAnalyticsRequest, opts ...grpc.CallOption) (*GetAnalyticsResponse, error) {
+func (c *coordinatorClient) IngestLogs(ctx context.Context, in *IngestLogsRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
-	out := new(GetAnalyticsResponse)
-	err := c.cc.Invoke(ctx, Coordinator_GetAnalytics_FullMethodName, in, out, cOpts...)
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, Coordinator_IngestLogs_FullMethodName, in, out, cOpts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-// CoordinatorServer is the server API for Coordinator service.
-// All implementations must embed UnimplementedCoordinatorServer
-// for forward compatibility.
-type CoordinatorServer interface {
-	// Worker flow methods
-	UpdateWorkerFlowStatus(context.Context, *WorkerFlowStatusRequest) (*CommonResponse, error)
-	// Worker methods
-	RegisterWorker(context.Context, *RegisterWorkerRequest) (*CommonResponse, error)
-	DeregisterWorker(context.Context, *DeregisterWorkerRequest) (*CommonResponse, error)
-	Heartbeat(context.Context, *HeartbeatRequest) (*HeartbeatResponse, error)
-	ListWorkers(context.Context, *ListWorkersRequest) (*ListWorkersResponse, error)
-	// Flow methods
-	ListFlows(context.Context, *ListFlowsRequest) (*ListFlowsResponse, error)
-	GetFlow(context.Context, *GetFlowRequest) (*FlowResponse, error)
-	CreateFlow(context.Context, *Flow) (*FlowResponse, error)
-	UpdateFlow(context.Context, *Flow) (*FlowResponse, error)
-	// Secret methods
-	ListSecrets(context.Context, *emptypb.Empty) (*ListSecretsResponse, error)
-	CreateSecret(context.Context, *SecretRequest) (*CommonResponse, error)
-	UpdateSecret(context.Context, *SecretRequest) (*CommonResponse, error)
-	GetSecret(context.Context, *SecretRequest) (*SecretResponse, error)
-	DeleteSecret(context.Context, *SecretRequest) (*CommonResponse, error)
-	// Cache methods
-	ListCaches(context.Context, *emptypb.Empty) (*ListCachesResponse, error)
-	GetCache(context.Context, *GetCacheRequest) (*CacheResponse, error)
-	CreateCache(context.Context, *Cache) (*CacheResponse, error)
-	UpdateCache(context.Context, *Cache) (*CacheResponse, error)
-	DeleteCache(context.Context, *GetCacheRequest) (*CommonResponse, error)
-	// Rate limit methods
-	ListRateLimits(context.Context, *emptypb.Empty) (*ListRateLimitsResponse, error)
-	GetRateLimit(context.Context, *GetRateLimitRequest) (*RateLimitResponse, error)
-	CreateRateLimit(context.Context, *RateLimit) (*RateLimitResponse, error)
-	UpdateRateLimit(context.Context, *RateLimit) (*RateLimitResponse, error)
-	DeleteRateLimit(context.Context, *GetRateLimitRequest) (*CommonResponse, error)
-	CheckRateLimit(context.Context, *RateLimitCheckRequest) (*RateLimitCheckResponse, error)
-	// Buffer methods
-	ListBuffers(context.Context, *emptypb.Empty) (*ListBuffersResponse, error)
-	GetBuffer(context.Context, *GetBufferRequest) (*BufferResponse, error)
-	CreateBuffer(context.Context, *Buffer) (*BufferResponse, error)
-	UpdateBuffer(context.Context, *Buffer) (*BufferResponse, error)
-	DeleteBuffer(context.Context, *GetBufferRequest) (*CommonResponse, error)
-	// File methods
-	ListFiles(context.Context, *emptypb.Empty) (*ListFilesResponse, error)
-	GetFile(context.Context, *GetFileRequest) (*FileResponse, error)
-	CreateFile(context.Context, *File) (*FileResponse, error)
-	UpdateFile(context.Context, *File) (*FileResponse, error)
-	DeleteFile(context.Context, *GetFileRequest) (*CommonResponse, error)
-	// Observability methods
-	ListEvents(context.Context, *ListEventsRequest) (*ListEventsResponse, error)
-	IngestEvents(grpc.BidiStreamingServer[Event, emptypb.Empty]) error
-	IngestMetrics(context.Context, *MetricsRequest) (*emptypb.Empty, error)
-	// Analytics methods
-	GetAnalytics(context.Context, *GetAnalyticsRequest) (*GetAnalyticsResponse, error)
-	mustEmbedUnimplementedCoordinatorServer()
+func (c *coordinatorClient) ListFlowLogs(ctx context.Context, in *ListFlowLogsRequest, opts ...grpc.CallOption) (*ListFlowLogsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListFlowLogsResponse)
+	err := c.cc.Invoke(ctx, Coordinator_ListFlowLogs_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-// UnimplementedCoordinatorServer must be embedded to have
-// forward compatible implementations.
-//
-// NOTE: this should be embedded by value instead of pointer to avoid a nil
-// pointer dereference when methods are called.
-type UnimplementedCoordinatorServer struct{}
-
-func (UnimplementedCoordinatorServer) UpdateWorkerFlowStatus(context.Context, *WorkerFlowStatusRequest) (*CommonResponse, error) {
-	return nil, status.Error(codes.Unimplemented, "method UpdateWorkerFlowStatus not implemented")
+func (c *coordinatorClient) TailFlowLogs(ctx context.Context, in *TailFlowLogsRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[TailFlowLogsResponse], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &Coordinator_ServiceDesc.Streams[2], Coordinator_TailFlowLogs_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[TailFlowLogsRequest, TailFlowLogsResponse]{ClientStream: stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
 }
-func (UnimplementedCoordinatorServer) RegisterWorker(context.Context, *RegisterWorkerRequest) (*CommonResponse, error) {
-	return nil, status.Error(codes.Unimplemented, "method RegisterWorker not implemented")
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type Coordinator_TailFlowLogsClient = grpc.ServerStreamingClient[TailFlowLogsResponse]
+
+func (c *coordinatorClient) GetFlowMetrics(ctx context.Context, in *GetFlowMetricsRequest, opts ...grpc.CallOption) (*GetFlowMetricsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetFlowMetricsResponse)
+	err := c.cc.Invoke(ctx, Coordinator_GetFlowMetrics_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedCoordinatorServer) DeregisterWorker(context.Context, *DeregisterWorkerRequest) (*CommonResponse, error) {
-	return nil, status.Error(codes.Unimplemented, "method DeregisterWorker not implemented")
+
+func (c *coordinatorClient) ReportToolProgress(ctx context.Context, in *ToolProgressRequest, opts ...grpc.CallOption) (*CommonResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CommonResponse)
+	err := c.cc.Invoke(ctx, Coordinator_ReportToolProgress_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedCoordinatorServer) Heartbeat(context.Context, *HeartbeatRequest) (*HeartbeatResponse, error) {
-	return nil, status.Error(codes.Unimplemented, "method Heartbeat not implemented")
+
+func (c *coordinatorClient) ListToolCalls(ctx context.Context, in *ListToolCallsRequest, opts ...grpc.CallOption) (*ListToolCallsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListToolCallsResponse)
+	err := c.cc.Invoke(ctx, Coordinator_ListToolCalls_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedCoordinatorServer) ListWorkers(context.Context, *ListWorkersRequest) (*ListWorkersResponse, error) {
-	return nil, status.Error(codes.Unimplemented, "method ListWorkers not implemented")
+
+func (c *coordinatorClient) ListMcpServers(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*ListMcpServersResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListMcpServersResponse)
+	err := c.cc.Invoke(ctx, Coordinator_ListMcpServers_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedCoordinatorServer) ListFlows(context.Context, *ListFlowsRequest) (*ListFlowsResponse, error) {
-	return nil, status.Error(codes.Unimplemented, "method ListFlows not implemented")
+
+func (c *coordinatorClient) GetMcpServer(ctx context.Context, in *GetMcpServerRequest, opts ...grpc.CallOption) (*McpServerResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(McpServerResponse)
+	err := c.cc.Invoke(ctx, Coordinator_GetMcpServer_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedCoordinatorServer) GetFlow(context.Context, *GetFlowRequest) (*FlowResponse, error) {
-	return nil, status.Error(codes.Unimplemented, "method GetFlow not implemented")
+
+func (c *coordinatorClient) CreateMcpServer(ctx context.Context, in *McpServer, opts ...grpc.CallOption) (*McpServerResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(McpServerResponse)
+	err := c.cc.Invoke(ctx, Coordinator_CreateMcpServer_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedCoordinatorServer) CreateFlow(context.Context, *Flow) (*FlowResponse, error) {
-	return nil, status.Error(codes.Unimplemented, "method CreateFlow not implemented")
+
+func (c *coordinatorClient) UpdateMcpServer(ctx context.Context, in *McpServer, opts ...grpc.CallOption) (*McpServerResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(McpServerResponse)
+	err := c.cc.Invoke(ctx, Coordinator_UpdateMcpServer_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedCoordinatorServer) UpdateFlow(context.Context, *Flow) (*FlowResponse, error) {
-	return nil, status.Error(codes.Unimplemented, "method UpdateFlow not implemented")
+
+func (c *coordinatorClient) DeleteMcpServer(ctx context.Context, in *GetMcpServerRequest, opts ...grpc.CallOption) (*CommonResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CommonResponse)
+	err := c.cc.Invoke(ctx, Coordinator_DeleteMcpServer_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedCoordinatorServer) ListSecrets(context.Context, *emptypb.Empty) (*ListSecretsResponse, error) {
-	return nil, status.Error(codes.Unimplemented, "method ListSecrets not implemented")
+
+func (c *coordinatorClient) ListQueuedRequests(ctx context.Context, in *ListQueuedRequestsRequest, opts ...grpc.CallOption) (*ListQueuedRequestsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListQueuedRequestsResponse)
+	err := c.cc.Invoke(ctx, Coordinator_ListQueuedRequests_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedCoordinatorServer) CreateSecret(context.Context, *SecretRequest) (*CommonResponse, error) {
-	return nil, status.Error(codes.Unimplemented, "method CreateSecret not implemented")
+
+func (c *coordinatorClient) RetryQueuedRequest(ctx context.Context, in *QueuedRequestIdRequest, opts ...grpc.CallOption) (*CommonResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CommonResponse)
+	err := c.cc.Invoke(ctx, Coordinator_RetryQueuedRequest_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedCoordinatorServer) UpdateSecret(context.Context, *SecretRequest) (*CommonResponse, error) {
-	return nil, status.Error(codes.Unimplemented, "method UpdateSecret not implemented")
+
+func (c *coordinatorClient) DeleteQueuedRequest(ctx context.Context, in *QueuedRequestIdRequest, opts ...grpc.CallOption) (*CommonResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CommonResponse)
+	err := c.cc.Invoke(ctx, Coordinator_DeleteQueuedRequest_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedCoordinatorServer) GetSecret(context.Context, *SecretRequest) (*SecretResponse, error) {
-	return nil, status.Error(codes.Unimplemented, "method GetSecret not implemented")
+
+func (c *coordinatorClient) ListAlerts(ctx context.Context, in *ListAlertsRequest, opts ...grpc.CallOption) (*ListAlertsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListAlertsResponse)
+	err := c.cc.Invoke(ctx, Coordinator_ListAlerts_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedCoordinatorServer) DeleteSecret(context.Context, *SecretRequest) (*CommonResponse, error) {
-	return nil, status.Error(codes.Unimplemented, "method DeleteSecret not implemented")
+
+func (c *coordinatorClient) ListAlertRules(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*ListAlertRulesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListAlertRulesResponse)
+	err := c.cc.Invoke(ctx, Coordinator_ListAlertRules_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedCoordinatorServer) ListCaches(context.Context, *emptypb.Empty) (*ListCachesResponse, error) {
-	return nil, status.Error(codes.Unimplemented, "method ListCaches not implemented")
+
+func (c *coordinatorClient) GetAlertRule(ctx context.Context, in *GetAlertRuleRequest, opts ...grpc.CallOption) (*AlertRuleResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(AlertRuleResponse)
+	err := c.cc.Invoke(ctx, Coordinator_GetAlertRule_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedCoordinatorServer) GetCache(context.Context, *GetCacheRequest) (*CacheResponse, error) {
-	return nil, status.Error(codes.Unimplemented, "method GetCache not implemented")
+
+func (c *coordinatorClient) CreateAlertRule(ctx context.Context, in *AlertRule, opts ...grpc.CallOption) (*AlertRuleResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(AlertRuleResponse)
+	err := c.cc.Invoke(ctx, Coordinator_CreateAlertRule_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *coordinatorClient) UpdateAlertRule(ctx context.Context, in *AlertRule, opts ...grpc.CallOption) (*AlertRuleResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(AlertRuleResponse)
+	err := c.cc.Invoke(ctx, Coordinator_UpdateAlertRule_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *coordinatorClient) DeleteAlertRule(ctx context.Context, in *GetAlertRuleRequest, opts ...grpc.CallOption) (*CommonResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CommonResponse)
+	err := c.cc.Invoke(ctx, Coordinator_DeleteAlertRule_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *coordinatorClient) ListAlertChannels(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*ListAlertChannelsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListAlertChannelsResponse)
+	err := c.cc.Invoke(ctx, Coordinator_ListAlertChannels_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *coordinatorClient) GetAlertChannel(ctx context.Context, in *GetAlertChannelRequest, opts ...grpc.CallOption) (*AlertChannelResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(AlertChannelResponse)
+	err := c.cc.Invoke(ctx, Coordinator_GetAlertChannel_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *coordinatorClient) CreateAlertChannel(ctx context.Context, in *AlertChannel, opts ...grpc.CallOption) (*AlertChannelResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(AlertChannelResponse)
+	err := c.cc.Invoke(ctx, Coordinator_CreateAlertChannel_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *coordinatorClient) UpdateAlertChannel(ctx context.Context, in *AlertChannel, opts ...grpc.CallOption) (*AlertChannelResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(AlertChannelResponse)
+	err := c.cc.Invoke(ctx, Coordinator_UpdateAlertChannel_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *coordinatorClient) DeleteAlertChannel(ctx context.Context, in *GetAlertChannelRequest, opts ...grpc.CallOption) (*CommonResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CommonResponse)
+	err := c.cc.Invoke(ctx, Coordinator_DeleteAlertChannel_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *coordinatorClient) TestAlertChannel(ctx context.Context, in *GetAlertChannelRequest, opts ...grpc.CallOption) (*CommonResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CommonResponse)
+	err := c.cc.Invoke(ctx, Coordinator_TestAlertChannel_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *coordinatorClient) ListAlertSilences(ctx context.Context, in *ListAlertSilencesRequest, opts ...grpc.CallOption) (*ListAlertSilencesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListAlertSilencesResponse)
+	err := c.cc.Invoke(ctx, Coordinator_ListAlertSilences_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *coordinatorClient) CreateAlertSilence(ctx context.Context, in *AlertSilence, opts ...grpc.CallOption) (*AlertSilenceResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(AlertSilenceResponse)
+	err := c.cc.Invoke(ctx, Coordinator_CreateAlertSilence_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *coordinatorClient) DeleteAlertSilence(ctx context.Context, in *GetAlertSilenceRequest, opts ...grpc.CallOption) (*CommonResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CommonResponse)
+	err := c.cc.Invoke(ctx, Coordinator_DeleteAlertSilence_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *coordinatorClient) GetAnalytics(ctx context.Context, in *GetAnalyticsRequest, opts ...grpc.CallOption) (*GetAnalyticsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetAnalyticsResponse)
+	err := c.cc.Invoke(ctx, Coordinator_GetAnalytics_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// CoordinatorServer is the server API for Coordinator service.
+// All implementations must embed UnimplementedCoordinatorServer
+// for forward compatibility.
+type CoordinatorServer interface {
+	// Worker flow methods
+	UpdateWorkerFlowStatus(context.Context, *WorkerFlowStatusRequest) (*CommonResponse, error)
+	// Worker methods
+	RegisterWorker(context.Context, *RegisterWorkerRequest) (*CommonResponse, error)
+	DeregisterWorker(context.Context, *DeregisterWorkerRequest) (*CommonResponse, error)
+	Heartbeat(context.Context, *HeartbeatRequest) (*HeartbeatResponse, error)
+	ListWorkers(context.Context, *ListWorkersRequest) (*ListWorkersResponse, error)
+	// Flow methods
+	ListFlows(context.Context, *ListFlowsRequest) (*ListFlowsResponse, error)
+	GetFlow(context.Context, *GetFlowRequest) (*FlowResponse, error)
+	CreateFlow(context.Context, *Flow) (*FlowResponse, error)
+	UpdateFlow(context.Context, *Flow) (*FlowResponse, error)
+	// Secret methods
+	ListSecrets(context.Context, *emptypb.Empty) (*ListSecretsResponse, error)
+	CreateSecret(context.Context, *SecretRequest) (*CommonResponse, error)
+	UpdateSecret(context.Context, *SecretRequest) (*CommonResponse, error)
+	GetSecret(context.Context, *SecretRequest) (*SecretResponse, error)
+	DeleteSecret(context.Context, *SecretRequest) (*CommonResponse, error)
+	// Cache methods
+	ListCaches(context.Context, *emptypb.Empty) (*ListCachesResponse, error)
+	GetCache(context.Context, *GetCacheRequest) (*CacheResponse, error)
+	CreateCache(context.Context, *Cache) (*CacheResponse, error)
+	UpdateCache(context.Context, *Cache) (*CacheResponse, error)
+	DeleteCache(context.Context, *GetCacheRequest) (*CommonResponse, error)
+	// Rate limit methods
+	ListRateLimits(context.Context, *emptypb.Empty) (*ListRateLimitsResponse, error)
+	GetRateLimit(context.Context, *GetRateLimitRequest) (*RateLimitResponse, error)
+	CreateRateLimit(context.Context, *RateLimit) (*RateLimitResponse, error)
+	UpdateRateLimit(context.Context, *RateLimit) (*RateLimitResponse, error)
+	DeleteRateLimit(context.Context, *GetRateLimitRequest) (*CommonResponse, error)
+	CheckRateLimit(context.Context, *RateLimitCheckRequest) (*RateLimitCheckResponse, error)
+	ReleaseRateLimit(context.Context, *RateLimitReleaseRequest) (*CommonResponse, error)
+	ListRateLimitKeys(context.Context, *ListRateLimitKeysRequest) (*ListRateLimitKeysResponse, error)
+	ResetRateLimit(context.Context, *ResetRateLimitRequest) (*CommonResponse, error)
+	GetRateLimitStats(context.Context, *GetRateLimitStatsRequest) (*GetRateLimitStatsResponse, error)
+	// Buffer methods
+	ListBuffers(context.Context, *emptypb.Empty) (*ListBuffersResponse, error)
+	GetBuffer(context.Context, *GetBufferRequest) (*BufferResponse, error)
+	CreateBuffer(context.Context, *Buffer) (*BufferResponse, error)
+	UpdateBuffer(context.Context, *Buffer) (*BufferResponse, error)
+	DeleteBuffer(context.Context, *GetBufferRequest) (*CommonResponse, error)
+	// File methods
+	ListFiles(context.Context, *emptypb.Empty) (*ListFilesResponse, error)
+	GetFile(context.Context, *GetFileRequest) (*FileResponse, error)
+	CreateFile(context.Context, *File) (*FileResponse, error)
+	UpdateFile(context.Context, *File) (*FileResponse, error)
+	DeleteFile(context.Context, *GetFileRequest) (*CommonResponse, error)
+	// Observability methods
+	ListEvents(context.Context, *ListEventsRequest) (*ListEventsResponse, error)
+	// TailEvents streams the events of a flow as they are stored. Over HTTP it is served as server-sent events by
+	// GET /api/v0/flows/{flow_id}/events/tail.
+	TailEvents(*TailEventsRequest, grpc.ServerStreamingServer[TailEventsResponse]) error
+	GetTrace(context.Context, *GetTraceRequest) (*GetTraceResponse, error)
+	IngestEvents(grpc.BidiStreamingServer[EventBatch, EventAck]) error
+	IngestMetrics(context.Context, *MetricsRequest) (*emptypb.Empty, error)
+	IngestLogs(context.Context, *IngestLogsRequest) (*emptypb.Empty, error)
+	ListFlowLogs(context.Context, *ListFlowLogsRequest) (*ListFlowLogsResponse, error)
+	// TailFlowLogs streams the log lines of a flow as they are stored. Over HTTP it is served as server-sent events
+	// by GET /api/v0/flows/{flow_id}/logs/tail.
+	TailFlowLogs(*TailFlowLogsRequest, grpc.ServerStreamingServer[TailFlowLogsResponse]) error
+	GetFlowMetrics(context.Context, *GetFlowMetricsRequest) (*GetFlowMetricsResponse, error)
+	// MCP methods
+	ReportToolProgress(context.Context, *ToolProgressRequest) (*CommonResponse, error)
+	ListToolCalls(context.Context, *ListToolCallsRequest) (*ListToolCallsResponse, error)
+	ListMcpServers(context.Context, *emptypb.Empty) (*ListMcpServersResponse, error)
+	GetMcpServer(context.Context, *GetMcpServerRequest) (*McpServerResponse, error)
+	CreateMcpServer(context.Context, *McpServer) (*McpServerResponse, error)
+	UpdateMcpServer(context.Context, *McpServer) (*McpServerResponse, error)
+	DeleteMcpServer(context.Context, *GetMcpServerRequest) (*CommonResponse, error)
+	// Ingress queue methods
+	ListQueuedRequests(context.Context, *ListQueuedRequestsRequest) (*ListQueuedRequestsResponse, error)
+	RetryQueuedRequest(context.Context, *QueuedRequestIdRequest) (*CommonResponse, error)
+	DeleteQueuedRequest(context.Context, *QueuedRequestIdRequest) (*CommonResponse, error)
+	// Alerting methods
+	ListAlerts(context.Context, *ListAlertsRequest) (*ListAlertsResponse, error)
+	ListAlertRules(context.Context, *emptypb.Empty) (*ListAlertRulesResponse, error)
+	GetAlertRule(context.Context, *GetAlertRuleRequest) (*AlertRuleResponse, error)
+	CreateAlertRule(context.Context, *AlertRule) (*AlertRuleResponse, error)
+	UpdateAlertRule(context.Context, *AlertRule) (*AlertRuleResponse, error)
+	DeleteAlertRule(context.Context, *GetAlertRuleRequest) (*CommonResponse, error)
+	ListAlertChannels(context.Context, *emptypb.Empty) (*ListAlertChannelsResponse, error)
+	GetAlertChannel(context.Context, *GetAlertChannelRequest) (*AlertChannelResponse, error)
+	CreateAlertChannel(context.Context, *AlertChannel) (*AlertChannelResponse, error)
+	UpdateAlertChannel(context.Context, *AlertChannel) (*AlertChannelResponse, error)
+	DeleteAlertChannel(context.Context, *GetAlertChannelRequest) (*CommonResponse, error)
+	// TestAlertChannel sends a test notification to the channel.
+	TestAlertChannel(context.Context, *GetAlertChannelRequest) (*CommonResponse, error)
+	ListAlertSilences(context.Context, *ListAlertSilencesRequest) (*ListAlertSilencesResponse, error)
+	CreateAlertSilence(context.Context, *AlertSilence) (*AlertSilenceResponse, error)
+	DeleteAlertSilence(context.Context, *GetAlertSilenceRequest) (*CommonResponse, error)
+	// Analytics methods
+	GetAnalytics(context.Context, *GetAnalyticsRequest) (*GetAnalyticsResponse, error)
+	mustEmbedUnimplementedCoordinatorServer()
+}
+
+// UnimplementedCoordinatorServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedCoordinatorServer struct{}
+
+func (UnimplementedCoordinatorServer) UpdateWorkerFlowStatus(context.Context, *WorkerFlowStatusRequest) (*CommonResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method UpdateWorkerFlowStatus not implemented")
+}
+func (UnimplementedCoordinatorServer) RegisterWorker(context.Context, *RegisterWorkerRequest) (*CommonResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method RegisterWorker not implemented")
+}
+func (UnimplementedCoordinatorServer) DeregisterWorker(context.Context, *DeregisterWorkerRequest) (*CommonResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method DeregisterWorker not implemented")
+}
+func (UnimplementedCoordinatorServer) Heartbeat(context.Context, *HeartbeatRequest) (*HeartbeatResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method Heartbeat not implemented")
+}
+func (UnimplementedCoordinatorServer) ListWorkers(context.Context, *ListWorkersRequest) (*ListWorkersResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ListWorkers not implemented")
+}
+func (UnimplementedCoordinatorServer) ListFlows(context.Context, *ListFlowsRequest) (*ListFlowsResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ListFlows not implemented")
+}
+func (UnimplementedCoordinatorServer) GetFlow(context.Context, *GetFlowRequest) (*FlowResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetFlow not implemented")
+}
+func (UnimplementedCoordinatorServer) CreateFlow(context.Context, *Flow) (*FlowResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method CreateFlow not implemented")
+}
+func (UnimplementedCoordinatorServer) UpdateFlow(context.Context, *Flow) (*FlowResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method UpdateFlow not implemented")
+}
+func (UnimplementedCoordinatorServer) ListSecrets(context.Context, *emptypb.Empty) (*ListSecretsResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ListSecrets not implemented")
+}
+func (UnimplementedCoordinatorServer) CreateSecret(context.Context, *SecretRequest) (*CommonResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method CreateSecret not implemented")
+}
+func (UnimplementedCoordinatorServer) UpdateSecret(context.Context, *SecretRequest) (*CommonResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method UpdateSecret not implemented")
+}
+func (UnimplementedCoordinatorServer) GetSecret(context.Context, *SecretRequest) (*SecretResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetSecret not implemented")
+}
+func (UnimplementedCoordinatorServer) DeleteSecret(context.Context, *SecretRequest) (*CommonResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method DeleteSecret not implemented")
+}
+func (UnimplementedCoordinatorServer) ListCaches(context.Context, *emptypb.Empty) (*ListCachesResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ListCaches not implemented")
+}
+func (UnimplementedCoordinatorServer) GetCache(context.Context, *GetCacheRequest) (*CacheResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetCache not implemented")
 }
 func (UnimplementedCoordinatorServer) CreateCache(context.Context, *Cache) (*CacheResponse, error) {
 	return nil, status.Error(codes.Unimplemented, "method CreateCache not implemented")
@@ -655,6 +1144,18 @@ func (UnimplementedCoordinatorServer) DeleteRateLimit(context.Context, *GetRateL
 func (UnimplementedCoordinatorServer) CheckRateLimit(context.Context, *RateLimitCheckRequest) (*RateLimitCheckResponse, error) {
 	return nil, status.Error(codes.Unimplemented, "method CheckRateLimit not implemented")
 }
+func (UnimplementedCoordinatorServer) ReleaseRateLimit(context.Context, *RateLimitReleaseRequest) (*CommonResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ReleaseRateLimit not implemented")
+}
+func (UnimplementedCoordinatorServer) ListRateLimitKeys(context.Context, *ListRateLimitKeysRequest) (*ListRateLimitKeysResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ListRateLimitKeys not implemented")
+}
+func (UnimplementedCoordinatorServer) ResetRateLimit(context.Context, *ResetRateLimitRequest) (*CommonResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ResetRateLimit not implemented")
+}
+func (UnimplementedCoordinatorServer) GetRateLimitStats(context.Context, *GetRateLimitStatsRequest) (*GetRateLimitStatsResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetRateLimitStats not implemented")
+}
 func (UnimplementedCoordinatorServer) ListBuffers(context.Context, *emptypb.Empty) (*ListBuffersResponse, error) {
 	return nil, status.Error(codes.Unimplemented, "method ListBuffers not implemented")
 }
@@ -688,12 +1189,105 @@ func (UnimplementedCoordinatorServer) DeleteFile(context.Context, *GetFileReques
 func (UnimplementedCoordinatorServer) ListEvents(context.Context, *ListEventsRequest) (*ListEventsResponse, error) {
 	return nil, status.Error(codes.Unimplemented, "method ListEvents not implemented")
 }
-func (UnimplementedCoordinatorServer) IngestEvents(grpc.BidiStreamingServer[Event, emptypb.Empty]) error {
+func (UnimplementedCoordinatorServer) TailEvents(*TailEventsRequest, grpc.ServerStreamingServer[TailEventsResponse]) error {
+	return status.Error(codes.Unimplemented, "method TailEvents not implemented")
+}
+func (UnimplementedCoordinatorServer) GetTrace(context.Context, *GetTraceRequest) (*GetTraceResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetTrace not implemented")
+}
+func (UnimplementedCoordinatorServer) IngestEvents(grpc.BidiStreamingServer[EventBatch, EventAck]) error {
 	return status.Error(codes.Unimplemented, "method IngestEvents not implemented")
 }
 func (UnimplementedCoordinatorServer) IngestMetrics(context.Context, *MetricsRequest) (*emptypb.Empty, error) {
 	return nil, status.Error(codes.Unimplemented, "method IngestMetrics not implemented")
 }
+func (UnimplementedCoordinatorServer) IngestLogs(context.Context, *IngestLogsRequest) (*emptypb.Empty, error) {
+	return nil, status.Error(codes.Unimplemented, "method IngestLogs not implemented")
+}
+func (UnimplementedCoordinatorServer) ListFlowLogs(context.Context, *ListFlowLogsRequest) (*ListFlowLogsResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ListFlowLogs not implemented")
+}
+func (UnimplementedCoordinatorServer) TailFlowLogs(*TailFlowLogsRequest, grpc.ServerStreamingServer[TailFlowLogsResponse]) error {
+	return status.Error(codes.Unimplemented, "method TailFlowLogs not implemented")
+}
+func (UnimplementedCoordinatorServer) GetFlowMetrics(context.Context, *GetFlowMetricsRequest) (*GetFlowMetricsResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetFlowMetrics not implemented")
+}
+func (UnimplementedCoordinatorServer) ReportToolProgress(context.Context, *ToolProgressRequest) (*CommonResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ReportToolProgress not implemented")
+}
+func (UnimplementedCoordinatorServer) ListToolCalls(context.Context, *ListToolCallsRequest) (*ListToolCallsResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ListToolCalls not implemented")
+}
+func (UnimplementedCoordinatorServer) ListMcpServers(context.Context, *emptypb.Empty) (*ListMcpServersResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ListMcpServers not implemented")
+}
+func (UnimplementedCoordinatorServer) GetMcpServer(context.Context, *GetMcpServerRequest) (*McpServerResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetMcpServer not implemented")
+}
+func (UnimplementedCoordinatorServer) CreateMcpServer(context.Context, *McpServer) (*McpServerResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method CreateMcpServer not implemented")
+}
+func (UnimplementedCoordinatorServer) UpdateMcpServer(context.Context, *McpServer) (*McpServerResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method UpdateMcpServer not implemented")
+}
+func (UnimplementedCoordinatorServer) DeleteMcpServer(context.Context, *GetMcpServerRequest) (*CommonResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method DeleteMcpServer not implemented")
+}
+func (UnimplementedCoordinatorServer) ListQueuedRequests(context.Context, *ListQueuedRequestsRequest) (*ListQueuedRequestsResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ListQueuedRequests not implemented")
+}
+func (UnimplementedCoordinatorServer) RetryQueuedRequest(context.Context, *QueuedRequestIdRequest) (*CommonResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method RetryQueuedRequest not implemented")
+}
+func (UnimplementedCoordinatorServer) DeleteQueuedRequest(context.Context, *QueuedRequestIdRequest) (*CommonResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method DeleteQueuedRequest not implemented")
+}
+func (UnimplementedCoordinatorServer) ListAlerts(context.Context, *ListAlertsRequest) (*ListAlertsResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ListAlerts not implemented")
+}
+func (UnimplementedCoordinatorServer) ListAlertRules(context.Context, *emptypb.Empty) (*ListAlertRulesResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ListAlertRules not implemented")
+}
+func (UnimplementedCoordinatorServer) GetAlertRule(context.Context, *GetAlertRuleRequest) (*AlertRuleResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetAlertRule not implemented")
+}
+func (UnimplementedCoordinatorServer) CreateAlertRule(context.Context, *AlertRule) (*AlertRuleResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method CreateAlertRule not implemented")
+}
+func (UnimplementedCoordinatorServer) UpdateAlertRule(context.Context, *AlertRule) (*AlertRuleResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method UpdateAlertRule not implemented")
+}
+func (UnimplementedCoordinatorServer) DeleteAlertRule(context.Context, *GetAlertRuleRequest) (*CommonResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method DeleteAlertRule not implemented")
+}
+func (UnimplementedCoordinatorServer) ListAlertChannels(context.Context, *emptypb.Empty) (*ListAlertChannelsResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ListAlertChannels not implemented")
+}
+func (UnimplementedCoordinatorServer) GetAlertChannel(context.Context, *GetAlertChannelRequest) (*AlertChannelResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetAlertChannel not implemented")
+}
+func (UnimplementedCoordinatorServer) CreateAlertChannel(context.Context, *AlertChannel) (*AlertChannelResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method CreateAlertChannel not implemented")
+}
+func (UnimplementedCoordinatorServer) UpdateAlertChannel(context.Context, *AlertChannel) (*AlertChannelResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method UpdateAlertChannel not implemented")
+}
+func (UnimplementedCoordinatorServer) DeleteAlertChannel(context.Context, *GetAlertChannelRequest) (*CommonResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method DeleteAlertChannel not implemented")
+}
+func (UnimplementedCoordinatorServer) TestAlertChannel(context.Context, *GetAlertChannelRequest) (*CommonResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method TestAlertChannel not implemented")
+}
+func (UnimplementedCoordinatorServer) ListAlertSilences(context.Context, *ListAlertSilencesRequest) (*ListAlertSilencesResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ListAlertSilences not implemented")
+}
+func (UnimplementedCoordinatorServer) CreateAlertSilence(context.Context, *AlertSilence) (*AlertSilenceResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method CreateAlertSilence not implemented")
+}
+func (UnimplementedCoordinatorServer) DeleteAlertSilence(context.Context, *GetAlertSilenceRequest) (*CommonResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method DeleteAlertSilence not implemented")
+}
 func (UnimplementedCoordinatorServer) GetAnalytics(context.Context, *GetAnalyticsRequest) (*GetAnalyticsResponse, error) {
 	return nil, status.Error(codes.Unimplemented, "method GetAnalytics not implemented")
 }
@@ -832,561 +1426,1177 @@ func _Coordinator_GetFlow_Handler(srv interface{}, ctx context.Context, dec func
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(CoordinatorServer).GetFlow(ctx, in)
+		return srv.(CoordinatorServer).GetFlow(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Coordinator_GetFlow_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CoordinatorServer).GetFlow(ctx, req.(*GetFlowRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Coordinator_CreateFlow_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Flow)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CoordinatorServer).CreateFlow(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Coordinator_CreateFlow_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CoordinatorServer).CreateFlow(ctx, req.(*Flow))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Coordinator_UpdateFlow_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Flow)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CoordinatorServer).UpdateFlow(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Coordinator_UpdateFlow_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CoordinatorServer).UpdateFlow(ctx, req.(*Flow))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Coordinator_ListSecrets_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(emptypb.Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CoordinatorServer).ListSecrets(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Coordinator_ListSecrets_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CoordinatorServer).ListSecrets(ctx, req.(*emptypb.Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Coordinator_CreateSecret_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SecretRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CoordinatorServer).CreateSecret(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Coordinator_CreateSecret_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CoordinatorServer).CreateSecret(ctx, req.(*SecretRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Coordinator_UpdateSecret_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SecretRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CoordinatorServer).UpdateSecret(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Coordinator_UpdateSecret_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CoordinatorServer).UpdateSecret(ctx, req.(*SecretRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Coordinator_GetSecret_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SecretRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CoordinatorServer).GetSecret(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Coordinator_GetSecret_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CoordinatorServer).GetSecret(ctx, req.(*SecretRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Coordinator_DeleteSecret_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SecretRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CoordinatorServer).DeleteSecret(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Coordinator_DeleteSecret_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CoordinatorServer).DeleteSecret(ctx, req.(*SecretRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Coordinator_ListCaches_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(emptypb.Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CoordinatorServer).ListCaches(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Coordinator_ListCaches_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CoordinatorServer).ListCaches(ctx, req.(*emptypb.Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Coordinator_GetCache_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetCacheRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CoordinatorServer).GetCache(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Coordinator_GetCache_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CoordinatorServer).GetCache(ctx, req.(*GetCacheRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Coordinator_CreateCache_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Cache)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CoordinatorServer).CreateCache(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Coordinator_CreateCache_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CoordinatorServer).CreateCache(ctx, req.(*Cache))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Coordinator_UpdateCache_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Cache)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CoordinatorServer).UpdateCache(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Coordinator_UpdateCache_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CoordinatorServer).UpdateCache(ctx, req.(*Cache))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Coordinator_DeleteCache_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetCacheRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CoordinatorServer).DeleteCache(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Coordinator_DeleteCache_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CoordinatorServer).DeleteCache(ctx, req.(*GetCacheRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Coordinator_ListRateLimits_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(emptypb.Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CoordinatorServer).ListRateLimits(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Coordinator_ListRateLimits_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CoordinatorServer).ListRateLimits(ctx, req.(*emptypb.Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Coordinator_GetRateLimit_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetRateLimitRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CoordinatorServer).GetRateLimit(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Coordinator_GetRateLimit_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CoordinatorServer).GetRateLimit(ctx, req.(*GetRateLimitRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Coordinator_CreateRateLimit_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RateLimit)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CoordinatorServer).CreateRateLimit(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Coordinator_CreateRateLimit_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CoordinatorServer).CreateRateLimit(ctx, req.(*RateLimit))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Coordinator_UpdateRateLimit_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RateLimit)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CoordinatorServer).UpdateRateLimit(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Coordinator_UpdateRateLimit_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CoordinatorServer).UpdateRateLimit(ctx, req.(*RateLimit))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Coordinator_DeleteRateLimit_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetRateLimitRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CoordinatorServer).DeleteRateLimit(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Coordinator_DeleteRateLimit_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CoordinatorServer).DeleteRateLimit(ctx, req.(*GetRateLimitRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Coordinator_CheckRateLimit_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RateLimitCheckRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CoordinatorServer).CheckRateLimit(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Coordinator_CheckRateLimit_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CoordinatorServer).CheckRateLimit(ctx, req.(*RateLimitCheckRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Coordinator_ReleaseRateLimit_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RateLimitReleaseRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CoordinatorServer).ReleaseRateLimit(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Coordinator_ReleaseRateLimit_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CoordinatorServer).ReleaseRateLimit(ctx, req.(*RateLimitReleaseRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Coordinator_ListRateLimitKeys_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListRateLimitKeysRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CoordinatorServer).ListRateLimitKeys(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Coordinator_ListRateLimitKeys_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CoordinatorServer).ListRateLimitKeys(ctx, req.(*ListRateLimitKeysRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Coordinator_ResetRateLimit_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ResetRateLimitRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CoordinatorServer).ResetRateLimit(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Coordinator_ResetRateLimit_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CoordinatorServer).ResetRateLimit(ctx, req.(*ResetRateLimitRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Coordinator_GetRateLimitStats_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetRateLimitStatsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CoordinatorServer).GetRateLimitStats(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Coordinator_GetRateLimitStats_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CoordinatorServer).GetRateLimitStats(ctx, req.(*GetRateLimitStatsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Coordinator_ListBuffers_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(emptypb.Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CoordinatorServer).ListBuffers(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Coordinator_ListBuffers_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CoordinatorServer).ListBuffers(ctx, req.(*emptypb.Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Coordinator_GetBuffer_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetBufferRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CoordinatorServer).GetBuffer(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Coordinator_GetBuffer_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CoordinatorServer).GetBuffer(ctx, req.(*GetBufferRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Coordinator_CreateBuffer_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Buffer)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CoordinatorServer).CreateBuffer(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Coordinator_CreateBuffer_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CoordinatorServer).CreateBuffer(ctx, req.(*Buffer))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Coordinator_UpdateBuffer_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Buffer)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CoordinatorServer).UpdateBuffer(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Coordinator_UpdateBuffer_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CoordinatorServer).UpdateBuffer(ctx, req.(*Buffer))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Coordinator_DeleteBuffer_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetBufferRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CoordinatorServer).DeleteBuffer(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Coordinator_DeleteBuffer_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CoordinatorServer).DeleteBuffer(ctx, req.(*GetBufferRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Coordinator_ListFiles_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(emptypb.Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CoordinatorServer).ListFiles(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Coordinator_ListFiles_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CoordinatorServer).ListFiles(ctx, req.(*emptypb.Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Coordinator_GetFile_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetFileRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CoordinatorServer).GetFile(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Coordinator_GetFile_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CoordinatorServer).GetFile(ctx, req.(*GetFileRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Coordinator_CreateFile_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(File)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CoordinatorServer).CreateFile(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Coordinator_CreateFile_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CoordinatorServer).CreateFile(ctx, req.(*File))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Coordinator_UpdateFile_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(File)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CoordinatorServer).UpdateFile(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Coordinator_UpdateFile_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CoordinatorServer).UpdateFile(ctx, req.(*File))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Coordinator_DeleteFile_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetFileRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CoordinatorServer).DeleteFile(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Coordinator_DeleteFile_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CoordinatorServer).DeleteFile(ctx, req.(*GetFileRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Coordinator_ListEvents_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListEventsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CoordinatorServer).ListEvents(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: Coordinator_GetFlow_FullMethodName,
+		FullMethod: Coordinator_ListEvents_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(CoordinatorServer).GetFlow(ctx, req.(*GetFlowRequest))
+		return srv.(CoordinatorServer).ListEvents(ctx, req.(*ListEventsRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Coordinator_CreateFlow_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(Flow)
+func _Coordinator_TailEvents_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(TailEventsRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(CoordinatorServer).TailEvents(m, &grpc.GenericServerStream[TailEventsRequest, TailEventsResponse]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type Coordinator_TailEventsServer = grpc.ServerStreamingServer[TailEventsResponse]
+
+func _Coordinator_GetTrace_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetTraceRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(CoordinatorServer).CreateFlow(ctx, in)
+		return srv.(CoordinatorServer).GetTrace(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: Coordinator_CreateFlow_FullMethodName,
+		FullMethod: Coordinator_GetTrace_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(CoordinatorServer).CreateFlow(ctx, req.(*Flow))
+		return srv.(CoordinatorServer).GetTrace(ctx, req.(*GetTraceRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Coordinator_UpdateFlow_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(Flow)
+func _Coordinator_IngestEvents_Handler(srv interface{}, stream grpc.ServerStream) error {
+	return srv.(CoordinatorServer).IngestEvents(&grpc.GenericServerStream[EventBatch, EventAck]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type Coordinator_IngestEventsServer = grpc.BidiStreamingServer[EventBatch, EventAck]
+
+func _Coordinator_IngestMetrics_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MetricsRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(CoordinatorServer).UpdateFlow(ctx, in)
+		return srv.(CoordinatorServer).IngestMetrics(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: Coordinator_UpdateFlow_FullMethodName,
+		FullMethod: Coordinator_IngestMetrics_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(CoordinatorServer).UpdateFlow(ctx, req.(*Flow))
+		return srv.(CoordinatorServer).IngestMetrics(ctx, req.(*MetricsRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Coordinator_ListSecrets_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(emptypb.Empty)
+func _Coordinator_IngestLogs_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(IngestLogsRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(CoordinatorServer).ListSecrets(ctx, in)
+		return srv.(CoordinatorServer).IngestLogs(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: Coordinator_ListSecrets_FullMethodName,
+		FullMethod: Coordinator_IngestLogs_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(CoordinatorServer).ListSecrets(ctx, req.(*emptypb.Empty))
+		return srv.(CoordinatorServer).IngestLogs(ctx, req.(*IngestLogsRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Coordinator_CreateSecret_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(SecretRequest)
+func _Coordinator_ListFlowLogs_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListFlowLogsRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(CoordinatorServer).CreateSecret(ctx, in)
+		return srv.(CoordinatorServer).ListFlowLogs(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: Coordinator_CreateSecret_FullMethodName,
+		FullMethod: Coordinator_ListFlowLogs_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(CoordinatorServer).CreateSecret(ctx, req.(*SecretRequest))
+		return srv.(CoordinatorServer).ListFlowLogs(ctx, req.(*ListFlowLogsRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Coordinator_UpdateSecret_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(SecretRequest)
+func _Coordinator_TailFlowLogs_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(TailFlowLogsRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(CoordinatorServer).TailFlowLogs(m, &grpc.GenericServerStream[TailFlowLogsRequest, TailFlowLogsResponse]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type Coordinator_TailFlowLogsServer = grpc.ServerStreamingServer[TailFlowLogsResponse]
+
+func _Coordinator_GetFlowMetrics_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetFlowMetricsRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(CoordinatorServer).UpdateSecret(ctx, in)
+		return srv.(CoordinatorServer).GetFlowMetrics(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: Coordinator_UpdateSecret_FullMethodName,
+		FullMethod: Coordinator_GetFlowMetrics_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(CoordinatorServer).UpdateSecret(ctx, req.(*SecretRequest))
+		return srv.(CoordinatorServer).GetFlowMetrics(ctx, req.(*GetFlowMetricsRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Coordinator_GetSecret_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(SecretRequest)
+func _Coordinator_ReportToolProgress_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ToolProgressRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(CoordinatorServer).GetSecret(ctx, in)
+		return srv.(CoordinatorServer).ReportToolProgress(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: Coordinator_GetSecret_FullMethodName,
+		FullMethod: Coordinator_ReportToolProgress_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(CoordinatorServer).GetSecret(ctx, req.(*SecretRequest))
+		return srv.(CoordinatorServer).ReportToolProgress(ctx, req.(*ToolProgressRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Coordinator_DeleteSecret_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(SecretRequest)
+func _Coordinator_ListToolCalls_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListToolCallsRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(CoordinatorServer).DeleteSecret(ctx, in)
+		return srv.(CoordinatorServer).ListToolCalls(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: Coordinator_DeleteSecret_FullMethodName,
+		FullMethod: Coordinator_ListToolCalls_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(CoordinatorServer).DeleteSecret(ctx, req.(*SecretRequest))
+		return srv.(CoordinatorServer).ListToolCalls(ctx, req.(*ListToolCallsRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Coordinator_ListCaches_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+func _Coordinator_ListMcpServers_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(emptypb.Empty)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(CoordinatorServer).ListCaches(ctx, in)
+		return srv.(CoordinatorServer).ListMcpServers(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: Coordinator_ListCaches_FullMethodName,
+		FullMethod: Coordinator_ListMcpServers_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(CoordinatorServer).ListCaches(ctx, req.(*emptypb.Empty))
+		return srv.(CoordinatorServer).ListMcpServers(ctx, req.(*emptypb.Empty))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Coordinator_GetCache_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetCacheRequest)
+func _Coordinator_GetMcpServer_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetMcpServerRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(CoordinatorServer).GetCache(ctx, in)
+		return srv.(CoordinatorServer).GetMcpServer(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: Coordinator_GetCache_FullMethodName,
+		FullMethod: Coordinator_GetMcpServer_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(CoordinatorServer).GetCache(ctx, req.(*GetCacheRequest))
+		return srv.(CoordinatorServer).GetMcpServer(ctx, req.(*GetMcpServerRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Coordinator_CreateCache_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(Cache)
+func _Coordinator_CreateMcpServer_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(McpServer)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(CoordinatorServer).CreateCache(ctx, in)
+		return srv.(CoordinatorServer).CreateMcpServer(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: Coordinator_CreateCache_FullMethodName,
+		FullMethod: Coordinator_CreateMcpServer_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(CoordinatorServer).CreateCache(ctx, req.(*Cache))
+		return srv.(CoordinatorServer).CreateMcpServer(ctx, req.(*McpServer))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Coordinator_UpdateCache_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(Cache)
+func _Coordinator_UpdateMcpServer_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(McpServer)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(CoordinatorServer).UpdateCache(ctx, in)
+		return srv.(CoordinatorServer).UpdateMcpServer(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: Coordinator_UpdateCache_FullMethodName,
+		FullMethod: Coordinator_UpdateMcpServer_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(CoordinatorServer).UpdateCache(ctx, req.(*Cache))
+		return srv.(CoordinatorServer).UpdateMcpServer(ctx, req.(*McpServer))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Coordinator_DeleteCache_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetCacheRequest)
+func _Coordinator_DeleteMcpServer_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetMcpServerRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(CoordinatorServer).DeleteCache(ctx, in)
+		return srv.(CoordinatorServer).DeleteMcpServer(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: Coordinator_DeleteCache_FullMethodName,
+		FullMethod: Coordinator_DeleteMcpServer_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(CoordinatorServer).DeleteCache(ctx, req.(*GetCacheRequest))
+		return srv.(CoordinatorServer).DeleteMcpServer(ctx, req.(*GetMcpServerRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Coordinator_ListRateLimits_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(emptypb.Empty)
+func _Coordinator_ListQueuedRequests_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListQueuedRequestsRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(CoordinatorServer).ListRateLimits(ctx, in)
+		return srv.(CoordinatorServer).ListQueuedRequests(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: Coordinator_ListRateLimits_FullMethodName,
+		FullMethod: Coordinator_ListQueuedRequests_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(CoordinatorServer).ListRateLimits(ctx, req.(*emptypb.Empty))
+		return srv.(CoordinatorServer).ListQueuedRequests(ctx, req.(*ListQueuedRequestsRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Coordinator_GetRateLimit_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetRateLimitRequest)
+func _Coordinator_RetryQueuedRequest_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueuedRequestIdRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(CoordinatorServer).GetRateLimit(ctx, in)
+		return srv.(CoordinatorServer).RetryQueuedRequest(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: Coordinator_GetRateLimit_FullMethodName,
+		FullMethod: Coordinator_RetryQueuedRequest_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(CoordinatorServer).GetRateLimit(ctx, req.(*GetRateLimitRequest))
+		return srv.(CoordinatorServer).RetryQueuedRequest(ctx, req.(*QueuedRequestIdRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Coordinator_CreateRateLimit_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(RateLimit)
+func _Coordinator_DeleteQueuedRequest_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueuedRequestIdRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(CoordinatorServer).CreateRateLimit(ctx, in)
+		return srv.(CoordinatorServer).DeleteQueuedRequest(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: Coordinator_CreateRateLimit_FullMethodName,
+		FullMethod: Coordinator_DeleteQueuedRequest_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(CoordinatorServer).CreateRateLimit(ctx, req.(*RateLimit))
+		return srv.(CoordinatorServer).DeleteQueuedRequest(ctx, req.(*QueuedRequestIdRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Coordinator_UpdateRateLimit_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(RateLimit)
+func _Coordinator_ListAlerts_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListAlertsRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(CoordinatorServer).UpdateRateLimit(ctx, in)
+		return srv.(CoordinatorServer).ListAlerts(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: Coordinator_UpdateRateLimit_FullMethodName,
+		FullMethod: Coordinator_ListAlerts_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(CoordinatorServer).UpdateRateLimit(ctx, req.(*RateLimit))
+		return srv.(CoordinatorServer).ListAlerts(ctx, req.(*ListAlertsRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Coordinator_DeleteRateLimit_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetRateLimitRequest)
+func _Coordinator_ListAlertRules_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(emptypb.Empty)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(CoordinatorServer).DeleteRateLimit(ctx, in)
+		return srv.(CoordinatorServer).ListAlertRules(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: Coordinator_DeleteRateLimit_FullMethodName,
+		FullMethod: Coordinator_ListAlertRules_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(CoordinatorServer).DeleteRateLimit(ctx, req.(*GetRateLimitRequest))
+		return srv.(CoordinatorServer).ListAlertRules(ctx, req.(*emptypb.Empty))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Coordinator_CheckRateLimit_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(RateLimitCheckRequest)
+func _Coordinator_GetAlertRule_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetAlertRuleRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(CoordinatorServer).CheckRateLimit(ctx, in)
+		return srv.(CoordinatorServer).GetAlertRule(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: Coordinator_CheckRateLimit_FullMethodName,
+		FullMethod: Coordinator_GetAlertRule_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(CoordinatorServer).CheckRateLimit(ctx, req.(*RateLimitCheckRequest))
+		return srv.(CoordinatorServer).GetAlertRule(ctx, req.(*GetAlertRuleRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Coordinator_ListBuffers_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(emptypb.Empty)
+func _Coordinator_CreateAlertRule_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AlertRule)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(CoordinatorServer).ListBuffers(ctx, in)
+		return srv.(CoordinatorServer).CreateAlertRule(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: Coordinator_ListBuffers_FullMethodName,
+		FullMethod: Coordinator_CreateAlertRule_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(CoordinatorServer).ListBuffers(ctx, req.(*emptypb.Empty))
+		return srv.(CoordinatorServer).CreateAlertRule(ctx, req.(*AlertRule))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Coordinator_GetBuffer_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetBufferRequest)
+func _Coordinator_UpdateAlertRule_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AlertRule)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(CoordinatorServer).GetBuffer(ctx, in)
+		return srv.(CoordinatorServer).UpdateAlertRule(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: Coordinator_GetBuffer_FullMethodName,
+		FullMethod: Coordinator_UpdateAlertRule_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(CoordinatorServer).GetBuffer(ctx, req.(*GetBufferRequest))
+		return srv.(CoordinatorServer).UpdateAlertRule(ctx, req.(*AlertRule))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Coordinator_CreateBuffer_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(Buffer)
+func _Coordinator_DeleteAlertRule_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetAlertRuleRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(CoordinatorServer).CreateBuffer(ctx, in)
+		return srv.(CoordinatorServer).DeleteAlertRule(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: Coordinator_CreateBuffer_FullMethodName,
+		FullMethod: Coordinator_DeleteAlertRule_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(CoordinatorServer).CreateBuffer(ctx, req.(*Buffer))
+		return srv.(CoordinatorServer).DeleteAlertRule(ctx, req.(*GetAlertRuleRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Coordinator_UpdateBuffer_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(Buffer)
+func _Coordinator_ListAlertChannels_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(emptypb.Empty)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(CoordinatorServer).UpdateBuffer(ctx, in)
+		return srv.(CoordinatorServer).ListAlertChannels(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: Coordinator_UpdateBuffer_FullMethodName,
+		FullMethod: Coordinator_ListAlertChannels_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(CoordinatorServer).UpdateBuffer(ctx, req.(*Buffer))
+		return srv.(CoordinatorServer).ListAlertChannels(ctx, req.(*emptypb.Empty))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Coordinator_DeleteBuffer_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetBufferRequest)
+func _Coordinator_GetAlertChannel_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetAlertChannelRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(CoordinatorServer).DeleteBuffer(ctx, in)
+		return srv.(CoordinatorServer).GetAlertChannel(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: Coordinator_DeleteBuffer_FullMethodName,
+		FullMethod: Coordinator_GetAlertChannel_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(CoordinatorServer).DeleteBuffer(ctx, req.(*GetBufferRequest))
+		return srv.(CoordinatorServer).GetAlertChannel(ctx, req.(*GetAlertChannelRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Coordinator_ListFiles_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(emptypb.Empty)
+func _Coordinator_CreateAlertChannel_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AlertChannel)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(CoordinatorServer).ListFiles(ctx, in)
+		return srv.(CoordinatorServer).CreateAlertChannel(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: Coordinator_ListFiles_FullMethodName,
+		FullMethod: Coordinator_CreateAlertChannel_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(CoordinatorServer).ListFiles(ctx, req.(*emptypb.Empty))
+		return srv.(CoordinatorServer).CreateAlertChannel(ctx, req.(*AlertChannel))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Coordinator_GetFile_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetFileRequest)
+func _Coordinator_UpdateAlertChannel_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AlertChannel)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(CoordinatorServer).GetFile(ctx, in)
+		return srv.(CoordinatorServer).UpdateAlertChannel(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: Coordinator_GetFile_FullMethodName,
+		FullMethod: Coordinator_UpdateAlertChannel_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(CoordinatorServer).GetFile(ctx, req.(*GetFileRequest))
+		return srv.(CoordinatorServer).UpdateAlertChannel(ctx, req.(*AlertChannel))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Coordinator_CreateFile_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(File)
+func _Coordinator_DeleteAlertChannel_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetAlertChannelRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(CoordinatorServer).CreateFile(ctx, in)
+		return srv.(CoordinatorServer).DeleteAlertChannel(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: Coordinator_CreateFile_FullMethodName,
+		FullMethod: Coordinator_DeleteAlertChannel_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(CoordinatorServer).CreateFile(ctx, req.(*File))
+		return srv.(CoordinatorServer).DeleteAlertChannel(ctx, req.(*GetAlertChannelRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Coordinator_UpdateFile_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(File)
+func _Coordinator_TestAlertChannel_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetAlertChannelRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(CoordinatorServer).UpdateFile(ctx, in)
+		return srv.(CoordinatorServer).TestAlertChannel(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: Coordinator_UpdateFile_FullMethodName,
+		FullMethod: Coordinator_TestAlertChannel_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(CoordinatorServer).UpdateFile(ctx, req.(*File))
+		return srv.(CoordinatorServer).TestAlertChannel(ctx, req.(*GetAlertChannelRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Coordinator_DeleteFile_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetFileRequest)
+func _Coordinator_ListAlertSilences_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListAlertSilencesRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(CoordinatorServer).DeleteFile(ctx, in)
+		return srv.(CoordinatorServer).ListAlertSilences(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: Coordinator_DeleteFile_FullMethodName,
+		FullMethod: Coordinator_ListAlertSilences_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(CoordinatorServer).DeleteFile(ctx, req.(*GetFileRequest))
+		return srv.(CoordinatorServer).ListAlertSilences(ctx, req.(*ListAlertSilencesRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Coordinator_ListEvents_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ListEventsRequest)
+func _Coordinator_CreateAlertSilence_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AlertSilence)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(CoordinatorServer).ListEvents(ctx, in)
+		return srv.(CoordinatorServer).CreateAlertSilence(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: Coordinator_ListEvents_FullMethodName,
+		FullMethod: Coordinator_CreateAlertSilence_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(CoordinatorServer).ListEvents(ctx, req.(*ListEventsRequest))
+		return srv.(CoordinatorServer).CreateAlertSilence(ctx, req.(*AlertSilence))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Coordinator_IngestEvents_Handler(srv interface{}, stream grpc.ServerStream) error {
-	return srv.(CoordinatorServer).IngestEvents(&grpc.GenericServerStream[Event, emptypb.Empty]{ServerStream: stream})
-}
-
-// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
-type Coordinator_IngestEventsServer = grpc.BidiStreamingServer[Event, emptypb.Empty]
-
-func _Coordinator_IngestMetrics_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MetricsRequest)
+func _Coordinator_DeleteAlertSilence_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetAlertSilenceRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(CoordinatorServer).IngestMetrics(ctx, in)
+		return srv.(CoordinatorServer).DeleteAlertSilence(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: Coordinator_IngestMetrics_FullMethodName,
+		FullMethod: Coordinator_DeleteAlertSilence_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(CoordinatorServer).IngestMetrics(ctx, req.(*MetricsRequest))
+		return srv.(CoordinatorServer).DeleteAlertSilence(ctx, req.(*GetAlertSilenceRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
@@ -1516,6 +2726,22 @@ var Coordinator_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "CheckRateLimit",
 			Handler:    _Coordinator_CheckRateLimit_Handler,
 		},
+		{
+			MethodName: "ReleaseRateLimit",
+			Handler:    _Coordinator_ReleaseRateLimit_Handler,
+		},
+		{
+			MethodName: "ListRateLimitKeys",
+			Handler:    _Coordinator_ListRateLimitKeys_Handler,
+		},
+		{
+			MethodName: "ResetRateLimit",
+			Handler:    _Coordinator_ResetRateLimit_Handler,
+		},
+		{
+			MethodName: "GetRateLimitStats",
+			Handler:    _Coordinator_GetRateLimitStats_Handler,
+		},
 		{
 			MethodName: "ListBuffers",
 			Handler:    _Coordinator_ListBuffers_Handler,
@@ -1560,22 +2786,148 @@ var Coordinator_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "ListEvents",
 			Handler:    _Coordinator_ListEvents_Handler,
 		},
+		{
+			MethodName: "GetTrace",
+			Handler:    _Coordinator_GetTrace_Handler,
+		},
 		{
 			MethodName: "IngestMetrics",
 			Handler:    _Coordinator_IngestMetrics_Handler,
 		},
+		{
+			MethodName: "IngestLogs",
+			Handler:    _Coordinator_IngestLogs_Handler,
+		},
+		{
+			MethodName: "ListFlowLogs",
+			Handler:    _Coordinator_ListFlowLogs_Handler,
+		},
+		{
+			MethodName: "GetFlowMetrics",
+			Handler:    _Coordinator_GetFlowMetrics_Handler,
+		},
+		{
+			MethodName: "ReportToolProgress",
+			Handler:    _Coordinator_ReportToolProgress_Handler,
+		},
+		{
+			MethodName: "ListToolCalls",
+			Handler:    _Coordinator_ListToolCalls_Handler,
+		},
+		{
+			MethodName: "ListMcpServers",
+			Handler:    _Coordinator_ListMcpServers_Handler,
+		},
+		{
+			MethodName: "GetMcpServer",
+			Handler:    _Coordinator_GetMcpServer_Handler,
+		},
+		{
+			MethodName: "CreateMcpServer",
+			Handler:    _Coordinator_CreateMcpServer_Handler,
+		},
+		{
+			MethodName: "UpdateMcpServer",
+			Handler:    _Coordinator_UpdateMcpServer_Handler,
+		},
+		{
+			MethodName: "DeleteMcpServer",
+			Handler:    _Coordinator_DeleteMcpServer_Handler,
+		},
+		{
+			MethodName: "ListQueuedRequests",
+			Handler:    _Coordinator_ListQueuedRequests_Handler,
+		},
+		{
+			MethodName: "RetryQueuedRequest",
+			Handler:    _Coordinator_RetryQueuedRequest_Handler,
+		},
+		{
+			MethodName: "DeleteQueuedRequest",
+			Handler:    _Coordinator_DeleteQueuedRequest_Handler,
+		},
+		{
+			MethodName: "ListAlerts",
+			Handler:    _Coordinator_ListAlerts_Handler,
+		},
+		{
+			MethodName: "ListAlertRules",
+			Handler:    _Coordinator_ListAlertRules_Handler,
+		},
+		{
+			MethodName: "GetAlertRule",
+			Handler:    _Coordinator_GetAlertRule_Handler,
+		},
+		{
+			MethodName: "CreateAlertRule",
+			Handler:    _Coordinator_CreateAlertRule_Handler,
+		},
+		{
+			MethodName: "UpdateAlertRule",
+			Handler:    _Coordinator_UpdateAlertRule_Handler,
+		},
+		{
+			MethodName: "DeleteAlertRule",
+			Handler:    _Coordinator_DeleteAlertRule_Handler,
+		},
+		{
+			MethodName: "ListAlertChannels",
+			Handler:    _Coordinator_ListAlertChannels_Handler,
+		},
+		{
+			MethodName: "GetAlertChannel",
+			Handler:    _Coordinator_GetAlertChannel_Handler,
+		},
+		{
+			MethodName: "CreateAlertChannel",
+			Handler:    _Coordinator_CreateAlertChannel_Handler,
+		},
+		{
+			MethodName: "UpdateAlertChannel",
+			Handler:    _Coordinator_UpdateAlertChannel_Handler,
+		},
+		{
+			MethodName: "DeleteAlertChannel",
+			Handler:    _Coordinator_DeleteAlertChannel_Handler,
+		},
+		{
+			MethodName: "TestAlertChannel",
+			Handler:    _Coordinator_TestAlertChannel_Handler,
+		},
+		{
+			MethodName: "ListAlertSilences",
+			Handler:    _Coordinator_ListAlertSilences_Handler,
+		},
+		{
+			MethodName: "CreateAlertSilence",
+			Handler:    _Coordinator_CreateAlertSilence_Handler,
+		},
+		{
+			MethodName: "DeleteAlertSilence",
+			Handler:    _Coordinator_DeleteAlertSilence_Handler,
+		},
 		{
 			MethodName: "GetAnalytics",
 			Handler:    _Coordinator_GetAnalytics_Handler,
 		},
 	},
 	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "TailEvents",
+			Handler:       _Coordinator_TailEvents_Handler,
+			ServerStreams: true,
+		},
 		{
 			StreamName:    "IngestEvents",
 			Handler:       _Coordinator_IngestEvents_Handler,
 			ServerStreams: true,
 			ClientStreams: true,
 		},
+		{
+			StreamName:    "TailFlowLogs",
+			Handler:       _Coordinator_TailFlowLogs_Handler,
+			ServerStreams: true,
+		},
 	},
 	Metadata: "coordinator.proto",
 }
@@ -266,20 +266,68 @@ func (x *CompleteFlowRequest) GetWorkerFlowId() int64 {
 	return 0
 }
 
-type IngestRequest struct {
+type HeaderValues struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	WorkerFlowId  int64                  `protobuf:"varint,1,opt,name=worker_flow_id,json=workerFlowId,proto3" json:"worker_flow_id,omitempty"`
-	Method        string                 `protobuf:"bytes,2,opt,name=method,proto3" json:"method,omitempty"`
-	Path          string                 `protobuf:"bytes,3,opt,name=path,proto3" json:"path,omitempty"`
-	ContentType   string                 `protobuf:"bytes,4,opt,name=content_type,json=contentType,proto3" json:"content_type,omitempty"`
-	Payload       []byte                 `protobuf:"bytes,5,opt,name=payload,proto3" json:"payload,omitempty"`
+	Values        []string               `protobuf:"bytes,1,rep,name=values,proto3" json:"values,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *HeaderValues) Reset() {
+	*x = HeaderValues{}
+	mi := &file_worker_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *HeaderValues) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*HeaderValues) ProtoMessage() {}
+
+func (x *HeaderValues) ProtoReflect() protoreflect.Message {
+	mi := &file_worker_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use HeaderValues.ProtoReflect.Descriptor instead.
+func (*HeaderValues) Descriptor() ([]byte, []int) {
+	return file_worker_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *HeaderValues) GetValues() []string {
+	if x != nil {
+		return x.Values
+	}
+	return nil
+}
+
+type IngestRequest struct {
+	state         protoimpl.MessageState   `protogen:"open.v1"`
+	WorkerFlowId  int64                    `protobuf:"varint,1,opt,name=worker_flow_id,json=workerFlowId,proto3" json:"worker_flow_id,omitempty"`
+	Method        string                   `protobuf:"bytes,2,opt,name=method,proto3" json:"method,omitempty"`
+	Path          string                   `protobuf:"bytes,3,opt,name=path,proto3" json:"path,omitempty"`
+	ContentType   string                   `protobuf:"bytes,4,opt,name=content_type,json=contentType,proto3" json:"content_type,omitempty"`
+	Payload       []byte                   `protobuf:"bytes,5,opt,name=payload,proto3" json:"payload,omitempty"`
+	McpCallId     string                   `protobuf:"bytes,6,opt,name=mcp_call_id,json=mcpCallId,proto3" json:"mcp_call_id,omitempty"`
+	Headers       map[string]*HeaderValues `protobuf:"bytes,7,rep,name=headers,proto3" json:"headers,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	Query         string                   `protobuf:"bytes,8,opt,name=query,proto3" json:"query,omitempty"`
+	RemoteAddr    string                   `protobuf:"bytes,9,opt,name=remote_addr,json=remoteAddr,proto3" json:"remote_addr,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
 func (x *IngestRequest) Reset() {
 	*x = IngestRequest{}
-	mi := &file_worker_proto_msgTypes[5]
+	mi := &file_worker_proto_msgTypes[6]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -291,7 +339,7 @@ func (x *IngestRequest) String() string {
 func (*IngestRequest) ProtoMessage() {}
 
 func (x *IngestRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_worker_proto_msgTypes[5]
+	mi := &file_worker_proto_msgTypes[6]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -304,7 +352,7 @@ func (x *IngestRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use IngestRequest.ProtoReflect.Descriptor instead.
 func (*IngestRequest) Descriptor() ([]byte, []int) {
-	return file_worker_proto_rawDescGZIP(), []int{5}
+	return file_worker_proto_rawDescGZIP(), []int{6}
 }
 
 func (x *IngestRequest) GetWorkerFlowId() int64 {
@@ -342,17 +390,46 @@ func (x *IngestRequest) GetPayload() []byte {
 	return nil
 }
 
+func (x *IngestRequest) GetMcpCallId() string {
+	if x != nil {
+		return x.McpCallId
+	}
+	return ""
+}
+
+func (x *IngestRequest) GetHeaders() map[string]*HeaderValues {
+	if x != nil {
+		return x.Headers
+	}
+	return nil
+}
+
+func (x *IngestRequest) GetQuery() string {
+	if x != nil {
+		return x.Query
+	}
+	return ""
+}
+
+func (x *IngestRequest) GetRemoteAddr() string {
+	if x != nil {
+		return x.RemoteAddr
+	}
+	return ""
+}
+
 type IngestResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	StatusCode    int32                  `protobuf:"varint,1,opt,name=statusCode,proto3" json:"statusCode,omitempty"`
-	Response      []byte                 `protobuf:"bytes,2,opt,name=response,proto3" json:"response,omitempty"`
+	state         protoimpl.MessageState   `protogen:"open.v1"`
+	StatusCode    int32                    `protobuf:"varint,1,opt,name=statusCode,proto3" json:"statusCode,omitempty"`
+	Response      []byte                   `protobuf:"bytes,2,opt,name=response,proto3" json:"response,omitempty"`
+	Headers       map[string]*HeaderValues `protobuf:"bytes,3,rep,name=headers,proto3" json:"headers,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
 func (x *IngestResponse) Reset() {
 	*x = IngestResponse{}
-	mi := &file_worker_proto_msgTypes[6]
+	mi := &file_worker_proto_msgTypes[7]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -364,7 +441,7 @@ func (x *IngestResponse) String() string {
 func (*IngestResponse) ProtoMessage() {}
 
 func (x *IngestResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_worker_proto_msgTypes[6]
+	mi := &file_worker_proto_msgTypes[7]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -377,7 +454,7 @@ func (x *IngestResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use IngestResponse.ProtoReflect.Descriptor instead.
 func (*IngestResponse) Descriptor() ([]byte, []int) {
-	return file_worker_proto_rawDescGZIP(), []int{6}
+	return file_worker_proto_rawDescGZIP(), []int{7}
 }
 
 func (x *IngestResponse) GetStatusCode() int32 {
@@ -394,6 +471,147 @@ func (x *IngestResponse) GetResponse() []byte {
 	return nil
 }
 
+func (x *IngestResponse) GetHeaders() map[string]*HeaderValues {
+	if x != nil {
+		return x.Headers
+	}
+	return nil
+}
+
+type IngestResponseHead struct {
+	state         protoimpl.MessageState   `protogen:"open.v1"`
+	StatusCode    int32                    `protobuf:"varint,1,opt,name=statusCode,proto3" json:"statusCode,omitempty"`
+	Headers       map[string]*HeaderValues `protobuf:"bytes,2,rep,name=headers,proto3" json:"headers,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *IngestResponseHead) Reset() {
+	*x = IngestResponseHead{}
+	mi := &file_worker_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *IngestResponseHead) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*IngestResponseHead) ProtoMessage() {}
+
+func (x *IngestResponseHead) ProtoReflect() protoreflect.Message {
+	mi := &file_worker_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use IngestResponseHead.ProtoReflect.Descriptor instead.
+func (*IngestResponseHead) Descriptor() ([]byte, []int) {
+	return file_worker_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *IngestResponseHead) GetStatusCode() int32 {
+	if x != nil {
+		return x.StatusCode
+	}
+	return 0
+}
+
+func (x *IngestResponseHead) GetHeaders() map[string]*HeaderValues {
+	if x != nil {
+		return x.Headers
+	}
+	return nil
+}
+
+type IngestStreamResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Types that are valid to be assigned to Frame:
+	//
+	//	*IngestStreamResponse_Head
+	//	*IngestStreamResponse_Chunk
+	Frame         isIngestStreamResponse_Frame `protobuf_oneof:"frame"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *IngestStreamResponse) Reset() {
+	*x = IngestStreamResponse{}
+	mi := &file_worker_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *IngestStreamResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*IngestStreamResponse) ProtoMessage() {}
+
+func (x *IngestStreamResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_worker_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use IngestStreamResponse.ProtoReflect.Descriptor instead.
+func (*IngestStreamResponse) Descriptor() ([]byte, []int) {
+	return file_worker_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *IngestStreamResponse) GetFrame() isIngestStreamResponse_Frame {
+	if x != nil {
+		return x.Frame
+	}
+	return nil
+}
+
+func (x *IngestStreamResponse) GetHead() *IngestResponseHead {
+	if x != nil {
+		if x, ok := x.Frame.(*IngestStreamResponse_Head); ok {
+			return x.Head
+		}
+	}
+	return nil
+}
+
+func (x *IngestStreamResponse) GetChunk() []byte {
+	if x != nil {
+		if x, ok := x.Frame.(*IngestStreamResponse_Chunk); ok {
+			return x.Chunk
+		}
+	}
+	return nil
+}
+
+type isIngestStreamResponse_Frame interface {
+	isIngestStreamResponse_Frame()
+}
+
+type IngestStreamResponse_Head struct {
+	Head *IngestResponseHead `protobuf:"bytes,1,opt,name=head,proto3,oneof"`
+}
+
+type IngestStreamResponse_Chunk struct {
+	Chunk []byte `protobuf:"bytes,2,opt,name=chunk,proto3,oneof"`
+}
+
+func (*IngestStreamResponse_Head) isIngestStreamResponse_Frame() {}
+
+func (*IngestStreamResponse_Chunk) isIngestStreamResponse_Frame() {}
+
 var File_worker_proto protoreflect.FileDescriptor
 
 const file_worker_proto_rawDesc = "" +
@@ -411,25 +629,52 @@ const file_worker_proto_rawDesc = "" +
 	"\x11FetchFlowResponse\x125\n" +
 	"\x06status\x18\x01 \x01(\x0e2\x1d.protorender.WorkerFlowStatusR\x06status\";\n" +
 	"\x13CompleteFlowRequest\x12$\n" +
-	"\x0eworker_flow_id\x18\x01 \x01(\x03R\fworkerFlowId\"\x9e\x01\n" +
+	"\x0eworker_flow_id\x18\x01 \x01(\x03R\fworkerFlowId\"&\n" +
+	"\fHeaderValues\x12\x16\n" +
+	"\x06values\x18\x01 \x03(\tR\x06values\"\x8f\x03\n" +
 	"\rIngestRequest\x12$\n" +
 	"\x0eworker_flow_id\x18\x01 \x01(\x03R\fworkerFlowId\x12\x16\n" +
 	"\x06method\x18\x02 \x01(\tR\x06method\x12\x12\n" +
 	"\x04path\x18\x03 \x01(\tR\x04path\x12!\n" +
 	"\fcontent_type\x18\x04 \x01(\tR\vcontentType\x12\x18\n" +
-	"\apayload\x18\x05 \x01(\fR\apayload\"L\n" +
+	"\apayload\x18\x05 \x01(\fR\apayload\x12\x1e\n" +
+	"\vmcp_call_id\x18\x06 \x01(\tR\tmcpCallId\x12A\n" +
+	"\aheaders\x18\a \x03(\v2'.protorender.IngestRequest.HeadersEntryR\aheaders\x12\x14\n" +
+	"\x05query\x18\b \x01(\tR\x05query\x12\x1f\n" +
+	"\vremote_addr\x18\t \x01(\tR\n" +
+	"remoteAddr\x1aU\n" +
+	"\fHeadersEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12/\n" +
+	"\x05value\x18\x02 \x01(\v2\x19.protorender.HeaderValuesR\x05value:\x028\x01\"\xe7\x01\n" +
 	"\x0eIngestResponse\x12\x1e\n" +
 	"\n" +
 	"statusCode\x18\x01 \x01(\x05R\n" +
 	"statusCode\x12\x1a\n" +
-	"\bresponse\x18\x02 \x01(\fR\bresponse2\xff\x02\n" +
+	"\bresponse\x18\x02 \x01(\fR\bresponse\x12B\n" +
+	"\aheaders\x18\x03 \x03(\v2(.protorender.IngestResponse.HeadersEntryR\aheaders\x1aU\n" +
+	"\fHeadersEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12/\n" +
+	"\x05value\x18\x02 \x01(\v2\x19.protorender.HeaderValuesR\x05value:\x028\x01\"\xd3\x01\n" +
+	"\x12IngestResponseHead\x12\x1e\n" +
+	"\n" +
+	"statusCode\x18\x01 \x01(\x05R\n" +
+	"statusCode\x12F\n" +
+	"\aheaders\x18\x02 \x03(\v2,.protorender.IngestResponseHead.HeadersEntryR\aheaders\x1aU\n" +
+	"\fHeadersEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12/\n" +
+	"\x05value\x18\x02 \x01(\v2\x19.protorender.HeaderValuesR\x05value:\x028\x01\"n\n" +
+	"\x14IngestStreamResponse\x125\n" +
+	"\x04head\x18\x01 \x01(\v2\x1f.protorender.IngestResponseHeadH\x00R\x04head\x12\x16\n" +
+	"\x05chunk\x18\x02 \x01(\fH\x00R\x05chunkB\a\n" +
+	"\x05frame2\xd2\x03\n" +
 	"\x06Worker\x12D\n" +
 	"\vHealthCheck\x12\x16.google.protobuf.Empty\x1a\x1b.protorender.CommonResponse\"\x00\x12K\n" +
 	"\n" +
 	"AssignFlow\x12\x1e.protorender.AssignFlowRequest\x1a\x1b.protorender.CommonResponse\"\x00\x12L\n" +
 	"\tFetchFlow\x12\x1d.protorender.FetchFlowRequest\x1a\x1e.protorender.FetchFlowResponse\"\x00\x12O\n" +
 	"\fCompleteFlow\x12 .protorender.CompleteFlowRequest\x1a\x1b.protorender.CommonResponse\"\x00\x12C\n" +
-	"\x06Ingest\x12\x1a.protorender.IngestRequest\x1a\x1b.protorender.IngestResponse\"\x00B4Z2github.com/sananguliyev/airtruct/internal/protogenb\x06proto3"
+	"\x06Ingest\x12\x1a.protorender.IngestRequest\x1a\x1b.protorender.IngestResponse\"\x00\x12Q\n" +
+	"\fIngestStream\x12\x1a.protorender.IngestRequest\x1a!.protorender.IngestStreamResponse\"\x000\x01B4Z2github.com/sananguliyev/airtruct/internal/protogenb\x06proto3"
 
 var (
 	file_worker_proto_rawDescOnce sync.Once
@@ -443,37 +688,52 @@ func file_worker_proto_rawDescGZIP() []byte {
 	return file_worker_proto_rawDescData
 }
 
-var file_worker_proto_msgTypes = make([]protoimpl.MessageInfo, 7)
+var file_worker_proto_msgTypes = make([]protoimpl.MessageInfo, 13)
 var file_worker_proto_goTypes = []any{
-	(*FlowFile)(nil),            // 0: protorender.FlowFile
-	(*AssignFlowRequest)(nil),   // 1: protorender.AssignFlowRequest
-	(*FetchFlowRequest)(nil),    // 2: protorender.FetchFlowRequest
-	(*FetchFlowResponse)(nil),   // 3: protorender.FetchFlowResponse
-	(*CompleteFlowRequest)(nil), // 4: protorender.CompleteFlowRequest
-	(*IngestRequest)(nil),       // 5: protorender.IngestRequest
-	(*IngestResponse)(nil),      // 6: protorender.IngestResponse
-	(WorkerFlowStatus)(0),       // 7: protorender.WorkerFlowStatus
-	(*emptypb.Empty)(nil),       // 8: google.protobuf.Empty
-	(*CommonResponse)(nil),      // 9: protorender.CommonResponse
+	(*FlowFile)(nil),             // 0: protorender.FlowFile
+	(*AssignFlowRequest)(nil),    // 1: protorender.AssignFlowRequest
+	(*FetchFlowRequest)(nil),     // 2: protorender.FetchFlowRequest
+	(*FetchFlowResponse)(nil),    // 3: protorender.FetchFlowResponse
+	(*CompleteFlowRequest)(nil),  // 4: protorender.CompleteFlowRequest
+	(*HeaderValues)(nil),         // 5: protorender.HeaderValues
+	(*IngestRequest)(nil),        // 6: protorender.IngestRequest
+	(*IngestResponse)(nil),       // 7: protorender.IngestResponse
+	(*IngestResponseHead)(nil),   // 8: protorender.IngestResponseHead
+	(*IngestStreamResponse)(nil), // 9: protorender.IngestStreamResponse
+	nil,                          // 10: protorender.IngestRequest.HeadersEntry
+	nil,                          // 11: protorender.IngestResponse.HeadersEntry
+	nil,                          // 12: protorender.IngestResponseHead.HeadersEntry
+	(WorkerFlowStatus)(0),        // 13: protorender.WorkerFlowStatus
+	(*emptypb.Empty)(nil),        // 14: google.protobuf.Empty
+	(*CommonResponse)(nil),       // 15: protorender.CommonResponse
 }
 var file_worker_proto_depIdxs = []int32{
-	0, // 0: protorender.AssignFlowRequest.files:type_name -> protorender.FlowFile
-	7, // 1: protorender.FetchFlowResponse.status:type_name -> protorender.WorkerFlowStatus
-	8, // 2: protorender.Worker.HealthCheck:input_type -> google.protobuf.Empty
-	1, // 3: protorender.Worker.AssignFlow:input_type -> protorender.AssignFlowRequest
-	2, // 4: protorender.Worker.FetchFlow:input_type -> protorender.FetchFlowRequest
-	4, // 5: protorender.Worker.CompleteFlow:input_type -> protorender.CompleteFlowRequest
-	5, // 6: protorender.Worker.Ingest:input_type -> protorender.IngestRequest
-	9, // 7: protorender.Worker.HealthCheck:output_type -> protorender.CommonResponse
-	9, // 8: protorender.Worker.AssignFlow:output_type -> protorender.CommonResponse
-	3, // 9: protorender.Worker.FetchFlow:output_type -> protorender.FetchFlowResponse
-	9, // 10: protorender.Worker.CompleteFlow:output_type -> protorender.CommonResponse
-	6, // 11: protorender.Worker.Ingest:output_type -> protorender.IngestResponse
-	7, // [7:12] is the sub-list for method output_type
-	2, // [2:7] is the sub-list for method input_type
-	2, // [2:2] is the sub-list for extension type_name
-	2, // [2:2] is the sub-list for extension extendee
-	0, // [0:2] is the sub-list for field type_name
+	0,  // 0: protorender.AssignFlowRequest.files:type_name -> protorender.FlowFile
+	13, // 1: protorender.FetchFlowResponse.status:type_name -> protorender.WorkerFlowStatus
+	10, // 2: protorender.IngestRequest.headers:type_name -> protorender.IngestRequest.HeadersEntry
+	11, // 3: protorender.IngestResponse.headers:type_name -> protorender.IngestResponse.HeadersEntry
+	12, // 4: protorender.IngestResponseHead.headers:type_name -> protorender.IngestResponseHead.HeadersEntry
+	8,  // 5: protorender.IngestStreamResponse.head:type_name -> protorender.IngestResponseHead
+	5,  // 6: protorender.IngestRequest.HeadersEntry.value:type_name -> protorender.HeaderValues
+	5,  // 7: protorender.IngestResponse.HeadersEntry.value:type_name -> protorender.HeaderValues
+	5,  // 8: protorender.IngestResponseHead.HeadersEntry.value:type_name -> protorender.HeaderValues
+	14, // 9: protorender.Worker.HealthCheck:input_type -> google.protobuf.Empty
+	1,  // 10: protorender.Worker.AssignFlow:input_type -> protorender.AssignFlowRequest
+	2,  // 11: protorender.Worker.FetchFlow:input_type -> protorender.FetchFlowRequest
+	4,  // 12: protorender.Worker.CompleteFlow:input_type -> protorender.CompleteFlowRequest
+	6,  // 13: protorender.Worker.Ingest:input_type -> protorender.IngestRequest
+	6,  // 14: protorender.Worker.IngestStream:input_type -> protorender.IngestRequest
+	15, // 15: protorender.Worker.HealthCheck:output_type -> protorender.CommonResponse
+	15, // 16: protorender.Worker.AssignFlow:output_type -> protorender.CommonResponse
+	3,  // 17: protorender.Worker.FetchFlow:output_type -> protorender.FetchFlowResponse
+	15, // 18: protorender.Worker.CompleteFlow:output_type -> protorender.CommonResponse
+	7,  // 19: protorender.Worker.Ingest:output_type -> protorender.IngestResponse
+	9,  // 20: protorender.Worker.IngestStream:output_type -> protorender.IngestStreamResponse
+	15, // [15:21] is the sub-list for method output_type
+	9,  // [9:15] is the sub-list for method input_type
+	9,  // [9:9] is the sub-list for extension type_name
+	9,  // [9:9] is the sub-list for extension extendee
+	0,  // [0:9] is the sub-list for field type_name
 }
 
 func init() { file_worker_proto_init() }
@@ -482,13 +742,17 @@ func file_worker_proto_init() {
 		return
 	}
 	file_common_proto_init()
+	file_worker_proto_msgTypes[9].OneofWrappers = []any{
+		(*IngestStreamResponse_Head)(nil),
+		(*IngestStreamResponse_Chunk)(nil),
+	}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_worker_proto_rawDesc), len(file_worker_proto_rawDesc)),
 			NumEnums:      0,
-			NumMessages:   7,
+			NumMessages:   13,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
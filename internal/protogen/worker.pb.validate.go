@@ -588,6 +588,105 @@ var _ interface {
 	ErrorName() string
 } = CompleteFlowRequestValidationError{}
 
+// Validate checks the field values on HeaderValues with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *HeaderValues) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on HeaderValues with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in HeaderValuesMultiError, or
+// nil if none found.
+func (m *HeaderValues) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *HeaderValues) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(errors) > 0 {
+		return HeaderValuesMultiError(errors)
+	}
+
+	return nil
+}
+
+// HeaderValuesMultiError is an error wrapping multiple validation errors
+// returned by HeaderValues.ValidateAll() if the designated constraints aren't met.
+type HeaderValuesMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m HeaderValuesMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m HeaderValuesMultiError) AllErrors() []error { return m }
+
+// HeaderValuesValidationError is the validation error returned by
+// HeaderValues.Validate if the designated constraints aren't met.
+type HeaderValuesValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e HeaderValuesValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e HeaderValuesValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e HeaderValuesValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e HeaderValuesValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e HeaderValuesValidationError) ErrorName() string { return "HeaderValuesValidationError" }
+
+// Error satisfies the builtin error interface
+func (e HeaderValuesValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sHeaderValues.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = HeaderValuesValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = HeaderValuesValidationError{}
+
 // Validate checks the field values on IngestRequest with the rules defined in
 // the proto definition for this message. If any rules are violated, the first
 // error encountered is returned, or nil if there are no violations.
@@ -620,6 +719,58 @@ func (m *IngestRequest) validate(all bool) error {
 
 	// no validation rules for Payload
 
+	// no validation rules for McpCallId
+
+	{
+		sorted_keys := make([]string, len(m.GetHeaders()))
+		i := 0
+		for key := range m.GetHeaders() {
+			sorted_keys[i] = key
+			i++
+		}
+		sort.Slice(sorted_keys, func(i, j int) bool { return sorted_keys[i] < sorted_keys[j] })
+		for _, key := range sorted_keys {
+			val := m.GetHeaders()[key]
+			_ = val
+
+			// no validation rules for Headers[key]
+
+			if all {
+				switch v := interface{}(val).(type) {
+				case interface{ ValidateAll() error }:
+					if err := v.ValidateAll(); err != nil {
+						errors = append(errors, IngestRequestValidationError{
+							field:  fmt.Sprintf("Headers[%v]", key),
+							reason: "embedded message failed validation",
+							cause:  err,
+						})
+					}
+				case interface{ Validate() error }:
+					if err := v.Validate(); err != nil {
+						errors = append(errors, IngestRequestValidationError{
+							field:  fmt.Sprintf("Headers[%v]", key),
+							reason: "embedded message failed validation",
+							cause:  err,
+						})
+					}
+				}
+			} else if v, ok := interface{}(val).(interface{ Validate() error }); ok {
+				if err := v.Validate(); err != nil {
+					return IngestRequestValidationError{
+						field:  fmt.Sprintf("Headers[%v]", key),
+						reason: "embedded message failed validation",
+						cause:  err,
+					}
+				}
+			}
+
+		}
+	}
+
+	// no validation rules for Query
+
+	// no validation rules for RemoteAddr
+
 	if len(errors) > 0 {
 		return IngestRequestMultiError(errors)
 	}
@@ -724,6 +875,52 @@ func (m *IngestResponse) validate(all bool) error {
 
 	// no validation rules for Response
 
+	{
+		sorted_keys := make([]string, len(m.GetHeaders()))
+		i := 0
+		for key := range m.GetHeaders() {
+			sorted_keys[i] = key
+			i++
+		}
+		sort.Slice(sorted_keys, func(i, j int) bool { return sorted_keys[i] < sorted_keys[j] })
+		for _, key := range sorted_keys {
+			val := m.GetHeaders()[key]
+			_ = val
+
+			// no validation rules for Headers[key]
+
+			if all {
+				switch v := interface{}(val).(type) {
+				case interface{ ValidateAll() error }:
+					if err := v.ValidateAll(); err != nil {
+						errors = append(errors, IngestResponseValidationError{
+							field:  fmt.Sprintf("Headers[%v]", key),
+							reason: "embedded message failed validation",
+							cause:  err,
+						})
+					}
+				case interface{ Validate() error }:
+					if err := v.Validate(); err != nil {
+						errors = append(errors, IngestResponseValidationError{
+							field:  fmt.Sprintf("Headers[%v]", key),
+							reason: "embedded message failed validation",
+							cause:  err,
+						})
+					}
+				}
+			} else if v, ok := interface{}(val).(interface{ Validate() error }); ok {
+				if err := v.Validate(); err != nil {
+					return IngestResponseValidationError{
+						field:  fmt.Sprintf("Headers[%v]", key),
+						reason: "embedded message failed validation",
+						cause:  err,
+					}
+				}
+			}
+
+		}
+	}
+
 	if len(errors) > 0 {
 		return IngestResponseMultiError(errors)
 	}
@@ -801,3 +998,313 @@ var _ interface {
 	Cause() error
 	ErrorName() string
 } = IngestResponseValidationError{}
+
+// Validate checks the field values on IngestResponseHead with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *IngestResponseHead) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on IngestResponseHead with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// IngestResponseHeadMultiError, or nil if none found.
+func (m *IngestResponseHead) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *IngestResponseHead) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for StatusCode
+
+	{
+		sorted_keys := make([]string, len(m.GetHeaders()))
+		i := 0
+		for key := range m.GetHeaders() {
+			sorted_keys[i] = key
+			i++
+		}
+		sort.Slice(sorted_keys, func(i, j int) bool { return sorted_keys[i] < sorted_keys[j] })
+		for _, key := range sorted_keys {
+			val := m.GetHeaders()[key]
+			_ = val
+
+			// no validation rules for Headers[key]
+
+			if all {
+				switch v := interface{}(val).(type) {
+				case interface{ ValidateAll() error }:
+					if err := v.ValidateAll(); err != nil {
+						errors = append(errors, IngestResponseHeadValidationError{
+							field:  fmt.Sprintf("Headers[%v]", key),
+							reason: "embedded message failed validation",
+							cause:  err,
+						})
+					}
+				case interface{ Validate() error }:
+					if err := v.Validate(); err != nil {
+						errors = append(errors, IngestResponseHeadValidationError{
+							field:  fmt.Sprintf("Headers[%v]", key),
+							reason: "embedded message failed validation",
+							cause:  err,
+						})
+					}
+				}
+			} else if v, ok := interface{}(val).(interface{ Validate() error }); ok {
+				if err := v.Validate(); err != nil {
+					return IngestResponseHeadValidationError{
+						field:  fmt.Sprintf("Headers[%v]", key),
+						reason: "embedded message failed validation",
+						cause:  err,
+					}
+				}
+			}
+
+		}
+	}
+
+	if len(errors) > 0 {
+		return IngestResponseHeadMultiError(errors)
+	}
+
+	return nil
+}
+
+// IngestResponseHeadMultiError is an error wrapping multiple validation errors
+// returned by IngestResponseHead.ValidateAll() if the designated constraints
+// aren't met.
+type IngestResponseHeadMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m IngestResponseHeadMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m IngestResponseHeadMultiError) AllErrors() []error { return m }
+
+// IngestResponseHeadValidationError is the validation error returned by
+// IngestResponseHead.Validate if the designated constraints aren't met.
+type IngestResponseHeadValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e IngestResponseHeadValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e IngestResponseHeadValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e IngestResponseHeadValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e IngestResponseHeadValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e IngestResponseHeadValidationError) ErrorName() string {
+	return "IngestResponseHeadValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e IngestResponseHeadValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sIngestResponseHead.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = IngestResponseHeadValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = IngestResponseHeadValidationError{}
+
+// Validate checks the field values on IngestStreamResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *IngestStreamResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on IngestStreamResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// IngestStreamResponseMultiError, or nil if none found.
+func (m *IngestStreamResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *IngestStreamResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	switch v := m.Frame.(type) {
+	case *IngestStreamResponse_Head:
+		if v == nil {
+			err := IngestStreamResponseValidationError{
+				field:  "Frame",
+				reason: "oneof value cannot be a typed-nil",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+		if all {
+			switch v := interface{}(m.GetHead()).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, IngestStreamResponseValidationError{
+						field:  "Head",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, IngestStreamResponseValidationError{
+						field:  "Head",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(m.GetHead()).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return IngestStreamResponseValidationError{
+					field:  "Head",
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	case *IngestStreamResponse_Chunk:
+		if v == nil {
+			err := IngestStreamResponseValidationError{
+				field:  "Frame",
+				reason: "oneof value cannot be a typed-nil",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+		// no validation rules for Chunk
+	default:
+		_ = v // ensures v is used
+	}
+
+	if len(errors) > 0 {
+		return IngestStreamResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// IngestStreamResponseMultiError is an error wrapping multiple validation
+// errors returned by IngestStreamResponse.ValidateAll() if the designated
+// constraints aren't met.
+type IngestStreamResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m IngestStreamResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m IngestStreamResponseMultiError) AllErrors() []error { return m }
+
+// IngestStreamResponseValidationError is the validation error returned by
+// IngestStreamResponse.Validate if the designated constraints aren't met.
+type IngestStreamResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e IngestStreamResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e IngestStreamResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e IngestStreamResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e IngestStreamResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e IngestStreamResponseValidationError) ErrorName() string {
+	return "IngestStreamResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e IngestStreamResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sIngestStreamResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = IngestStreamResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = IngestStreamResponseValidationError{}
@@ -25,6 +25,7 @@ const (
 	Worker_FetchFlow_FullMethodName    = "/protorender.Worker/FetchFlow"
 	Worker_CompleteFlow_FullMethodName = "/protorender.Worker/CompleteFlow"
 	Worker_Ingest_FullMethodName       = "/protorender.Worker/Ingest"
+	Worker_IngestStream_FullMethodName = "/protorender.Worker/IngestStream"
 )
 
 // WorkerClient is the client API for Worker service.
@@ -36,6 +37,7 @@ type WorkerClient interface {
 	FetchFlow(ctx context.Context, in *FetchFlowRequest, opts ...grpc.CallOption) (*FetchFlowResponse, error)
 	CompleteFlow(ctx context.Context, in *CompleteFlowRequest, opts ...grpc.CallOption) (*CommonResponse, error)
 	Ingest(ctx context.Context, in *IngestRequest, opts ...grpc.CallOption) (*IngestResponse, error)
+	IngestStream(ctx context.Context, in *IngestRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[IngestStreamResponse], error)
 }
 
 type workerClient struct {
@@ -96,6 +98,25 @@ func (c *workerClient) Ingest(ctx context.Context, in *IngestRequest, opts ...gr
 	return out, nil
 }
 
+func (c *workerClient) IngestStream(ctx context.Context, in *IngestRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[IngestStreamResponse], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &Worker_ServiceDesc.Streams[0], Worker_IngestStream_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[IngestRequest, IngestStreamResponse]{ClientStream: stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type Worker_IngestStreamClient = grpc.ServerStreamingClient[IngestStreamResponse]
+
 // WorkerServer is the server API for Worker service.
 // All implementations must embed UnimplementedWorkerServer
 // for forward compatibility.
@@ -105,6 +126,7 @@ type WorkerServer interface {
 	FetchFlow(context.Context, *FetchFlowRequest) (*FetchFlowResponse, error)
 	CompleteFlow(context.Context, *CompleteFlowRequest) (*CommonResponse, error)
 	Ingest(context.Context, *IngestRequest) (*IngestResponse, error)
+	IngestStream(*IngestRequest, grpc.ServerStreamingServer[IngestStreamResponse]) error
 	mustEmbedUnimplementedWorkerServer()
 }
 
@@ -130,6 +152,9 @@ func (UnimplementedWorkerServer) CompleteFlow(context.Context, *CompleteFlowRequ
 func (UnimplementedWorkerServer) Ingest(context.Context, *IngestRequest) (*IngestResponse, error) {
 	return nil, status.Error(codes.Unimplemented, "method Ingest not implemented")
 }
+func (UnimplementedWorkerServer) IngestStream(*IngestRequest, grpc.ServerStreamingServer[IngestStreamResponse]) error {
+	return status.Error(codes.Unimplemented, "method IngestStream not implemented")
+}
 func (UnimplementedWorkerServer) mustEmbedUnimplementedWorkerServer() {}
 func (UnimplementedWorkerServer) testEmbeddedByValue()                {}
 
@@ -241,6 +266,17 @@ func _Worker_Ingest_Handler(srv interface{}, ctx context.Context, dec func(inter
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Worker_IngestStream_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(IngestRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(WorkerServer).IngestStream(m, &grpc.GenericServerStream[IngestRequest, IngestStreamResponse]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type Worker_IngestStreamServer = grpc.ServerStreamingServer[IngestStreamResponse]
+
 // Worker_ServiceDesc is the grpc.ServiceDesc for Worker service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -269,6 +305,12 @@ var Worker_ServiceDesc = grpc.ServiceDesc{
 			Handler:    _Worker_Ingest_Handler,
 		},
 	},
-	Streams:  []grpc.StreamDesc{},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "IngestStream",
+			Handler:       _Worker_IngestStream_Handler,
+			ServerStreams: true,
+		},
+	},
 	Metadata: "worker.proto",
 }
@@ -0,0 +1,74 @@
+package ratelimiter
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/sananguliyev/airtruct/internal/persistence"
+)
+
+// KeyState is the current usage of a rate limit by one key.
+type KeyState struct {
+	Key          string
+	Remaining    int64
+	Limit        int64
+	ResetAt      int64
+	LastRefillAt time.Time
+	UpdatedAt    time.Time
+}
+
+// Keys returns the keys of the rate limit with their remaining quota, most recently used first. A non-empty
+// prefix filters the keys.
+func (e *Engine) Keys(label, prefix string, limit, offset int) ([]KeyState, int64, error) {
+	config, err := e.config(label)
+	if err != nil {
+		return nil, 0, err
+	}
+	if err := e.Flush(); err != nil {
+		return nil, 0, err
+	}
+
+	states, total, err := e.rateLimitStateRepo.List(label, prefix, limit, offset)
+	if err != nil {
+		return nil, 0, fmt.Errorf("failed to list rate limit states: %w", err)
+	}
+
+	now := e.now()
+	keys := make([]KeyState, len(states))
+	for i, state := range states {
+		result := peek(state, config, now)
+		keys[i] = KeyState{
+			Key:          state.Key,
+			Remaining:    result.Remaining,
+			Limit:        result.Limit,
+			ResetAt:      result.ResetAt,
+			LastRefillAt: state.LastRefillAt,
+			UpdatedAt:    state.UpdatedAt,
+		}
+	}
+	return keys, total, nil
+}
+
+// Reset forgets the usage of the key, or of every key of the rate limit when key is empty.
+func (e *Engine) Reset(label, key string) error {
+	return e.states.reset(label, key)
+}
+
+// Stats returns the allowed and denied checks of the rate limit per minute since the given time.
+func (e *Engine) Stats(label string, since time.Time) ([]persistence.RateLimitCounter, error) {
+	if err := e.Flush(); err != nil {
+		return nil, err
+	}
+
+	counters, err := e.rateLimitCounterRepo.ListByLabel(label, since)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list rate limit counters: %w", err)
+	}
+	return counters, nil
+}
+
+// peek evaluates a request without cost against a copy of the state, which reports the quota left at now
+// without changing it.
+func peek(state persistence.RateLimitState, config *limitConfig, now time.Time) *CheckResult {
+	return evaluate(&state, &entryLog{}, config, 0, now)
+}
@@ -0,0 +1,242 @@
+package ratelimiter
+
+import (
+	"cmp"
+	"encoding/json"
+	"math"
+	"slices"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/sananguliyev/airtruct/internal/persistence"
+)
+
+const (
+	// AlgorithmTokenBucket refills count tokens per interval and allows bursts of up to count plus burst.
+	AlgorithmTokenBucket = "token_bucket"
+	// AlgorithmFixedWindow allows count per calendar aligned interval, e.g. per minute or per day.
+	AlgorithmFixedWindow = "fixed_window"
+	// AlgorithmSlidingWindow weighs the usage of the previous window by how much of it overlaps the last
+	// interval, which smooths the bursts a fixed window allows at its boundaries.
+	AlgorithmSlidingWindow = "sliding_window"
+	// AlgorithmSlidingLog remembers every request of the last interval and is exact, at the cost of storing
+	// one entry per request.
+	AlgorithmSlidingLog = "sliding_log"
+	// AlgorithmConcurrency allows count requests in flight, each holds a lease until it is released or the
+	// interval passes.
+	AlgorithmConcurrency = "concurrency"
+)
+
+type limitConfig struct {
+	algorithm string
+	count     float64
+	interval  time.Duration
+	burst     int64
+}
+
+func (c *limitConfig) maxTokens() float64 {
+	if c.burst > 0 {
+		return c.count + float64(c.burst)
+	}
+	return c.count
+}
+
+// limitEntry is a request of the sliding log or a lease of the concurrency algorithm, it stops counting
+// against the limit at ExpiresAt.
+type limitEntry struct {
+	ID        string  `json:"id,omitempty"`
+	Cost      float64 `json:"cost"`
+	ExpiresAt int64   `json:"expires_at"`
+}
+
+// entryLog holds the decoded entries of a state, ordered by expiry, so they are not decoded and encoded again
+// on every check. The entries are only encoded into the state when it is written to the database.
+type entryLog struct {
+	entries []limitEntry
+	decoded bool
+}
+
+// active drops the expired entries and returns the others, decoding them from the state on first use.
+func (l *entryLog) active(state *persistence.RateLimitState, now time.Time) []limitEntry {
+	if !l.decoded {
+		l.entries = nil
+		if len(state.Entries) > 0 {
+			// Entries that cannot be decoded are dropped, the key starts over.
+			_ = json.Unmarshal(state.Entries, &l.entries)
+		}
+		slices.SortStableFunc(l.entries, func(a, b limitEntry) int {
+			return cmp.Compare(a.ExpiresAt, b.ExpiresAt)
+		})
+		l.decoded = true
+	}
+
+	nowMs := now.UnixMilli()
+	expired, _ := slices.BinarySearchFunc(l.entries, nowMs, func(entry limitEntry, ms int64) int {
+		// Entries expiring at now count as expired, so the search ends after them.
+		if entry.ExpiresAt <= ms {
+			return -1
+		}
+		return 1
+	})
+	l.entries = l.entries[expired:]
+	return l.entries
+}
+
+// add inserts the entry in order of expiry.
+func (l *entryLog) add(entry limitEntry) {
+	i, _ := slices.BinarySearchFunc(l.entries, entry.ExpiresAt, func(e limitEntry, ms int64) int {
+		if e.ExpiresAt <= ms {
+			return -1
+		}
+		return 1
+	})
+	l.entries = slices.Insert(l.entries, i, entry)
+}
+
+// encode returns the entries as they are stored in the state.
+func (l *entryLog) encode() []byte {
+	// Encoding a slice of plain structs cannot fail.
+	encoded, _ := json.Marshal(l.entries)
+	return encoded
+}
+
+// evaluate counts a request of the given cost against the state with the algorithm of the rate limit.
+func evaluate(state *persistence.RateLimitState, log *entryLog, config *limitConfig, requestCost float64, now time.Time) *CheckResult {
+	switch config.algorithm {
+	case AlgorithmFixedWindow, AlgorithmSlidingWindow:
+		return checkWindow(state, config, requestCost, now)
+	case AlgorithmSlidingLog, AlgorithmConcurrency:
+		return checkEntries(state, log, config, requestCost, now)
+	default:
+		return checkTokenBucket(state, config, requestCost, now)
+	}
+}
+
+func checkWindow(state *persistence.RateLimitState, config *limitConfig, requestCost float64, now time.Time) *CheckResult {
+	windowStart := now.Truncate(config.interval)
+	windowEnd := windowStart.Add(config.interval)
+	rollWindow(state, windowStart, config.interval)
+
+	used := state.Tokens
+	if config.algorithm == AlgorithmSlidingWindow {
+		overlap := 1 - float64(now.Sub(windowStart))/float64(config.interval)
+		used += state.PreviousTokens * overlap
+	}
+
+	allowed := used+requestCost <= config.count
+
+	var retryAfterMs int64
+	if !allowed {
+		wait := windowEnd.Sub(now)
+		if config.algorithm == AlgorithmSlidingWindow && state.PreviousTokens > 0 && state.Tokens+requestCost <= config.count {
+			// The request fits once enough of the previous window has slid out of the interval.
+			overlap := (config.count - state.Tokens - requestCost) / state.PreviousTokens
+			wait = time.Duration((1-overlap)*float64(config.interval)) - now.Sub(windowStart)
+		}
+		retryAfterMs = int64(math.Ceil(float64(wait) / float64(time.Millisecond)))
+	} else {
+		state.Tokens += requestCost
+		used += requestCost
+	}
+
+	return &CheckResult{
+		Allowed:      allowed,
+		RetryAfterMs: retryAfterMs,
+		Remaining:    int64(math.Floor(config.count - used)),
+		Limit:        int64(config.count),
+		ResetAt:      windowEnd.Unix(),
+	}
+}
+
+// rollWindow moves the state to the window starting at windowStart. The usage of the window right before it
+// becomes the previous usage, older usage is dropped.
+func rollWindow(state *persistence.RateLimitState, windowStart time.Time, interval time.Duration) {
+	stateWindow := state.LastRefillAt.Truncate(interval)
+	if stateWindow.Equal(windowStart) {
+		return
+	}
+	if stateWindow.Equal(windowStart.Add(-interval)) {
+		state.PreviousTokens = state.Tokens
+	} else {
+		state.PreviousTokens = 0
+	}
+	state.Tokens = 0
+	state.LastRefillAt = windowStart
+}
+
+func checkEntries(state *persistence.RateLimitState, log *entryLog, config *limitConfig, requestCost float64, now time.Time) *CheckResult {
+	entries := log.active(state, now)
+	used := sumEntries(entries)
+
+	allowed := used+requestCost <= config.count
+
+	var retryAfterMs, resetAt int64
+	var leaseID string
+	if !allowed {
+		// Wait until enough of the oldest entries expire, or a whole interval when the cost exceeds the limit.
+		retryAfterMs = config.interval.Milliseconds()
+		freed := 0.0
+		for _, entry := range entries {
+			freed += entry.Cost
+			if used-freed+requestCost <= config.count {
+				retryAfterMs = entry.ExpiresAt - now.UnixMilli()
+				break
+			}
+		}
+	} else {
+		entry := limitEntry{Cost: requestCost, ExpiresAt: now.Add(config.interval).UnixMilli()}
+		if config.algorithm == AlgorithmConcurrency {
+			entry.ID = uuid.NewString()
+			leaseID = entry.ID
+		}
+		log.add(entry)
+		used += requestCost
+	}
+
+	if len(log.entries) > 0 {
+		resetAt = time.UnixMilli(log.entries[0].ExpiresAt).Unix()
+	} else {
+		resetAt = now.Add(config.interval).Unix()
+	}
+
+	storeEntries(state, log, now)
+
+	return &CheckResult{
+		Allowed:      allowed,
+		RetryAfterMs: retryAfterMs,
+		Remaining:    int64(math.Floor(config.count - used)),
+		Limit:        int64(config.count),
+		ResetAt:      resetAt,
+		LeaseID:      leaseID,
+	}
+}
+
+// releaseLease removes the lease from the state and reports whether it was still held.
+func releaseLease(state *persistence.RateLimitState, log *entryLog, leaseID string, now time.Time) bool {
+	entries := log.active(state, now)
+	index := slices.IndexFunc(entries, func(entry limitEntry) bool {
+		return entry.ID == leaseID
+	})
+	if index < 0 {
+		return false
+	}
+
+	log.entries = slices.Delete(entries, index, index+1)
+	storeEntries(state, log, now)
+	return true
+}
+
+// storeEntries updates the usage of the state, the entries themselves are encoded when the state is flushed.
+func storeEntries(state *persistence.RateLimitState, log *entryLog, now time.Time) {
+	state.Tokens = sumEntries(log.entries)
+	state.LastRefillAt = now
+}
+
+func sumEntries(entries []limitEntry) float64 {
+	var sum float64
+	for _, entry := range entries {
+		sum += entry.Cost
+	}
+	return sum
+}
@@ -3,27 +3,55 @@ package ratelimiter
 import (
 	"fmt"
 	"math"
+	"net/http"
+	"strconv"
 	"sync"
 	"time"
 
 	"gopkg.in/yaml.v3"
 
+	"github.com/sananguliyev/airtruct/internal/metrics"
 	"github.com/sananguliyev/airtruct/internal/persistence"
 )
 
+// configCacheTTL bounds how long a parsed rate limit config is reused when it is not invalidated, e.g. after
+// it was changed directly in the database.
+const configCacheTTL = time.Minute
+
+// counterRetention is how long the allowed and denied counters of rate limits are kept.
+const counterRetention = 7 * 24 * time.Hour
+
+// Engine checks requests against the rate limits. The state of every key is kept in memory, sharded by label
+// and key so unrelated keys never wait for each other, and written to the database by Flush.
 type Engine struct {
-	rateLimitRepo      persistence.RateLimitRepository
-	rateLimitStateRepo persistence.RateLimitStateRepository
-	mu                 sync.Mutex
+	rateLimitRepo        persistence.RateLimitRepository
+	rateLimitStateRepo   persistence.RateLimitStateRepository
+	rateLimitCounterRepo persistence.RateLimitCounterRepository
+	now                  func() time.Time
+
+	configMu sync.RWMutex
+	configs  map[string]cachedConfig
+
+	states *stateStore
+}
+
+type cachedConfig struct {
+	config   *limitConfig
+	loadedAt time.Time
 }
 
 func NewEngine(
 	rateLimitRepo persistence.RateLimitRepository,
 	rateLimitStateRepo persistence.RateLimitStateRepository,
+	rateLimitCounterRepo persistence.RateLimitCounterRepository,
 ) *Engine {
 	return &Engine{
-		rateLimitRepo:      rateLimitRepo,
-		rateLimitStateRepo: rateLimitStateRepo,
+		rateLimitRepo:        rateLimitRepo,
+		rateLimitStateRepo:   rateLimitStateRepo,
+		rateLimitCounterRepo: rateLimitCounterRepo,
+		now:                  time.Now,
+		configs:              make(map[string]cachedConfig),
+		states:               newStateStore(rateLimitStateRepo, rateLimitCounterRepo),
 	}
 }
 
@@ -33,12 +61,117 @@ type CheckResult struct {
 	Remaining    int64
 	Limit        int64
 	ResetAt      int64
+	// LeaseID identifies the slot taken by a concurrency rate limit, it has to be passed to Release once the
+	// request is done.
+	LeaseID string
+}
+
+// Headers returns the X-RateLimit-* headers describing the result, and Retry-After in seconds when the
+// request was denied.
+func (r *CheckResult) Headers() http.Header {
+	headers := http.Header{}
+	headers.Set("X-RateLimit-Limit", strconv.FormatInt(r.Limit, 10))
+	headers.Set("X-RateLimit-Remaining", strconv.FormatInt(max(r.Remaining, 0), 10))
+	headers.Set("X-RateLimit-Reset", strconv.FormatInt(r.ResetAt, 10))
+	if !r.Allowed {
+		headers.Set("Retry-After", strconv.FormatInt(max((r.RetryAfterMs+999)/1000, 1), 10))
+	}
+	return headers
 }
 
 func (e *Engine) Check(label, key string, cost int64) (*CheckResult, error) {
-	e.mu.Lock()
-	defer e.mu.Unlock()
+	config, err := e.config(label)
+	if err != nil {
+		return nil, err
+	}
+
+	requestCost := float64(cost)
+	if requestCost == 0 {
+		requestCost = 1
+	}
+
+	initialTokens := 0.0
+	if config.algorithm == AlgorithmTokenBucket {
+		initialTokens = config.maxTokens()
+	}
+
+	var now time.Time
+	var result *CheckResult
+	err = e.states.update(label, key, initialTokens, func(state *persistence.RateLimitState, log *entryLog) bool {
+		// Taken after the state is loaded, a new state starts at the current time.
+		now = e.now()
+		result = evaluate(state, log, config, requestCost, now)
+		state.UpdatedAt = now
+		return true
+	})
+	if err != nil {
+		return nil, err
+	}
+	e.states.record(label, key, result.Allowed, now)
+	if result.Allowed {
+		metrics.RateLimitChecks.WithLabelValues(label, "allowed").Inc()
+	} else {
+		metrics.RateLimitChecks.WithLabelValues(label, "denied").Inc()
+	}
+	return result, nil
+}
+
+// Release returns a lease taken by a concurrency rate limit before it expires. Releasing an empty, unknown or
+// expired lease is a no-op.
+func (e *Engine) Release(label, key, leaseID string) error {
+	if leaseID == "" {
+		return nil
+	}
+
+	return e.states.update(label, key, 0, func(state *persistence.RateLimitState, log *entryLog) bool {
+		return releaseLease(state, log, leaseID, e.now())
+	})
+}
+
+// Invalidate drops the cached config of the rate limit, it has to be called whenever a rate limit is changed.
+func (e *Engine) Invalidate(label string) {
+	e.configMu.Lock()
+	delete(e.configs, label)
+	e.configMu.Unlock()
+}
+
+// Flush writes the states changed since the last flush to the database.
+func (e *Engine) Flush() error {
+	return e.states.flush()
+}
+
+func (e *Engine) config(label string) (*limitConfig, error) {
+	e.configMu.RLock()
+	cached, ok := e.configs[label]
+	e.configMu.RUnlock()
+	if ok && time.Since(cached.loadedAt) < configCacheTTL {
+		return cached.config, nil
+	}
 
+	config, err := e.loadConfig(label)
+	if err != nil {
+		return nil, err
+	}
+
+	e.configMu.Lock()
+	e.configs[label] = cachedConfig{config: config, loadedAt: time.Now()}
+	e.configMu.Unlock()
+	return config, nil
+}
+
+// IsConcurrency reports whether the rate limit config uses the concurrency algorithm, whose leases have to be
+// released by the caller.
+func IsConcurrency(rateLimitConfig []byte) bool {
+	var config struct {
+		Algorithm string `yaml:"algorithm"`
+	}
+	if err := yaml.Unmarshal(rateLimitConfig, &config); err != nil {
+		return false
+	}
+	return config.Algorithm == AlgorithmConcurrency
+}
+
+func (e *Engine) loadConfig(label string) (*limitConfig, error) {
 	rateLimit, err := e.rateLimitRepo.FindByLabel(label)
 	if err != nil {
 		return nil, fmt.Errorf("failed to find rate limit: %w", err)
@@ -67,49 +200,55 @@ func (e *Engine) Check(label, key string, cost int64) (*CheckResult, error) {
 		burst = int64(burstVal)
 	}
 
+	algorithm := AlgorithmTokenBucket
+	if algorithmVal, ok := config["algorithm"].(string); ok && algorithmVal != "" {
+		algorithm = algorithmVal
+	}
+	switch algorithm {
+	case AlgorithmTokenBucket, AlgorithmFixedWindow, AlgorithmSlidingWindow, AlgorithmSlidingLog, AlgorithmConcurrency:
+	default:
+		return nil, fmt.Errorf("unsupported algorithm: %s", algorithm)
+	}
+
 	intervalDuration, err := parseDuration(interval)
 	if err != nil {
 		return nil, fmt.Errorf("invalid interval: %w", err)
 	}
-
-	maxTokens := float64(count)
-	if burst > 0 {
-		maxTokens = float64(int64(count) + burst)
+	if intervalDuration <= 0 {
+		return nil, fmt.Errorf("invalid interval: %s must be positive", interval)
 	}
 
-	state, err := e.rateLimitStateRepo.GetOrCreate(label, key, maxTokens)
-	if err != nil {
-		return nil, fmt.Errorf("failed to get or create state: %w", err)
-	}
+	return &limitConfig{
+		algorithm: algorithm,
+		count:     float64(count),
+		interval:  intervalDuration,
+		burst:     burst,
+	}, nil
+}
+
+func checkTokenBucket(state *persistence.RateLimitState, config *limitConfig, requestCost float64, now time.Time) *CheckResult {
+	count := config.count
+	intervalDuration := config.interval
+	maxTokens := config.maxTokens()
 
-	now := time.Now()
 	elapsed := now.Sub(state.LastRefillAt)
 
-	tokensToAdd := (float64(count) / intervalDuration.Seconds()) * elapsed.Seconds()
+	tokensToAdd := (count / intervalDuration.Seconds()) * elapsed.Seconds()
 	state.Tokens = math.Min(state.Tokens+tokensToAdd, maxTokens)
 	state.LastRefillAt = now
 
-	requestCost := float64(cost)
-	if requestCost == 0 {
-		requestCost = 1
-	}
-
 	allowed := state.Tokens >= requestCost
 
 	var retryAfterMs int64
 	if !allowed {
 		tokensNeeded := requestCost - state.Tokens
-		refillRate := float64(count) / intervalDuration.Seconds()
+		refillRate := count / intervalDuration.Seconds()
 		secondsToWait := tokensNeeded / refillRate
 		retryAfterMs = int64(math.Ceil(secondsToWait * 1000))
 	} else {
 		state.Tokens -= requestCost
 	}
 
-	if err := e.rateLimitStateRepo.Update(state); err != nil {
-		return nil, fmt.Errorf("failed to update state: %w", err)
-	}
-
 	resetAt := now.Add(intervalDuration).Unix()
 	remaining := int64(math.Floor(state.Tokens))
 
@@ -119,13 +258,21 @@ func (e *Engine) Check(label, key string, cost int64) (*CheckResult, error) {
 		Remaining:    remaining,
 		Limit:        int64(maxTokens),
 		ResetAt:      resetAt,
-	}, nil
+	}
 }
 
+// Cleanup drops the states of keys that were not used for the given duration from memory and the database,
+// and counters past their retention.
 func (e *Engine) Cleanup(olderThan time.Duration) error {
+	e.states.evict(e.now().Add(-olderThan))
+	if err := e.rateLimitCounterRepo.DeleteOlderThan(counterRetention); err != nil {
+		return fmt.Errorf("failed to delete old counters: %w", err)
+	}
 	return e.rateLimitStateRepo.DeleteOlderThan(olderThan)
 }
 
+// parseDuration parses intervals like 30s, 5m, 1h, 1d or 2w, and falls back to Go duration syntax such as
+// 1h30m or 500ms.
 func parseDuration(interval string) (time.Duration, error) {
 	if len(interval) < 2 {
 		return 0, fmt.Errorf("invalid interval format: %s", interval)
@@ -134,20 +281,24 @@ func parseDuration(interval string) (time.Duration, error) {
 	unit := interval[len(interval)-1:]
 	valueStr := interval[:len(interval)-1]
 
-	var value int64
-	_, err := fmt.Sscanf(valueStr, "%d", &value)
-	if err != nil {
-		return 0, fmt.Errorf("invalid interval value: %s", interval)
+	if value, err := strconv.ParseInt(valueStr, 10, 64); err == nil {
+		switch unit {
+		case "s":
+			return time.Duration(value) * time.Second, nil
+		case "m":
+			return time.Duration(value) * time.Minute, nil
+		case "h":
+			return time.Duration(value) * time.Hour, nil
+		case "d":
+			return time.Duration(value) * 24 * time.Hour, nil
+		case "w":
+			return time.Duration(value) * 7 * 24 * time.Hour, nil
+		}
 	}
 
-	switch unit {
-	case "s":
-		return time.Duration(value) * time.Second, nil
-	case "m":
-		return time.Duration(value) * time.Minute, nil
-	case "h":
-		return time.Duration(value) * time.Hour, nil
-	default:
-		return 0, fmt.Errorf("unsupported interval unit: %s", unit)
+	duration, err := time.ParseDuration(interval)
+	if err != nil {
+		return 0, fmt.Errorf("unsupported interval: %s", interval)
 	}
+	return duration, nil
 }
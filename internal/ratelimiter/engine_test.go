@@ -1,6 +1,8 @@
 package ratelimiter
 
 import (
+	"fmt"
+	"sync/atomic"
 	"testing"
 	"time"
 
@@ -14,17 +16,19 @@ import (
 	"gorm.io/gorm"
 )
 
-func setupTestDB(t *testing.T) *gorm.DB {
+func setupTestDB(t testing.TB) *gorm.DB {
 	sqlDB, err := sql.Open("sqlite", ":memory:")
 	if err != nil {
 		t.Fatalf("failed to open sqlite connection: %v", err)
 	}
+	// Every connection opens its own in-memory database.
+	sqlDB.SetMaxOpenConns(1)
 	db, err := gorm.Open(sqlite.New(sqlite.Config{Conn: sqlDB}), &gorm.Config{})
 	if err != nil {
 		t.Fatalf("failed to open test database: %v", err)
 	}
 
-	err = db.AutoMigrate(&persistence.RateLimit{}, &persistence.RateLimitState{})
+	err = db.AutoMigrate(&persistence.RateLimit{}, &persistence.RateLimitState{}, &persistence.RateLimitCounter{})
 	if err != nil {
 		t.Fatalf("failed to migrate test database: %v", err)
 	}
@@ -32,7 +36,7 @@ func setupTestDB(t *testing.T) *gorm.DB {
 	return db
 }
 
-func createTestRateLimit(t *testing.T, db *gorm.DB, label string, count int64, interval string, burst int64) {
+func createTestRateLimit(t testing.TB, db *gorm.DB, label string, count int64, interval string, burst int64) {
 	repo := persistence.NewRateLimitRepository(db)
 
 	config := map[string]any{
@@ -59,13 +63,62 @@ func createTestRateLimit(t *testing.T, db *gorm.DB, label string, count int64, i
 	}
 }
 
+func createTestRateLimitWithAlgorithm(t *testing.T, db *gorm.DB, label, algorithm string, count int64, interval string) {
+	repo := persistence.NewRateLimitRepository(db)
+
+	config := map[string]any{
+		"coordinator_address": "localhost:50000",
+		"algorithm":           algorithm,
+		"count":               count,
+		"interval":            interval,
+	}
+	configYaml, err := yaml.Marshal(config)
+	if err != nil {
+		t.Fatalf("failed to marshal config: %v", err)
+	}
+
+	rateLimit := &persistence.RateLimit{
+		Label:     label,
+		Component: "coordinator",
+		Config:    configYaml,
+		IsCurrent: true,
+		CreatedAt: time.Now(),
+	}
+	if err := repo.Create(rateLimit); err != nil {
+		t.Fatalf("failed to create rate limit: %v", err)
+	}
+}
+
+// setupTestEngineWithClock returns an engine whose clock is controlled by the returned function. The clock
+// starts after the current time, so states created with the real time are never in the future.
+func setupTestEngineWithClock(db *gorm.DB, start time.Time) (*Engine, func(time.Time)) {
+	engine := NewEngine(persistence.NewRateLimitRepository(db), persistence.NewRateLimitStateRepository(db), persistence.NewRateLimitCounterRepository(db))
+	now := start
+	engine.now = func() time.Time { return now }
+	return engine, func(t time.Time) { now = t }
+}
+
+func countAllowed(t *testing.T, engine *Engine, label, key string, requests int) int {
+	allowed := 0
+	for i := 0; i < requests; i++ {
+		result, err := engine.Check(label, key, 1)
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if result.Allowed {
+			allowed++
+		}
+	}
+	return allowed
+}
+
 func TestEngine_Check_BasicRateLimit(t *testing.T) {
 	db := setupTestDB(t)
 	createTestRateLimit(t, db, "test_limit", 10, "1s", 0)
 
 	rateLimitRepo := persistence.NewRateLimitRepository(db)
 	rateLimitStateRepo := persistence.NewRateLimitStateRepository(db)
-	engine := NewEngine(rateLimitRepo, rateLimitStateRepo)
+	engine := NewEngine(rateLimitRepo, rateLimitStateRepo, persistence.NewRateLimitCounterRepository(db))
 
 	result, err := engine.Check("test_limit", "user1", 1)
 	if err != nil {
@@ -91,7 +144,7 @@ func TestEngine_Check_WithBurst(t *testing.T) {
 
 	rateLimitRepo := persistence.NewRateLimitRepository(db)
 	rateLimitStateRepo := persistence.NewRateLimitStateRepository(db)
-	engine := NewEngine(rateLimitRepo, rateLimitStateRepo)
+	engine := NewEngine(rateLimitRepo, rateLimitStateRepo, persistence.NewRateLimitCounterRepository(db))
 
 	result, err := engine.Check("test_burst", "user1", 1)
 	if err != nil {
@@ -117,7 +170,7 @@ func TestEngine_Check_ExceedLimit(t *testing.T) {
 
 	rateLimitRepo := persistence.NewRateLimitRepository(db)
 	rateLimitStateRepo := persistence.NewRateLimitStateRepository(db)
-	engine := NewEngine(rateLimitRepo, rateLimitStateRepo)
+	engine := NewEngine(rateLimitRepo, rateLimitStateRepo, persistence.NewRateLimitCounterRepository(db))
 
 	for i := 0; i < 2; i++ {
 		result, err := engine.Check("test_exceed", "user1", 1)
@@ -149,7 +202,7 @@ func TestEngine_Check_PerKeyIsolation(t *testing.T) {
 
 	rateLimitRepo := persistence.NewRateLimitRepository(db)
 	rateLimitStateRepo := persistence.NewRateLimitStateRepository(db)
-	engine := NewEngine(rateLimitRepo, rateLimitStateRepo)
+	engine := NewEngine(rateLimitRepo, rateLimitStateRepo, persistence.NewRateLimitCounterRepository(db))
 
 	result1, err := engine.Check("test_perkey", "user1", 2)
 	if err != nil {
@@ -190,7 +243,7 @@ func TestEngine_Check_WithCost(t *testing.T) {
 
 	rateLimitRepo := persistence.NewRateLimitRepository(db)
 	rateLimitStateRepo := persistence.NewRateLimitStateRepository(db)
-	engine := NewEngine(rateLimitRepo, rateLimitStateRepo)
+	engine := NewEngine(rateLimitRepo, rateLimitStateRepo, persistence.NewRateLimitCounterRepository(db))
 
 	result, err := engine.Check("test_cost", "user1", 5)
 	if err != nil {
@@ -221,7 +274,7 @@ func TestEngine_Check_TokenRefill(t *testing.T) {
 
 	rateLimitRepo := persistence.NewRateLimitRepository(db)
 	rateLimitStateRepo := persistence.NewRateLimitStateRepository(db)
-	engine := NewEngine(rateLimitRepo, rateLimitStateRepo)
+	engine := NewEngine(rateLimitRepo, rateLimitStateRepo, persistence.NewRateLimitCounterRepository(db))
 
 	result1, err := engine.Check("test_refill", "user1", 10)
 	if err != nil {
@@ -256,7 +309,7 @@ func TestEngine_Check_MinuteInterval(t *testing.T) {
 
 	rateLimitRepo := persistence.NewRateLimitRepository(db)
 	rateLimitStateRepo := persistence.NewRateLimitStateRepository(db)
-	engine := NewEngine(rateLimitRepo, rateLimitStateRepo)
+	engine := NewEngine(rateLimitRepo, rateLimitStateRepo, persistence.NewRateLimitCounterRepository(db))
 
 	result, err := engine.Check("test_minute", "user1", 1)
 	if err != nil {
@@ -278,7 +331,7 @@ func TestEngine_Check_HourInterval(t *testing.T) {
 
 	rateLimitRepo := persistence.NewRateLimitRepository(db)
 	rateLimitStateRepo := persistence.NewRateLimitStateRepository(db)
-	engine := NewEngine(rateLimitRepo, rateLimitStateRepo)
+	engine := NewEngine(rateLimitRepo, rateLimitStateRepo, persistence.NewRateLimitCounterRepository(db))
 
 	result, err := engine.Check("test_hour", "user1", 1)
 	if err != nil {
@@ -299,7 +352,7 @@ func TestEngine_Check_NotFound(t *testing.T) {
 
 	rateLimitRepo := persistence.NewRateLimitRepository(db)
 	rateLimitStateRepo := persistence.NewRateLimitStateRepository(db)
-	engine := NewEngine(rateLimitRepo, rateLimitStateRepo)
+	engine := NewEngine(rateLimitRepo, rateLimitStateRepo, persistence.NewRateLimitCounterRepository(db))
 
 	_, err := engine.Check("nonexistent", "user1", 1)
 	if err == nil {
@@ -313,7 +366,7 @@ func TestEngine_Check_ZeroCost(t *testing.T) {
 
 	rateLimitRepo := persistence.NewRateLimitRepository(db)
 	rateLimitStateRepo := persistence.NewRateLimitStateRepository(db)
-	engine := NewEngine(rateLimitRepo, rateLimitStateRepo)
+	engine := NewEngine(rateLimitRepo, rateLimitStateRepo, persistence.NewRateLimitCounterRepository(db))
 
 	result, err := engine.Check("test_zero", "user1", 0)
 	if err != nil {
@@ -335,7 +388,7 @@ func TestEngine_Cleanup(t *testing.T) {
 
 	rateLimitRepo := persistence.NewRateLimitRepository(db)
 	rateLimitStateRepo := persistence.NewRateLimitStateRepository(db)
-	engine := NewEngine(rateLimitRepo, rateLimitStateRepo)
+	engine := NewEngine(rateLimitRepo, rateLimitStateRepo, persistence.NewRateLimitCounterRepository(db))
 
 	_, err := engine.Check("test_cleanup", "user1", 1)
 	if err != nil {
@@ -371,6 +424,11 @@ func TestParseDuration(t *testing.T) {
 		{"5m", 5 * time.Minute, false},
 		{"1h", 1 * time.Hour, false},
 		{"24h", 24 * time.Hour, false},
+		{"1d", 24 * time.Hour, false},
+		{"7d", 7 * 24 * time.Hour, false},
+		{"2w", 14 * 24 * time.Hour, false},
+		{"1h30m", 90 * time.Minute, false},
+		{"500ms", 500 * time.Millisecond, false},
 		{"invalid", 0, true},
 		{"s", 0, true},
 		{"1x", 0, true},
@@ -395,3 +453,478 @@ func TestParseDuration(t *testing.T) {
 		})
 	}
 }
+
+func TestCheckResult_Headers(t *testing.T) {
+	allowed := (&CheckResult{Allowed: true, Remaining: 4, Limit: 5, ResetAt: 1700000000}).Headers()
+	if allowed.Get("X-RateLimit-Limit") != "5" {
+		t.Errorf("expected limit header 5, got %q", allowed.Get("X-RateLimit-Limit"))
+	}
+	if allowed.Get("X-RateLimit-Remaining") != "4" {
+		t.Errorf("expected remaining header 4, got %q", allowed.Get("X-RateLimit-Remaining"))
+	}
+	if allowed.Get("X-RateLimit-Reset") != "1700000000" {
+		t.Errorf("expected reset header 1700000000, got %q", allowed.Get("X-RateLimit-Reset"))
+	}
+	if allowed.Get("Retry-After") != "" {
+		t.Errorf("expected no Retry-After header for allowed requests")
+	}
+
+	denied := (&CheckResult{Allowed: false, RetryAfterMs: 1500, Remaining: 0, Limit: 5}).Headers()
+	if denied.Get("Retry-After") != "2" {
+		t.Errorf("expected Retry-After to round up to 2, got %q", denied.Get("Retry-After"))
+	}
+
+	denied = (&CheckResult{Allowed: false, RetryAfterMs: 10, Limit: 5}).Headers()
+	if denied.Get("Retry-After") != "1" {
+		t.Errorf("expected Retry-After of at least 1, got %q", denied.Get("Retry-After"))
+	}
+}
+
+func TestEngine_Check_BurstAcrossWindowBoundary(t *testing.T) {
+	// A client sends 10 requests right before a minute ends, 10 right after it and 10 more half a minute
+	// later, against a limit of 10 per minute.
+	tests := []struct {
+		algorithm       string
+		atBoundary      int
+		halfMinuteLater int
+	}{
+		{AlgorithmTokenBucket, 10, 5},
+		{AlgorithmFixedWindow, 20, 0},
+		{AlgorithmSlidingWindow, 10, 5},
+		{AlgorithmSlidingLog, 10, 0},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.algorithm, func(t *testing.T) {
+			db := setupTestDB(t)
+			createTestRateLimitWithAlgorithm(t, db, "test_burst", tt.algorithm, 10, "1m")
+
+			minute := time.Now().Truncate(time.Minute).Add(time.Minute)
+			engine, setNow := setupTestEngineWithClock(db, minute.Add(59*time.Second))
+
+			allowed := countAllowed(t, engine, "test_burst", "user1", 10)
+			setNow(minute.Add(61 * time.Second))
+			allowed += countAllowed(t, engine, "test_burst", "user1", 10)
+			if allowed != tt.atBoundary {
+				t.Errorf("expected %d requests allowed around the boundary, got %d", tt.atBoundary, allowed)
+			}
+
+			setNow(minute.Add(90 * time.Second))
+			allowed = countAllowed(t, engine, "test_burst", "user1", 10)
+			if allowed != tt.halfMinuteLater {
+				t.Errorf("expected %d requests allowed half a minute later, got %d", tt.halfMinuteLater, allowed)
+			}
+		})
+	}
+}
+
+func TestEngine_Check_FixedWindow(t *testing.T) {
+	db := setupTestDB(t)
+	createTestRateLimitWithAlgorithm(t, db, "test_fixed", AlgorithmFixedWindow, 5, "1d")
+
+	day := time.Now().Truncate(24 * time.Hour).Add(24 * time.Hour)
+	engine, setNow := setupTestEngineWithClock(db, day.Add(18*time.Hour))
+
+	if allowed := countAllowed(t, engine, "test_fixed", "user1", 5); allowed != 5 {
+		t.Fatalf("expected 5 requests allowed, got %d", allowed)
+	}
+
+	result, err := engine.Check("test_fixed", "user1", 1)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if result.Allowed {
+		t.Errorf("expected request to be denied")
+	}
+	if result.RetryAfterMs != (6 * time.Hour).Milliseconds() {
+		t.Errorf("expected retry after the end of the day, got %dms", result.RetryAfterMs)
+	}
+	if result.ResetAt != day.Add(24*time.Hour).Unix() {
+		t.Errorf("expected reset at the end of the day, got %d", result.ResetAt)
+	}
+
+	setNow(day.Add(24 * time.Hour))
+	result, err = engine.Check("test_fixed", "user1", 1)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if !result.Allowed || result.Remaining != 4 {
+		t.Errorf("expected request to be allowed in the next day with 4 remaining, got allowed=%v remaining=%d", result.Allowed, result.Remaining)
+	}
+}
+
+func TestEngine_Check_SlidingLogRetryAfter(t *testing.T) {
+	db := setupTestDB(t)
+	createTestRateLimitWithAlgorithm(t, db, "test_log", AlgorithmSlidingLog, 2, "10s")
+
+	start := time.Now().Add(time.Minute)
+	engine, setNow := setupTestEngineWithClock(db, start)
+
+	countAllowed(t, engine, "test_log", "user1", 1)
+	setNow(start.Add(4 * time.Second))
+	countAllowed(t, engine, "test_log", "user1", 1)
+
+	result, err := engine.Check("test_log", "user1", 1)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if result.Allowed {
+		t.Fatalf("expected request to be denied")
+	}
+	if result.RetryAfterMs != 6000 {
+		t.Errorf("expected retry once the oldest request expires after 6000ms, got %dms", result.RetryAfterMs)
+	}
+
+	setNow(start.Add(10 * time.Second))
+	if allowed := countAllowed(t, engine, "test_log", "user1", 2); allowed != 1 {
+		t.Errorf("expected 1 request allowed after the oldest one expired, got %d", allowed)
+	}
+}
+
+func TestEngine_Check_Concurrency(t *testing.T) {
+	db := setupTestDB(t)
+	createTestRateLimitWithAlgorithm(t, db, "test_concurrency", AlgorithmConcurrency, 2, "1m")
+
+	start := time.Now().Add(time.Minute)
+	engine, setNow := setupTestEngineWithClock(db, start)
+
+	var leases []string
+	for i := 0; i < 2; i++ {
+		result, err := engine.Check("test_concurrency", "user1", 1)
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if !result.Allowed || result.LeaseID == "" {
+			t.Fatalf("expected request %d to be allowed with a lease", i+1)
+		}
+		leases = append(leases, result.LeaseID)
+	}
+
+	result, err := engine.Check("test_concurrency", "user1", 1)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if result.Allowed || result.LeaseID != "" {
+		t.Errorf("expected third request to be denied without a lease")
+	}
+
+	if err := engine.Release("test_concurrency", "user1", leases[0]); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if err := engine.Release("test_concurrency", "user1", leases[0]); err != nil {
+		t.Fatalf("releasing a lease twice should be a no-op: %v", err)
+	}
+
+	result, err = engine.Check("test_concurrency", "user1", 1)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if !result.Allowed {
+		t.Errorf("expected request to be allowed after a lease was released")
+	}
+
+	setNow(start.Add(time.Minute))
+	if allowed := countAllowed(t, engine, "test_concurrency", "user1", 3); allowed != 2 {
+		t.Errorf("expected expired leases to free their slots, got %d allowed", allowed)
+	}
+}
+
+func TestEngine_Check_UnsupportedAlgorithm(t *testing.T) {
+	db := setupTestDB(t)
+	createTestRateLimitWithAlgorithm(t, db, "test_unknown", "leaky", 10, "1s")
+
+	engine := NewEngine(persistence.NewRateLimitRepository(db), persistence.NewRateLimitStateRepository(db), persistence.NewRateLimitCounterRepository(db))
+	if _, err := engine.Check("test_unknown", "user1", 1); err == nil {
+		t.Errorf("expected error for unsupported algorithm")
+	}
+}
+
+func TestIsConcurrency(t *testing.T) {
+	tests := map[string]bool{
+		"count: 5\ninterval: 1m\nalgorithm: concurrency\n":  true,
+		"count: 5\ninterval: 1m\nalgorithm: fixed_window\n": false,
+		"count: 5\ninterval: 1m\n":                          false,
+		"not: [yaml":                                        false,
+	}
+	for config, want := range tests {
+		if got := IsConcurrency([]byte(config)); got != want {
+			t.Errorf("IsConcurrency(%q) = %t, want %t", config, got, want)
+		}
+	}
+}
+
+func TestEngine_Flush(t *testing.T) {
+	db := setupTestDB(t)
+	createTestRateLimit(t, db, "test_flush", 10, "1m", 0)
+
+	start := time.Now().Add(time.Minute)
+	engine, _ := setupTestEngineWithClock(db, start)
+	countAllowed(t, engine, "test_flush", "user1", 3)
+
+	var state persistence.RateLimitState
+	db.Where("rate_limit_label = ? AND key = ?", "test_flush", "user1").First(&state)
+	if state.Tokens != 10 {
+		t.Errorf("expected state to be written only by flush, got %v tokens", state.Tokens)
+	}
+
+	if err := engine.Flush(); err != nil {
+		t.Fatalf("flush failed: %v", err)
+	}
+	db.Where("rate_limit_label = ? AND key = ?", "test_flush", "user1").First(&state)
+	if state.Tokens != 7 {
+		t.Errorf("expected 7 tokens after flush, got %v", state.Tokens)
+	}
+
+	restarted, _ := setupTestEngineWithClock(db, start)
+	result, err := restarted.Check("test_flush", "user1", 1)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if result.Remaining != 6 {
+		t.Errorf("expected a new engine to continue from the flushed state, got %d remaining", result.Remaining)
+	}
+}
+
+func TestEngine_Flush_SlidingLog(t *testing.T) {
+	db := setupTestDB(t)
+	createTestRateLimitWithAlgorithm(t, db, "test_log_flush", AlgorithmSlidingLog, 3, "1m")
+
+	start := time.Now().Add(time.Minute)
+	engine, _ := setupTestEngineWithClock(db, start)
+	countAllowed(t, engine, "test_log_flush", "user1", 2)
+
+	var state persistence.RateLimitState
+	db.Where("rate_limit_label = ? AND key = ?", "test_log_flush", "user1").First(&state)
+	if len(state.Entries) != 0 {
+		t.Errorf("expected entries to be encoded only by flush, got %s", state.Entries)
+	}
+
+	if err := engine.Flush(); err != nil {
+		t.Fatalf("flush failed: %v", err)
+	}
+
+	restarted, _ := setupTestEngineWithClock(db, start.Add(time.Second))
+	if allowed := countAllowed(t, restarted, "test_log_flush", "user1", 2); allowed != 1 {
+		t.Errorf("expected a new engine to continue from the flushed entries, got %d allowed", allowed)
+	}
+}
+
+func TestEngine_Check_ConcurrentFirstUse(t *testing.T) {
+	db := setupTestDB(t)
+	createTestRateLimitWithAlgorithm(t, db, "test_first_use", AlgorithmFixedWindow, 5, "1h")
+
+	engine, _ := setupTestEngineWithClock(db, time.Now().Truncate(time.Hour).Add(time.Hour))
+
+	var allowed atomic.Int64
+	done := make(chan struct{})
+	for i := 0; i < 20; i++ {
+		go func() {
+			defer func() { done <- struct{}{} }()
+			result, err := engine.Check("test_first_use", "user1", 1)
+			if err != nil {
+				t.Errorf("unexpected error: %v", err)
+				return
+			}
+			if result.Allowed {
+				allowed.Add(1)
+			}
+		}()
+	}
+	for i := 0; i < 20; i++ {
+		<-done
+	}
+
+	if allowed.Load() != 5 {
+		t.Errorf("expected 5 requests allowed, got %d", allowed.Load())
+	}
+	var rows int64
+	db.Model(&persistence.RateLimitState{}).Where("rate_limit_label = ?", "test_first_use").Count(&rows)
+	if rows != 1 {
+		t.Errorf("expected the state to be created once, got %d rows", rows)
+	}
+}
+
+func TestEngine_Invalidate(t *testing.T) {
+	db := setupTestDB(t)
+	createTestRateLimitWithAlgorithm(t, db, "test_invalidate", AlgorithmFixedWindow, 1, "1h")
+
+	engine, _ := setupTestEngineWithClock(db, time.Now().Truncate(time.Hour).Add(time.Hour))
+	if allowed := countAllowed(t, engine, "test_invalidate", "user1", 2); allowed != 1 {
+		t.Fatalf("expected 1 request allowed, got %d", allowed)
+	}
+
+	config, _ := yaml.Marshal(map[string]any{"algorithm": AlgorithmFixedWindow, "count": 3, "interval": "1h"})
+	db.Model(&persistence.RateLimit{}).Where("label = ?", "test_invalidate").Update("config", config)
+
+	if allowed := countAllowed(t, engine, "test_invalidate", "user1", 1); allowed != 0 {
+		t.Errorf("expected the cached config to be used until it is invalidated")
+	}
+
+	engine.Invalidate("test_invalidate")
+	if allowed := countAllowed(t, engine, "test_invalidate", "user1", 3); allowed != 2 {
+		t.Errorf("expected 2 more requests allowed with the new count, got %d", allowed)
+	}
+}
+
+func BenchmarkEngine_Check(b *testing.B) {
+	db := setupTestDB(b)
+	createTestRateLimit(b, db, "bench", 1000000, "1s", 0)
+	engine := NewEngine(persistence.NewRateLimitRepository(db), persistence.NewRateLimitStateRepository(db), persistence.NewRateLimitCounterRepository(db))
+
+	if _, err := engine.Check("bench", "user1", 1); err != nil {
+		b.Fatalf("unexpected error: %v", err)
+	}
+
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		if _, err := engine.Check("bench", "user1", 1); err != nil {
+			b.Fatalf("unexpected error: %v", err)
+		}
+	}
+}
+
+func BenchmarkEngine_CheckParallel(b *testing.B) {
+	db := setupTestDB(b)
+	createTestRateLimit(b, db, "bench", 1000000, "1s", 0)
+	engine := NewEngine(persistence.NewRateLimitRepository(db), persistence.NewRateLimitStateRepository(db), persistence.NewRateLimitCounterRepository(db))
+
+	keys := make([]string, 1000)
+	for i := range keys {
+		keys[i] = fmt.Sprintf("user%d", i)
+		if _, err := engine.Check("bench", keys[i], 1); err != nil {
+			b.Fatalf("unexpected error: %v", err)
+		}
+	}
+
+	var next atomic.Int64
+	b.ResetTimer()
+	b.RunParallel(func(pb *testing.PB) {
+		i := int(next.Add(1))
+		for pb.Next() {
+			if _, err := engine.Check("bench", keys[i%len(keys)], 1); err != nil {
+				b.Errorf("unexpected error: %v", err)
+				return
+			}
+			i++
+		}
+	})
+}
+
+func TestEngine_KeysAndReset(t *testing.T) {
+	db := setupTestDB(t)
+	createTestRateLimitWithAlgorithm(t, db, "test_keys", AlgorithmFixedWindow, 5, "1h")
+
+	engine, _ := setupTestEngineWithClock(db, time.Now().Truncate(time.Hour).Add(time.Hour))
+	countAllowed(t, engine, "test_keys", "user1", 2)
+	countAllowed(t, engine, "test_keys", "user2", 1)
+	countAllowed(t, engine, "test_keys", "other", 1)
+
+	keys, total, err := engine.Keys("test_keys", "user", 10, 0)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if total != 2 || len(keys) != 2 {
+		t.Fatalf("expected 2 keys with the prefix, got %d of %d", len(keys), total)
+	}
+	remaining := map[string]int64{}
+	for _, key := range keys {
+		remaining[key.Key] = key.Remaining
+	}
+	if remaining["user1"] != 3 || remaining["user2"] != 4 {
+		t.Errorf("expected listing not to consume quota, got %v", remaining)
+	}
+
+	if err := engine.Reset("test_keys", "user1"); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	result, err := engine.Check("test_keys", "user1", 1)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if result.Remaining != 4 {
+		t.Errorf("expected a reset key to start over, got %d remaining", result.Remaining)
+	}
+
+	if err := engine.Reset("test_keys", ""); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	_, total, err = engine.Keys("test_keys", "", 10, 0)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if total != 0 {
+		t.Errorf("expected no keys after resetting the label, got %d", total)
+	}
+}
+
+func TestEngine_ResetDuringFlush(t *testing.T) {
+	db := setupTestDB(t)
+	createTestRateLimitWithAlgorithm(t, db, "test_reset_flush", AlgorithmFixedWindow, 5, "1h")
+
+	engine, _ := setupTestEngineWithClock(db, time.Now().Truncate(time.Hour).Add(time.Hour))
+	for i := 0; i < 20; i++ {
+		key := fmt.Sprintf("user%d", i)
+		countAllowed(t, engine, "test_reset_flush", key, 3)
+	}
+
+	stop := make(chan struct{})
+	flushed := make(chan struct{})
+	go func() {
+		defer close(flushed)
+		for {
+			select {
+			case <-stop:
+				return
+			default:
+				if err := engine.Flush(); err != nil {
+					t.Errorf("flush failed: %v", err)
+					return
+				}
+			}
+		}
+	}()
+	if err := engine.Reset("test_reset_flush", ""); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	close(stop)
+	<-flushed
+
+	if err := engine.Flush(); err != nil {
+		t.Fatalf("flush failed: %v", err)
+	}
+	var rows int64
+	db.Model(&persistence.RateLimitState{}).Where("rate_limit_label = ?", "test_reset_flush").Count(&rows)
+	if rows != 0 {
+		t.Errorf("expected no states after the reset, got %d", rows)
+	}
+}
+
+func TestEngine_Stats(t *testing.T) {
+	db := setupTestDB(t)
+	createTestRateLimitWithAlgorithm(t, db, "test_stats", AlgorithmFixedWindow, 3, "1h")
+
+	hour := time.Now().Truncate(time.Hour).Add(time.Hour)
+	engine, setNow := setupTestEngineWithClock(db, hour)
+	countAllowed(t, engine, "test_stats", "user1", 5)
+	countAllowed(t, engine, "test_stats", "user2", 1)
+	if err := engine.Flush(); err != nil {
+		t.Fatalf("flush failed: %v", err)
+	}
+
+	setNow(hour.Add(time.Minute))
+	countAllowed(t, engine, "test_stats", "user2", 3)
+
+	counters, err := engine.Stats("test_stats", hour.Add(-time.Minute))
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(counters) != 2 {
+		t.Fatalf("expected counters of 2 minutes, got %d", len(counters))
+	}
+	if counters[0].Allowed != 4 || counters[0].Denied != 2 {
+		t.Errorf("expected 4 allowed and 2 denied in the first minute, got %d and %d", counters[0].Allowed, counters[0].Denied)
+	}
+	if counters[1].Allowed != 2 || counters[1].Denied != 1 {
+		t.Errorf("expected 2 allowed and 1 denied in the second minute, got %d and %d", counters[1].Allowed, counters[1].Denied)
+	}
+}
@@ -0,0 +1,295 @@
+package ratelimiter
+
+import (
+	"errors"
+	"fmt"
+	"hash/fnv"
+	"sync"
+	"time"
+
+	"github.com/sananguliyev/airtruct/internal/persistence"
+)
+
+// stateShardCount is the number of independently locked parts of the state store.
+const stateShardCount = 64
+
+type stateKey struct {
+	label string
+	key   string
+}
+
+type cachedState struct {
+	state *persistence.RateLimitState
+	log   entryLog
+	// dirty is set when the state changed since it was last written to the database.
+	dirty bool
+}
+
+// stateLoad is a state being read from the database, checks of the same key wait for it instead of reading
+// it again.
+type stateLoad struct {
+	done chan struct{}
+	err  error
+}
+
+type counterKey struct {
+	label  string
+	bucket int64
+}
+
+type counterValue struct {
+	allowed int64
+	denied  int64
+}
+
+type stateShard struct {
+	mu     sync.Mutex
+	states map[stateKey]*cachedState
+	loads  map[stateKey]*stateLoad
+	// counters holds the checks per label and minute that were not written to the database yet.
+	counters map[counterKey]*counterValue
+}
+
+// stateStore keeps the rate limit states in memory. A state is read from the database the first time its key
+// is used and written back in batches by flush, so a check is a map lookup under the lock of one shard.
+type stateStore struct {
+	repo        persistence.RateLimitStateRepository
+	counterRepo persistence.RateLimitCounterRepository
+	shards      [stateShardCount]*stateShard
+	// resetMu is held for reading while states are loaded from or written to the database and for writing by
+	// reset, so neither can bring back a state that is being reset.
+	resetMu sync.RWMutex
+}
+
+func newStateStore(repo persistence.RateLimitStateRepository, counterRepo persistence.RateLimitCounterRepository) *stateStore {
+	store := &stateStore{repo: repo, counterRepo: counterRepo}
+	for i := range store.shards {
+		store.shards[i] = &stateShard{
+			states:   make(map[stateKey]*cachedState),
+			loads:    make(map[stateKey]*stateLoad),
+			counters: make(map[counterKey]*counterValue),
+		}
+	}
+	return store
+}
+
+func (s *stateStore) shard(k stateKey) *stateShard {
+	h := fnv.New32a()
+	h.Write([]byte(k.label))
+	h.Write([]byte{0})
+	h.Write([]byte(k.key))
+	return s.shards[h.Sum32()%stateShardCount]
+}
+
+// update calls fn with the state of the key while holding the lock of its shard, the state is marked dirty
+// when fn reports a change. A state that is not in memory yet is read from the database without holding the
+// lock, so other keys of the shard are not blocked by it.
+func (s *stateStore) update(label, key string, initialTokens float64, fn func(state *persistence.RateLimitState, log *entryLog) bool) error {
+	k := stateKey{label: label, key: key}
+	shard := s.shard(k)
+
+	shard.mu.Lock()
+	cached, ok := shard.states[k]
+	for !ok {
+		load, loading := shard.loads[k]
+		if !loading {
+			load = &stateLoad{done: make(chan struct{})}
+			shard.loads[k] = load
+		}
+		shard.mu.Unlock()
+
+		if loading {
+			<-load.done
+		} else {
+			s.load(shard, k, initialTokens, load)
+		}
+		if load.err != nil {
+			return load.err
+		}
+
+		shard.mu.Lock()
+		// The state can be removed again before the lock is taken, e.g. by a reset.
+		cached, ok = shard.states[k]
+	}
+	defer shard.mu.Unlock()
+
+	if fn(cached.state, &cached.log) {
+		cached.dirty = true
+	}
+	return nil
+}
+
+// load reads the state of the key from the database into the shard and wakes the checks waiting for it.
+func (s *stateStore) load(shard *stateShard, k stateKey, initialTokens float64, load *stateLoad) {
+	s.resetMu.RLock()
+	defer s.resetMu.RUnlock()
+
+	state, err := s.repo.GetOrCreate(k.label, k.key, initialTokens)
+
+	shard.mu.Lock()
+	defer shard.mu.Unlock()
+	delete(shard.loads, k)
+	if err != nil {
+		load.err = fmt.Errorf("failed to get or create state: %w", err)
+	} else {
+		shard.states[k] = &cachedState{state: state}
+	}
+	close(load.done)
+}
+
+// record counts a check of the key in the minute of now.
+func (s *stateStore) record(label, key string, allowed bool, now time.Time) {
+	shard := s.shard(stateKey{label: label, key: key})
+	k := counterKey{label: label, bucket: now.Truncate(time.Minute).Unix()}
+
+	shard.mu.Lock()
+	defer shard.mu.Unlock()
+
+	counter, ok := shard.counters[k]
+	if !ok {
+		counter = &counterValue{}
+		shard.counters[k] = counter
+	}
+	if allowed {
+		counter.allowed++
+	} else {
+		counter.denied++
+	}
+}
+
+// reset drops the state of the key, or of every key of the label when key is empty, from memory and from the
+// database.
+func (s *stateStore) reset(label, key string) error {
+	s.resetMu.Lock()
+	defer s.resetMu.Unlock()
+
+	s.remove(label, key)
+	if err := s.repo.Delete(label, key); err != nil {
+		return fmt.Errorf("failed to delete rate limit states: %w", err)
+	}
+	return nil
+}
+
+// remove drops the state of the key, or of every key of the label when key is empty, from memory.
+func (s *stateStore) remove(label, key string) {
+	if key != "" {
+		k := stateKey{label: label, key: key}
+		shard := s.shard(k)
+		shard.mu.Lock()
+		delete(shard.states, k)
+		shard.mu.Unlock()
+		return
+	}
+
+	for _, shard := range s.shards {
+		shard.mu.Lock()
+		for k := range shard.states {
+			if k.label == label {
+				delete(shard.states, k)
+			}
+		}
+		shard.mu.Unlock()
+	}
+}
+
+// flush writes the dirty states and the counters to the database in batches. States and counts that fail to
+// be written are retried by the next flush.
+func (s *stateStore) flush() error {
+	s.resetMu.RLock()
+	defer s.resetMu.RUnlock()
+
+	var states []*persistence.RateLimitState
+	counters := make(map[counterKey]*counterValue)
+	for _, shard := range s.shards {
+		shard.mu.Lock()
+		for _, cached := range shard.states {
+			if cached.dirty {
+				state := *cached.state
+				if cached.log.decoded {
+					state.Entries = cached.log.encode()
+				}
+				states = append(states, &state)
+				cached.dirty = false
+			}
+		}
+		for k, counter := range shard.counters {
+			total, ok := counters[k]
+			if !ok {
+				total = &counterValue{}
+				counters[k] = total
+			}
+			total.allowed += counter.allowed
+			total.denied += counter.denied
+		}
+		clear(shard.counters)
+		shard.mu.Unlock()
+	}
+
+	return errors.Join(s.flushStates(states), s.flushCounters(counters))
+}
+
+func (s *stateStore) flushStates(states []*persistence.RateLimitState) error {
+	if len(states) == 0 {
+		return nil
+	}
+
+	if err := s.repo.SaveAll(states); err != nil {
+		for _, state := range states {
+			k := stateKey{label: state.RateLimitLabel, key: state.Key}
+			shard := s.shard(k)
+			shard.mu.Lock()
+			if cached, ok := shard.states[k]; ok {
+				cached.dirty = true
+			}
+			shard.mu.Unlock()
+		}
+		return fmt.Errorf("failed to save rate limit states: %w", err)
+	}
+	return nil
+}
+
+func (s *stateStore) flushCounters(counters map[counterKey]*counterValue) error {
+	if len(counters) == 0 {
+		return nil
+	}
+
+	rows := make([]*persistence.RateLimitCounter, 0, len(counters))
+	for k, counter := range counters {
+		rows = append(rows, &persistence.RateLimitCounter{
+			RateLimitLabel: k.label,
+			Bucket:         time.Unix(k.bucket, 0).UTC(),
+			Allowed:        counter.allowed,
+			Denied:         counter.denied,
+		})
+	}
+	if err := s.counterRepo.Increment(rows); err != nil {
+		// Put the counts back into the first shard, the next flush merges them again.
+		shard := s.shards[0]
+		shard.mu.Lock()
+		for k, counter := range counters {
+			existing, ok := shard.counters[k]
+			if !ok {
+				existing = &counterValue{}
+				shard.counters[k] = existing
+			}
+			existing.allowed += counter.allowed
+			existing.denied += counter.denied
+		}
+		shard.mu.Unlock()
+		return fmt.Errorf("failed to save rate limit counters: %w", err)
+	}
+	return nil
+}
+
+// evict drops states that were flushed and not used since before the given time from memory.
+func (s *stateStore) evict(before time.Time) {
+	for _, shard := range s.shards {
+		shard.mu.Lock()
+		for k, cached := range shard.states {
+			if !cached.dirty && cached.state.UpdatedAt.Before(before) {
+				delete(shard.states, k)
+			}
+		}
+		shard.mu.Unlock()
+	}
+}
@@ -0,0 +1,29 @@
+package tracing
+
+import (
+	"github.com/warpstreamlabs/bento/public/service"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// BentoTracerName is the tracer flows use to export their spans, e.g. one per processor, with the tracer
+// provider of the node. The coordinator sets it in the config of every flow it assigns.
+const BentoTracerName = "airtruct"
+
+func init() {
+	spec := service.NewConfigSpec().
+		Summary("Exports the spans of a flow with the tracer provider of the Airtruct node.")
+
+	err := service.RegisterOtelTracerProvider(BentoTracerName, spec, func(*service.ParsedConfig) (trace.TracerProvider, error) {
+		return nodeTracerProvider{otel.GetTracerProvider()}, nil
+	})
+	if err != nil {
+		panic(err)
+	}
+}
+
+// nodeTracerProvider hides the Shutdown method of the node's provider, streams shut their tracer down when
+// they stop while the provider is shared by all flows.
+type nodeTracerProvider struct {
+	trace.TracerProvider
+}
@@ -0,0 +1,71 @@
+package tracing
+
+import (
+	"context"
+	"testing"
+
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/trace"
+
+	"github.com/sananguliyev/airtruct/internal/config"
+)
+
+const testTraceparent = "00-4bf92f3577b34da6a3ce929d0e0e4736-00f067aa0ba902b7-01"
+
+// restoreGlobals puts back the tracer provider and propagator Init replaces.
+func restoreGlobals(t *testing.T) {
+	provider, propagator := otel.GetTracerProvider(), otel.GetTextMapPropagator()
+	t.Cleanup(func() {
+		otel.SetTracerProvider(provider)
+		otel.SetTextMapPropagator(propagator)
+	})
+}
+
+func TestInitWithoutEndpoint(t *testing.T) {
+	restoreGlobals(t)
+	provider := otel.GetTracerProvider()
+
+	shutdown, err := Init(t.Context(), &config.TracingConfig{}, "airtruct", "test")
+	if err != nil {
+		t.Fatalf("Init returned error: %v", err)
+	}
+	if err := shutdown(context.Background()); err != nil {
+		t.Errorf("shutdown returned error: %v", err)
+	}
+
+	if otel.GetTracerProvider() != provider {
+		t.Error("expected the tracer provider to be left as is")
+	}
+	_, span := Tracer().Start(t.Context(), "test")
+	defer span.End()
+	if span.IsRecording() {
+		t.Error("expected spans not to be recorded")
+	}
+}
+
+func TestInitWithoutEndpointPassesTraceContextOn(t *testing.T) {
+	restoreGlobals(t)
+
+	if _, err := Init(t.Context(), &config.TracingConfig{}, "airtruct", "test"); err != nil {
+		t.Fatalf("Init returned error: %v", err)
+	}
+
+	ctx := Extract(t.Context(), map[string]string{"traceparent": testTraceparent})
+	if sc := trace.SpanContextFromContext(ctx); !sc.IsRemote() || sc.TraceID().String() != "4bf92f3577b34da6a3ce929d0e0e4736" {
+		t.Fatalf("expected the remote trace context, got %+v", sc)
+	}
+
+	headers := make(map[string][]string)
+	Inject(ctx, headers)
+	if got := headers["Traceparent"]; len(got) != 1 || got[0] != testTraceparent {
+		t.Errorf("expected traceparent %q, got %v", testTraceparent, got)
+	}
+}
+
+func TestInitUnsupportedProtocol(t *testing.T) {
+	restoreGlobals(t)
+
+	if _, err := Init(t.Context(), &config.TracingConfig{Endpoint: "localhost:4317", Protocol: "thrift"}, "airtruct", "test"); err == nil {
+		t.Error("expected an error for an unsupported protocol")
+	}
+}
@@ -0,0 +1,25 @@
+package utils
+
+import (
+	"net/http"
+
+	pb "github.com/sananguliyev/airtruct/internal/protogen"
+)
+
+func HeadersToProto(headers http.Header) map[string]*pb.HeaderValues {
+	result := make(map[string]*pb.HeaderValues, len(headers))
+	for key, values := range headers {
+		result[key] = &pb.HeaderValues{Values: values}
+	}
+	return result
+}
+
+func HeadersFromProto(headers map[string]*pb.HeaderValues) http.Header {
+	result := make(http.Header, len(headers))
+	for key, values := range headers {
+		for _, value := range values.GetValues() {
+			result.Add(key, value)
+		}
+	}
+	return result
+}
@@ -26,13 +26,20 @@ import CachesPage from "./pages/caches/page.tsx";
 import SecretsPage from "./pages/secrets/page.tsx";
 import CacheNewPage from "./pages/caches/new/page.tsx";
 import CacheEditPage from "./pages/caches/[id]/edit/page.tsx";
+import McpServersPage from "./pages/mcp-servers/page.tsx";
+import McpServerNewPage from "./pages/mcp-servers/new/page.tsx";
+import McpServerEditPage from "./pages/mcp-servers/[id]/edit/page.tsx";
 import RateLimitsPage from "./pages/rate-limits/page.tsx";
 import RateLimitNewPage from "./pages/rate-limits/new/page.tsx";
 import RateLimitEditPage from "./pages/rate-limits/[id]/edit/page.tsx";
+import RateLimitUsagePage from "./pages/rate-limits/[id]/usage/page.tsx";
 import FlowEditPage from "./pages/flows/[id]/edit/page.tsx";
 import FlowEventsPage from "./pages/flows/[id]/events/page.tsx";
+import FlowLogsPage from "./pages/flows/[id]/logs/page.tsx";
 import FlowNewPage from "./pages/flows/new/page.tsx";
 import FilesPage from "./pages/files/page.tsx";
+import IngressQueuePage from "./pages/ingress-queue/page.tsx";
+import AlertsPage from "./pages/alerts/page.tsx";
 
 const AppLayout: React.FC = () => {
   return (
@@ -94,7 +101,10 @@ function App() {
             <Route path="flows/new" element={<FlowNewPage />} />
             <Route path="flows/:id/edit" element={<FlowEditPage />} />
             <Route path="flows/:id/events" element={<FlowEventsPage />} />
+            <Route path="flows/:id/logs" element={<FlowLogsPage />} />
             <Route path="workers" element={<WorkersPage />} />
+            <Route path="ingress-queue" element={<IngressQueuePage />} />
+            <Route path="alerts" element={<AlertsPage />} />
             <Route path="secrets" element={<SecretsPage />} />
             <Route path="buffers" element={<BuffersPage />} />
             <Route path="buffers/new" element={<BufferNewPage />} />
@@ -108,6 +118,16 @@ function App() {
               path="rate-limits/:id/edit"
               element={<RateLimitEditPage />}
             />
+            <Route
+              path="rate-limits/:id/usage"
+              element={<RateLimitUsagePage />}
+            />
+            <Route path="mcp-servers" element={<McpServersPage />} />
+            <Route path="mcp-servers/new" element={<McpServerNewPage />} />
+            <Route
+              path="mcp-servers/:id/edit"
+              element={<McpServerEditPage />}
+            />
             <Route path="files" element={<FilesPage />} />
           </Route>
         </Routes>
@@ -20,6 +20,7 @@ import {
   ScanLine,
   Waypoints,
   Monitor,
+  Plug,
 } from "lucide-react";
 import { useTheme } from "next-themes";
 
@@ -85,6 +86,12 @@ export function CommandPalette({ open, onOpenChange }: CommandPaletteProps) {
                 <Layers className="mr-2 h-4 w-4" />
                 Buffers
               </CommandItem>
+              <CommandItem
+                onSelect={() => runCommand(() => navigate("/mcp-servers"))}
+              >
+                <Plug className="mr-2 h-4 w-4" />
+                MCP Servers
+              </CommandItem>
               <CommandItem
                 onSelect={() => runCommand(() => navigate("/scanners"))}
               >
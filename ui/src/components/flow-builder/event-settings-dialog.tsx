@@ -0,0 +1,191 @@
+import { Plus, Trash2 } from "lucide-react";
+import { Button } from "@/components/ui/button";
+import { Input } from "@/components/ui/input";
+import { Label } from "@/components/ui/label";
+import {
+  Select,
+  SelectContent,
+  SelectItem,
+  SelectTrigger,
+  SelectValue,
+} from "@/components/ui/select";
+import {
+  Dialog,
+  DialogContent,
+  DialogHeader,
+  DialogTitle,
+  DialogDescription,
+} from "@/components/ui/dialog";
+import type { EventRedaction, EventSettings } from "@/lib/entities";
+
+type RedactionKind = "path" | "pattern" | "preset";
+
+const PRESETS = [
+  { value: "email", label: "Email addresses" },
+  { value: "card_number", label: "Card numbers" },
+];
+
+function redactionKind(redaction: EventRedaction): RedactionKind {
+  if (redaction.preset) return "preset";
+  if (redaction.pattern !== undefined) return "pattern";
+  return "path";
+}
+
+function toNumber(value: string): number {
+  const parsed = Number(value);
+  return Number.isFinite(parsed) && parsed > 0 ? Math.floor(parsed) : 0;
+}
+
+interface EventSettingsDialogProps {
+  open: boolean;
+  onOpenChange: (open: boolean) => void;
+  value: EventSettings;
+  onChange: (value: EventSettings) => void;
+}
+
+export function EventSettingsDialog({ open, onOpenChange, value, onChange }: EventSettingsDialogProps) {
+  const redactions = value.redactions || [];
+  const samplePercent = value.sample_rate === undefined ? "" : String(Math.round(value.sample_rate * 1000) / 10);
+
+  const setRedaction = (index: number, redaction: EventRedaction) => {
+    onChange({ ...value, redactions: redactions.map((r, i) => (i === index ? redaction : r)) });
+  };
+
+  const setRedactionKind = (index: number, kind: RedactionKind) => {
+    const replacement = redactions[index].replacement;
+    if (kind === "preset") setRedaction(index, { preset: PRESETS[0].value, replacement });
+    else if (kind === "pattern") setRedaction(index, { pattern: "", replacement });
+    else setRedaction(index, { path: "", replacement });
+  };
+
+  return (
+    <Dialog open={open} onOpenChange={onOpenChange}>
+      <DialogContent className="max-w-2xl max-h-[85vh] flex flex-col">
+        <DialogHeader>
+          <DialogTitle>Event Settings</DialogTitle>
+          <DialogDescription>
+            Control which tracing events of this flow are stored and for how long. Close this dialog and use the Save button to persist.
+          </DialogDescription>
+        </DialogHeader>
+        <div className="flex-1 min-h-0 overflow-y-auto space-y-6 p-1">
+          <div className="grid grid-cols-2 gap-4">
+            <div className="space-y-2">
+              <Label htmlFor="event-sample-rate">Sample Rate (%)</Label>
+              <Input
+                id="event-sample-rate"
+                type="number"
+                min={0}
+                max={100}
+                value={samplePercent}
+                placeholder="100"
+                onChange={(e) => {
+                  const percent = e.target.value === "" ? undefined : Math.min(100, Math.max(0, Number(e.target.value)));
+                  onChange({ ...value, sample_rate: percent === undefined ? undefined : percent / 100 });
+                }}
+              />
+              <p className="text-xs text-muted-foreground">Share of traces whose events are stored.</p>
+            </div>
+            <div className="space-y-2">
+              <Label htmlFor="event-max-content-size">Max Content Size (bytes)</Label>
+              <Input
+                id="event-max-content-size"
+                type="number"
+                min={0}
+                value={value.max_content_size || ""}
+                placeholder="Unlimited"
+                onChange={(e) => onChange({ ...value, max_content_size: toNumber(e.target.value) })}
+              />
+              <p className="text-xs text-muted-foreground">Longer content is truncated.</p>
+            </div>
+            <div className="space-y-2">
+              <Label htmlFor="event-retention-days">Retention (days)</Label>
+              <Input
+                id="event-retention-days"
+                type="number"
+                min={0}
+                value={value.retention_days || ""}
+                placeholder="Forever"
+                onChange={(e) => onChange({ ...value, retention_days: toNumber(e.target.value) })}
+              />
+            </div>
+            <div className="space-y-2">
+              <Label htmlFor="event-retention-rows">Retention (events)</Label>
+              <Input
+                id="event-retention-rows"
+                type="number"
+                min={0}
+                value={value.retention_rows || ""}
+                placeholder="Unlimited"
+                onChange={(e) => onChange({ ...value, retention_rows: toNumber(e.target.value) })}
+              />
+            </div>
+          </div>
+
+          <div className="space-y-2">
+            <div className="flex items-center justify-between">
+              <div>
+                <Label>Redactions</Label>
+                <p className="text-xs text-muted-foreground">
+                  Replace values at JSON paths of the content, e.g. <code>customer.email</code> or <code>items.*.card</code>, or matches of a pattern in content and metadata.
+                </p>
+              </div>
+              <Button
+                variant="outline"
+                size="sm"
+                onClick={() => onChange({ ...value, redactions: [...redactions, { path: "" }] })}
+                className="flex items-center gap-1"
+              >
+                <Plus className="h-4 w-4" /> Add
+              </Button>
+            </div>
+            {redactions.map((redaction, index) => {
+              const kind = redactionKind(redaction);
+              return (
+                <div key={index} className="flex items-center gap-2">
+                  <Select value={kind} onValueChange={(val) => setRedactionKind(index, val as RedactionKind)}>
+                    <SelectTrigger className="w-32"><SelectValue /></SelectTrigger>
+                    <SelectContent>
+                      <SelectItem value="path">JSON path</SelectItem>
+                      <SelectItem value="pattern">Regex</SelectItem>
+                      <SelectItem value="preset">Preset</SelectItem>
+                    </SelectContent>
+                  </Select>
+                  {kind === "preset" ? (
+                    <Select value={redaction.preset} onValueChange={(val) => setRedaction(index, { ...redaction, preset: val })}>
+                      <SelectTrigger className="flex-1"><SelectValue /></SelectTrigger>
+                      <SelectContent>
+                        {PRESETS.map((preset) => (
+                          <SelectItem key={preset.value} value={preset.value}>{preset.label}</SelectItem>
+                        ))}
+                      </SelectContent>
+                    </Select>
+                  ) : (
+                    <Input
+                      className="flex-1 font-mono"
+                      value={(kind === "path" ? redaction.path : redaction.pattern) || ""}
+                      placeholder={kind === "path" ? "customer.email" : "\\d{3}-\\d{2}-\\d{4}"}
+                      onChange={(e) => setRedaction(index, { ...redaction, [kind]: e.target.value })}
+                    />
+                  )}
+                  <Input
+                    className="w-36"
+                    value={redaction.replacement || ""}
+                    placeholder="[REDACTED]"
+                    onChange={(e) => setRedaction(index, { ...redaction, replacement: e.target.value })}
+                  />
+                  <Button
+                    variant="ghost"
+                    size="icon"
+                    onClick={() => onChange({ ...value, redactions: redactions.filter((_, i) => i !== index) })}
+                  >
+                    <Trash2 className="h-4 w-4" />
+                  </Button>
+                </div>
+              );
+            })}
+          </div>
+        </div>
+      </DialogContent>
+    </Dialog>
+  );
+}
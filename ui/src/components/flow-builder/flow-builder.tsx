@@ -29,6 +29,7 @@ import {
   Download,
   Workflow,
   Upload,
+  ScrollText,
 } from "lucide-react";
 import {
   NodeConfigPanel,
@@ -53,7 +54,8 @@ import {
 import { Textarea } from "@/components/ui/textarea";
 import { useToast } from "@/components/toast";
 import { fetchBuffers } from "@/lib/api";
-import type { Buffer } from "@/lib/entities";
+import type { Buffer, EventSettings } from "@/lib/entities";
+import { EventSettingsDialog } from "./event-settings-dialog";
 import * as yaml from "js-yaml";
 
 import { InputNode } from "./nodes/input-node";
@@ -141,6 +143,10 @@ interface FlowBuilderProps {
     name: string;
     status: string;
     bufferId?: number;
+    slug?: string;
+    route?: string;
+    routeMethods?: string[];
+    eventSettings?: EventSettings;
     nodes: FlowNodeData[];
     builderState?: string;
   };
@@ -148,6 +154,10 @@ interface FlowBuilderProps {
     name: string;
     status: string;
     bufferId?: number;
+    slug: string;
+    route: string;
+    routeMethods: string[];
+    eventSettings: EventSettings;
     nodes: FlowNodeData[];
     builderState: string;
     isReady: boolean;
@@ -517,6 +527,11 @@ function FlowBuilderContent({
   const [name, setName] = useState(initialData?.name || "");
   const [status, setStatus] = useState(initialData?.status || "active");
   const [bufferId, setBufferId] = useState<number | undefined>(initialData?.bufferId);
+  const [slug, setSlug] = useState(initialData?.slug || "");
+  const [route, setRoute] = useState(initialData?.route || "");
+  const [routeMethods, setRouteMethods] = useState((initialData?.routeMethods || []).join(", "));
+  const [eventSettings, setEventSettings] = useState<EventSettings>(initialData?.eventSettings || {});
+  const [eventSettingsOpen, setEventSettingsOpen] = useState(false);
   const [availableBuffers, setAvailableBuffers] = useState<Buffer[]>([]);
   const [selectedNodeId, setSelectedNodeId] = useState<string | null>(null);
   const [editingNodeId, setEditingNodeId] = useState<string | null>(null);
@@ -3186,12 +3201,14 @@ function FlowBuilderContent({
       }
     }
 
-    onSave({ name, status, bufferId, nodes: orderedNodes, builderState, isReady });
-  }, [name, status, bufferId, nodes, edges, onSave, addToast, findDisconnectedNodes, validateRequiredFields, setNodes, serializeBranchGroup, serializeCatchGroup, serializeBrokerGroup, serializeBrokerInputGroup, serializeSwitchGroup, serializeProcessorSwitchGroup]);
+    const methods = routeMethods.split(",").map((m) => m.trim().toUpperCase()).filter(Boolean);
+    onSave({ name, status, bufferId, slug: slug.trim(), route: route.trim(), routeMethods: methods, eventSettings, nodes: orderedNodes, builderState, isReady });
+  }, [name, status, bufferId, slug, route, routeMethods, eventSettings, nodes, edges, onSave, addToast, findDisconnectedNodes, validateRequiredFields, setNodes, serializeBranchGroup, serializeCatchGroup, serializeBrokerGroup, serializeBrokerInputGroup, serializeSwitchGroup, serializeProcessorSwitchGroup]);
 
   const hasInput = nodes.some((n) => (n.data as StreamFlowNodeData).type === "input");
   const hasOutput = nodes.some((n) => (n.data as StreamFlowNodeData).type === "output");
   const hasProcessors = nodes.some((n) => (n.data as StreamFlowNodeData).type === "processor" && !n.parentId);
+  const hasHttpServerInput = nodes.some((n) => (n.data as StreamFlowNodeData).type === "input" && (n.data as StreamFlowNodeData).componentId === "http_server");
 
   return (
     <div className="flex flex-col h-[calc(100vh-10rem)] w-full">
@@ -3227,6 +3244,26 @@ function FlowBuilderContent({
             </SelectContent>
           </Select>
         </div>
+        {hasHttpServerInput && (
+          <>
+            <div className="w-40">
+              <Label htmlFor="stream-slug">Slug</Label>
+              <Input id="stream-slug" value={slug} onChange={(e) => setSlug(e.target.value)} placeholder="shopify-orders" />
+            </div>
+            <div className="w-48">
+              <Label htmlFor="stream-route">Route</Label>
+              <Input id="stream-route" value={route} onChange={(e) => setRoute(e.target.value)} placeholder="/hooks/shopify/orders" />
+            </div>
+            <div className="w-36">
+              <Label htmlFor="stream-route-methods">Route Methods</Label>
+              <Input id="stream-route-methods" value={routeMethods} onChange={(e) => setRouteMethods(e.target.value)} placeholder="POST, PUT" />
+            </div>
+          </>
+        )}
+        <Button variant="outline" onClick={() => setEventSettingsOpen(true)} className="flex items-center gap-1">
+          <ScrollText className="h-4 w-4" />
+          Events
+        </Button>
         {onValidate && (
           <Button variant="outline" onClick={handleValidate} disabled={isValidating || !hasInput || !hasOutput} className="flex items-center gap-1">
             <ShieldCheck className="h-4 w-4" />
@@ -3305,6 +3342,13 @@ function FlowBuilderContent({
       </div>
 
       {/* Config modal */}
+      <EventSettingsDialog
+        open={eventSettingsOpen}
+        onOpenChange={setEventSettingsOpen}
+        value={eventSettings}
+        onChange={setEventSettings}
+      />
+
       <Dialog open={!!editingNodeId} onOpenChange={(open: boolean) => { if (!open) setEditingNodeId(null); }}>
         <DialogContent className="max-w-2xl max-h-[85vh] flex flex-col">
           <DialogHeader>
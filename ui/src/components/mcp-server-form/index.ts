@@ -0,0 +1 @@
+export { McpServerForm } from "./mcp-server-form";
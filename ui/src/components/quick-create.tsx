@@ -1,6 +1,14 @@
 import React from "react";
 import { useNavigate } from "react-router-dom";
-import { Plus, Waypoints, KeyRound, MemoryStick, Gauge, FileText } from "lucide-react";
+import {
+  Plus,
+  Waypoints,
+  KeyRound,
+  MemoryStick,
+  Gauge,
+  FileText,
+  Plug,
+} from "lucide-react";
 import { Button } from "@/components/ui/button";
 import {
   DropdownMenu,
@@ -28,6 +36,10 @@ export function QuickCreate({ isCollapsed }: { isCollapsed: boolean }) {
     navigate("/secrets?create=true");
   };
 
+  const handleCreateMcpServer = () => {
+    navigate("/mcp-servers/new");
+  };
+
   const handleCreateFile = () => {
     navigate("/files/new");
   };
@@ -64,6 +76,10 @@ export function QuickCreate({ isCollapsed }: { isCollapsed: boolean }) {
           <Gauge className="mr-2 h-4 w-4" />
           New Rate Limit
         </DropdownMenuItem>
+        <DropdownMenuItem onSelect={handleCreateMcpServer}>
+          <Plug className="mr-2 h-4 w-4" />
+          New MCP Server
+        </DropdownMenuItem>
         <DropdownMenuItem onSelect={handleCreateFile}>
           <FileText className="mr-2 h-4 w-4" />
           New File
@@ -8,6 +8,9 @@ import {
   KeyRound,
   Gauge,
   FileText,
+  Plug,
+  Inbox,
+  Bell,
 } from "lucide-react";
 
 import { cn } from "@/lib/utils";
@@ -40,6 +43,16 @@ const menuItems = [
         href: "/workers",
         icon: Cpu,
       },
+      {
+        name: "Ingress Queue",
+        href: "/ingress-queue",
+        icon: Inbox,
+      },
+      {
+        name: "Alerts",
+        href: "/alerts",
+        icon: Bell,
+      },
     ],
   },
   {
@@ -65,6 +78,11 @@ const menuItems = [
         href: "/buffers",
         icon: Layers,
       },
+      {
+        name: "MCP Servers",
+        href: "/mcp-servers",
+        icon: Plug,
+      },
       {
         name: "Files",
         href: "/files",
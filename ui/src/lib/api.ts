@@ -1,12 +1,27 @@
 import {
   Flow,
   FlowEvent,
+  FlowEventFilters,
+  FlowLog,
+  FlowLogFilters,
+  Trace,
   Worker,
   Secret,
   Cache,
+  McpServer,
   RateLimit,
   FileEntry,
   Analytics,
+  QueuedRequest,
+  Alert,
+  AlertFilters,
+  AlertRule,
+  AlertChannel,
+  AlertSilence,
+  RateLimitKey,
+  RateLimitStats,
+  FlowMetrics,
+  EventSettings,
 } from "./entities";
 import * as yaml from "js-yaml";
 
@@ -33,6 +48,20 @@ async function handleResponse(response: Response): Promise<Response> {
   return response;
 }
 
+// eventSettingsFromApi converts the int64 fields of event settings, sent as strings, to numbers and drops the
+// empty fields of redactions, only the one that is set tells the kind of a redaction.
+function eventSettingsFromApi(settings: any): EventSettings {
+  return {
+    sample_rate: settings?.sample_rate ?? undefined,
+    max_content_size: Number(settings?.max_content_size || 0),
+    redactions: (settings?.redactions || []).map((r: any) =>
+      Object.fromEntries(Object.entries(r).filter(([, v]) => v !== "")),
+    ),
+    retention_days: Number(settings?.retention_days || 0),
+    retention_rows: Number(settings?.retention_rows || 0),
+  };
+}
+
 export async function fetchWorkers(): Promise<Worker[]> {
   try {
     const response = await handleResponse(
@@ -98,6 +127,10 @@ export async function fetchFlows(): Promise<Flow[]> {
       is_mcp_tool: flow.is_mcp_tool || false,
       is_ready: flow.is_ready || false,
       builder_state: flow.builder_state || undefined,
+      slug: flow.slug || "",
+      route: flow.route || "",
+      route_methods: flow.route_methods || [],
+      event_settings: eventSettingsFromApi(flow.event_settings),
     }));
   } catch (error) {
     console.error("Error fetching flows:", error);
@@ -140,6 +173,10 @@ export async function fetchStream(id: string): Promise<Flow> {
       is_mcp_tool: data.data.is_mcp_tool || false,
       is_ready: data.data.is_ready || false,
       builder_state: data.data.builder_state || undefined,
+      slug: data.data.slug || "",
+      route: data.data.route || "",
+      route_methods: data.data.route_methods || [],
+      event_settings: eventSettingsFromApi(data.data.event_settings),
     };
   } catch (error) {
     console.error("Error fetching flow:", error);
@@ -208,6 +245,10 @@ export async function createFlow(flow: {
   buffer_id?: number;
   is_ready?: boolean;
   builder_state?: string;
+  slug?: string;
+  route?: string;
+  route_methods?: string[];
+  event_settings?: EventSettings;
   processors: Array<{
     label: string;
     component: string;
@@ -231,6 +272,10 @@ export async function createFlow(flow: {
           buffer_id: flow.buffer_id || undefined,
           is_ready: flow.is_ready ?? true,
           builder_state: flow.builder_state || "",
+          slug: flow.slug || "",
+          route: flow.route || "",
+          route_methods: flow.route_methods || [],
+          event_settings: flow.event_settings,
           processors: flow.processors.map((processor) => ({
             label: processor.label,
             component: processor.component,
@@ -268,6 +313,10 @@ export async function createFlow(flow: {
       is_mcp_tool: data.data.is_mcp_tool || false,
       is_ready: data.data.is_ready || false,
       builder_state: data.data.builder_state || undefined,
+      slug: data.data.slug || "",
+      route: data.data.route || "",
+      route_methods: data.data.route_methods || [],
+      event_settings: eventSettingsFromApi(data.data.event_settings),
     };
   } catch (error) {
     console.error("Error creating flow:", error);
@@ -289,6 +338,10 @@ export async function updateFlow(
     buffer_id?: number;
     is_ready?: boolean;
     builder_state?: string;
+    slug?: string;
+    route?: string;
+    route_methods?: string[];
+    event_settings?: EventSettings;
     processors: Array<{
       label: string;
       component: string;
@@ -313,6 +366,10 @@ export async function updateFlow(
           buffer_id: flow.buffer_id || undefined,
           is_ready: flow.is_ready ?? true,
           builder_state: flow.builder_state || "",
+          slug: flow.slug || "",
+          route: flow.route || "",
+          route_methods: flow.route_methods || [],
+          event_settings: flow.event_settings,
           processors: flow.processors.map((processor) => ({
             label: processor.label,
             component: processor.component,
@@ -350,6 +407,10 @@ export async function updateFlow(
       is_mcp_tool: data.data.is_mcp_tool || false,
       is_ready: data.data.is_ready || false,
       builder_state: data.data.builder_state || undefined,
+      slug: data.data.slug || "",
+      route: data.data.route || "",
+      route_methods: data.data.route_methods || [],
+      event_settings: eventSettingsFromApi(data.data.event_settings),
     };
   } catch (error) {
     console.error("Error updating flow:", error);
@@ -397,6 +458,10 @@ export async function updateFlowStatus(
           buffer_id: flow.buffer_id || undefined,
           is_ready: flow.is_ready,
           builder_state: flow.builder_state,
+          slug: flow.slug || "",
+          route: flow.route || "",
+          route_methods: flow.route_methods || [],
+          event_settings: flow.event_settings,
           processors: flow.processors.map((p) => ({
             label: p.label,
             component: p.component,
@@ -437,6 +502,10 @@ export async function updateFlowStatus(
       is_mcp_tool: data.data.input_component === "mcp_tool",
       is_ready: data.data.is_ready || false,
       builder_state: data.data.builder_state || undefined,
+      slug: data.data.slug || "",
+      route: data.data.route || "",
+      route_methods: data.data.route_methods || [],
+      event_settings: eventSettingsFromApi(data.data.event_settings),
     };
   } catch (error) {
     console.error("Error updating flow status:", error);
@@ -684,6 +753,159 @@ export async function deleteCache(id: string): Promise<void> {
   }
 }
 
+export async function fetchMcpServers(): Promise<McpServer[]> {
+  try {
+    const response = await handleResponse(
+      await fetch(`${API_BASE_URL}/mcp-servers`, {
+        headers: getAuthHeaders(),
+      }),
+    );
+    if (!response.ok) {
+      throw new Error(`HTTP error! status: ${response.status}`);
+    }
+    const data = await response.json();
+
+    return data?.data.map((server: any) => ({
+      id: server.id,
+      label: server.label,
+      component: server.component,
+      config: server.config || "",
+      createdAt: new Date(server.created_at).toLocaleString(),
+    }));
+  } catch (error) {
+    console.error("Error fetching MCP servers:", error);
+    throw error;
+  }
+}
+
+export async function fetchMcpServer(id: string): Promise<McpServer> {
+  try {
+    const response = await handleResponse(
+      await fetch(`${API_BASE_URL}/mcp-servers/${id}`, {
+        headers: getAuthHeaders(),
+      }),
+    );
+    if (!response.ok) {
+      throw new Error(`HTTP error! status: ${response.status}`);
+    }
+    const data = await response.json();
+
+    return {
+      id: data.data.id,
+      label: data.data.label,
+      component: data.data.component,
+      config: data.data.config || "",
+      createdAt: new Date(data.data.created_at).toLocaleString(),
+    };
+  } catch (error) {
+    console.error("Error fetching MCP server:", error);
+    throw error;
+  }
+}
+
+export async function createMcpServer(serverData: {
+  label: string;
+  component: string;
+  config: any;
+}): Promise<McpServer> {
+  try {
+    const configYaml = yaml.dump(serverData.config);
+
+    const response = await handleResponse(
+      await fetch(`${API_BASE_URL}/mcp-servers`, {
+        method: "POST",
+        headers: getAuthHeaders(),
+        body: JSON.stringify({
+          label: serverData.label,
+          component: serverData.component,
+          config: configYaml,
+        }),
+      }),
+    );
+
+    if (!response.ok) {
+      const data = await response.json();
+      throw new Error(data.message || `HTTP error! status: ${response.status}`);
+    }
+
+    const data = await response.json();
+
+    return {
+      id: data.data.id,
+      label: data.data.label,
+      component: data.data.component,
+      config: data.data.config || "",
+      createdAt: new Date(data.data.created_at).toLocaleString(),
+    };
+  } catch (error) {
+    console.error("Error creating MCP server:", error);
+    throw error;
+  }
+}
+
+export async function updateMcpServer(
+  id: string,
+  serverData: {
+    label: string;
+    component: string;
+    config: any;
+  },
+): Promise<McpServer> {
+  try {
+    const configYaml = yaml.dump(serverData.config);
+
+    const response = await handleResponse(
+      await fetch(`${API_BASE_URL}/mcp-servers/${id}`, {
+        method: "PUT",
+        headers: getAuthHeaders(),
+        body: JSON.stringify({
+          id: parseInt(id),
+          label: serverData.label,
+          component: serverData.component,
+          config: configYaml,
+        }),
+      }),
+    );
+
+    if (!response.ok) {
+      const data = await response.json();
+      throw new Error(data.message || `HTTP error! status: ${response.status}`);
+    }
+
+    const data = await response.json();
+
+    return {
+      id: data.data.id,
+      label: data.data.label,
+      component: data.data.component,
+      config: data.data.config || "",
+      createdAt: new Date(data.data.created_at).toLocaleString(),
+    };
+  } catch (error) {
+    console.error("Error updating MCP server:", error);
+    throw error;
+  }
+}
+
+export async function deleteMcpServer(id: string): Promise<void> {
+  try {
+    const response = await handleResponse(
+      await fetch(`${API_BASE_URL}/mcp-servers/${id}`, {
+        method: "DELETE",
+        headers: getAuthHeaders(),
+      }),
+    );
+
+    if (!response.ok) {
+      const data = await response.json();
+      throw new Error(data.message || `HTTP error! status: ${response.status}`);
+    }
+  } catch (error) {
+    console.error("Error deleting MCP server:", error);
+    throw error;
+  }
+}
+
 export async function fetchRateLimits(): Promise<RateLimit[]> {
   try {
     const response = await handleResponse(
@@ -992,6 +1214,62 @@ export async function deleteBuffer(id: string): Promise<void> {
   }
 }
 
+export async function fetchFlowMetrics(
+  flowId: string,
+  range: string,
+  step?: string,
+): Promise<FlowMetrics> {
+  try {
+    const query = new URLSearchParams({ range });
+    if (step) {
+      query.set("step", step);
+    }
+
+    const response = await handleResponse(
+      await fetch(`${API_BASE_URL}/flows/${flowId}/metrics?${query.toString()}`, {
+        headers: getAuthHeaders(),
+      }),
+    );
+    if (!response.ok) {
+      throw new Error(`HTTP error! status: ${response.status}`);
+    }
+
+    const result = await response.json();
+
+    return {
+      components: (result.components || []).map((component: any) => ({
+        section: component.section,
+        componentLabel: component.component_label,
+        data: (component.data || []).map((point: any) => ({
+          timestamp: point.timestamp,
+          events: Number(point.events) || 0,
+          errors: Number(point.errors) || 0,
+        })),
+        totalEvents: Number(component.total_events) || 0,
+        totalErrors: Number(component.total_errors) || 0,
+      })),
+      step: result.step,
+    };
+  } catch (error) {
+    console.error("Error fetching flow metrics:", error);
+    throw error;
+  }
+}
+
+function flowEventFromApi(event: any): FlowEvent {
+  return {
+    id: Number(event.id),
+    worker_flow_id: Number(event.workerFlowId),
+    trace_id: event.traceId,
+    section: event.section,
+    component_label: event.componentLabel,
+    type: event.type,
+    content: event.content,
+    meta: event.meta || {},
+    created_at: event.createdAt,
+  };
+}
+
 export async function fetchFlowEvents(
   flowId: string,
   params: {
@@ -999,7 +1277,7 @@ export async function fetchFlowEvents(
     offset: number;
     startTime: string;
     endTime: string;
-  },
+  } & FlowEventFilters,
 ): Promise<{ data: FlowEvent[]; total: number }> {
   try {
     const query = new URLSearchParams({
@@ -1008,6 +1286,13 @@ export async function fetchFlowEvents(
       start_time: params.startTime,
       end_time: params.endTime,
     });
+    if (params.section) query.set("section", params.section);
+    if (params.componentLabel) query.set("component_label", params.componentLabel);
+    if (params.type) query.set("type", params.type);
+    if (params.traceId) query.set("trace_id", params.traceId);
+    if (params.workerFlowId) query.set("worker_flow_id", params.workerFlowId.toString());
+    if (params.flowVersionId) query.set("flow_version_id", params.flowVersionId.toString());
+    if (params.search) query.set("search", params.search);
 
     const response = await handleResponse(
       await fetch(
@@ -1025,18 +1310,8 @@ export async function fetchFlowEvents(
     const result = await response.json();
 
     return {
-      data: (result.data || []).map((event: any) => ({
-        id: event.id,
-        worker_flow_id: event.workerFlowId,
-        trace_id: event.traceId,
-        section: event.section,
-        component_label: event.componentLabel,
-        type: event.type,
-        content: event.content,
-        meta: event.meta || {},
-        created_at: event.createdAt,
-      })),
-      total: result.total || 0,
+      data: (result.data || []).map(flowEventFromApi),
+      total: Number(result.total) || 0,
     };
   } catch (error) {
     console.error("Error fetching flow events:", error);
@@ -1044,6 +1319,184 @@ export async function fetchFlowEvents(
   }
 }
 
+// tailFlowEvents streams the events of the flow matching the filters as they are stored, until the signal aborts.
+// dropped is the number of events missed before the event because the stream fell behind.
+export async function tailFlowEvents(
+  flowId: string,
+  filters: FlowEventFilters,
+  onEvent: (event: FlowEvent, dropped: number) => void,
+  signal: AbortSignal,
+): Promise<void> {
+  const query = new URLSearchParams();
+  if (filters.section) query.set("section", filters.section);
+  if (filters.componentLabel) query.set("component_label", filters.componentLabel);
+  if (filters.type) query.set("type", filters.type);
+  if (filters.traceId) query.set("trace_id", filters.traceId);
+  if (filters.workerFlowId) query.set("worker_flow_id", filters.workerFlowId.toString());
+  if (filters.flowVersionId) query.set("flow_version_id", filters.flowVersionId.toString());
+  if (filters.search) query.set("search", filters.search);
+
+  const response = await handleResponse(
+    await fetch(`${API_BASE_URL}/flows/${flowId}/events/tail?${query.toString()}`, {
+      headers: { ...getAuthHeaders(), Accept: "text/event-stream" },
+      signal,
+    }),
+  );
+
+  await readServerSentEvents(response, (result) =>
+    onEvent(flowEventFromApi(result.event), Number(result.dropped) || 0),
+  );
+}
+
+// readServerSentEvents hands the JSON data of each server-sent event of the response to onData until the stream ends.
+async function readServerSentEvents(
+  response: Response,
+  onData: (data: any) => void,
+): Promise<void> {
+  if (!response.ok || !response.body) {
+    throw new Error(`HTTP error! status: ${response.status}`);
+  }
+
+  const reader = response.body.getReader();
+  const decoder = new TextDecoder();
+  let buffer = "";
+  while (true) {
+    const { done, value } = await reader.read();
+    if (done) return;
+
+    buffer += decoder.decode(value, { stream: true });
+    let boundary = buffer.indexOf("\n\n");
+    while (boundary !== -1) {
+      const message = buffer.slice(0, boundary);
+      buffer = buffer.slice(boundary + 2);
+      boundary = buffer.indexOf("\n\n");
+
+      const data = message
+        .split("\n")
+        .filter((line) => line.startsWith("data: "))
+        .map((line) => line.slice(6))
+        .join("\n");
+      if (!data) continue;
+
+      onData(JSON.parse(data));
+    }
+  }
+}
+
+export async function fetchTrace(traceId: string): Promise<Trace> {
+  try {
+    const response = await handleResponse(
+      await fetch(`${API_BASE_URL}/traces/${encodeURIComponent(traceId)}`, {
+        headers: getAuthHeaders(),
+      }),
+    );
+
+    if (!response.ok) {
+      throw new Error(`HTTP error! status: ${response.status}`);
+    }
+
+    const result = await response.json();
+
+    return {
+      trace_id: result.traceId,
+      flow_id: Number(result.flowId),
+      started_at: result.startedAt,
+      ended_at: result.endedAt,
+      timeline: (result.timeline || []).map((component: any) => ({
+        section: component.section,
+        component_label: component.componentLabel,
+        started_at: component.startedAt,
+        ended_at: component.endedAt,
+        errors: Number(component.errors) || 0,
+        events: (component.events || []).map(flowEventFromApi),
+      })),
+    };
+  } catch (error) {
+    console.error("Error fetching trace:", error);
+    throw error;
+  }
+}
+
+function flowLogFromApi(line: any): FlowLog {
+  return {
+    id: Number(line.id),
+    worker_flow_id: Number(line.workerFlowId),
+    worker_id: line.workerId,
+    level: line.level,
+    message: line.message,
+    fields: line.fields || {},
+    created_at: line.createdAt,
+  };
+}
+
+function flowLogQuery(filters: FlowLogFilters): URLSearchParams {
+  const query = new URLSearchParams();
+  if (filters.level) query.set("level", filters.level);
+  if (filters.workerId) query.set("worker_id", filters.workerId);
+  if (filters.workerFlowId) query.set("worker_flow_id", filters.workerFlowId.toString());
+  if (filters.flowVersionId) query.set("flow_version_id", filters.flowVersionId.toString());
+  if (filters.search) query.set("search", filters.search);
+  return query;
+}
+
+export async function fetchFlowLogs(
+  flowId: string,
+  params: {
+    limit: number;
+    offset: number;
+    startTime: string;
+    endTime: string;
+  } & FlowLogFilters,
+): Promise<{ data: FlowLog[]; total: number }> {
+  try {
+    const query = flowLogQuery(params);
+    query.set("limit", params.limit.toString());
+    query.set("offset", params.offset.toString());
+    query.set("start_time", params.startTime);
+    query.set("end_time", params.endTime);
+
+    const response = await handleResponse(
+      await fetch(`${API_BASE_URL}/flows/${flowId}/logs?${query.toString()}`, {
+        headers: getAuthHeaders(),
+      }),
+    );
+
+    if (!response.ok) {
+      throw new Error(`HTTP error! status: ${response.status}`);
+    }
+
+    const result = await response.json();
+
+    return {
+      data: (result.data || []).map(flowLogFromApi),
+      total: Number(result.total) || 0,
+    };
+  } catch (error) {
+    console.error("Error fetching flow logs:", error);
+    throw error;
+  }
+}
+
+// tailFlowLogs streams the log lines of the flow matching the filters as they are stored, until the signal aborts.
+// dropped is the number of lines missed before the line because the stream fell behind.
+export async function tailFlowLogs(
+  flowId: string,
+  filters: FlowLogFilters,
+  onLog: (line: FlowLog, dropped: number) => void,
+  signal: AbortSignal,
+): Promise<void> {
+  const response = await handleResponse(
+    await fetch(`${API_BASE_URL}/flows/${flowId}/logs/tail?${flowLogQuery(filters).toString()}`, {
+      headers: { ...getAuthHeaders(), Accept: "text/event-stream" },
+      signal,
+    }),
+  );
+
+  await readServerSentEvents(response, (result) =>
+    onLog(flowLogFromApi(result.log), Number(result.dropped) || 0),
+  );
+}
+
 // File methods
 
 export async function fetchFiles(): Promise<FileEntry[]> {
@@ -1201,10 +1654,196 @@ export async function deleteFile(id: string): Promise<void> {
   }
 }
 
-export async function fetchAnalytics(): Promise<Analytics> {
+// Ingress queue methods
+
+export async function fetchQueuedRequests(params: {
+  status: string;
+  limit: number;
+  offset: number;
+}): Promise<{ data: QueuedRequest[]; total: number }> {
   try {
+    const query = new URLSearchParams({
+      status: params.status,
+      limit: params.limit.toString(),
+      offset: params.offset.toString(),
+    });
+
     const response = await handleResponse(
-      await fetch(`${API_BASE_URL}/analytics`, {
+      await fetch(`${API_BASE_URL}/ingress/queue?${query.toString()}`, {
+        headers: getAuthHeaders(),
+      }),
+    );
+    if (!response.ok) {
+      throw new Error(`HTTP error! status: ${response.status}`);
+    }
+
+    const result = await response.json();
+
+    return {
+      data: (result.data || []).map((request: any) => ({
+        id: request.id.toString(),
+        flowId: request.flowId.toString(),
+        flowVersionId: request.flowVersionId.toString(),
+        method: request.method,
+        path: request.path,
+        query: request.query,
+        contentType: request.contentType,
+        remoteAddr: request.remoteAddr,
+        payload: request.payload ? atob(request.payload) : "",
+        status: request.status,
+        attempts: request.attempts || 0,
+        lastError: request.lastError,
+        createdAt: request.createdAt,
+        updatedAt: request.updatedAt,
+      })),
+      total: Number(result.total) || 0,
+    };
+  } catch (error) {
+    console.error("Error fetching queued requests:", error);
+    throw error;
+  }
+}
+
+export async function retryQueuedRequest(id: string): Promise<void> {
+  try {
+    const response = await handleResponse(
+      await fetch(`${API_BASE_URL}/ingress/queue/${id}/retry`, {
+        method: "POST",
+        headers: getAuthHeaders(),
+      }),
+    );
+
+    if (!response.ok) {
+      const data = await response.json();
+      throw new Error(data.message || `HTTP error! status: ${response.status}`);
+    }
+  } catch (error) {
+    console.error("Error retrying queued request:", error);
+    throw error;
+  }
+}
+
+export async function deleteQueuedRequest(id: string): Promise<void> {
+  try {
+    const response = await handleResponse(
+      await fetch(`${API_BASE_URL}/ingress/queue/${id}`, {
+        method: "DELETE",
+        headers: getAuthHeaders(),
+      }),
+    );
+
+    if (!response.ok) {
+      const data = await response.json();
+      throw new Error(data.message || `HTTP error! status: ${response.status}`);
+    }
+  } catch (error) {
+    console.error("Error deleting queued request:", error);
+    throw error;
+  }
+}
+
+export async function fetchRateLimitKeys(
+  id: string,
+  params: { key: string; limit: number; offset: number },
+): Promise<{ data: RateLimitKey[]; total: number }> {
+  try {
+    const query = new URLSearchParams({
+      key: params.key,
+      limit: params.limit.toString(),
+      offset: params.offset.toString(),
+    });
+
+    const response = await handleResponse(
+      await fetch(`${API_BASE_URL}/rate-limits/${id}/keys?${query.toString()}`, {
+        headers: getAuthHeaders(),
+      }),
+    );
+    if (!response.ok) {
+      throw new Error(`HTTP error! status: ${response.status}`);
+    }
+
+    const result = await response.json();
+
+    return {
+      data: (result.data || []).map((key: any) => ({
+        key: key.key,
+        remaining: Number(key.remaining) || 0,
+        limit: Number(key.limit) || 0,
+        resetAt: Number(key.reset_at) || 0,
+        lastRefillAt: key.last_refill_at,
+        updatedAt: key.updated_at,
+      })),
+      total: Number(result.total) || 0,
+    };
+  } catch (error) {
+    console.error("Error fetching rate limit keys:", error);
+    throw error;
+  }
+}
+
+export async function resetRateLimit(id: string, key = ""): Promise<void> {
+  try {
+    const response = await handleResponse(
+      await fetch(`${API_BASE_URL}/rate-limits/${id}/reset`, {
+        method: "POST",
+        headers: getAuthHeaders(),
+        body: JSON.stringify({ key }),
+      }),
+    );
+
+    if (!response.ok) {
+      const data = await response.json();
+      throw new Error(data.message || `HTTP error! status: ${response.status}`);
+    }
+  } catch (error) {
+    console.error("Error resetting rate limit:", error);
+    throw error;
+  }
+}
+
+export async function fetchRateLimitStats(
+  id: string,
+  hours: number,
+): Promise<RateLimitStats> {
+  try {
+    const response = await handleResponse(
+      await fetch(`${API_BASE_URL}/rate-limits/${id}/stats?hours=${hours}`, {
+        headers: getAuthHeaders(),
+      }),
+    );
+    if (!response.ok) {
+      throw new Error(`HTTP error! status: ${response.status}`);
+    }
+
+    const result = await response.json();
+
+    return {
+      data: (result.data || []).map((point: any) => ({
+        timestamp: point.timestamp,
+        allowed: Number(point.allowed) || 0,
+        denied: Number(point.denied) || 0,
+      })),
+      totalAllowed: Number(result.total_allowed) || 0,
+      totalDenied: Number(result.total_denied) || 0,
+    };
+  } catch (error) {
+    console.error("Error fetching rate limit stats:", error);
+    throw error;
+  }
+}
+
+export async function fetchAnalytics(
+  range: string = "30d",
+  flowId?: string,
+): Promise<Analytics> {
+  try {
+    const query = new URLSearchParams({ range });
+    if (flowId) {
+      query.set("flow_id", flowId);
+    }
+
+    const response = await handleResponse(
+      await fetch(`${API_BASE_URL}/analytics?${query.toString()}`, {
         headers: getAuthHeaders(),
       }),
     );
@@ -1247,9 +1886,284 @@ export async function fetchAnalytics(): Promise<Analytics> {
           count: num(c.count),
         }),
       ),
+      tool_calls: (data.tool_calls ?? []).map(
+        (t: { tool: string; calls: unknown; errors: unknown; avg_latency_ms: unknown; max_latency_ms: unknown }) => ({
+          tool: t.tool,
+          calls: num(t.calls),
+          errors: num(t.errors),
+          avg_latency_ms: num(t.avg_latency_ms),
+          max_latency_ms: num(t.max_latency_ms),
+        }),
+      ),
     };
   } catch (error) {
     console.error("Error fetching analytics:", error);
     throw error;
   }
 }
+
+// Alerting methods
+
+// requestJson sends a request to the API and returns its JSON body, throwing the message of the error response.
+async function requestJson(path: string, init: RequestInit = {}): Promise<any> {
+  const response = await handleResponse(
+    await fetch(`${API_BASE_URL}${path}`, {
+      ...init,
+      headers: getAuthHeaders(),
+    }),
+  );
+
+  const data = await response.json().catch(() => ({}));
+  if (!response.ok) {
+    throw new Error(data.message || `HTTP error! status: ${response.status}`);
+  }
+  return data;
+}
+
+function alertFromApi(alert: any): Alert {
+  return {
+    id: alert.id.toString(),
+    ruleId: alert.rule_id.toString(),
+    ruleName: alert.rule_name,
+    type: alert.type,
+    status: alert.status,
+    flowId: alert.flow_id.toString(),
+    workerId: alert.worker_id,
+    message: alert.message,
+    silenced: alert.silenced,
+    startedAt: alert.started_at,
+    resolvedAt: alert.resolved_at || undefined,
+  };
+}
+
+function alertRuleFromApi(rule: any): AlertRule {
+  return {
+    id: rule.id.toString(),
+    name: rule.name,
+    type: rule.type,
+    flowId: rule.flow_id.toString(),
+    threshold: Number(rule.threshold) || 0,
+    windowMinutes: Number(rule.window_minutes) || 0,
+    channelIds: (rule.channel_ids || []).map((id: any) => id.toString()),
+    enabled: rule.enabled,
+    createdAt: rule.created_at,
+    updatedAt: rule.updated_at || undefined,
+  };
+}
+
+function alertRuleToApi(rule: Omit<AlertRule, "id" | "createdAt" | "updatedAt">) {
+  return {
+    name: rule.name,
+    type: rule.type,
+    flow_id: rule.flowId || "0",
+    threshold: rule.threshold,
+    window_minutes: rule.windowMinutes.toString(),
+    channel_ids: rule.channelIds,
+    enabled: rule.enabled,
+  };
+}
+
+function alertChannelFromApi(channel: any): AlertChannel {
+  return {
+    id: channel.id.toString(),
+    name: channel.name,
+    type: channel.type,
+    url: channel.url,
+    recipients: channel.recipients || [],
+    createdAt: channel.created_at,
+    updatedAt: channel.updated_at || undefined,
+  };
+}
+
+function alertSilenceFromApi(silence: any): AlertSilence {
+  return {
+    id: silence.id.toString(),
+    ruleId: silence.rule_id.toString(),
+    flowId: silence.flow_id.toString(),
+    workerId: silence.worker_id,
+    comment: silence.comment,
+    startsAt: silence.starts_at,
+    endsAt: silence.ends_at,
+    createdAt: silence.created_at,
+  };
+}
+
+export async function fetchAlerts(
+  filters: AlertFilters,
+): Promise<{ data: Alert[]; total: number }> {
+  const query = new URLSearchParams({
+    limit: filters.limit.toString(),
+    offset: filters.offset.toString(),
+  });
+  if (filters.status) query.set("status", filters.status);
+  if (filters.ruleId) query.set("rule_id", filters.ruleId);
+  if (filters.flowId) query.set("flow_id", filters.flowId);
+
+  try {
+    const result = await requestJson(`/alerts?${query.toString()}`);
+    return {
+      data: (result.data || []).map(alertFromApi),
+      total: Number(result.total) || 0,
+    };
+  } catch (error) {
+    console.error("Error fetching alerts:", error);
+    throw error;
+  }
+}
+
+export async function fetchAlertRules(): Promise<AlertRule[]> {
+  try {
+    const result = await requestJson("/alerts/rules");
+    return (result.data || []).map(alertRuleFromApi);
+  } catch (error) {
+    console.error("Error fetching alert rules:", error);
+    throw error;
+  }
+}
+
+export async function createAlertRule(
+  rule: Omit<AlertRule, "id" | "createdAt" | "updatedAt">,
+): Promise<AlertRule> {
+  try {
+    const result = await requestJson("/alerts/rules", {
+      method: "POST",
+      body: JSON.stringify(alertRuleToApi(rule)),
+    });
+    return alertRuleFromApi(result.data);
+  } catch (error) {
+    console.error("Error creating alert rule:", error);
+    throw error;
+  }
+}
+
+export async function updateAlertRule(
+  id: string,
+  rule: Omit<AlertRule, "id" | "createdAt" | "updatedAt">,
+): Promise<AlertRule> {
+  try {
+    const result = await requestJson(`/alerts/rules/${id}`, {
+      method: "PUT",
+      body: JSON.stringify(alertRuleToApi(rule)),
+    });
+    return alertRuleFromApi(result.data);
+  } catch (error) {
+    console.error("Error updating alert rule:", error);
+    throw error;
+  }
+}
+
+export async function deleteAlertRule(id: string): Promise<void> {
+  try {
+    await requestJson(`/alerts/rules/${id}`, { method: "DELETE" });
+  } catch (error) {
+    console.error("Error deleting alert rule:", error);
+    throw error;
+  }
+}
+
+export async function fetchAlertChannels(): Promise<AlertChannel[]> {
+  try {
+    const result = await requestJson("/alerts/channels");
+    return (result.data || []).map(alertChannelFromApi);
+  } catch (error) {
+    console.error("Error fetching alert channels:", error);
+    throw error;
+  }
+}
+
+export async function createAlertChannel(
+  channel: Omit<AlertChannel, "id" | "createdAt" | "updatedAt">,
+): Promise<AlertChannel> {
+  try {
+    const result = await requestJson("/alerts/channels", {
+      method: "POST",
+      body: JSON.stringify(channel),
+    });
+    return alertChannelFromApi(result.data);
+  } catch (error) {
+    console.error("Error creating alert channel:", error);
+    throw error;
+  }
+}
+
+export async function updateAlertChannel(
+  id: string,
+  channel: Omit<AlertChannel, "id" | "createdAt" | "updatedAt">,
+): Promise<AlertChannel> {
+  try {
+    const result = await requestJson(`/alerts/channels/${id}`, {
+      method: "PUT",
+      body: JSON.stringify(channel),
+    });
+    return alertChannelFromApi(result.data);
+  } catch (error) {
+    console.error("Error updating alert channel:", error);
+    throw error;
+  }
+}
+
+export async function deleteAlertChannel(id: string): Promise<void> {
+  try {
+    await requestJson(`/alerts/channels/${id}`, { method: "DELETE" });
+  } catch (error) {
+    console.error("Error deleting alert channel:", error);
+    throw error;
+  }
+}
+
+export async function testAlertChannel(id: string): Promise<void> {
+  try {
+    await requestJson(`/alerts/channels/${id}/test`, { method: "POST" });
+  } catch (error) {
+    console.error("Error testing alert channel:", error);
+    throw error;
+  }
+}
+
+export async function fetchAlertSilences(
+  includeExpired: boolean,
+): Promise<AlertSilence[]> {
+  try {
+    const result = await requestJson(
+      `/alerts/silences?include_expired=${includeExpired}`,
+    );
+    return (result.data || []).map(alertSilenceFromApi);
+  } catch (error) {
+    console.error("Error fetching alert silences:", error);
+    throw error;
+  }
+}
+
+export async function createAlertSilence(silence: {
+  ruleId: string;
+  flowId: string;
+  workerId: string;
+  comment: string;
+  endsAt: string;
+}): Promise<AlertSilence> {
+  try {
+    const result = await requestJson("/alerts/silences", {
+      method: "POST",
+      body: JSON.stringify({
+        rule_id: silence.ruleId || "0",
+        flow_id: silence.flowId || "0",
+        worker_id: silence.workerId,
+        comment: silence.comment,
+        ends_at: silence.endsAt,
+      }),
+    });
+    return alertSilenceFromApi(result.data);
+  } catch (error) {
+    console.error("Error creating alert silence:", error);
+    throw error;
+  }
+}
+
+export async function deleteAlertSilence(id: string): Promise<void> {
+  try {
+    await requestJson(`/alerts/silences/${id}`, { method: "DELETE" });
+  } catch (error) {
+    console.error("Error deleting alert silence:", error);
+    throw error;
+  }
+}
@@ -518,6 +518,218 @@ export const componentSchemas = {
             },
           },
         },
+        ingress: {
+          type: "object",
+          title: "Ingress Policy",
+          description:
+            "Authenticate requests to /ingest/{flow_id} in the coordinator before they reach the flow. Keys and secrets can reference secrets, e.g. ${WEBHOOK_SECRET}.",
+          properties: {
+            api_key: {
+              type: "object",
+              title: "API Key",
+              description: "Require a static API key.",
+              properties: {
+                key: {
+                  type: "input",
+                  title: "Key",
+                  description: "The expected API key, e.g. ${INGEST_API_KEY}.",
+                },
+                header: {
+                  type: "input",
+                  title: "Header",
+                  description: "Header carrying the key. Defaults to X-API-Key.",
+                },
+                query: {
+                  type: "input",
+                  title: "Query Parameter",
+                  description: "Query parameter carrying the key, checked when the header is absent.",
+                },
+              },
+            },
+            signature: {
+              type: "object",
+              title: "Signature",
+              description: "Verify an HMAC signature of the request body.",
+              properties: {
+                preset: {
+                  type: "select",
+                  title: "Preset",
+                  description:
+                    "Provider whose signature scheme to verify. Custom uses the header, algorithm, encoding and prefix below.",
+                  options: ["custom", "github", "stripe", "shopify", "slack"],
+                  default: "custom",
+                },
+                secret: {
+                  type: "input",
+                  title: "Secret",
+                  description: "The signing secret, e.g. ${GITHUB_WEBHOOK_SECRET}.",
+                },
+                header: {
+                  type: "input",
+                  title: "Header",
+                  description: "Header carrying the signature (custom only).",
+                },
+                algorithm: {
+                  type: "select",
+                  title: "Algorithm",
+                  description: "HMAC hash algorithm (custom only).",
+                  options: ["sha256", "sha1", "sha512"],
+                  default: "sha256",
+                },
+                encoding: {
+                  type: "select",
+                  title: "Encoding",
+                  description: "Encoding of the signature (custom only).",
+                  options: ["hex", "base64"],
+                  default: "hex",
+                },
+                prefix: {
+                  type: "input",
+                  title: "Prefix",
+                  description: "Prefix before the signature, e.g. sha256= (custom only).",
+                },
+                tolerance: {
+                  type: "input",
+                  title: "Timestamp Tolerance",
+                  description: "Maximum age of signed timestamps for Stripe and Slack.",
+                  default: "5m",
+                },
+              },
+            },
+            ip_allowlist: {
+              type: "array",
+              title: "IP Allowlist",
+              description: "Client addresses or CIDR ranges that are allowed to send requests.",
+              default: [],
+            },
+            trust_forwarded_for: {
+              type: "bool",
+              title: "Trust X-Forwarded-For",
+              description:
+                "Use the X-Forwarded-For address appended by the proxy in front of the coordinator as the client address. Only enable behind a proxy that sets this header.",
+              default: false,
+            },
+            trusted_proxy_hops: {
+              type: "number",
+              title: "Trusted Proxy Hops",
+              description:
+                "Number of trusted proxies in front of the coordinator. The client address is the X-Forwarded-For entry this many positions from the right.",
+              default: 1,
+            },
+            replay: {
+              type: "object",
+              title: "Replay Protection",
+              description: "Reject requests whose nonce was already received.",
+              properties: {
+                enabled: {
+                  type: "bool",
+                  title: "Enabled",
+                  description: "Enable replay protection.",
+                  default: false,
+                },
+                header: {
+                  type: "input",
+                  title: "Nonce Header",
+                  description:
+                    "Header carrying a unique request ID. Defaults to the delivery ID of the preset, or the signature itself.",
+                },
+                ttl: {
+                  type: "input",
+                  title: "TTL",
+                  description: "How long nonces are remembered.",
+                  default: "10m",
+                },
+              },
+            },
+            queue: {
+              type: "object",
+              title: "Queue",
+              description:
+                "Accept requests with 202 while the flow is not running and deliver them in order once it runs again.",
+              properties: {
+                enabled: {
+                  type: "bool",
+                  title: "Enabled",
+                  description: "Queue requests while no worker runs the flow.",
+                  default: false,
+                },
+                max_age: {
+                  type: "input",
+                  title: "Max Age",
+                  description: "Queued requests older than this are moved to the dead letters.",
+                  default: "24h",
+                },
+                max_requests: {
+                  type: "number",
+                  title: "Max Requests",
+                  description: "Requests beyond this many are rejected with 503.",
+                  default: 10000,
+                },
+                max_attempts: {
+                  type: "number",
+                  title: "Max Attempts",
+                  description: "Failed deliveries before a request is moved to the dead letters.",
+                  default: 5,
+                },
+              },
+            },
+            limits: {
+              type: "object",
+              title: "Limits",
+              description:
+                "Bound the requests of this flow. Unset values fall back to the coordinator defaults.",
+              properties: {
+                max_body_size: {
+                  type: "input",
+                  title: "Max Body Size",
+                  description: "Larger requests are rejected with 413, e.g. 512KB or 10MiB.",
+                },
+                timeout: {
+                  type: "input",
+                  title: "Timeout",
+                  description: "Time the flow has to respond before the request fails with 504, e.g. 30s.",
+                },
+                max_in_flight: {
+                  type: "number",
+                  title: "Max In-Flight Requests",
+                  description: "Concurrent requests beyond this many are rejected with 429. 0 means unlimited.",
+                  default: 0,
+                },
+              },
+            },
+            rate_limit: {
+              type: "object",
+              title: "Rate Limit",
+              description:
+                "Apply a rate limit resource to the requests of this flow. Requests over the limit are rejected with 429.",
+              properties: {
+                label: {
+                  type: "input",
+                  title: "Rate Limit",
+                  description: "Label of the rate limit resource.",
+                },
+                key: {
+                  type: "select",
+                  title: "Key",
+                  description: "What the limit is counted per.",
+                  options: ["ip", "api_key", "header", "global"],
+                  default: "ip",
+                },
+                header: {
+                  type: "input",
+                  title: "Header",
+                  description: "Header whose value is the key (header key only).",
+                },
+                cost: {
+                  type: "number",
+                  title: "Cost",
+                  description: "Tokens each request consumes.",
+                  default: 1,
+                },
+              },
+            },
+          },
+        },
       },
     },
     mcp_tool: {
@@ -545,6 +757,44 @@ export const componentSchemas = {
           description:
             "Define the parameters that AI assistants will pass when calling this tool.",
         },
+        timeout: {
+          type: "input",
+          title: "Timeout",
+          description:
+            "The maximum duration a tool call may run before it is cancelled (e.g., 30s, 5m, 1h).",
+          default: "60s",
+        },
+        rate_limit: {
+          type: "object",
+          title: "Rate Limit",
+          description:
+            "Apply a rate limit resource to the calls of this tool. Calls over the limit fail with an error result.",
+          properties: {
+            label: {
+              type: "input",
+              title: "Rate Limit",
+              description: "Label of the rate limit resource.",
+            },
+            key: {
+              type: "select",
+              title: "Key",
+              description: "What the limit is counted per.",
+              options: ["session", "client", "ip", "header", "global"],
+              default: "session",
+            },
+            header: {
+              type: "input",
+              title: "Header",
+              description: "HTTP header whose value is the key (header key only).",
+            },
+            cost: {
+              type: "number",
+              title: "Cost",
+              description: "Tokens each call consumes.",
+              default: 1,
+            },
+          },
+        },
       },
     },
     broker: {
@@ -987,6 +1237,165 @@ export const componentSchemas = {
         },
       },
     },
+    mcp_call: {
+      title: "MCP Call",
+      description:
+        "Calls a tool on an external MCP server and replaces the message with its result.",
+      properties: {
+        url: {
+          type: "input",
+          title: "URL",
+          description: "The URL of the MCP server endpoint, e.g. https://example.com/mcp.",
+          required: true,
+        },
+        transport: {
+          type: "select",
+          title: "Transport",
+          description: "The transport used to talk to the MCP server.",
+          options: ["streamable_http", "sse"],
+          default: "streamable_http",
+        },
+        tool: {
+          type: "input",
+          title: "Tool",
+          description: "The name of the tool to call.",
+          required: true,
+        },
+        args_mapping: {
+          type: "code",
+          title: "Args Mapping",
+          description:
+            "A Bloblang mapping which should evaluate to an object of tool arguments. When empty, the message content is used as arguments.",
+        },
+        headers: {
+          type: "key_value",
+          title: "Headers",
+          description:
+            "A map of headers to add to every request sent to the MCP server. Values may reference secrets, e.g. ${MY_SECRET}.",
+          default: {},
+        },
+        bearer_token: {
+          type: "input",
+          title: "Bearer Token",
+          description:
+            "An optional bearer token sent in the Authorization header. Can reference a secret, e.g. ${MCP_TOKEN}.",
+          default: "",
+        },
+        oauth2: {
+          type: "object",
+          title: "OAuth2",
+          description: "OAuth2 client credentials configuration",
+          properties: {
+            enabled: {
+              type: "bool",
+              title: "Enabled",
+              description: "Enable OAuth2 authentication",
+              default: false,
+            },
+            client_key: {
+              type: "input",
+              title: "Client Key",
+              description: "OAuth2 client key",
+              default: "",
+            },
+            client_secret: {
+              type: "input",
+              title: "Client Secret",
+              description: "OAuth2 client secret",
+              default: "",
+            },
+            token_url: {
+              type: "input",
+              title: "Token URL",
+              description: "OAuth2 token URL",
+              default: "",
+            },
+            scopes: {
+              type: "array",
+              title: "Scopes",
+              description: "OAuth2 scopes",
+              default: [],
+            },
+            endpoint_params: {
+              type: "key_value",
+              title: "Endpoint Parameters",
+              description: "OAuth2 endpoint parameters",
+              default: {},
+            },
+          },
+        },
+        timeout: {
+          type: "input",
+          title: "Timeout",
+          description: "The maximum duration of a single tool call.",
+          default: "30s",
+        },
+      },
+    },
+    mcp_progress: {
+      title: "MCP Progress",
+      description:
+        "Reports the progress of the MCP tool call that triggered the message. Only has an effect in MCP tool flows.",
+      properties: {
+        progress: {
+          type: "input",
+          title: "Progress",
+          description:
+            "The current progress. Supports interpolation functions and must resolve to a number.",
+          required: true,
+        },
+        total: {
+          type: "input",
+          title: "Total",
+          description:
+            "The total amount of work, if known. Supports interpolation functions and must resolve to a number.",
+          default: "",
+        },
+        message: {
+          type: "input",
+          title: "Message",
+          description:
+            "An optional human-readable message describing the current progress. Supports interpolation functions.",
+          default: "",
+        },
+      },
+    },
+    coordinator_rate_limit: {
+      title: "Coordinator Rate Limit",
+      description:
+        "Checks every message against a rate limit with a key taken from the message, e.g. to limit each customer separately.",
+      properties: {
+        rate_limit: {
+          type: "dynamic_select",
+          title: "Rate Limit",
+          description: "The rate limit to check.",
+          dataSource: "rate_limits",
+          required: true,
+        },
+        key: {
+          type: "input",
+          title: "Key",
+          description:
+            "The key the message is counted against. Supports interpolation functions, e.g. ${! meta(\"customer_id\") }.",
+          default: "global",
+        },
+        cost: {
+          type: "code",
+          title: "Cost",
+          description:
+            "An optional Bloblang mapping which should evaluate to the number of tokens the message costs. Each message costs 1 when empty.",
+          default: "",
+        },
+        policy: {
+          type: "select",
+          title: "Policy",
+          description:
+            "What to do with a message that exceeds the limit. wait holds it until it fits, drop removes it, reject flags it as failed so it can be handled with a catch processor.",
+          options: ["wait", "drop", "reject"],
+          default: "wait",
+        },
+      },
+    },
     mapping: {
       title: "Mapping",
       flat: true,
@@ -2193,6 +2602,107 @@ export const componentSchemas = {
         },
       },
     },
+    mcp: {
+      title: "MCP",
+      description: "Calls a tool on an external MCP server for each message.",
+      properties: {
+        url: {
+          type: "input",
+          title: "URL",
+          description: "The URL of the MCP server endpoint, e.g. https://example.com/mcp.",
+          required: true,
+        },
+        transport: {
+          type: "select",
+          title: "Transport",
+          description: "The transport used to talk to the MCP server.",
+          options: ["streamable_http", "sse"],
+          default: "streamable_http",
+        },
+        tool: {
+          type: "input",
+          title: "Tool",
+          description: "The name of the tool to call.",
+          required: true,
+        },
+        args_mapping: {
+          type: "code",
+          title: "Args Mapping",
+          description:
+            "A Bloblang mapping which should evaluate to an object of tool arguments. When empty, the message content is used as arguments.",
+        },
+        headers: {
+          type: "key_value",
+          title: "Headers",
+          description:
+            "A map of headers to add to every request sent to the MCP server. Values may reference secrets, e.g. ${MY_SECRET}.",
+          default: {},
+        },
+        bearer_token: {
+          type: "input",
+          title: "Bearer Token",
+          description:
+            "An optional bearer token sent in the Authorization header. Can reference a secret, e.g. ${MCP_TOKEN}.",
+          default: "",
+        },
+        oauth2: {
+          type: "object",
+          title: "OAuth2",
+          description: "OAuth2 client credentials configuration",
+          properties: {
+            enabled: {
+              type: "bool",
+              title: "Enabled",
+              description: "Enable OAuth2 authentication",
+              default: false,
+            },
+            client_key: {
+              type: "input",
+              title: "Client Key",
+              description: "OAuth2 client key",
+              default: "",
+            },
+            client_secret: {
+              type: "input",
+              title: "Client Secret",
+              description: "OAuth2 client secret",
+              default: "",
+            },
+            token_url: {
+              type: "input",
+              title: "Token URL",
+              description: "OAuth2 token URL",
+              default: "",
+            },
+            scopes: {
+              type: "array",
+              title: "Scopes",
+              description: "OAuth2 scopes",
+              default: [],
+            },
+            endpoint_params: {
+              type: "key_value",
+              title: "Endpoint Parameters",
+              description: "OAuth2 endpoint parameters",
+              default: {},
+            },
+          },
+        },
+        timeout: {
+          type: "input",
+          title: "Timeout",
+          description: "The maximum duration of a single tool call.",
+          default: "30s",
+        },
+        max_in_flight: {
+          type: "number",
+          title: "Max In Flight",
+          description:
+            "The maximum number of messages to have in flight at a given time.",
+          default: 64,
+        },
+      },
+    },
     kafka: {
       title: "Kafka",
       properties: {
@@ -2990,43 +3500,59 @@ export const componentSchemas = {
     coordinator: {
       title: "Coordinator",
       properties: {
+        algorithm: {
+          type: "select",
+          title: "Algorithm",
+          description:
+            "How requests are counted. token_bucket refills gradually and allows bursts, fixed_window resets at the start of every interval, sliding_window weighs in the previous window to smooth boundary bursts, sliding_log is exact but stores every request, concurrency limits requests in flight.",
+          options: [
+            "token_bucket",
+            "fixed_window",
+            "sliding_window",
+            "sliding_log",
+            "concurrency",
+          ],
+          default: "token_bucket",
+        },
         count: {
           type: "number",
           title: "Count",
-          description: "Number of requests allowed per interval.",
+          description:
+            "Number of requests allowed per interval, or in flight at once for the concurrency algorithm.",
           required: true,
           default: 10,
           min: 1,
         },
         interval: {
-          type: "select",
+          type: "input",
           title: "Interval",
-          description: "Time interval for rate limiting.",
-          options: [
-            "1s",
-            "5s",
-            "10s",
-            "30s",
-            "1m",
-            "5m",
-            "10m",
-            "30m",
-            "1h",
-            "2h",
-            "6h",
-            "12h",
-            "24h",
-          ],
+          description:
+            "Time interval for rate limiting, e.g. 1s, 5m, 1h, 1d, 1w or Go duration syntax such as 1h30m. For the concurrency algorithm it is the time after which unreleased requests free their slot.",
           default: "1s",
           required: true,
         },
         burst: {
           type: "number",
           title: "Burst",
-          description: "Additional burst capacity for handling traffic spikes.",
+          description:
+            "Additional burst capacity for handling traffic spikes. Only used by the token_bucket algorithm.",
           default: 0,
           min: 0,
         },
+        block_size: {
+          type: "number",
+          title: "Block Size",
+          description:
+            "Number of tokens a worker leases from the coordinator at once and spends locally, saving a coordinator call per message. Tokens left when the block expires are lost. 0 checks every message with the coordinator. Not supported by the concurrency algorithm.",
+          default: 0,
+          min: 0,
+        },
+        block_ttl: {
+          type: "input",
+          title: "Block TTL",
+          description: "How long a worker can spend a leased block of tokens.",
+          default: "1s",
+        },
       },
     },
   },
@@ -3172,6 +3698,146 @@ export const componentSchemas = {
       },
     },
   },
+  mcp_server: {
+    streamable_http: {
+      title: "Streamable HTTP",
+      description:
+        "Connects to an upstream MCP server over the Streamable HTTP transport and re-exports its tools.",
+      properties: {
+        url: {
+          type: "string",
+          title: "URL",
+          description:
+            "The URL of the upstream MCP server. Secrets can be referenced as ${KEY}.",
+          required: true,
+        },
+        headers: {
+          type: "object",
+          title: "Headers",
+          description:
+            "HTTP headers sent with every request to the upstream server. Values can reference secrets as ${KEY}.",
+          default: "{}",
+        },
+        bearer_token: {
+          type: "string",
+          title: "Bearer Token",
+          description:
+            "A bearer token sent in the Authorization header, e.g. ${GITHUB_TOKEN}.",
+          default: "",
+        },
+        prefix: {
+          type: "string",
+          title: "Prefix",
+          description:
+            "A prefix added to the names of the re-exported tools, e.g. github_.",
+          default: "",
+        },
+        allowed_tools: {
+          type: "array",
+          title: "Allowed Tools",
+          description:
+            "Glob patterns of upstream tool names to expose. All tools are exposed when empty.",
+          default: "[]",
+        },
+        denied_tools: {
+          type: "array",
+          title: "Denied Tools",
+          description:
+            "Glob patterns of upstream tool names to hide. Takes precedence over allowed tools.",
+          default: "[]",
+        },
+        args_mapping: {
+          type: "code",
+          title: "Arguments Mapping",
+          description:
+            "An optional Bloblang mapping that rewrites the tool arguments before they are sent upstream. The upstream tool name is available as @tool.",
+          default: "",
+        },
+        rate_limits: {
+          type: "object",
+          title: "Rate Limits",
+          description:
+            'A map of upstream tool names to rate limit labels. Use "*" to apply a rate limit to every tool.',
+          default: "{}",
+        },
+        timeout: {
+          type: "string",
+          title: "Timeout",
+          description: "The maximum duration of a single upstream tool call.",
+          default: "60s",
+        },
+      },
+    },
+    sse: {
+      title: "SSE",
+      description:
+        "Connects to an upstream MCP server over the legacy SSE transport and re-exports its tools.",
+      properties: {
+        url: {
+          type: "string",
+          title: "URL",
+          description:
+            "The URL of the upstream MCP server. Secrets can be referenced as ${KEY}.",
+          required: true,
+        },
+        headers: {
+          type: "object",
+          title: "Headers",
+          description:
+            "HTTP headers sent with every request to the upstream server. Values can reference secrets as ${KEY}.",
+          default: "{}",
+        },
+        bearer_token: {
+          type: "string",
+          title: "Bearer Token",
+          description:
+            "A bearer token sent in the Authorization header, e.g. ${GITHUB_TOKEN}.",
+          default: "",
+        },
+        prefix: {
+          type: "string",
+          title: "Prefix",
+          description:
+            "A prefix added to the names of the re-exported tools, e.g. github_.",
+          default: "",
+        },
+        allowed_tools: {
+          type: "array",
+          title: "Allowed Tools",
+          description:
+            "Glob patterns of upstream tool names to expose. All tools are exposed when empty.",
+          default: "[]",
+        },
+        denied_tools: {
+          type: "array",
+          title: "Denied Tools",
+          description:
+            "Glob patterns of upstream tool names to hide. Takes precedence over allowed tools.",
+          default: "[]",
+        },
+        args_mapping: {
+          type: "code",
+          title: "Arguments Mapping",
+          description:
+            "An optional Bloblang mapping that rewrites the tool arguments before they are sent upstream. The upstream tool name is available as @tool.",
+          default: "",
+        },
+        rate_limits: {
+          type: "object",
+          title: "Rate Limits",
+          description:
+            'A map of upstream tool names to rate limit labels. Use "*" to apply a rate limit to every tool.',
+          default: "{}",
+        },
+        timeout: {
+          type: "string",
+          title: "Timeout",
+          description: "The maximum duration of a single upstream tool call.",
+          default: "60s",
+        },
+      },
+    },
+  },
 };
 
 // Component lists for each type
@@ -3189,6 +3855,9 @@ export const componentLists = {
   ],
   pipeline: [
     "ai_gateway",
+    "mcp_call",
+    "mcp_progress",
+    "coordinator_rate_limit",
     "branch",
     "mapping",
     "json_schema",
@@ -3203,6 +3872,7 @@ export const componentLists = {
   ],
   output: [
     "http_client",
+    "mcp",
     "kafka",
     "amqp_0_9",
     "sync_response",
@@ -3222,4 +3892,5 @@ export const componentLists = {
   ],
   rate_limit: ["coordinator"],
   buffer: ["memory", "sqlite", "system_window"],
+  mcp_server: ["streamable_http", "sse"],
 };
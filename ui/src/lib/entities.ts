@@ -34,6 +34,10 @@ export type Flow = {
   is_mcp_tool: boolean;
   is_ready: boolean;
   builder_state?: string;
+  slug?: string;
+  route?: string;
+  route_methods?: string[];
+  event_settings?: EventSettings;
 
   // Legacy fields for backward compatibility
   inputLabel?: string;
@@ -45,6 +49,22 @@ export type Flow = {
   isHttpServer?: boolean;
 };
 
+export type EventRedaction = {
+  path?: string;
+  pattern?: string;
+  preset?: string;
+  replacement?: string;
+};
+
+export type EventSettings = {
+  // Share of traces whose events are stored, all are when unset.
+  sample_rate?: number;
+  max_content_size?: number;
+  redactions?: EventRedaction[];
+  retention_days?: number;
+  retention_rows?: number;
+};
+
 export type FlowProcessor = {
   processorID: number;
   label: string;
@@ -59,6 +79,15 @@ export type Cache = {
   createdAt: string;
 };
 
+export type McpServer = {
+  id: string;
+  parentID?: string;
+  label: string;
+  component: string;
+  config: string;
+  createdAt: string;
+};
+
 export type RateLimit = {
   id: string;
   parentID?: string;
@@ -87,6 +116,119 @@ export type FileEntry = {
   updatedAt?: string;
 };
 
+export type QueuedRequest = {
+  id: string;
+  flowId: string;
+  flowVersionId: string;
+  method: string;
+  path: string;
+  query: string;
+  contentType: string;
+  remoteAddr: string;
+  payload: string;
+  status: string;
+  attempts: number;
+  lastError: string;
+  createdAt: string;
+  updatedAt?: string;
+};
+
+export type Alert = {
+  id: string;
+  ruleId: string;
+  ruleName: string;
+  type: string;
+  status: string;
+  flowId: string;
+  workerId: string;
+  message: string;
+  silenced: boolean;
+  startedAt: string;
+  resolvedAt?: string;
+};
+
+export type AlertFilters = {
+  status?: string;
+  ruleId?: string;
+  flowId?: string;
+  limit: number;
+  offset: number;
+};
+
+export type AlertRule = {
+  id: string;
+  name: string;
+  type: string;
+  flowId: string;
+  threshold: number;
+  windowMinutes: number;
+  channelIds: string[];
+  enabled: boolean;
+  createdAt: string;
+  updatedAt?: string;
+};
+
+export type AlertChannel = {
+  id: string;
+  name: string;
+  type: string;
+  url: string;
+  recipients: string[];
+  createdAt: string;
+  updatedAt?: string;
+};
+
+export type AlertSilence = {
+  id: string;
+  ruleId: string;
+  flowId: string;
+  workerId: string;
+  comment: string;
+  startsAt: string;
+  endsAt: string;
+  createdAt: string;
+};
+
+export type RateLimitKey = {
+  key: string;
+  remaining: number;
+  limit: number;
+  resetAt: number;
+  lastRefillAt: string;
+  updatedAt: string;
+};
+
+export type RateLimitStatsPoint = {
+  timestamp: string;
+  allowed: number;
+  denied: number;
+};
+
+export type RateLimitStats = {
+  data: RateLimitStatsPoint[];
+  totalAllowed: number;
+  totalDenied: number;
+};
+
+export type FlowMetricsPoint = {
+  timestamp: string;
+  events: number;
+  errors: number;
+};
+
+export type FlowComponentMetrics = {
+  section: string;
+  componentLabel: string;
+  data: FlowMetricsPoint[];
+  totalEvents: number;
+  totalErrors: number;
+};
+
+export type FlowMetrics = {
+  components: FlowComponentMetrics[];
+  step: string;
+};
+
 export type FlowEvent = {
   id: number;
   worker_flow_id: number;
@@ -99,6 +241,51 @@ export type FlowEvent = {
   created_at: string;
 };
 
+export type FlowEventFilters = {
+  section?: string;
+  componentLabel?: string;
+  type?: string;
+  traceId?: string;
+  workerFlowId?: number;
+  flowVersionId?: number;
+  search?: string;
+};
+
+export type FlowLog = {
+  id: number;
+  worker_flow_id: number;
+  worker_id: string;
+  level: string;
+  message: string;
+  fields: Record<string, any>;
+  created_at: string;
+};
+
+export type FlowLogFilters = {
+  level?: string;
+  workerId?: string;
+  workerFlowId?: number;
+  flowVersionId?: number;
+  search?: string;
+};
+
+export type TraceComponent = {
+  section: string;
+  component_label: string;
+  started_at: string;
+  ended_at: string;
+  errors: number;
+  events: FlowEvent[];
+};
+
+export type Trace = {
+  trace_id: string;
+  flow_id: number;
+  started_at: string;
+  ended_at: string;
+  timeline: TraceComponent[];
+};
+
 export type FlowStatusCount = {
   status: string;
   count: number;
@@ -116,6 +303,14 @@ export type TimeSeriesPoint = {
   error_events: number;
 };
 
+export type ToolCallStats = {
+  tool: string;
+  calls: number;
+  errors: number;
+  avg_latency_ms: number;
+  max_latency_ms: number;
+};
+
 export type Analytics = {
   total_flows: number;
   flows_by_status: FlowStatusCount[];
@@ -128,4 +323,5 @@ export type Analytics = {
   events_over_time: TimeSeriesPoint[];
   top_input_components: ComponentCount[];
   top_output_components: ComponentCount[];
+  tool_calls: ToolCallStats[];
 };
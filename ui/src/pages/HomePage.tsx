@@ -23,8 +23,16 @@ import {
   Workflow,
 } from "lucide-react";
 import { Badge } from "@/components/ui/badge";
-import { fetchAnalytics } from "@/lib/api";
-import type { Analytics } from "@/lib/entities";
+import {
+  Select,
+  SelectContent,
+  SelectItem,
+  SelectTrigger,
+  SelectValue,
+} from "@/components/ui/select";
+import { Tabs, TabsList, TabsTrigger } from "@/components/ui/tabs";
+import { fetchAnalytics, fetchFlows } from "@/lib/api";
+import type { Analytics, Flow } from "@/lib/entities";
 
 const STATUS_COLORS: Record<string, string> = {
   active: "hsl(142, 76%, 36%)",
@@ -46,6 +54,20 @@ const statusChartConfig = {
   failed: { label: "Failed", color: STATUS_COLORS.failed },
 } satisfies ChartConfig;
 
+const RANGE_DESCRIPTIONS: Record<string, string> = {
+  "1h": "Event counts per minute for the last hour",
+  "24h": "Hourly event counts for the last 24 hours",
+  "7d": "Hourly event counts for the last 7 days",
+  "30d": "Daily event counts for the last 30 days",
+};
+
+const RANGE_LABELS: Record<string, string> = {
+  "1h": "the last hour",
+  "24h": "the last 24 hours",
+  "7d": "the last 7 days",
+  "30d": "the last 30 days",
+};
+
 function formatNumber(n: number): string {
   if (n >= 1_000_000) return `${(n / 1_000_000).toFixed(1)}M`;
   if (n >= 1_000) return `${(n / 1_000).toFixed(1)}K`;
@@ -56,13 +78,26 @@ export default function Home() {
   const [data, setData] = useState<Analytics | null>(null);
   const [loading, setLoading] = useState(true);
   const [error, setError] = useState<string | null>(null);
+  const [range, setRange] = useState("30d");
+  const [flowId, setFlowId] = useState("all");
+  const [flows, setFlows] = useState<Flow[]>([]);
+
+  useEffect(() => {
+    fetchFlows()
+      .then(setFlows)
+      .catch((err) => console.error(err));
+  }, []);
 
   useEffect(() => {
     const load = async () => {
       try {
         setLoading(true);
-        const analytics = await fetchAnalytics();
+        const analytics = await fetchAnalytics(
+          range,
+          flowId === "all" ? undefined : flowId,
+        );
         setData(analytics);
+        setError(null);
       } catch (err) {
         setError("Failed to load analytics");
         console.error(err);
@@ -71,9 +106,9 @@ export default function Home() {
       }
     };
     load();
-  }, []);
+  }, [range, flowId]);
 
-  if (loading) {
+  if (loading && !data) {
     return (
       <div className="flex items-center justify-center h-[50vh]">
         <Loader2 className="h-8 w-8 animate-spin text-muted-foreground" />
@@ -113,6 +148,29 @@ export default function Home() {
     <div className="p-6">
       <div className="flex items-center justify-between mb-6">
         <h1 className="text-2xl font-bold">Dashboard</h1>
+        <div className="flex items-center gap-2">
+          <Select value={flowId} onValueChange={setFlowId}>
+            <SelectTrigger className="w-[200px]">
+              <SelectValue placeholder="All flows" />
+            </SelectTrigger>
+            <SelectContent>
+              <SelectItem value="all">All flows</SelectItem>
+              {flows.map((flow) => (
+                <SelectItem key={flow.parentID || flow.id} value={flow.parentID || flow.id}>
+                  {flow.name}
+                </SelectItem>
+              ))}
+            </SelectContent>
+          </Select>
+          <Tabs value={range} onValueChange={setRange}>
+            <TabsList>
+              <TabsTrigger value="1h">1h</TabsTrigger>
+              <TabsTrigger value="24h">24h</TabsTrigger>
+              <TabsTrigger value="7d">7d</TabsTrigger>
+              <TabsTrigger value="30d">30d</TabsTrigger>
+            </TabsList>
+          </Tabs>
+        </div>
       </div>
 
       <div className="grid gap-4 md:grid-cols-2 lg:grid-cols-4 mb-6">
@@ -141,7 +199,7 @@ export default function Home() {
           <CardContent>
             <div className="text-2xl font-bold">{formatNumber(data.total_input_events)}</div>
             <p className="text-xs text-muted-foreground mt-1">
-              Total events ingested across all flows
+              Events ingested in {RANGE_LABELS[range]}
             </p>
           </CardContent>
         </Card>
@@ -154,7 +212,7 @@ export default function Home() {
           <CardContent>
             <div className="text-2xl font-bold">{formatNumber(data.total_output_events)}</div>
             <p className="text-xs text-muted-foreground mt-1">
-              Total events delivered to outputs
+              Events delivered to outputs in {RANGE_LABELS[range]}
             </p>
           </CardContent>
         </Card>
@@ -188,7 +246,7 @@ export default function Home() {
         <Card className="md:col-span-5">
           <CardHeader>
             <CardTitle>Events Over Time</CardTitle>
-            <CardDescription>Daily event counts for the last 30 days</CardDescription>
+            <CardDescription>{RANGE_DESCRIPTIONS[range]}</CardDescription>
           </CardHeader>
           <CardContent>
             {timeSeriesData.length > 0 ? (
@@ -201,6 +259,9 @@ export default function Home() {
                     axisLine={false}
                     tickFormatter={(value) => {
                       const d = new Date(value);
+                      if (range === "1h" || range === "24h") {
+                        return d.toLocaleTimeString("en-US", { hour: "2-digit", minute: "2-digit" });
+                      }
                       return d.toLocaleDateString("en-US", { month: "short", day: "numeric" });
                     }}
                   />
@@ -298,6 +359,33 @@ export default function Home() {
           </CardContent>
         </Card>
       </div>
+
+      <Card>
+        <CardHeader>
+          <CardTitle>MCP Tool Calls</CardTitle>
+          <CardDescription>Calls, errors and latency per tool for {RANGE_LABELS[range]}</CardDescription>
+        </CardHeader>
+        <CardContent>
+          {data.tool_calls.length > 0 ? (
+            <div className="space-y-3">
+              {data.tool_calls.map((t) => (
+                <div key={t.tool} className="flex items-center justify-between">
+                  <div className="flex items-center gap-2">
+                    <div className={`h-2 w-2 rounded-full ${t.errors > 0 ? "bg-red-500" : "bg-purple-500"}`} />
+                    <span className="text-sm font-medium">{t.tool}</span>
+                  </div>
+                  <span className="text-sm text-muted-foreground">
+                    {t.calls} {t.calls === 1 ? "call" : "calls"} · {t.errors} {t.errors === 1 ? "error" : "errors"} ·{" "}
+                    {Math.round(t.avg_latency_ms)}ms avg · {t.max_latency_ms}ms max
+                  </span>
+                </div>
+              ))}
+            </div>
+          ) : (
+            <p className="text-sm text-muted-foreground">No MCP tool calls yet</p>
+          )}
+        </CardContent>
+      </Card>
     </div>
   );
 }
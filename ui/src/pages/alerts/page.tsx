@@ -0,0 +1,1091 @@
+import { useCallback, useEffect, useMemo, useState } from "react";
+import { BellOff, Plus, Send } from "lucide-react";
+import { Card, CardContent, CardHeader, CardTitle } from "@/components/ui/card";
+import { Badge } from "@/components/ui/badge";
+import { Button } from "@/components/ui/button";
+import { Checkbox } from "@/components/ui/checkbox";
+import { Input } from "@/components/ui/input";
+import { Label } from "@/components/ui/label";
+import { Switch } from "@/components/ui/switch";
+import { Tabs, TabsContent, TabsList, TabsTrigger } from "@/components/ui/tabs";
+import {
+  Select,
+  SelectContent,
+  SelectItem,
+  SelectTrigger,
+  SelectValue,
+} from "@/components/ui/select";
+import {
+  Dialog,
+  DialogContent,
+  DialogFooter,
+  DialogHeader,
+  DialogTitle,
+} from "@/components/ui/dialog";
+import {
+  AlertDialog,
+  AlertDialogAction,
+  AlertDialogCancel,
+  AlertDialogContent,
+  AlertDialogDescription,
+  AlertDialogFooter,
+  AlertDialogHeader,
+  AlertDialogTitle,
+} from "@/components/ui/alert-dialog";
+
+import {
+  Alert,
+  AlertChannel,
+  AlertRule,
+  AlertSilence,
+  Flow,
+} from "@/lib/entities";
+import { DataTable } from "@/components/data-table";
+import { useToast } from "@/components/toast";
+import {
+  createAlertChannel,
+  createAlertRule,
+  createAlertSilence,
+  deleteAlertChannel,
+  deleteAlertRule,
+  deleteAlertSilence,
+  fetchAlertChannels,
+  fetchAlertRules,
+  fetchAlertSilences,
+  fetchAlerts,
+  fetchFlows,
+  testAlertChannel,
+  updateAlertChannel,
+  updateAlertRule,
+} from "@/lib/api";
+
+const PAGE_SIZE = 50;
+
+const RULE_TYPES = [
+  { value: "flow_failed", label: "Flow failed" },
+  { value: "error_rate", label: "Error rate above threshold" },
+  { value: "no_input", label: "No input" },
+  { value: "worker_lost", label: "Worker lost" },
+  { value: "lease_expired", label: "Lease expired" },
+];
+
+const CHANNEL_TYPES = [
+  { value: "webhook", label: "Webhook" },
+  { value: "slack", label: "Slack" },
+  { value: "email", label: "Email" },
+];
+
+const SILENCE_DURATIONS = [
+  { value: "60", label: "1 hour" },
+  { value: "240", label: "4 hours" },
+  { value: "1440", label: "1 day" },
+  { value: "10080", label: "7 days" },
+];
+
+const ruleTypeLabel = (type: string) =>
+  RULE_TYPES.find((t) => t.value === type)?.label || type;
+
+const isWindowed = (type: string) =>
+  type === "error_rate" || type === "no_input";
+
+const errorMessage = (error: unknown) =>
+  error instanceof Error ? error.message : "An unknown error occurred";
+
+type RuleForm = Omit<AlertRule, "id" | "createdAt" | "updatedAt">;
+type ChannelForm = Omit<AlertChannel, "id" | "createdAt" | "updatedAt">;
+type SilenceForm = {
+  ruleId: string;
+  flowId: string;
+  workerId: string;
+  comment: string;
+  minutes: string;
+};
+
+const emptyRule: RuleForm = {
+  name: "",
+  type: "flow_failed",
+  flowId: "0",
+  threshold: 0.05,
+  windowMinutes: 5,
+  channelIds: [],
+  enabled: true,
+};
+
+const emptyChannel: ChannelForm = {
+  name: "",
+  type: "webhook",
+  url: "",
+  recipients: [],
+};
+
+const emptySilence: SilenceForm = {
+  ruleId: "0",
+  flowId: "0",
+  workerId: "",
+  comment: "",
+  minutes: "60",
+};
+
+export default function AlertsPage() {
+  const { addToast } = useToast();
+  const [tab, setTab] = useState("history");
+
+  const [alerts, setAlerts] = useState<Alert[]>([]);
+  const [total, setTotal] = useState(0);
+  const [status, setStatus] = useState("firing");
+  const [offset, setOffset] = useState(0);
+
+  const [rules, setRules] = useState<AlertRule[]>([]);
+  const [channels, setChannels] = useState<AlertChannel[]>([]);
+  const [silences, setSilences] = useState<AlertSilence[]>([]);
+  const [includeExpired, setIncludeExpired] = useState(false);
+  const [flows, setFlows] = useState<Flow[]>([]);
+
+  const [ruleForm, setRuleForm] = useState<RuleForm | null>(null);
+  const [editingRuleId, setEditingRuleId] = useState<string | null>(null);
+  const [channelForm, setChannelForm] = useState<ChannelForm | null>(null);
+  const [editingChannelId, setEditingChannelId] = useState<string | null>(
+    null,
+  );
+  const [silenceForm, setSilenceForm] = useState<SilenceForm | null>(null);
+  const [toDelete, setToDelete] = useState<{
+    kind: "rule" | "channel" | "silence";
+    id: string;
+    name: string;
+  } | null>(null);
+
+  const flowNames = useMemo(() => {
+    const names: Record<string, string> = {};
+    flows.forEach((flow) => {
+      names[flow.parentID || flow.id] = flow.name;
+    });
+    return names;
+  }, [flows]);
+
+  const loadAlerts = useCallback(async () => {
+    try {
+      const result = await fetchAlerts({
+        status: status === "all" ? "" : status,
+        limit: PAGE_SIZE,
+        offset,
+      });
+      setAlerts(result.data);
+      setTotal(result.total);
+    } catch (error) {
+      console.error("Error fetching alerts:", error);
+    }
+  }, [status, offset]);
+
+  const loadRules = useCallback(async () => {
+    try {
+      setRules(await fetchAlertRules());
+    } catch (error) {
+      console.error("Error fetching alert rules:", error);
+    }
+  }, []);
+
+  const loadChannels = useCallback(async () => {
+    try {
+      setChannels(await fetchAlertChannels());
+    } catch (error) {
+      console.error("Error fetching alert channels:", error);
+    }
+  }, []);
+
+  const loadSilences = useCallback(async () => {
+    try {
+      setSilences(await fetchAlertSilences(includeExpired));
+    } catch (error) {
+      console.error("Error fetching alert silences:", error);
+    }
+  }, [includeExpired]);
+
+  useEffect(() => {
+    loadAlerts();
+  }, [loadAlerts]);
+
+  useEffect(() => {
+    loadSilences();
+  }, [loadSilences]);
+
+  useEffect(() => {
+    loadRules();
+    loadChannels();
+    fetchFlows()
+      .then(setFlows)
+      .catch((error) => console.error("Error fetching flows:", error));
+  }, [loadRules, loadChannels]);
+
+  const handleSaveRule = async (e: React.FormEvent) => {
+    e.preventDefault();
+    if (!ruleForm) return;
+
+    try {
+      if (editingRuleId) {
+        await updateAlertRule(editingRuleId, ruleForm);
+      } else {
+        await createAlertRule(ruleForm);
+      }
+      addToast({
+        id: "alert-rule-saved",
+        title: "Alert Rule Saved",
+        description: `Alert rule "${ruleForm.name}" has been saved successfully.`,
+        variant: "success",
+      });
+      setRuleForm(null);
+      loadRules();
+    } catch (error) {
+      addToast({
+        id: "alert-rule-save-error",
+        title: "Error Saving Alert Rule",
+        description: errorMessage(error),
+        variant: "error",
+      });
+    }
+  };
+
+  const handleSaveChannel = async (e: React.FormEvent) => {
+    e.preventDefault();
+    if (!channelForm) return;
+
+    try {
+      if (editingChannelId) {
+        await updateAlertChannel(editingChannelId, channelForm);
+      } else {
+        await createAlertChannel(channelForm);
+      }
+      addToast({
+        id: "alert-channel-saved",
+        title: "Alert Channel Saved",
+        description: `Alert channel "${channelForm.name}" has been saved successfully.`,
+        variant: "success",
+      });
+      setChannelForm(null);
+      loadChannels();
+    } catch (error) {
+      addToast({
+        id: "alert-channel-save-error",
+        title: "Error Saving Alert Channel",
+        description: errorMessage(error),
+        variant: "error",
+      });
+    }
+  };
+
+  const handleTestChannel = async (channel: AlertChannel) => {
+    try {
+      await testAlertChannel(channel.id);
+      addToast({
+        id: "alert-channel-tested",
+        title: "Test Notification Sent",
+        description: `A test notification has been sent to "${channel.name}".`,
+        variant: "success",
+      });
+    } catch (error) {
+      addToast({
+        id: "alert-channel-test-error",
+        title: "Error Sending Test Notification",
+        description: errorMessage(error),
+        variant: "error",
+      });
+    }
+  };
+
+  const handleSaveSilence = async (e: React.FormEvent) => {
+    e.preventDefault();
+    if (!silenceForm) return;
+
+    try {
+      const endsAt = new Date(
+        Date.now() + Number(silenceForm.minutes) * 60 * 1000,
+      );
+      await createAlertSilence({
+        ruleId: silenceForm.ruleId === "0" ? "" : silenceForm.ruleId,
+        flowId: silenceForm.flowId === "0" ? "" : silenceForm.flowId,
+        workerId: silenceForm.workerId.trim(),
+        comment: silenceForm.comment,
+        endsAt: endsAt.toISOString(),
+      });
+      addToast({
+        id: "alert-silence-created",
+        title: "Silence Created",
+        description: `Matching alerts are silenced until ${endsAt.toLocaleString()}.`,
+        variant: "success",
+      });
+      setSilenceForm(null);
+      loadSilences();
+    } catch (error) {
+      addToast({
+        id: "alert-silence-create-error",
+        title: "Error Creating Silence",
+        description: errorMessage(error),
+        variant: "error",
+      });
+    }
+  };
+
+  const confirmDelete = async () => {
+    if (!toDelete) return;
+
+    try {
+      if (toDelete.kind === "rule") {
+        await deleteAlertRule(toDelete.id);
+        loadRules();
+      } else if (toDelete.kind === "channel") {
+        await deleteAlertChannel(toDelete.id);
+        loadChannels();
+      } else {
+        await deleteAlertSilence(toDelete.id);
+        loadSilences();
+      }
+      addToast({
+        id: "alert-item-deleted",
+        title: "Deleted",
+        description: `${toDelete.name} has been deleted successfully.`,
+        variant: "success",
+      });
+    } catch (error) {
+      addToast({
+        id: "alert-item-delete-error",
+        title: "Error Deleting",
+        description: errorMessage(error),
+        variant: "error",
+      });
+    } finally {
+      setToDelete(null);
+    }
+  };
+
+  const flowLabel = (flowId: string) =>
+    flowId === "0" ? "" : flowNames[flowId] || `#${flowId}`;
+
+  const alertColumns = [
+    {
+      key: "status" as keyof Alert,
+      title: "Status",
+      render: (value: string, row: Alert) => (
+        <div className="flex items-center gap-2">
+          <Badge
+            variant="outline"
+            className={
+              value === "firing"
+                ? "bg-red-100 text-red-800 dark:bg-red-900 dark:text-red-300"
+                : "bg-green-100 text-green-800 dark:bg-green-900 dark:text-green-300"
+            }
+          >
+            {value}
+          </Badge>
+          {row.silenced && (
+            <BellOff
+              className="h-4 w-4 text-muted-foreground"
+              aria-label="Silenced"
+            />
+          )}
+        </div>
+      ),
+    },
+    { key: "ruleName" as keyof Alert, title: "Rule" },
+    {
+      key: "message" as keyof Alert,
+      title: "Message",
+      render: (value: string) => <span className="text-sm">{value}</span>,
+    },
+    {
+      key: "startedAt" as keyof Alert,
+      title: "Started",
+      render: (value: string) => new Date(value).toLocaleString(),
+    },
+    {
+      key: "resolvedAt" as keyof Alert,
+      title: "Resolved",
+      render: (value?: string) =>
+        value ? new Date(value).toLocaleString() : "",
+    },
+  ];
+
+  const ruleColumns = [
+    { key: "name" as keyof AlertRule, title: "Name" },
+    {
+      key: "type" as keyof AlertRule,
+      title: "Condition",
+      render: (value: string, row: AlertRule) => {
+        if (value === "error_rate") {
+          return `Error rate above ${(row.threshold * 100).toFixed(1)}% over ${row.windowMinutes} min`;
+        } else if (value === "no_input") {
+          return `No input for ${row.windowMinutes} min`;
+        }
+        return ruleTypeLabel(value);
+      },
+    },
+    {
+      key: "flowId" as keyof AlertRule,
+      title: "Flow",
+      render: (value: string) => flowLabel(value) || "All flows",
+    },
+    {
+      key: "channelIds" as keyof AlertRule,
+      title: "Channels",
+      render: (value: string[]) =>
+        value
+          .map((id) => channels.find((c) => c.id === id)?.name || `#${id}`)
+          .join(", "),
+    },
+    {
+      key: "enabled" as keyof AlertRule,
+      title: "Enabled",
+      render: (value: boolean) => (
+        <Badge variant="outline">{value ? "enabled" : "disabled"}</Badge>
+      ),
+    },
+  ];
+
+  const channelColumns = [
+    { key: "name" as keyof AlertChannel, title: "Name" },
+    {
+      key: "type" as keyof AlertChannel,
+      title: "Type",
+      render: (value: string) =>
+        CHANNEL_TYPES.find((t) => t.value === value)?.label || value,
+    },
+    {
+      key: "url" as keyof AlertChannel,
+      title: "Destination",
+      render: (value: string, row: AlertChannel) => (
+        <span className="font-mono text-xs">
+          {row.type === "email" ? row.recipients.join(", ") : value}
+        </span>
+      ),
+    },
+  ];
+
+  const silenceColumns = [
+    {
+      key: "ruleId" as keyof AlertSilence,
+      title: "Rule",
+      render: (value: string) =>
+        value === "0"
+          ? "Any"
+          : rules.find((r) => r.id === value)?.name || `#${value}`,
+    },
+    {
+      key: "flowId" as keyof AlertSilence,
+      title: "Flow",
+      render: (value: string) => flowLabel(value) || "Any",
+    },
+    {
+      key: "workerId" as keyof AlertSilence,
+      title: "Worker",
+      render: (value: string) => value || "Any",
+    },
+    { key: "comment" as keyof AlertSilence, title: "Comment" },
+    {
+      key: "startsAt" as keyof AlertSilence,
+      title: "Starts",
+      render: (value: string) => new Date(value).toLocaleString(),
+    },
+    {
+      key: "endsAt" as keyof AlertSilence,
+      title: "Ends",
+      render: (value: string) => new Date(value).toLocaleString(),
+    },
+  ];
+
+  const flowOptions = flows.map((flow) => (
+    <SelectItem key={flow.parentID || flow.id} value={flow.parentID || flow.id}>
+      {flow.name}
+    </SelectItem>
+  ));
+
+  return (
+    <div className="p-6">
+      <div className="flex items-center justify-between mb-6">
+        <div>
+          <h1 className="text-2xl font-bold">Alerts</h1>
+          <p className="text-muted-foreground">
+            Alert rules evaluated on the coordinator, the channels they notify
+            and their history
+          </p>
+        </div>
+      </div>
+
+      <Tabs value={tab} onValueChange={setTab}>
+        <TabsList className="mb-4">
+          <TabsTrigger value="history">History</TabsTrigger>
+          <TabsTrigger value="rules">Rules</TabsTrigger>
+          <TabsTrigger value="channels">Channels</TabsTrigger>
+          <TabsTrigger value="silences">Silences</TabsTrigger>
+        </TabsList>
+
+        <TabsContent value="history">
+          <Card>
+            <CardHeader className="flex flex-row items-center justify-between">
+              <CardTitle>Alerts ({total})</CardTitle>
+              <Tabs
+                value={status}
+                onValueChange={(value) => {
+                  setStatus(value);
+                  setOffset(0);
+                }}
+              >
+                <TabsList>
+                  <TabsTrigger value="firing">Firing</TabsTrigger>
+                  <TabsTrigger value="resolved">Resolved</TabsTrigger>
+                  <TabsTrigger value="all">All</TabsTrigger>
+                </TabsList>
+              </Tabs>
+            </CardHeader>
+            <CardContent>
+              <DataTable
+                data={alerts}
+                columns={alertColumns}
+                additionalActions={(alert) =>
+                  alert.status === "firing" && (
+                    <Button
+                      variant="ghost"
+                      size="icon"
+                      title="Silence"
+                      onClick={() =>
+                        setSilenceForm({
+                          ...emptySilence,
+                          ruleId: alert.ruleId,
+                          flowId: alert.flowId,
+                          workerId: alert.flowId === "0" ? alert.workerId : "",
+                        })
+                      }
+                    >
+                      <BellOff className="h-4 w-4" />
+                    </Button>
+                  )
+                }
+              />
+              <div className="flex items-center justify-end gap-2 mt-4">
+                <Button
+                  variant="outline"
+                  size="sm"
+                  disabled={offset === 0}
+                  onClick={() => setOffset(Math.max(0, offset - PAGE_SIZE))}
+                >
+                  Previous
+                </Button>
+                <Button
+                  variant="outline"
+                  size="sm"
+                  disabled={offset + PAGE_SIZE >= total}
+                  onClick={() => setOffset(offset + PAGE_SIZE)}
+                >
+                  Next
+                </Button>
+              </div>
+            </CardContent>
+          </Card>
+        </TabsContent>
+
+        <TabsContent value="rules">
+          <Card>
+            <CardHeader className="flex flex-row items-center justify-between">
+              <CardTitle>Rules ({rules.length})</CardTitle>
+              <Button
+                onClick={() => {
+                  setEditingRuleId(null);
+                  setRuleForm(emptyRule);
+                }}
+              >
+                <Plus className="mr-2 h-4 w-4" />
+                Add Rule
+              </Button>
+            </CardHeader>
+            <CardContent>
+              <DataTable
+                data={rules}
+                columns={ruleColumns}
+                onEdit={(rule) => {
+                  setEditingRuleId(rule.id);
+                  setRuleForm({
+                    name: rule.name,
+                    type: rule.type,
+                    flowId: rule.flowId,
+                    threshold: rule.threshold,
+                    windowMinutes: rule.windowMinutes,
+                    channelIds: rule.channelIds,
+                    enabled: rule.enabled,
+                  });
+                }}
+                onDelete={(rule) =>
+                  setToDelete({
+                    kind: "rule",
+                    id: rule.id,
+                    name: `Alert rule "${rule.name}"`,
+                  })
+                }
+              />
+            </CardContent>
+          </Card>
+        </TabsContent>
+
+        <TabsContent value="channels">
+          <Card>
+            <CardHeader className="flex flex-row items-center justify-between">
+              <CardTitle>Channels ({channels.length})</CardTitle>
+              <Button
+                onClick={() => {
+                  setEditingChannelId(null);
+                  setChannelForm(emptyChannel);
+                }}
+              >
+                <Plus className="mr-2 h-4 w-4" />
+                Add Channel
+              </Button>
+            </CardHeader>
+            <CardContent>
+              <DataTable
+                data={channels}
+                columns={channelColumns}
+                onEdit={(channel) => {
+                  setEditingChannelId(channel.id);
+                  setChannelForm({
+                    name: channel.name,
+                    type: channel.type,
+                    url: channel.url,
+                    recipients: channel.recipients,
+                  });
+                }}
+                onDelete={(channel) =>
+                  setToDelete({
+                    kind: "channel",
+                    id: channel.id,
+                    name: `Alert channel "${channel.name}"`,
+                  })
+                }
+                additionalActions={(channel) => (
+                  <Button
+                    variant="ghost"
+                    size="icon"
+                    title="Send test notification"
+                    onClick={() => handleTestChannel(channel)}
+                  >
+                    <Send className="h-4 w-4" />
+                  </Button>
+                )}
+              />
+            </CardContent>
+          </Card>
+        </TabsContent>
+
+        <TabsContent value="silences">
+          <Card>
+            <CardHeader className="flex flex-row items-center justify-between">
+              <CardTitle>Silences ({silences.length})</CardTitle>
+              <div className="flex items-center gap-4">
+                <div className="flex items-center gap-2">
+                  <Switch
+                    id="include-expired"
+                    checked={includeExpired}
+                    onCheckedChange={setIncludeExpired}
+                  />
+                  <Label htmlFor="include-expired">Show expired</Label>
+                </div>
+                <Button onClick={() => setSilenceForm(emptySilence)}>
+                  <Plus className="mr-2 h-4 w-4" />
+                  Add Silence
+                </Button>
+              </div>
+            </CardHeader>
+            <CardContent>
+              <DataTable
+                data={silences}
+                columns={silenceColumns}
+                onDelete={(silence) =>
+                  setToDelete({
+                    kind: "silence",
+                    id: silence.id,
+                    name: `Silence #${silence.id}`,
+                  })
+                }
+              />
+            </CardContent>
+          </Card>
+        </TabsContent>
+      </Tabs>
+
+      <Dialog
+        open={ruleForm !== null}
+        onOpenChange={(open) => !open && setRuleForm(null)}
+      >
+        <DialogContent className="sm:max-w-md">
+          <DialogHeader>
+            <DialogTitle>
+              {editingRuleId ? "Edit Alert Rule" : "Add Alert Rule"}
+            </DialogTitle>
+          </DialogHeader>
+          {ruleForm && (
+            <form onSubmit={handleSaveRule} className="space-y-4">
+              <div className="space-y-2">
+                <Label htmlFor="rule-name">Name</Label>
+                <Input
+                  id="rule-name"
+                  value={ruleForm.name}
+                  onChange={(e) =>
+                    setRuleForm({ ...ruleForm, name: e.target.value })
+                  }
+                  required
+                />
+              </div>
+              <div className="space-y-2">
+                <Label>Condition</Label>
+                <Select
+                  value={ruleForm.type}
+                  onValueChange={(type) =>
+                    setRuleForm({
+                      ...ruleForm,
+                      type,
+                      flowId: type === "worker_lost" ? "0" : ruleForm.flowId,
+                    })
+                  }
+                >
+                  <SelectTrigger>
+                    <SelectValue />
+                  </SelectTrigger>
+                  <SelectContent>
+                    {RULE_TYPES.map((type) => (
+                      <SelectItem key={type.value} value={type.value}>
+                        {type.label}
+                      </SelectItem>
+                    ))}
+                  </SelectContent>
+                </Select>
+              </div>
+              {ruleForm.type !== "worker_lost" && (
+                <div className="space-y-2">
+                  <Label>Flow</Label>
+                  <Select
+                    value={ruleForm.flowId}
+                    onValueChange={(flowId) =>
+                      setRuleForm({ ...ruleForm, flowId })
+                    }
+                  >
+                    <SelectTrigger>
+                      <SelectValue />
+                    </SelectTrigger>
+                    <SelectContent>
+                      <SelectItem value="0">All flows</SelectItem>
+                      {flowOptions}
+                    </SelectContent>
+                  </Select>
+                </div>
+              )}
+              {ruleForm.type === "error_rate" && (
+                <div className="space-y-2">
+                  <Label htmlFor="rule-threshold">Threshold (%)</Label>
+                  <Input
+                    id="rule-threshold"
+                    type="number"
+                    min={0}
+                    max={100}
+                    step={0.1}
+                    value={ruleForm.threshold * 100}
+                    onChange={(e) =>
+                      setRuleForm({
+                        ...ruleForm,
+                        threshold: Number(e.target.value) / 100,
+                      })
+                    }
+                  />
+                  <p className="text-xs text-muted-foreground">
+                    Share of input messages with processor errors
+                  </p>
+                </div>
+              )}
+              {isWindowed(ruleForm.type) && (
+                <div className="space-y-2">
+                  <Label htmlFor="rule-window">Window (minutes)</Label>
+                  <Input
+                    id="rule-window"
+                    type="number"
+                    min={1}
+                    max={1440}
+                    value={ruleForm.windowMinutes}
+                    onChange={(e) =>
+                      setRuleForm({
+                        ...ruleForm,
+                        windowMinutes: Number(e.target.value),
+                      })
+                    }
+                    required
+                  />
+                </div>
+              )}
+              <div className="space-y-2">
+                <Label>Channels</Label>
+                {channels.length === 0 ? (
+                  <p className="text-xs text-muted-foreground">
+                    No channels yet, alerts of this rule are only recorded
+                  </p>
+                ) : (
+                  channels.map((channel) => (
+                    <div key={channel.id} className="flex items-center gap-2">
+                      <Checkbox
+                        id={`rule-channel-${channel.id}`}
+                        checked={ruleForm.channelIds.includes(channel.id)}
+                        onCheckedChange={(checked) =>
+                          setRuleForm({
+                            ...ruleForm,
+                            channelIds: checked
+                              ? [...ruleForm.channelIds, channel.id]
+                              : ruleForm.channelIds.filter(
+                                  (id) => id !== channel.id,
+                                ),
+                          })
+                        }
+                      />
+                      <Label htmlFor={`rule-channel-${channel.id}`}>
+                        {channel.name}
+                      </Label>
+                    </div>
+                  ))
+                )}
+              </div>
+              <div className="flex items-center gap-2">
+                <Switch
+                  id="rule-enabled"
+                  checked={ruleForm.enabled}
+                  onCheckedChange={(enabled) =>
+                    setRuleForm({ ...ruleForm, enabled })
+                  }
+                />
+                <Label htmlFor="rule-enabled">Enabled</Label>
+              </div>
+              <DialogFooter>
+                <Button
+                  type="button"
+                  variant="outline"
+                  onClick={() => setRuleForm(null)}
+                >
+                  Cancel
+                </Button>
+                <Button type="submit">Save</Button>
+              </DialogFooter>
+            </form>
+          )}
+        </DialogContent>
+      </Dialog>
+
+      <Dialog
+        open={channelForm !== null}
+        onOpenChange={(open) => !open && setChannelForm(null)}
+      >
+        <DialogContent className="sm:max-w-md">
+          <DialogHeader>
+            <DialogTitle>
+              {editingChannelId ? "Edit Alert Channel" : "Add Alert Channel"}
+            </DialogTitle>
+          </DialogHeader>
+          {channelForm && (
+            <form onSubmit={handleSaveChannel} className="space-y-4">
+              <div className="space-y-2">
+                <Label htmlFor="channel-name">Name</Label>
+                <Input
+                  id="channel-name"
+                  value={channelForm.name}
+                  onChange={(e) =>
+                    setChannelForm({ ...channelForm, name: e.target.value })
+                  }
+                  required
+                />
+              </div>
+              <div className="space-y-2">
+                <Label>Type</Label>
+                <Select
+                  value={channelForm.type}
+                  onValueChange={(type) =>
+                    setChannelForm({ ...channelForm, type })
+                  }
+                >
+                  <SelectTrigger>
+                    <SelectValue />
+                  </SelectTrigger>
+                  <SelectContent>
+                    {CHANNEL_TYPES.map((type) => (
+                      <SelectItem key={type.value} value={type.value}>
+                        {type.label}
+                      </SelectItem>
+                    ))}
+                  </SelectContent>
+                </Select>
+              </div>
+              {channelForm.type === "email" ? (
+                <div className="space-y-2">
+                  <Label htmlFor="channel-recipients">Recipients</Label>
+                  <Input
+                    id="channel-recipients"
+                    placeholder="ops@example.com, oncall@example.com"
+                    value={channelForm.recipients.join(", ")}
+                    onChange={(e) =>
+                      setChannelForm({
+                        ...channelForm,
+                        recipients: e.target.value
+                          .split(",")
+                          .map((r) => r.trim()),
+                      })
+                    }
+                    required
+                  />
+                </div>
+              ) : (
+                <div className="space-y-2">
+                  <Label htmlFor="channel-url">URL</Label>
+                  <Input
+                    id="channel-url"
+                    type="url"
+                    placeholder={
+                      channelForm.type === "slack"
+                        ? "https://hooks.slack.com/services/..."
+                        : "https://example.com/alerts"
+                    }
+                    value={channelForm.url}
+                    onChange={(e) =>
+                      setChannelForm({ ...channelForm, url: e.target.value })
+                    }
+                    required
+                  />
+                </div>
+              )}
+              <DialogFooter>
+                <Button
+                  type="button"
+                  variant="outline"
+                  onClick={() => setChannelForm(null)}
+                >
+                  Cancel
+                </Button>
+                <Button type="submit">Save</Button>
+              </DialogFooter>
+            </form>
+          )}
+        </DialogContent>
+      </Dialog>
+
+      <Dialog
+        open={silenceForm !== null}
+        onOpenChange={(open) => !open && setSilenceForm(null)}
+      >
+        <DialogContent className="sm:max-w-md">
+          <DialogHeader>
+            <DialogTitle>Add Silence</DialogTitle>
+          </DialogHeader>
+          {silenceForm && (
+            <form onSubmit={handleSaveSilence} className="space-y-4">
+              <div className="space-y-2">
+                <Label>Rule</Label>
+                <Select
+                  value={silenceForm.ruleId}
+                  onValueChange={(ruleId) =>
+                    setSilenceForm({ ...silenceForm, ruleId })
+                  }
+                >
+                  <SelectTrigger>
+                    <SelectValue />
+                  </SelectTrigger>
+                  <SelectContent>
+                    <SelectItem value="0">Any rule</SelectItem>
+                    {rules.map((rule) => (
+                      <SelectItem key={rule.id} value={rule.id}>
+                        {rule.name}
+                      </SelectItem>
+                    ))}
+                  </SelectContent>
+                </Select>
+              </div>
+              <div className="space-y-2">
+                <Label>Flow</Label>
+                <Select
+                  value={silenceForm.flowId}
+                  onValueChange={(flowId) =>
+                    setSilenceForm({ ...silenceForm, flowId })
+                  }
+                >
+                  <SelectTrigger>
+                    <SelectValue />
+                  </SelectTrigger>
+                  <SelectContent>
+                    <SelectItem value="0">Any flow</SelectItem>
+                    {flowOptions}
+                  </SelectContent>
+                </Select>
+              </div>
+              <div className="space-y-2">
+                <Label htmlFor="silence-worker">Worker</Label>
+                <Input
+                  id="silence-worker"
+                  placeholder="Any worker"
+                  value={silenceForm.workerId}
+                  onChange={(e) =>
+                    setSilenceForm({ ...silenceForm, workerId: e.target.value })
+                  }
+                />
+              </div>
+              <div className="space-y-2">
+                <Label>Duration</Label>
+                <Select
+                  value={silenceForm.minutes}
+                  onValueChange={(minutes) =>
+                    setSilenceForm({ ...silenceForm, minutes })
+                  }
+                >
+                  <SelectTrigger>
+                    <SelectValue />
+                  </SelectTrigger>
+                  <SelectContent>
+                    {SILENCE_DURATIONS.map((duration) => (
+                      <SelectItem key={duration.value} value={duration.value}>
+                        {duration.label}
+                      </SelectItem>
+                    ))}
+                  </SelectContent>
+                </Select>
+              </div>
+              <div className="space-y-2">
+                <Label htmlFor="silence-comment">Comment</Label>
+                <Input
+                  id="silence-comment"
+                  placeholder="e.g. Planned maintenance"
+                  value={silenceForm.comment}
+                  onChange={(e) =>
+                    setSilenceForm({ ...silenceForm, comment: e.target.value })
+                  }
+                />
+              </div>
+              <DialogFooter>
+                <Button
+                  type="button"
+                  variant="outline"
+                  onClick={() => setSilenceForm(null)}
+                >
+                  Cancel
+                </Button>
+                <Button type="submit">Silence</Button>
+              </DialogFooter>
+            </form>
+          )}
+        </DialogContent>
+      </Dialog>
+
+      <AlertDialog
+        open={toDelete !== null}
+        onOpenChange={(open) => !open && setToDelete(null)}
+      >
+        <AlertDialogContent>
+          <AlertDialogHeader>
+            <AlertDialogTitle>Delete</AlertDialogTitle>
+            <AlertDialogDescription>
+              {toDelete?.name} will be deleted. This action cannot be undone.
+            </AlertDialogDescription>
+          </AlertDialogHeader>
+          <AlertDialogFooter>
+            <AlertDialogCancel>Cancel</AlertDialogCancel>
+            <AlertDialogAction onClick={confirmDelete}>Delete</AlertDialogAction>
+          </AlertDialogFooter>
+        </AlertDialogContent>
+      </AlertDialog>
+    </div>
+  );
+}
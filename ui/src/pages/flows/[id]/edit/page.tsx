@@ -9,6 +9,7 @@ import {
   componentLists 
 } from "@/lib/component-schemas";
 import type { AllComponentSchemas } from "@/components/flow-builder/node-config-panel";
+import type { EventSettings } from "@/lib/entities";
 
 // Define StreamNodeData type locally since the file was deleted
 export interface StreamNodeData {
@@ -63,6 +64,10 @@ export default function EditStreamPage() {
     name: string;
     status: string;
     bufferId?: number;
+    slug?: string;
+    route?: string;
+    routeMethods?: string[];
+    eventSettings?: EventSettings;
     nodes: StreamNodeData[];
     builderState?: string;
   } | null>(null);
@@ -133,6 +138,10 @@ export default function EditStreamPage() {
           name: streamResponse.name,
           status: streamResponse.status,
           bufferId: streamResponse.buffer_id,
+          slug: streamResponse.slug,
+          route: streamResponse.route,
+          routeMethods: streamResponse.route_methods,
+          eventSettings: streamResponse.event_settings,
           nodes,
           builderState: streamResponse.builder_state,
         });
@@ -186,7 +195,7 @@ export default function EditStreamPage() {
     return tryFlow(data);
   };
 
-  const handleSaveStream = async (data: { name: string; status: string; bufferId?: number; nodes: StreamNodeData[]; builderState: string; isReady: boolean }) => {
+  const handleSaveStream = async (data: { name: string; status: string; bufferId?: number; slug: string; route: string; routeMethods: string[]; eventSettings: EventSettings; nodes: StreamNodeData[]; builderState: string; isReady: boolean }) => {
     setIsSubmitting(true);
 
     try {
@@ -236,6 +245,10 @@ export default function EditStreamPage() {
         output_label: outputNode.label,
         output_config: outputNode.configYaml || "",
         buffer_id: data.bufferId,
+        slug: data.slug,
+        route: data.route,
+        route_methods: data.routeMethods,
+        event_settings: data.eventSettings,
         is_ready: data.isReady,
         builder_state: data.builderState,
         processors: processors
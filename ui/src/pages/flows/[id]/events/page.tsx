@@ -6,6 +6,7 @@ import {
   ChevronRight,
   ChevronDown,
   Loader2,
+  Radio,
 } from "lucide-react";
 import { Button } from "@/components/ui/button";
 import {
@@ -21,8 +22,14 @@ import {
   PopoverTrigger,
 } from "@/components/ui/popover";
 import { Badge } from "@/components/ui/badge";
-import { fetchFlowEvents, fetchStream } from "@/lib/api";
-import { FlowEvent } from "@/lib/entities";
+import { Input } from "@/components/ui/input";
+import {
+  fetchFlowEvents,
+  fetchStream,
+  fetchTrace,
+  tailFlowEvents,
+} from "@/lib/api";
+import { FlowEvent, FlowEventFilters } from "@/lib/entities";
 
 const TIME_RANGES = [
   { label: "Last 15 minutes", value: "15" },
@@ -38,6 +45,15 @@ const TIME_RANGES = [
 
 const PAGE_SIZE = 50;
 
+const SECTIONS = ["input", "pipeline", "output"];
+
+const EVENT_TYPES = ["PRODUCE", "CONSUME", "DELETE", "ERROR", "UNKNOWN"];
+
+const FILTER_DEBOUNCE_MS = 400;
+
+// Live events kept on the page, the oldest are discarded beyond it.
+const LIVE_MAX_EVENTS = 2000;
+
 const SECTION_ORDER: Record<string, number> = {
   input: 0,
   pipeline: 1,
@@ -129,18 +145,34 @@ function formatTimestamp(ts: string): string {
   return d.toLocaleTimeString("en-US", opts);
 }
 
-function FlowRow({ group }: { group: FlowGroup }) {
+function FlowRow({ group, filtered }: { group: FlowGroup; filtered: boolean }) {
   const [expanded, setExpanded] = useState(false);
+  const [traceEvents, setTraceEvents] = useState<FlowEvent[] | null>(null);
+  const [traceLoading, setTraceLoading] = useState(false);
   const inputEvent = group.events.find((e) => e.section === "input");
   const displayEvent = inputEvent || group.firstEvent;
   const borderClass = group.events.some((e) => e.type === "ERROR")
     ? "border-l-red-500"
     : typeBorderColor(displayEvent.type);
+  // Filtered rows only carry the matching events, the whole journey is loaded on expand.
+  const journey = traceEvents ?? group.events;
+
+  const toggle = () => {
+    const next = !expanded;
+    setExpanded(next);
+    if (next && filtered && traceEvents === null && !traceLoading) {
+      setTraceLoading(true);
+      fetchTrace(group.traceId)
+        .then((trace) => setTraceEvents(trace.timeline.flatMap((component) => component.events)))
+        .catch(() => setTraceEvents(group.events))
+        .finally(() => setTraceLoading(false));
+    }
+  };
 
   return (
     <div className="border-b border-gray-800 last:border-b-0">
       <button
-        onClick={() => setExpanded(!expanded)}
+        onClick={toggle}
         className={`w-full text-left px-4 py-2.5 hover:bg-gray-800/50 transition-colors flex items-center gap-3 border-l-2 ${borderClass} min-w-0`}
       >
         <span className="text-gray-500 flex-shrink-0">
@@ -167,13 +199,20 @@ function FlowRow({ group }: { group: FlowGroup }) {
           variant="outline"
           className="text-[10px] px-1.5 py-0 border-gray-700 text-gray-400 flex-shrink-0"
         >
-          {group.events.length} event{group.events.length !== 1 ? "s" : ""}
+          {group.events.length} {filtered ? "matching " : ""}event{group.events.length !== 1 ? "s" : ""}
         </Badge>
       </button>
 
-      {expanded && (
+      {expanded && traceLoading && (
+        <div className="flex items-center justify-center py-3 text-gray-500 text-xs">
+          <Loader2 className="h-3.5 w-3.5 animate-spin mr-2" />
+          Loading message journey...
+        </div>
+      )}
+
+      {expanded && !traceLoading && (
         <div className="bg-gray-900/50 border-l-2 border-l-gray-700 py-2 overflow-hidden">
-          {group.events.map((event, idx) => (
+          {journey.map((event, idx) => (
             <div key={event.id}>
               {idx > 0 && (
                 <div className="flex justify-center py-1">
@@ -234,6 +273,14 @@ export default function FlowEventsPage() {
   const [customStart, setCustomStart] = useState("");
   const [customEnd, setCustomEnd] = useState("");
   const [customOpen, setCustomOpen] = useState(false);
+  const [section, setSection] = useState("all");
+  const [eventType, setEventType] = useState("all");
+  const [componentInput, setComponentInput] = useState("");
+  const [traceInput, setTraceInput] = useState("");
+  const [searchInput, setSearchInput] = useState("");
+  const [filters, setFilters] = useState<FlowEventFilters>({});
+  const [live, setLive] = useState(false);
+  const [liveDropped, setLiveDropped] = useState(0);
   const [error, setError] = useState<string | null>(null);
   const scrollRef = useRef<HTMLDivElement>(null);
 
@@ -316,6 +363,7 @@ export default function FlowEventsPage() {
           offset,
           startTime,
           endTime,
+          ...filters,
         });
 
         if (reset) {
@@ -335,9 +383,51 @@ export default function FlowEventsPage() {
         setLoadingMore(false);
       }
     },
-    [id, loadedFlowPages, timeRange, getTimeParams],
+    [id, loadedFlowPages, timeRange, getTimeParams, filters],
   );
 
+  useEffect(() => {
+    const timer = setTimeout(() => {
+      const next: FlowEventFilters = {
+        section: section === "all" ? undefined : section,
+        type: eventType === "all" ? undefined : eventType,
+        componentLabel: componentInput.trim() || undefined,
+        traceId: traceInput.trim() || undefined,
+        search: searchInput.trim() || undefined,
+      };
+      setFilters((prev) =>
+        JSON.stringify(prev) === JSON.stringify(next) ? prev : next,
+      );
+    }, FILTER_DEBOUNCE_MS);
+    return () => clearTimeout(timer);
+  }, [section, eventType, componentInput, traceInput, searchInput]);
+
+  const filtered = Object.values(filters).some((value) => value !== undefined);
+
+  useEffect(() => {
+    if (!id || !live) return;
+
+    const controller = new AbortController();
+    setEvents([]);
+    setLiveDropped(0);
+    setError(null);
+    tailFlowEvents(
+      id,
+      filters,
+      (event, dropped) => {
+        setEvents((prev) => [event, ...prev].slice(0, LIVE_MAX_EVENTS));
+        if (dropped > 0) setLiveDropped((prev) => prev + dropped);
+      },
+      controller.signal,
+    ).catch((err) => {
+      if (controller.signal.aborted) return;
+      setError("Live tail disconnected");
+      setLive(false);
+      console.error(err);
+    });
+    return () => controller.abort();
+  }, [id, live, filters]);
+
   useEffect(() => {
     if (!id) return;
     fetchStream(id)
@@ -346,19 +436,20 @@ export default function FlowEventsPage() {
   }, [id]);
 
   useEffect(() => {
+    if (live) return;
     loadEvents(true);
-  }, [id, timeRange]);
+  }, [id, timeRange, filters, live]);
 
   const handleScroll = useCallback(() => {
     const el = scrollRef.current;
-    if (!el || loadingMore || loading) return;
+    if (!el || loadingMore || loading || live) return;
     if (loadedFlowPages * PAGE_SIZE >= totalFlows) return;
 
     const { scrollTop, scrollHeight, clientHeight } = el;
     if (scrollHeight - scrollTop - clientHeight < 100) {
       loadEvents(false);
     }
-  }, [loadingMore, loading, loadedFlowPages, totalFlows, loadEvents]);
+  }, [loadingMore, loading, loadedFlowPages, totalFlows, loadEvents, live]);
 
   useEffect(() => {
     const el = scrollRef.current;
@@ -389,14 +480,25 @@ export default function FlowEventsPage() {
         </div>
         <div className="flex items-center gap-3">
           <span className="text-sm text-muted-foreground">
-            {totalFlows} flows
+            {live
+              ? `${flowGroups.length} flows${liveDropped > 0 ? `, ${liveDropped} events missed` : ""}`
+              : `${totalFlows} flows`}
           </span>
+          <Button
+            variant={live ? "default" : "outline"}
+            onClick={() => setLive(!live)}
+            className="flex items-center gap-2"
+          >
+            <Radio className={`h-4 w-4 ${live ? "animate-pulse" : ""}`} />
+            Live
+          </Button>
           <Popover open={customOpen} onOpenChange={setCustomOpen}>
             <PopoverTrigger asChild>
               <div>
                 <Select
                   value={timeRange === "custom" ? "custom" : timeRange}
                   onValueChange={handleTimeRangeChange}
+                  disabled={live}
                 >
                   <SelectTrigger className={timeRange === "custom" ? "w-[320px]" : "w-[180px]"}>
                     {timeRange === "custom" ? (
@@ -453,11 +555,58 @@ export default function FlowEventsPage() {
         </div>
       </div>
 
+      <div className="flex items-center gap-3 mb-4 flex-shrink-0 flex-wrap">
+        <Input
+          className="w-64"
+          placeholder="Search content and metadata"
+          value={searchInput}
+          onChange={(e) => setSearchInput(e.target.value)}
+        />
+        <Select value={section} onValueChange={setSection}>
+          <SelectTrigger className="w-[140px]">
+            <SelectValue />
+          </SelectTrigger>
+          <SelectContent>
+            <SelectItem value="all">All sections</SelectItem>
+            {SECTIONS.map((value) => (
+              <SelectItem key={value} value={value}>
+                {value}
+              </SelectItem>
+            ))}
+          </SelectContent>
+        </Select>
+        <Select value={eventType} onValueChange={setEventType}>
+          <SelectTrigger className="w-[140px]">
+            <SelectValue />
+          </SelectTrigger>
+          <SelectContent>
+            <SelectItem value="all">All types</SelectItem>
+            {EVENT_TYPES.map((value) => (
+              <SelectItem key={value} value={value}>
+                {value}
+              </SelectItem>
+            ))}
+          </SelectContent>
+        </Select>
+        <Input
+          className="w-48"
+          placeholder="Component label"
+          value={componentInput}
+          onChange={(e) => setComponentInput(e.target.value)}
+        />
+        <Input
+          className="w-72 font-mono"
+          placeholder="Trace ID"
+          value={traceInput}
+          onChange={(e) => setTraceInput(e.target.value)}
+        />
+      </div>
+
       <div
         ref={scrollRef}
         className="flex-1 min-h-0 min-w-0 overflow-y-auto overflow-x-hidden rounded-lg border border-gray-800 bg-gray-950 font-mono text-sm"
       >
-        {loading ? (
+        {loading && !live ? (
           <div className="flex items-center justify-center h-32 text-gray-500">
             <Loader2 className="h-5 w-5 animate-spin mr-2" />
             Loading events...
@@ -466,14 +615,21 @@ export default function FlowEventsPage() {
           <div className="flex items-center justify-center h-32 text-red-400">
             {error}
           </div>
+        ) : flowGroups.length === 0 && live ? (
+          <div className="flex items-center justify-center h-32 text-gray-500">
+            <Loader2 className="h-5 w-5 animate-spin mr-2" />
+            Waiting for events...
+          </div>
         ) : flowGroups.length === 0 ? (
           <div className="flex items-center justify-center h-32 text-gray-500">
-            No events found in the selected time range.
+            {filtered
+              ? "No events match the filters in the selected time range."
+              : "No events found in the selected time range."}
           </div>
         ) : (
           <>
             {flowGroups.map((group) => (
-              <FlowRow key={group.traceId} group={group} />
+              <FlowRow key={group.traceId} group={group} filtered={filtered} />
             ))}
             {loadingMore && (
               <div className="flex items-center justify-center py-3 text-gray-500">
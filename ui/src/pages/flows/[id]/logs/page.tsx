@@ -0,0 +1,463 @@
+import { useEffect, useState, useRef, useCallback } from "react";
+import { useParams, useNavigate } from "react-router-dom";
+import { ArrowLeft, Loader2, Radio } from "lucide-react";
+import { Button } from "@/components/ui/button";
+import {
+  Select,
+  SelectContent,
+  SelectItem,
+  SelectTrigger,
+  SelectValue,
+} from "@/components/ui/select";
+import {
+  Popover,
+  PopoverContent,
+  PopoverTrigger,
+} from "@/components/ui/popover";
+import { Badge } from "@/components/ui/badge";
+import { Input } from "@/components/ui/input";
+import { fetchFlowLogs, fetchStream, tailFlowLogs } from "@/lib/api";
+import { FlowLog, FlowLogFilters } from "@/lib/entities";
+
+const TIME_RANGES = [
+  { label: "Last 15 minutes", value: "15" },
+  { label: "Last 30 minutes", value: "30" },
+  { label: "Last 1 hour", value: "60" },
+  { label: "Last 6 hours", value: "360" },
+  { label: "Last 1 day", value: "1440" },
+  { label: "Last 2 days", value: "2880" },
+  { label: "Last 3 days", value: "4320" },
+  { label: "Last 1 week", value: "10080" },
+  { label: "Custom", value: "custom" },
+];
+
+const PAGE_SIZE = 100;
+
+const LEVELS = ["trace", "debug", "info", "warn", "error", "fatal", "panic"];
+
+const FILTER_DEBOUNCE_MS = 400;
+
+// Live log lines kept on the page, the oldest are discarded beyond it.
+const LIVE_MAX_LOGS = 2000;
+
+function levelColor(level: string): string {
+  switch (level) {
+    case "trace":
+    case "debug":
+      return "bg-gray-500/20 text-gray-400 border-gray-500/30";
+    case "info":
+      return "bg-blue-500/20 text-blue-400 border-blue-500/30";
+    case "warn":
+      return "bg-yellow-500/20 text-yellow-400 border-yellow-500/30";
+    default:
+      return "bg-red-500/20 text-red-400 border-red-500/30";
+  }
+}
+
+function formatTimestamp(ts: string): string {
+  const d = new Date(ts);
+  const opts: Intl.DateTimeFormatOptions & { fractionalSecondDigits?: number } =
+    {
+      hour12: false,
+      hour: "2-digit",
+      minute: "2-digit",
+      second: "2-digit",
+      fractionalSecondDigits: 3,
+    };
+  return d.toLocaleTimeString("en-US", opts);
+}
+
+function formatFields(fields: Record<string, any>): string {
+  return Object.entries(fields)
+    .map(
+      ([key, value]) =>
+        `${key}=${typeof value === "string" ? value : JSON.stringify(value)}`,
+    )
+    .join(" ");
+}
+
+function LogRow({ line }: { line: FlowLog }) {
+  const fields = formatFields(line.fields);
+
+  return (
+    <div className="px-4 py-1.5 border-b border-gray-800 last:border-b-0 hover:bg-gray-800/50 flex items-start gap-3 min-w-0">
+      <span className="text-gray-500 font-mono text-xs flex-shrink-0 pt-0.5">
+        {formatTimestamp(line.created_at)}
+      </span>
+      <Badge
+        variant="outline"
+        className={`text-[10px] px-1.5 py-0 flex-shrink-0 w-12 justify-center ${levelColor(line.level)}`}
+      >
+        {line.level}
+      </Badge>
+      <span
+        className="text-cyan-400 font-mono text-xs flex-shrink-0 max-w-[10rem] truncate pt-0.5"
+        title={`Worker flow ${line.worker_flow_id}`}
+      >
+        {line.worker_id}
+      </span>
+      <div className="flex-1 min-w-0 font-mono text-xs pt-0.5 break-all whitespace-pre-wrap">
+        <span className="text-gray-300">{line.message}</span>
+        {fields && <span className="text-gray-500"> {fields}</span>}
+      </div>
+    </div>
+  );
+}
+
+export default function FlowLogsPage() {
+  const { id } = useParams<{ id: string }>();
+  const navigate = useNavigate();
+  const [streamName, setStreamName] = useState<string>("");
+  const [logs, setLogs] = useState<FlowLog[]>([]);
+  const [total, setTotal] = useState(0);
+  const [loadedPages, setLoadedPages] = useState(0);
+  const [loading, setLoading] = useState(true);
+  const [loadingMore, setLoadingMore] = useState(false);
+  const [timeRange, setTimeRange] = useState("30");
+  const [customStart, setCustomStart] = useState("");
+  const [customEnd, setCustomEnd] = useState("");
+  const [customOpen, setCustomOpen] = useState(false);
+  const [level, setLevel] = useState("all");
+  const [workerInput, setWorkerInput] = useState("");
+  const [searchInput, setSearchInput] = useState("");
+  const [filters, setFilters] = useState<FlowLogFilters>({});
+  const [live, setLive] = useState(false);
+  const [liveDropped, setLiveDropped] = useState(0);
+  const [error, setError] = useState<string | null>(null);
+  const scrollRef = useRef<HTMLDivElement>(null);
+
+  const toLocalISO = (d: Date): string => {
+    const pad = (n: number, len = 2) => n.toString().padStart(len, "0");
+    return `${d.getFullYear()}-${pad(d.getMonth() + 1)}-${pad(d.getDate())}T${pad(d.getHours())}:${pad(d.getMinutes())}:${pad(d.getSeconds())}.${pad(d.getMilliseconds(), 3)}Z`;
+  };
+
+  const toDatetimeLocal = (d: Date): string => {
+    const pad = (n: number) => n.toString().padStart(2, "0");
+    return `${d.getFullYear()}-${pad(d.getMonth() + 1)}-${pad(d.getDate())}T${pad(d.getHours())}:${pad(d.getMinutes())}`;
+  };
+
+  const getTimeParams = useCallback(
+    () => {
+      if (timeRange === "custom") {
+        return {
+          startTime: toLocalISO(new Date(customStart)),
+          endTime: toLocalISO(new Date(customEnd)),
+        };
+      }
+      const end = new Date();
+      const start = new Date(end.getTime() - parseInt(timeRange) * 60 * 1000);
+      return {
+        startTime: toLocalISO(start),
+        endTime: toLocalISO(end),
+      };
+    },
+    [timeRange, customStart, customEnd],
+  );
+
+  const handleTimeRangeChange = (value: string) => {
+    if (value === "custom") {
+      const now = new Date();
+      const oneHourAgo = new Date(now.getTime() - 60 * 60 * 1000);
+      setCustomStart(toDatetimeLocal(oneHourAgo));
+      setCustomEnd(toDatetimeLocal(now));
+      setCustomOpen(true);
+    } else {
+      setTimeRange(value);
+    }
+  };
+
+  const applyCustomRange = () => {
+    if (customStart && customEnd) {
+      setCustomOpen(false);
+      setTimeRange("custom");
+    }
+  };
+
+  const formatCustomLabel = (): string => {
+    if (!customStart || !customEnd) return "Custom";
+    const fmt = (v: string) => {
+      const d = new Date(v);
+      const pad = (n: number) => n.toString().padStart(2, "0");
+      return `${d.getFullYear()}/${pad(d.getMonth() + 1)}/${pad(d.getDate())} ${pad(d.getHours())}:${pad(d.getMinutes())}`;
+    };
+    return `${fmt(customStart)} – ${fmt(customEnd)}`;
+  };
+
+  const loadLogs = useCallback(
+    async (reset: boolean) => {
+      if (!id) return;
+
+      try {
+        if (reset) {
+          setLoading(true);
+          setLogs([]);
+          setLoadedPages(0);
+        } else {
+          setLoadingMore(true);
+        }
+
+        const currentPage = reset ? 0 : loadedPages;
+        const { startTime, endTime } = getTimeParams();
+
+        const result = await fetchFlowLogs(id, {
+          limit: PAGE_SIZE,
+          offset: currentPage * PAGE_SIZE,
+          startTime,
+          endTime,
+          ...filters,
+        });
+
+        if (reset) {
+          setLogs(result.data);
+          setLoadedPages(1);
+        } else {
+          setLogs((prev) => [...prev, ...result.data]);
+          setLoadedPages((prev) => prev + 1);
+        }
+        setTotal(result.total);
+        setError(null);
+      } catch (err) {
+        setError("Failed to fetch logs");
+        console.error(err);
+      } finally {
+        setLoading(false);
+        setLoadingMore(false);
+      }
+    },
+    [id, loadedPages, getTimeParams, filters],
+  );
+
+  useEffect(() => {
+    const timer = setTimeout(() => {
+      const next: FlowLogFilters = {
+        level: level === "all" ? undefined : level,
+        workerId: workerInput.trim() || undefined,
+        search: searchInput.trim() || undefined,
+      };
+      setFilters((prev) =>
+        JSON.stringify(prev) === JSON.stringify(next) ? prev : next,
+      );
+    }, FILTER_DEBOUNCE_MS);
+    return () => clearTimeout(timer);
+  }, [level, workerInput, searchInput]);
+
+  const filtered = Object.values(filters).some((value) => value !== undefined);
+
+  useEffect(() => {
+    if (!id || !live) return;
+
+    const controller = new AbortController();
+    setLogs([]);
+    setLiveDropped(0);
+    setError(null);
+    tailFlowLogs(
+      id,
+      filters,
+      (line, dropped) => {
+        setLogs((prev) => [line, ...prev].slice(0, LIVE_MAX_LOGS));
+        if (dropped > 0) setLiveDropped((prev) => prev + dropped);
+      },
+      controller.signal,
+    ).catch((err) => {
+      if (controller.signal.aborted) return;
+      setError("Live tail disconnected");
+      setLive(false);
+      console.error(err);
+    });
+    return () => controller.abort();
+  }, [id, live, filters]);
+
+  useEffect(() => {
+    if (!id) return;
+    fetchStream(id)
+      .then((flow) => setStreamName(flow.name))
+      .catch(() => {});
+  }, [id]);
+
+  useEffect(() => {
+    if (live) return;
+    loadLogs(true);
+  }, [id, timeRange, filters, live]);
+
+  const handleScroll = useCallback(() => {
+    const el = scrollRef.current;
+    if (!el || loadingMore || loading || live) return;
+    if (loadedPages * PAGE_SIZE >= total) return;
+
+    const { scrollTop, scrollHeight, clientHeight } = el;
+    if (scrollHeight - scrollTop - clientHeight < 100) {
+      loadLogs(false);
+    }
+  }, [loadingMore, loading, loadedPages, total, loadLogs, live]);
+
+  useEffect(() => {
+    const el = scrollRef.current;
+    if (!el) return;
+    el.addEventListener("scroll", handleScroll);
+    return () => el.removeEventListener("scroll", handleScroll);
+  }, [handleScroll]);
+
+  return (
+    <div className="p-6 flex flex-col h-[calc(100vh-5rem)] max-w-full overflow-hidden">
+      <div className="flex items-center justify-between mb-4 flex-shrink-0">
+        <div className="flex items-center gap-3">
+          <Button
+            variant="ghost"
+            size="icon"
+            onClick={() => navigate("/flows")}
+          >
+            <ArrowLeft className="h-4 w-4" />
+          </Button>
+          <div>
+            <h1 className="text-2xl font-bold">Logs</h1>
+            {streamName && (
+              <p className="text-sm text-muted-foreground">{streamName}</p>
+            )}
+          </div>
+        </div>
+        <div className="flex items-center gap-3">
+          <span className="text-sm text-muted-foreground">
+            {live
+              ? `${logs.length} lines${liveDropped > 0 ? `, ${liveDropped} lines missed` : ""}`
+              : `${total} lines`}
+          </span>
+          <Button
+            variant={live ? "default" : "outline"}
+            onClick={() => setLive(!live)}
+            className="flex items-center gap-2"
+          >
+            <Radio className={`h-4 w-4 ${live ? "animate-pulse" : ""}`} />
+            Live
+          </Button>
+          <Popover open={customOpen} onOpenChange={setCustomOpen}>
+            <PopoverTrigger asChild>
+              <div>
+                <Select
+                  value={timeRange === "custom" ? "custom" : timeRange}
+                  onValueChange={handleTimeRangeChange}
+                  disabled={live}
+                >
+                  <SelectTrigger className={timeRange === "custom" ? "w-[320px]" : "w-[180px]"}>
+                    {timeRange === "custom" ? (
+                      <span className="truncate">
+                        {formatCustomLabel()}
+                      </span>
+                    ) : (
+                      <SelectValue />
+                    )}
+                  </SelectTrigger>
+                  <SelectContent>
+                    {TIME_RANGES.map((range) => (
+                      <SelectItem key={range.value} value={range.value}>
+                        {range.label}
+                      </SelectItem>
+                    ))}
+                  </SelectContent>
+                </Select>
+              </div>
+            </PopoverTrigger>
+            <PopoverContent className="w-80 p-4" align="end">
+              <div className="flex flex-col gap-3">
+                <p className="text-sm font-medium">Custom time range</p>
+                <div className="flex flex-col gap-2">
+                  <label className="text-xs text-muted-foreground">
+                    Start
+                  </label>
+                  <input
+                    type="datetime-local"
+                    value={customStart}
+                    onChange={(e) => setCustomStart(e.target.value)}
+                    className="h-9 w-full rounded-md border border-input bg-background px-3 text-sm ring-offset-background focus-visible:outline-none focus-visible:ring-2 focus-visible:ring-ring"
+                  />
+                </div>
+                <div className="flex flex-col gap-2">
+                  <label className="text-xs text-muted-foreground">End</label>
+                  <input
+                    type="datetime-local"
+                    value={customEnd}
+                    onChange={(e) => setCustomEnd(e.target.value)}
+                    className="h-9 w-full rounded-md border border-input bg-background px-3 text-sm ring-offset-background focus-visible:outline-none focus-visible:ring-2 focus-visible:ring-ring"
+                  />
+                </div>
+                <Button
+                  size="sm"
+                  onClick={applyCustomRange}
+                  disabled={!customStart || !customEnd}
+                >
+                  Apply
+                </Button>
+              </div>
+            </PopoverContent>
+          </Popover>
+        </div>
+      </div>
+
+      <div className="flex items-center gap-3 mb-4 flex-shrink-0 flex-wrap">
+        <Input
+          className="w-64"
+          placeholder="Search messages and fields"
+          value={searchInput}
+          onChange={(e) => setSearchInput(e.target.value)}
+        />
+        <Select value={level} onValueChange={setLevel}>
+          <SelectTrigger className="w-[140px]">
+            <SelectValue />
+          </SelectTrigger>
+          <SelectContent>
+            <SelectItem value="all">All levels</SelectItem>
+            {LEVELS.map((value) => (
+              <SelectItem key={value} value={value}>
+                {value} and above
+              </SelectItem>
+            ))}
+          </SelectContent>
+        </Select>
+        <Input
+          className="w-64 font-mono"
+          placeholder="Worker ID"
+          value={workerInput}
+          onChange={(e) => setWorkerInput(e.target.value)}
+        />
+      </div>
+
+      <div
+        ref={scrollRef}
+        className="flex-1 min-h-0 min-w-0 overflow-y-auto overflow-x-hidden rounded-lg border border-gray-800 bg-gray-950 font-mono text-sm"
+      >
+        {loading && !live ? (
+          <div className="flex items-center justify-center h-32 text-gray-500">
+            <Loader2 className="h-5 w-5 animate-spin mr-2" />
+            Loading logs...
+          </div>
+        ) : error ? (
+          <div className="flex items-center justify-center h-32 text-red-400">
+            {error}
+          </div>
+        ) : logs.length === 0 && live ? (
+          <div className="flex items-center justify-center h-32 text-gray-500">
+            <Loader2 className="h-5 w-5 animate-spin mr-2" />
+            Waiting for logs...
+          </div>
+        ) : logs.length === 0 ? (
+          <div className="flex items-center justify-center h-32 text-gray-500">
+            {filtered
+              ? "No logs match the filters in the selected time range."
+              : "No logs found in the selected time range."}
+          </div>
+        ) : (
+          <>
+            {logs.map((line) => (
+              <LogRow key={line.id} line={line} />
+            ))}
+            {loadingMore && (
+              <div className="flex items-center justify-center py-3 text-gray-500">
+                <Loader2 className="h-4 w-4 animate-spin mr-2" />
+                Loading more...
+              </div>
+            )}
+          </>
+        )}
+      </div>
+    </div>
+  );
+}
@@ -9,6 +9,7 @@ import {
   componentLists
 } from "@/lib/component-schemas";
 import type { AllComponentSchemas } from "@/components/flow-builder/node-config-panel";
+import type { EventSettings } from "@/lib/entities";
 import { Button } from "@/components/ui/button";
 import { Input } from "@/components/ui/input";
 import { Label } from "@/components/ui/label";
@@ -374,7 +375,7 @@ export default function NewStreamPage() {
     return tryFlow(data);
   };
 
-  const handleSaveStream = async (data: { name: string; status: string; bufferId?: number; nodes: StreamNodeData[]; builderState: string; isReady: boolean }) => {
+  const handleSaveStream = async (data: { name: string; status: string; bufferId?: number; slug: string; route: string; routeMethods: string[]; eventSettings: EventSettings; nodes: StreamNodeData[]; builderState: string; isReady: boolean }) => {
     setIsSubmitting(true);
     try {
       const inputNode = data.nodes.find((node) => node.type === "input");
@@ -423,6 +424,10 @@ export default function NewStreamPage() {
         output_label: outputNode.label,
         output_config: outputNode.configYaml || "",
         buffer_id: data.bufferId,
+        slug: data.slug,
+        route: data.route,
+        route_methods: data.routeMethods,
+        event_settings: data.eventSettings,
         is_ready: data.isReady,
         builder_state: data.builderState,
         processors: processors
@@ -1,7 +1,7 @@
 import React, { useEffect, useState, lazy, Suspense } from "react";
 import { Button } from "@/components/ui/button";
 import { DataTable } from "@/components/data-table";
-import { Plus, Eye, Play, Pause, RotateCcw, ScrollText } from "lucide-react";
+import { Plus, Eye, Play, Pause, RotateCcw, ScrollText, FileText } from "lucide-react";
 import { useNavigate } from "react-router-dom";
 import { useToast } from "@/components/toast";
 import {
@@ -162,6 +162,16 @@ export default function FlowsPage() {
               >
                 <ScrollText className="h-4 w-4" />
               </Button>
+              <Button
+                variant="ghost"
+                size="icon"
+                onClick={() =>
+                  navigate(`/flows/${flow.parentID || flow.id}/logs`)
+                }
+                title="Logs"
+              >
+                <FileText className="h-4 w-4" />
+              </Button>
               <Button
                 variant="ghost"
                 size="icon"
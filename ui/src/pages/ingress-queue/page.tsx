@@ -0,0 +1,236 @@
+import { useCallback, useEffect, useState } from "react";
+import { RotateCcw } from "lucide-react";
+import { Card, CardContent, CardHeader, CardTitle } from "@/components/ui/card";
+import { Badge } from "@/components/ui/badge";
+import { Button } from "@/components/ui/button";
+import { Tabs, TabsList, TabsTrigger } from "@/components/ui/tabs";
+import {
+  AlertDialog,
+  AlertDialogAction,
+  AlertDialogCancel,
+  AlertDialogContent,
+  AlertDialogDescription,
+  AlertDialogFooter,
+  AlertDialogHeader,
+  AlertDialogTitle,
+} from "@/components/ui/alert-dialog";
+
+import { QueuedRequest } from "@/lib/entities";
+import { DataTable } from "@/components/data-table";
+import { useToast } from "@/components/toast";
+import {
+  deleteQueuedRequest,
+  fetchQueuedRequests,
+  retryQueuedRequest,
+} from "@/lib/api";
+
+const PAGE_SIZE = 50;
+
+export default function IngressQueuePage() {
+  const { addToast } = useToast();
+  const [status, setStatus] = useState("dead");
+  const [offset, setOffset] = useState(0);
+  const [requests, setRequests] = useState<QueuedRequest[]>([]);
+  const [total, setTotal] = useState(0);
+  const [requestToDelete, setRequestToDelete] = useState<QueuedRequest | null>(
+    null,
+  );
+
+  const loadRequests = useCallback(async () => {
+    try {
+      const result = await fetchQueuedRequests({
+        status,
+        limit: PAGE_SIZE,
+        offset,
+      });
+      setRequests(result.data);
+      setTotal(result.total);
+    } catch (error) {
+      console.error("Error fetching queued requests:", error);
+    }
+  }, [status, offset]);
+
+  useEffect(() => {
+    loadRequests();
+  }, [loadRequests]);
+
+  const handleRetry = async (request: QueuedRequest) => {
+    try {
+      await retryQueuedRequest(request.id);
+      addToast({
+        id: "queued-request-retried",
+        title: "Request Queued",
+        description: `Request #${request.id} will be delivered once flow #${request.flowId} is running.`,
+        variant: "success",
+      });
+      loadRequests();
+    } catch (error) {
+      addToast({
+        id: "queued-request-retry-error",
+        title: "Error Retrying Request",
+        description:
+          error instanceof Error ? error.message : "An unknown error occurred",
+        variant: "error",
+      });
+    }
+  };
+
+  const confirmDelete = async () => {
+    if (!requestToDelete) return;
+
+    try {
+      await deleteQueuedRequest(requestToDelete.id);
+      addToast({
+        id: "queued-request-deleted",
+        title: "Request Deleted",
+        description: `Request #${requestToDelete.id} has been deleted successfully.`,
+        variant: "success",
+      });
+      loadRequests();
+    } catch (error) {
+      addToast({
+        id: "queued-request-delete-error",
+        title: "Error Deleting Request",
+        description:
+          error instanceof Error ? error.message : "An unknown error occurred",
+        variant: "error",
+      });
+    } finally {
+      setRequestToDelete(null);
+    }
+  };
+
+  const columns = [
+    { key: "id" as keyof QueuedRequest, title: "ID" },
+    { key: "flowId" as keyof QueuedRequest, title: "Flow" },
+    {
+      key: "path" as keyof QueuedRequest,
+      title: "Request",
+      render: (value: string, row: QueuedRequest) => (
+        <span className="font-mono text-xs">
+          {row.method} {value}
+          {row.query ? `?${row.query}` : ""}
+        </span>
+      ),
+    },
+    {
+      key: "status" as keyof QueuedRequest,
+      title: "Status",
+      render: (value: string) => {
+        const colorMap: Record<string, string> = {
+          queued:
+            "bg-blue-100 text-blue-800 dark:bg-blue-900 dark:text-blue-300",
+          dead: "bg-red-100 text-red-800 dark:bg-red-900 dark:text-red-300",
+        };
+        return (
+          <Badge className={colorMap[value] || ""} variant="outline">
+            {value}
+          </Badge>
+        );
+      },
+    },
+    { key: "attempts" as keyof QueuedRequest, title: "Attempts" },
+    {
+      key: "lastError" as keyof QueuedRequest,
+      title: "Last Error",
+      render: (value: string) => (
+        <span className="text-xs text-muted-foreground">{value}</span>
+      ),
+    },
+    {
+      key: "createdAt" as keyof QueuedRequest,
+      title: "Received",
+      render: (value: string) => new Date(value).toLocaleString(),
+    },
+  ];
+
+  return (
+    <div className="p-6">
+      <div className="flex items-center justify-between mb-6">
+        <div>
+          <h1 className="text-2xl font-bold">Ingress Queue</h1>
+          <p className="text-muted-foreground">
+            Requests received while their flow was not running, and dead letters
+            that could not be delivered
+          </p>
+        </div>
+      </div>
+
+      <Card>
+        <CardHeader className="flex flex-row items-center justify-between">
+          <CardTitle>
+            {status === "dead" ? "Dead Letters" : "Queued Requests"} ({total})
+          </CardTitle>
+          <Tabs
+            value={status}
+            onValueChange={(value) => {
+              setStatus(value);
+              setOffset(0);
+            }}
+          >
+            <TabsList>
+              <TabsTrigger value="dead">Dead Letters</TabsTrigger>
+              <TabsTrigger value="queued">Queued</TabsTrigger>
+            </TabsList>
+          </Tabs>
+        </CardHeader>
+        <CardContent>
+          <DataTable
+            data={requests}
+            columns={columns}
+            onDelete={(request) => setRequestToDelete(request)}
+            additionalActions={(request) =>
+              request.status === "dead" && (
+                <Button
+                  variant="ghost"
+                  size="icon"
+                  title="Retry"
+                  onClick={() => handleRetry(request)}
+                >
+                  <RotateCcw className="h-4 w-4" />
+                </Button>
+              )
+            }
+          />
+          <div className="flex items-center justify-end gap-2 mt-4">
+            <Button
+              variant="outline"
+              size="sm"
+              disabled={offset === 0}
+              onClick={() => setOffset(Math.max(0, offset - PAGE_SIZE))}
+            >
+              Previous
+            </Button>
+            <Button
+              variant="outline"
+              size="sm"
+              disabled={offset + PAGE_SIZE >= total}
+              onClick={() => setOffset(offset + PAGE_SIZE)}
+            >
+              Next
+            </Button>
+          </div>
+        </CardContent>
+      </Card>
+
+      <AlertDialog
+        open={requestToDelete !== null}
+        onOpenChange={(open) => !open && setRequestToDelete(null)}
+      >
+        <AlertDialogContent>
+          <AlertDialogHeader>
+            <AlertDialogTitle>Delete Request</AlertDialogTitle>
+            <AlertDialogDescription>
+              Request #{requestToDelete?.id} will be deleted and never delivered.
+              This action cannot be undone.
+            </AlertDialogDescription>
+          </AlertDialogHeader>
+          <AlertDialogFooter>
+            <AlertDialogCancel>Cancel</AlertDialogCancel>
+            <AlertDialogAction onClick={confirmDelete}>Delete</AlertDialogAction>
+          </AlertDialogFooter>
+        </AlertDialogContent>
+      </AlertDialog>
+    </div>
+  );
+}
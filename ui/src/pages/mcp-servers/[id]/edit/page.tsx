@@ -0,0 +1,128 @@
+import { useState, useEffect } from "react";
+import { useNavigate, useParams } from "react-router-dom";
+import { Loader2 } from "lucide-react";
+import { useToast } from "@/components/toast";
+import { McpServerForm } from "@/components/mcp-server-form/mcp-server-form";
+import { fetchMcpServer, updateMcpServer } from "@/lib/api";
+import * as yaml from "js-yaml";
+
+export default function EditMcpServerPage() {
+  const navigate = useNavigate();
+  const { id } = useParams<{ id: string }>();
+  const { addToast } = useToast();
+  const [isLoading, setIsLoading] = useState(true);
+  const [isSubmitting, setIsSubmitting] = useState(false);
+  const [mcpServerData, setMcpServerData] = useState<{
+    label: string;
+    component: string;
+    config: any;
+  } | null>(null);
+
+  useEffect(() => {
+    async function loadMcpServer() {
+      try {
+        setIsLoading(true);
+        const server = await fetchMcpServer(id || "");
+
+        let parsedConfig = {};
+        try {
+          parsedConfig = yaml.load(server.config) || {};
+        } catch (error) {
+          console.error("Failed to parse MCP server config YAML:", error);
+        }
+
+        setMcpServerData({
+          label: server.label,
+          component: server.component,
+          config: parsedConfig,
+        });
+      } catch (error) {
+        console.error("Error loading MCP server:", error);
+        addToast({
+          id: "fetch-error",
+          title: "Error Loading MCP Server",
+          description:
+            error instanceof Error
+              ? error.message
+              : "An unknown error occurred",
+          variant: "error",
+        });
+        navigate("/mcp-servers");
+      } finally {
+        setIsLoading(false);
+      }
+    }
+
+    loadMcpServer();
+  }, [id, navigate, addToast]);
+
+  const handleSaveMcpServer = async (data: {
+    label: string;
+    component: string;
+    config: any;
+  }) => {
+    setIsSubmitting(true);
+    try {
+      await updateMcpServer(id || "", data);
+
+      addToast({
+        id: "mcp-server-updated",
+        title: "MCP Server Updated",
+        description: `MCP server "${data.label}" has been updated successfully.`,
+        variant: "success",
+      });
+
+      navigate("/mcp-servers");
+    } catch (error) {
+      console.error("Error updating MCP server:", error);
+      addToast({
+        id: "mcp-server-update-error",
+        title: "Error",
+        description:
+          error instanceof Error
+            ? error.message
+            : "Failed to update MCP server.",
+        variant: "error",
+      });
+    } finally {
+      setIsSubmitting(false);
+    }
+  };
+
+  const handleCancel = () => {
+    navigate("/mcp-servers");
+  };
+
+  if (isLoading) {
+    return (
+      <div className="p-6 flex justify-center items-center h-64">
+        <Loader2 className="h-8 w-8 animate-spin text-muted-foreground" />
+      </div>
+    );
+  }
+
+  return (
+    <div className="p-6">
+      <div className="mb-6">
+        <h1 className="text-2xl font-bold">Edit MCP Server</h1>
+        <p className="text-muted-foreground">
+          Modify your upstream MCP server configuration
+        </p>
+      </div>
+
+      {isSubmitting ? (
+        <div className="flex justify-center items-center h-64">
+          <Loader2 className="h-8 w-8 animate-spin text-muted-foreground" />
+        </div>
+      ) : (
+        mcpServerData && (
+          <McpServerForm
+            initialData={mcpServerData}
+            onSubmit={handleSaveMcpServer}
+            onCancel={handleCancel}
+          />
+        )
+      )}
+    </div>
+  );
+}
@@ -0,0 +1,69 @@
+import { useState } from "react";
+import { useNavigate } from "react-router-dom";
+import { Loader2 } from "lucide-react";
+import { useToast } from "@/components/toast";
+import { McpServerForm } from "@/components/mcp-server-form/mcp-server-form";
+import { createMcpServer } from "@/lib/api";
+
+export default function NewMcpServerPage() {
+  const navigate = useNavigate();
+  const { addToast } = useToast();
+  const [isSubmitting, setIsSubmitting] = useState(false);
+
+  const handleSaveMcpServer = async (data: {
+    label: string;
+    component: string;
+    config: any;
+  }) => {
+    setIsSubmitting(true);
+    try {
+      await createMcpServer(data);
+
+      addToast({
+        id: "mcp-server-created",
+        title: "MCP Server Created",
+        description: `MCP server "${data.label}" has been created successfully.`,
+        variant: "success",
+      });
+
+      // Navigate back to MCP servers list
+      navigate("/mcp-servers");
+    } catch (error) {
+      console.error("Error creating MCP server:", error);
+      addToast({
+        id: "mcp-server-creation-error",
+        title: "Error",
+        description:
+          error instanceof Error
+            ? error.message
+            : "Failed to create MCP server.",
+        variant: "error",
+      });
+    } finally {
+      setIsSubmitting(false);
+    }
+  };
+
+  const handleCancel = () => {
+    navigate("/mcp-servers");
+  };
+
+  return (
+    <div className="p-6">
+      <div className="mb-6">
+        <h1 className="text-2xl font-bold">Add New MCP Server</h1>
+        <p className="text-muted-foreground">
+          Connect an upstream MCP server and re-export its tools
+        </p>
+      </div>
+
+      {isSubmitting ? (
+        <div className="flex justify-center items-center h-64">
+          <Loader2 className="h-8 w-8 animate-spin text-muted-foreground" />
+        </div>
+      ) : (
+        <McpServerForm onSubmit={handleSaveMcpServer} onCancel={handleCancel} />
+      )}
+    </div>
+  );
+}
@@ -0,0 +1,109 @@
+import { useEffect, useState } from "react";
+import { Button } from "@/components/ui/button";
+import { DataTable } from "@/components/data-table";
+import { Plus } from "lucide-react";
+import { useNavigate } from "react-router-dom";
+import { useToast } from "@/components/toast";
+import { McpServer } from "@/lib/entities";
+import { fetchMcpServers, deleteMcpServer } from "@/lib/api";
+import { columns } from "@/components/mcp-server-columns";
+
+export default function McpServersPage() {
+  const navigate = useNavigate();
+  const { addToast } = useToast();
+  const [mcpServers, setMcpServers] = useState<McpServer[]>([]);
+  const [loading, setLoading] = useState(true);
+  const [error, setError] = useState<string | null>(null);
+
+  const handleRowClick = (server: McpServer) => {
+    navigate(`/mcp-servers/${server.id}/edit`);
+  };
+
+  const handleAddNew = () => {
+    navigate("/mcp-servers/new");
+  };
+
+  const handleDelete = async (server: McpServer) => {
+    try {
+      await deleteMcpServer(server.id);
+      setMcpServers(mcpServers.filter((s) => s.id !== server.id));
+
+      addToast({
+        id: "mcp-server-deleted",
+        title: "MCP Server Deleted",
+        description: `${server.label} has been deleted successfully.`,
+        variant: "success",
+      });
+    } catch (error) {
+      addToast({
+        id: "mcp-server-delete-error",
+        title: "Error",
+        description:
+          error instanceof Error
+            ? error.message
+            : "Failed to delete MCP server.",
+        variant: "error",
+      });
+    }
+  };
+
+  useEffect(() => {
+    const loadMcpServers = async () => {
+      try {
+        setLoading(true);
+        const data = await fetchMcpServers();
+        setMcpServers(data);
+        setError(null);
+      } catch (err) {
+        setError("Failed to fetch MCP servers");
+        console.error(err);
+      } finally {
+        setLoading(false);
+      }
+    };
+    loadMcpServers();
+  }, []);
+
+  return (
+    <div className="p-6">
+      <div className="flex items-center justify-between mb-6">
+        <div>
+          <h1 className="text-2xl font-bold">MCP Servers</h1>
+          <p className="text-muted-foreground mt-1">
+            Manage upstream MCP servers exposed through the MCP endpoint
+          </p>
+        </div>
+        <Button onClick={handleAddNew}>
+          <Plus className="mr-2 h-4 w-4" />
+          Add New
+        </Button>
+      </div>
+
+      {loading ? (
+        <p>Loading MCP servers...</p>
+      ) : error ? (
+        <p className="text-red-500">{error}</p>
+      ) : mcpServers.length === 0 ? (
+        <div className="flex flex-col items-center justify-center min-h-[400px] border-2 border-dashed border-gray-300 dark:border-gray-700 rounded-lg">
+          <div className="text-center p-8">
+            <h3 className="text-lg font-semibold mb-2">No MCP servers yet</h3>
+            <p className="text-muted-foreground mb-4">
+              Get started by connecting your first upstream MCP server
+            </p>
+            <Button onClick={handleAddNew}>
+              <Plus className="mr-2 h-4 w-4" />
+              Add New MCP Server
+            </Button>
+          </div>
+        </div>
+      ) : (
+        <DataTable
+          data={mcpServers}
+          columns={columns()}
+          onEdit={handleRowClick}
+          onDelete={handleDelete}
+        />
+      )}
+    </div>
+  );
+}
@@ -0,0 +1,297 @@
+import { useCallback, useEffect, useState } from "react";
+import { useParams } from "react-router-dom";
+import { RotateCcw } from "lucide-react";
+import { Bar, BarChart, CartesianGrid, XAxis, YAxis } from "recharts";
+import {
+  Card,
+  CardContent,
+  CardDescription,
+  CardHeader,
+  CardTitle,
+} from "@/components/ui/card";
+import {
+  ChartConfig,
+  ChartContainer,
+  ChartTooltip,
+  ChartTooltipContent,
+} from "@/components/ui/chart";
+import { Button } from "@/components/ui/button";
+import { Input } from "@/components/ui/input";
+import { Tabs, TabsList, TabsTrigger } from "@/components/ui/tabs";
+import {
+  AlertDialog,
+  AlertDialogAction,
+  AlertDialogCancel,
+  AlertDialogContent,
+  AlertDialogDescription,
+  AlertDialogFooter,
+  AlertDialogHeader,
+  AlertDialogTitle,
+} from "@/components/ui/alert-dialog";
+
+import { RateLimit, RateLimitKey, RateLimitStats } from "@/lib/entities";
+import { DataTable } from "@/components/data-table";
+import { useToast } from "@/components/toast";
+import {
+  fetchRateLimit,
+  fetchRateLimitKeys,
+  fetchRateLimitStats,
+  resetRateLimit,
+} from "@/lib/api";
+
+const PAGE_SIZE = 50;
+
+const statsChartConfig = {
+  allowed: { label: "Allowed", color: "hsl(142, 76%, 36%)" },
+  denied: { label: "Denied", color: "hsl(0, 84%, 60%)" },
+} satisfies ChartConfig;
+
+export default function RateLimitUsagePage() {
+  const { id } = useParams<{ id: string }>();
+  const { addToast } = useToast();
+  const [rateLimit, setRateLimit] = useState<RateLimit | null>(null);
+  const [hours, setHours] = useState("24");
+  const [stats, setStats] = useState<RateLimitStats | null>(null);
+  const [search, setSearch] = useState("");
+  const [offset, setOffset] = useState(0);
+  const [keys, setKeys] = useState<RateLimitKey[]>([]);
+  const [total, setTotal] = useState(0);
+  // null closes the dialog, an empty key resets every key of the rate limit.
+  const [keyToReset, setKeyToReset] = useState<string | null>(null);
+
+  useEffect(() => {
+    if (!id) return;
+    fetchRateLimit(id)
+      .then(setRateLimit)
+      .catch((error) => console.error("Error fetching rate limit:", error));
+  }, [id]);
+
+  const loadStats = useCallback(async () => {
+    if (!id) return;
+    try {
+      setStats(await fetchRateLimitStats(id, Number(hours)));
+    } catch (error) {
+      console.error("Error fetching rate limit stats:", error);
+    }
+  }, [id, hours]);
+
+  const loadKeys = useCallback(async () => {
+    if (!id) return;
+    try {
+      const result = await fetchRateLimitKeys(id, {
+        key: search,
+        limit: PAGE_SIZE,
+        offset,
+      });
+      setKeys(result.data);
+      setTotal(result.total);
+    } catch (error) {
+      console.error("Error fetching rate limit keys:", error);
+    }
+  }, [id, search, offset]);
+
+  useEffect(() => {
+    loadStats();
+  }, [loadStats]);
+
+  useEffect(() => {
+    loadKeys();
+  }, [loadKeys]);
+
+  const confirmReset = async () => {
+    if (!id || keyToReset === null) return;
+    try {
+      await resetRateLimit(id, keyToReset);
+      addToast({
+        id: "rate-limit-reset",
+        title: "Rate Limit Reset",
+        description: keyToReset
+          ? `${keyToReset} has been reset successfully.`
+          : "All keys have been reset successfully.",
+        variant: "success",
+      });
+      loadKeys();
+    } catch (error) {
+      addToast({
+        id: "rate-limit-reset-error",
+        title: "Error Resetting Rate Limit",
+        description:
+          error instanceof Error ? error.message : "An unknown error occurred",
+        variant: "error",
+      });
+    } finally {
+      setKeyToReset(null);
+    }
+  };
+
+  const columns = [
+    {
+      key: "key" as keyof RateLimitKey,
+      title: "Key",
+      render: (value: string) => (
+        <span className="font-mono text-xs">{value}</span>
+      ),
+    },
+    {
+      key: "remaining" as keyof RateLimitKey,
+      title: "Remaining",
+      render: (value: number, row: RateLimitKey) => `${value} / ${row.limit}`,
+    },
+    {
+      key: "resetAt" as keyof RateLimitKey,
+      title: "Resets",
+      render: (value: number) => new Date(value * 1000).toLocaleString(),
+    },
+    {
+      key: "updatedAt" as keyof RateLimitKey,
+      title: "Last Used",
+      render: (value: string) => new Date(value).toLocaleString(),
+    },
+  ];
+
+  return (
+    <div className="p-6">
+      <div className="flex items-center justify-between mb-6">
+        <div>
+          <h1 className="text-2xl font-bold">
+            {rateLimit ? rateLimit.label : "Rate Limit"} Usage
+          </h1>
+          <p className="text-muted-foreground">
+            Allowed and denied requests over time, and the remaining quota of
+            each key
+          </p>
+        </div>
+        <Button variant="outline" onClick={() => setKeyToReset("")}>
+          <RotateCcw className="mr-2 h-4 w-4" />
+          Reset All Keys
+        </Button>
+      </div>
+
+      <Card className="mb-6">
+        <CardHeader className="flex flex-row items-center justify-between">
+          <div>
+            <CardTitle>Requests Over Time</CardTitle>
+            <CardDescription>
+              {stats
+                ? `${stats.totalAllowed} allowed, ${stats.totalDenied} denied`
+                : "Loading..."}
+            </CardDescription>
+          </div>
+          <Tabs value={hours} onValueChange={setHours}>
+            <TabsList>
+              <TabsTrigger value="1">1h</TabsTrigger>
+              <TabsTrigger value="24">24h</TabsTrigger>
+              <TabsTrigger value="168">7d</TabsTrigger>
+            </TabsList>
+          </Tabs>
+        </CardHeader>
+        <CardContent>
+          <ChartContainer config={statsChartConfig} className="h-[300px] w-full">
+            <BarChart data={stats?.data || []} accessibilityLayer>
+              <CartesianGrid vertical={false} />
+              <XAxis
+                dataKey="timestamp"
+                tickLine={false}
+                axisLine={false}
+                tickFormatter={(value) => {
+                  const d = new Date(value);
+                  return hours === "168"
+                    ? d.toLocaleDateString("en-US", {
+                        month: "short",
+                        day: "numeric",
+                      })
+                    : d.toLocaleTimeString("en-US", {
+                        hour: "2-digit",
+                        minute: "2-digit",
+                      });
+                }}
+              />
+              <YAxis tickLine={false} axisLine={false} />
+              <ChartTooltip content={<ChartTooltipContent />} />
+              <Bar
+                dataKey="allowed"
+                stackId="requests"
+                fill="var(--color-allowed)"
+              />
+              <Bar
+                dataKey="denied"
+                stackId="requests"
+                fill="var(--color-denied)"
+              />
+            </BarChart>
+          </ChartContainer>
+        </CardContent>
+      </Card>
+
+      <Card>
+        <CardHeader className="flex flex-row items-center justify-between">
+          <CardTitle>Keys ({total})</CardTitle>
+          <Input
+            className="max-w-xs"
+            placeholder="Filter by key prefix"
+            value={search}
+            onChange={(e) => {
+              setSearch(e.target.value);
+              setOffset(0);
+            }}
+          />
+        </CardHeader>
+        <CardContent>
+          <DataTable
+            data={keys}
+            columns={columns}
+            getRowId={(key) => key.key}
+            additionalActions={(key) => (
+              <Button
+                variant="ghost"
+                size="icon"
+                title="Reset"
+                onClick={() => setKeyToReset(key.key)}
+              >
+                <RotateCcw className="h-4 w-4" />
+              </Button>
+            )}
+          />
+          <div className="flex items-center justify-end gap-2 mt-4">
+            <Button
+              variant="outline"
+              size="sm"
+              disabled={offset === 0}
+              onClick={() => setOffset(Math.max(0, offset - PAGE_SIZE))}
+            >
+              Previous
+            </Button>
+            <Button
+              variant="outline"
+              size="sm"
+              disabled={offset + PAGE_SIZE >= total}
+              onClick={() => setOffset(offset + PAGE_SIZE)}
+            >
+              Next
+            </Button>
+          </div>
+        </CardContent>
+      </Card>
+
+      <AlertDialog
+        open={keyToReset !== null}
+        onOpenChange={(open) => !open && setKeyToReset(null)}
+      >
+        <AlertDialogContent>
+          <AlertDialogHeader>
+            <AlertDialogTitle>Reset Rate Limit</AlertDialogTitle>
+            <AlertDialogDescription>
+              {keyToReset
+                ? `${keyToReset} will start over with its full quota.`
+                : "Every key of this rate limit will start over with its full quota."}
+            </AlertDialogDescription>
+          </AlertDialogHeader>
+          <AlertDialogFooter>
+            <AlertDialogCancel>Cancel</AlertDialogCancel>
+            <AlertDialogAction onClick={confirmReset}>Reset</AlertDialogAction>
+          </AlertDialogFooter>
+        </AlertDialogContent>
+      </AlertDialog>
+    </div>
+  );
+}
@@ -1,7 +1,7 @@
 import { useEffect, useState } from "react";
 import { Button } from "@/components/ui/button";
 import { DataTable } from "@/components/data-table";
-import { Plus } from "lucide-react";
+import { Activity, Plus } from "lucide-react";
 import { useNavigate } from "react-router-dom";
 import { useToast } from "@/components/toast";
 import { RateLimit } from "@/lib/entities";
@@ -100,6 +100,16 @@ export default function RateLimitsPage() {
           columns={columns()}
           onEdit={handleRowClick}
           onDelete={handleDelete}
+          additionalActions={(rateLimit) => (
+            <Button
+              variant="ghost"
+              size="icon"
+              title="Usage"
+              onClick={() => navigate(`/rate-limits/${rateLimit.id}/usage`)}
+            >
+              <Activity className="h-4 w-4" />
+            </Button>
+          )}
         />
       )}
     </div>
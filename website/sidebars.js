@@ -50,6 +50,9 @@ const sidebars = {
           link: { type: "doc", id: "components/processors/index" },
           items: [
             "components/processors/ai-gateway",
+            "components/processors/mcp-call",
+            "components/processors/mcp-progress",
+            "components/processors/coordinator-rate-limit",
             "components/processors/mapping",
             "components/processors/json-schema",
             "components/processors/catch",
@@ -67,6 +70,7 @@ const sidebars = {
           link: { type: "doc", id: "components/outputs/index" },
           items: [
             "components/outputs/http-client",
+            "components/outputs/mcp",
             "components/outputs/kafka",
             "components/outputs/amqp-0-9",
             "components/outputs/sql-insert",
@@ -76,6 +80,7 @@ const sidebars = {
           ],
         },
         "components/caches",
+        "components/mcp-servers",
       ],
     },
     {
@@ -84,6 +89,10 @@ const sidebars = {
       items: [
         "guides/scaling-workers",
         "guides/keycloak-authentication",
+        "guides/monitoring",
+        "guides/flow-events",
+        "guides/flow-logs",
+        "guides/alerting",
       ],
     },
     {